@@ -35,6 +35,7 @@ import (
 // Collection contains a collection of casts.
 type Collection struct {
 	objinterface.RootObjectMap
+	renamedTypes map[id.Type]id.Type // This is only used by the function `WithRenamedTypes`
 }
 
 // CastType is the type of the cast, indicating which contexts it may be called in.
@@ -295,7 +296,9 @@ func (pgc *Collection) getCast(ctx context.Context, castID id.Cast, sourceType *
 func (pgc *Collection) getSizingOrIdentityCast(castID id.Cast, sourceType *pgtypes.DoltgresType, targetType *pgtypes.DoltgresType, castType CastType) Cast {
 	// If we receive different types, then we can return immediately
 	if sourceType.ID != targetType.ID {
-		return Cast{}
+		if renamedID, ok := pgc.renamedTypes[sourceType.ID]; !ok || renamedID != targetType.ID {
+			return Cast{}
+		}
 	}
 
 	// If we have different atttypmod values, then we need to do a sizing cast only if one exists
@@ -314,12 +317,118 @@ func (pgc *Collection) getSizingOrIdentityCast(castID id.Cast, sourceType *pgtyp
 		ID:       castID,
 		CastType: castType,
 		Function: id.NullFunction,
-		BuiltIn:  nil,
+		BuiltIn:  pgc.getAlteredTypeCast(sourceType, targetType, castType),
 		UseInOut: useInOut,
 		request:  castType,
 	}
 }
 
+// getAlteredTypeCast returns the function that converts values between two versions of the same enum or composite
+// type, which differ when the type has been altered since the values were written. Enum labels that were renamed
+// retain their sort order, and composite attributes retain their number, which is how the values are matched. Returns
+// nil when the values do not need to be converted.
+func (pgc *Collection) getAlteredTypeCast(sourceType *pgtypes.DoltgresType, targetType *pgtypes.DoltgresType, castType CastType) pgtypes.TypeCastFunction {
+	switch {
+	case sourceType.TypType == pgtypes.TypeType_Enum && targetType.TypType == pgtypes.TypeType_Enum:
+		for label := range sourceType.EnumLabels {
+			if _, ok := targetType.EnumLabels[label]; !ok {
+				return enumLabelCast
+			}
+		}
+		return nil
+	case sourceType.TypType == pgtypes.TypeType_Composite && targetType.TypType == pgtypes.TypeType_Composite:
+		if len(sourceType.CompositeAttrs) != len(targetType.CompositeAttrs) {
+			return pgc.compositeAttributeCast(castType)
+		}
+		for i := range sourceType.CompositeAttrs {
+			if sourceType.CompositeAttrs[i].Num != targetType.CompositeAttrs[i].Num ||
+				sourceType.CompositeAttrs[i].Type.ID != targetType.CompositeAttrs[i].Type.ID {
+				return pgc.compositeAttributeCast(castType)
+			}
+		}
+		return nil
+	default:
+		return nil
+	}
+}
+
+// enumLabelCast converts a label of the source enum to the matching label of the target enum. Labels are matched by
+// name, and then by their sort order for labels that have been renamed.
+func enumLabelCast(ctx *sql.Context, val any, sourceType *pgtypes.DoltgresType, targetType *pgtypes.DoltgresType) (any, error) {
+	label, ok, err := sql.Unwrap[string](ctx, val)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errors.Errorf("expected enum label, but got %T", val)
+	}
+	if _, ok = targetType.EnumLabels[label]; ok {
+		return label, nil
+	}
+	if sourceLabel, ok := sourceType.EnumLabels[label]; ok {
+		for targetLabel, enumLabel := range targetType.EnumLabels {
+			if enumLabel.SortOrder == sourceLabel.SortOrder {
+				return targetLabel, nil
+			}
+		}
+	}
+	return nil, pgtypes.ErrInvalidInputValueForEnum.New(targetType.Name(), label)
+}
+
+// compositeAttributeCast returns the function that converts a value of the source composite type to the target
+// composite type. Attributes are matched by their number, with attributes that only exist in the target set to NULL.
+func (pgc *Collection) compositeAttributeCast(castType CastType) pgtypes.TypeCastFunction {
+	return func(ctx *sql.Context, val any, sourceType *pgtypes.DoltgresType, targetType *pgtypes.DoltgresType) (_ any, err error) {
+		vals, ok := val.([]pgtypes.RecordValue)
+		if !ok || len(vals) != len(sourceType.CompositeAttrs) {
+			return nil, errors.Errorf("cannot cast type %s to %s", sourceType.Name(), targetType.Name())
+		}
+		outputVals := make([]pgtypes.RecordValue, len(targetType.CompositeAttrs))
+		for i, targetAttr := range targetType.CompositeAttrs {
+			outputVals[i].Type = targetAttr.Type
+			for j, sourceAttr := range sourceType.CompositeAttrs {
+				if sourceAttr.Num != targetAttr.Num || vals[j].Value == nil {
+					continue
+				}
+				valType, ok := vals[j].Type.(*pgtypes.DoltgresType)
+				if !ok {
+					return nil, errors.New("cannot cast record containing GMS type")
+				}
+				var attrCast Cast
+				switch castType {
+				case CastType_Explicit:
+					attrCast, err = pgc.GetExplicitCast(ctx, valType, targetAttr.Type)
+				case CastType_Assignment:
+					attrCast, err = pgc.GetAssignmentCast(ctx, valType, targetAttr.Type)
+				case CastType_Implicit:
+					attrCast, err = pgc.GetImplicitCast(ctx, valType, targetAttr.Type)
+				}
+				if err != nil {
+					return nil, err
+				}
+				if !attrCast.ID.IsValid() {
+					return nil, errors.Errorf("cannot cast type %s to %s in attribute %s",
+						valType.Name(), targetAttr.Type.Name(), targetAttr.Name)
+				}
+				if outputVals[i].Value, err = attrCast.Eval(ctx, vals[j].Value, valType, targetAttr.Type); err != nil {
+					return nil, err
+				}
+			}
+		}
+		return outputVals, nil
+	}
+}
+
+// WithRenamedTypes executes the given function while treating each type in the map as the same type as the one that
+// it has been renamed to, which allows values to be converted to the new name of their type.
+func (pgc *Collection) WithRenamedTypes(renamedTypes map[id.Type]id.Type, f func() error) error {
+	pgc.renamedTypes = renamedTypes
+	defer func() {
+		pgc.renamedTypes = nil
+	}()
+	return f()
+}
+
 // getRecordCast handles casting from a record type to a composite type (if applicable). Returns a Cast with an invalid
 // ID if not applicable.
 func (pgc *Collection) getRecordCast(sourceType *pgtypes.DoltgresType, targetType *pgtypes.DoltgresType, castType CastType) Cast {
@@ -29,9 +29,13 @@ import (
 
 	"github.com/dolthub/doltgresql/core/aggregates"
 	"github.com/dolthub/doltgresql/core/casts"
+	"github.com/dolthub/doltgresql/core/conflicts"
 	"github.com/dolthub/doltgresql/core/extensions"
 	"github.com/dolthub/doltgresql/core/functions"
+	"github.com/dolthub/doltgresql/core/indexmethods"
+	"github.com/dolthub/doltgresql/core/matviews"
 	"github.com/dolthub/doltgresql/core/operators"
+	"github.com/dolthub/doltgresql/core/policies"
 	"github.com/dolthub/doltgresql/core/procedures"
 	"github.com/dolthub/doltgresql/core/rootobject"
 	"github.com/dolthub/doltgresql/core/rootobject/objinterface"
@@ -366,6 +370,26 @@ func GetAggregatesCollectionFromContext(ctx *sql.Context, database string) (*agg
 	return coll.(*aggregates.Collection), nil
 }
 
+// GetIndexAccessMethodsCollectionFromContext returns the given index access methods collection from the context.
+// Will always return a collection if no error is returned.
+func GetIndexAccessMethodsCollectionFromContext(ctx *sql.Context, database string) (*indexmethods.Collection, error) {
+	coll, err := collectionFromContext(ctx, database, objinterface.RootObjectID_IndexAccessMethods)
+	if err != nil {
+		return nil, err
+	}
+	return coll.(*indexmethods.Collection), nil
+}
+
+// GetMaterializedViewsCollectionFromContext returns the given materialized views collection from the context.
+// Will always return a collection if no error is returned.
+func GetMaterializedViewsCollectionFromContext(ctx *sql.Context, database string) (*matviews.Collection, error) {
+	coll, err := collectionFromContext(ctx, database, objinterface.RootObjectID_MaterializedViews)
+	if err != nil {
+		return nil, err
+	}
+	return coll.(*matviews.Collection), nil
+}
+
 // GetOperatorsCollectionFromContext returns the given operators collection from the context.
 // Will always return a collection if no error is returned.
 func GetOperatorsCollectionFromContext(ctx *sql.Context, database string) (*operators.Collection, error) {
@@ -376,6 +400,26 @@ func GetOperatorsCollectionFromContext(ctx *sql.Context, database string) (*oper
 	return coll.(*operators.Collection), nil
 }
 
+// GetConflictsCollectionFromContext returns the root object conflicts collection from the given context. Will always
+// return a collection if no error is returned.
+func GetConflictsCollectionFromContext(ctx *sql.Context, database string) (*conflicts.Collection, error) {
+	coll, err := collectionFromContext(ctx, database, objinterface.RootObjectID_Conflicts)
+	if err != nil {
+		return nil, err
+	}
+	return coll.(*conflicts.Collection), nil
+}
+
+// GetRootObjectCollectionFromContext returns the collection matching the given root object ID from the context. This
+// is used when the type of root object is only known at runtime (such as when resolving conflicts). Will always return
+// a collection if no error is returned.
+func GetRootObjectCollectionFromContext(ctx *sql.Context, database string, rootObjID objinterface.RootObjectID) (objinterface.Collection, error) {
+	if rootObjID <= objinterface.RootObjectID_None || rootObjID >= objinterface.RootObjectID_Count {
+		return nil, errors.Errorf("invalid root object ID: %d", rootObjID)
+	}
+	return collectionFromContext(ctx, database, rootObjID)
+}
+
 // GetExtensionsCollectionFromContext returns the extensions collection from the given context. Will always return a
 // collection if no error is returned.
 func GetExtensionsCollectionFromContext(ctx *sql.Context, database string) (*extensions.Collection, error) {
@@ -396,6 +440,16 @@ func GetFunctionsCollectionFromContext(ctx *sql.Context, database string) (*func
 	return coll.(*functions.Collection), nil
 }
 
+// GetPoliciesCollectionFromContext returns the given policies collection from the context.
+// Will always return a collection if no error is returned.
+func GetPoliciesCollectionFromContext(ctx *sql.Context, database string) (*policies.Collection, error) {
+	coll, err := collectionFromContext(ctx, database, objinterface.RootObjectID_Policies)
+	if err != nil {
+		return nil, err
+	}
+	return coll.(*policies.Collection), nil
+}
+
 // GetProceduresCollectionFromContext returns the procedures collection from the given context. Will always return a
 // collection if no error is returned.
 func GetProceduresCollectionFromContext(ctx *sql.Context, database string) (*procedures.Collection, error) {
@@ -24,6 +24,7 @@ import (
 	"github.com/dolthub/dolt/go/store/hash"
 
 	"github.com/dolthub/doltgresql/core/id"
+	pgmerge "github.com/dolthub/doltgresql/core/merge"
 	"github.com/dolthub/doltgresql/core/procedures"
 	"github.com/dolthub/doltgresql/core/rootobject/objinterface"
 	"github.com/dolthub/doltgresql/server/plpgsql"
@@ -321,6 +322,38 @@ func (function Function) ReplaceDefinition(newInner string) string {
 	return strings.Replace(function.Definition, function.GetInnerDefinition(), newInner, 1)
 }
 
+// mergeInnerDefinition performs a three-way merge of the inner definitions of our function and their function, returning
+// our function with the merged definition. Returns false if the changes conflict, or if there is no ancestor to merge
+// from. A merged PL/pgSQL body that no longer parses is also considered a conflict.
+func (function Function) mergeInnerDefinition(theirs Function, ancestor Function, hasAncestor bool) (Function, bool) {
+	if !hasAncestor {
+		return Function{}, false
+	}
+	mergedInner, ok := pgmerge.MergeText(function.GetInnerDefinition(), theirs.GetInnerDefinition(), ancestor.GetInnerDefinition())
+	if !ok {
+		return Function{}, false
+	}
+	merged, err := function.replaceInnerDefinition(mergedInner)
+	if err != nil {
+		return Function{}, false
+	}
+	return merged, true
+}
+
+// replaceInnerDefinition returns a copy of the function with the inner definition replaced with the given string. The
+// interpreter operations of PL/pgSQL functions are parsed from the new definition, so that they always match it.
+func (function Function) replaceInnerDefinition(newInner string) (Function, error) {
+	function.Definition = function.ReplaceDefinition(newInner)
+	if len(function.Operations) > 0 {
+		parsedBody, err := plpgsql.Parse(function.Definition)
+		if err != nil {
+			return Function{}, err
+		}
+		function.Operations = parsedBody
+	}
+	return function, nil
+}
+
 // GetRootObjectID implements the interface objinterface.RootObject.
 func (function Function) GetRootObjectID() objinterface.RootObjectID {
 	return objinterface.RootObjectID_Functions
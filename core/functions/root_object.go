@@ -115,9 +115,17 @@ func (pgf *Collection) DiffRootObjects(ctx context.Context, fromHash string, o o
 			FieldName: FIELD_NAME_DEFINITION,
 		}
 		if pgmerge.DiffValues(&diff, ours.GetInnerDefinition(), theirs.GetInnerDefinition(), ancestor.GetInnerDefinition(), hasAncestor) {
-			diffs = append(diffs, diff)
+			// Both sides changed the body, so we attempt to merge the changes if they were made to different statements
+			if merged, ok := ours.mergeInnerDefinition(theirs, ancestor, hasAncestor); ok {
+				ours = merged
+			} else {
+				diffs = append(diffs, diff)
+			}
 		} else {
-			ours.Definition = ours.ReplaceDefinition(diff.OurValue.(string))
+			var err error
+			if ours, err = ours.replaceInnerDefinition(diff.OurValue.(string)); err != nil {
+				return nil, nil, err
+			}
 		}
 	}
 	if ours.ExtensionName != theirs.ExtensionName {
@@ -151,7 +159,15 @@ func (pgf *Collection) DiffRootObjects(ctx context.Context, fromHash string, o o
 			FieldName: FIELD_NAME_SQL_DEFINITION,
 		}
 		if pgmerge.DiffValues(&diff, ours.SQLDefinition, theirs.SQLDefinition, ancestor.SQLDefinition, hasAncestor) {
-			diffs = append(diffs, diff)
+			merged, ok := "", false
+			if hasAncestor {
+				merged, ok = pgmerge.MergeText(ours.SQLDefinition, theirs.SQLDefinition, ancestor.SQLDefinition)
+			}
+			if ok {
+				ours.SQLDefinition = merged
+			} else {
+				diffs = append(diffs, diff)
+			}
 		} else {
 			ours.SQLDefinition = diff.OurValue.(string)
 		}
@@ -56,6 +56,19 @@ func init() {
 	globalCache.setBuiltIn(NewId(Section_OperatorFamily, "hash", "uuid_ops"), 2969)
 	globalCache.setBuiltIn(NewId(Section_OperatorFamily, "hash", "jsonb_ops"), 4034)
 
+	// gin operator families
+	globalCache.setBuiltIn(NewId(Section_OperatorFamily, "gin", "array_ops"), 2745)
+	globalCache.setBuiltIn(NewId(Section_OperatorFamily, "gin", "jsonb_ops"), 4036)
+	globalCache.setBuiltIn(NewId(Section_OperatorFamily, "gin", "jsonb_path_ops"), 4037)
+
+	// brin operator families
+	globalCache.setBuiltIn(NewId(Section_OperatorFamily, "brin", "integer_minmax_ops"), 4054)
+	globalCache.setBuiltIn(NewId(Section_OperatorFamily, "brin", "numeric_minmax_ops"), 4055)
+	globalCache.setBuiltIn(NewId(Section_OperatorFamily, "brin", "text_minmax_ops"), 4056)
+	globalCache.setBuiltIn(NewId(Section_OperatorFamily, "brin", "datetime_minmax_ops"), 4059)
+	globalCache.setBuiltIn(NewId(Section_OperatorFamily, "brin", "float_minmax_ops"), 4070)
+	globalCache.setBuiltIn(NewId(Section_OperatorFamily, "brin", "uuid_minmax_ops"), 4081)
+
 	// btree operator classes (Doltgres-assigned OIDs, see comment above)
 	globalCache.setBuiltIn(NewId(Section_OperatorClass, "btree", "array_ops"), 15000)
 	globalCache.setBuiltIn(NewId(Section_OperatorClass, "btree", "bool_ops"), 15001)
@@ -103,4 +116,22 @@ func init() {
 	globalCache.setBuiltIn(NewId(Section_OperatorClass, "hash", "timestamptz_ops"), 15115)
 	globalCache.setBuiltIn(NewId(Section_OperatorClass, "hash", "uuid_ops"), 15116)
 	globalCache.setBuiltIn(NewId(Section_OperatorClass, "hash", "varchar_ops"), 15117)
+
+	// gin operator classes (Doltgres-assigned OIDs, see comment above)
+	globalCache.setBuiltIn(NewId(Section_OperatorClass, "gin", "array_ops"), 15200)
+	globalCache.setBuiltIn(NewId(Section_OperatorClass, "gin", "jsonb_ops"), 15201)
+	globalCache.setBuiltIn(NewId(Section_OperatorClass, "gin", "jsonb_path_ops"), 15202)
+
+	// brin operator classes (Doltgres-assigned OIDs, see comment above)
+	globalCache.setBuiltIn(NewId(Section_OperatorClass, "brin", "date_minmax_ops"), 15300)
+	globalCache.setBuiltIn(NewId(Section_OperatorClass, "brin", "float4_minmax_ops"), 15301)
+	globalCache.setBuiltIn(NewId(Section_OperatorClass, "brin", "float8_minmax_ops"), 15302)
+	globalCache.setBuiltIn(NewId(Section_OperatorClass, "brin", "int2_minmax_ops"), 15303)
+	globalCache.setBuiltIn(NewId(Section_OperatorClass, "brin", "int4_minmax_ops"), 15304)
+	globalCache.setBuiltIn(NewId(Section_OperatorClass, "brin", "int8_minmax_ops"), 15305)
+	globalCache.setBuiltIn(NewId(Section_OperatorClass, "brin", "numeric_minmax_ops"), 15306)
+	globalCache.setBuiltIn(NewId(Section_OperatorClass, "brin", "text_minmax_ops"), 15307)
+	globalCache.setBuiltIn(NewId(Section_OperatorClass, "brin", "timestamp_minmax_ops"), 15308)
+	globalCache.setBuiltIn(NewId(Section_OperatorClass, "brin", "timestamptz_minmax_ops"), 15309)
+	globalCache.setBuiltIn(NewId(Section_OperatorClass, "brin", "uuid_minmax_ops"), 15310)
 }
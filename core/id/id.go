@@ -75,6 +75,8 @@ const (
 	NullNamespace Namespace = ""
 	// NullOperator is an empty, invalid ID. This is exactly equivalent to Null.
 	NullOperator Operator = ""
+	// NullPolicy is an empty, invalid ID. This is exactly equivalent to Null.
+	NullPolicy Policy = ""
 	// NullProcedure is an empty, invalid ID. This is exactly equivalent to Null.
 	NullProcedure Procedure = ""
 	// NullSequence is an empty, invalid ID. This is exactly equivalent to Null.
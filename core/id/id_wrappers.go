@@ -65,6 +65,9 @@ type Oid Id
 // Operator is an Id wrapper for operators. This wrapper must not be returned to the client.
 type Operator Id
 
+// Policy is an Id wrapper for row-level security policies. This wrapper must not be returned to the client.
+type Policy Id
+
 // Procedure is an Id wrapper for procedures. This wrapper must not be returned to the client.
 type Procedure Id
 
@@ -209,6 +212,14 @@ func NewOperator(schemaName string, symbol string, leftType Type, rightType Type
 	return Operator(NewId(Section_Operator, schemaName, symbol, string(leftType), string(rightType)))
 }
 
+// NewPolicy returns a new Policy. This wrapper must not be returned to the client.
+func NewPolicy(schemaName string, tableName string, policyName string) Policy {
+	if len(schemaName) == 0 && len(tableName) == 0 && len(policyName) == 0 {
+		return NullPolicy
+	}
+	return Policy(NewId(Section_RowLevelSecurity, schemaName, tableName, policyName))
+}
+
 // NewProcedure returns a new Procedure. This wrapper must not be returned to the client.
 func NewProcedure(schemaName string, procName string, params ...Type) Procedure {
 	if len(schemaName) == 0 && len(procName) == 0 && len(params) == 0 {
@@ -450,6 +461,21 @@ func (id Operator) Symbol() string {
 	return Id(id).Segment(1)
 }
 
+// SchemaName returns the schema name of the policy.
+func (id Policy) SchemaName() string {
+	return Id(id).Segment(0)
+}
+
+// TableName returns the name of the table that the policy belongs to.
+func (id Policy) TableName() string {
+	return Id(id).Segment(1)
+}
+
+// PolicyName returns the policy's name.
+func (id Policy) PolicyName() string {
+	return Id(id).Segment(2)
+}
+
 // ProcedureName returns the procedure's name.
 func (id Procedure) ProcedureName() string {
 	return Id(id).Segment(1)
@@ -580,6 +606,9 @@ func (id Oid) IsValid() bool { return Id(id).IsValid() }
 // IsValid returns whether the ID is valid.
 func (id Operator) IsValid() bool { return Id(id).IsValid() }
 
+// IsValid returns whether the ID is valid.
+func (id Policy) IsValid() bool { return Id(id).IsValid() }
+
 // IsValid returns whether the ID is valid.
 func (id Procedure) IsValid() bool { return Id(id).IsValid() }
 
@@ -646,6 +675,9 @@ func (id Oid) AsId() Id { return Id(id) }
 // AsId returns the unwrapped ID.
 func (id Operator) AsId() Id { return Id(id) }
 
+// AsId returns the unwrapped ID.
+func (id Policy) AsId() Id { return Id(id) }
+
 // AsId returns the unwrapped ID.
 func (id Procedure) AsId() Id { return Id(id) }
 
@@ -0,0 +1,99 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"strings"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/core/id"
+	"github.com/dolthub/doltgresql/core/indexmethods"
+)
+
+// GinPostingTablePrefix is the prefix for the names of the tables that hold the posting lists of GIN indexes. These
+// tables are hidden from the catalogs and from Dolt's system tables.
+const GinPostingTablePrefix = "dg_gin_"
+
+// indexAccessMethodCommentPrefix is the prefix of the comment that carries the access method of a new index from the
+// parser to the analyzer, as the index creation node does not have a field for it. The analyzer removes the comment
+// before the index is created, and records the access method in the index access methods collection instead.
+const indexAccessMethodCommentPrefix = "doltgres:using="
+
+// IndexAccessMethodComment returns the comment that carries the given access method to the analyzer.
+func IndexAccessMethodComment(accessMethod string) string {
+	return indexAccessMethodCommentPrefix + accessMethod
+}
+
+// ParseIndexAccessMethodComment returns the access method that is carried by the given comment. Returns false if the
+// comment does not carry an access method.
+func ParseIndexAccessMethodComment(comment string) (string, bool) {
+	return strings.CutPrefix(comment, indexAccessMethodCommentPrefix)
+}
+
+// GetIndexAccessMethod returns the access method of the given index, which belongs to a table in the given schema.
+// Indexes that do not record an access method are B-tree indexes.
+func GetIndexAccessMethod(ctx *sql.Context, schema string, index sql.Index) (indexmethods.AccessMethod, error) {
+	collection, err := GetIndexAccessMethodsCollectionFromContext(ctx, index.Database())
+	if err != nil {
+		return indexmethods.AccessMethod{}, err
+	}
+	return collection.GetAccessMethod(ctx, id.NewIndex(schema, index.Table(), index.ID())), nil
+}
+
+// IsGinPostingTable returns whether the given table name, which may be qualified by its schema, names a table that
+// holds the posting lists of a GIN index.
+func IsGinPostingTable(tableName string) bool {
+	if idx := strings.LastIndexByte(tableName, '.'); idx >= 0 {
+		tableName = tableName[idx+1:]
+	}
+	return strings.HasPrefix(strings.ToLower(tableName), GinPostingTablePrefix)
+}
+
+// GinPostingTableNames returns the names of the tables that hold the posting lists of the GIN indexes on the given
+// table, which may be qualified by its schema. The returned names are qualified only when the given name is. Returns
+// nothing if the name does not belong to a table, as callers may pass arguments that only sometimes name tables.
+func GinPostingTableNames(ctx *sql.Context, tableName string) ([]string, error) {
+	schemaName, name, qualified := strings.Cut(tableName, ".")
+	if !qualified {
+		schemaName, name = "", tableName
+	}
+	table, err := GetSqlTableFromContext(ctx, "", doltdb.TableName{Name: name, Schema: schemaName})
+	if err != nil || table == nil {
+		return nil, nil
+	}
+	schemaTable, ok := table.(sql.DatabaseSchemaTable)
+	if !ok {
+		return nil, nil
+	}
+	collection, err := GetIndexAccessMethodsCollectionFromContext(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	var postingTables []string
+	schema := schemaTable.DatabaseSchema().SchemaName()
+	for _, accessMethod := range collection.GetAccessMethodsForTable(ctx, id.NewTable(schema, table.Name())) {
+		if !accessMethod.IsGin() {
+			continue
+		}
+		if qualified {
+			postingTables = append(postingTables, schema+"."+accessMethod.Postings)
+		} else {
+			postingTables = append(postingTables, accessMethod.Postings)
+		}
+	}
+	return postingTables, nil
+}
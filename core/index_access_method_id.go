@@ -0,0 +1,64 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/core/id"
+)
+
+// indexAccessMethodIDListener implements the performer and validator functions for index access methods.
+type indexAccessMethodIDListener struct{}
+
+var _ id.Listener = indexAccessMethodIDListener{}
+
+// OperationValidator is the internal ID validator for index access methods.
+func (indexAccessMethodIDListener) OperationValidator(ctx *sql.Context, operation id.Operation, databaseName string, originalID id.Id, newID id.Id) error {
+	switch originalID.Section() {
+	case id.Section_Table:
+		switch operation {
+		case id.Operation_Rename, id.Operation_Delete, id.Operation_Delete_Cascade:
+			return nil
+		default:
+			return errors.Errorf("index access method validator received unexpected operation `%s`", operation.String())
+		}
+	default:
+		return errors.Errorf("index access method validator received unexpected section `%s`", originalID.Section().String())
+	}
+}
+
+// OperationPerformer is the internal ID performer for index access methods. Dropping a table also drops the access
+// methods of its indexes. Renames are handled by the root when the table is renamed.
+func (indexAccessMethodIDListener) OperationPerformer(ctx *sql.Context, operation id.Operation, databaseName string, originalID id.Id, newID id.Id) error {
+	switch originalID.Section() {
+	case id.Section_Table:
+		switch operation {
+		case id.Operation_Rename:
+			return nil
+		case id.Operation_Delete, id.Operation_Delete_Cascade:
+			collection, err := GetIndexAccessMethodsCollectionFromContext(ctx, databaseName)
+			if err != nil {
+				return err
+			}
+			return collection.DropTable(ctx, id.Table(originalID))
+		default:
+			return errors.Errorf("index access method performer received unexpected operation `%s`", operation.String())
+		}
+	default:
+		return errors.Errorf("index access method performer received unexpected section `%s`", originalID.Section().String())
+	}
+}
@@ -0,0 +1,292 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexmethods
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/store/hash"
+
+	"github.com/dolthub/doltgresql/core/id"
+	"github.com/dolthub/doltgresql/core/rootobject/objinterface"
+)
+
+// Collection contains the access methods of every index that is not a B-tree index. Dolt does not have a notion of
+// access methods, so every index is stored as an ordered index, and this records the access method that the index was
+// created with.
+type Collection struct {
+	objinterface.RootObjectMap
+	accessMethodCache map[id.Index]AccessMethod // This cache is used for general access when you know the exact ID
+	tableCache        map[id.Table][]id.Index   // This cache is used to find access methods by table
+	idCache           []id.Index                // This cache simply contains the name of every index
+}
+
+// AccessMethod represents the access method of an index, along with any information that the access method requires.
+// Hash and BRIN indexes are stored as B-tree indexes, as those support every lookup that hash and BRIN indexes do, so
+// only GIN indexes make use of the additional information.
+type AccessMethod struct {
+	ID       id.Index
+	Name     string // The name of the access method, such as "hash" or "gin"
+	OpClass  string // The operator class of the indexed column, which is empty when the default operator class is used
+	Postings string // The name of the table that holds the posting lists of a GIN index
+}
+
+// BTree is the name of the access method of indexes that are not found in the collection.
+const BTree = "btree"
+
+// nameSuffix is appended to the name of an index to form the name of its root object, since the unaltered name belongs
+// to the index.
+const nameSuffix = ".access_method"
+
+var _ objinterface.Collection = (*Collection)(nil)
+var _ objinterface.RootObject = AccessMethod{}
+
+// NewCollection returns a new Collection.
+func NewCollection(ctx context.Context, rom objinterface.RootObjectMap) (*Collection, error) {
+	collection := &Collection{
+		RootObjectMap:     rom,
+		accessMethodCache: make(map[id.Index]AccessMethod),
+		tableCache:        make(map[id.Table][]id.Index),
+	}
+	return collection, collection.reloadCaches(ctx)
+}
+
+// GetAccessMethod returns the access method of the given index. Indexes that are not found in the collection are B-tree
+// indexes, which return an access method with an invalid ID (AccessMethod.ID.IsValid() == false).
+func (pgi *Collection) GetAccessMethod(ctx context.Context, indexID id.Index) AccessMethod {
+	if am, ok := pgi.accessMethodCache[indexID]; ok {
+		return am
+	}
+	return AccessMethod{Name: BTree}
+}
+
+// GetAccessMethodsForTable returns the access methods of the indexes on the given table.
+func (pgi *Collection) GetAccessMethodsForTable(ctx context.Context, tableID id.Table) []AccessMethod {
+	indexIDs := pgi.tableCache[tableID]
+	accessMethods := make([]AccessMethod, len(indexIDs))
+	for i, indexID := range indexIDs {
+		accessMethods[i] = pgi.accessMethodCache[indexID]
+	}
+	return accessMethods
+}
+
+// HasAccessMethod returns whether the index has an access method within the collection.
+func (pgi *Collection) HasAccessMethod(ctx context.Context, indexID id.Index) bool {
+	_, ok := pgi.accessMethodCache[indexID]
+	return ok
+}
+
+// AddAccessMethod adds the access method of a new index.
+func (pgi *Collection) AddAccessMethod(ctx context.Context, am AccessMethod) error {
+	if _, ok := pgi.accessMethodCache[am.ID]; ok {
+		return errors.Errorf(`access method for index "%s" already exists`, am.ID.IndexName())
+	}
+	data, err := am.Serialize(ctx)
+	if err != nil {
+		return err
+	}
+	h, err := pgi.NodeStore().WriteBytes(ctx, data)
+	if err != nil {
+		return err
+	}
+	mapEditor := pgi.Contents().Editor()
+	if err = mapEditor.Add(ctx, string(am.ID), h); err != nil {
+		return err
+	}
+	newMap, err := mapEditor.Flush(ctx)
+	if err != nil {
+		return err
+	}
+	pgi.SetContents(newMap)
+	return pgi.reloadCaches(ctx)
+}
+
+// DropAccessMethod drops the access methods of the given indexes.
+func (pgi *Collection) DropAccessMethod(ctx context.Context, indexIDs ...id.Index) error {
+	if len(indexIDs) == 0 {
+		return nil
+	}
+	// Check that each name exists before performing any deletions
+	for _, indexID := range indexIDs {
+		if _, ok := pgi.accessMethodCache[indexID]; !ok {
+			return errors.Errorf(`access method for index "%s" does not exist`, indexID.IndexName())
+		}
+	}
+	mapEditor := pgi.Contents().Editor()
+	for _, indexID := range indexIDs {
+		if err := mapEditor.Delete(ctx, string(indexID)); err != nil {
+			return err
+		}
+	}
+	newMap, err := mapEditor.Flush(ctx)
+	if err != nil {
+		return err
+	}
+	pgi.SetContents(newMap)
+	return pgi.reloadCaches(ctx)
+}
+
+// DropTable removes the access methods of every index on the given table.
+func (pgi *Collection) DropTable(ctx context.Context, tableID id.Table) error {
+	return pgi.DropAccessMethod(ctx, pgi.tableCache[tableID]...)
+}
+
+// RenameTable moves the access methods of every index on the old table to the new table.
+func (pgi *Collection) RenameTable(ctx context.Context, oldTableID id.Table, newTableID id.Table) error {
+	accessMethods := pgi.GetAccessMethodsForTable(ctx, oldTableID)
+	if err := pgi.DropTable(ctx, oldTableID); err != nil {
+		return err
+	}
+	for _, am := range accessMethods {
+		am.ID = id.NewIndex(newTableID.SchemaName(), newTableID.TableName(), am.ID.IndexName())
+		if err := pgi.AddAccessMethod(ctx, am); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IterateAccessMethods iterates over all access methods in the collection.
+func (pgi *Collection) IterateAccessMethods(ctx context.Context, callback func(am AccessMethod) (stop bool, err error)) error {
+	for _, indexID := range pgi.idCache {
+		stop, err := callback(pgi.accessMethodCache[indexID])
+		if err != nil {
+			return err
+		} else if stop {
+			return nil
+		}
+	}
+	return nil
+}
+
+// resolveName returns the fully resolved ID of the given access method. Returns an error if the name is ambiguous.
+func (pgi *Collection) resolveName(ctx context.Context, schemaName string, formattedName string) (id.Index, error) {
+	if len(formattedName) == 0 || len(pgi.accessMethodCache) == 0 {
+		return id.NullIndex, nil
+	}
+
+	// Check for an exact match
+	fullID := tableNameToID(schemaName, formattedName)
+	if _, ok := pgi.accessMethodCache[fullID]; ok {
+		return fullID, nil
+	}
+
+	// Otherwise we'll iterate over all the names
+	var resolvedID id.Index
+	for _, indexID := range pgi.idCache {
+		if !strings.EqualFold(formattedName, IndexIDToTableName(indexID).Name) {
+			continue
+		}
+		if len(schemaName) > 0 && !strings.EqualFold(schemaName, indexID.SchemaName()) {
+			continue
+		}
+		if resolvedID.IsValid() {
+			return id.NullIndex, fmt.Errorf("`%s.%s` is ambiguous, matches `%s` and `%s`", schemaName, formattedName,
+				IndexIDToTableName(indexID).String(), IndexIDToTableName(resolvedID).String())
+		}
+		resolvedID = indexID
+	}
+	return resolvedID, nil
+}
+
+// reloadCaches writes the underlying map's contents to the caches.
+func (pgi *Collection) reloadCaches(ctx context.Context) error {
+	count, err := pgi.Contents().Count()
+	if err != nil {
+		return err
+	}
+
+	clear(pgi.accessMethodCache)
+	clear(pgi.tableCache)
+	pgi.idCache = make([]id.Index, 0, count)
+
+	return pgi.Contents().IterAll(ctx, func(_ string, h hash.Hash) error {
+		if h.IsEmpty() {
+			return nil
+		}
+		data, err := pgi.NodeStore().ReadBytes(ctx, h)
+		if err != nil {
+			return err
+		}
+		am, err := DeserializeAccessMethod(ctx, data)
+		if err != nil {
+			return err
+		}
+		pgi.accessMethodCache[am.ID] = am
+		tableID := am.TableID()
+		pgi.tableCache[tableID] = append(pgi.tableCache[tableID], am.ID)
+		pgi.idCache = append(pgi.idCache, am.ID)
+		return nil
+	})
+}
+
+// tableNameToID returns the ID that was encoded via the Name() call, as the returned TableName contains additional
+// information (which this is able to process).
+func tableNameToID(schemaName string, formattedName string) id.Index {
+	if !strings.HasSuffix(formattedName, nameSuffix) {
+		return id.NullIndex
+	}
+	names := strings.Split(strings.TrimSuffix(formattedName, nameSuffix), ".")
+	if len(names) != 2 {
+		return id.NullIndex
+	}
+	return id.NewIndex(schemaName, names[0], names[1])
+}
+
+// IsGin returns whether this is a GIN access method.
+func (am AccessMethod) IsGin() bool {
+	return am.Name == "gin"
+}
+
+// TableID returns the ID of the table that the index belongs to.
+func (am AccessMethod) TableID() id.Table {
+	return id.NewTable(am.ID.SchemaName(), am.ID.TableName())
+}
+
+// GetID implements the interface objinterface.RootObject.
+func (am AccessMethod) GetID() id.Id {
+	return am.ID.AsId()
+}
+
+// GetRootObjectID implements the interface objinterface.RootObject.
+func (am AccessMethod) GetRootObjectID() objinterface.RootObjectID {
+	return objinterface.RootObjectID_IndexAccessMethods
+}
+
+// HashOf implements the interface objinterface.RootObject.
+func (am AccessMethod) HashOf(ctx context.Context) (hash.Hash, error) {
+	data, err := am.Serialize(ctx)
+	if err != nil {
+		return hash.Hash{}, err
+	}
+	return hash.Of(data), nil
+}
+
+// Name implements the interface objinterface.RootObject.
+func (am AccessMethod) Name() doltdb.TableName {
+	return IndexIDToTableName(am.ID)
+}
+
+// IndexIDToTableName returns the ID in a format that's better for user consumption.
+func IndexIDToTableName(indexID id.Index) doltdb.TableName {
+	return doltdb.TableName{
+		Name:   fmt.Sprintf("%s.%s%s", indexID.TableName(), indexID.IndexName(), nameSuffix),
+		Schema: indexID.SchemaName(),
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexmethods
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/merge"
+
+	"github.com/dolthub/doltgresql/core/id"
+	pgmerge "github.com/dolthub/doltgresql/core/merge"
+	"github.com/dolthub/doltgresql/core/rootobject/objinterface"
+	"github.com/dolthub/doltgresql/flatbuffers/gen/serial"
+)
+
+// storage is used to read from and write to the root.
+var storage = objinterface.RootObjectSerializer{
+	Bytes:        (*serial.RootValue).IndexAccessMethodsBytes,
+	RootValueAdd: serial.RootValueAddIndexAccessMethods,
+}
+
+// HandleMerge implements the interface objinterface.Collection.
+func (*Collection) HandleMerge(ctx context.Context, mro merge.MergeRootObject) (doltdb.RootObject, *merge.MergeStats, error) {
+	ourAccessMethod := mro.OurRootObj.(AccessMethod)
+	theirAccessMethod := mro.TheirRootObj.(AccessMethod)
+	// Ensure that they have the same identifier
+	if ourAccessMethod.ID != theirAccessMethod.ID {
+		return nil, nil, errors.Newf("attempted to merge different index access methods: `%s` and `%s`",
+			ourAccessMethod.Name().String(), theirAccessMethod.Name().String())
+	}
+	ourHash, err := ourAccessMethod.HashOf(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	theirHash, err := theirAccessMethod.HashOf(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if ourHash.Equal(theirHash) {
+		return mro.OurRootObj, &merge.MergeStats{
+			Operation:            merge.TableUnmodified,
+			Adds:                 0,
+			Deletes:              0,
+			Modifications:        0,
+			DataConflicts:        0,
+			SchemaConflicts:      0,
+			RootObjectConflicts:  0,
+			ConstraintViolations: 0,
+		}, nil
+	}
+	return pgmerge.CreateConflict(ctx, mro.RightSrc, ourAccessMethod, theirAccessMethod, mro.AncestorRootObj)
+}
+
+// LoadCollection implements the interface objinterface.Collection.
+func (*Collection) LoadCollection(ctx context.Context, root objinterface.RootValue) (objinterface.Collection, error) {
+	return LoadIndexAccessMethods(ctx, root)
+}
+
+// LoadIndexAccessMethods loads the index access methods collection from the given root.
+func LoadIndexAccessMethods(ctx context.Context, root objinterface.RootValue) (*Collection, error) {
+	rom, err := objinterface.NewRootObjectMap(ctx, storage, root)
+	if err != nil {
+		return nil, err
+	}
+	return NewCollection(ctx, rom)
+}
+
+// ResolveNameFromObjects implements the interface objinterface.Collection.
+func (*Collection) ResolveNameFromObjects(ctx context.Context, name doltdb.TableName, rootObjects []objinterface.RootObject) (doltdb.TableName, id.Id, error) {
+	tempCollection := Collection{
+		accessMethodCache: make(map[id.Index]AccessMethod),
+		idCache:           make([]id.Index, 0, len(rootObjects)),
+	}
+	for _, rootObject := range rootObjects {
+		if am, ok := rootObject.(AccessMethod); ok {
+			tempCollection.accessMethodCache[am.ID] = am
+			tempCollection.idCache = append(tempCollection.idCache, am.ID)
+		}
+	}
+	return tempCollection.ResolveName(ctx, name)
+}
+
+// Serializer implements the interface objinterface.Collection.
+func (*Collection) Serializer() objinterface.RootObjectSerializer {
+	return storage
+}
@@ -0,0 +1,210 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexmethods
+
+import (
+	"context"
+	"io"
+
+	"github.com/cockroachdb/errors"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/store/hash"
+
+	"github.com/dolthub/doltgresql/core/id"
+	pgmerge "github.com/dolthub/doltgresql/core/merge"
+	"github.com/dolthub/doltgresql/core/rootobject/objinterface"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+const (
+	FIELD_NAME_ACCESS_METHOD = "access_method"
+	FIELD_NAME_OPCLASS       = "opclass"
+	FIELD_NAME_POSTINGS      = "postings"
+)
+
+// DeserializeRootObject implements the interface objinterface.Collection.
+func (pgi *Collection) DeserializeRootObject(ctx context.Context, data []byte) (objinterface.RootObject, error) {
+	return DeserializeAccessMethod(ctx, data)
+}
+
+// DiffRootObjects implements the interface objinterface.Collection.
+func (pgi *Collection) DiffRootObjects(ctx context.Context, fromHash string, o objinterface.RootObject, t objinterface.RootObject, a objinterface.RootObject) ([]objinterface.RootObjectDiff, objinterface.RootObject, error) {
+	ours := o.(AccessMethod)
+	theirs := t.(AccessMethod)
+	ancestor, hasAncestor := a.(AccessMethod)
+	var diffs []objinterface.RootObjectDiff
+	if ours.Name != theirs.Name {
+		diff := objinterface.RootObjectDiff{
+			Type:      pgtypes.Text,
+			FromHash:  fromHash,
+			FieldName: FIELD_NAME_ACCESS_METHOD,
+		}
+		if pgmerge.DiffValues(&diff, ours.Name, theirs.Name, ancestor.Name, hasAncestor) {
+			diffs = append(diffs, diff)
+		} else {
+			ours.Name = diff.OurValue.(string)
+		}
+	}
+	if ours.OpClass != theirs.OpClass {
+		diff := objinterface.RootObjectDiff{
+			Type:      pgtypes.Text,
+			FromHash:  fromHash,
+			FieldName: FIELD_NAME_OPCLASS,
+		}
+		if pgmerge.DiffValues(&diff, ours.OpClass, theirs.OpClass, ancestor.OpClass, hasAncestor) {
+			diffs = append(diffs, diff)
+		} else {
+			ours.OpClass = diff.OurValue.(string)
+		}
+	}
+	if ours.Postings != theirs.Postings {
+		diff := objinterface.RootObjectDiff{
+			Type:      pgtypes.Text,
+			FromHash:  fromHash,
+			FieldName: FIELD_NAME_POSTINGS,
+		}
+		if pgmerge.DiffValues(&diff, ours.Postings, theirs.Postings, ancestor.Postings, hasAncestor) {
+			diffs = append(diffs, diff)
+		} else {
+			ours.Postings = diff.OurValue.(string)
+		}
+	}
+	return diffs, ours, nil
+}
+
+// DropRootObject implements the interface objinterface.Collection.
+func (pgi *Collection) DropRootObject(ctx context.Context, identifier id.Id) error {
+	if identifier.Section() != id.Section_Index {
+		return errors.Errorf(`access method for index %s does not exist`, identifier.String())
+	}
+	return pgi.DropAccessMethod(ctx, id.Index(identifier))
+}
+
+// GetFieldType implements the interface objinterface.Collection.
+func (pgi *Collection) GetFieldType(ctx context.Context, fieldName string) *pgtypes.DoltgresType {
+	switch fieldName {
+	case FIELD_NAME_ACCESS_METHOD:
+		return pgtypes.Text
+	case FIELD_NAME_OPCLASS:
+		return pgtypes.Text
+	case FIELD_NAME_POSTINGS:
+		return pgtypes.Text
+	default:
+		return nil
+	}
+}
+
+// GetID implements the interface objinterface.Collection.
+func (pgi *Collection) GetID() objinterface.RootObjectID {
+	return objinterface.RootObjectID_IndexAccessMethods
+}
+
+// GetRootObject implements the interface objinterface.Collection.
+func (pgi *Collection) GetRootObject(ctx context.Context, identifier id.Id) (objinterface.RootObject, bool, error) {
+	if identifier.Section() != id.Section_Index {
+		return nil, false, nil
+	}
+	am, ok := pgi.accessMethodCache[id.Index(identifier)]
+	return am, ok, nil
+}
+
+// HasRootObject implements the interface objinterface.Collection.
+func (pgi *Collection) HasRootObject(ctx context.Context, identifier id.Id) (bool, error) {
+	if identifier.Section() != id.Section_Index {
+		return false, nil
+	}
+	return pgi.HasAccessMethod(ctx, id.Index(identifier)), nil
+}
+
+// IDToTableName implements the interface objinterface.Collection.
+func (pgi *Collection) IDToTableName(identifier id.Id) doltdb.TableName {
+	if identifier.Section() != id.Section_Index {
+		return doltdb.TableName{}
+	}
+	return IndexIDToTableName(id.Index(identifier))
+}
+
+// IterAll implements the interface objinterface.Collection.
+func (pgi *Collection) IterAll(ctx context.Context, callback func(rootObj objinterface.RootObject) (stop bool, err error)) error {
+	return pgi.IterateAccessMethods(ctx, func(am AccessMethod) (stop bool, err error) {
+		return callback(am)
+	})
+}
+
+// IterIDs implements the interface objinterface.Collection.
+func (pgi *Collection) IterIDs(ctx context.Context, callback func(identifier id.Id) (stop bool, err error)) error {
+	return pgi.Contents().IterAll(ctx, func(k string, _ hash.Hash) error {
+		stop, err := callback(id.Id(k))
+		if err != nil {
+			return err
+		} else if stop {
+			return io.EOF
+		} else {
+			return nil
+		}
+	})
+}
+
+// PutRootObject implements the interface objinterface.Collection.
+func (pgi *Collection) PutRootObject(ctx context.Context, rootObj objinterface.RootObject) error {
+	am, ok := rootObj.(AccessMethod)
+	if !ok {
+		return errors.Newf("invalid index access method root object: %T", rootObj)
+	}
+	return pgi.AddAccessMethod(ctx, am)
+}
+
+// RenameRootObject implements the interface objinterface.Collection.
+func (pgi *Collection) RenameRootObject(ctx context.Context, oldName id.Id, newName id.Id) error {
+	if !oldName.IsValid() || !newName.IsValid() || oldName.Section() != newName.Section() || oldName.Section() != id.Section_Index {
+		return errors.New("cannot rename index access method due to invalid id")
+	}
+	am := pgi.GetAccessMethod(ctx, id.Index(oldName))
+	if err := pgi.DropAccessMethod(ctx, id.Index(oldName)); err != nil {
+		return err
+	}
+	am.ID = id.Index(newName)
+	return pgi.AddAccessMethod(ctx, am)
+}
+
+// ResolveName implements the interface objinterface.Collection.
+func (pgi *Collection) ResolveName(ctx context.Context, name doltdb.TableName) (doltdb.TableName, id.Id, error) {
+	rawID, err := pgi.resolveName(ctx, name.Schema, name.Name)
+	if err != nil || !rawID.IsValid() {
+		return doltdb.TableName{}, id.Null, err
+	}
+	return IndexIDToTableName(rawID), rawID.AsId(), nil
+}
+
+// TableNameToID implements the interface objinterface.Collection.
+func (pgi *Collection) TableNameToID(name doltdb.TableName) id.Id {
+	return tableNameToID(name.Schema, name.Name).AsId()
+}
+
+// UpdateField implements the interface objinterface.Collection.
+func (pgi *Collection) UpdateField(ctx context.Context, rootObject objinterface.RootObject, fieldName string, newValue any) (objinterface.RootObject, error) {
+	am := rootObject.(AccessMethod)
+	switch fieldName {
+	case FIELD_NAME_ACCESS_METHOD:
+		am.Name = newValue.(string)
+	case FIELD_NAME_OPCLASS:
+		am.OpClass = newValue.(string)
+	case FIELD_NAME_POSTINGS:
+		am.Postings = newValue.(string)
+	default:
+		return nil, errors.Newf("unknown field name: `%s`", fieldName)
+	}
+	return am, nil
+}
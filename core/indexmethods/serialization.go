@@ -0,0 +1,67 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexmethods
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/dolthub/doltgresql/core/id"
+	"github.com/dolthub/doltgresql/utils"
+)
+
+// Serialize returns the AccessMethod as a byte slice. If the AccessMethod is invalid, then this returns a nil slice.
+func (am AccessMethod) Serialize(ctx context.Context) ([]byte, error) {
+	if !am.ID.IsValid() {
+		return nil, nil
+	}
+
+	// Initialize the writer and version
+	writer := utils.NewWriter(128)
+	writer.VariableUint(0) // Version
+	// Write the access method data
+	writer.Id(am.ID.AsId())
+	writer.String(am.Name)
+	writer.String(am.OpClass)
+	writer.String(am.Postings)
+	// Returns the data
+	return writer.Data(), nil
+}
+
+// DeserializeAccessMethod returns the AccessMethod that was serialized in the byte slice. Returns an empty
+// AccessMethod (invalid ID) if data is nil or empty.
+func DeserializeAccessMethod(ctx context.Context, data []byte) (AccessMethod, error) {
+	if len(data) == 0 {
+		return AccessMethod{}, nil
+	}
+	reader := utils.NewReader(data)
+	version := reader.VariableUint()
+	if version != 0 {
+		return AccessMethod{}, errors.Errorf("version %d of index access methods is not supported, please upgrade the server", version)
+	}
+
+	// Read from the reader
+	am := AccessMethod{}
+	am.ID = id.Index(reader.Id())
+	am.Name = reader.String()
+	am.OpClass = reader.String()
+	am.Postings = reader.String()
+	if !reader.IsEmpty() {
+		return AccessMethod{}, errors.Errorf("extra data found while deserializing an index access method")
+	}
+	// Return the deserialized object
+	return am, nil
+}
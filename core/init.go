@@ -36,6 +36,9 @@ func Init() {
 	types.DoltgresRootValueWalkAddrs = rootValueWalkAddrs
 	plpgsql.GetTypesCollectionFromContext = GetTypesCollectionFromContext
 	id.RegisterListener(sequenceIDListener{}, id.Section_Table)
+	id.RegisterListener(materializedViewIDListener{}, id.Section_Table)
+	id.RegisterListener(policyIDListener{}, id.Section_Table)
+	id.RegisterListener(indexAccessMethodIDListener{}, id.Section_Table)
 	typecollection.GetSqlTableFromContext = GetSqlTableFromContext
 	typecollection.GetSchemaName = GetSchemaName
 	pgtypes.GetTypesCollectionFromContext = func(ctx *sql.Context, database string) (pgtypes.TypeCollection, error) {
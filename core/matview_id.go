@@ -0,0 +1,69 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/core/id"
+)
+
+// materializedViewIDListener implements the performer and validator functions for materialized views.
+type materializedViewIDListener struct{}
+
+var _ id.Listener = materializedViewIDListener{}
+
+// OperationValidator is the internal ID validator for materialized views.
+func (materializedViewIDListener) OperationValidator(ctx *sql.Context, operation id.Operation, databaseName string, originalID id.Id, newID id.Id) error {
+	switch originalID.Section() {
+	case id.Section_Table:
+		switch operation {
+		case id.Operation_Rename, id.Operation_Delete, id.Operation_Delete_Cascade:
+			return nil
+		default:
+			return errors.Errorf("materialized view validator received unexpected operation `%s`", operation.String())
+		}
+	default:
+		return errors.Errorf("materialized view validator received unexpected section `%s`", originalID.Section().String())
+	}
+}
+
+// OperationPerformer is the internal ID performer for materialized views. Dropping the table that holds a materialized
+// view's rows also drops the materialized view's definition. Renames are handled by the root when the table is renamed.
+func (materializedViewIDListener) OperationPerformer(ctx *sql.Context, operation id.Operation, databaseName string, originalID id.Id, newID id.Id) error {
+	switch originalID.Section() {
+	case id.Section_Table:
+		switch operation {
+		case id.Operation_Rename:
+			return nil
+		case id.Operation_Delete, id.Operation_Delete_Cascade:
+			originalIDTable := id.Table(originalID)
+			collection, err := GetMaterializedViewsCollectionFromContext(ctx, databaseName)
+			if err != nil {
+				return err
+			}
+			viewID := id.NewView(originalIDTable.SchemaName(), originalIDTable.TableName())
+			if !collection.HasMaterializedView(ctx, viewID) {
+				return nil
+			}
+			return collection.DropMaterializedView(ctx, viewID)
+		default:
+			return errors.Errorf("materialized view performer received unexpected operation `%s`", operation.String())
+		}
+	default:
+		return errors.Errorf("materialized view performer received unexpected section `%s`", originalID.Section().String())
+	}
+}
@@ -0,0 +1,229 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package matviews
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/store/hash"
+
+	"github.com/dolthub/doltgresql/core/id"
+	"github.com/dolthub/doltgresql/core/rootobject/objinterface"
+)
+
+// Collection contains a collection of materialized views.
+type Collection struct {
+	objinterface.RootObjectMap
+}
+
+// MaterializedView represents the definition of a created materialized view. The materialized view's rows are stored
+// in a table of the same name, so this only tracks what is needed to refresh that table.
+type MaterializedView struct {
+	ID         id.View
+	Definition string // The query that populates the materialized view
+	Populated  bool   // Whether the materialized view may be scanned, which is false after WITH NO DATA
+}
+
+// nameSuffix is appended to the name of a materialized view to form the name of its root object. The table holding the
+// materialized view's rows already uses the unaltered name.
+const nameSuffix = ".matview"
+
+var _ objinterface.Collection = (*Collection)(nil)
+var _ objinterface.RootObject = MaterializedView{}
+
+// NewCollection returns a new Collection.
+func NewCollection(ctx context.Context, rom objinterface.RootObjectMap) *Collection {
+	return &Collection{RootObjectMap: rom}
+}
+
+// GetMaterializedView returns the materialized view with the given ID. Returns a MaterializedView with an invalid ID if
+// it cannot be found (MaterializedView.ID.IsValid() == false).
+func (pgm *Collection) GetMaterializedView(ctx context.Context, viewID id.View) (MaterializedView, error) {
+	h, err := pgm.Contents().Get(ctx, string(viewID))
+	if err != nil || h.IsEmpty() {
+		return MaterializedView{}, err
+	}
+	data, err := pgm.NodeStore().ReadBytes(ctx, h)
+	if err != nil {
+		return MaterializedView{}, err
+	}
+	return DeserializeMaterializedView(ctx, data)
+}
+
+// HasMaterializedView returns whether the given materialized view exists.
+func (pgm *Collection) HasMaterializedView(ctx context.Context, viewID id.View) bool {
+	ok, err := pgm.Contents().Has(ctx, string(viewID))
+	return err == nil && ok
+}
+
+// AddMaterializedView adds a new materialized view.
+func (pgm *Collection) AddMaterializedView(ctx context.Context, mv MaterializedView) error {
+	if pgm.HasMaterializedView(ctx, mv.ID) {
+		return errors.Errorf(`materialized view "%s" already exists`, mv.ID.ViewName())
+	}
+	data, err := mv.Serialize(ctx)
+	if err != nil {
+		return err
+	}
+	h, err := pgm.NodeStore().WriteBytes(ctx, data)
+	if err != nil {
+		return err
+	}
+	mapEditor := pgm.Contents().Editor()
+	if err = mapEditor.Add(ctx, string(mv.ID), h); err != nil {
+		return err
+	}
+	newMap, err := mapEditor.Flush(ctx)
+	if err != nil {
+		return err
+	}
+	pgm.SetContents(newMap)
+	return nil
+}
+
+// DropMaterializedView drops existing materialized views.
+func (pgm *Collection) DropMaterializedView(ctx context.Context, viewIDs ...id.View) error {
+	if len(viewIDs) == 0 {
+		return nil
+	}
+	for _, viewID := range viewIDs {
+		if ok, err := pgm.Contents().Has(ctx, string(viewID)); err != nil {
+			return err
+		} else if !ok {
+			return errors.Errorf(`materialized view "%s" does not exist`, viewID.ViewName())
+		}
+	}
+
+	mapEditor := pgm.Contents().Editor()
+	for _, viewID := range viewIDs {
+		if err := mapEditor.Delete(ctx, string(viewID)); err != nil {
+			return err
+		}
+	}
+	newMap, err := mapEditor.Flush(ctx)
+	if err != nil {
+		return err
+	}
+	pgm.SetContents(newMap)
+	return nil
+}
+
+// UpdateMaterializedView replaces the existing materialized view that has the same ID.
+func (pgm *Collection) UpdateMaterializedView(ctx context.Context, mv MaterializedView) error {
+	if err := pgm.DropMaterializedView(ctx, mv.ID); err != nil {
+		return err
+	}
+	return pgm.AddMaterializedView(ctx, mv)
+}
+
+// resolveName returns the fully resolved ID of the given materialized view. Returns an error if the name is ambiguous.
+func (pgm *Collection) resolveName(ctx context.Context, schemaName string, formattedName string) (id.View, error) {
+	viewID := tableNameToID(schemaName, formattedName)
+	if !viewID.IsValid() {
+		return id.NullView, nil
+	}
+
+	// Check for an exact match
+	if pgm.HasMaterializedView(ctx, viewID) {
+		return viewID, nil
+	}
+
+	// Otherwise we'll iterate over all the names
+	var resolvedID id.View
+	err := pgm.IterateMaterializedViews(ctx, func(mv MaterializedView) (stop bool, err error) {
+		if !strings.EqualFold(mv.ID.ViewName(), viewID.ViewName()) {
+			return false, nil
+		}
+		if len(schemaName) > 0 && !strings.EqualFold(mv.ID.SchemaName(), schemaName) {
+			return false, nil
+		}
+		if resolvedID.IsValid() {
+			return true, fmt.Errorf("`%s` is ambiguous, matches `%s` and `%s`", formattedName,
+				MaterializedViewIDToTableName(mv.ID).String(), MaterializedViewIDToTableName(resolvedID).String())
+		}
+		resolvedID = mv.ID
+		return false, nil
+	})
+	return resolvedID, err
+}
+
+// IterateMaterializedViews iterates over all materialized views in the collection.
+func (pgm *Collection) IterateMaterializedViews(ctx context.Context, callback func(mv MaterializedView) (stop bool, err error)) error {
+	return pgm.Contents().IterAll(ctx, func(_ string, v hash.Hash) error {
+		data, err := pgm.NodeStore().ReadBytes(ctx, v)
+		if err != nil {
+			return err
+		}
+		mv, err := DeserializeMaterializedView(ctx, data)
+		if err != nil {
+			return err
+		}
+		stop, err := callback(mv)
+		if err != nil {
+			return err
+		} else if stop {
+			return io.EOF
+		} else {
+			return nil
+		}
+	})
+}
+
+// MaterializedViewIDToTableName returns the name of the materialized view's root object, which is distinct from the
+// name of the table that holds the materialized view's rows.
+func MaterializedViewIDToTableName(viewID id.View) doltdb.TableName {
+	return doltdb.TableName{
+		Name:   viewID.ViewName() + nameSuffix,
+		Schema: viewID.SchemaName(),
+	}
+}
+
+// tableNameToID returns the ID of the materialized view that the root object name refers to. Returns an invalid ID if
+// the name does not refer to a materialized view's root object.
+func tableNameToID(schemaName string, formattedName string) id.View {
+	if len(formattedName) <= len(nameSuffix) || !strings.HasSuffix(formattedName, nameSuffix) {
+		return id.NullView
+	}
+	return id.NewView(schemaName, strings.TrimSuffix(formattedName, nameSuffix))
+}
+
+// GetID implements the interface objinterface.RootObject.
+func (mv MaterializedView) GetID() id.Id {
+	return mv.ID.AsId()
+}
+
+// GetRootObjectID implements the interface objinterface.RootObject.
+func (mv MaterializedView) GetRootObjectID() objinterface.RootObjectID {
+	return objinterface.RootObjectID_MaterializedViews
+}
+
+// HashOf implements the interface objinterface.RootObject.
+func (mv MaterializedView) HashOf(ctx context.Context) (hash.Hash, error) {
+	data, err := mv.Serialize(ctx)
+	if err != nil {
+		return hash.Hash{}, err
+	}
+	return hash.Of(data), nil
+}
+
+// Name implements the interface objinterface.RootObject.
+func (mv MaterializedView) Name() doltdb.TableName {
+	return MaterializedViewIDToTableName(mv.ID)
+}
@@ -0,0 +1,196 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package matviews
+
+import (
+	"context"
+	"io"
+
+	"github.com/cockroachdb/errors"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/store/hash"
+
+	"github.com/dolthub/doltgresql/core/id"
+	pgmerge "github.com/dolthub/doltgresql/core/merge"
+	"github.com/dolthub/doltgresql/core/rootobject/objinterface"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+const (
+	FIELD_NAME_DEFINITION = "definition"
+	FIELD_NAME_POPULATED  = "populated"
+)
+
+// DeserializeRootObject implements the interface objinterface.Collection.
+func (pgm *Collection) DeserializeRootObject(ctx context.Context, data []byte) (objinterface.RootObject, error) {
+	return DeserializeMaterializedView(ctx, data)
+}
+
+// DiffRootObjects implements the interface objinterface.Collection.
+func (pgm *Collection) DiffRootObjects(ctx context.Context, fromHash string, o objinterface.RootObject, t objinterface.RootObject, a objinterface.RootObject) ([]objinterface.RootObjectDiff, objinterface.RootObject, error) {
+	ours := o.(MaterializedView)
+	theirs := t.(MaterializedView)
+	ancestor, hasAncestor := a.(MaterializedView)
+	var diffs []objinterface.RootObjectDiff
+	if ours.Definition != theirs.Definition {
+		diff := objinterface.RootObjectDiff{
+			Type:      pgtypes.Text,
+			FromHash:  fromHash,
+			FieldName: FIELD_NAME_DEFINITION,
+		}
+		if pgmerge.DiffValues(&diff, ours.Definition, theirs.Definition, ancestor.Definition, hasAncestor) {
+			diffs = append(diffs, diff)
+		} else {
+			ours.Definition = diff.OurValue.(string)
+		}
+	}
+	if ours.Populated != theirs.Populated {
+		diff := objinterface.RootObjectDiff{
+			Type:      pgtypes.Bool,
+			FromHash:  fromHash,
+			FieldName: FIELD_NAME_POPULATED,
+		}
+		if pgmerge.DiffValues(&diff, ours.Populated, theirs.Populated, ancestor.Populated, hasAncestor) {
+			diffs = append(diffs, diff)
+		} else {
+			ours.Populated = diff.OurValue.(bool)
+		}
+	}
+	return diffs, ours, nil
+}
+
+// DropRootObject implements the interface objinterface.Collection.
+func (pgm *Collection) DropRootObject(ctx context.Context, identifier id.Id) error {
+	if identifier.Section() != id.Section_View {
+		return errors.Errorf(`materialized view %s does not exist`, identifier.String())
+	}
+	return pgm.DropMaterializedView(ctx, id.View(identifier))
+}
+
+// GetFieldType implements the interface objinterface.Collection.
+func (pgm *Collection) GetFieldType(ctx context.Context, fieldName string) *pgtypes.DoltgresType {
+	switch fieldName {
+	case FIELD_NAME_DEFINITION:
+		return pgtypes.Text
+	case FIELD_NAME_POPULATED:
+		return pgtypes.Bool
+	default:
+		return nil
+	}
+}
+
+// GetID implements the interface objinterface.Collection.
+func (pgm *Collection) GetID() objinterface.RootObjectID {
+	return objinterface.RootObjectID_MaterializedViews
+}
+
+// GetRootObject implements the interface objinterface.Collection.
+func (pgm *Collection) GetRootObject(ctx context.Context, identifier id.Id) (objinterface.RootObject, bool, error) {
+	if identifier.Section() != id.Section_View {
+		return nil, false, nil
+	}
+	mv, err := pgm.GetMaterializedView(ctx, id.View(identifier))
+	return mv, err == nil && mv.ID.IsValid(), err
+}
+
+// HasRootObject implements the interface objinterface.Collection.
+func (pgm *Collection) HasRootObject(ctx context.Context, identifier id.Id) (bool, error) {
+	if identifier.Section() != id.Section_View {
+		return false, nil
+	}
+	return pgm.HasMaterializedView(ctx, id.View(identifier)), nil
+}
+
+// IDToTableName implements the interface objinterface.Collection.
+func (pgm *Collection) IDToTableName(identifier id.Id) doltdb.TableName {
+	if identifier.Section() != id.Section_View {
+		return doltdb.TableName{}
+	}
+	return MaterializedViewIDToTableName(id.View(identifier))
+}
+
+// IterAll implements the interface objinterface.Collection.
+func (pgm *Collection) IterAll(ctx context.Context, callback func(rootObj objinterface.RootObject) (stop bool, err error)) error {
+	return pgm.IterateMaterializedViews(ctx, func(mv MaterializedView) (stop bool, err error) {
+		return callback(mv)
+	})
+}
+
+// IterIDs implements the interface objinterface.Collection.
+func (pgm *Collection) IterIDs(ctx context.Context, callback func(identifier id.Id) (stop bool, err error)) error {
+	return pgm.Contents().IterAll(ctx, func(k string, _ hash.Hash) error {
+		stop, err := callback(id.Id(k))
+		if err != nil {
+			return err
+		} else if stop {
+			return io.EOF
+		} else {
+			return nil
+		}
+	})
+}
+
+// PutRootObject implements the interface objinterface.Collection.
+func (pgm *Collection) PutRootObject(ctx context.Context, rootObj objinterface.RootObject) error {
+	mv, ok := rootObj.(MaterializedView)
+	if !ok {
+		return errors.Newf("invalid materialized view root object: %T", rootObj)
+	}
+	return pgm.AddMaterializedView(ctx, mv)
+}
+
+// RenameRootObject implements the interface objinterface.Collection.
+func (pgm *Collection) RenameRootObject(ctx context.Context, oldName id.Id, newName id.Id) error {
+	if !oldName.IsValid() || !newName.IsValid() || oldName.Section() != newName.Section() || oldName.Section() != id.Section_View {
+		return errors.New("cannot rename materialized view due to invalid id")
+	}
+	mv, err := pgm.GetMaterializedView(ctx, id.View(oldName))
+	if err != nil {
+		return err
+	}
+	if err = pgm.DropMaterializedView(ctx, id.View(oldName)); err != nil {
+		return err
+	}
+	mv.ID = id.View(newName)
+	return pgm.AddMaterializedView(ctx, mv)
+}
+
+// ResolveName implements the interface objinterface.Collection.
+func (pgm *Collection) ResolveName(ctx context.Context, name doltdb.TableName) (doltdb.TableName, id.Id, error) {
+	rawID, err := pgm.resolveName(ctx, name.Schema, name.Name)
+	if err != nil || !rawID.IsValid() {
+		return doltdb.TableName{}, id.Null, err
+	}
+	return MaterializedViewIDToTableName(rawID), rawID.AsId(), nil
+}
+
+// TableNameToID implements the interface objinterface.Collection.
+func (pgm *Collection) TableNameToID(name doltdb.TableName) id.Id {
+	return tableNameToID(name.Schema, name.Name).AsId()
+}
+
+// UpdateField implements the interface objinterface.Collection.
+func (pgm *Collection) UpdateField(ctx context.Context, rootObject objinterface.RootObject, fieldName string, newValue any) (objinterface.RootObject, error) {
+	mv := rootObject.(MaterializedView)
+	switch fieldName {
+	case FIELD_NAME_DEFINITION:
+		mv.Definition = newValue.(string)
+	case FIELD_NAME_POPULATED:
+		mv.Populated = newValue.(bool)
+	default:
+		return nil, errors.Newf("unknown field name: `%s`", fieldName)
+	}
+	return mv, nil
+}
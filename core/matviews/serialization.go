@@ -0,0 +1,66 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package matviews
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/dolthub/doltgresql/core/id"
+	"github.com/dolthub/doltgresql/utils"
+)
+
+// Serialize returns the MaterializedView as a byte slice. If the MaterializedView is invalid, then this returns a nil
+// slice.
+func (mv MaterializedView) Serialize(ctx context.Context) ([]byte, error) {
+	if !mv.ID.IsValid() {
+		return nil, nil
+	}
+
+	// Initialize the writer and version
+	writer := utils.NewWriter(256)
+	writer.VariableUint(0) // Version
+	// Write the materialized view data
+	writer.Id(mv.ID.AsId())
+	writer.String(mv.Definition)
+	writer.Bool(mv.Populated)
+	// Returns the data
+	return writer.Data(), nil
+}
+
+// DeserializeMaterializedView returns the MaterializedView that was serialized in the byte slice. Returns an empty
+// MaterializedView (invalid ID) if data is nil or empty.
+func DeserializeMaterializedView(ctx context.Context, data []byte) (MaterializedView, error) {
+	if len(data) == 0 {
+		return MaterializedView{}, nil
+	}
+	reader := utils.NewReader(data)
+	version := reader.VariableUint()
+	if version != 0 {
+		return MaterializedView{}, errors.Errorf("version %d of materialized views is not supported, please upgrade the server", version)
+	}
+
+	// Read from the reader
+	mv := MaterializedView{}
+	mv.ID = id.View(reader.Id())
+	mv.Definition = reader.String()
+	mv.Populated = reader.Bool()
+	if !reader.IsEmpty() {
+		return MaterializedView{}, errors.Errorf("extra data found while deserializing a materialized view")
+	}
+	// Return the deserialized object
+	return mv, nil
+}
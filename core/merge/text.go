@@ -0,0 +1,257 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package merge
+
+import "strings"
+
+// maxTextMergeCells is the largest comparison table that MergeText will build when diffing a side against the
+// ancestor. Texts that would need a larger table are treated as conflicting rather than consuming excessive memory.
+const maxTextMergeCells = 1 << 22
+
+// textEdit is a replacement of the ancestor's units in the range [start, end) with the given units. An insertion has
+// an empty range, while a deletion has no units.
+type textEdit struct {
+	start int
+	end   int
+	units []string
+}
+
+// MergeText performs a three-way merge of the given text, such as the body of a function. The text is split into
+// units at every line break and at every statement-terminating semicolon (ignoring those within quotes and comments),
+// so that changes to different statements merge cleanly, even when they're on the same line. Returns false when "ours"
+// and "theirs" make different changes to the same units of the ancestor (or insert at the same position), which is a
+// conflict.
+func MergeText(ours, theirs, ancestor string) (string, bool) {
+	if ours == theirs || theirs == ancestor {
+		return ours, true
+	}
+	if ours == ancestor {
+		return theirs, true
+	}
+	ancUnits := splitMergeUnits(ancestor)
+	ourEdits, ok := diffMergeUnits(ancUnits, splitMergeUnits(ours))
+	if !ok {
+		return "", false
+	}
+	theirEdits, ok := diffMergeUnits(ancUnits, splitMergeUnits(theirs))
+	if !ok {
+		return "", false
+	}
+
+	sb := strings.Builder{}
+	ancPos := 0
+	for len(ourEdits) > 0 || len(theirEdits) > 0 {
+		// A region begins with whichever edit comes first, and grows to include every edit that overlaps it. Edits that
+		// merely touch are kept apart, unless one of them is an insertion, as the order of the insertion is ambiguous.
+		var regionOurs, regionTheirs []textEdit
+		var edit textEdit
+		if len(theirEdits) == 0 || (len(ourEdits) > 0 && ourEdits[0].start <= theirEdits[0].start) {
+			edit = ourEdits[0]
+			regionOurs, ourEdits = append(regionOurs, edit), ourEdits[1:]
+		} else {
+			edit = theirEdits[0]
+			regionTheirs, theirEdits = append(regionTheirs, edit), theirEdits[1:]
+		}
+		start, end := edit.start, edit.end
+		endsWithInsertion := edit.start == edit.end
+		overlaps := func(edits []textEdit) bool {
+			return len(edits) > 0 && (edits[0].start < end ||
+				(edits[0].start == end && (endsWithInsertion || edits[0].start == edits[0].end)))
+		}
+		for {
+			if overlaps(ourEdits) {
+				edit = ourEdits[0]
+				regionOurs, ourEdits = append(regionOurs, edit), ourEdits[1:]
+			} else if overlaps(theirEdits) {
+				edit = theirEdits[0]
+				regionTheirs, theirEdits = append(regionTheirs, edit), theirEdits[1:]
+			} else {
+				break
+			}
+			if edit.end > end {
+				end = edit.end
+				endsWithInsertion = false
+			}
+			if edit.start == edit.end && edit.start == end {
+				endsWithInsertion = true
+			}
+		}
+
+		for _, unit := range ancUnits[ancPos:start] {
+			sb.WriteString(unit)
+		}
+		switch {
+		case len(regionTheirs) == 0:
+			sb.WriteString(applyTextEdits(ancUnits, start, end, regionOurs))
+		case len(regionOurs) == 0:
+			sb.WriteString(applyTextEdits(ancUnits, start, end, regionTheirs))
+		default:
+			ourRegion := applyTextEdits(ancUnits, start, end, regionOurs)
+			if ourRegion != applyTextEdits(ancUnits, start, end, regionTheirs) {
+				return "", false
+			}
+			sb.WriteString(ourRegion)
+		}
+		ancPos = end
+	}
+	for _, unit := range ancUnits[ancPos:] {
+		sb.WriteString(unit)
+	}
+	return sb.String(), true
+}
+
+// applyTextEdits returns the ancestor's units in the range [start, end) with the given edits applied. The edits must
+// be in order, and must be contained within the range.
+func applyTextEdits(ancUnits []string, start int, end int, edits []textEdit) string {
+	sb := strings.Builder{}
+	pos := start
+	for _, edit := range edits {
+		for _, unit := range ancUnits[pos:edit.start] {
+			sb.WriteString(unit)
+		}
+		for _, unit := range edit.units {
+			sb.WriteString(unit)
+		}
+		pos = edit.end
+	}
+	for _, unit := range ancUnits[pos:end] {
+		sb.WriteString(unit)
+	}
+	return sb.String()
+}
+
+// diffMergeUnits returns the edits that transform the ancestor's units into the other units, using the longest common
+// subsequence between them. Consecutive changes are combined into a single edit, so edits are always separated by at
+// least one unchanged unit. Returns false if the texts are too large to compare.
+func diffMergeUnits(ancUnits []string, otherUnits []string) ([]textEdit, bool) {
+	// Units shared at the beginning and end do not participate in the comparison
+	prefix := 0
+	for prefix < len(ancUnits) && prefix < len(otherUnits) && ancUnits[prefix] == otherUnits[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(ancUnits)-prefix && suffix < len(otherUnits)-prefix &&
+		ancUnits[len(ancUnits)-1-suffix] == otherUnits[len(otherUnits)-1-suffix] {
+		suffix++
+	}
+	anc := ancUnits[prefix : len(ancUnits)-suffix]
+	other := otherUnits[prefix : len(otherUnits)-suffix]
+	if (len(anc)+1)*(len(other)+1) > maxTextMergeCells {
+		return nil, false
+	}
+
+	// lcs[i][j] holds the length of the longest common subsequence of anc[i:] and other[j:]
+	width := len(other) + 1
+	lcs := make([]int32, (len(anc)+1)*width)
+	for i := len(anc) - 1; i >= 0; i-- {
+		for j := len(other) - 1; j >= 0; j-- {
+			if anc[i] == other[j] {
+				lcs[i*width+j] = lcs[(i+1)*width+j+1] + 1
+			} else if lcs[(i+1)*width+j] >= lcs[i*width+j+1] {
+				lcs[i*width+j] = lcs[(i+1)*width+j]
+			} else {
+				lcs[i*width+j] = lcs[i*width+j+1]
+			}
+		}
+	}
+
+	var edits []textEdit
+	var current *textEdit
+	i, j := 0, 0
+	for i < len(anc) || j < len(other) {
+		if i < len(anc) && j < len(other) && anc[i] == other[j] {
+			if current != nil {
+				edits = append(edits, *current)
+				current = nil
+			}
+			i++
+			j++
+			continue
+		}
+		if current == nil {
+			current = &textEdit{start: prefix + i, end: prefix + i}
+		}
+		if j >= len(other) || (i < len(anc) && lcs[(i+1)*width+j] >= lcs[i*width+j+1]) {
+			i++
+			current.end = prefix + i
+		} else {
+			current.units = append(current.units, other[j])
+			j++
+		}
+	}
+	if current != nil {
+		edits = append(edits, *current)
+	}
+	return edits, true
+}
+
+// splitMergeUnits splits the text into the units that are compared by MergeText. A unit ends after every line break,
+// and after every semicolon that is not within a quoted string, quoted identifier, dollar-quoted string, or comment.
+// Concatenating the units always returns the original text.
+func splitMergeUnits(text string) []string {
+	var units []string
+	unitStart := 0
+	for i := 0; i < len(text); i++ {
+		switch text[i] {
+		case '\n':
+			units = append(units, text[unitStart:i+1])
+			unitStart = i + 1
+		case ';':
+			units = append(units, text[unitStart:i+1])
+			unitStart = i + 1
+		case '\'', '"':
+			// Skip to the closing quote, leaving any line breaks within the quotes to split the unit
+			quote := text[i]
+			for i+1 < len(text) && text[i+1] != quote && text[i+1] != '\n' {
+				i++
+			}
+			if i+1 < len(text) && text[i+1] == quote {
+				i++
+			}
+		case '-':
+			if i+1 < len(text) && text[i+1] == '-' {
+				// Line comments extend to the line break, which still ends the unit
+				for i+1 < len(text) && text[i+1] != '\n' {
+					i++
+				}
+			}
+		case '$':
+			// Dollar-quoted strings, such as those used by EXECUTE, are treated as a single unit
+			tagEnd := strings.IndexByte(text[i+1:], '$')
+			if tagEnd == -1 || !isDollarQuoteTag(text[i+1:i+1+tagEnd]) {
+				continue
+			}
+			tag := text[i : i+tagEnd+2]
+			if closing := strings.Index(text[i+len(tag):], tag); closing != -1 {
+				i += len(tag) + closing + len(tag) - 1
+			}
+		}
+	}
+	if unitStart < len(text) {
+		units = append(units, text[unitStart:])
+	}
+	return units
+}
+
+// isDollarQuoteTag returns whether the given string is a valid tag for a dollar-quoted string. An empty tag is valid.
+func isDollarQuoteTag(tag string) bool {
+	for i, r := range tag {
+		isLetter := (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || r == '_'
+		if !isLetter && (i == 0 || r < '0' || r > '9') {
+			return false
+		}
+	}
+	return true
+}
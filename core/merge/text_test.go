@@ -0,0 +1,162 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package merge
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMergeText(t *testing.T) {
+	ancestor := `BEGIN
+	IF input > 0 THEN
+		RETURN 'positive';
+	END IF;
+	IF input < 0 THEN
+		RETURN 'negative';
+	END IF;
+	RETURN 'zero';
+END;`
+	tests := []struct {
+		name     string
+		ours     string
+		theirs   string
+		ancestor string
+		merged   string
+		conflict bool
+	}{
+		{
+			name:     "only ours changed",
+			ours:     strings.Replace(ancestor, "'positive'", "'pos'", 1),
+			theirs:   ancestor,
+			ancestor: ancestor,
+			merged:   strings.Replace(ancestor, "'positive'", "'pos'", 1),
+		},
+		{
+			name:     "only theirs changed",
+			ours:     ancestor,
+			theirs:   strings.Replace(ancestor, "'zero'", "'nil'", 1),
+			ancestor: ancestor,
+			merged:   strings.Replace(ancestor, "'zero'", "'nil'", 1),
+		},
+		{
+			name:     "different branches changed",
+			ours:     strings.Replace(ancestor, "'positive'", "'pos'", 1),
+			theirs:   strings.Replace(ancestor, "'negative'", "'neg'", 1),
+			ancestor: ancestor,
+			merged:   strings.Replace(strings.Replace(ancestor, "'positive'", "'pos'", 1), "'negative'", "'neg'", 1),
+		},
+		{
+			name:     "both sides made the same change",
+			ours:     strings.Replace(ancestor, "'zero'", "'nil'", 1),
+			theirs:   strings.Replace(strings.Replace(ancestor, "'zero'", "'nil'", 1), "'negative'", "'neg'", 1),
+			ancestor: ancestor,
+			merged:   strings.Replace(strings.Replace(ancestor, "'zero'", "'nil'", 1), "'negative'", "'neg'", 1),
+		},
+		{
+			name:     "insertions in different places",
+			ours:     strings.Replace(ancestor, "BEGIN\n", "BEGIN\n\tRAISE NOTICE 'start';\n", 1),
+			theirs:   strings.Replace(ancestor, "\tRETURN 'zero';\n", "\tRAISE NOTICE 'end';\n\tRETURN 'zero';\n", 1),
+			ancestor: ancestor,
+			merged: strings.Replace(strings.Replace(ancestor, "BEGIN\n", "BEGIN\n\tRAISE NOTICE 'start';\n", 1),
+				"\tRETURN 'zero';\n", "\tRAISE NOTICE 'end';\n\tRETURN 'zero';\n", 1),
+		},
+		{
+			name:     "same line changed differently",
+			ours:     strings.Replace(ancestor, "'positive'", "'pos'", 1),
+			theirs:   strings.Replace(ancestor, "'positive'", "'plus'", 1),
+			ancestor: ancestor,
+			conflict: true,
+		},
+		{
+			name:     "adjacent lines changed",
+			ours:     strings.Replace(ancestor, "IF input > 0 THEN", "IF input >= 1 THEN", 1),
+			theirs:   strings.Replace(ancestor, "'positive'", "'pos'", 1),
+			ancestor: ancestor,
+			merged:   strings.Replace(strings.Replace(ancestor, "IF input > 0 THEN", "IF input >= 1 THEN", 1), "'positive'", "'pos'", 1),
+		},
+		{
+			name:     "insertions at the same position",
+			ours:     strings.Replace(ancestor, "BEGIN\n", "BEGIN\n\tRAISE NOTICE 'a';\n", 1),
+			theirs:   strings.Replace(ancestor, "BEGIN\n", "BEGIN\n\tRAISE NOTICE 'b';\n", 1),
+			ancestor: ancestor,
+			conflict: true,
+		},
+		{
+			name:     "insertion next to a change",
+			ours:     strings.Replace(ancestor, "\tRETURN 'zero';\n", "\tRAISE NOTICE 'end';\n\tRETURN 'zero';\n", 1),
+			theirs:   strings.Replace(ancestor, "\tRETURN 'zero';\n", "\tRETURN 'nil';\n", 1),
+			ancestor: ancestor,
+			conflict: true,
+		},
+		{
+			name:     "different statements on the same line",
+			ours:     "BEGIN x := 2; y := 1; RETURN x + y; END;",
+			theirs:   "BEGIN x := 1; y := 3; RETURN x + y; END;",
+			ancestor: "BEGIN x := 1; y := 1; RETURN x + y; END;",
+			merged:   "BEGIN x := 2; y := 3; RETURN x + y; END;",
+		},
+		{
+			name:     "same statement on a single line",
+			ours:     "BEGIN RETURN '2' || input; END;",
+			theirs:   "BEGIN RETURN '3' || input; END;",
+			ancestor: "BEGIN RETURN '1' || input; END;",
+			conflict: true,
+		},
+		{
+			name:     "semicolons within strings are not split",
+			ours:     "BEGIN x := 'a;b'; y := 2; END;",
+			theirs:   "BEGIN x := 'a;c'; y := 1; END;",
+			ancestor: "BEGIN x := 'a;b'; y := 1; END;",
+			merged:   "BEGIN x := 'a;c'; y := 2; END;",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			merged, ok := MergeText(test.ours, test.theirs, test.ancestor)
+			if test.conflict {
+				if ok {
+					t.Fatalf("expected a conflict, but merged to:\n%s", merged)
+				}
+				return
+			}
+			if !ok {
+				t.Fatalf("unexpected conflict")
+			}
+			if merged != test.merged {
+				t.Fatalf("expected:\n%s\ngot:\n%s", test.merged, merged)
+			}
+		})
+	}
+}
+
+func TestSplitMergeUnits(t *testing.T) {
+	for _, text := range []string{
+		"",
+		"BEGIN RETURN 1; END;",
+		"BEGIN\n\tRETURN 'a;b'; -- comment; here\nEND;\n",
+		"BEGIN EXECUTE $q$SELECT 1; SELECT 2;$q$; RETURN $1; END;",
+		"BEGIN RETURN 'unterminated",
+	} {
+		if joined := strings.Join(splitMergeUnits(text), ""); joined != text {
+			t.Fatalf("expected:\n%s\ngot:\n%s", text, joined)
+		}
+	}
+	units := splitMergeUnits("BEGIN EXECUTE $q$SELECT 1; SELECT 2;$q$; RETURN $1; END;")
+	expected := []string{"BEGIN EXECUTE $q$SELECT 1; SELECT 2;$q$;", " RETURN $1;", " END;"}
+	if strings.Join(units, "|") != strings.Join(expected, "|") {
+		t.Fatalf("expected %q, got %q", expected, units)
+	}
+}
@@ -0,0 +1,433 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policies
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/store/hash"
+
+	"github.com/dolthub/doltgresql/core/id"
+	"github.com/dolthub/doltgresql/core/rootobject/objinterface"
+)
+
+// Collection contains a collection of row-level security policies, along with the row-level security settings of each
+// table.
+type Collection struct {
+	objinterface.RootObjectMap
+	policyCache   map[id.Policy]Policy       // This cache is used for general access when you know the exact ID
+	tableCache    map[id.Table][]id.Policy   // This cache is used to find policies by table
+	securityCache map[id.Table]TableSecurity // This cache contains the settings of every table that has them
+	idCache       []id.Policy                // This cache simply contains the name of every policy
+}
+
+// PolicyCommand specifies the command that a policy applies to.
+type PolicyCommand uint8
+
+const (
+	PolicyCommand_All    PolicyCommand = 0
+	PolicyCommand_Select PolicyCommand = 1
+	PolicyCommand_Insert PolicyCommand = 2
+	PolicyCommand_Update PolicyCommand = 3
+	PolicyCommand_Delete PolicyCommand = 4
+)
+
+// Policy represents a row-level security policy on a table.
+type Policy struct {
+	ID          id.Policy
+	Restrictive bool // When false, represents a permissive policy
+	Command     PolicyCommand
+	Roles       []string // An empty slice represents PUBLIC
+	Using       string   // The USING expression, which is empty when not given
+	WithCheck   string   // The WITH CHECK expression, which is empty when not given
+}
+
+// TableSecurity represents the row-level security settings of a table. Tables without settings have row-level
+// security disabled.
+type TableSecurity struct {
+	ID      id.Table
+	Enabled bool // ENABLE ROW LEVEL SECURITY
+	Forced  bool // FORCE ROW LEVEL SECURITY, which applies the policies to the table's owner as well
+}
+
+// securityNameSuffix is appended to the name of a table to form the name of its row-level security settings, since the
+// unaltered name belongs to the table.
+const securityNameSuffix = ".rowsecurity"
+
+var _ objinterface.Collection = (*Collection)(nil)
+var _ objinterface.RootObject = Policy{}
+var _ objinterface.RootObject = TableSecurity{}
+
+// NewCollection returns a new Collection.
+func NewCollection(ctx context.Context, rom objinterface.RootObjectMap) (*Collection, error) {
+	collection := &Collection{
+		RootObjectMap: rom,
+		policyCache:   make(map[id.Policy]Policy),
+		tableCache:    make(map[id.Table][]id.Policy),
+		securityCache: make(map[id.Table]TableSecurity),
+	}
+	return collection, collection.reloadCaches(ctx)
+}
+
+// GetPolicy returns the policy with the given ID. Returns a policy with an invalid ID if it cannot be found
+// (Policy.ID.IsValid() == false).
+func (pgp *Collection) GetPolicy(ctx context.Context, policyID id.Policy) (Policy, error) {
+	if p, ok := pgp.policyCache[policyID]; ok {
+		return p, nil
+	}
+	return Policy{}, nil
+}
+
+// GetPoliciesForTable returns the policies for the given table, sorted by their name ascending.
+func (pgp *Collection) GetPoliciesForTable(ctx context.Context, tableID id.Table) []Policy {
+	policyIDs := pgp.tableCache[tableID]
+	policies := make([]Policy, len(policyIDs))
+	for i, policyID := range policyIDs {
+		policies[i] = pgp.policyCache[policyID]
+	}
+	sort.Slice(policies, func(i, j int) bool {
+		return policies[i].ID.PolicyName() < policies[j].ID.PolicyName()
+	})
+	return policies
+}
+
+// GetTableSecurity returns the row-level security settings of the given table. Tables without settings return
+// settings where row-level security is disabled.
+func (pgp *Collection) GetTableSecurity(ctx context.Context, tableID id.Table) TableSecurity {
+	if security, ok := pgp.securityCache[tableID]; ok {
+		return security
+	}
+	return TableSecurity{ID: tableID}
+}
+
+// HasPolicy returns whether the policy is present.
+func (pgp *Collection) HasPolicy(ctx context.Context, policyID id.Policy) bool {
+	_, ok := pgp.policyCache[policyID]
+	return ok
+}
+
+// HasTableSecurity returns whether the table has row-level security settings.
+func (pgp *Collection) HasTableSecurity(ctx context.Context, tableID id.Table) bool {
+	_, ok := pgp.securityCache[tableID]
+	return ok
+}
+
+// AddPolicy adds a new policy.
+func (pgp *Collection) AddPolicy(ctx context.Context, p Policy) error {
+	if _, ok := pgp.policyCache[p.ID]; ok {
+		return errors.Errorf(`policy "%s" for table "%s" already exists`, p.ID.PolicyName(), p.ID.TableName())
+	}
+	data, err := p.Serialize(ctx)
+	if err != nil {
+		return err
+	}
+	return pgp.writeObject(ctx, p.ID.AsId(), data)
+}
+
+// DropPolicy drops existing policies.
+func (pgp *Collection) DropPolicy(ctx context.Context, policyIDs ...id.Policy) error {
+	if len(policyIDs) == 0 {
+		return nil
+	}
+	// Check that each name exists before performing any deletions
+	rawIDs := make([]id.Id, len(policyIDs))
+	for i, policyID := range policyIDs {
+		if _, ok := pgp.policyCache[policyID]; !ok {
+			return errors.Errorf(`policy "%s" for table "%s" does not exist`, policyID.PolicyName(), policyID.TableName())
+		}
+		rawIDs[i] = policyID.AsId()
+	}
+	return pgp.deleteObjects(ctx, rawIDs)
+}
+
+// UpdatePolicy replaces the existing policy that has the same ID.
+func (pgp *Collection) UpdatePolicy(ctx context.Context, p Policy) error {
+	if err := pgp.DropPolicy(ctx, p.ID); err != nil {
+		return err
+	}
+	return pgp.AddPolicy(ctx, p)
+}
+
+// SetTableSecurity sets the row-level security settings of the table. Settings that disable row-level security are
+// removed, as they are the same as having no settings at all.
+func (pgp *Collection) SetTableSecurity(ctx context.Context, security TableSecurity) error {
+	if _, ok := pgp.securityCache[security.ID]; ok {
+		if err := pgp.deleteObjects(ctx, []id.Id{security.ID.AsId()}); err != nil {
+			return err
+		}
+	}
+	if !security.Enabled && !security.Forced {
+		return nil
+	}
+	data, err := security.Serialize(ctx)
+	if err != nil {
+		return err
+	}
+	return pgp.writeObject(ctx, security.ID.AsId(), data)
+}
+
+// DropTable removes all policies and row-level security settings for the given table.
+func (pgp *Collection) DropTable(ctx context.Context, tableID id.Table) error {
+	rawIDs := make([]id.Id, 0, len(pgp.tableCache[tableID])+1)
+	for _, policyID := range pgp.tableCache[tableID] {
+		rawIDs = append(rawIDs, policyID.AsId())
+	}
+	if _, ok := pgp.securityCache[tableID]; ok {
+		rawIDs = append(rawIDs, tableID.AsId())
+	}
+	if len(rawIDs) == 0 {
+		return nil
+	}
+	return pgp.deleteObjects(ctx, rawIDs)
+}
+
+// RenameTable moves all policies and row-level security settings from the old table to the new table.
+func (pgp *Collection) RenameTable(ctx context.Context, oldTableID id.Table, newTableID id.Table) error {
+	policies := pgp.GetPoliciesForTable(ctx, oldTableID)
+	security, hasSecurity := pgp.securityCache[oldTableID]
+	if err := pgp.DropTable(ctx, oldTableID); err != nil {
+		return err
+	}
+	for _, p := range policies {
+		p.ID = id.NewPolicy(newTableID.SchemaName(), newTableID.TableName(), p.ID.PolicyName())
+		if err := pgp.AddPolicy(ctx, p); err != nil {
+			return err
+		}
+	}
+	if hasSecurity {
+		security.ID = newTableID
+		return pgp.SetTableSecurity(ctx, security)
+	}
+	return nil
+}
+
+// IteratePolicies iterates over all policies in the collection.
+func (pgp *Collection) IteratePolicies(ctx context.Context, callback func(p Policy) (stop bool, err error)) error {
+	for _, policyID := range pgp.idCache {
+		stop, err := callback(pgp.policyCache[policyID])
+		if err != nil {
+			return err
+		} else if stop {
+			return nil
+		}
+	}
+	return nil
+}
+
+// writeObject writes the serialized root object to the map using the given ID.
+func (pgp *Collection) writeObject(ctx context.Context, rawID id.Id, data []byte) error {
+	h, err := pgp.NodeStore().WriteBytes(ctx, data)
+	if err != nil {
+		return err
+	}
+	mapEditor := pgp.Contents().Editor()
+	if err = mapEditor.Add(ctx, string(rawID), h); err != nil {
+		return err
+	}
+	newMap, err := mapEditor.Flush(ctx)
+	if err != nil {
+		return err
+	}
+	pgp.SetContents(newMap)
+	return pgp.reloadCaches(ctx)
+}
+
+// deleteObjects removes the root objects with the given IDs from the map.
+func (pgp *Collection) deleteObjects(ctx context.Context, rawIDs []id.Id) error {
+	mapEditor := pgp.Contents().Editor()
+	for _, rawID := range rawIDs {
+		if err := mapEditor.Delete(ctx, string(rawID)); err != nil {
+			return err
+		}
+	}
+	newMap, err := mapEditor.Flush(ctx)
+	if err != nil {
+		return err
+	}
+	pgp.SetContents(newMap)
+	return pgp.reloadCaches(ctx)
+}
+
+// resolveName returns the fully resolved ID of the given policy or table settings. Returns an error if the name is
+// ambiguous.
+func (pgp *Collection) resolveName(ctx context.Context, schemaName string, formattedName string) (id.Id, error) {
+	if len(formattedName) == 0 || (len(pgp.policyCache) == 0 && len(pgp.securityCache) == 0) {
+		return id.Null, nil
+	}
+
+	// Check for an exact match
+	fullID := tableNameToID(schemaName, formattedName)
+	if ok, _ := pgp.HasRootObject(ctx, fullID); ok {
+		return fullID, nil
+	}
+
+	// Otherwise we'll iterate over all the names
+	var resolvedID id.Id
+	checkMatch := func(candidate id.Id, candidateSchema string) error {
+		if !strings.EqualFold(formattedName, pgp.IDToTableName(candidate).Name) {
+			return nil
+		}
+		if len(schemaName) > 0 && !strings.EqualFold(schemaName, candidateSchema) {
+			return nil
+		}
+		if resolvedID.IsValid() {
+			return fmt.Errorf("`%s.%s` is ambiguous, matches `%s` and `%s`", schemaName, formattedName,
+				pgp.IDToTableName(candidate).String(), pgp.IDToTableName(resolvedID).String())
+		}
+		resolvedID = candidate
+		return nil
+	}
+	for _, policyID := range pgp.idCache {
+		if err := checkMatch(policyID.AsId(), policyID.SchemaName()); err != nil {
+			return id.Null, err
+		}
+	}
+	for tableID := range pgp.securityCache {
+		if err := checkMatch(tableID.AsId(), tableID.SchemaName()); err != nil {
+			return id.Null, err
+		}
+	}
+	return resolvedID, nil
+}
+
+// reloadCaches writes the underlying map's contents to the caches.
+func (pgp *Collection) reloadCaches(ctx context.Context) error {
+	count, err := pgp.Contents().Count()
+	if err != nil {
+		return err
+	}
+
+	clear(pgp.policyCache)
+	clear(pgp.tableCache)
+	clear(pgp.securityCache)
+	pgp.idCache = make([]id.Policy, 0, count)
+
+	return pgp.Contents().IterAll(ctx, func(_ string, h hash.Hash) error {
+		if h.IsEmpty() {
+			return nil
+		}
+		data, err := pgp.NodeStore().ReadBytes(ctx, h)
+		if err != nil {
+			return err
+		}
+		rootObj, err := DeserializeRootObject(ctx, data)
+		if err != nil {
+			return err
+		}
+		switch rootObj := rootObj.(type) {
+		case Policy:
+			pgp.policyCache[rootObj.ID] = rootObj
+			tableID := rootObj.TableID()
+			pgp.tableCache[tableID] = append(pgp.tableCache[tableID], rootObj.ID)
+			pgp.idCache = append(pgp.idCache, rootObj.ID)
+		case TableSecurity:
+			pgp.securityCache[rootObj.ID] = rootObj
+		}
+		return nil
+	})
+}
+
+// tableNameToID returns the ID that was encoded via the Name() call, as the returned TableName contains additional
+// information (which this is able to process).
+func tableNameToID(schemaName string, formattedName string) id.Id {
+	if len(formattedName) > len(securityNameSuffix) && strings.HasSuffix(formattedName, securityNameSuffix) {
+		return id.NewTable(schemaName, strings.TrimSuffix(formattedName, securityNameSuffix)).AsId()
+	}
+	names := strings.Split(formattedName, ".")
+	if len(names) != 2 {
+		return id.Null
+	}
+	return id.NewPolicy(schemaName, names[0], names[1]).AsId()
+}
+
+// TableID returns the ID of the table that the policy belongs to.
+func (p Policy) TableID() id.Table {
+	return id.NewTable(p.ID.SchemaName(), p.ID.TableName())
+}
+
+// AppliesTo returns whether the policy applies to the given command. Policies for ALL commands apply to every command.
+func (p Policy) AppliesTo(command PolicyCommand) bool {
+	return p.Command == PolicyCommand_All || p.Command == command
+}
+
+// GetID implements the interface objinterface.RootObject.
+func (p Policy) GetID() id.Id {
+	return p.ID.AsId()
+}
+
+// GetRootObjectID implements the interface objinterface.RootObject.
+func (p Policy) GetRootObjectID() objinterface.RootObjectID {
+	return objinterface.RootObjectID_Policies
+}
+
+// HashOf implements the interface objinterface.RootObject.
+func (p Policy) HashOf(ctx context.Context) (hash.Hash, error) {
+	data, err := p.Serialize(ctx)
+	if err != nil {
+		return hash.Hash{}, err
+	}
+	return hash.Of(data), nil
+}
+
+// Name implements the interface objinterface.RootObject.
+func (p Policy) Name() doltdb.TableName {
+	return PolicyIDToTableName(p.ID)
+}
+
+// GetID implements the interface objinterface.RootObject.
+func (security TableSecurity) GetID() id.Id {
+	return security.ID.AsId()
+}
+
+// GetRootObjectID implements the interface objinterface.RootObject.
+func (security TableSecurity) GetRootObjectID() objinterface.RootObjectID {
+	return objinterface.RootObjectID_Policies
+}
+
+// HashOf implements the interface objinterface.RootObject.
+func (security TableSecurity) HashOf(ctx context.Context) (hash.Hash, error) {
+	data, err := security.Serialize(ctx)
+	if err != nil {
+		return hash.Hash{}, err
+	}
+	return hash.Of(data), nil
+}
+
+// Name implements the interface objinterface.RootObject.
+func (security TableSecurity) Name() doltdb.TableName {
+	return TableSecurityIDToTableName(security.ID)
+}
+
+// PolicyIDToTableName returns the ID in a format that's better for user consumption.
+func PolicyIDToTableName(policyID id.Policy) doltdb.TableName {
+	return doltdb.TableName{
+		Name:   fmt.Sprintf("%s.%s", policyID.TableName(), policyID.PolicyName()),
+		Schema: policyID.SchemaName(),
+	}
+}
+
+// TableSecurityIDToTableName returns the name of the table's row-level security settings, which is distinct from the
+// name of the table itself.
+func TableSecurityIDToTableName(tableID id.Table) doltdb.TableName {
+	return doltdb.TableName{
+		Name:   tableID.TableName() + securityNameSuffix,
+		Schema: tableID.SchemaName(),
+	}
+}
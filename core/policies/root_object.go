@@ -0,0 +1,409 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policies
+
+import (
+	"context"
+	"io"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/store/hash"
+
+	"github.com/dolthub/doltgresql/core/id"
+	pgmerge "github.com/dolthub/doltgresql/core/merge"
+	"github.com/dolthub/doltgresql/core/rootobject/objinterface"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+const (
+	FIELD_NAME_RESTRICTIVE = "restrictive"
+	FIELD_NAME_COMMAND     = "command"
+	FIELD_NAME_ROLES       = "roles"
+	FIELD_NAME_USING       = "using"
+	FIELD_NAME_WITH_CHECK  = "with_check"
+	FIELD_NAME_ENABLED     = "enabled"
+	FIELD_NAME_FORCED      = "forced"
+)
+
+// DeserializeRootObject implements the interface objinterface.Collection.
+func (pgp *Collection) DeserializeRootObject(ctx context.Context, data []byte) (objinterface.RootObject, error) {
+	return DeserializeRootObject(ctx, data)
+}
+
+// DiffRootObjects implements the interface objinterface.Collection.
+func (pgp *Collection) DiffRootObjects(ctx context.Context, fromHash string, o objinterface.RootObject, t objinterface.RootObject, a objinterface.RootObject) ([]objinterface.RootObjectDiff, objinterface.RootObject, error) {
+	switch ours := o.(type) {
+	case Policy:
+		return diffPolicies(fromHash, ours, t.(Policy), a)
+	case TableSecurity:
+		return diffTableSecurity(fromHash, ours, t.(TableSecurity), a)
+	default:
+		return nil, nil, errors.Newf("invalid policy root object: %T", o)
+	}
+}
+
+// diffPolicies returns the conflicting fields of the two policies, along with the policy that has all non-conflicting
+// fields merged.
+func diffPolicies(fromHash string, ours Policy, theirs Policy, a objinterface.RootObject) ([]objinterface.RootObjectDiff, objinterface.RootObject, error) {
+	ancestor, hasAncestor := a.(Policy)
+	var diffs []objinterface.RootObjectDiff
+	if ours.Restrictive != theirs.Restrictive {
+		diff := objinterface.RootObjectDiff{
+			Type:      pgtypes.Bool,
+			FromHash:  fromHash,
+			FieldName: FIELD_NAME_RESTRICTIVE,
+		}
+		if pgmerge.DiffValues(&diff, ours.Restrictive, theirs.Restrictive, ancestor.Restrictive, hasAncestor) {
+			diffs = append(diffs, diff)
+		} else {
+			ours.Restrictive = diff.OurValue.(bool)
+		}
+	}
+	if ours.Command != theirs.Command {
+		diff := objinterface.RootObjectDiff{
+			Type:      pgtypes.Text,
+			FromHash:  fromHash,
+			FieldName: FIELD_NAME_COMMAND,
+		}
+		if pgmerge.DiffValues(&diff, ours.Command.String(), theirs.Command.String(), ancestor.Command.String(), hasAncestor) {
+			diffs = append(diffs, diff)
+		} else {
+			ours.Command = PolicyCommandFromString(diff.OurValue.(string))
+		}
+	}
+	if ourRoles, theirRoles := rolesToString(ours.Roles), rolesToString(theirs.Roles); ourRoles != theirRoles {
+		diff := objinterface.RootObjectDiff{
+			Type:      pgtypes.Text,
+			FromHash:  fromHash,
+			FieldName: FIELD_NAME_ROLES,
+		}
+		if pgmerge.DiffValues(&diff, ourRoles, theirRoles, rolesToString(ancestor.Roles), hasAncestor) {
+			diffs = append(diffs, diff)
+		} else {
+			ours.Roles = rolesFromString(diff.OurValue.(string))
+		}
+	}
+	if ours.Using != theirs.Using {
+		diff := objinterface.RootObjectDiff{
+			Type:      pgtypes.Text,
+			FromHash:  fromHash,
+			FieldName: FIELD_NAME_USING,
+		}
+		if pgmerge.DiffValues(&diff, ours.Using, theirs.Using, ancestor.Using, hasAncestor) {
+			diffs = append(diffs, diff)
+		} else {
+			ours.Using = diff.OurValue.(string)
+		}
+	}
+	if ours.WithCheck != theirs.WithCheck {
+		diff := objinterface.RootObjectDiff{
+			Type:      pgtypes.Text,
+			FromHash:  fromHash,
+			FieldName: FIELD_NAME_WITH_CHECK,
+		}
+		if pgmerge.DiffValues(&diff, ours.WithCheck, theirs.WithCheck, ancestor.WithCheck, hasAncestor) {
+			diffs = append(diffs, diff)
+		} else {
+			ours.WithCheck = diff.OurValue.(string)
+		}
+	}
+	return diffs, ours, nil
+}
+
+// diffTableSecurity returns the conflicting fields of the two table settings, along with the settings that have all
+// non-conflicting fields merged.
+func diffTableSecurity(fromHash string, ours TableSecurity, theirs TableSecurity, a objinterface.RootObject) ([]objinterface.RootObjectDiff, objinterface.RootObject, error) {
+	ancestor, hasAncestor := a.(TableSecurity)
+	var diffs []objinterface.RootObjectDiff
+	if ours.Enabled != theirs.Enabled {
+		diff := objinterface.RootObjectDiff{
+			Type:      pgtypes.Bool,
+			FromHash:  fromHash,
+			FieldName: FIELD_NAME_ENABLED,
+		}
+		if pgmerge.DiffValues(&diff, ours.Enabled, theirs.Enabled, ancestor.Enabled, hasAncestor) {
+			diffs = append(diffs, diff)
+		} else {
+			ours.Enabled = diff.OurValue.(bool)
+		}
+	}
+	if ours.Forced != theirs.Forced {
+		diff := objinterface.RootObjectDiff{
+			Type:      pgtypes.Bool,
+			FromHash:  fromHash,
+			FieldName: FIELD_NAME_FORCED,
+		}
+		if pgmerge.DiffValues(&diff, ours.Forced, theirs.Forced, ancestor.Forced, hasAncestor) {
+			diffs = append(diffs, diff)
+		} else {
+			ours.Forced = diff.OurValue.(bool)
+		}
+	}
+	return diffs, ours, nil
+}
+
+// DropRootObject implements the interface objinterface.Collection.
+func (pgp *Collection) DropRootObject(ctx context.Context, identifier id.Id) error {
+	switch identifier.Section() {
+	case id.Section_RowLevelSecurity:
+		return pgp.DropPolicy(ctx, id.Policy(identifier))
+	case id.Section_Table:
+		if !pgp.HasTableSecurity(ctx, id.Table(identifier)) {
+			return errors.Errorf(`row-level security settings for %s do not exist`, identifier.String())
+		}
+		return pgp.deleteObjects(ctx, []id.Id{identifier})
+	default:
+		return errors.Errorf(`policy %s does not exist`, identifier.String())
+	}
+}
+
+// GetFieldType implements the interface objinterface.Collection.
+func (pgp *Collection) GetFieldType(ctx context.Context, fieldName string) *pgtypes.DoltgresType {
+	switch fieldName {
+	case FIELD_NAME_RESTRICTIVE:
+		return pgtypes.Bool
+	case FIELD_NAME_COMMAND:
+		return pgtypes.Text
+	case FIELD_NAME_ROLES:
+		return pgtypes.Text
+	case FIELD_NAME_USING:
+		return pgtypes.Text
+	case FIELD_NAME_WITH_CHECK:
+		return pgtypes.Text
+	case FIELD_NAME_ENABLED:
+		return pgtypes.Bool
+	case FIELD_NAME_FORCED:
+		return pgtypes.Bool
+	default:
+		return nil
+	}
+}
+
+// GetID implements the interface objinterface.Collection.
+func (pgp *Collection) GetID() objinterface.RootObjectID {
+	return objinterface.RootObjectID_Policies
+}
+
+// GetRootObject implements the interface objinterface.Collection.
+func (pgp *Collection) GetRootObject(ctx context.Context, identifier id.Id) (objinterface.RootObject, bool, error) {
+	switch identifier.Section() {
+	case id.Section_RowLevelSecurity:
+		p, ok := pgp.policyCache[id.Policy(identifier)]
+		return p, ok, nil
+	case id.Section_Table:
+		security, ok := pgp.securityCache[id.Table(identifier)]
+		return security, ok, nil
+	default:
+		return nil, false, nil
+	}
+}
+
+// HasRootObject implements the interface objinterface.Collection.
+func (pgp *Collection) HasRootObject(ctx context.Context, identifier id.Id) (bool, error) {
+	switch identifier.Section() {
+	case id.Section_RowLevelSecurity:
+		return pgp.HasPolicy(ctx, id.Policy(identifier)), nil
+	case id.Section_Table:
+		return pgp.HasTableSecurity(ctx, id.Table(identifier)), nil
+	default:
+		return false, nil
+	}
+}
+
+// IDToTableName implements the interface objinterface.Collection.
+func (pgp *Collection) IDToTableName(identifier id.Id) doltdb.TableName {
+	switch identifier.Section() {
+	case id.Section_RowLevelSecurity:
+		return PolicyIDToTableName(id.Policy(identifier))
+	case id.Section_Table:
+		return TableSecurityIDToTableName(id.Table(identifier))
+	default:
+		return doltdb.TableName{}
+	}
+}
+
+// IterAll implements the interface objinterface.Collection.
+func (pgp *Collection) IterAll(ctx context.Context, callback func(rootObj objinterface.RootObject) (stop bool, err error)) error {
+	return pgp.Contents().IterAll(ctx, func(_ string, h hash.Hash) error {
+		data, err := pgp.NodeStore().ReadBytes(ctx, h)
+		if err != nil {
+			return err
+		}
+		rootObj, err := DeserializeRootObject(ctx, data)
+		if err != nil {
+			return err
+		}
+		stop, err := callback(rootObj)
+		if err != nil {
+			return err
+		} else if stop {
+			return io.EOF
+		} else {
+			return nil
+		}
+	})
+}
+
+// IterIDs implements the interface objinterface.Collection.
+func (pgp *Collection) IterIDs(ctx context.Context, callback func(identifier id.Id) (stop bool, err error)) error {
+	return pgp.Contents().IterAll(ctx, func(k string, _ hash.Hash) error {
+		stop, err := callback(id.Id(k))
+		if err != nil {
+			return err
+		} else if stop {
+			return io.EOF
+		} else {
+			return nil
+		}
+	})
+}
+
+// PutRootObject implements the interface objinterface.Collection.
+func (pgp *Collection) PutRootObject(ctx context.Context, rootObj objinterface.RootObject) error {
+	switch rootObj := rootObj.(type) {
+	case Policy:
+		return pgp.AddPolicy(ctx, rootObj)
+	case TableSecurity:
+		if pgp.HasTableSecurity(ctx, rootObj.ID) {
+			return errors.Errorf(`row-level security settings for table "%s" already exist`, rootObj.ID.TableName())
+		}
+		return pgp.SetTableSecurity(ctx, rootObj)
+	default:
+		return errors.Newf("invalid policy root object: %T", rootObj)
+	}
+}
+
+// RenameRootObject implements the interface objinterface.Collection.
+func (pgp *Collection) RenameRootObject(ctx context.Context, oldName id.Id, newName id.Id) error {
+	if !oldName.IsValid() || !newName.IsValid() || oldName.Section() != newName.Section() {
+		return errors.New("cannot rename policy due to invalid id")
+	}
+	switch oldName.Section() {
+	case id.Section_RowLevelSecurity:
+		p, err := pgp.GetPolicy(ctx, id.Policy(oldName))
+		if err != nil {
+			return err
+		}
+		if err = pgp.DropPolicy(ctx, id.Policy(oldName)); err != nil {
+			return err
+		}
+		p.ID = id.Policy(newName)
+		return pgp.AddPolicy(ctx, p)
+	case id.Section_Table:
+		security := pgp.GetTableSecurity(ctx, id.Table(oldName))
+		if err := pgp.SetTableSecurity(ctx, TableSecurity{ID: id.Table(oldName)}); err != nil {
+			return err
+		}
+		security.ID = id.Table(newName)
+		return pgp.SetTableSecurity(ctx, security)
+	default:
+		return errors.New("cannot rename policy due to invalid id")
+	}
+}
+
+// ResolveName implements the interface objinterface.Collection.
+func (pgp *Collection) ResolveName(ctx context.Context, name doltdb.TableName) (doltdb.TableName, id.Id, error) {
+	rawID, err := pgp.resolveName(ctx, name.Schema, name.Name)
+	if err != nil || !rawID.IsValid() {
+		return doltdb.TableName{}, id.Null, err
+	}
+	return pgp.IDToTableName(rawID), rawID, nil
+}
+
+// TableNameToID implements the interface objinterface.Collection.
+func (pgp *Collection) TableNameToID(name doltdb.TableName) id.Id {
+	return tableNameToID(name.Schema, name.Name)
+}
+
+// UpdateField implements the interface objinterface.Collection.
+func (pgp *Collection) UpdateField(ctx context.Context, rootObject objinterface.RootObject, fieldName string, newValue any) (objinterface.RootObject, error) {
+	switch rootObject := rootObject.(type) {
+	case Policy:
+		switch fieldName {
+		case FIELD_NAME_RESTRICTIVE:
+			rootObject.Restrictive = newValue.(bool)
+		case FIELD_NAME_COMMAND:
+			rootObject.Command = PolicyCommandFromString(newValue.(string))
+		case FIELD_NAME_ROLES:
+			rootObject.Roles = rolesFromString(newValue.(string))
+		case FIELD_NAME_USING:
+			rootObject.Using = newValue.(string)
+		case FIELD_NAME_WITH_CHECK:
+			rootObject.WithCheck = newValue.(string)
+		default:
+			return nil, errors.Newf("unknown field name: `%s`", fieldName)
+		}
+		return rootObject, nil
+	case TableSecurity:
+		switch fieldName {
+		case FIELD_NAME_ENABLED:
+			rootObject.Enabled = newValue.(bool)
+		case FIELD_NAME_FORCED:
+			rootObject.Forced = newValue.(bool)
+		default:
+			return nil, errors.Newf("unknown field name: `%s`", fieldName)
+		}
+		return rootObject, nil
+	default:
+		return nil, errors.Newf("invalid policy root object: %T", rootObject)
+	}
+}
+
+// String returns the keyword of the command.
+func (command PolicyCommand) String() string {
+	switch command {
+	case PolicyCommand_Select:
+		return "SELECT"
+	case PolicyCommand_Insert:
+		return "INSERT"
+	case PolicyCommand_Update:
+		return "UPDATE"
+	case PolicyCommand_Delete:
+		return "DELETE"
+	default:
+		return "ALL"
+	}
+}
+
+// PolicyCommandFromString returns the command that has the given keyword. Unknown keywords return ALL.
+func PolicyCommandFromString(command string) PolicyCommand {
+	switch strings.ToUpper(command) {
+	case "SELECT":
+		return PolicyCommand_Select
+	case "INSERT":
+		return PolicyCommand_Insert
+	case "UPDATE":
+		return PolicyCommand_Update
+	case "DELETE":
+		return PolicyCommand_Delete
+	default:
+		return PolicyCommand_All
+	}
+}
+
+// rolesToString returns the roles as a comma-separated list, which is how they're displayed in diffs and conflicts.
+func rolesToString(roles []string) string {
+	return strings.Join(roles, ",")
+}
+
+// rolesFromString returns the roles from a comma-separated list.
+func rolesFromString(roles string) []string {
+	if len(roles) == 0 {
+		return nil
+	}
+	return strings.Split(roles, ",")
+}
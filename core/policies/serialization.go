@@ -0,0 +1,103 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policies
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/dolthub/doltgresql/core/id"
+	"github.com/dolthub/doltgresql/core/rootobject/objinterface"
+	"github.com/dolthub/doltgresql/utils"
+)
+
+// Serialize returns the Policy as a byte slice. If the Policy is invalid, then this returns a nil slice.
+func (p Policy) Serialize(ctx context.Context) ([]byte, error) {
+	if !p.ID.IsValid() {
+		return nil, nil
+	}
+
+	// Initialize the writer and version
+	writer := utils.NewWriter(256)
+	writer.VariableUint(0) // Version
+	// Write the policy data
+	writer.Id(p.ID.AsId())
+	writer.Bool(p.Restrictive)
+	writer.Uint8(uint8(p.Command))
+	writer.StringSlice(p.Roles)
+	writer.String(p.Using)
+	writer.String(p.WithCheck)
+	// Returns the data
+	return writer.Data(), nil
+}
+
+// Serialize returns the TableSecurity as a byte slice. If the TableSecurity is invalid, then this returns a nil slice.
+func (security TableSecurity) Serialize(ctx context.Context) ([]byte, error) {
+	if !security.ID.IsValid() {
+		return nil, nil
+	}
+
+	// Initialize the writer and version
+	writer := utils.NewWriter(32)
+	writer.VariableUint(0) // Version
+	// Write the table settings
+	writer.Id(security.ID.AsId())
+	writer.Bool(security.Enabled)
+	writer.Bool(security.Forced)
+	// Returns the data
+	return writer.Data(), nil
+}
+
+// DeserializeRootObject returns the Policy or TableSecurity that was serialized in the byte slice, which is
+// determined by the section of the serialized ID. Returns an empty Policy (invalid ID) if data is nil or empty.
+func DeserializeRootObject(ctx context.Context, data []byte) (objinterface.RootObject, error) {
+	if len(data) == 0 {
+		return Policy{}, nil
+	}
+	reader := utils.NewReader(data)
+	version := reader.VariableUint()
+	if version != 0 {
+		return nil, errors.Errorf("version %d of policies is not supported, please upgrade the server", version)
+	}
+
+	// Read from the reader
+	var rootObj objinterface.RootObject
+	rawID := reader.Id()
+	switch rawID.Section() {
+	case id.Section_RowLevelSecurity:
+		p := Policy{}
+		p.ID = id.Policy(rawID)
+		p.Restrictive = reader.Bool()
+		p.Command = PolicyCommand(reader.Uint8())
+		p.Roles = reader.StringSlice()
+		p.Using = reader.String()
+		p.WithCheck = reader.String()
+		rootObj = p
+	case id.Section_Table:
+		security := TableSecurity{}
+		security.ID = id.Table(rawID)
+		security.Enabled = reader.Bool()
+		security.Forced = reader.Bool()
+		rootObj = security
+	default:
+		return nil, errors.Errorf("unexpected section `%s` found while deserializing a policy", rawID.Section().String())
+	}
+	if !reader.IsEmpty() {
+		return nil, errors.Errorf("extra data found while deserializing a policy")
+	}
+	// Return the deserialized object
+	return rootObj, nil
+}
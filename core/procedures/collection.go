@@ -25,6 +25,7 @@ import (
 	"github.com/dolthub/dolt/go/store/prolly"
 
 	"github.com/dolthub/doltgresql/core/id"
+	pgmerge "github.com/dolthub/doltgresql/core/merge"
 	"github.com/dolthub/doltgresql/core/rootobject/objinterface"
 	"github.com/dolthub/doltgresql/server/plpgsql"
 )
@@ -360,6 +361,38 @@ func (procedure Procedure) ReplaceDefinition(newInner string) string {
 	return strings.Replace(procedure.Definition, procedure.GetInnerDefinition(), newInner, 1)
 }
 
+// mergeInnerDefinition performs a three-way merge of the inner definitions of our procedure and their procedure, returning
+// our procedure with the merged definition. Returns false if the changes conflict, or if there is no ancestor to merge
+// from. A merged PL/pgSQL body that no longer parses is also considered a conflict.
+func (procedure Procedure) mergeInnerDefinition(theirs Procedure, ancestor Procedure, hasAncestor bool) (Procedure, bool) {
+	if !hasAncestor {
+		return Procedure{}, false
+	}
+	mergedInner, ok := pgmerge.MergeText(procedure.GetInnerDefinition(), theirs.GetInnerDefinition(), ancestor.GetInnerDefinition())
+	if !ok {
+		return Procedure{}, false
+	}
+	merged, err := procedure.replaceInnerDefinition(mergedInner)
+	if err != nil {
+		return Procedure{}, false
+	}
+	return merged, true
+}
+
+// replaceInnerDefinition returns a copy of the procedure with the inner definition replaced with the given string. The
+// interpreter operations of PL/pgSQL procedures are parsed from the new definition, so that they always match it.
+func (procedure Procedure) replaceInnerDefinition(newInner string) (Procedure, error) {
+	procedure.Definition = procedure.ReplaceDefinition(newInner)
+	if len(procedure.Operations) > 0 {
+		parsedBody, err := plpgsql.Parse(procedure.Definition)
+		if err != nil {
+			return Procedure{}, err
+		}
+		procedure.Operations = parsedBody
+	}
+	return procedure, nil
+}
+
 // GetRootObjectID implements the interface objinterface.RootObject.
 func (procedure Procedure) GetRootObjectID() objinterface.RootObjectID {
 	return objinterface.RootObjectID_Procedures
@@ -21,6 +21,7 @@ import (
 	"github.com/dolthub/go-mysql-server/sql"
 
 	"github.com/dolthub/doltgresql/core/id"
+	"github.com/dolthub/doltgresql/core/matviews"
 	"github.com/dolthub/doltgresql/core/sequences"
 )
 
@@ -31,12 +32,19 @@ const (
 	RelationType_DoesNotExist RelationType = iota
 	RelationType_Table
 	RelationType_Sequence
+	RelationType_MaterializedView
 )
 
 // GetRelationType returns whether the working root has the given relation, and what type of relation it is. According
 // to the Postgres docs, a relation may be one of: table, sequence, index, view, materialized view, foreign table. This
 // may also include composite types and partitions, but this hasn't been confirmed.
 func GetRelationType(ctx *sql.Context, schema string, relation string) (RelationType, error) {
+	return GetRelationTypeInDatabase(ctx, ctx.GetCurrentDatabase(), schema, relation)
+}
+
+// GetRelationTypeInDatabase performs the same function as GetRelationType, except that it uses the working root of the
+// given database rather than the current database.
+func GetRelationTypeInDatabase(ctx *sql.Context, database string, schema string, relation string) (RelationType, error) {
 	// TODO: the schema isn't actually being used
 	if len(schema) == 0 {
 		var err error
@@ -47,7 +55,7 @@ func GetRelationType(ctx *sql.Context, schema string, relation string) (Relation
 	}
 
 	session := dsess.DSessFromSess(ctx.Session)
-	state, ok, err := session.LookupDbState(ctx, ctx.GetCurrentDatabase())
+	state, ok, err := session.LookupDbState(ctx, database)
 	if err != nil {
 		return RelationType_DoesNotExist, err
 	}
@@ -56,8 +64,7 @@ func GetRelationType(ctx *sql.Context, schema string, relation string) (Relation
 	}
 
 	// Verify relation against temporary tables created this session
-	dbName := ctx.GetCurrentDatabase()
-	if _, ok := session.GetTemporaryTable(ctx, dbName, relation); ok {
+	if _, ok := session.GetTemporaryTable(ctx, database, relation); ok {
 		return RelationType_Table, nil
 	}
 
@@ -80,6 +87,14 @@ func GetRelationTypeFromRoot(ctx *sql.Context, schema string, relation string, r
 		return RelationType_DoesNotExist, err
 	}
 	if ok {
+		// Materialized views store their rows in a table, so they're distinguished by their definition
+		matviewsColl, err := matviews.LoadMaterializedViews(ctx, root)
+		if err != nil {
+			return RelationType_DoesNotExist, err
+		}
+		if matviewsColl.HasMaterializedView(ctx, id.NewView(schema, relation)) {
+			return RelationType_MaterializedView, nil
+		}
 		return RelationType_Table, nil
 	}
 
@@ -29,7 +29,10 @@ import (
 	"github.com/dolthub/doltgresql/core/extensions"
 	"github.com/dolthub/doltgresql/core/functions"
 	"github.com/dolthub/doltgresql/core/id"
+	"github.com/dolthub/doltgresql/core/indexmethods"
+	"github.com/dolthub/doltgresql/core/matviews"
 	"github.com/dolthub/doltgresql/core/operators"
+	"github.com/dolthub/doltgresql/core/policies"
 	"github.com/dolthub/doltgresql/core/procedures"
 	"github.com/dolthub/doltgresql/core/rootobject/objinterface"
 	"github.com/dolthub/doltgresql/core/sequences"
@@ -52,6 +55,9 @@ var (
 		&casts.Collection{},
 		&operators.Collection{},
 		&aggregates.Collection{},
+		&matviews.Collection{},
+		&policies.Collection{},
+		&indexmethods.Collection{},
 	}
 )
 
@@ -420,6 +426,20 @@ func LoadCollection(ctx context.Context, root objinterface.RootValue, collection
 	return globalCollections[collectionID].LoadCollection(ctx, root)
 }
 
+// LoadRootObjects loads every root object from the collection matching the given ID, keyed by their IDs.
+func LoadRootObjects(ctx context.Context, root objinterface.RootValue, collectionID objinterface.RootObjectID) (map[id.Id]objinterface.RootObject, error) {
+	coll, err := LoadCollection(ctx, root, collectionID)
+	if err != nil || coll == nil {
+		return nil, err
+	}
+	rootObjects := make(map[id.Id]objinterface.RootObject)
+	err = coll.IterAll(ctx, func(rootObj objinterface.RootObject) (stop bool, err error) {
+		rootObjects[rootObj.GetID()] = rootObj
+		return false, nil
+	})
+	return rootObjects, err
+}
+
 // PutRootObject adds the given root object to the respective Collection in the root, returning the updated root.
 func PutRootObject(ctx context.Context, root objinterface.RootValue, tName doltdb.TableName, rootObj objinterface.RootObject) (objinterface.RootValue, error) {
 	if rootObj == nil {
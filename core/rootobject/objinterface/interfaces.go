@@ -40,6 +40,9 @@ const (
 	RootObjectID_Casts
 	RootObjectID_Operators
 	RootObjectID_Aggregates
+	RootObjectID_MaterializedViews
+	RootObjectID_Policies
+	RootObjectID_IndexAccessMethods
 	RootObjectID_Count // This must always be last since it represents the count
 )
 
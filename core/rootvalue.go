@@ -33,6 +33,9 @@ import (
 
 	"github.com/dolthub/doltgresql/core/conflicts"
 	"github.com/dolthub/doltgresql/core/id"
+	"github.com/dolthub/doltgresql/core/indexmethods"
+	"github.com/dolthub/doltgresql/core/matviews"
+	"github.com/dolthub/doltgresql/core/policies"
 	"github.com/dolthub/doltgresql/core/rootobject"
 	"github.com/dolthub/doltgresql/core/rootobject/objinterface"
 	"github.com/dolthub/doltgresql/core/sequences"
@@ -807,7 +810,51 @@ func (root *RootValue) RenameTable(ctx context.Context, oldName, newName doltdb.
 		for _, seq := range seqs {
 			seq.OwnerTable = id.NewTable(seq.OwnerTable.SchemaName(), newName.Name)
 		}
-		return collection.UpdateRoot(ctx, newRoot)
+		updatedRoot, err := collection.UpdateRoot(ctx, newRoot)
+		if err != nil {
+			return nil, err
+		}
+
+		// Materialized views store their rows in a table, so their definition follows the table's name
+		matviewsColl, err := matviews.LoadMaterializedViews(ctx, updatedRoot)
+		if err != nil {
+			return nil, err
+		}
+		newSchema := newName.Schema
+		if len(newSchema) == 0 {
+			newSchema = oldName.Schema
+		}
+		oldViewID := id.NewView(oldName.Schema, oldName.Name)
+		if matviewsColl.HasMaterializedView(ctx, oldViewID) {
+			if err = matviewsColl.RenameRootObject(ctx, oldViewID.AsId(), id.NewView(newSchema, newName.Name).AsId()); err != nil {
+				return nil, err
+			}
+			if updatedRoot, err = matviewsColl.UpdateRoot(ctx, updatedRoot); err != nil {
+				return nil, err
+			}
+		}
+
+		// Policies and row-level security settings belong to the table, so they follow the table's name
+		policiesColl, err := policies.LoadPolicies(ctx, updatedRoot)
+		if err != nil {
+			return nil, err
+		}
+		if err = policiesColl.RenameTable(ctx, id.NewTable(oldName.Schema, oldName.Name), id.NewTable(newSchema, newName.Name)); err != nil {
+			return nil, err
+		}
+		if updatedRoot, err = policiesColl.UpdateRoot(ctx, updatedRoot); err != nil {
+			return nil, err
+		}
+
+		// Index access methods are keyed by the table that the index belongs to, so they follow the table's name
+		accessMethodsColl, err := indexmethods.LoadIndexAccessMethods(ctx, updatedRoot)
+		if err != nil {
+			return nil, err
+		}
+		if err = accessMethodsColl.RenameTable(ctx, id.NewTable(oldName.Schema, oldName.Name), id.NewTable(newSchema, newName.Name)); err != nil {
+			return nil, err
+		}
+		return accessMethodsColl.UpdateRoot(ctx, updatedRoot)
 	} else {
 		coll, err := rootobject.LoadCollection(ctx, root, objID)
 		if err != nil {
@@ -0,0 +1,102 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sequences
+
+import (
+	"sync"
+
+	"github.com/dolthub/doltgresql/core/id"
+)
+
+// cacheKey identifies a sequence within a database. Every branch has its own sequence state, so the database name is
+// qualified by its revision (such as "postgres/main"), which keeps values that were allocated on one branch from being
+// handed out after the session checks out another.
+type cacheKey struct {
+	database string
+	sequence id.Sequence
+}
+
+// sessionCaches holds the values that each session has allocated ahead of time for sequences with a CACHE greater than
+// one, keyed by the connection ID. Values are handed out by the session that allocated them, so concurrent sessions
+// only contend on the shared sequence state once per block of values. Just like Postgres, any values that a session
+// has allocated but does not use are lost.
+var sessionCaches = struct {
+	mu          sync.Mutex
+	connections map[uint32]map[cacheKey][]int64
+}{connections: make(map[uint32]map[cacheKey][]int64)}
+
+// NextCachedValue returns the next value that the session has allocated for the sequence, within the given
+// revision-qualified database. Returns false if the session has no remaining values for the sequence.
+func NextCachedValue(connectionID uint32, database string, sequence id.Sequence) (int64, bool) {
+	sessionCaches.mu.Lock()
+	defer sessionCaches.mu.Unlock()
+	key := cacheKey{database: database, sequence: sequence}
+	values := sessionCaches.connections[connectionID][key]
+	if len(values) == 0 {
+		return 0, false
+	}
+	if len(values) == 1 {
+		delete(sessionCaches.connections[connectionID], key)
+	} else {
+		sessionCaches.connections[connectionID][key] = values[1:]
+	}
+	return values[0], true
+}
+
+// SetCachedValues sets the values that the session has allocated for the sequence, replacing any that remain.
+func SetCachedValues(connectionID uint32, database string, sequence id.Sequence, values []int64) {
+	sessionCaches.mu.Lock()
+	defer sessionCaches.mu.Unlock()
+	key := cacheKey{database: database, sequence: sequence}
+	if len(values) == 0 {
+		delete(sessionCaches.connections[connectionID], key)
+		return
+	}
+	cache, ok := sessionCaches.connections[connectionID]
+	if !ok {
+		cache = make(map[cacheKey][]int64)
+		sessionCaches.connections[connectionID] = cache
+	}
+	cache[key] = values
+}
+
+// DiscardCachedValues discards the values that the session has allocated for the sequence. This is used whenever the
+// session changes the state of the sequence, such as through setval or ALTER SEQUENCE, so that the following call to
+// nextval observes the change. The caches of other sessions are unaffected, which matches Postgres.
+func DiscardCachedValues(connectionID uint32, database string, sequence id.Sequence) {
+	SetCachedValues(connectionID, database, sequence, nil)
+}
+
+// RemoveSessionCache removes all values that the session has allocated. This is called when the connection closes.
+func RemoveSessionCache(connectionID uint32) {
+	sessionCaches.mu.Lock()
+	defer sessionCaches.mu.Unlock()
+	delete(sessionCaches.connections, connectionID)
+}
+
+// invalidateCachedValues discards the values that every session has allocated for the sequence within any database.
+// This is used when a sequence is dropped, as a new sequence with the same name must not hand out the values that
+// were allocated from the old one.
+func invalidateCachedValues(sequence id.Sequence) {
+	sessionCaches.mu.Lock()
+	defer sessionCaches.mu.Unlock()
+	for _, cache := range sessionCaches.connections {
+		for key := range cache {
+			if key.sequence == sequence {
+				delete(cache, key)
+			}
+		}
+	}
+}
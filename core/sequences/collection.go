@@ -86,15 +86,11 @@ func (sequence SequenceState) Merge(otherSequenceState SequenceState) (merged Se
 		newSequenceState.Increment = utils.Max(sequence.Increment, otherSequenceState.Increment)
 		newSequenceState.Start = utils.Max(sequence.Start, otherSequenceState.Start)
 	}
-	if sequence.GreaterThan(otherSequenceState) {
-		newSequenceState.Current = sequence.Current
-	} else {
-		newSequenceState.Current = otherSequenceState.Current
-	}
+	newSequenceState.Current, newSequenceState.IsAtEnd = sequence.furthestPosition(otherSequenceState)
 	newSequenceState.Minimum = utils.Min(sequence.Minimum, otherSequenceState.Minimum)
 	newSequenceState.Maximum = utils.Max(sequence.Maximum, otherSequenceState.Maximum)
+	newSequenceState.Cache = utils.Min(sequence.Cache, otherSequenceState.Cache)
 	newSequenceState.Cycle = sequence.Cycle || otherSequenceState.Cycle
-	newSequenceState.IsAtEnd = sequence.IsAtEnd && otherSequenceState.IsAtEnd
 	newSequenceState.HasBeenCalled = sequence.HasBeenCalled || otherSequenceState.HasBeenCalled
 	return newSequenceState
 }
@@ -140,6 +136,20 @@ func (sequence SequenceState) GreaterThan(other SequenceState) bool {
 	}
 }
 
+// furthestPosition returns the position (the current value, and whether the end has been reached) of whichever state
+// has progressed the furthest. Both states must increment in the same direction. A state that has reached the end has
+// already handed out its current value, so it's further along than a state with the same current value that has not.
+// Taking the furthest position ensures that a merged state never hands out a value that either state has handed out.
+func (sequence SequenceState) furthestPosition(other SequenceState) (current int64, isAtEnd bool) {
+	if sequence.Current == other.Current {
+		return sequence.Current, sequence.IsAtEnd || other.IsAtEnd
+	}
+	if sequence.GreaterThan(other) {
+		return sequence.Current, sequence.IsAtEnd
+	}
+	return other.Current, other.IsAtEnd
+}
+
 func (sequence SequenceState) AtEnd() bool {
 	return sequence.IsAtEnd
 }
@@ -337,6 +347,7 @@ func (pgs *Collection) DropSequence(ctx context.Context, names ...id.Sequence) (
 		if err = mapEditor.Delete(ctx, string(name)); err != nil {
 			return err
 		}
+		invalidateCachedValues(name)
 	}
 	flushed, err := mapEditor.Flush(ctx)
 	if err != nil {
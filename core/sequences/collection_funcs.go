@@ -91,15 +91,19 @@ func (*Collection) HandleMerge(ctx context.Context, mro merge.MergeRootObject) (
 			return ourStart
 		}
 	})
-	mergedSeq.Current = merge2.ResolveMergeValues(ourSeq.Current, theirSeq.Current, ancSeq.Current, hasAncestor, func(ourCurrent, theirCurrent int64) int64 {
-		if ourSeq.Increment >= 0 && theirSeq.Increment >= 0 {
-			return utils.Max(ourCurrent, theirCurrent)
-		} else if ourSeq.Increment < 0 && theirSeq.Increment < 0 {
-			return utils.Min(ourCurrent, theirCurrent)
-		} else {
-			return ourCurrent
+	// The position of the merged sequence must not be behind the position of either side, otherwise values that were
+	// handed out on one branch would be handed out again. If only one side has moved from the ancestor, then we take
+	// that side, as it may have been moved backwards deliberately (through setval or RESTART), and the other side has
+	// not handed out any values since the ancestor.
+	ourPositionChanged := !hasAncestor || ourSeq.Current != ancSeq.Current || ourSeq.IsAtEnd != ancSeq.IsAtEnd
+	theirPositionChanged := !hasAncestor || theirSeq.Current != ancSeq.Current || theirSeq.IsAtEnd != ancSeq.IsAtEnd
+	if (ourSeq.Increment >= 0) == (theirSeq.Increment >= 0) {
+		if ourPositionChanged && theirPositionChanged {
+			mergedSeq.Current, mergedSeq.IsAtEnd = ourSeq.SequenceState.furthestPosition(theirSeq.SequenceState)
+		} else if theirPositionChanged {
+			mergedSeq.Current, mergedSeq.IsAtEnd = theirSeq.Current, theirSeq.IsAtEnd
 		}
-	})
+	}
 	mergedSeq.HasBeenCalled = merge2.ResolveMergeValues(ourSeq.HasBeenCalled, theirSeq.HasBeenCalled, ancSeq.HasBeenCalled, hasAncestor, func(ourcalled, theirCalled bool) bool {
 		return ourcalled || theirCalled
 	})
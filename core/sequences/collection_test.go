@@ -72,6 +72,41 @@ func TestDropSequence_RemainsUsableAfterFlushFailure(t *testing.T) {
 	})
 }
 
+// TestSequenceStateMerge_DoesNotReissueValues asserts that merging two
+// states never results in a state that hands out a value that either
+// state has already handed out.
+func TestSequenceStateMerge_DoesNotReissueValues(t *testing.T) {
+	t.Parallel()
+	base := SequenceState{
+		Id:        id.NewSequence("public", "seq"),
+		Start:     1,
+		Current:   1,
+		Increment: 1,
+		Minimum:   1,
+		Maximum:   10,
+		Cache:     1,
+	}
+	// One side has handed out the maximum value, while the other is further behind
+	atEnd := base.WithValue(10)
+	atEnd.IsAtEnd = true
+	behind := base.WithValue(5)
+	for _, merged := range []SequenceState{atEnd.Merge(behind), behind.Merge(atEnd)} {
+		_, _, _, err := merged.Next()
+		require.Error(t, err)
+	}
+	// Both sides are at the same position, but only one side has handed out that value
+	notAtEnd := base.WithValue(10)
+	for _, merged := range []SequenceState{atEnd.Merge(notAtEnd), notAtEnd.Merge(atEnd)} {
+		_, _, _, err := merged.Next()
+		require.Error(t, err)
+	}
+	// Neither side has reached the end, so the merged state continues from the furthest position
+	merged := behind.Merge(base.WithValue(7))
+	val, _, _, err := merged.Next()
+	require.NoError(t, err)
+	require.Equal(t, int64(7), val)
+}
+
 // newTestCollection returns a Collection backed by |ns| with an empty
 // address map.
 func newTestCollection(t *testing.T, ns tree.NodeStore) *Collection {
@@ -16,11 +16,15 @@ package typecollection
 
 import (
 	"context"
+	"sort"
 
 	"github.com/cockroachdb/errors"
 	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
 	"github.com/dolthub/dolt/go/libraries/doltcore/merge"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
 	"github.com/dolthub/dolt/go/store/prolly/tree"
+	"github.com/dolthub/go-mysql-server/sql"
 
 	"github.com/dolthub/doltgresql/core/id"
 	merge2 "github.com/dolthub/doltgresql/core/merge"
@@ -95,12 +99,278 @@ func (*TypeCollection) HandleMerge(ctx context.Context, mro merge.MergeRootObjec
 			SchemaConflicts:      0,
 			ConstraintViolations: 0,
 		}, nil
+	case pgtypes.TypeType_Enum:
+		var ancLabels map[string]pgtypes.EnumLabel
+		if hasAncestor {
+			ancLabels = ancType.EnumLabels
+		}
+		labels, err := mergeEnumLabels(ourType.ID, ourType.EnumLabels, theirType.EnumLabels, ancLabels)
+		if err != nil {
+			return nil, nil, err
+		}
+		if err = checkEnumLabelRenames(ctx, mro, ourType, theirType.EnumLabels, ancLabels); err != nil {
+			return nil, nil, err
+		}
+		mergedType.EnumLabels = labels
+		return TypeWrapper{Type: mergedType}, &merge.MergeStats{
+			Operation:            merge.TableModified,
+			Adds:                 0,
+			Deletes:              0,
+			Modifications:        1,
+			DataConflicts:        0,
+			SchemaConflicts:      0,
+			ConstraintViolations: 0,
+		}, nil
 	default:
 		// TODO: support merge for other types. (base, range, etc.)
 		return nil, nil, errors.Newf("cannot merge `%s` due to unsupported type", ourType.ID.TypeName())
 	}
 }
 
+// mergeEnumLabels merges the labels of both versions of an enum type. A label's sort order is its identity, as a
+// renamed label keeps its sort order. Our labels keep their sort orders, their renamed labels are renamed, and their
+// added labels are placed directly after the label that precedes them on their side.
+func mergeEnumLabels(typeID id.Type, ourLabels, theirLabels, ancLabels map[string]pgtypes.EnumLabel) (map[string]pgtypes.EnumLabel, error) {
+	labelWithSortOrder := func(labels map[string]pgtypes.EnumLabel, sortOrder float32) (string, bool) {
+		for name, label := range labels {
+			if label.SortOrder == sortOrder {
+				return name, true
+			}
+		}
+		return "", false
+	}
+	merged := make(map[string]pgtypes.EnumLabel, len(ourLabels)+len(theirLabels))
+	for name, label := range ourLabels {
+		merged[name] = label
+	}
+	// Apply the labels that they renamed
+	for name, ancLabel := range ancLabels {
+		if _, ok := theirLabels[name]; ok {
+			continue
+		}
+		theirName, ok := labelWithSortOrder(theirLabels, ancLabel.SortOrder)
+		if !ok {
+			continue
+		}
+		if ourLabel, ok := merged[name]; ok {
+			if _, ok = merged[theirName]; ok {
+				return nil, errors.Errorf(`cannot merge enum type "%s" because label "%s" exists on both sides`,
+					typeID.TypeName(), theirName)
+			}
+			delete(merged, name)
+			merged[theirName] = pgtypes.EnumLabel{ID: id.NewEnumLabel(typeID, theirName), SortOrder: ourLabel.SortOrder}
+		} else if ourName, ok := labelWithSortOrder(ourLabels, ancLabel.SortOrder); ok && ourName != theirName {
+			return nil, errors.Errorf(`cannot merge enum type "%s" because label "%s" was renamed to both "%s" and "%s"`,
+				typeID.TypeName(), name, ourName, theirName)
+		}
+	}
+	// Add the labels that they added
+	sortedTheirLabels := pgtypes.SortedEnumLabels(theirLabels)
+	for i, theirLabel := range sortedTheirLabels {
+		name := theirLabel.ID.Label()
+		if _, ok := merged[name]; ok {
+			continue
+		}
+		if _, ok := ancLabels[name]; ok {
+			// We've renamed or removed this label
+			continue
+		}
+		neighbor := ""
+		for j := i - 1; j >= 0 && len(neighbor) == 0; j-- {
+			prevName := sortedTheirLabels[j].ID.Label()
+			if _, ok := merged[prevName]; ok {
+				neighbor = prevName
+			} else if _, ok = ancLabels[prevName]; ok {
+				// We may have renamed the preceding label, which will still have the same sort order
+				neighbor, _ = labelWithSortOrder(merged, sortedTheirLabels[j].SortOrder)
+			}
+		}
+		before := false
+		if len(neighbor) == 0 && len(merged) > 0 {
+			// There are no preceding labels, so this is placed before all of our labels
+			neighbor = pgtypes.SortedEnumLabels(merged)[0].ID.Label()
+			before = true
+		}
+		var err error
+		merged, err = pgtypes.PlaceEnumLabel(typeID, merged, name, neighbor, before)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return merged, nil
+}
+
+// checkEnumLabelRenames returns an error when a label that was renamed on one side may still be stored under its old
+// name on the other side. Enum values are stored as their labels, so any rows that the other side added or changed
+// in a table using the type would no longer be readable once the rename has been merged.
+func checkEnumLabelRenames(ctx context.Context, mro merge.MergeRootObject, ourType *pgtypes.DoltgresType, theirLabels, ancLabels map[string]pgtypes.EnumLabel) error {
+	ourRenames := renamedEnumLabels(ourType.EnumLabels, theirLabels, ancLabels)
+	theirRenames := renamedEnumLabels(theirLabels, ourType.EnumLabels, ancLabels)
+	if len(ourRenames) == 0 && len(theirRenames) == 0 {
+		return nil
+	}
+	renameErr := func(renames map[string]string, tableName string) error {
+		oldNames := make([]string, 0, len(renames))
+		for oldName := range renames {
+			oldNames = append(oldNames, oldName)
+		}
+		sort.Strings(oldNames)
+		if len(tableName) == 0 {
+			return errors.Errorf(`cannot merge enum type "%s" because label "%s" was renamed to "%s" on only one side`,
+				ourType.ID.TypeName(), oldNames[0], renames[oldNames[0]])
+		}
+		return errors.Errorf(`cannot merge enum type "%s" because label "%s" was renamed to "%s" while table "%s" was changed on the other side`,
+			ourType.ID.TypeName(), oldNames[0], renames[oldNames[0]], tableName)
+	}
+	ourRoot, theirRoot, ancRoot, ok, err := enumMergeRoots(ctx, mro)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		// We can't tell which tables have changed, so we can't merge the rename safely
+		if len(ourRenames) > 0 {
+			return renameErr(ourRenames, "")
+		}
+		return renameErr(theirRenames, "")
+	}
+	typeIDs := map[id.Type]struct{}{ourType.ID: {}}
+	if ourType.Array != nil {
+		typeIDs[ourType.Array.ID] = struct{}{}
+	}
+	if len(ourRenames) > 0 {
+		tableName, err := changedTableUsingTypes(ctx, theirRoot, ancRoot, typeIDs)
+		if err != nil {
+			return err
+		}
+		if len(tableName) > 0 {
+			return renameErr(ourRenames, tableName)
+		}
+	}
+	if len(theirRenames) > 0 {
+		tableName, err := changedTableUsingTypes(ctx, ourRoot, ancRoot, typeIDs)
+		if err != nil {
+			return err
+		}
+		if len(tableName) > 0 {
+			return renameErr(theirRenames, tableName)
+		}
+	}
+	return nil
+}
+
+// renamedEnumLabels returns the ancestor's labels that were renamed on the renaming side while the other side still
+// has them under their original name, mapped to their new names.
+func renamedEnumLabels(renamingLabels, otherLabels, ancLabels map[string]pgtypes.EnumLabel) map[string]string {
+	renames := make(map[string]string)
+	for name, ancLabel := range ancLabels {
+		if _, ok := renamingLabels[name]; ok {
+			continue
+		}
+		if _, ok := otherLabels[name]; !ok {
+			continue
+		}
+		for newName, label := range renamingLabels {
+			if label.SortOrder == ancLabel.SortOrder {
+				renames[name] = newName
+				break
+			}
+		}
+	}
+	return renames
+}
+
+// enumMergeRoots returns our working root, their root, and the root of the common ancestor for the merge. Returns
+// false if any of the roots could not be determined, such as when the merge does not come from a commit.
+func enumMergeRoots(ctx context.Context, mro merge.MergeRootObject) (ours doltdb.RootValue, theirs doltdb.RootValue, ancestor doltdb.RootValue, ok bool, err error) {
+	sqlCtx, ok := ctx.(*sql.Context)
+	if !ok {
+		return nil, nil, nil, false, nil
+	}
+	theirCommit, ok := mro.RightSrc.(*doltdb.Commit)
+	if !ok {
+		return nil, nil, nil, false, nil
+	}
+	sess := dsess.DSessFromSess(sqlCtx.Session)
+	dbName := sqlCtx.GetCurrentDatabase()
+	roots, ok := sess.GetRoots(sqlCtx, dbName)
+	if !ok {
+		return nil, nil, nil, false, nil
+	}
+	ourCommit, err := sess.GetHeadCommit(sqlCtx, dbName)
+	if err != nil {
+		return nil, nil, nil, false, err
+	}
+	ancCommit, err := doltdb.GetCommitAncestor(ctx, ourCommit, theirCommit)
+	if err != nil {
+		return nil, nil, nil, false, err
+	}
+	if theirs, err = theirCommit.GetRootValue(ctx); err != nil {
+		return nil, nil, nil, false, err
+	}
+	if ancestor, err = ancCommit.GetRootValue(ctx); err != nil {
+		return nil, nil, nil, false, err
+	}
+	return roots.Working, theirs, ancestor, true, nil
+}
+
+// changedTableUsingTypes returns the name of a table that has a column using one of the given types, and that has
+// been added or changed since the ancestor. Returns an empty string if there is no such table.
+func changedTableUsingTypes(ctx context.Context, root doltdb.RootValue, ancRoot doltdb.RootValue, typeIDs map[id.Type]struct{}) (string, error) {
+	var changedTable string
+	err := root.IterTables(ctx, func(name doltdb.TableName, table *doltdb.Table, sch schema.Schema) (stop bool, err error) {
+		usesType := false
+		for _, col := range sch.GetAllCols().GetColumns() {
+			if dgtype, ok := col.TypeInfo.ToSqlType().(*pgtypes.DoltgresType); ok && typeReferencesTypes(dgtype, typeIDs, make(map[id.Type]struct{})) {
+				usesType = true
+				break
+			}
+		}
+		if !usesType {
+			return false, nil
+		}
+		tableHash, err := table.HashOf()
+		if err != nil {
+			return true, err
+		}
+		ancHash, ok, err := ancRoot.GetTableHash(ctx, name)
+		if err != nil {
+			return true, err
+		}
+		if !ok || tableHash != ancHash {
+			changedTable = name.Name
+			return true, nil
+		}
+		return false, nil
+	})
+	return changedTable, err
+}
+
+// typeReferencesTypes returns whether the type is, or references, any of the given types.
+func typeReferencesTypes(typ *pgtypes.DoltgresType, typeIDs map[id.Type]struct{}, visited map[id.Type]struct{}) bool {
+	if typ == nil {
+		return false
+	}
+	if _, ok := typeIDs[typ.ID]; ok {
+		return true
+	}
+	if _, ok := visited[typ.ID]; ok {
+		return false
+	}
+	visited[typ.ID] = struct{}{}
+	if typ.IsArrayType() && typeReferencesTypes(typ.Elem, typeIDs, visited) {
+		return true
+	}
+	if typ.TypType == pgtypes.TypeType_Domain && typeReferencesTypes(typ.BaseTypeType, typeIDs, visited) {
+		return true
+	}
+	for _, attr := range typ.CompositeAttrs {
+		if typeReferencesTypes(attr.Type, typeIDs, visited) {
+			return true
+		}
+	}
+	return false
+}
+
 // LoadCollection implements the interface objinterface.Collection.
 func (*TypeCollection) LoadCollection(ctx context.Context, root objinterface.RootValue) (objinterface.Collection, error) {
 	return LoadTypes(ctx, root)
@@ -114,6 +114,24 @@ func (pgs *TypeCollection) DropType(ctx context.Context, names ...id.Type) (err
 	return nil
 }
 
+// UpdateType replaces an existing type with the given type, which must have the same ID.
+func (pgs *TypeCollection) UpdateType(ctx context.Context, typ *pgtypes.DoltgresType) error {
+	if _, ok := pgtypes.IDToBuiltInDoltgresType[typ.ID]; ok {
+		return errors.Errorf(`cannot alter type %s because it is required by the database system`, typ.Name())
+	}
+	// Cached types may reference the type being replaced, so we write the cache to ensure that they're reloaded
+	if err := pgs.writeCache(ctx); err != nil {
+		return err
+	}
+	if ok, err := pgs.Contents().Has(ctx, string(typ.ID)); err != nil {
+		return err
+	} else if !ok {
+		return pgtypes.ErrTypeDoesNotExist.New(typ.Name())
+	}
+	pgs.accessedMap[typ.ID] = typ
+	return nil
+}
+
 // GetAllTypes returns a map containing all types in the collection, grouped by the schema they're contained in.
 // Each type array is also sorted by the type name. It includes built-in types.
 func (pgs *TypeCollection) GetAllTypes(ctx context.Context) (typeMap map[string][]*pgtypes.DoltgresType, schemaNames []string, totalCount int, err error) {
@@ -712,3 +712,4 @@ const CONTAINED_BY = 58052
 const POSTFIXOP = 58053
 const UMINUS = 58054
 const HELPTOKEN = 58055
+const EQUALS_GREATER = 58056
@@ -4,20 +4,20 @@
 package parser
 
 var helpMessages = map[string]HelpMessageBody{
-	//line sql.y: 1556
+	//line sql.y: 1582
 	`ALTER`: {
-		//line sql.y: 1557
+		//line sql.y: 1583
 		Category: hGroup,
-		//line sql.y: 1558
+		//line sql.y: 1584
 		Text: `ALTER TABLE, ALTER INDEX, ALTER VIEW, ALTER SEQUENCE, ALTER DATABASE, ALTER USER, ALTER ROLE
 `,
 	},
-	//line sql.y: 1583
+	//line sql.y: 1612
 	`ALTER TABLE`: {
 		ShortDescription: `change the definition of a table`,
-		//line sql.y: 1584
+		//line sql.y: 1613
 		Category: hDDL,
-		//line sql.y: 1585
+		//line sql.y: 1614
 		Text: `
 ALTER TABLE [IF EXISTS] <tablename> <command> [, ...]
 
@@ -41,30 +41,30 @@ Column qualifiers:
   COLLATE <collationname>
 
 `,
-		//line sql.y: 1607
+		//line sql.y: 1636
 		SeeAlso: `WEBDOCS/alter-table.html
 `,
 	},
-	//line sql.y: 1618
+	//line sql.y: 1647
 	`ALTER VIEW`: {
 		ShortDescription: `change the definition of a view`,
-		//line sql.y: 1619
+		//line sql.y: 1648
 		Category: hDDL,
-		//line sql.y: 1620
+		//line sql.y: 1649
 		Text: `
 ALTER [MATERIALIZED] VIEW [IF EXISTS] <name> RENAME TO <newname>
 ALTER [MATERIALIZED] VIEW [IF EXISTS] <name> SET SCHEMA <newschemaname>
 `,
-		//line sql.y: 1623
+		//line sql.y: 1652
 		SeeAlso: `WEBDOCS/alter-view.html
 `,
 	},
-	//line sql.y: 1667
+	//line sql.y: 1696
 	`ALTER SEQUENCE`: {
 		ShortDescription: `change the definition of a sequence`,
-		//line sql.y: 1668
+		//line sql.y: 1697
 		Category: hDDL,
-		//line sql.y: 1669
+		//line sql.y: 1698
 		Text: `
 ALTER SEQUENCE [IF EXISTS] <name>
   [INCREMENT <increment>]
@@ -76,26 +76,26 @@ ALTER SEQUENCE [IF EXISTS] <name> RENAME TO <newname>
 ALTER SEQUENCE [IF EXISTS] <name> SET SCHEMA <newschemaname>
 `,
 	},
-	//line sql.y: 1711
+	//line sql.y: 1740
 	`ALTER DATABASE`: {
 		ShortDescription: `change the definition of a database`,
-		//line sql.y: 1712
+		//line sql.y: 1741
 		Category: hDDL,
-		//line sql.y: 1713
+		//line sql.y: 1742
 		Text: `
 ALTER DATABASE <name> RENAME TO <newname>
 ALTER DATABASE <name> OWNER TO <newowner>
 `,
-		//line sql.y: 1716
+		//line sql.y: 1745
 		SeeAlso: `WEBDOCS/alter-database.html
 `,
 	},
-	//line sql.y: 1887
+	//line sql.y: 1916
 	`ALTER INDEX`: {
 		ShortDescription: `change the definition of an index`,
-		//line sql.y: 1888
+		//line sql.y: 1917
 		Category: hDDL,
-		//line sql.y: 1889
+		//line sql.y: 1918
 		Text: `
 ALTER INDEX [IF EXISTS] <idxname> <command>
 
@@ -103,16 +103,72 @@ Commands:
   ALTER INDEX ... RENAME TO <newname>
 
 `,
-		//line sql.y: 1895
+		//line sql.y: 1924
 		SeeAlso: `WEBDOCS/alter-index.html
 `,
 	},
-	//line sql.y: 2615
+	//line sql.y: 1943
+	`ALTER POLICY`: {
+		ShortDescription: `change the definition of a row-level security policy`,
+		//line sql.y: 1944
+		Category: hDDL,
+		//line sql.y: 1945
+		Text: `
+ALTER POLICY <name> ON <table_name> RENAME TO <new_name>
+ALTER POLICY <name> ON <table_name>
+  [ TO <role> [, ...] ]
+  [ USING ( <using_expression> ) ]
+  [ WITH CHECK ( <check_expression> ) ]
+`,
+		//line sql.y: 1951
+		SeeAlso: `WEBDOCS/sql-alterpolicy.html
+`,
+	},
+	//line sql.y: 2035
+	`ALTER PUBLICATION`: {
+		ShortDescription: `change the definition of a publication`,
+		//line sql.y: 2036
+		Category: hDDL,
+		//line sql.y: 2037
+		Text: `
+ALTER PUBLICATION <name> { ADD | SET | DROP } <publication_object> [, ...]
+ALTER PUBLICATION <name> SET ( <option> [= <value>] [, ... ] )
+ALTER PUBLICATION <name> OWNER TO <role>
+ALTER PUBLICATION <name> RENAME TO <newname>
+
+Publication objects:
+  TABLE <table_name> [, ...]
+  TABLES IN SCHEMA <schema_name> [, ...]
+`,
+		//line sql.y: 2046
+		SeeAlso: `WEBDOCS/sql-alterpublication.html
+`,
+	},
+	//line sql.y: 2106
+	`ALTER SUBSCRIPTION`: {
+		ShortDescription: `change the definition of a subscription`,
+		//line sql.y: 2107
+		Category: hDDL,
+		//line sql.y: 2108
+		Text: `
+ALTER SUBSCRIPTION <name> CONNECTION '<conninfo>'
+ALTER SUBSCRIPTION <name> { SET | ADD | DROP } PUBLICATION <publication> [, ...] [WITH ( <option> [= <value>] [, ... ] )]
+ALTER SUBSCRIPTION <name> REFRESH PUBLICATION [WITH ( <option> [= <value>] [, ... ] )]
+ALTER SUBSCRIPTION <name> { ENABLE | DISABLE }
+ALTER SUBSCRIPTION <name> SET ( <option> [= <value>] [, ... ] )
+ALTER SUBSCRIPTION <name> OWNER TO <role>
+ALTER SUBSCRIPTION <name> RENAME TO <newname>
+`,
+		//line sql.y: 2116
+		SeeAlso: `WEBDOCS/sql-altersubscription.html
+`,
+	},
+	//line sql.y: 2888
 	`ALTER TYPE`: {
 		ShortDescription: `change the definition of a type.`,
-		//line sql.y: 2616
+		//line sql.y: 2889
 		Category: hDDL,
-		//line sql.y: 2617
+		//line sql.y: 2890
 		Text: `ALTER TYPE <typename> <command>
 
 Commands:
@@ -130,26 +186,26 @@ Attribute action:
   ALTER ATTRIBUTE <name> [ SET DATA ] TYPE <type> [ COLLATE <collation> ] [ CASCADE | RESTRICT ]
 
 `,
-		//line sql.y: 2633
+		//line sql.y: 2906
 		SeeAlso: `WEBDOCS/alter-type.html
 `,
 	},
-	//line sql.y: 3003
+	//line sql.y: 3276
 	`REFRESH`: {
 		ShortDescription: `recalculate a materialized view`,
-		//line sql.y: 3004
+		//line sql.y: 3277
 		Category: hMisc,
-		//line sql.y: 3005
+		//line sql.y: 3278
 		Text: `
 REFRESH MATERIALIZED VIEW [CONCURRENTLY] view_name [WITH [NO] DATA]
 `,
 	},
-	//line sql.y: 3032
+	//line sql.y: 3305
 	`BACKUP`: {
 		ShortDescription: `back up data to external storage`,
-		//line sql.y: 3033
+		//line sql.y: 3306
 		Category: hCCL,
-		//line sql.y: 3034
+		//line sql.y: 3307
 		Text: `
 BACKUP <targets...> TO <location...>
        [ AS OF SYSTEM TIME <expr> ]
@@ -171,16 +227,16 @@ Options:
    detached: execute backup job asynchronously, without waiting for its completion
 
 `,
-		//line sql.y: 3054
+		//line sql.y: 3327
 		SeeAlso: `RESTORE, WEBDOCS/backup.html
 `,
 	},
-	//line sql.y: 3158
+	//line sql.y: 3431
 	`CREATE SCHEDULE FOR BACKUP`: {
 		ShortDescription: `backup data periodically`,
-		//line sql.y: 3159
+		//line sql.y: 3432
 		Category: hCCL,
-		//line sql.y: 3160
+		//line sql.y: 3433
 		Text: `
 CREATE SCHEDULE [<description>]
 FOR BACKUP [<targets>] TO <location...>
@@ -251,16 +307,16 @@ FULL BACKUP <crontab|ALWAYS>:
     objects.
 
 `,
-		//line sql.y: 3229
+		//line sql.y: 3502
 		SeeAlso: `BACKUP
 `,
 	},
-	//line sql.y: 3307
+	//line sql.y: 3580
 	`RESTORE`: {
 		ShortDescription: `restore data from external storage`,
-		//line sql.y: 3308
+		//line sql.y: 3581
 		Category: hCCL,
-		//line sql.y: 3309
+		//line sql.y: 3582
 		Text: `
 RESTORE <targets...> FROM <location...>
         [ AS OF SYSTEM TIME <expr> ]
@@ -283,16 +339,16 @@ Options:
    kms="[kms_provider]://[kms_host]/[master_key_identifier]?[parameters]" : decrypt backups using KMS
    detached: execute restore job asynchronously, without waiting for its completion
 `,
-		//line sql.y: 3330
+		//line sql.y: 3603
 		SeeAlso: `BACKUP, WEBDOCS/restore.html
 `,
 	},
-	//line sql.y: 3451
+	//line sql.y: 3724
 	`IMPORT`: {
 		ShortDescription: `load data from file in a distributed manner`,
-		//line sql.y: 3452
+		//line sql.y: 3725
 		Category: hCCL,
-		//line sql.y: 3453
+		//line sql.y: 3726
 		Text: `
 -- Import both schema and table data:
 IMPORT [ TABLE <tablename> FROM ]
@@ -322,16 +378,16 @@ Options:
    comment = '...'        [CSV-specific]
 
 `,
-		//line sql.y: 3481
+		//line sql.y: 3754
 		SeeAlso: `CREATE TABLE
 `,
 	},
-	//line sql.y: 3525
+	//line sql.y: 3798
 	`EXPORT`: {
 		ShortDescription: `export data to file in a distributed manner`,
-		//line sql.y: 3526
+		//line sql.y: 3799
 		Category: hCCL,
-		//line sql.y: 3527
+		//line sql.y: 3800
 		Text: `
 EXPORT INTO <format> <datafile> [WITH <option> [= value] [,...]] FROM <query>
 
@@ -342,89 +398,89 @@ Options:
    delimiter = '...'   [CSV-specific]
 
 `,
-		//line sql.y: 3536
+		//line sql.y: 3809
 		SeeAlso: `SELECT
 `,
 	},
-	//line sql.y: 3618
+	//line sql.y: 3891
 	`CALL`: {
 		ShortDescription: `invoke a procedure`,
-		//line sql.y: 3619
+		//line sql.y: 3892
 		Category: hMisc,
-		//line sql.y: 3620
+		//line sql.y: 3893
 		Text: `CALL <name> ( [ <expr> [, ...] ] )
 `,
-		//line sql.y: 3621
+		//line sql.y: 3894
 		SeeAlso: `CREATE PROCEDURE
 `,
 	},
-	//line sql.y: 3855
+	//line sql.y: 4128
 	`CANCEL`: {
-		//line sql.y: 3856
+		//line sql.y: 4129
 		Category: hGroup,
-		//line sql.y: 3857
+		//line sql.y: 4130
 		Text: `CANCEL JOBS, CANCEL QUERIES, CANCEL SESSIONS
 `,
 	},
-	//line sql.y: 3864
+	//line sql.y: 4137
 	`CANCEL JOBS`: {
 		ShortDescription: `cancel background jobs`,
-		//line sql.y: 3865
+		//line sql.y: 4138
 		Category: hMisc,
-		//line sql.y: 3866
+		//line sql.y: 4139
 		Text: `
 CANCEL JOBS <selectclause>
 CANCEL JOB <jobid>
 `,
-		//line sql.y: 3869
+		//line sql.y: 4142
 		SeeAlso: `SHOW JOBS, PAUSE JOBS, RESUME JOBS
 `,
 	},
-	//line sql.y: 3891
+	//line sql.y: 4164
 	`CANCEL QUERIES`: {
 		ShortDescription: `cancel running queries`,
-		//line sql.y: 3892
+		//line sql.y: 4165
 		Category: hMisc,
-		//line sql.y: 3893
+		//line sql.y: 4166
 		Text: `
 CANCEL QUERIES [IF EXISTS] <selectclause>
 CANCEL QUERY [IF EXISTS] <expr>
 `,
-		//line sql.y: 3896
+		//line sql.y: 4169
 		SeeAlso: `SHOW QUERIES
 `,
 	},
-	//line sql.y: 3927
+	//line sql.y: 4200
 	`CANCEL SESSIONS`: {
 		ShortDescription: `cancel open sessions`,
-		//line sql.y: 3928
+		//line sql.y: 4201
 		Category: hMisc,
-		//line sql.y: 3929
+		//line sql.y: 4202
 		Text: `
 CANCEL SESSIONS [IF EXISTS] <selectclause>
 CANCEL SESSION [IF EXISTS] <sessionid>
 `,
-		//line sql.y: 3932
+		//line sql.y: 4205
 		SeeAlso: `SHOW SESSIONS
 `,
 	},
-	//line sql.y: 4180
+	//line sql.y: 4453
 	`CREATE`: {
-		//line sql.y: 4181
+		//line sql.y: 4454
 		Category: hGroup,
-		//line sql.y: 4182
+		//line sql.y: 4455
 		Text: `
 CREATE DATABASE, CREATE TABLE, CREATE INDEX, CREATE TABLE AS,
 CREATE USER, CREATE VIEW, CREATE SEQUENCE, CREATE STATISTICS,
 CREATE ROLE, CREATE TYPE, CREATE CAST
 `,
 	},
-	//line sql.y: 4329
+	//line sql.y: 4603
 	`CREATE OPERATOR`: {
 		ShortDescription: `define a new operator`,
-		//line sql.y: 4330
+		//line sql.y: 4604
 		Category: hDDL,
-		//line sql.y: 4331
+		//line sql.y: 4605
 		Text: `CREATE OPERATOR name (
          {FUNCTION|PROCEDURE} = function_name
          [, LEFTARG = left_type ] [, RIGHTARG = right_type ]
@@ -433,204 +489,291 @@ CREATE ROLE, CREATE TYPE, CREATE CAST
          [, HASHES ] [, MERGES ]
        )
 `,
-		//line sql.y: 4338
+		//line sql.y: 4612
 		SeeAlso: `WEBDOCS/sql-createoperator.html
 `,
 	},
-	//line sql.y: 4372
+	//line sql.y: 4646
 	`CREATE CAST`: {
 		ShortDescription: `define a new cast`,
-		//line sql.y: 4373
+		//line sql.y: 4647
 		Category: hDDL,
-		//line sql.y: 4374
+		//line sql.y: 4648
 		Text: `CREATE CAST (source_type AS target_type) WITH FUNCTION function_name [ (argument_type [, ...]) ] [ AS ASSIGNMENT | AS IMPLICIT ]
        CREATE CAST (source_type AS target_type) WITHOUT FUNCTION [ AS ASSIGNMENT | AS IMPLICIT ]
        CREATE CAST (source_type AS target_type) WITH INOUT [ AS ASSIGNMENT | AS IMPLICIT ]
 `,
-		//line sql.y: 4377
+		//line sql.y: 4651
 		SeeAlso: `WEBDOCS/sql-createcast.html
 `,
 	},
-	//line sql.y: 4952
+	//line sql.y: 4804
+	`CREATE POLICY`: {
+		ShortDescription: `define a new row-level security policy for a table`,
+		//line sql.y: 4805
+		Category: hDDL,
+		//line sql.y: 4806
+		Text: `
+CREATE POLICY <name> ON <table_name>
+  [ AS { PERMISSIVE | RESTRICTIVE } ]
+  [ FOR { ALL | SELECT | INSERT | UPDATE | DELETE } ]
+  [ TO <role> [, ...] ]
+  [ USING ( <using_expression> ) ]
+  [ WITH CHECK ( <check_expression> ) ]
+`,
+		//line sql.y: 4813
+		SeeAlso: `WEBDOCS/sql-createpolicy.html
+`,
+	},
+	//line sql.y: 4828
+	`CREATE PUBLICATION`: {
+		ShortDescription: `define a new publication`,
+		//line sql.y: 4829
+		Category: hDDL,
+		//line sql.y: 4830
+		Text: `
+CREATE PUBLICATION <name>
+  [ FOR ALL TABLES | FOR <publication_object> [, ... ] ]
+  [ WITH ( <option> [= <value>] [, ... ] ) ]
+
+Publication objects:
+  TABLE <table_name> [, ...]
+  TABLES IN SCHEMA <schema_name> [, ...]
+`,
+		//line sql.y: 4838
+		SeeAlso: `WEBDOCS/sql-createpublication.html
+`,
+	},
+	//line sql.y: 4853
+	`CREATE SUBSCRIPTION`: {
+		ShortDescription: `define a new subscription`,
+		//line sql.y: 4854
+		Category: hDDL,
+		//line sql.y: 4855
+		Text: `
+CREATE SUBSCRIPTION <name> CONNECTION '<conninfo>' PUBLICATION <publication> [, ...]
+  [WITH ( <option> [= <value>] [, ... ] )]
+`,
+		//line sql.y: 4858
+		SeeAlso: `WEBDOCS/sql-createsubscription.html
+`,
+	},
+	//line sql.y: 5285
 	`DROP OPERATOR`: {
 		ShortDescription: `remove an operator`,
-		//line sql.y: 4953
+		//line sql.y: 5286
 		Category: hDDL,
-		//line sql.y: 4954
+		//line sql.y: 5287
 		Text: `DROP OPERATOR [ IF EXISTS ] name ( { left_type | NONE } , right_type ) [, ...] [ CASCADE | RESTRICT ]
 `,
-		//line sql.y: 4955
+		//line sql.y: 5288
 		SeeAlso: `WEBDOCS/sql-dropoperator.html
 `,
 	},
-	//line sql.y: 5062
+	//line sql.y: 5336
+	`DROP POLICY`: {
+		ShortDescription: `remove a row-level security policy from a table`,
+		//line sql.y: 5337
+		Category: hDDL,
+		//line sql.y: 5338
+		Text: `DROP POLICY [ IF EXISTS ] <name> ON <table_name> [ CASCADE | RESTRICT ]
+`,
+		//line sql.y: 5339
+		SeeAlso: `WEBDOCS/sql-droppolicy.html
+`,
+	},
+	//line sql.y: 5350
+	`DROP PUBLICATION`: {
+		ShortDescription: `remove a publication`,
+		//line sql.y: 5351
+		Category: hDDL,
+		//line sql.y: 5352
+		Text: `DROP PUBLICATION [ IF EXISTS ] <name> [, ...] [ CASCADE | RESTRICT ]
+`,
+		//line sql.y: 5353
+		SeeAlso: `WEBDOCS/sql-droppublication.html
+`,
+	},
+	//line sql.y: 5364
+	`DROP SUBSCRIPTION`: {
+		ShortDescription: `remove a subscription`,
+		//line sql.y: 5365
+		Category: hDDL,
+		//line sql.y: 5366
+		Text: `DROP SUBSCRIPTION [ IF EXISTS ] <name> [ CASCADE | RESTRICT ]
+`,
+		//line sql.y: 5367
+		SeeAlso: `WEBDOCS/sql-dropsubscription.html
+`,
+	},
+	//line sql.y: 5437
 	`CREATE STATISTICS`: {
 		ShortDescription: `create a new table statistic`,
-		//line sql.y: 5063
+		//line sql.y: 5438
 		Category: hMisc,
-		//line sql.y: 5064
+		//line sql.y: 5439
 		Text: `
 CREATE STATISTICS <statisticname>
   [ON <colname> [, ...]]
   FROM <tablename> [AS OF SYSTEM TIME <expr>]
 `,
 	},
-	//line sql.y: 5207
+	//line sql.y: 5582
 	`DELETE`: {
 		ShortDescription: `delete rows from a table`,
-		//line sql.y: 5208
+		//line sql.y: 5583
 		Category: hDML,
-		//line sql.y: 5209
+		//line sql.y: 5584
 		Text: `DELETE FROM <tablename> [WHERE <expr>]
               [ORDER BY <exprs...>]
               [LIMIT <expr>]
               [RETURNING <exprs...>]
 `,
-		//line sql.y: 5213
+		//line sql.y: 5588
 		SeeAlso: `WEBDOCS/delete.html
 `,
 	},
-	//line sql.y: 5232
+	//line sql.y: 5607
 	`DISCARD`: {
 		ShortDescription: `reset the session to its initial state`,
-		//line sql.y: 5233
+		//line sql.y: 5608
 		Category: hCfg,
-		//line sql.y: 5234
+		//line sql.y: 5609
 		Text: `DISCARD ALL
 `,
 	},
-	//line sql.y: 5246
+	//line sql.y: 5621
 	`DROP`: {
-		//line sql.y: 5247
+		//line sql.y: 5622
 		Category: hGroup,
-		//line sql.y: 5248
+		//line sql.y: 5623
 		Text: `
 DROP CAST, DROP DATABASE, DROP INDEX, DROP TABLE, DROP VIEW, DROP SEQUENCE,
 DROP USER, DROP ROLE, DROP TYPE
 `,
 	},
-	//line sql.y: 5276
+	//line sql.y: 5654
 	`DROP CAST`: {
 		ShortDescription: `remove a cast`,
-		//line sql.y: 5277
+		//line sql.y: 5655
 		Category: hDDL,
-		//line sql.y: 5278
+		//line sql.y: 5656
 		Text: `DROP CAST [ IF EXISTS ] (source_type AS target_type) [ CASCADE | RESTRICT ]
 `,
-		//line sql.y: 5279
+		//line sql.y: 5657
 		SeeAlso: `WEBDOCS/sql-dropcast.html
 `,
 	},
-	//line sql.y: 5293
+	//line sql.y: 5671
 	`DROP VIEW`: {
 		ShortDescription: `remove a view`,
-		//line sql.y: 5294
+		//line sql.y: 5672
 		Category: hDDL,
-		//line sql.y: 5295
+		//line sql.y: 5673
 		Text: `DROP [MATERIALIZED] VIEW [IF EXISTS] <tablename> [, ...] [CASCADE | RESTRICT]
 `,
-		//line sql.y: 5296
+		//line sql.y: 5674
 		SeeAlso: `WEBDOCS/drop-index.html
 `,
 	},
-	//line sql.y: 5326
+	//line sql.y: 5704
 	`DROP SEQUENCE`: {
 		ShortDescription: `remove a sequence`,
-		//line sql.y: 5327
+		//line sql.y: 5705
 		Category: hDDL,
-		//line sql.y: 5328
+		//line sql.y: 5706
 		Text: `DROP SEQUENCE [IF EXISTS] <sequenceName> [, ...] [CASCADE | RESTRICT]
 `,
-		//line sql.y: 5329
+		//line sql.y: 5707
 		SeeAlso: `DROP
 `,
 	},
-	//line sql.y: 5341
+	//line sql.y: 5719
 	`DROP TABLE`: {
 		ShortDescription: `remove a table`,
-		//line sql.y: 5342
+		//line sql.y: 5720
 		Category: hDDL,
-		//line sql.y: 5343
+		//line sql.y: 5721
 		Text: `DROP TABLE [IF EXISTS] <tablename> [, ...] [CASCADE | RESTRICT]
 `,
-		//line sql.y: 5344
+		//line sql.y: 5722
 		SeeAlso: `WEBDOCS/drop-table.html
 `,
 	},
-	//line sql.y: 5366
+	//line sql.y: 5744
 	`DROP INDEX`: {
 		ShortDescription: `remove an index`,
-		//line sql.y: 5367
+		//line sql.y: 5745
 		Category: hDDL,
-		//line sql.y: 5368
+		//line sql.y: 5746
 		Text: `DROP INDEX [CONCURRENTLY] [IF EXISTS] <idxname> [, ...] [CASCADE | RESTRICT]
 `,
-		//line sql.y: 5369
+		//line sql.y: 5747
 		SeeAlso: `WEBDOCS/drop-index.html
 `,
 	},
-	//line sql.y: 5391
+	//line sql.y: 5769
 	`DROP DATABASE`: {
 		ShortDescription: `remove a database`,
-		//line sql.y: 5392
+		//line sql.y: 5770
 		Category: hDDL,
-		//line sql.y: 5393
+		//line sql.y: 5771
 		Text: `DROP DATABASE [IF EXISTS] <databasename> [ [ WITH ] ( option [, ...] ) ]
 `,
-		//line sql.y: 5394
+		//line sql.y: 5772
 		SeeAlso: `WEBDOCS/drop-database.html
 `,
 	},
-	//line sql.y: 5428
+	//line sql.y: 5806
 	`DROP TYPE`: {
 		ShortDescription: `remove a type`,
-		//line sql.y: 5429
+		//line sql.y: 5807
 		Category: hDDL,
-		//line sql.y: 5430
+		//line sql.y: 5808
 		Text: `DROP TYPE [IF EXISTS] <type_name> [, ...] [CASCADE | RESTRICT]
 `,
 	},
-	//line sql.y: 5460
+	//line sql.y: 5838
 	`DROP SCHEMA`: {
 		ShortDescription: `remove a schema`,
-		//line sql.y: 5461
+		//line sql.y: 5839
 		Category: hDDL,
-		//line sql.y: 5462
+		//line sql.y: 5840
 		Text: `DROP SCHEMA [IF EXISTS] <schema_name> [, ...] [CASCADE | RESTRICT]
 `,
 	},
-	//line sql.y: 5492
+	//line sql.y: 5870
 	`DROP ROLE`: {
 		ShortDescription: `remove a user`,
-		//line sql.y: 5493
+		//line sql.y: 5871
 		Category: hPriv,
-		//line sql.y: 5494
+		//line sql.y: 5872
 		Text: `DROP ROLE [IF EXISTS] <user> [, ...]
 `,
-		//line sql.y: 5495
+		//line sql.y: 5873
 		SeeAlso: `CREATE ROLE, SHOW ROLE
 `,
 	},
-	//line sql.y: 5519
+	//line sql.y: 5897
 	`ANALYZE`: {
 		ShortDescription: `collect table statistics`,
-		//line sql.y: 5520
+		//line sql.y: 5898
 		Category: hMisc,
-		//line sql.y: 5521
+		//line sql.y: 5899
 		Text: `
 ANALYZE [<tablename>]
 
 `,
-		//line sql.y: 5524
+		//line sql.y: 5902
 		SeeAlso: `CREATE STATISTICS
 `,
 	},
-	//line sql.y: 5556
+	//line sql.y: 5934
 	`EXPLAIN`: {
 		ShortDescription: `show the logical plan of a query`,
-		//line sql.y: 5557
+		//line sql.y: 5935
 		Category: hMisc,
-		//line sql.y: 5558
+		//line sql.y: 5936
 		Text: `
 EXPLAIN <statement>
 EXPLAIN ([PLAN ,] <planoptions...> ) <statement>
@@ -645,52 +788,52 @@ Plan options:
     TYPES, VERBOSE, OPT
 
 `,
-		//line sql.y: 5571
+		//line sql.y: 5949
 		SeeAlso: `WEBDOCS/explain.html
 `,
 	},
-	//line sql.y: 5701
+	//line sql.y: 6079
 	`PREPARE`: {
 		ShortDescription: `prepare a statement for later execution`,
-		//line sql.y: 5702
+		//line sql.y: 6080
 		Category: hMisc,
-		//line sql.y: 5703
+		//line sql.y: 6081
 		Text: `PREPARE <name> [ ( <types...> ) ] AS <query>
 `,
-		//line sql.y: 5704
+		//line sql.y: 6082
 		SeeAlso: `EXECUTE, DEALLOCATE, DISCARD
 `,
 	},
-	//line sql.y: 5735
+	//line sql.y: 6113
 	`EXECUTE`: {
 		ShortDescription: `execute a statement prepared previously`,
-		//line sql.y: 5736
+		//line sql.y: 6114
 		Category: hMisc,
-		//line sql.y: 5737
+		//line sql.y: 6115
 		Text: `EXECUTE <name> [ ( <exprs...> ) ]
 `,
-		//line sql.y: 5738
+		//line sql.y: 6116
 		SeeAlso: `PREPARE, DEALLOCATE, DISCARD
 `,
 	},
-	//line sql.y: 5768
+	//line sql.y: 6146
 	`DEALLOCATE`: {
 		ShortDescription: `remove a prepared statement`,
-		//line sql.y: 5769
+		//line sql.y: 6147
 		Category: hMisc,
-		//line sql.y: 5770
+		//line sql.y: 6148
 		Text: `DEALLOCATE [PREPARE] { <name> | ALL }
 `,
-		//line sql.y: 5771
+		//line sql.y: 6149
 		SeeAlso: `PREPARE, EXECUTE, DISCARD
 `,
 	},
-	//line sql.y: 5791
+	//line sql.y: 6169
 	`GRANT`: {
 		ShortDescription: `define access privileges and role memberships`,
-		//line sql.y: 5792
+		//line sql.y: 6170
 		Category: hPriv,
-		//line sql.y: 5793
+		//line sql.y: 6171
 		Text: `
 Grant privileges:
   GRANT {ALL | <privileges...> } ON <targets...> TO <grantees...>
@@ -707,16 +850,16 @@ Targets:
   SCHEMA <schemaname> [, <schemaname>]...
 
 `,
-		//line sql.y: 5808
+		//line sql.y: 6186
 		SeeAlso: `REVOKE, WEBDOCS/grant.html
 `,
 	},
-	//line sql.y: 6017
+	//line sql.y: 6395
 	`REVOKE`: {
 		ShortDescription: `remove access privileges and role memberships`,
-		//line sql.y: 6018
+		//line sql.y: 6396
 		Category: hPriv,
-		//line sql.y: 6019
+		//line sql.y: 6397
 		Text: `
 Revoke privileges:
   REVOKE {ALL | <privileges...> } ON <targets...> FROM <grantees...>
@@ -733,39 +876,39 @@ Targets:
   SCHEMA <schemaname> [, <schemaname]...
 
 `,
-		//line sql.y: 6034
+		//line sql.y: 6412
 		SeeAlso: `GRANT, WEBDOCS/revoke.html
 `,
 	},
-	//line sql.y: 6142
+	//line sql.y: 6520
 	`RESET`: {
 		ShortDescription: `reset a session variable to its default value`,
-		//line sql.y: 6143
+		//line sql.y: 6521
 		Category: hCfg,
-		//line sql.y: 6144
+		//line sql.y: 6522
 		Text: `RESET [SESSION] <var>
 `,
 	},
-	//line sql.y: 6171
+	//line sql.y: 6549
 	`USE`: {
 		ShortDescription: `set the current database`,
-		//line sql.y: 6172
+		//line sql.y: 6550
 		Category: hCfg,
-		//line sql.y: 6173
+		//line sql.y: 6551
 		Text: `USE <dbname>
 
 "USE <dbname>" is an alias for "SET [SESSION] database = <dbname>".
 `,
-		//line sql.y: 6176
+		//line sql.y: 6554
 		SeeAlso: `SET SESSION, WEBDOCS/set-vars.html
 `,
 	},
-	//line sql.y: 6207
+	//line sql.y: 6585
 	`SCRUB`: {
 		ShortDescription: `run checks against databases or tables`,
-		//line sql.y: 6208
+		//line sql.y: 6586
 		Category: hExperimental,
-		//line sql.y: 6209
+		//line sql.y: 6587
 		Text: `
 EXPERIMENTAL SCRUB TABLE <table> ...
 EXPERIMENTAL SCRUB DATABASE <database>
@@ -775,16 +918,16 @@ The various checks that ca be run with SCRUB includes:
   - Secondary index integrity
   - Constraint integrity (NOT NULL, CHECK, FOREIGN KEY, UNIQUE)
 `,
-		//line sql.y: 6217
+		//line sql.y: 6595
 		SeeAlso: `SCRUB TABLE, SCRUB DATABASE
 `,
 	},
-	//line sql.y: 6223
+	//line sql.y: 6601
 	`SCRUB DATABASE`: {
 		ShortDescription: `run scrub checks on a database`,
-		//line sql.y: 6224
+		//line sql.y: 6602
 		Category: hExperimental,
-		//line sql.y: 6225
+		//line sql.y: 6603
 		Text: `
 EXPERIMENTAL SCRUB DATABASE <database>
                             [AS OF SYSTEM TIME <expr>]
@@ -794,16 +937,16 @@ All scrub checks will be run on the database. This includes:
   - Secondary index integrity
   - Constraint integrity (NOT NULL, CHECK, FOREIGN KEY, UNIQUE)
 `,
-		//line sql.y: 6233
+		//line sql.y: 6611
 		SeeAlso: `SCRUB TABLE, SCRUB
 `,
 	},
-	//line sql.y: 6241
+	//line sql.y: 6619
 	`SCRUB TABLE`: {
 		ShortDescription: `run scrub checks on a table`,
-		//line sql.y: 6242
+		//line sql.y: 6620
 		Category: hExperimental,
-		//line sql.y: 6243
+		//line sql.y: 6621
 		Text: `
 SCRUB TABLE <tablename>
             [AS OF SYSTEM TIME <expr>]
@@ -816,16 +959,16 @@ Options:
   EXPERIMENTAL SCRUB TABLE ... WITH OPTIONS CONSTRAINT (<constraint>...)
   EXPERIMENTAL SCRUB TABLE ... WITH OPTIONS PHYSICAL
 `,
-		//line sql.y: 6254
+		//line sql.y: 6632
 		SeeAlso: `SCRUB DATABASE, SRUB
 `,
 	},
-	//line sql.y: 6321
+	//line sql.y: 6699
 	`SET TRANSACTION`: {
 		ShortDescription: `configure the transaction settings`,
-		//line sql.y: 6322
+		//line sql.y: 6700
 		Category: hTxn,
-		//line sql.y: 6323
+		//line sql.y: 6701
 		Text: `
 SET [SESSION] TRANSACTION <txnparameters...>
 
@@ -836,22 +979,22 @@ Transaction parameters:
    [NOT] DEFERRABLE
 `,
 	},
-	//line sql.y: 6345
+	//line sql.y: 6723
 	`SET CONSTRAINTS`: {
 		ShortDescription: `configure the constraints settings`,
-		//line sql.y: 6346
+		//line sql.y: 6724
 		Category: hCfg,
-		//line sql.y: 6347
+		//line sql.y: 6725
 		Text: `
 SET CONSTRAINTS { ALL | name [, ...] } { DEFERRED | IMMEDIATE }
 `,
 	},
-	//line sql.y: 6367
+	//line sql.y: 6745
 	`SET SESSION`: {
 		ShortDescription: `change a session variable`,
-		//line sql.y: 6368
+		//line sql.y: 6746
 		Category: hCfg,
-		//line sql.y: 6369
+		//line sql.y: 6747
 		Text: `
 SET [ SESSION | LOCAL ] <var> { TO | = } <values...>
 SET [ SESSION | LOCAL ] TIME ZONE <tz>
@@ -859,16 +1002,16 @@ SET [ SESSION | LOCAL ] ROLE role_name
 SET [ SESSION | LOCAL ] ROLE NONE
 
 `,
-		//line sql.y: 6375
+		//line sql.y: 6753
 		SeeAlso: `SET TRANSACTION
 WEBDOCS/set-vars.html
 `,
 	},
-	//line sql.y: 6571
+	//line sql.y: 6949
 	`SHOW`: {
-		//line sql.y: 6572
+		//line sql.y: 6950
 		Category: hGroup,
-		//line sql.y: 6573
+		//line sql.y: 6951
 		Text: `
 SHOW BACKUP, SHOW CLUSTER SETTING, SHOW COLUMNS, SHOW CONSTRAINTS,
 SHOW CREATE, SHOW DATABASES, SHOW ENUMS, SHOW HISTOGRAM, SHOW INDEXES, SHOW
@@ -878,24 +1021,24 @@ SHOW STATISTICS, SHOW SYNTAX, SHOW TABLES, SHOW TRACE, SHOW TRANSACTION,
 SHOW TRANSACTIONS, SHOW TYPES, SHOW USERS, SHOW LAST QUERY STATISTICS, SHOW SCHEDULES
 `,
 	},
-	//line sql.y: 6874
+	//line sql.y: 7252
 	`SHOW SESSION`: {
 		ShortDescription: `display session variables`,
-		//line sql.y: 6875
+		//line sql.y: 7253
 		Category: hCfg,
-		//line sql.y: 6876
+		//line sql.y: 7254
 		Text: `SHOW [SESSION] { <var> | ALL }
 `,
-		//line sql.y: 6877
+		//line sql.y: 7255
 		SeeAlso: `WEBDOCS/show-vars.html
 `,
 	},
-	//line sql.y: 6901
+	//line sql.y: 7279
 	`SHOW STATISTICS`: {
 		ShortDescription: `display table statistics (experimental)`,
-		//line sql.y: 6902
+		//line sql.y: 7280
 		Category: hExperimental,
-		//line sql.y: 6903
+		//line sql.y: 7281
 		Text: `SHOW STATISTICS [USING JSON] FOR TABLE <table_name>
 
 Returns the available statistics for a table.
@@ -904,97 +1047,97 @@ be used with SHOW HISTOGRAM.
 If USING JSON is specified, the statistics and histograms
 are encoded in JSON format.
 `,
-		//line sql.y: 6910
+		//line sql.y: 7288
 		SeeAlso: `SHOW HISTOGRAM
 `,
 	},
-	//line sql.y: 6923
+	//line sql.y: 7301
 	`SHOW HISTOGRAM`: {
 		ShortDescription: `display histogram (experimental)`,
-		//line sql.y: 6924
+		//line sql.y: 7302
 		Category: hExperimental,
-		//line sql.y: 6925
+		//line sql.y: 7303
 		Text: `SHOW HISTOGRAM <histogram_id>
 
 Returns the data in the histogram with the
 given ID (as returned by SHOW STATISTICS).
 `,
-		//line sql.y: 6929
+		//line sql.y: 7307
 		SeeAlso: `SHOW STATISTICS
 `,
 	},
-	//line sql.y: 6942
+	//line sql.y: 7320
 	`SHOW BACKUP`: {
 		ShortDescription: `list backup contents`,
-		//line sql.y: 6943
+		//line sql.y: 7321
 		Category: hCCL,
-		//line sql.y: 6944
+		//line sql.y: 7322
 		Text: `SHOW BACKUP [SCHEMAS|FILES|RANGES] <location>
 `,
-		//line sql.y: 6945
+		//line sql.y: 7323
 		SeeAlso: `WEBDOCS/show-backup.html
 `,
 	},
-	//line sql.y: 6999
+	//line sql.y: 7377
 	`SHOW COLUMNS`: {
 		ShortDescription: `list columns in relation`,
-		//line sql.y: 7000
+		//line sql.y: 7378
 		Category: hDDL,
-		//line sql.y: 7001
+		//line sql.y: 7379
 		Text: `SHOW COLUMNS FROM <tablename>
 `,
-		//line sql.y: 7002
+		//line sql.y: 7380
 		SeeAlso: `WEBDOCS/show-columns.html
 `,
 	},
-	//line sql.y: 7010
+	//line sql.y: 7388
 	`SHOW PARTITIONS`: {
 		ShortDescription: `list partition information`,
-		//line sql.y: 7011
+		//line sql.y: 7389
 		Category: hDDL,
-		//line sql.y: 7012
+		//line sql.y: 7390
 		Text: `SHOW PARTITIONS FROM { TABLE <table> | INDEX <index> | DATABASE <database> }
 `,
-		//line sql.y: 7013
+		//line sql.y: 7391
 		SeeAlso: `WEBDOCS/show-partitions.html
 `,
 	},
-	//line sql.y: 7033
+	//line sql.y: 7411
 	`SHOW DATABASES`: {
 		ShortDescription: `list databases`,
-		//line sql.y: 7034
+		//line sql.y: 7412
 		Category: hDDL,
-		//line sql.y: 7035
+		//line sql.y: 7413
 		Text: `SHOW DATABASES
 `,
-		//line sql.y: 7036
+		//line sql.y: 7414
 		SeeAlso: `WEBDOCS/show-databases.html
 `,
 	},
-	//line sql.y: 7044
+	//line sql.y: 7422
 	`SHOW ENUMS`: {
 		ShortDescription: `list enums`,
-		//line sql.y: 7045
+		//line sql.y: 7423
 		Category: hMisc,
-		//line sql.y: 7046
+		//line sql.y: 7424
 		Text: `SHOW ENUMS
 `,
 	},
-	//line sql.y: 7054
+	//line sql.y: 7432
 	`SHOW TYPES`: {
 		ShortDescription: `list user defined types`,
-		//line sql.y: 7055
+		//line sql.y: 7433
 		Category: hMisc,
-		//line sql.y: 7056
+		//line sql.y: 7434
 		Text: `SHOW TYPES
 `,
 	},
-	//line sql.y: 7064
+	//line sql.y: 7442
 	`SHOW GRANTS`: {
 		ShortDescription: `list grants`,
-		//line sql.y: 7065
+		//line sql.y: 7443
 		Category: hPriv,
-		//line sql.y: 7066
+		//line sql.y: 7444
 		Text: `
 Show privilege grants:
   SHOW GRANTS [ON <targets...>] [FOR <users...>]
@@ -1002,219 +1145,219 @@ Show role grants:
   SHOW GRANTS ON ROLE [<roles...>] [FOR <grantees...>]
 
 `,
-		//line sql.y: 7072
+		//line sql.y: 7450
 		SeeAlso: `WEBDOCS/show-grants.html
 `,
 	},
-	//line sql.y: 7085
+	//line sql.y: 7463
 	`SHOW INDEXES`: {
 		ShortDescription: `list indexes`,
-		//line sql.y: 7086
+		//line sql.y: 7464
 		Category: hDDL,
-		//line sql.y: 7087
+		//line sql.y: 7465
 		Text: `SHOW INDEXES FROM { <tablename> | DATABASE <database_name> } [WITH COMMENT]
 `,
-		//line sql.y: 7088
+		//line sql.y: 7466
 		SeeAlso: `WEBDOCS/show-index.html
 `,
 	},
-	//line sql.y: 7118
+	//line sql.y: 7496
 	`SHOW CONSTRAINTS`: {
 		ShortDescription: `list constraints`,
-		//line sql.y: 7119
+		//line sql.y: 7497
 		Category: hDDL,
-		//line sql.y: 7120
+		//line sql.y: 7498
 		Text: `SHOW CONSTRAINTS FROM <tablename>
 `,
-		//line sql.y: 7121
+		//line sql.y: 7499
 		SeeAlso: `WEBDOCS/show-constraints.html
 `,
 	},
-	//line sql.y: 7134
+	//line sql.y: 7512
 	`SHOW QUERIES`: {
 		ShortDescription: `list running queries`,
-		//line sql.y: 7135
+		//line sql.y: 7513
 		Category: hMisc,
-		//line sql.y: 7136
+		//line sql.y: 7514
 		Text: `SHOW [ALL] [CLUSTER | LOCAL] QUERIES
 `,
-		//line sql.y: 7137
+		//line sql.y: 7515
 		SeeAlso: `CANCEL QUERIES
 `,
 	},
-	//line sql.y: 7158
+	//line sql.y: 7536
 	`SHOW JOBS`: {
 		ShortDescription: `list background jobs`,
-		//line sql.y: 7159
+		//line sql.y: 7537
 		Category: hMisc,
-		//line sql.y: 7160
+		//line sql.y: 7538
 		Text: `
 SHOW [AUTOMATIC] JOBS [select clause]
 SHOW JOBS FOR SCHEDULES [select clause]
 SHOW JOB <jobid>
 `,
-		//line sql.y: 7164
+		//line sql.y: 7542
 		SeeAlso: `CANCEL JOBS, PAUSE JOBS, RESUME JOBS
 `,
 	},
-	//line sql.y: 7208
+	//line sql.y: 7586
 	`SHOW SCHEDULES`: {
 		ShortDescription: `list periodic schedules`,
-		//line sql.y: 7209
+		//line sql.y: 7587
 		Category: hMisc,
-		//line sql.y: 7210
+		//line sql.y: 7588
 		Text: `
 SHOW [RUNNING | PAUSED] SCHEDULES [FOR BACKUP]
 SHOW SCHEDULE <schedule_id>
 `,
-		//line sql.y: 7213
+		//line sql.y: 7591
 		SeeAlso: `PAUSE SCHEDULES, RESUME SCHEDULES, DROP SCHEDULES
 `,
 	},
-	//line sql.y: 7260
+	//line sql.y: 7638
 	`SHOW TRACE`: {
 		ShortDescription: `display an execution trace`,
-		//line sql.y: 7261
+		//line sql.y: 7639
 		Category: hMisc,
-		//line sql.y: 7262
+		//line sql.y: 7640
 		Text: `
 SHOW [COMPACT] [KV] TRACE FOR SESSION
 `,
-		//line sql.y: 7264
+		//line sql.y: 7642
 		SeeAlso: `EXPLAIN
 `,
 	},
-	//line sql.y: 7287
+	//line sql.y: 7665
 	`SHOW SESSIONS`: {
 		ShortDescription: `list open client sessions`,
-		//line sql.y: 7288
+		//line sql.y: 7666
 		Category: hMisc,
-		//line sql.y: 7289
+		//line sql.y: 7667
 		Text: `SHOW [ALL] [CLUSTER | LOCAL] SESSIONS
 `,
-		//line sql.y: 7290
+		//line sql.y: 7668
 		SeeAlso: `CANCEL SESSIONS
 `,
 	},
-	//line sql.y: 7303
+	//line sql.y: 7681
 	`SHOW TABLES`: {
 		ShortDescription: `list tables`,
-		//line sql.y: 7304
+		//line sql.y: 7682
 		Category: hDDL,
-		//line sql.y: 7305
+		//line sql.y: 7683
 		Text: `SHOW TABLES [FROM <databasename> [ . <schemaname> ] ] [WITH COMMENT]
 `,
-		//line sql.y: 7306
+		//line sql.y: 7684
 		SeeAlso: `WEBDOCS/show-tables.html
 `,
 	},
-	//line sql.y: 7334
+	//line sql.y: 7712
 	`SHOW TRANSACTIONS`: {
 		ShortDescription: `list open client transactions across the cluster`,
-		//line sql.y: 7335
+		//line sql.y: 7713
 		Category: hMisc,
-		//line sql.y: 7336
+		//line sql.y: 7714
 		Text: `SHOW [ALL] [CLUSTER | LOCAL] TRANSACTIONS
 `,
 	},
-	//line sql.y: 7353
+	//line sql.y: 7731
 	`SHOW SCHEMAS`: {
 		ShortDescription: `list schemas`,
-		//line sql.y: 7354
+		//line sql.y: 7732
 		Category: hDDL,
-		//line sql.y: 7355
+		//line sql.y: 7733
 		Text: `SHOW SCHEMAS [FROM <databasename> ]
 `,
 	},
-	//line sql.y: 7367
+	//line sql.y: 7745
 	`SHOW SEQUENCES`: {
 		ShortDescription: `list sequences`,
-		//line sql.y: 7368
+		//line sql.y: 7746
 		Category: hDDL,
-		//line sql.y: 7369
+		//line sql.y: 7747
 		Text: `SHOW SEQUENCES [FROM <databasename> ]
 `,
 	},
-	//line sql.y: 7381
+	//line sql.y: 7759
 	`SHOW SYNTAX`: {
 		ShortDescription: `analyze SQL syntax`,
-		//line sql.y: 7382
+		//line sql.y: 7760
 		Category: hMisc,
-		//line sql.y: 7383
+		//line sql.y: 7761
 		Text: `SHOW SYNTAX <string>
 `,
 	},
-	//line sql.y: 7392
+	//line sql.y: 7770
 	`SHOW LAST QUERY STATISTICS`: {
 		ShortDescription: `display statistics for the last query issued`,
-		//line sql.y: 7393
+		//line sql.y: 7771
 		Category: hMisc,
-		//line sql.y: 7394
+		//line sql.y: 7772
 		Text: `SHOW LAST QUERY STATISTICS
 `,
 	},
-	//line sql.y: 7402
+	//line sql.y: 7780
 	`SHOW SAVEPOINT`: {
 		ShortDescription: `display current savepoint properties`,
-		//line sql.y: 7403
+		//line sql.y: 7781
 		Category: hCfg,
-		//line sql.y: 7404
+		//line sql.y: 7782
 		Text: `SHOW SAVEPOINT STATUS
 `,
 	},
-	//line sql.y: 7412
+	//line sql.y: 7790
 	`SHOW TRANSACTION`: {
 		ShortDescription: `display current transaction properties`,
-		//line sql.y: 7413
+		//line sql.y: 7791
 		Category: hCfg,
-		//line sql.y: 7414
+		//line sql.y: 7792
 		Text: `SHOW TRANSACTION {ISOLATION LEVEL | PRIORITY | STATUS}
 `,
-		//line sql.y: 7415
+		//line sql.y: 7793
 		SeeAlso: `WEBDOCS/show-transaction.html
 `,
 	},
-	//line sql.y: 7434
+	//line sql.y: 7812
 	`SHOW CREATE`: {
 		ShortDescription: `display the CREATE statement for a table, sequence or view`,
-		//line sql.y: 7435
+		//line sql.y: 7813
 		Category: hDDL,
-		//line sql.y: 7436
+		//line sql.y: 7814
 		Text: `SHOW CREATE [ TABLE | SEQUENCE | VIEW ] <tablename>
 `,
-		//line sql.y: 7437
+		//line sql.y: 7815
 		SeeAlso: `WEBDOCS/show-create-table.html
 `,
 	},
-	//line sql.y: 7455
+	//line sql.y: 7833
 	`SHOW USERS`: {
 		ShortDescription: `list defined users`,
-		//line sql.y: 7456
+		//line sql.y: 7834
 		Category: hPriv,
-		//line sql.y: 7457
+		//line sql.y: 7835
 		Text: `SHOW USERS
 `,
-		//line sql.y: 7458
+		//line sql.y: 7836
 		SeeAlso: `CREATE USER, DROP USER, WEBDOCS/show-users.html
 `,
 	},
-	//line sql.y: 7466
+	//line sql.y: 7844
 	`SHOW ROLES`: {
 		ShortDescription: `list defined roles`,
-		//line sql.y: 7467
+		//line sql.y: 7845
 		Category: hPriv,
-		//line sql.y: 7468
+		//line sql.y: 7846
 		Text: `SHOW ROLES
 `,
-		//line sql.y: 7469
+		//line sql.y: 7847
 		SeeAlso: `CREATE ROLE, ALTER ROLE, DROP ROLE
 `,
 	},
-	//line sql.y: 7691
+	//line sql.y: 8069
 	`PAUSE`: {
-		//line sql.y: 7692
+		//line sql.y: 8070
 		Category: hMisc,
-		//line sql.y: 7693
+		//line sql.y: 8071
 		Text: `
 
 Pause various background tasks and activities.
@@ -1222,11 +1365,11 @@ Pause various background tasks and activities.
 PAUSE JOBS, PAUSE SCHEDULES
 `,
 	},
-	//line sql.y: 7703
+	//line sql.y: 8081
 	`RESUME`: {
-		//line sql.y: 7704
+		//line sql.y: 8082
 		Category: hMisc,
-		//line sql.y: 7705
+		//line sql.y: 8083
 		Text: `
 
 Resume various background tasks and activities.
@@ -1234,51 +1377,51 @@ Resume various background tasks and activities.
 RESUME JOBS, RESUME SCHEDULES
 `,
 	},
-	//line sql.y: 7715
+	//line sql.y: 8093
 	`PAUSE JOBS`: {
 		ShortDescription: `pause background jobs`,
-		//line sql.y: 7716
+		//line sql.y: 8094
 		Category: hMisc,
-		//line sql.y: 7717
+		//line sql.y: 8095
 		Text: `
 PAUSE JOBS <selectclause>
 PAUSE JOB <jobid>
 `,
-		//line sql.y: 7720
+		//line sql.y: 8098
 		SeeAlso: `SHOW JOBS, CANCEL JOBS, RESUME JOBS
 `,
 	},
-	//line sql.y: 7755
+	//line sql.y: 8133
 	`PAUSE SCHEDULES`: {
 		ShortDescription: `pause scheduled jobs`,
-		//line sql.y: 7756
+		//line sql.y: 8134
 		Category: hMisc,
-		//line sql.y: 7757
+		//line sql.y: 8135
 		Text: `
 PAUSE SCHEDULES <selectclause>
   select clause: select statement returning schedule id to pause.
 PAUSE SCHEDULE <scheduleID>
 `,
-		//line sql.y: 7761
+		//line sql.y: 8139
 		SeeAlso: `RESUME SCHEDULES, SHOW JOBS, CANCEL JOBS
 `,
 	},
-	//line sql.y: 7782
+	//line sql.y: 8160
 	`CREATE SCHEMA`: {
 		ShortDescription: `create a new schema`,
-		//line sql.y: 7783
+		//line sql.y: 8161
 		Category: hDDL,
-		//line sql.y: 7784
+		//line sql.y: 8162
 		Text: `
 CREATE SCHEMA [IF NOT EXISTS] { <schemaname> | [<schemaname>] AUTHORIZATION <rolename> }
 `,
 	},
-	//line sql.y: 7843
+	//line sql.y: 8221
 	`ALTER SCHEMA`: {
 		ShortDescription: `alter an existing schema`,
-		//line sql.y: 7844
+		//line sql.y: 8222
 		Category: hDDL,
-		//line sql.y: 7845
+		//line sql.y: 8223
 		Text: `
 
 Commands:
@@ -1286,12 +1429,12 @@ Commands:
   ALTER SCHEMA ... OWNER TO {<newowner> | CURRENT_ROLE | CURRENT_USER | SESSION_USER }
 `,
 	},
-	//line sql.y: 7871
+	//line sql.y: 8249
 	`CREATE TABLE`: {
 		ShortDescription: `create a new table`,
-		//line sql.y: 7872
+		//line sql.y: 8250
 		Category: hDDL,
-		//line sql.y: 7873
+		//line sql.y: 8251
 		Text: `
 CREATE [[GLOBAL | LOCAL] {TEMPORARY | TEMP}] TABLE [IF NOT EXISTS] <tablename> ( <elements...> ) [<interleave>] [<on_commit>]
 CREATE [[GLOBAL | LOCAL] {TEMPORARY | TEMP}] TABLE [IF NOT EXISTS] <tablename> [( <colnames...> )] AS <source> [<interleave>] [<on commit>]
@@ -1323,18 +1466,18 @@ On commit clause:
    ON COMMIT {PRESERVE ROWS | DROP | DELETE ROWS}
 
 `,
-		//line sql.y: 7903
+		//line sql.y: 8281
 		SeeAlso: `SHOW TABLES, CREATE VIEW, SHOW CREATE,
 WEBDOCS/create-table.html
 WEBDOCS/create-table-as.html
 `,
 	},
-	//line sql.y: 8770
+	//line sql.y: 9148
 	`CREATE SEQUENCE`: {
 		ShortDescription: `create a new sequence`,
-		//line sql.y: 8771
+		//line sql.y: 9149
 		Category: hDDL,
-		//line sql.y: 8772
+		//line sql.y: 9150
 		Text: `
 CREATE [ { TEMPORARY | TEMP } | UNLOGGED ] SEQUENCE [ IF NOT EXISTS ] name
    [ AS data_type ]
@@ -1344,76 +1487,76 @@ CREATE [ { TEMPORARY | TEMP } | UNLOGGED ] SEQUENCE [ IF NOT EXISTS ] name
    [ OWNED BY { table_name.column_name | NONE } ]
 
 `,
-		//line sql.y: 8780
+		//line sql.y: 9158
 		SeeAlso: `CREATE TABLE
 `,
 	},
-	//line sql.y: 8965
+	//line sql.y: 9343
 	`TRUNCATE`: {
 		ShortDescription: `empty one or more tables`,
-		//line sql.y: 8966
+		//line sql.y: 9344
 		Category: hDML,
-		//line sql.y: 8967
+		//line sql.y: 9345
 		Text: `TRUNCATE [TABLE] <tablename> [, ...] [CASCADE | RESTRICT]
 `,
-		//line sql.y: 8968
+		//line sql.y: 9346
 		SeeAlso: `WEBDOCS/truncate.html
 `,
 	},
-	//line sql.y: 9236
+	//line sql.y: 9614
 	`CREATE ROLE`: {
 		ShortDescription: `define a new role`,
-		//line sql.y: 9237
+		//line sql.y: 9615
 		Category: hPriv,
-		//line sql.y: 9238
+		//line sql.y: 9616
 		Text: `CREATE ROLE [IF NOT EXISTS] <name> [ [WITH] <OPTIONS...> ]
 `,
-		//line sql.y: 9239
+		//line sql.y: 9617
 		SeeAlso: `ALTER ROLE, DROP ROLE, SHOW ROLES
 `,
 	},
-	//line sql.y: 9251
+	//line sql.y: 9629
 	`ALTER ROLE`: {
 		ShortDescription: `alter a role`,
-		//line sql.y: 9252
+		//line sql.y: 9630
 		Category: hPriv,
-		//line sql.y: 9253
+		//line sql.y: 9631
 		Text: `ALTER ROLE <name> [WITH] <options...>
 `,
-		//line sql.y: 9254
+		//line sql.y: 9632
 		SeeAlso: `CREATE ROLE, DROP ROLE, SHOW ROLES
 `,
 	},
-	//line sql.y: 9289
+	//line sql.y: 9667
 	`CREATE VIEW`: {
 		ShortDescription: `create a new view`,
-		//line sql.y: 9290
+		//line sql.y: 9668
 		Category: hDDL,
-		//line sql.y: 9291
+		//line sql.y: 9669
 		Text: `CREATE [ OR REPLACE ] [ TEMP | TEMPORARY ] [ RECURSIVE ] VIEW name [ ( column_name [, ...] ) ]
    	  [ WITH ( view_option_name [= view_option_value] [, ... ] ) ]
    	  AS <source>
    	  [ WITH [ CASCADED | LOCAL ] CHECK OPTION ]
 `,
-		//line sql.y: 9295
+		//line sql.y: 9673
 		SeeAlso: `CREATE TABLE, SHOW CREATE, WEBDOCS/create-view.html
 `,
 	},
-	//line sql.y: 9526
+	//line sql.y: 9904
 	`CREATE TYPE`: {
 		ShortDescription: `- create a type`,
-		//line sql.y: 9527
+		//line sql.y: 9905
 		Category: hDDL,
-		//line sql.y: 9528
+		//line sql.y: 9906
 		Text: `CREATE TYPE <type_name> AS ENUM (...)
 `,
 	},
-	//line sql.y: 9714
+	//line sql.y: 10092
 	`CREATE INDEX`: {
 		ShortDescription: `create a new index`,
-		//line sql.y: 9715
+		//line sql.y: 10093
 		Category: hDDL,
-		//line sql.y: 9716
+		//line sql.y: 10094
 		Text: `
 CREATE [UNIQUE] INDEX [CONCURRENTLY] [IF NOT EXISTS] [<idxname>]
        ON [ONLY] <tablename> [USING <method>]
@@ -1425,38 +1568,38 @@ CREATE [UNIQUE] INDEX [CONCURRENTLY] [IF NOT EXISTS] [<idxname>]
        [WHERE <where_conds...>]
 `,
 	},
-	//line sql.y: 10072
+	//line sql.y: 10450
 	`RELEASE`: {
 		ShortDescription: `complete a sub-transaction`,
-		//line sql.y: 10073
+		//line sql.y: 10451
 		Category: hTxn,
-		//line sql.y: 10074
+		//line sql.y: 10452
 		Text: `RELEASE [SAVEPOINT] <savepoint name>
 `,
-		//line sql.y: 10075
+		//line sql.y: 10453
 		SeeAlso: `SAVEPOINT, WEBDOCS/savepoint.html
 `,
 	},
-	//line sql.y: 10083
+	//line sql.y: 10461
 	`RESUME JOBS`: {
 		ShortDescription: `resume background jobs`,
-		//line sql.y: 10084
+		//line sql.y: 10462
 		Category: hMisc,
-		//line sql.y: 10085
+		//line sql.y: 10463
 		Text: `
 RESUME JOBS <selectclause>
 RESUME JOB <jobid>
 `,
-		//line sql.y: 10088
+		//line sql.y: 10466
 		SeeAlso: `SHOW JOBS, CANCEL JOBS, PAUSE JOBS
 `,
 	},
-	//line sql.y: 10110
+	//line sql.y: 10488
 	`RESUME SCHEDULES`: {
 		ShortDescription: `resume executing scheduled jobs`,
-		//line sql.y: 10111
+		//line sql.y: 10489
 		Category: hMisc,
-		//line sql.y: 10112
+		//line sql.y: 10490
 		Text: `
 RESUME SCHEDULES <selectclause>
  selectclause: select statement returning schedule IDs to resume.
@@ -1464,16 +1607,16 @@ RESUME SCHEDULES <selectclause>
 RESUME SCHEDULES <jobid>
 
 `,
-		//line sql.y: 10118
+		//line sql.y: 10496
 		SeeAlso: `PAUSE SCHEDULES, SHOW JOBS, RESUME JOBS
 `,
 	},
-	//line sql.y: 10139
+	//line sql.y: 10517
 	`DROP SCHEDULES`: {
 		ShortDescription: `destroy specified schedules`,
-		//line sql.y: 10140
+		//line sql.y: 10518
 		Category: hMisc,
-		//line sql.y: 10141
+		//line sql.y: 10519
 		Text: `
 DROP SCHEDULES <selectclause>
  selectclause: select statement returning schedule IDs to resume.
@@ -1481,28 +1624,28 @@ DROP SCHEDULES <selectclause>
 DROP SCHEDULES <jobid>
 
 `,
-		//line sql.y: 10147
+		//line sql.y: 10525
 		SeeAlso: `PAUSE SCHEDULES, SHOW JOBS, CANCEL JOBS
 `,
 	},
-	//line sql.y: 10168
+	//line sql.y: 10546
 	`SAVEPOINT`: {
 		ShortDescription: `start a sub-transaction`,
-		//line sql.y: 10169
+		//line sql.y: 10547
 		Category: hTxn,
-		//line sql.y: 10170
+		//line sql.y: 10548
 		Text: `SAVEPOINT <savepoint name>
 `,
-		//line sql.y: 10171
+		//line sql.y: 10549
 		SeeAlso: `RELEASE, WEBDOCS/savepoint.html
 `,
 	},
-	//line sql.y: 10186
+	//line sql.y: 10564
 	`BEGIN`: {
 		ShortDescription: `start a transaction`,
-		//line sql.y: 10187
+		//line sql.y: 10565
 		Category: hTxn,
-		//line sql.y: 10188
+		//line sql.y: 10566
 		Text: `
 BEGIN [TRANSACTION] [ <txnparameter> [[,] ...] ]
 START TRANSACTION [ <txnparameter> [[,] ...] ]
@@ -1512,56 +1655,56 @@ Transaction parameters:
    PRIORITY { LOW | NORMAL | HIGH }
 
 `,
-		//line sql.y: 10196
+		//line sql.y: 10574
 		SeeAlso: `COMMIT, ROLLBACK, WEBDOCS/begin-transaction.html
 `,
 	},
-	//line sql.y: 10209
+	//line sql.y: 10587
 	`COMMIT`: {
 		ShortDescription: `commit the current transaction`,
-		//line sql.y: 10210
+		//line sql.y: 10588
 		Category: hTxn,
-		//line sql.y: 10211
+		//line sql.y: 10589
 		Text: `
 COMMIT [TRANSACTION]
 END [TRANSACTION]
 `,
-		//line sql.y: 10214
+		//line sql.y: 10592
 		SeeAlso: `BEGIN, ROLLBACK, WEBDOCS/commit-transaction.html
 `,
 	},
-	//line sql.y: 10241
+	//line sql.y: 10619
 	`ROLLBACK`: {
 		ShortDescription: `abort the current (sub-)transaction`,
-		//line sql.y: 10242
+		//line sql.y: 10620
 		Category: hTxn,
-		//line sql.y: 10243
+		//line sql.y: 10621
 		Text: `
 ROLLBACK [TRANSACTION]
 ROLLBACK [TRANSACTION] TO [SAVEPOINT] <savepoint name>
 `,
-		//line sql.y: 10246
+		//line sql.y: 10624
 		SeeAlso: `BEGIN, COMMIT, SAVEPOINT, WEBDOCS/rollback-transaction.html
 `,
 	},
-	//line sql.y: 10362
+	//line sql.y: 10740
 	`CREATE DATABASE`: {
 		ShortDescription: `create a new database`,
-		//line sql.y: 10363
+		//line sql.y: 10741
 		Category: hDDL,
-		//line sql.y: 10364
+		//line sql.y: 10742
 		Text: `CREATE DATABASE [IF NOT EXISTS] <name>
 `,
-		//line sql.y: 10365
+		//line sql.y: 10743
 		SeeAlso: `WEBDOCS/create-database.html
 `,
 	},
-	//line sql.y: 10579
+	//line sql.y: 10957
 	`INSERT`: {
 		ShortDescription: `create new rows in a table`,
-		//line sql.y: 10580
+		//line sql.y: 10958
 		Category: hDML,
-		//line sql.y: 10581
+		//line sql.y: 10959
 		Text: `
 INSERT INTO <tablename> [[AS] <name>] [( <colnames...> )]
        <selectclause>
@@ -1571,31 +1714,31 @@ INSERT INTO <tablename> [[AS] <name>] [( <colnames...> )]
        }
        [RETURNING <exprs...>]
 `,
-		//line sql.y: 10589
+		//line sql.y: 10967
 		SeeAlso: `UPSERT, UPDATE, DELETE, WEBDOCS/insert.html
 `,
 	},
-	//line sql.y: 10608
+	//line sql.y: 10986
 	`UPSERT`: {
 		ShortDescription: `create or replace rows in a table`,
-		//line sql.y: 10609
+		//line sql.y: 10987
 		Category: hDML,
-		//line sql.y: 10610
+		//line sql.y: 10988
 		Text: `
 UPSERT INTO <tablename> [AS <name>] [( <colnames...> )]
        <selectclause>
        [RETURNING <exprs...>]
 `,
-		//line sql.y: 10614
+		//line sql.y: 10992
 		SeeAlso: `INSERT, UPDATE, DELETE, WEBDOCS/upsert.html
 `,
 	},
-	//line sql.y: 10730
+	//line sql.y: 11108
 	`UPDATE`: {
 		ShortDescription: `update rows of a table`,
-		//line sql.y: 10731
+		//line sql.y: 11109
 		Category: hDML,
-		//line sql.y: 10732
+		//line sql.y: 11110
 		Text: `
 UPDATE <tablename> [[AS] <name>]
        SET ...
@@ -1604,16 +1747,16 @@ UPDATE <tablename> [[AS] <name>]
        [LIMIT <expr>]
        [RETURNING <exprs...>]
 `,
-		//line sql.y: 10739
+		//line sql.y: 11117
 		SeeAlso: `INSERT, UPSERT, DELETE, WEBDOCS/update.html
 `,
 	},
-	//line sql.y: 10964
+	//line sql.y: 11342
 	`<SELECTCLAUSE>`: {
 		ShortDescription: `access tabular data`,
-		//line sql.y: 10965
+		//line sql.y: 11343
 		Category: hDML,
-		//line sql.y: 10966
+		//line sql.y: 11344
 		Text: `
 Select clause:
   TABLE <tablename>
@@ -1621,12 +1764,12 @@ Select clause:
   SELECT ... [ { INTERSECT | UNION | EXCEPT } [ ALL | DISTINCT ] <selectclause> ]
 `,
 	},
-	//line sql.y: 11001
+	//line sql.y: 11379
 	`SELECT`: {
 		ShortDescription: `retrieve rows from a data source and compute a result`,
-		//line sql.y: 11002
+		//line sql.y: 11380
 		Category: hDML,
-		//line sql.y: 11003
+		//line sql.y: 11381
 		Text: `
 SELECT [DISTINCT [ ON ( <expr> [ , ... ] ) ] ]
        { <expr> [[AS] <name>] | [ [<dbname>.] <tablename>. ] * } [, ...]
@@ -1640,40 +1783,40 @@ SELECT [DISTINCT [ ON ( <expr> [ , ... ] ) ] ]
        [ LIMIT { <expr> | ALL } ]
        [ OFFSET <expr> [ ROW | ROWS ] ]
 `,
-		//line sql.y: 11015
+		//line sql.y: 11393
 		SeeAlso: `WEBDOCS/select-clause.html
 `,
 	},
-	//line sql.y: 11101
+	//line sql.y: 11479
 	`TABLE`: {
 		ShortDescription: `select an entire table`,
-		//line sql.y: 11102
+		//line sql.y: 11480
 		Category: hDML,
-		//line sql.y: 11103
+		//line sql.y: 11481
 		Text: `TABLE <tablename>
 `,
-		//line sql.y: 11104
+		//line sql.y: 11482
 		SeeAlso: `SELECT, VALUES, WEBDOCS/table-expressions.html
 `,
 	},
-	//line sql.y: 11459
+	//line sql.y: 11837
 	`VALUES`: {
 		ShortDescription: `select a given set of values`,
-		//line sql.y: 11460
+		//line sql.y: 11838
 		Category: hDML,
-		//line sql.y: 11461
+		//line sql.y: 11839
 		Text: `VALUES ( <exprs...> ) [, ...]
 `,
-		//line sql.y: 11462
+		//line sql.y: 11840
 		SeeAlso: `SELECT, TABLE, WEBDOCS/table-expressions.html
 `,
 	},
-	//line sql.y: 11571
+	//line sql.y: 11949
 	`<SOURCE>`: {
 		ShortDescription: `define a data source for SELECT`,
-		//line sql.y: 11572
+		//line sql.y: 11950
 		Category: hDML,
-		//line sql.y: 11573
+		//line sql.y: 11951
 		Text: `
 Data sources:
   <tablename> [ @ { <idxname> | <indexflags> } ]
@@ -1697,7 +1840,7 @@ Join types:
   { INNER | { LEFT | RIGHT | FULL } [OUTER] } [ { HASH | MERGE | LOOKUP } ]
 
 `,
-		//line sql.y: 11595
+		//line sql.y: 11973
 		SeeAlso: `WEBDOCS/table-expressions.html
 `,
 	},
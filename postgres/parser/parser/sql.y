@@ -441,6 +441,9 @@ func (u *sqlSymUnion) idxElems() tree.IndexElemList {
 func (u *sqlSymUnion) dropBehavior() tree.DropBehavior {
     return u.val.(tree.DropBehavior)
 }
+func (u *sqlSymUnion) policyCommand() tree.PolicyCommand {
+    return u.val.(tree.PolicyCommand)
+}
 func (u *sqlSymUnion) validationBehavior() tree.ValidationBehavior {
     return u.val.(tree.ValidationBehavior)
 }
@@ -693,6 +696,12 @@ func (u *sqlSymUnion) createAggOption() tree.CreateAggOption {
 func (u *sqlSymUnion) createAggOptions() []tree.CreateAggOption {
     return u.val.([]tree.CreateAggOption)
 }
+func (u *sqlSymUnion) publicationObject() tree.PublicationObject {
+    return u.val.(tree.PublicationObject)
+}
+func (u *sqlSymUnion) publicationObjects() tree.PublicationObjects {
+    return u.val.(tree.PublicationObjects)
+}
 func (u *sqlSymUnion) aggregatesToDrop() []tree.AggregateToDrop {
     return u.val.([]tree.AggregateToDrop)
 }
@@ -881,6 +890,9 @@ func (u *sqlSymUnion) vacuumTableAndColsList() tree.VacuumTableAndColsList {
 %type <tree.Statement> alter_materialized_view_stmt
 %type <tree.Statement> alter_function_stmt
 %type <tree.Statement> alter_language_stmt
+%type <tree.Statement> alter_policy_stmt
+%type <tree.Statement> alter_publication_stmt
+%type <tree.Statement> alter_subscription_stmt
 %type <tree.Statement> alter_procedure_stmt
 %type <tree.Statement> alter_view_stmt
 %type <tree.Statement> alter_sequence_stmt
@@ -959,6 +971,9 @@ func (u *sqlSymUnion) vacuumTableAndColsList() tree.VacuumTableAndColsList {
 %type <tree.Statement> create_role_stmt
 %type <tree.Statement> create_schedule_for_backup_stmt
 %type <tree.Statement> create_extension_stmt
+%type <tree.Statement> create_policy_stmt
+%type <tree.Statement> create_publication_stmt
+%type <tree.Statement> create_subscription_stmt
 %type <tree.Statement> create_function_stmt
 %type <tree.Statement> create_language_stmt
 %type <tree.Statement> create_procedure_stmt
@@ -1002,6 +1017,15 @@ func (u *sqlSymUnion) vacuumTableAndColsList() tree.VacuumTableAndColsList {
 %type <tree.Statement> drop_domain_stmt
 %type <tree.Statement> drop_sequence_stmt
 %type <tree.Statement> drop_extension_stmt
+%type <tree.Statement> drop_policy_stmt
+%type <tree.Statement> drop_publication_stmt
+%type <bool> opt_policy_restrictive
+%type <tree.PolicyCommand> opt_policy_command
+%type <[]string> opt_policy_roles
+%type <tree.Expr> opt_policy_using opt_policy_with_check
+%type <tree.Statement> drop_subscription_stmt
+%type <tree.PublicationObject> publication_object
+%type <tree.PublicationObjects> publication_object_list
 %type <tree.Statement> drop_language_stmt
 %type <tree.Statement> drop_function_stmt
 %type <tree.Statement> drop_procedure_stmt
@@ -1113,6 +1137,8 @@ func (u *sqlSymUnion) vacuumTableAndColsList() tree.VacuumTableAndColsList {
 %type <str> import_format
 %type <tree.StorageParam> storage_parameter
 %type <[]tree.StorageParam> storage_parameter_list opt_table_with opt_with_storage_parameter_list attribution_list
+%type <tree.StorageParam> subscription_option
+%type <[]tree.StorageParam> subscription_option_list opt_subscription_options
 
 %type <*tree.Select> select_no_parens
 %type <tree.SelectStatement> select_clause select_with_parens simple_select empty_select values_clause table_clause simple_select_clause
@@ -1252,7 +1278,7 @@ func (u *sqlSymUnion) vacuumTableAndColsList() tree.VacuumTableAndColsList {
 %type <tree.TableExprs> from_list opt_from_list
 %type <tree.TablePatterns> table_pattern_list single_table_pattern_list
 %type <tree.TableNames> table_name_list opt_locked_rels opt_inherits
-%type <tree.Exprs> expr_list opt_expr_list tuple1_ambiguous_values tuple1_unambiguous_values
+%type <tree.Exprs> expr_list opt_expr_list func_arg_list tuple1_ambiguous_values tuple1_unambiguous_values
 %type <*tree.Tuple> expr_tuple1_ambiguous expr_tuple_unambiguous
 %type <tree.SelectExprs> target_list
 %type <tree.UpdateExprs> set_clause_list
@@ -1314,7 +1340,7 @@ func (u *sqlSymUnion) vacuumTableAndColsList() tree.VacuumTableAndColsList {
 %type <*tree.IndexFlags> opt_index_flags
 %type <*tree.IndexFlags> index_flags_param
 %type <*tree.IndexFlags> index_flags_param_list
-%type <tree.Expr> a_expr b_expr c_expr d_expr typed_literal
+%type <tree.Expr> a_expr b_expr c_expr d_expr typed_literal func_arg_expr
 %type <tree.Expr> substr_from substr_for
 %type <tree.Expr> in_expr partition_bound_expr
 %type <tree.Expr> having_clause
@@ -1504,6 +1530,10 @@ func (u *sqlSymUnion) vacuumTableAndColsList() tree.VacuumTableAndColsList {
 %left      JOIN CROSS LEFT FULL RIGHT INNER NATURAL
 %right     HELPTOKEN
 
+// EQUALS_GREATER is the => that separates a parameter name from its argument in a
+// function call. It is declared last so that the other tokens keep their values.
+%token <str> EQUALS_GREATER
+
 %%
 
 stmt_block:
@@ -1579,6 +1609,9 @@ alter_ddl_stmt:
 | alter_trigger_stmt            // EXTEND WITH HELP: ALTER TRIGGER
 | alter_language_stmt           // EXTEND WITH HELP: ALTER LANGUAGE
 | alter_domain_stmt             // EXTEND WITH HELP: ALTER DOMAIN
+| alter_policy_stmt             // EXTEND WITH HELP: ALTER POLICY
+| alter_publication_stmt        // EXTEND WITH HELP: ALTER PUBLICATION
+| alter_subscription_stmt       // EXTEND WITH HELP: ALTER SUBSCRIPTION
 
 // %Help: ALTER TABLE - change the definition of a table
 // %Category: DDL
@@ -1911,6 +1944,250 @@ alter_language_stmt:
     $$.val = &tree.AlterLanguage{Name: tree.Name($4), Procedural: $2.bool(), Owner: $5}
   }
 
+// %Help: ALTER POLICY - change the definition of a row-level security policy
+// %Category: DDL
+// %Text:
+// ALTER POLICY <name> ON <table_name> RENAME TO <new_name>
+// ALTER POLICY <name> ON <table_name>
+//   [ TO <role> [, ...] ]
+//   [ USING ( <using_expression> ) ]
+//   [ WITH CHECK ( <check_expression> ) ]
+// %SeeAlso: WEBDOCS/sql-alterpolicy.html
+alter_policy_stmt:
+  ALTER POLICY name ON table_name RENAME TO name
+  {
+    $$.val = &tree.AlterPolicy{Name: tree.Name($3), Table: $5.unresolvedObjectName(), NewName: tree.Name($8)}
+  }
+| ALTER POLICY name ON table_name opt_policy_roles opt_policy_using opt_policy_with_check
+  {
+    $$.val = &tree.AlterPolicy{Name: tree.Name($3), Table: $5.unresolvedObjectName(), Roles: $6.strs(), Using: $7.expr(), WithCheck: $8.expr()}
+  }
+
+opt_policy_restrictive:
+  AS IDENT
+  {
+    switch $2 {
+    case "permissive":
+      $$.val = false
+    case "restrictive":
+      $$.val = true
+    default:
+      return setErr(sqllex, fmt.Errorf("unrecognized row security option \"%s\"", $2))
+    }
+  }
+| /* EMPTY */
+  {
+    $$.val = false
+  }
+
+opt_policy_command:
+  FOR ALL
+  {
+    $$.val = tree.PolicyCommandAll
+  }
+| FOR SELECT
+  {
+    $$.val = tree.PolicyCommandSelect
+  }
+| FOR INSERT
+  {
+    $$.val = tree.PolicyCommandInsert
+  }
+| FOR UPDATE
+  {
+    $$.val = tree.PolicyCommandUpdate
+  }
+| FOR DELETE
+  {
+    $$.val = tree.PolicyCommandDelete
+  }
+| /* EMPTY */
+  {
+    $$.val = tree.PolicyCommandAll
+  }
+
+opt_policy_roles:
+  TO role_spec_list
+  {
+    $$.val = $2.strs()
+  }
+| /* EMPTY */
+  {
+    $$.val = []string(nil)
+  }
+
+opt_policy_using:
+  USING '(' a_expr ')'
+  {
+    $$.val = $3.expr()
+  }
+| /* EMPTY */
+  {
+    $$.val = tree.Expr(nil)
+  }
+
+opt_policy_with_check:
+  WITH CHECK '(' a_expr ')'
+  {
+    $$.val = $4.expr()
+  }
+| /* EMPTY */
+  {
+    $$.val = tree.Expr(nil)
+  }
+
+// %Help: ALTER PUBLICATION - change the definition of a publication
+// %Category: DDL
+// %Text:
+// ALTER PUBLICATION <name> { ADD | SET | DROP } <publication_object> [, ...]
+// ALTER PUBLICATION <name> SET ( <option> [= <value>] [, ... ] )
+// ALTER PUBLICATION <name> OWNER TO <role>
+// ALTER PUBLICATION <name> RENAME TO <newname>
+//
+// Publication objects:
+//   TABLE <table_name> [, ...]
+//   TABLES IN SCHEMA <schema_name> [, ...]
+// %SeeAlso: WEBDOCS/sql-alterpublication.html
+alter_publication_stmt:
+  ALTER PUBLICATION name ADD publication_object_list
+  {
+    $$.val = &tree.AlterPublication{Name: tree.Name($3), Cmd: &tree.AlterPublicationObjects{Action: tree.AlterSubscriptionPublicationAdd, Objects: $5.publicationObjects()}}
+  }
+| ALTER PUBLICATION name SET publication_object_list
+  {
+    $$.val = &tree.AlterPublication{Name: tree.Name($3), Cmd: &tree.AlterPublicationObjects{Action: tree.AlterSubscriptionPublicationSet, Objects: $5.publicationObjects()}}
+  }
+| ALTER PUBLICATION name DROP publication_object_list
+  {
+    $$.val = &tree.AlterPublication{Name: tree.Name($3), Cmd: &tree.AlterPublicationObjects{Action: tree.AlterSubscriptionPublicationDrop, Objects: $5.publicationObjects()}}
+  }
+| ALTER PUBLICATION name SET '(' storage_parameter_list ')'
+  {
+    $$.val = &tree.AlterPublication{Name: tree.Name($3), Cmd: &tree.AlterPublicationSetOptions{Options: $6.storageParams()}}
+  }
+| ALTER PUBLICATION name owner_to
+  {
+    $$.val = &tree.AlterPublication{Name: tree.Name($3), Cmd: &tree.AlterPublicationOwner{Owner: $4}}
+  }
+| ALTER PUBLICATION name RENAME TO name
+  {
+    $$.val = &tree.AlterPublication{Name: tree.Name($3), Cmd: &tree.AlterPublicationRename{NewName: tree.Name($6)}}
+  }
+
+publication_object_list:
+  publication_object
+  {
+    objs, err := tree.PublicationObjects(nil).Append($1.publicationObject())
+    if err != nil {
+      return setErr(sqllex, err)
+    }
+    $$.val = objs
+  }
+| publication_object_list ',' publication_object
+  {
+    objs, err := $1.publicationObjects().Append($3.publicationObject())
+    if err != nil {
+      return setErr(sqllex, err)
+    }
+    $$.val = objs
+  }
+
+// A standalone name takes the kind of the object that precedes it in the list.
+publication_object:
+  TABLE table_name
+  {
+    $$.val = tree.PublicationObject{Kind: tree.PublicationObjectTable, Table: $2.unresolvedObjectName()}
+  }
+| TABLES IN SCHEMA name
+  {
+    $$.val = tree.PublicationObject{Kind: tree.PublicationObjectSchema, Schema: tree.Name($4)}
+  }
+| table_name
+  {
+    $$.val = tree.PublicationObject{Kind: tree.PublicationObjectContinuation, Table: $1.unresolvedObjectName()}
+  }
+
+// %Help: ALTER SUBSCRIPTION - change the definition of a subscription
+// %Category: DDL
+// %Text:
+// ALTER SUBSCRIPTION <name> CONNECTION '<conninfo>'
+// ALTER SUBSCRIPTION <name> { SET | ADD | DROP } PUBLICATION <publication> [, ...] [WITH ( <option> [= <value>] [, ... ] )]
+// ALTER SUBSCRIPTION <name> REFRESH PUBLICATION [WITH ( <option> [= <value>] [, ... ] )]
+// ALTER SUBSCRIPTION <name> { ENABLE | DISABLE }
+// ALTER SUBSCRIPTION <name> SET ( <option> [= <value>] [, ... ] )
+// ALTER SUBSCRIPTION <name> OWNER TO <role>
+// ALTER SUBSCRIPTION <name> RENAME TO <newname>
+// %SeeAlso: WEBDOCS/sql-altersubscription.html
+alter_subscription_stmt:
+  ALTER SUBSCRIPTION name CONNECTION SCONST
+  {
+    $$.val = &tree.AlterSubscription{Name: tree.Name($3), Cmd: &tree.AlterSubscriptionConnection{ConnInfo: $5}}
+  }
+| ALTER SUBSCRIPTION name SET PUBLICATION name_list opt_subscription_options
+  {
+    $$.val = &tree.AlterSubscription{Name: tree.Name($3), Cmd: &tree.AlterSubscriptionPublication{Action: tree.AlterSubscriptionPublicationSet, Publications: $6.nameList(), Options: $7.storageParams()}}
+  }
+| ALTER SUBSCRIPTION name ADD PUBLICATION name_list opt_subscription_options
+  {
+    $$.val = &tree.AlterSubscription{Name: tree.Name($3), Cmd: &tree.AlterSubscriptionPublication{Action: tree.AlterSubscriptionPublicationAdd, Publications: $6.nameList(), Options: $7.storageParams()}}
+  }
+| ALTER SUBSCRIPTION name DROP PUBLICATION name_list opt_subscription_options
+  {
+    $$.val = &tree.AlterSubscription{Name: tree.Name($3), Cmd: &tree.AlterSubscriptionPublication{Action: tree.AlterSubscriptionPublicationDrop, Publications: $6.nameList(), Options: $7.storageParams()}}
+  }
+| ALTER SUBSCRIPTION name REFRESH PUBLICATION opt_subscription_options
+  {
+    $$.val = &tree.AlterSubscription{Name: tree.Name($3), Cmd: &tree.AlterSubscriptionRefresh{Options: $6.storageParams()}}
+  }
+| ALTER SUBSCRIPTION name ENABLE
+  {
+    $$.val = &tree.AlterSubscription{Name: tree.Name($3), Cmd: &tree.AlterSubscriptionEnable{Enable: true}}
+  }
+| ALTER SUBSCRIPTION name DISABLE
+  {
+    $$.val = &tree.AlterSubscription{Name: tree.Name($3), Cmd: &tree.AlterSubscriptionEnable{Enable: false}}
+  }
+| ALTER SUBSCRIPTION name SET '(' subscription_option_list ')'
+  {
+    $$.val = &tree.AlterSubscription{Name: tree.Name($3), Cmd: &tree.AlterSubscriptionSetOptions{Options: $6.storageParams()}}
+  }
+| ALTER SUBSCRIPTION name owner_to
+  {
+    $$.val = &tree.AlterSubscription{Name: tree.Name($3), Cmd: &tree.AlterSubscriptionOwner{Owner: $4}}
+  }
+| ALTER SUBSCRIPTION name RENAME TO name
+  {
+    $$.val = &tree.AlterSubscription{Name: tree.Name($3), Cmd: &tree.AlterSubscriptionRename{NewName: tree.Name($6)}}
+  }
+
+opt_subscription_options:
+  /* EMPTY */
+  {
+    $$.val = []tree.StorageParam(nil)
+  }
+| WITH '(' subscription_option_list ')'
+  {
+    $$.val = $3.storageParams()
+  }
+
+subscription_option_list:
+  subscription_option
+  {
+    $$.val = []tree.StorageParam{$1.storageParam()}
+  }
+| subscription_option_list ',' subscription_option
+  {
+    $$.val = append($1.storageParams(), $3.storageParam())
+  }
+
+// Subscription options also accept NONE as a value (such as slot_name = NONE), which is equivalent to 'none'.
+subscription_option:
+  storage_parameter
+| name '=' NONE
+  {
+    $$.val = tree.StorageParam{Key: tree.Name($1), Value: tree.NewStrVal("none")}
+  }
+
 alter_domain_stmt:
   ALTER DOMAIN type_name alter_domain_cmd
   {
@@ -4194,6 +4471,9 @@ create_stmt:
 | create_language_stmt  // EXTEND WITH HELP: CREATE LANGUAGE
 | create_aggregate_stmt // EXTEND WITH HELP: CREATE AGGREGATE
 | create_cast_stmt      // EXTEND WITH HELP: CREATE CAST
+| create_policy_stmt    // EXTEND WITH HELP: CREATE POLICY
+| create_publication_stmt // EXTEND WITH HELP: CREATE PUBLICATION
+| create_subscription_stmt // EXTEND WITH HELP: CREATE SUBSCRIPTION
 | create_operator_stmt  // EXTEND WITH HELP: CREATE OPERATOR
 | create_unsupported   {}
 | CREATE error         // SHOW HELP: CREATE
@@ -4204,10 +4484,8 @@ create_unsupported:
 | CREATE FOREIGN TABLE error { return unimplemented(sqllex, "create foreign table") }
 | CREATE OPERATOR CLASS error { return unimplemented(sqllex, "create operator class") }
 | CREATE OPERATOR FAMILY error { return unimplemented(sqllex, "create operator family") }
-| CREATE PUBLICATION error { return unimplemented(sqllex, "create publication") }
 | CREATE opt_or_replace RULE error { return unimplemented(sqllex, "create rule") }
 | CREATE SERVER error { return unimplemented(sqllex, "create server") }
-| CREATE SUBSCRIPTION error { return unimplemented(sqllex, "create subscription") }
 | CREATE TEXT error { return unimplementedWithIssueDetail(sqllex, 7821, "create text") }
 
 create_aggregate_stmt:
@@ -4527,6 +4805,67 @@ opt_handler_validator:
     $$.val = $2.unresolvedObjectName()
   }
 
+// %Help: CREATE POLICY - define a new row-level security policy for a table
+// %Category: DDL
+// %Text:
+// CREATE POLICY <name> ON <table_name>
+//   [ AS { PERMISSIVE | RESTRICTIVE } ]
+//   [ FOR { ALL | SELECT | INSERT | UPDATE | DELETE } ]
+//   [ TO <role> [, ...] ]
+//   [ USING ( <using_expression> ) ]
+//   [ WITH CHECK ( <check_expression> ) ]
+// %SeeAlso: WEBDOCS/sql-createpolicy.html
+create_policy_stmt:
+  CREATE POLICY name ON table_name opt_policy_restrictive opt_policy_command opt_policy_roles opt_policy_using opt_policy_with_check
+  {
+    $$.val = &tree.CreatePolicy{
+      Name: tree.Name($3),
+      Table: $5.unresolvedObjectName(),
+      Restrictive: $6.bool(),
+      Command: $7.policyCommand(),
+      Roles: $8.strs(),
+      Using: $9.expr(),
+      WithCheck: $10.expr(),
+    }
+  }
+
+// %Help: CREATE PUBLICATION - define a new publication
+// %Category: DDL
+// %Text:
+// CREATE PUBLICATION <name>
+//   [ FOR ALL TABLES | FOR <publication_object> [, ... ] ]
+//   [ WITH ( <option> [= <value>] [, ... ] ) ]
+//
+// Publication objects:
+//   TABLE <table_name> [, ...]
+//   TABLES IN SCHEMA <schema_name> [, ...]
+// %SeeAlso: WEBDOCS/sql-createpublication.html
+create_publication_stmt:
+  CREATE PUBLICATION name opt_subscription_options
+  {
+    $$.val = &tree.CreatePublication{Name: tree.Name($3), Options: $4.storageParams()}
+  }
+| CREATE PUBLICATION name FOR ALL TABLES opt_subscription_options
+  {
+    $$.val = &tree.CreatePublication{Name: tree.Name($3), AllTables: true, Options: $7.storageParams()}
+  }
+| CREATE PUBLICATION name FOR publication_object_list opt_subscription_options
+  {
+    $$.val = &tree.CreatePublication{Name: tree.Name($3), Objects: $5.publicationObjects(), Options: $6.storageParams()}
+  }
+
+// %Help: CREATE SUBSCRIPTION - define a new subscription
+// %Category: DDL
+// %Text:
+// CREATE SUBSCRIPTION <name> CONNECTION '<conninfo>' PUBLICATION <publication> [, ...]
+//   [WITH ( <option> [= <value>] [, ... ] )]
+// %SeeAlso: WEBDOCS/sql-createsubscription.html
+create_subscription_stmt:
+  CREATE SUBSCRIPTION name CONNECTION SCONST PUBLICATION name_list opt_subscription_options
+  {
+    $$.val = &tree.CreateSubscription{Name: tree.Name($3), ConnInfo: $5, Publications: $7.nameList(), Options: $8.storageParams()}
+  }
+
 create_extension_stmt:
   CREATE EXTENSION name opt_with opt_schema opt_version opt_cascade
   {
@@ -4923,10 +5262,8 @@ drop_unsupported:
 | DROP FOREIGN DATA error { return unimplemented(sqllex, "drop fdw") }
 | DROP OPERATOR CLASS error { return unimplemented(sqllex, "drop operator class") }
 | DROP OPERATOR FAMILY error { return unimplemented(sqllex, "drop operator family") }
-| DROP PUBLICATION error { return unimplemented(sqllex, "drop publication") }
 | DROP RULE error { return unimplemented(sqllex, "drop rule") }
 | DROP SERVER error { return unimplemented(sqllex, "drop server") }
-| DROP SUBSCRIPTION error { return unimplemented(sqllex, "drop subscription") }
 | DROP TEXT error { return unimplementedWithIssueDetail(sqllex, 7821, "drop text") }
 
 drop_aggregate_stmt:
@@ -5000,6 +5337,48 @@ drop_language_stmt:
     $$.val = &tree.DropLanguage{Name: tree.Name($6), Procedural: $2.bool(), IfExists: true, DropBehavior: $7.dropBehavior()}
   }
 
+// %Help: DROP POLICY - remove a row-level security policy from a table
+// %Category: DDL
+// %Text: DROP POLICY [ IF EXISTS ] <name> ON <table_name> [ CASCADE | RESTRICT ]
+// %SeeAlso: WEBDOCS/sql-droppolicy.html
+drop_policy_stmt:
+  DROP POLICY name ON table_name opt_drop_behavior
+  {
+    $$.val = &tree.DropPolicy{Name: tree.Name($3), Table: $5.unresolvedObjectName(), DropBehavior: $6.dropBehavior()}
+  }
+| DROP POLICY IF EXISTS name ON table_name opt_drop_behavior
+  {
+    $$.val = &tree.DropPolicy{Name: tree.Name($5), Table: $7.unresolvedObjectName(), IfExists: true, DropBehavior: $8.dropBehavior()}
+  }
+
+// %Help: DROP PUBLICATION - remove a publication
+// %Category: DDL
+// %Text: DROP PUBLICATION [ IF EXISTS ] <name> [, ...] [ CASCADE | RESTRICT ]
+// %SeeAlso: WEBDOCS/sql-droppublication.html
+drop_publication_stmt:
+  DROP PUBLICATION name_list opt_drop_behavior
+  {
+    $$.val = &tree.DropPublication{Names: $3.nameList(), DropBehavior: $4.dropBehavior()}
+  }
+| DROP PUBLICATION IF EXISTS name_list opt_drop_behavior
+  {
+    $$.val = &tree.DropPublication{Names: $5.nameList(), IfExists: true, DropBehavior: $6.dropBehavior()}
+  }
+
+// %Help: DROP SUBSCRIPTION - remove a subscription
+// %Category: DDL
+// %Text: DROP SUBSCRIPTION [ IF EXISTS ] <name> [ CASCADE | RESTRICT ]
+// %SeeAlso: WEBDOCS/sql-dropsubscription.html
+drop_subscription_stmt:
+  DROP SUBSCRIPTION name opt_drop_behavior
+  {
+    $$.val = &tree.DropSubscription{Name: tree.Name($3), DropBehavior: $4.dropBehavior()}
+  }
+| DROP SUBSCRIPTION IF EXISTS name opt_drop_behavior
+  {
+    $$.val = &tree.DropSubscription{Name: tree.Name($5), IfExists: true, DropBehavior: $6.dropBehavior()}
+  }
+
 drop_extension_stmt:
   DROP EXTENSION name_list opt_drop_behavior
   {
@@ -5259,6 +5638,9 @@ drop_stmt:
 | drop_language_stmt  // EXTEND WITH HELP: DROP LANGUAGE
 | drop_aggregate_stmt // EXTEND WITH HELP: DROP AGGREGATE
 | drop_operator_stmt  // EXTEND WITH HELP: DROP OPERATOR
+| drop_policy_stmt    // EXTEND WITH HELP: DROP POLICY
+| drop_publication_stmt // EXTEND WITH HELP: DROP PUBLICATION
+| drop_subscription_stmt // EXTEND WITH HELP: DROP SUBSCRIPTION
 | drop_unsupported   {}
 | DROP error         // SHOW HELP: DROP
 
@@ -13432,7 +13814,7 @@ d_expr:
     if err != nil { return setErr(sqllex, err) }
     $$.val = d
   }
-| func_name '(' expr_list opt_sort_clause ')' SCONST { return unimplemented(sqllex, $1.unresolvedName().String() + "(...) SCONST") }
+| func_name '(' func_arg_list opt_sort_clause ')' SCONST { return unimplemented(sqllex, $1.unresolvedName().String() + "(...) SCONST") }
 | typed_literal
   {
     $$.val = $1.expr()
@@ -13510,12 +13892,18 @@ func_application:
   {
     $$.val = &tree.FuncExpr{Func: $1.resolvableFuncRefFromName()}
   }
-| func_name '(' expr_list opt_sort_clause ')'
+| func_name '(' func_arg_list opt_sort_clause ')'
   {
     $$.val = &tree.FuncExpr{Func: $1.resolvableFuncRefFromName(), Exprs: $3.exprs(), OrderBy: $4.orderBy(), AggType: tree.GeneralAgg}
   }
-| func_name '(' VARIADIC a_expr opt_sort_clause ')' { return unimplemented(sqllex, "variadic") }
-| func_name '(' expr_list ',' VARIADIC a_expr opt_sort_clause ')' { return unimplemented(sqllex, "variadic") }
+| func_name '(' VARIADIC a_expr opt_sort_clause ')'
+  {
+    $$.val = &tree.FuncExpr{Func: $1.resolvableFuncRefFromName(), Exprs: tree.Exprs{$4.expr()}, OrderBy: $5.orderBy(), AggType: tree.GeneralAgg, Variadic: true}
+  }
+| func_name '(' func_arg_list ',' VARIADIC a_expr opt_sort_clause ')'
+  {
+    $$.val = &tree.FuncExpr{Func: $1.resolvableFuncRefFromName(), Exprs: append($3.exprs(), $6.expr()), OrderBy: $7.orderBy(), AggType: tree.GeneralAgg, Variadic: true}
+  }
 | func_name '(' ALL expr_list opt_sort_clause ')'
   {
     $$.val = &tree.FuncExpr{Func: $1.resolvableFuncRefFromName(), Type: tree.AllFuncType, Exprs: $4.exprs(), OrderBy: $5.orderBy(), AggType: tree.GeneralAgg}
@@ -14242,6 +14630,25 @@ expr_list:
     $$.val = append($1.exprs(), $3.expr())
   }
 
+// func_arg_list is the list of arguments given to a function call, any of
+// which may be given by the name of its parameter.
+func_arg_list:
+  func_arg_expr
+  {
+    $$.val = tree.Exprs{$1.expr()}
+  }
+| func_arg_list ',' func_arg_expr
+  {
+    $$.val = append($1.exprs(), $3.expr())
+  }
+
+func_arg_expr:
+  a_expr
+| type_function_name EQUALS_GREATER a_expr
+  {
+    $$.val = &tree.NamedArgExpr{Name: tree.Name($1), Value: $3.expr()}
+  }
+
 type_list:
   typename
   {
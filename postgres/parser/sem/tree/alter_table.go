@@ -379,7 +379,7 @@ func (node *AlterTableComputed) Format(ctx *FmtCtx) {
 			}
 			if s.ByDefault {
 				ctx.WriteString("SET GENERATED BY DEFAULT")
-			} else if s.Options == nil {
+			} else if len(s.Options) > 0 {
 				ctx.WriteString("SET ")
 				ctx.FormatNode(&s.Options)
 			} else if s.IsRestart {
@@ -240,6 +240,54 @@ func (node *DropExtension) Format(ctx *FmtCtx) {
 	}
 }
 
+var _ Statement = &DropSubscription{}
+
+// DropSubscription represents a DROP SUBSCRIPTION statement.
+type DropSubscription struct {
+	Name         Name
+	IfExists     bool
+	DropBehavior DropBehavior
+}
+
+// Format implements the NodeFormatter interface.
+func (node *DropSubscription) Format(ctx *FmtCtx) {
+	ctx.WriteString("DROP SUBSCRIPTION ")
+	if node.IfExists {
+		ctx.WriteString("IF EXISTS ")
+	}
+	ctx.FormatNode(&node.Name)
+	switch node.DropBehavior {
+	case DropDefault:
+	default:
+		ctx.WriteByte(' ')
+		ctx.WriteString(dropBehaviorName[node.DropBehavior])
+	}
+}
+
+var _ Statement = &DropPublication{}
+
+// DropPublication represents a DROP PUBLICATION statement.
+type DropPublication struct {
+	Names        NameList
+	IfExists     bool
+	DropBehavior DropBehavior
+}
+
+// Format implements the NodeFormatter interface.
+func (node *DropPublication) Format(ctx *FmtCtx) {
+	ctx.WriteString("DROP PUBLICATION ")
+	if node.IfExists {
+		ctx.WriteString("IF EXISTS ")
+	}
+	ctx.FormatNode(&node.Names)
+	switch node.DropBehavior {
+	case DropDefault:
+	default:
+		ctx.WriteByte(' ')
+		ctx.WriteString(dropBehaviorName[node.DropBehavior])
+	}
+}
+
 var _ Statement = &DropFunction{}
 
 // DropFunction represents a DROP FUNCTION statement.
@@ -1323,12 +1323,29 @@ type FuncExpr struct {
 	// OrderBy is used for aggregations which specify an order. This same field
 	// is used for any type of aggregation.
 	OrderBy OrderBy
+	// Variadic is set when the last argument was marked with VARIADIC, in which
+	// case it is an array that supplies all of the variadic parameter's values.
+	Variadic bool
 
 	typeAnnotation
 	fnProps *FunctionProperties
 	fn      *Overload
 }
 
+// NamedArgExpr represents an argument of a function call that is given by the
+// name of its parameter, such as the argument in make_interval(days => 10).
+type NamedArgExpr struct {
+	Name  Name
+	Value Expr
+}
+
+// Format implements the NodeFormatter interface.
+func (node *NamedArgExpr) Format(ctx *FmtCtx) {
+	ctx.FormatNode(&node.Name)
+	ctx.WriteString(" => ")
+	ctx.FormatNode(node.Value)
+}
+
 // NewTypedFuncExpr returns a FuncExpr that is already well-typed and resolved.
 func NewTypedFuncExpr(
 	ref ResolvableFunctionReference,
@@ -1432,6 +1449,9 @@ func (node *FuncExpr) Format(ctx *FmtCtx) {
 			if i > 0 {
 				ctx.WriteString(", ")
 			}
+			if node.Variadic && i == len(node.Exprs)-1 {
+				ctx.WriteString("VARIADIC ")
+			}
 			ctx.FormatNode(e)
 		}
 		ctx.WriteString(")")
@@ -1765,6 +1785,7 @@ func (node *IsOfTypeExpr) String() string     { return AsString(node) }
 func (node *Name) String() string             { return AsString(node) }
 func (node *UnrestrictedName) String() string { return AsString(node) }
 func (node *NotExpr) String() string          { return AsString(node) }
+func (node *NamedArgExpr) String() string     { return AsString(node) }
 func (node *IsNullExpr) String() string       { return AsString(node) }
 func (node *IsNotNullExpr) String() string    { return AsString(node) }
 func (node *NullIfExpr) String() string       { return AsString(node) }
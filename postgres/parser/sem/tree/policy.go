@@ -0,0 +1,141 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+import "strings"
+
+// PolicyCommand is the command that a row-level security policy applies to.
+type PolicyCommand int
+
+// PolicyCommand values.
+const (
+	PolicyCommandAll PolicyCommand = iota
+	PolicyCommandSelect
+	PolicyCommandInsert
+	PolicyCommandUpdate
+	PolicyCommandDelete
+)
+
+var policyCommandName = [...]string{
+	PolicyCommandAll:    "ALL",
+	PolicyCommandSelect: "SELECT",
+	PolicyCommandInsert: "INSERT",
+	PolicyCommandUpdate: "UPDATE",
+	PolicyCommandDelete: "DELETE",
+}
+
+// String returns the keyword of the command.
+func (p PolicyCommand) String() string {
+	return policyCommandName[p]
+}
+
+var _ Statement = &CreatePolicy{}
+
+// CreatePolicy represents a CREATE POLICY statement.
+type CreatePolicy struct {
+	Name        Name
+	Table       *UnresolvedObjectName
+	Restrictive bool
+	Command     PolicyCommand
+	Roles       []string
+	Using       Expr
+	WithCheck   Expr
+}
+
+// Format implements the NodeFormatter interface.
+func (node *CreatePolicy) Format(ctx *FmtCtx) {
+	ctx.WriteString("CREATE POLICY ")
+	ctx.FormatNode(&node.Name)
+	ctx.WriteString(" ON ")
+	ctx.FormatNode(node.Table)
+	if node.Restrictive {
+		ctx.WriteString(" AS RESTRICTIVE")
+	}
+	ctx.WriteString(" FOR ")
+	ctx.WriteString(node.Command.String())
+	formatPolicyClauses(ctx, node.Roles, node.Using, node.WithCheck)
+}
+
+var _ Statement = &AlterPolicy{}
+
+// AlterPolicy represents an ALTER POLICY statement. Either NewName is set, or some combination of the roles and
+// expressions are set.
+type AlterPolicy struct {
+	Name      Name
+	Table     *UnresolvedObjectName
+	NewName   Name
+	Roles     []string
+	Using     Expr
+	WithCheck Expr
+}
+
+// Format implements the NodeFormatter interface.
+func (node *AlterPolicy) Format(ctx *FmtCtx) {
+	ctx.WriteString("ALTER POLICY ")
+	ctx.FormatNode(&node.Name)
+	ctx.WriteString(" ON ")
+	ctx.FormatNode(node.Table)
+	if len(node.NewName) > 0 {
+		ctx.WriteString(" RENAME TO ")
+		ctx.FormatNode(&node.NewName)
+		return
+	}
+	formatPolicyClauses(ctx, node.Roles, node.Using, node.WithCheck)
+}
+
+var _ Statement = &DropPolicy{}
+
+// DropPolicy represents a DROP POLICY statement.
+type DropPolicy struct {
+	Name         Name
+	Table        *UnresolvedObjectName
+	IfExists     bool
+	DropBehavior DropBehavior
+}
+
+// Format implements the NodeFormatter interface.
+func (node *DropPolicy) Format(ctx *FmtCtx) {
+	ctx.WriteString("DROP POLICY ")
+	if node.IfExists {
+		ctx.WriteString("IF EXISTS ")
+	}
+	ctx.FormatNode(&node.Name)
+	ctx.WriteString(" ON ")
+	ctx.FormatNode(node.Table)
+	switch node.DropBehavior {
+	case DropDefault:
+	default:
+		ctx.WriteByte(' ')
+		ctx.WriteString(dropBehaviorName[node.DropBehavior])
+	}
+}
+
+// formatPolicyClauses writes the TO, USING, and WITH CHECK clauses that are shared by CREATE POLICY and ALTER POLICY.
+func formatPolicyClauses(ctx *FmtCtx, roles []string, using Expr, withCheck Expr) {
+	if len(roles) > 0 {
+		ctx.WriteString(" TO ")
+		ctx.WriteString(strings.Join(roles, ", "))
+	}
+	if using != nil {
+		ctx.WriteString(" USING (")
+		ctx.FormatNode(using)
+		ctx.WriteByte(')')
+	}
+	if withCheck != nil {
+		ctx.WriteString(" WITH CHECK (")
+		ctx.FormatNode(withCheck)
+		ctx.WriteByte(')')
+	}
+}
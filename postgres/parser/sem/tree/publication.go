@@ -0,0 +1,189 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+import "github.com/cockroachdb/errors"
+
+// PublicationObjectKind is the kind of object that is given in a publication's object list.
+type PublicationObjectKind int
+
+// PublicationObjectKind values.
+const (
+	// PublicationObjectContinuation is a standalone name, which takes the kind of the object that precedes it.
+	PublicationObjectContinuation PublicationObjectKind = iota
+	PublicationObjectTable
+	PublicationObjectSchema
+)
+
+// PublicationObject is a table or schema that is part of a publication.
+type PublicationObject struct {
+	Kind PublicationObjectKind
+	// Table is set for PublicationObjectTable.
+	Table *UnresolvedObjectName
+	// Schema is set for PublicationObjectSchema.
+	Schema Name
+}
+
+// Format implements the NodeFormatter interface.
+func (node *PublicationObject) Format(ctx *FmtCtx) {
+	switch node.Kind {
+	case PublicationObjectTable:
+		ctx.WriteString("TABLE ")
+		ctx.FormatNode(node.Table)
+	case PublicationObjectSchema:
+		ctx.WriteString("TABLES IN SCHEMA ")
+		ctx.FormatNode(&node.Schema)
+	default:
+		ctx.FormatNode(node.Table)
+	}
+}
+
+// PublicationObjects is a list of publication objects.
+type PublicationObjects []PublicationObject
+
+// Format implements the NodeFormatter interface.
+func (node *PublicationObjects) Format(ctx *FmtCtx) {
+	for i := range *node {
+		if i > 0 {
+			ctx.WriteString(", ")
+		}
+		ctx.FormatNode(&(*node)[i])
+	}
+}
+
+// Append adds the object to the list. A standalone name takes the kind of the object that precedes it, so an error is
+// returned if it is the first object in the list.
+func (node PublicationObjects) Append(obj PublicationObject) (PublicationObjects, error) {
+	if obj.Kind == PublicationObjectContinuation {
+		if len(node) == 0 {
+			return nil, errors.New("invalid publication object list: one of TABLE or TABLES IN SCHEMA must be specified before a standalone table or schema name")
+		}
+		switch node[len(node)-1].Kind {
+		case PublicationObjectTable:
+			obj.Kind = PublicationObjectTable
+		case PublicationObjectSchema:
+			if obj.Table.NumParts != 1 {
+				return nil, errors.Errorf(`invalid schema name: %s`, obj.Table.String())
+			}
+			obj = PublicationObject{Kind: PublicationObjectSchema, Schema: Name(obj.Table.Parts[0])}
+		}
+	}
+	return append(node, obj), nil
+}
+
+var _ Statement = &CreatePublication{}
+
+// CreatePublication represents a CREATE PUBLICATION statement.
+type CreatePublication struct {
+	Name      Name
+	AllTables bool
+	Objects   PublicationObjects
+	Options   StorageParams
+}
+
+// Format implements the NodeFormatter interface.
+func (node *CreatePublication) Format(ctx *FmtCtx) {
+	ctx.WriteString("CREATE PUBLICATION ")
+	ctx.FormatNode(&node.Name)
+	if node.AllTables {
+		ctx.WriteString(" FOR ALL TABLES")
+	} else if len(node.Objects) > 0 {
+		ctx.WriteString(" FOR ")
+		ctx.FormatNode(&node.Objects)
+	}
+	formatSubscriptionOptions(ctx, node.Options)
+}
+
+var _ Statement = &AlterPublication{}
+
+// AlterPublication represents an ALTER PUBLICATION statement.
+type AlterPublication struct {
+	Name Name
+	Cmd  AlterPublicationCmd
+}
+
+// Format implements the NodeFormatter interface.
+func (node *AlterPublication) Format(ctx *FmtCtx) {
+	ctx.WriteString("ALTER PUBLICATION ")
+	ctx.FormatNode(&node.Name)
+	ctx.FormatNode(node.Cmd)
+}
+
+// AlterPublicationCmd represents a publication modification operation.
+type AlterPublicationCmd interface {
+	NodeFormatter
+	// Placeholder function to ensure that only desired types
+	// (AlterPublication*) conform to the AlterPublicationCmd interface.
+	alterPublicationCmd()
+}
+
+func (*AlterPublicationObjects) alterPublicationCmd()    {}
+func (*AlterPublicationSetOptions) alterPublicationCmd() {}
+func (*AlterPublicationOwner) alterPublicationCmd()      {}
+func (*AlterPublicationRename) alterPublicationCmd()     {}
+
+var _ AlterPublicationCmd = &AlterPublicationObjects{}
+var _ AlterPublicationCmd = &AlterPublicationSetOptions{}
+var _ AlterPublicationCmd = &AlterPublicationOwner{}
+var _ AlterPublicationCmd = &AlterPublicationRename{}
+
+// AlterPublicationObjects represents an ALTER PUBLICATION ... { ADD | SET | DROP } command. It reuses the actions of
+// ALTER SUBSCRIPTION, as they have the same meaning.
+type AlterPublicationObjects struct {
+	Action  AlterSubscriptionPublicationAction
+	Objects PublicationObjects
+}
+
+// Format implements the NodeFormatter interface.
+func (node *AlterPublicationObjects) Format(ctx *FmtCtx) {
+	ctx.WriteByte(' ')
+	ctx.WriteString(alterSubscriptionPublicationActionName[node.Action])
+	ctx.WriteByte(' ')
+	ctx.FormatNode(&node.Objects)
+}
+
+// AlterPublicationSetOptions represents an ALTER PUBLICATION ... SET ( options ) command.
+type AlterPublicationSetOptions struct {
+	Options StorageParams
+}
+
+// Format implements the NodeFormatter interface.
+func (node *AlterPublicationSetOptions) Format(ctx *FmtCtx) {
+	ctx.WriteString(" SET (")
+	ctx.FormatNode(&node.Options)
+	ctx.WriteByte(')')
+}
+
+// AlterPublicationOwner represents an ALTER PUBLICATION ... OWNER TO command.
+type AlterPublicationOwner struct {
+	Owner string
+}
+
+// Format implements the NodeFormatter interface.
+func (node *AlterPublicationOwner) Format(ctx *FmtCtx) {
+	ctx.WriteString(" OWNER TO ")
+	ctx.WriteString(node.Owner)
+}
+
+// AlterPublicationRename represents an ALTER PUBLICATION ... RENAME TO command.
+type AlterPublicationRename struct {
+	NewName Name
+}
+
+// Format implements the NodeFormatter interface.
+func (node *AlterPublicationRename) Format(ctx *FmtCtx) {
+	ctx.WriteString(" RENAME TO ")
+	ctx.FormatNode(&node.NewName)
+}
@@ -266,6 +266,24 @@ func (*AlterLanguage) StatementTag() string { return "ALTER LANGUAGE" }
 
 func (*AlterLanguage) hiddenFromShowQueries() {}
 
+// StatementType implements the Statement interface.
+func (*AlterPolicy) StatementType() StatementType { return DDL }
+
+// StatementTag returns a short string identifying the type of statement.
+func (*AlterPolicy) StatementTag() string { return "ALTER POLICY" }
+
+// StatementType implements the Statement interface.
+func (*AlterPublication) StatementType() StatementType { return DDL }
+
+// StatementTag returns a short string identifying the type of statement.
+func (*AlterPublication) StatementTag() string { return "ALTER PUBLICATION" }
+
+// StatementType implements the Statement interface.
+func (*AlterSubscription) StatementType() StatementType { return DDL }
+
+// StatementTag returns a short string identifying the type of statement.
+func (*AlterSubscription) StatementTag() string { return "ALTER SUBSCRIPTION" }
+
 // StatementType implements the Statement interface.
 func (*AlterMaterializedView) StatementType() StatementType { return DDL }
 
@@ -509,6 +527,24 @@ func (*CreateExtension) StatementType() StatementType { return DDL }
 // StatementTag returns a short string identifying the type of statement.
 func (*CreateExtension) StatementTag() string { return "CREATE EXTENSION" }
 
+// StatementType implements the Statement interface.
+func (*CreatePolicy) StatementType() StatementType { return DDL }
+
+// StatementTag returns a short string identifying the type of statement.
+func (*CreatePolicy) StatementTag() string { return "CREATE POLICY" }
+
+// StatementType implements the Statement interface.
+func (*CreatePublication) StatementType() StatementType { return DDL }
+
+// StatementTag returns a short string identifying the type of statement.
+func (*CreatePublication) StatementTag() string { return "CREATE PUBLICATION" }
+
+// StatementType implements the Statement interface.
+func (*CreateSubscription) StatementType() StatementType { return DDL }
+
+// StatementTag returns a short string identifying the type of statement.
+func (*CreateSubscription) StatementTag() string { return "CREATE SUBSCRIPTION" }
+
 // StatementType implements the Statement interface.
 func (*CreateFunction) StatementType() StatementType { return DDL }
 
@@ -666,6 +702,24 @@ func (*DropExtension) StatementType() StatementType { return DDL }
 // StatementTag returns a short string identifying the type of statement.
 func (*DropExtension) StatementTag() string { return "DROP EXTENSION" }
 
+// StatementType implements the Statement interface.
+func (*DropPolicy) StatementType() StatementType { return DDL }
+
+// StatementTag returns a short string identifying the type of statement.
+func (*DropPolicy) StatementTag() string { return "DROP POLICY" }
+
+// StatementType implements the Statement interface.
+func (*DropPublication) StatementType() StatementType { return DDL }
+
+// StatementTag returns a short string identifying the type of statement.
+func (*DropPublication) StatementTag() string { return "DROP PUBLICATION" }
+
+// StatementType implements the Statement interface.
+func (*DropSubscription) StatementType() StatementType { return DDL }
+
+// StatementTag returns a short string identifying the type of statement.
+func (*DropSubscription) StatementTag() string { return "DROP SUBSCRIPTION" }
+
 // StatementType implements the Statement interface.
 func (*DropFunction) StatementType() StatementType { return DDL }
 
@@ -1223,6 +1277,9 @@ func (n *AlterFunction) String() string             { return AsString(n) }
 func (n *AlterIndex) String() string                { return AsString(n) }
 func (n *AlterIndexAllInTablespace) String() string { return AsString(n) }
 func (n *AlterLanguage) String() string             { return AsString(n) }
+func (n *AlterPolicy) String() string               { return AsString(n) }
+func (n *AlterPublication) String() string          { return AsString(n) }
+func (n *AlterSubscription) String() string         { return AsString(n) }
 func (n *AlterMaterializedView) String() string     { return AsString(n) }
 func (n *AlterProcedure) String() string            { return AsString(n) }
 func (n *AlterSchema) String() string               { return AsString(n) }
@@ -1255,6 +1312,9 @@ func (n *CreateChangefeed) String() string          { return AsString(n) }
 func (n *CreateDatabase) String() string            { return AsString(n) }
 func (n *CreateDomain) String() string              { return AsString(n) }
 func (n *CreateExtension) String() string           { return AsString(n) }
+func (n *CreatePolicy) String() string              { return AsString(n) }
+func (n *CreatePublication) String() string         { return AsString(n) }
+func (n *CreateSubscription) String() string        { return AsString(n) }
 func (n *CreateFunction) String() string            { return AsString(n) }
 func (n *CreateIndex) String() string               { return AsString(n) }
 func (n *CreateLanguage) String() string            { return AsString(n) }
@@ -1276,6 +1336,9 @@ func (n *DropCast) String() string                  { return AsString(n) }
 func (n *DropDatabase) String() string              { return AsString(n) }
 func (n *DropDomain) String() string                { return AsString(n) }
 func (n *DropExtension) String() string             { return AsString(n) }
+func (n *DropPolicy) String() string                { return AsString(n) }
+func (n *DropPublication) String() string           { return AsString(n) }
+func (n *DropSubscription) String() string          { return AsString(n) }
 func (n *DropFunction) String() string              { return AsString(n) }
 func (n *DropIndex) String() string                 { return AsString(n) }
 func (n *DropLanguage) String() string              { return AsString(n) }
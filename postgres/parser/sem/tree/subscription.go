@@ -0,0 +1,188 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+import "github.com/dolthub/doltgresql/postgres/parser/lex"
+
+var _ Statement = &CreateSubscription{}
+
+// CreateSubscription represents a CREATE SUBSCRIPTION statement.
+type CreateSubscription struct {
+	Name         Name
+	ConnInfo     string
+	Publications NameList
+	Options      StorageParams
+}
+
+// Format implements the NodeFormatter interface.
+func (node *CreateSubscription) Format(ctx *FmtCtx) {
+	ctx.WriteString("CREATE SUBSCRIPTION ")
+	ctx.FormatNode(&node.Name)
+	ctx.WriteString(" CONNECTION ")
+	lex.EncodeSQLString(&ctx.Buffer, node.ConnInfo)
+	ctx.WriteString(" PUBLICATION ")
+	ctx.FormatNode(&node.Publications)
+	formatSubscriptionOptions(ctx, node.Options)
+}
+
+var _ Statement = &AlterSubscription{}
+
+// AlterSubscription represents an ALTER SUBSCRIPTION statement.
+type AlterSubscription struct {
+	Name Name
+	Cmd  AlterSubscriptionCmd
+}
+
+// Format implements the NodeFormatter interface.
+func (node *AlterSubscription) Format(ctx *FmtCtx) {
+	ctx.WriteString("ALTER SUBSCRIPTION ")
+	ctx.FormatNode(&node.Name)
+	ctx.FormatNode(node.Cmd)
+}
+
+// AlterSubscriptionCmd represents a subscription modification operation.
+type AlterSubscriptionCmd interface {
+	NodeFormatter
+	// Placeholder function to ensure that only desired types
+	// (AlterSubscription*) conform to the AlterSubscriptionCmd interface.
+	alterSubscriptionCmd()
+}
+
+func (*AlterSubscriptionConnection) alterSubscriptionCmd()  {}
+func (*AlterSubscriptionPublication) alterSubscriptionCmd() {}
+func (*AlterSubscriptionRefresh) alterSubscriptionCmd()     {}
+func (*AlterSubscriptionEnable) alterSubscriptionCmd()      {}
+func (*AlterSubscriptionSetOptions) alterSubscriptionCmd()  {}
+func (*AlterSubscriptionOwner) alterSubscriptionCmd()       {}
+func (*AlterSubscriptionRename) alterSubscriptionCmd()      {}
+
+var _ AlterSubscriptionCmd = &AlterSubscriptionConnection{}
+var _ AlterSubscriptionCmd = &AlterSubscriptionPublication{}
+var _ AlterSubscriptionCmd = &AlterSubscriptionRefresh{}
+var _ AlterSubscriptionCmd = &AlterSubscriptionEnable{}
+var _ AlterSubscriptionCmd = &AlterSubscriptionSetOptions{}
+var _ AlterSubscriptionCmd = &AlterSubscriptionOwner{}
+var _ AlterSubscriptionCmd = &AlterSubscriptionRename{}
+
+// AlterSubscriptionConnection represents an ALTER SUBSCRIPTION ... CONNECTION command.
+type AlterSubscriptionConnection struct {
+	ConnInfo string
+}
+
+// Format implements the NodeFormatter interface.
+func (node *AlterSubscriptionConnection) Format(ctx *FmtCtx) {
+	ctx.WriteString(" CONNECTION ")
+	lex.EncodeSQLString(&ctx.Buffer, node.ConnInfo)
+}
+
+// AlterSubscriptionPublicationAction is the action taken by an AlterSubscriptionPublication command.
+type AlterSubscriptionPublicationAction int
+
+// AlterSubscriptionPublicationAction values.
+const (
+	AlterSubscriptionPublicationSet AlterSubscriptionPublicationAction = iota
+	AlterSubscriptionPublicationAdd
+	AlterSubscriptionPublicationDrop
+)
+
+var alterSubscriptionPublicationActionName = [...]string{
+	AlterSubscriptionPublicationSet:  "SET",
+	AlterSubscriptionPublicationAdd:  "ADD",
+	AlterSubscriptionPublicationDrop: "DROP",
+}
+
+// AlterSubscriptionPublication represents an ALTER SUBSCRIPTION ... { SET | ADD | DROP } PUBLICATION command.
+type AlterSubscriptionPublication struct {
+	Action       AlterSubscriptionPublicationAction
+	Publications NameList
+	Options      StorageParams
+}
+
+// Format implements the NodeFormatter interface.
+func (node *AlterSubscriptionPublication) Format(ctx *FmtCtx) {
+	ctx.WriteByte(' ')
+	ctx.WriteString(alterSubscriptionPublicationActionName[node.Action])
+	ctx.WriteString(" PUBLICATION ")
+	ctx.FormatNode(&node.Publications)
+	formatSubscriptionOptions(ctx, node.Options)
+}
+
+// AlterSubscriptionRefresh represents an ALTER SUBSCRIPTION ... REFRESH PUBLICATION command.
+type AlterSubscriptionRefresh struct {
+	Options StorageParams
+}
+
+// Format implements the NodeFormatter interface.
+func (node *AlterSubscriptionRefresh) Format(ctx *FmtCtx) {
+	ctx.WriteString(" REFRESH PUBLICATION")
+	formatSubscriptionOptions(ctx, node.Options)
+}
+
+// AlterSubscriptionEnable represents an ALTER SUBSCRIPTION ... { ENABLE | DISABLE } command.
+type AlterSubscriptionEnable struct {
+	Enable bool
+}
+
+// Format implements the NodeFormatter interface.
+func (node *AlterSubscriptionEnable) Format(ctx *FmtCtx) {
+	if node.Enable {
+		ctx.WriteString(" ENABLE")
+	} else {
+		ctx.WriteString(" DISABLE")
+	}
+}
+
+// AlterSubscriptionSetOptions represents an ALTER SUBSCRIPTION ... SET ( options ) command.
+type AlterSubscriptionSetOptions struct {
+	Options StorageParams
+}
+
+// Format implements the NodeFormatter interface.
+func (node *AlterSubscriptionSetOptions) Format(ctx *FmtCtx) {
+	ctx.WriteString(" SET (")
+	ctx.FormatNode(&node.Options)
+	ctx.WriteByte(')')
+}
+
+// AlterSubscriptionOwner represents an ALTER SUBSCRIPTION ... OWNER TO command.
+type AlterSubscriptionOwner struct {
+	Owner string
+}
+
+// Format implements the NodeFormatter interface.
+func (node *AlterSubscriptionOwner) Format(ctx *FmtCtx) {
+	ctx.WriteString(" OWNER TO ")
+	ctx.WriteString(node.Owner)
+}
+
+// AlterSubscriptionRename represents an ALTER SUBSCRIPTION ... RENAME TO command.
+type AlterSubscriptionRename struct {
+	NewName Name
+}
+
+// Format implements the NodeFormatter interface.
+func (node *AlterSubscriptionRename) Format(ctx *FmtCtx) {
+	ctx.WriteString(" RENAME TO ")
+	ctx.FormatNode(&node.NewName)
+}
+
+// formatSubscriptionOptions formats the WITH clause of a subscription statement, if there are any options.
+func formatSubscriptionOptions(ctx *FmtCtx, options StorageParams) {
+	if len(options) > 0 {
+		ctx.WriteString(" WITH (")
+		ctx.FormatNode(&options)
+		ctx.WriteByte(')')
+	}
+}
@@ -701,6 +701,7 @@ func (expr *ComparisonExpr) TypeCheck(
 var (
 	errStarNotAllowed      = pgerror.New(pgcode.Syntax, "cannot use \"*\" in this context")
 	errInvalidDefaultUsage = pgerror.New(pgcode.Syntax, "DEFAULT can only appear in a VALUES list within INSERT or on the right side of a SET")
+	errNamedArgUsage       = pgerror.New(pgcode.Syntax, "named arguments can only appear within a function call")
 	errInvalidMaxUsage     = pgerror.New(pgcode.Syntax, "MAXVALUE can only appear within a range partition expression")
 	errInvalidMinUsage     = pgerror.New(pgcode.Syntax, "MINVALUE can only appear within a range partition expression")
 	errPrivateFunction     = pgerror.New(pgcode.ReservedName, "function reserved for internal use")
@@ -1297,6 +1298,13 @@ func (expr *UnaryExpr) TypeCheck(
 	return expr, nil
 }
 
+// TypeCheck implements the Expr interface.
+func (expr *NamedArgExpr) TypeCheck(
+	_ context.Context, _ *SemaContext, desired *types.T,
+) (TypedExpr, error) {
+	return nil, errNamedArgUsage
+}
+
 // TypeCheck implements the Expr interface.
 func (expr DefaultVal) TypeCheck(
 	_ context.Context, _ *SemaContext, desired *types.T,
@@ -435,6 +435,17 @@ func (expr *IsOfTypeExpr) Walk(v Visitor) Expr {
 	return expr
 }
 
+// Walk implements the Expr interface.
+func (expr *NamedArgExpr) Walk(v Visitor) Expr {
+	e, changed := WalkExpr(v, expr.Value)
+	if changed {
+		exprCopy := *expr
+		exprCopy.Value = e
+		return &exprCopy
+	}
+	return expr
+}
+
 // Walk implements the Expr interface.
 func (expr *NotExpr) Walk(v Visitor) Expr {
 	e, changed := WalkExpr(v, expr.Expr)
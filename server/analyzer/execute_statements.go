@@ -0,0 +1,84 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"fmt"
+
+	"github.com/cockroachdb/errors"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/analyzer"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/planbuilder"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/core"
+	pgexpression "github.com/dolthub/doltgresql/server/expression"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
+	"github.com/dolthub/doltgresql/server/prepared"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// ResolveExecuteStatements replaces an EXECUTE statement with the prepared statement that it executes. Each parameter
+// is cast to the type of the prepared statement's parameter, and bound in the same way as the parameters of a Bind
+// message, so that the rest of the analysis sees the statement as though it had been run directly.
+func ResolveExecuteStatements(ctx *sql.Context, a *analyzer.Analyzer, node sql.Node, scope *plan.Scope, selector analyzer.RuleSelector, qFlags *sql.QueryFlags) (sql.Node, transform.TreeIdentity, error) {
+	execute, ok := node.(*pgnodes.ExecuteStatement)
+	if !ok {
+		return node, transform.SameTree, nil
+	}
+	statement, ok := prepared.Get(ctx.Session.ID(), execute.Name)
+	if !ok {
+		return nil, transform.NewTree, errors.Errorf(`prepared statement "%s" does not exist`, execute.Name)
+	}
+	if len(execute.Params) != len(statement.ParameterTypes) {
+		return nil, transform.NewTree, errors.Errorf(`wrong number of parameters for prepared statement "%s"`, execute.Name)
+	}
+	bindings := make(map[string]vitess.Expr, len(execute.Params))
+	if len(execute.Params) > 0 {
+		typeColl, err := core.GetTypesCollectionFromContext(ctx, "")
+		if err != nil {
+			return nil, transform.NewTree, err
+		}
+		for i, param := range execute.Params {
+			bindVar := fmt.Sprintf("v%d", i+1)
+			typ, err := typeColl.GetType(ctx, statement.ParameterTypes[i])
+			if err != nil {
+				return nil, transform.NewTree, err
+			}
+			// Parameters whose type could not be determined are bound as given
+			if typ == nil || typ.ID == pgtypes.Unknown.ID {
+				bindings[bindVar] = param
+				continue
+			}
+			cast, err := pgexpression.NewExplicitCastInjectable(typ)
+			if err != nil {
+				return nil, transform.NewTree, err
+			}
+			bindings[bindVar] = vitess.InjectedExpr{
+				Expression: cast,
+				Children:   vitess.Exprs{param},
+			}
+		}
+	}
+	builder := planbuilder.New(ctx, a.Catalog, nil)
+	builder.SetBindings(bindings)
+	bound, _, err := builder.BindOnly(statement.AST, statement.Query, qFlags)
+	if err != nil {
+		return nil, transform.NewTree, err
+	}
+	return bound, transform.NewTree, nil
+}
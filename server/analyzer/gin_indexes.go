@@ -0,0 +1,352 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/analyzer"
+	gmsexpression "github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+
+	"github.com/dolthub/doltgresql/core"
+	pgexpression "github.com/dolthub/doltgresql/server/expression"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
+	pgtransform "github.com/dolthub/doltgresql/server/transform"
+)
+
+// ApplyGinIndexMaintenance wraps the destinations of INSERT, UPDATE, DELETE, and TRUNCATE statements, so that the
+// posting lists of any GIN indexes on the destination tables are updated along with the rows.
+func ApplyGinIndexMaintenance(ctx *sql.Context, a *analyzer.Analyzer, node sql.Node, scope *plan.Scope, selector analyzer.RuleSelector, qFlags *sql.QueryFlags) (sql.Node, transform.TreeIdentity, error) {
+	return pgtransform.NodeWithOpaque(ctx, node, func(ctx *sql.Context, node sql.Node) (sql.Node, transform.TreeIdentity, error) {
+		switch node := node.(type) {
+		case *plan.InsertInto:
+			if plan.IsEmptyTable(node.Destination) || isGinIndexMaintenance(node.Destination) {
+				return node, transform.SameTree, nil
+			}
+			insertable, err := plan.GetInsertable(node.Destination)
+			if err != nil {
+				return nil, transform.NewTree, err
+			}
+			wrapped, ok, err := pgnodes.NewGinIndexMaintenance(ctx, insertable, node.Destination)
+			if err != nil || !ok {
+				return node, transform.SameTree, err
+			}
+			newNode, err := node.WithChildren(ctx, wrapped)
+			return newNode, transform.NewTree, err
+		case *plan.Update:
+			if plan.IsEmptyTable(node.Child) || isGinIndexMaintenance(node.Child) {
+				return node, transform.SameTree, nil
+			}
+			if updateJoin, ok := node.Child.(*plan.UpdateJoin); ok {
+				targets := make(map[string]sql.Node, len(updateJoin.UpdateTargets))
+				same := transform.SameTree
+				for tableName, target := range updateJoin.UpdateTargets {
+					targets[tableName] = target
+					if isGinIndexMaintenance(target) {
+						continue
+					}
+					updatable, err := plan.GetUpdatable(target)
+					if err != nil {
+						return nil, transform.NewTree, err
+					}
+					wrapped, ok, err := pgnodes.NewGinIndexMaintenance(ctx, updatable, target)
+					if err != nil {
+						return nil, transform.NewTree, err
+					}
+					if ok {
+						targets[tableName] = wrapped
+						same = transform.NewTree
+					}
+				}
+				if same {
+					return node, transform.SameTree, nil
+				}
+				newNode, err := node.WithChildren(ctx, plan.NewUpdateJoin(targets, updateJoin.Child))
+				return newNode, transform.NewTree, err
+			}
+			updatable, err := plan.GetUpdatable(node.Child)
+			if err != nil {
+				return nil, transform.NewTree, err
+			}
+			wrapped, ok, err := pgnodes.NewGinIndexMaintenance(ctx, updatable, node.Child)
+			if err != nil || !ok {
+				return node, transform.SameTree, err
+			}
+			newNode, err := node.WithChildren(ctx, wrapped)
+			return newNode, transform.NewTree, err
+		case *plan.DeleteFrom:
+			if plan.IsEmptyTable(node.Child) {
+				return node, transform.SameTree, nil
+			}
+			targets := node.GetDeleteTargets()
+			newTargets := make([]sql.Node, len(targets))
+			same := transform.SameTree
+			for i, target := range targets {
+				newTargets[i] = target
+				if isGinIndexMaintenance(target) {
+					continue
+				}
+				deletable, err := plan.GetDeletable(target)
+				if err != nil {
+					return nil, transform.NewTree, err
+				}
+				wrapped, ok, err := pgnodes.NewGinIndexMaintenance(ctx, deletable, target)
+				if err != nil {
+					return nil, transform.NewTree, err
+				}
+				if ok {
+					newTargets[i] = wrapped
+					same = transform.NewTree
+				}
+			}
+			if same {
+				return node, transform.SameTree, nil
+			}
+			if node.HasExplicitTargets() {
+				return node.WithExplicitTargets(newTargets), transform.NewTree, nil
+			}
+			return node.WithTargets(newTargets), transform.NewTree, nil
+		case *plan.Truncate:
+			if isGinIndexMaintenance(node.Child) {
+				return node, transform.SameTree, nil
+			}
+			truncatable, err := plan.GetTruncatable(node.Child)
+			if err != nil {
+				return nil, transform.NewTree, err
+			}
+			wrapped, ok, err := pgnodes.NewGinIndexMaintenance(ctx, truncatable, node.Child)
+			if err != nil || !ok {
+				return node, transform.SameTree, err
+			}
+			newNode, err := node.WithChildren(ctx, wrapped)
+			return newNode, transform.NewTree, err
+		default:
+			return node, transform.SameTree, nil
+		}
+	})
+}
+
+// ApplyGinIndexScans replaces the scans of tables that are filtered by an operator that a GIN index supports, such as
+// JSONB containment, with a scan of the GIN index. The filter is kept so that the rows found by the index are
+// rechecked.
+func ApplyGinIndexScans(ctx *sql.Context, a *analyzer.Analyzer, node sql.Node, scope *plan.Scope, selector analyzer.RuleSelector, qFlags *sql.QueryFlags) (sql.Node, transform.TreeIdentity, error) {
+	return pgtransform.NodeWithOpaque(ctx, node, func(ctx *sql.Context, node sql.Node) (sql.Node, transform.TreeIdentity, error) {
+		filter, ok := node.(*plan.Filter)
+		if !ok {
+			return node, transform.SameTree, nil
+		}
+		for _, conjunct := range gmsexpression.SplitConjunction(ctx, filter.Expression) {
+			binOp, ok := conjunct.(*pgexpression.BinaryOperator)
+			if !ok {
+				continue
+			}
+			column, operator, value, ok := ginSearchArguments(ctx, binOp)
+			if !ok {
+				continue
+			}
+			newChild, replaced, err := replaceWithGinIndexScan(ctx, filter.Child, column, operator, value)
+			if err != nil {
+				return nil, transform.NewTree, err
+			}
+			if replaced {
+				newNode, err := filter.WithChildren(ctx, newChild)
+				return newNode, transform.NewTree, err
+			}
+		}
+		return node, transform.SameTree, nil
+	})
+}
+
+// ginSearchArguments returns the column, operator, and value that a GIN index would be searched with for the given
+// operator. The operator is commuted when the column is on its right side. Returns false if the operator does not
+// compare a column to a constant value.
+func ginSearchArguments(ctx *sql.Context, binOp *pgexpression.BinaryOperator) (*gmsexpression.GetField, framework.Operator, sql.Expression, bool) {
+	left, right := unwrapGinSearchColumn(binOp.Left()), unwrapGinSearchColumn(binOp.Right())
+	if column, ok := left.(*gmsexpression.GetField); ok && isGinSearchValue(ctx, binOp.Right()) {
+		return column, binOp.Operator(), binOp.Right(), true
+	}
+	if column, ok := right.(*gmsexpression.GetField); ok && isGinSearchValue(ctx, binOp.Left()) {
+		switch binOp.Operator() {
+		case framework.Operator_BinaryJSONContainsLeft:
+			return column, framework.Operator_BinaryJSONContainsRight, binOp.Left(), true
+		case framework.Operator_BinaryOverlap:
+			return column, framework.Operator_BinaryOverlap, binOp.Left(), true
+		}
+	}
+	return nil, 0, nil, false
+}
+
+// unwrapGinSearchColumn removes a GMSCast from a column, since the cast does not change the column's value.
+func unwrapGinSearchColumn(expr sql.Expression) sql.Expression {
+	if cast, ok := expr.(*pgexpression.GMSCast); ok {
+		return cast.Child()
+	}
+	return expr
+}
+
+// isGinSearchValue returns whether the expression may be evaluated once for an index search, meaning that it does not
+// depend on any rows or parameters.
+func isGinSearchValue(ctx *sql.Context, expr sql.Expression) bool {
+	if expr == nil {
+		return false
+	}
+	return !transform.InspectExpr(ctx, expr, func(ctx *sql.Context, expr sql.Expression) bool {
+		switch expr.(type) {
+		case *gmsexpression.GetField, *gmsexpression.BindVar, *gmsexpression.ProcedureParam, *plan.Subquery:
+			return true
+		default:
+			return false
+		}
+	})
+}
+
+// replaceWithGinIndexScan replaces the table beneath the given node with a GIN index scan, looking through any filters
+// and aliases that sit between them. Returns false if the table was not replaced.
+func replaceWithGinIndexScan(ctx *sql.Context, node sql.Node, column *gmsexpression.GetField, operator framework.Operator, value sql.Expression) (sql.Node, bool, error) {
+	switch node := node.(type) {
+	case *plan.Filter:
+		child, replaced, err := replaceWithGinIndexScan(ctx, node.Child, column, operator, value)
+		if err != nil || !replaced {
+			return node, false, err
+		}
+		newNode, err := node.WithChildren(ctx, child)
+		return newNode, err == nil, err
+	case *plan.TableAlias:
+		rt, ok := node.Child.(*plan.ResolvedTable)
+		if !ok || !strings.EqualFold(node.Name(), column.Table()) {
+			return node, false, nil
+		}
+		scan, ok, err := newGinIndexScan(ctx, rt, column, operator, value)
+		if err != nil || !ok {
+			return node, false, err
+		}
+		newNode, err := node.WithChildren(ctx, scan)
+		return newNode, err == nil, err
+	case *plan.ResolvedTable:
+		if !strings.EqualFold(node.Name(), column.Table()) {
+			return node, false, nil
+		}
+		scan, ok, err := newGinIndexScan(ctx, node, column, operator, value)
+		if err != nil || !ok {
+			return node, false, err
+		}
+		return scan, true, nil
+	default:
+		return node, false, nil
+	}
+}
+
+// newGinIndexScan returns a GIN index scan of the table. Returns false if the table cannot be scanned by a GIN index.
+func newGinIndexScan(ctx *sql.Context, rt *plan.ResolvedTable, column *gmsexpression.GetField, operator framework.Operator, value sql.Expression) (sql.Node, bool, error) {
+	// Index scans are run as nested statements against the current database, so other databases and historical
+	// revisions use a regular table scan.
+	if rt.AsOf != nil || rt.Database() == nil || !strings.EqualFold(rt.Database().Name(), ctx.GetCurrentDatabase()) {
+		return nil, false, nil
+	}
+	scan, ok, err := pgnodes.NewGinIndexScan(ctx, rt, column.Name(), operator, value)
+	if err != nil || !ok {
+		return nil, false, err
+	}
+	return scan, true, nil
+}
+
+// isGinIndexMaintenance returns whether the node has already been wrapped for GIN index maintenance.
+func isGinIndexMaintenance(node sql.Node) bool {
+	_, ok := node.(*pgnodes.GinIndexMaintenance)
+	return ok
+}
+
+// ginListingSystemTables are the Dolt system tables that list the tables of a database, by their unprefixed names.
+// Conflicts and constraint violations are left visible, since they must be resolved before a merge can be committed.
+var ginListingSystemTables = map[string]struct{}{
+	"column_diff":    {},
+	"diff":           {},
+	"status":         {},
+	"status_ignored": {},
+}
+
+// ginListingTableFunctions are the Dolt table functions that list the tables of a database.
+var ginListingTableFunctions = map[string]struct{}{
+	"dolt_diff_stat":    {},
+	"dolt_diff_summary": {},
+	"dolt_patch":        {},
+	"dolt_schema_diff":  {},
+}
+
+// HideGinPostingTables wraps the Dolt system tables and table functions that list tables, so that the tables that hold
+// the posting lists of GIN indexes are hidden from them. The tables are matched from their parents, so that nodes that
+// have already been wrapped are not wrapped again.
+func HideGinPostingTables(ctx *sql.Context, a *analyzer.Analyzer, node sql.Node, scope *plan.Scope, selector analyzer.RuleSelector, qFlags *sql.QueryFlags) (sql.Node, transform.TreeIdentity, error) {
+	node, same, err := pgtransform.NodeWithOpaque(ctx, node, func(ctx *sql.Context, node sql.Node) (sql.Node, transform.TreeIdentity, error) {
+		children := node.Children()
+		var newChildren []sql.Node
+		for i, child := range children {
+			columns := ginListingColumns(ctx, child)
+			if len(columns) == 0 {
+				continue
+			}
+			if newChildren == nil {
+				newChildren = slices.Clone(children)
+			}
+			newChildren[i] = pgnodes.NewGinPostingTableFilter(child, columns)
+		}
+		if newChildren == nil {
+			return node, transform.SameTree, nil
+		}
+		newNode, err := node.WithChildren(ctx, newChildren...)
+		return newNode, transform.NewTree, err
+	})
+	if err != nil {
+		return nil, transform.NewTree, err
+	}
+	if columns := ginListingColumns(ctx, node); len(columns) > 0 {
+		return pgnodes.NewGinPostingTableFilter(node, columns), transform.NewTree, nil
+	}
+	return node, same, nil
+}
+
+// ginListingColumns returns the indexes of the columns that hold table names when the given node is a Dolt system
+// table or table function that lists tables. Returns nothing for all other nodes.
+func ginListingColumns(ctx *sql.Context, node sql.Node) []int {
+	switch node := node.(type) {
+	case sql.TableFunction:
+		if _, ok := ginListingTableFunctions[strings.ToLower(node.Name())]; !ok {
+			return nil
+		}
+	case sql.TableNode:
+		if core.SQLTableToDoltTable(node.UnderlyingTable()) != nil {
+			return nil
+		}
+		name := strings.TrimPrefix(strings.ToLower(node.UnderlyingTable().Name()), "dolt_")
+		if _, ok := ginListingSystemTables[name]; !ok {
+			return nil
+		}
+	default:
+		return nil
+	}
+	var columns []int
+	for i, col := range node.Schema(ctx) {
+		switch strings.ToLower(col.Name) {
+		case "table_name", "from_table_name", "to_table_name":
+			columns = append(columns, i)
+		}
+	}
+	return columns
+}
@@ -55,12 +55,22 @@ const (
 	ruleId_ResolveProcedureDefaults                                      // resolveProcedureDefaults
 	ruleId_SetRunner                                                     // setRunner
 	ruleId_TypeSanitizeExistsSubquery                                    // typeSanitizeExistsSubquery
+	ruleId_ValidateMaterializedViews                                     // validateMaterializedViews
+	ruleId_ApplyRowLevelSecurity                                         // applyRowLevelSecurity
+	ruleId_ApplyGinIndexMaintenance                                      // applyGinIndexMaintenance
+	ruleId_ApplyGinIndexScans                                            // applyGinIndexScans
+	ruleId_HideGinPostingTables                                          // hideGinPostingTables
+	ruleId_ResolveExecuteStatements                                      // resolveExecuteStatements
+	ruleId_TrackIsolation                                                // trackIsolation
+	ruleId_QualifyDefaultSequences                                       // qualifyDefaultSequences
 )
 
 // Init adds additional rules to the analyzer to handle Doltgres-specific functionality.
 func Init() {
 	// OnceBeforeDefault runs before AlwaysBeforeDefault in GMS
 	analyzer.OnceBeforeDefault = append([]analyzer.Rule{
+		// EXECUTE is replaced by its prepared statement before any other rule, so that the statement is analyzed in full
+		{Id: ruleId_ResolveExecuteStatements, Apply: ResolveExecuteStatements},
 		{Id: ruleId_ResolveType, Apply: ResolveType}, // ResolveType rule must run before simplifyFilters rule in GMS
 		{Id: ruleId_ApplyTablesForAnalyzeAllTables, Apply: applyTablesForAnalyzeAllTables},
 		{Id: ruleId_ConvertDropPrimaryKeyConstraint, Apply: convertDropPrimaryKeyConstraint}},
@@ -82,6 +92,8 @@ func Init() {
 		analyzer.Rule{Id: ruleId_ValidateCreateFunction, Apply: ValidateCreateFunction},
 		analyzer.Rule{Id: ruleId_ValidateCreateSchema, Apply: ValidateCreateSchema},
 		analyzer.Rule{Id: ruleId_ResolveProcedureDefaults, Apply: ResolveProcedureDefaults},
+		analyzer.Rule{Id: ruleId_ValidateMaterializedViews, Apply: ValidateMaterializedViews},
+		analyzer.Rule{Id: ruleId_ApplyRowLevelSecurity, Apply: ApplyRowLevelSecurity},
 	)
 
 	// We remove several validation rules and substitute our own
@@ -106,6 +118,7 @@ func Init() {
 
 	analyzer.OnceAfterDefault = append(analyzer.OnceAfterDefault,
 		analyzer.Rule{Id: ruleId_ReplaceSerial, Apply: ReplaceSerial},
+		analyzer.Rule{Id: ruleId_QualifyDefaultSequences, Apply: QualifyDefaultSequences},
 		analyzer.Rule{Id: ruleId_ReplaceArithmeticExpressions, Apply: ReplaceArithmeticExpressions},
 		// Must run after GMS's unnestExistsSubqueries rule, so decorrelation gets a chance to see
 		// a bare *plan.ExistsSubquery before it's cast-wrapped.
@@ -119,6 +132,12 @@ func Init() {
 		// AddDomainConstraintsToCasts needs to run after 'assignExecIndexes' rule in GMS.
 		analyzer.Rule{Id: ruleId_AddDomainConstraintsToCasts, Apply: AddDomainConstraintsToCasts},
 		analyzer.Rule{Id: ruleId_ReplaceNode, Apply: ReplaceNode},
+		// TrackIsolation only inspects the final tree, so it runs once all nodes that read or write tables are in place.
+		analyzer.Rule{Id: ruleId_TrackIsolation, Apply: TrackIsolation},
+		// GIN indexes are applied after all other optimizations, so that index scans only replace full table scans.
+		analyzer.Rule{Id: ruleId_ApplyGinIndexScans, Apply: ApplyGinIndexScans},
+		analyzer.Rule{Id: ruleId_ApplyGinIndexMaintenance, Apply: ApplyGinIndexMaintenance},
+		analyzer.Rule{Id: ruleId_HideGinPostingTables, Apply: HideGinPostingTables},
 		analyzer.Rule{Id: ruleId_InsertContextRootFinalizer, Apply: InsertContextRootFinalizer},
 	)
 
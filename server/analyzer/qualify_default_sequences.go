@@ -0,0 +1,104 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/analyzer"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+
+	pgexprs "github.com/dolthub/doltgresql/server/expression"
+	"github.com/dolthub/doltgresql/server/functions"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+)
+
+// QualifyDefaultSequences qualifies the sequences that are referenced by column defaults with the database of the table
+// that is being inserted into. Defaults do not store the database of their sequences, as they always refer to the
+// database containing the table, however they are evaluated against the current database, which may differ (such as
+// when inserting into a table on another branch).
+func QualifyDefaultSequences(ctx *sql.Context, a *analyzer.Analyzer, node sql.Node, scope *plan.Scope, selector analyzer.RuleSelector, qFlags *sql.QueryFlags) (sql.Node, transform.TreeIdentity, error) {
+	return transform.Node(ctx, node, func(ctx *sql.Context, n sql.Node) (sql.Node, transform.TreeIdentity, error) {
+		insertInto, ok := n.(*plan.InsertInto)
+		if !ok || insertInto.Database() == nil {
+			return n, transform.SameTree, nil
+		}
+		database := insertInto.Database().Name()
+		if len(database) == 0 || strings.EqualFold(database, ctx.GetCurrentDatabase()) {
+			return n, transform.SameTree, nil
+		}
+		newSource, sameSource, err := transform.NodeExprs(ctx, insertInto.Source, func(ctx *sql.Context, expr sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+			return transform.Expr(ctx, expr, func(ctx *sql.Context, expr sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+				defaultValue, ok := expr.(*sql.ColumnDefaultValue)
+				if !ok || defaultValue == nil || defaultValue.Expr == nil {
+					return expr, transform.SameTree, nil
+				}
+				newExpr, sameExpr, err := transform.Expr(ctx, defaultValue.Expr, func(ctx *sql.Context, expr sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+					return qualifyNextval(ctx, expr, database)
+				})
+				if err != nil || sameExpr {
+					return expr, transform.SameTree, err
+				}
+				newDefaultValue, err := defaultValue.WithChildren(ctx, newExpr)
+				if err != nil {
+					return nil, transform.NewTree, err
+				}
+				return newDefaultValue, transform.NewTree, nil
+			})
+		})
+		if err != nil || sameSource {
+			return n, transform.SameTree, err
+		}
+		return insertInto.WithSource(newSource), transform.NewTree, nil
+	})
+}
+
+// qualifyNextval returns a new nextval call that has its sequence qualified with the given database, if the expression
+// is a call to nextval with an unqualified sequence name.
+func qualifyNextval(ctx *sql.Context, expr sql.Expression, database string) (sql.Expression, transform.TreeIdentity, error) {
+	compiledFunction, ok := expr.(*framework.CompiledFunction)
+	if !ok || !strings.EqualFold(compiledFunction.Name, "nextval") || len(compiledFunction.Arguments) != 1 {
+		return expr, transform.SameTree, nil
+	}
+	literal, ok := compiledFunction.Arguments[0].(*expression.Literal)
+	if !ok {
+		return expr, transform.SameTree, nil
+	}
+	relationName, ok := literal.Value().(string)
+	if !ok {
+		return expr, transform.SameTree, nil
+	}
+	relationDatabase, schema, relation, err := functions.ParseQualifiedRelationName(ctx, relationName)
+	if err != nil {
+		return nil, transform.NewTree, err
+	}
+	// Sequences belonging to temporary tables are held by the session, so they're not qualified
+	if len(relationDatabase) > 0 || strings.HasPrefix(strings.ToLower(schema), "pg_temp") {
+		return expr, transform.SameTree, nil
+	}
+	qualifiedName := fmt.Sprintf(`"%s"."%s"."%s"`, database, schema, relation)
+	nextVal, found, err := framework.GetFunction(ctx, "nextval", pgexprs.NewTextLiteral(qualifiedName))
+	if err != nil {
+		return nil, transform.NewTree, err
+	}
+	if !found {
+		return expr, transform.SameTree, nil
+	}
+	return nextVal, transform.NewTree, nil
+}
@@ -30,6 +30,17 @@ func ReplaceNode(ctx *sql.Context, a *analyzer.Analyzer, node sql.Node, scope *p
 	switch node := node.(type) {
 	case *plan.DropTable:
 		return pgnodes.NewDropTable(node), transform.NewTree, nil
+	case *plan.DropView:
+		return pgnodes.NewDropView(node), transform.NewTree, nil
+	case *plan.AlterIndex:
+		switch node.Action {
+		case plan.IndexAction_Create:
+			return pgnodes.NewCreateIndex(node), transform.NewTree, nil
+		case plan.IndexAction_Drop:
+			return pgnodes.NewDropIndex(node), transform.NewTree, nil
+		default:
+			return node, transform.SameTree, nil
+		}
 	default:
 		return node, transform.SameTree, nil
 	}
@@ -65,6 +65,18 @@ func ResolveTypeForNodes(ctx *sql.Context, a *analyzer.Analyzer, node sql.Node,
 				col.Type = dt
 			}
 			return node, same, nil
+		case *pgnodes.AlterType:
+			for i, attr := range n.Attributes {
+				if attr.Type != nil && !attr.Type.IsResolvedType() {
+					dt, err := resolveType(ctx, db, attr.Type)
+					if err != nil {
+						return nil, transform.NewTree, err
+					}
+					same = transform.NewTree
+					n.Attributes[i].Type = dt
+				}
+			}
+			return node, same, nil
 		case *pgnodes.CreateAggregate:
 			if !n.SType.IsResolvedType() {
 				sType, err := resolveType(ctx, db, n.SType)
@@ -0,0 +1,281 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/analyzer"
+	gmsexpression "github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/planbuilder"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/id"
+	"github.com/dolthub/doltgresql/core/policies"
+	"github.com/dolthub/doltgresql/postgres/parser/parser"
+	"github.com/dolthub/doltgresql/server/ast"
+	"github.com/dolthub/doltgresql/server/auth"
+	pgexpression "github.com/dolthub/doltgresql/server/expression"
+	"github.com/dolthub/doltgresql/utils"
+)
+
+// ApplyRowLevelSecurity applies the row-level security policies of every table that has row-level security enabled.
+// Rows that are scanned are filtered by the USING expressions of the policies, while rows that are inserted or updated
+// are verified by the WITH CHECK expressions. As table ownership is not yet tracked, every table is owned by the
+// bootstrap superuser, so FORCE ROW LEVEL SECURITY does not change which roles the policies apply to.
+func ApplyRowLevelSecurity(ctx *sql.Context, a *analyzer.Analyzer, n sql.Node, scope *plan.Scope, selector analyzer.RuleSelector, qFlags *sql.QueryFlags) (sql.Node, transform.TreeIdentity, error) {
+	if !core.IsContextValid(ctx) || ctx.Client().User == "" {
+		return n, transform.SameTree, nil
+	}
+	var role auth.Role
+	auth.LockRead(func() {
+		role = auth.GetRole(ctx.Client().User)
+	})
+	if !role.IsValid() || role.IsSuperUser || role.CanBypassRowLevelSecurity {
+		return n, transform.SameTree, nil
+	}
+	// Scans of the table targeted by an UPDATE or DELETE use the policies of that command, while all other scans use
+	// the policies for SELECT.
+	targetCommand := policies.PolicyCommand_Select
+	targetTable := id.NullTable
+	transform.Inspect(n, func(node sql.Node) bool {
+		switch node := node.(type) {
+		case *plan.Update:
+			if updatable, err := plan.GetUpdatable(node.Child); err == nil {
+				targetCommand, targetTable = policies.PolicyCommand_Update, sqlTableID(updatable)
+			}
+			return false
+		case *plan.DeleteFrom:
+			if deletable, err := plan.GetDeletable(node.Child); err == nil {
+				targetCommand, targetTable = policies.PolicyCommand_Delete, sqlTableID(deletable)
+			}
+			return false
+		}
+		return true
+	})
+	n, same, err := transform.NodeWithCtx(ctx, n, nil, func(ctx *sql.Context, c transform.Context) (sql.Node, transform.TreeIdentity, error) {
+		rt, ok := c.Node.(*plan.ResolvedTable)
+		if !ok || rt.Database() == nil {
+			return c.Node, transform.SameTree, nil
+		}
+		// The destination of an INSERT is not scanned, and tables that already have their policies applied are skipped
+		switch parent := c.Parent.(type) {
+		case *plan.InsertInto:
+			return c.Node, transform.SameTree, nil
+		case *plan.Filter:
+			if _, ok = parent.Expression.(*pgexpression.RowSecurity); ok {
+				return c.Node, transform.SameTree, nil
+			}
+		}
+		tableID := sqlTableID(rt.Table)
+		if !tableID.IsValid() {
+			return c.Node, transform.SameTree, nil
+		}
+		command := policies.PolicyCommand_Select
+		if tableID == targetTable {
+			command = targetCommand
+		}
+		expr, err := buildRowSecurityExpression(ctx, a, role, rt.Database().Name(), tableID, command, false)
+		if err != nil || expr == nil {
+			return c.Node, transform.SameTree, err
+		}
+		expr, err = rebindRowSecurityExpression(ctx, rt, expr)
+		if err != nil {
+			return nil, transform.NewTree, err
+		}
+		return plan.NewFilter(pgexpression.NewRowSecurityUsing(expr, tableID.TableName()), rt), transform.NewTree, nil
+	})
+	if err != nil {
+		return nil, transform.NewTree, err
+	}
+	// New rows are verified by adding the WITH CHECK expressions to the checks of the node
+	var checkNode sql.CheckConstraintNode
+	var checkTable sql.Table
+	var checkTableSource sql.Node
+	checkCommand := policies.PolicyCommand_Insert
+	switch node := n.(type) {
+	case *plan.InsertInto:
+		checkNode = node
+		checkTable, _ = plan.GetInsertable(node.Destination)
+		checkTableSource = node.Destination
+	case *plan.Update:
+		checkNode = node
+		checkTable, _ = plan.GetUpdatable(node.Child)
+		checkTableSource = node.Child
+		checkCommand = policies.PolicyCommand_Update
+	default:
+		return n, same, nil
+	}
+	for _, check := range checkNode.Checks() {
+		if _, ok := check.Expr.(*pgexpression.RowSecurity); ok {
+			return n, same, nil
+		}
+	}
+	tableID := sqlTableID(checkTable)
+	if !tableID.IsValid() {
+		return n, same, nil
+	}
+	// The policies are read from the database that the table was resolved from, which may not be the current database
+	database := resolvedTableDatabase(checkTableSource, tableID)
+	if len(database) == 0 {
+		return n, same, nil
+	}
+	expr, err := buildRowSecurityExpression(ctx, a, role, database, tableID, checkCommand, true)
+	if err != nil || expr == nil {
+		return n, same, err
+	}
+	checks := append(checkNode.Checks(), &sql.CheckConstraint{
+		Name:     "row level security",
+		Expr:     pgexpression.NewRowSecurityCheck(expr, tableID.TableName()),
+		Enforced: true,
+	})
+	return checkNode.WithChecks(checks), transform.NewTree, nil
+}
+
+// sqlTableID returns the ID of the given table. Returns an invalid ID if the table is not a Dolt table.
+func sqlTableID(table sql.Table) id.Table {
+	if table == nil {
+		return id.NullTable
+	}
+	doltTable := core.SQLTableToDoltTable(table)
+	if doltTable == nil {
+		return id.NullTable
+	}
+	tableName := doltTable.TableName()
+	return id.NewTable(tableName.Schema, tableName.Name)
+}
+
+// resolvedTableDatabase returns the name of the database that the table with the given ID was resolved from within the
+// given node. Returns an empty string if the table could not be found.
+func resolvedTableDatabase(node sql.Node, tableID id.Table) string {
+	var database string
+	transform.Inspect(node, func(node sql.Node) bool {
+		if rt, ok := node.(*plan.ResolvedTable); ok && rt.Database() != nil && sqlTableID(rt.Table) == tableID {
+			database = rt.Database().Name()
+		}
+		return len(database) == 0
+	})
+	return database
+}
+
+// buildRowSecurityExpression returns the combined expression of the policies that apply to the role for the given
+// command. Permissive policies are combined with OR, and restrictive policies are combined with AND. Returns a nil
+// expression if the table does not have row-level security enabled. When isCheck is true, the WITH CHECK expressions
+// are used, falling back to the USING expressions for policies that do not have one.
+func buildRowSecurityExpression(ctx *sql.Context, a *analyzer.Analyzer, role auth.Role, database string, tableID id.Table, command policies.PolicyCommand, isCheck bool) (sql.Expression, error) {
+	collection, err := core.GetPoliciesCollectionFromContext(ctx, database)
+	if err != nil {
+		return nil, err
+	}
+	if !collection.GetTableSecurity(ctx, tableID).Enabled {
+		return nil, nil
+	}
+	var permissive, restrictive []string
+	for _, policy := range collection.GetPoliciesForTable(ctx, tableID) {
+		if !policy.AppliesTo(command) || !policyAppliesToRole(role, policy) {
+			continue
+		}
+		expression := policy.Using
+		if isCheck && len(policy.WithCheck) > 0 {
+			expression = policy.WithCheck
+		}
+		// Policies without an expression for this clause do not contribute to it
+		if len(expression) == 0 {
+			continue
+		}
+		if policy.Restrictive {
+			restrictive = append(restrictive, "("+expression+")")
+		} else {
+			permissive = append(permissive, "("+expression+")")
+		}
+	}
+	// When no permissive policies apply, then no rows are visible or may be modified
+	combined := "false"
+	if len(permissive) > 0 {
+		combined = "(" + strings.Join(permissive, " OR ") + ")"
+	}
+	if len(restrictive) > 0 {
+		combined += " AND " + strings.Join(restrictive, " AND ")
+	}
+	stmt, err := parser.ParseOne(fmt.Sprintf("SELECT %s FROM %s", combined,
+		utils.QuoteQualifiedIdentifier(tableID.SchemaName(), tableID.TableName())))
+	if err != nil {
+		return nil, err
+	}
+	parsed, err := ast.Convert(stmt)
+	if err != nil {
+		return nil, err
+	}
+	selectStmt, ok := parsed.(*vitess.Select)
+	if !ok || len(selectStmt.SelectExprs) != 1 || len(selectStmt.From) != 1 {
+		return nil, errors.Errorf(`invalid row-level security policy for table "%s"`, tableID.TableName())
+	}
+	ae, ok := selectStmt.SelectExprs[0].(*vitess.AliasedExpr)
+	if !ok {
+		return nil, errors.Errorf(`invalid row-level security policy for table "%s"`, tableID.TableName())
+	}
+	builder := planbuilder.New(ctx, a.Catalog, nil)
+	return builder.BuildScalarWithTable(ae.Expr, selectStmt.From[0]), nil
+}
+
+// rebindRowSecurityExpression binds the columns of the expression to the given table, since the expression was built
+// against a separate instance of the table.
+func rebindRowSecurityExpression(ctx *sql.Context, rt *plan.ResolvedTable, expr sql.Expression) (sql.Expression, error) {
+	firstColumn, ok := rt.Columns().Next(1)
+	if !ok {
+		return expr, nil
+	}
+	schema := rt.Schema(ctx)
+	newExpr, _, err := transform.Expr(ctx, expr, func(ctx *sql.Context, e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+		gf, ok := e.(*gmsexpression.GetField)
+		if !ok {
+			return e, transform.SameTree, nil
+		}
+		idx := schema.IndexOfColName(gf.Name())
+		if idx < 0 {
+			return nil, transform.NewTree, errors.Errorf(`column "%s" does not exist`, gf.Name())
+		}
+		return gmsexpression.NewGetFieldWithTable(int(firstColumn)+idx, int(rt.Id()), gf.Type(ctx), rt.Database().Name(),
+			rt.Name(), gf.Name(), gf.IsNullable(ctx)), transform.NewTree, nil
+	})
+	return newExpr, err
+}
+
+// policyAppliesToRole returns whether the policy applies to the given role, either directly or through membership.
+func policyAppliesToRole(role auth.Role, policy policies.Policy) bool {
+	if len(policy.Roles) == 0 {
+		return true
+	}
+	applies := false
+	auth.LockRead(func() {
+		for _, roleName := range policy.Roles {
+			group := auth.GetRole(roleName)
+			if !group.IsValid() {
+				continue
+			}
+			if groupID, _, _ := auth.IsRoleAMember(role.ID(), group.ID()); groupID.IsValid() {
+				applies = true
+				return
+			}
+		}
+	})
+	return applies
+}
@@ -36,6 +36,7 @@ import (
 	"github.com/dolthub/doltgresql/server/functions"
 	"github.com/dolthub/doltgresql/server/functions/framework"
 	pgnodes "github.com/dolthub/doltgresql/server/node"
+	"github.com/dolthub/doltgresql/server/temporary"
 	pgtypes "github.com/dolthub/doltgresql/server/types"
 )
 
@@ -89,6 +90,12 @@ func ReplaceSerial(ctx *sql.Context, a *analyzer.Analyzer, node sql.Node, scope
 		if err != nil {
 			return nil, false, err
 		}
+		// The sequences of temporary tables are also temporary, so they're found within the temporary schema
+		persistence := sequences.Persistence_Permanent
+		if createTable.Temporary() {
+			schemaName = "pg_temp"
+			persistence = sequences.Persistence_Temporary
+		}
 
 		sequenceName, err := generateSequenceName(ctx, createTable, col, schemaName)
 		if err != nil {
@@ -96,9 +103,11 @@ func ReplaceSerial(ctx *sql.Context, a *analyzer.Analyzer, node sql.Node, scope
 		}
 
 		// TODO: need better way to detect sequence usage
-		err = authCheckSequence(ctx, a.Catalog.AuthHandler, schemaName, sequenceName)
-		if err != nil {
-			return nil, transform.SameTree, err
+		if !createTable.Temporary() {
+			err = authCheckSequence(ctx, a.Catalog.AuthHandler, schemaName, sequenceName)
+			if err != nil {
+				return nil, transform.SameTree, err
+			}
 		}
 
 		seqName := doltdb.TableName{Name: sequenceName, Schema: schemaName}.String()
@@ -137,9 +146,9 @@ func ReplaceSerial(ctx *sql.Context, a *analyzer.Analyzer, node sql.Node, scope
 			maxValue = 9223372036854775807
 		}
 
-		ctSequences = append(ctSequences, pgnodes.NewCreateSequence(false, "", false, &sequences.Sequence{
+		ctSequences = append(ctSequences, pgnodes.NewCreateSequence(false, createTable.Db.Name(), "", false, &sequences.Sequence{
 			DataTypeID:  col.Type.(*pgtypes.DoltgresType).ID,
-			Persistence: sequences.Persistence_Permanent,
+			Persistence: persistence,
 			SequenceState: sequences.SequenceState{
 				Id:        id.NewSequence("", sequenceName),
 				Start:     1,
@@ -162,19 +171,19 @@ func ReplaceSerial(ctx *sql.Context, a *analyzer.Analyzer, node sql.Node, scope
 func generateSequenceName(ctx *sql.Context, createTable *plan.CreateTable, col *sql.Column, schemaName string) (string, error) {
 	baseSequenceName := fmt.Sprintf("%s_%s_seq", createTable.Name(), col.Name)
 	sequenceName := baseSequenceName
-	relationType, err := core.GetRelationType(ctx, schemaName, baseSequenceName)
+	exists, err := sequenceNameExists(ctx, createTable, schemaName, baseSequenceName)
 	if err != nil {
 		return "", err
 	}
-	if relationType != core.RelationType_DoesNotExist {
+	if exists {
 		seqIndex := 1
 		for ; seqIndex <= maxSequenceAutoNames; seqIndex++ {
 			sequenceName = fmt.Sprintf("%s%d", baseSequenceName, seqIndex)
-			relationType, err = core.GetRelationType(ctx, schemaName, sequenceName)
+			exists, err = sequenceNameExists(ctx, createTable, schemaName, sequenceName)
 			if err != nil {
 				return "", err
 			}
-			if relationType == core.RelationType_DoesNotExist {
+			if !exists {
 				break
 			}
 		}
@@ -185,11 +194,40 @@ func generateSequenceName(ctx *sql.Context, createTable *plan.CreateTable, col *
 	return sequenceName, nil
 }
 
+// sequenceNameExists returns whether a relation with the given name already exists. The sequences of temporary tables
+// are held by the session, so they're also checked for temporary tables.
+func sequenceNameExists(ctx *sql.Context, createTable *plan.CreateTable, schemaName string, sequenceName string) (bool, error) {
+	if createTable.Temporary() {
+		if _, ok := temporary.GetSequence(ctx.Session.ID(), ctx.GetCurrentDatabase(), sequenceName); ok {
+			return true, nil
+		}
+		if _, ok := temporary.GetView(ctx.Session.ID(), ctx.GetCurrentDatabase(), sequenceName); ok {
+			return true, nil
+		}
+	}
+	relationType, err := core.GetRelationType(ctx, schemaName, sequenceName)
+	if err != nil {
+		return false, err
+	}
+	return relationType != core.RelationType_DoesNotExist, nil
+}
+
 // authCheckSequenceFromExpr checks authorization of sequence being used.
 // It parses schema and sequence names out of given expression.
 // There can be only one argument expression of string type.
 func authCheckSequenceFromExpr(ctx *sql.Context, ah sql.AuthorizationHandler, arg sql.Expression) error {
-	schemaName, seqName, err := functions.ParseRelationNameWithCurrentSchema(ctx, strings.Trim(arg.String(), "'"))
+	relationName := strings.Trim(arg.String(), "'")
+	schemaName, seqName, err := functions.ParseRelationName(ctx, relationName)
+	if err != nil {
+		return err
+	}
+	// Temporary sequences belong to the session that created them, so they're always usable by that session
+	if schemaName == "" || temporary.IsSchema(ctx.Session.ID(), schemaName) {
+		if _, ok := temporary.GetSequence(ctx.Session.ID(), ctx.GetCurrentDatabase(), seqName); ok {
+			return nil
+		}
+	}
+	schemaName, seqName, err = functions.ParseRelationNameWithCurrentSchema(ctx, relationName)
 	if err != nil {
 		return err
 	}
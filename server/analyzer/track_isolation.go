@@ -0,0 +1,115 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/analyzer"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+
+	"github.com/dolthub/doltgresql/core/id"
+	"github.com/dolthub/doltgresql/server/isolation"
+)
+
+// TrackIsolation records the tables that the statement reads and writes with the connection's transaction, so that
+// conflicts between concurrent SERIALIZABLE transactions may be detected when they commit. This does not modify the
+// node.
+func TrackIsolation(ctx *sql.Context, a *analyzer.Analyzer, node sql.Node, scope *plan.Scope, selector analyzer.RuleSelector, qFlags *sql.QueryFlags) (sql.Node, transform.TreeIdentity, error) {
+	if ctx.Session == nil {
+		return node, transform.SameTree, nil
+	}
+	level, ok := isolation.TrackedLevel(ctx.Session.ID())
+	if !ok {
+		return node, transform.SameTree, nil
+	}
+	var reads []isolation.Table
+	if level == isolation.Serializable {
+		reads = isolationReads(ctx, node, reads)
+	}
+	var writes []isolation.Table
+	transform.InspectWithOpaque(ctx, node, func(ctx *sql.Context, n sql.Node) bool {
+		var target sql.Table
+		var targetNode sql.Node
+		switch n := n.(type) {
+		case *plan.InsertInto:
+			target, _ = n.Destination.(sql.Table)
+			targetNode = n.Destination
+		case *plan.Update:
+			target, _ = plan.GetUpdatable(n.Child)
+			targetNode = n.Child
+		case *plan.DeleteFrom:
+			target, _ = plan.GetDeletable(n.Child)
+			targetNode = n.Child
+		case *plan.Truncate:
+			target, _ = plan.GetTruncatable(n.Child)
+			targetNode = n.Child
+		}
+		if tableID := sqlTableID(target); tableID.IsValid() {
+			database := tableNodeDatabase(targetNode)
+			if len(database) == 0 {
+				database = resolvedTableDatabase(targetNode, tableID)
+			}
+			writes = append(writes, isolationTable(ctx, database, tableID))
+		}
+		return true
+	})
+	isolation.Track(ctx.Session.ID(), reads, writes)
+	return node, transform.SameTree, nil
+}
+
+// isolationReads appends every table that is read by the node, including those that are read by subqueries. The
+// destination of an INSERT is not read, but its source is, even though the source is not one of its children.
+func isolationReads(ctx *sql.Context, node sql.Node, reads []isolation.Table) []isolation.Table {
+	transform.InspectWithOpaque(ctx, node, func(ctx *sql.Context, n sql.Node) bool {
+		if insert, ok := n.(*plan.InsertInto); ok {
+			if insert.Source != nil {
+				reads = isolationReads(ctx, insert.Source, reads)
+			}
+			return false
+		}
+		if table, ok := n.(sql.Table); ok {
+			if tableID := sqlTableID(table); tableID.IsValid() {
+				reads = append(reads, isolationTable(ctx, tableNodeDatabase(n), tableID))
+			}
+		}
+		return true
+	})
+	transform.InspectExpressions(ctx, node, func(ctx *sql.Context, expr sql.Expression) bool {
+		if subquery, ok := expr.(*plan.Subquery); ok && subquery.Query != nil {
+			reads = isolationReads(ctx, subquery.Query, reads)
+		}
+		return true
+	})
+	return reads
+}
+
+// tableNodeDatabase returns the name of the database that the node's table was resolved from. Returns an empty string
+// if the node is not a table node.
+func tableNodeDatabase(node sql.Node) string {
+	if tableNode, ok := node.(sql.TableNode); ok && tableNode.Database() != nil {
+		return tableNode.Database().Name()
+	}
+	return ""
+}
+
+// isolationTable returns the table with the given database, using the current database if the one that the table was
+// resolved from is not known.
+func isolationTable(ctx *sql.Context, database string, tableID id.Table) isolation.Table {
+	if len(database) == 0 {
+		database = ctx.GetCurrentDatabase()
+	}
+	return isolation.Table{Database: database, Table: tableID}
+}
@@ -0,0 +1,110 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/analyzer"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/id"
+	"github.com/dolthub/doltgresql/core/matviews"
+)
+
+// ValidateMaterializedViews ensures that materialized views are only modified by REFRESH MATERIALIZED VIEW, and that
+// materialized views created WITH NO DATA are not scanned until they've been refreshed.
+func ValidateMaterializedViews(ctx *sql.Context, a *analyzer.Analyzer, n sql.Node, scope *plan.Scope, selector analyzer.RuleSelector, qFlags *sql.QueryFlags) (sql.Node, transform.TreeIdentity, error) {
+	if !core.IsContextValid(ctx) {
+		return n, transform.SameTree, nil
+	}
+	var err error
+	transform.Inspect(n, func(node sql.Node) bool {
+		var targets []sql.Table
+		switch node := node.(type) {
+		case *plan.InsertInto:
+			if insertable, nErr := plan.GetInsertable(node.Destination); nErr == nil {
+				targets = append(targets, insertable)
+			}
+		case *plan.Update:
+			if updatable, nErr := plan.GetUpdatable(node.Child); nErr == nil {
+				targets = append(targets, updatable)
+			}
+		case *plan.DeleteFrom:
+			deleteTargets := node.GetDeleteTargets()
+			if len(deleteTargets) == 0 {
+				deleteTargets = []sql.Node{node.Child}
+			}
+			for _, deleteTarget := range deleteTargets {
+				if deletable, nErr := plan.GetDeletable(deleteTarget); nErr == nil {
+					targets = append(targets, deletable)
+				}
+			}
+		case *plan.Truncate:
+			if truncatable, nErr := plan.GetTruncatable(node.Child); nErr == nil {
+				targets = append(targets, truncatable)
+			}
+		}
+		for _, target := range targets {
+			var mv matviews.MaterializedView
+			mv, err = getMaterializedView(ctx, ctx.GetCurrentDatabase(), target)
+			if err != nil {
+				return false
+			}
+			if mv.ID.IsValid() {
+				err = errors.Errorf(`cannot change materialized view "%s"`, mv.ID.ViewName())
+				return false
+			}
+		}
+		return true
+	})
+	if err != nil || plan.IsNoRowNode(n) {
+		return n, transform.SameTree, err
+	}
+	transform.Inspect(n, func(node sql.Node) bool {
+		rt, ok := node.(*plan.ResolvedTable)
+		if !ok || rt.Database() == nil {
+			return true
+		}
+		var mv matviews.MaterializedView
+		mv, err = getMaterializedView(ctx, rt.Database().Name(), rt.Table)
+		if err != nil {
+			return false
+		}
+		if mv.ID.IsValid() && !mv.Populated {
+			err = errors.Errorf(`materialized view "%s" has not been populated`, mv.ID.ViewName())
+			return false
+		}
+		return true
+	})
+	return n, transform.SameTree, err
+}
+
+// getMaterializedView returns the materialized view whose rows are stored in the given table. Returns a
+// MaterializedView with an invalid ID if the table is not a materialized view.
+func getMaterializedView(ctx *sql.Context, database string, table sql.Table) (matviews.MaterializedView, error) {
+	doltTable := core.SQLTableToDoltTable(table)
+	if doltTable == nil {
+		return matviews.MaterializedView{}, nil
+	}
+	collection, err := core.GetMaterializedViewsCollectionFromContext(ctx, database)
+	if err != nil {
+		return matviews.MaterializedView{}, err
+	}
+	tableName := doltTable.TableName()
+	return collection.GetMaterializedView(ctx, id.NewView(tableName.Schema, tableName.Name))
+}
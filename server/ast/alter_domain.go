@@ -15,13 +15,15 @@
 package ast
 
 import (
-	"github.com/dolthub/vitess/go/vt/sqlparser"
+	"github.com/cockroachdb/errors"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
 )
 
 // nodeAlterDomain handles ALTER DOMAIN nodes.
-func nodeAlterDomain(ctx *Context, stmt *tree.AlterDomain) (sqlparser.Statement, error) {
+func nodeAlterDomain(ctx *Context, stmt *tree.AlterDomain) (vitess.Statement, error) {
 	if stmt == nil {
 		return nil, nil
 	}
@@ -31,5 +33,82 @@ func nodeAlterDomain(ctx *Context, stmt *tree.AlterDomain) (sqlparser.Statement,
 		return NewNoOp("OWNER TO is unsupported and ignored"), nil
 	}
 
-	return NotYetSupportedError("ALTER DOMAIN is not yet supported")
+	name, err := nodeUnresolvedObjectName(ctx, stmt.Name)
+	if err != nil {
+		return nil, err
+	}
+	alterDomain := &pgnodes.AlterDomain{
+		SchemaName: name.SchemaQualifier.String(),
+		Name:       name.Name.String(),
+	}
+	var children []vitess.Expr
+	switch cmd := stmt.Cmd.(type) {
+	case *tree.AlterDomainSetDrop:
+		switch {
+		case cmd.NotNull && cmd.IsSet:
+			alterDomain.Action = pgnodes.AlterDomainAction_SetNotNull
+		case cmd.NotNull:
+			alterDomain.Action = pgnodes.AlterDomainAction_DropNotNull
+		case cmd.IsSet:
+			alterDomain.Action = pgnodes.AlterDomainAction_SetDefault
+			defExpr, err := nodeExpr(ctx, cmd.Default)
+			if err != nil {
+				return nil, err
+			}
+			// Wrap any default expression using a function call in parens to match MySQL's column default requirements
+			if _, ok := defExpr.(*vitess.FuncExpr); ok {
+				defExpr = &vitess.ParenExpr{Expr: defExpr}
+			}
+			children = append(children, defExpr)
+		default:
+			alterDomain.Action = pgnodes.AlterDomainAction_DropDefault
+		}
+	case *tree.AlterDomainConstraint:
+		alterDomain.ConstraintName = string(cmd.ConstraintName)
+		switch cmd.Action {
+		case tree.AlterDomainAddConstraint:
+			alterDomain.ConstraintName = string(cmd.Constraint.Constraint)
+			if cmd.Constraint.Check == nil {
+				if !cmd.Constraint.NotNull {
+					return nil, errors.Errorf("only CHECK and NOT NULL constraints may be added to a domain")
+				}
+				alterDomain.Action = pgnodes.AlterDomainAction_SetNotNull
+				break
+			}
+			alterDomain.Action = pgnodes.AlterDomainAction_AddConstraint
+			alterDomain.NotValid = cmd.NotValid
+			// VALUE takes on the domain's type, which is not known until the node is executed
+			check, err := verifyAndReplaceValue(nil, cmd.Constraint.Check)
+			if err != nil {
+				return nil, err
+			}
+			checkExpr, err := nodeExpr(ctx, check)
+			if err != nil {
+				return nil, err
+			}
+			children = append(children, checkExpr)
+		case tree.AlterDomainDropConstraint:
+			alterDomain.Action = pgnodes.AlterDomainAction_DropConstraint
+			alterDomain.IfExists = cmd.IfExists
+		case tree.AlterDomainRenameConstraint:
+			alterDomain.Action = pgnodes.AlterDomainAction_RenameConstraint
+			alterDomain.NewConstraintName = string(cmd.NewName)
+		case tree.AlterDomainValidateConstraint:
+			alterDomain.Action = pgnodes.AlterDomainAction_ValidateConstraint
+		default:
+			return nil, errors.Errorf("unknown ALTER DOMAIN constraint action: %d", cmd.Action)
+		}
+	case *tree.AlterDomainRename:
+		alterDomain.Action = pgnodes.AlterDomainAction_Rename
+		alterDomain.NewName = cmd.NewName
+	case *tree.AlterDomainSetSchema:
+		alterDomain.Action = pgnodes.AlterDomainAction_Rename
+		alterDomain.NewSchema = cmd.Schema
+	default:
+		return nil, errors.Errorf("unknown ALTER DOMAIN command: %T", cmd)
+	}
+	return vitess.InjectedStatement{
+		Statement: alterDomain,
+		Children:  children,
+	}, nil
 }
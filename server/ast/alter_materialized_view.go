@@ -15,6 +15,7 @@
 package ast
 
 import (
+	"github.com/cockroachdb/errors"
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
@@ -25,6 +26,13 @@ func nodeAlterMaterializedView(ctx *Context, node *tree.AlterMaterializedView) (
 	if node == nil {
 		return nil, nil
 	}
-
-	return NotYetSupportedError("ALTER MATERIALIZED VIEW is not yet supported")
+	if node.Extension != "" {
+		return nil, errors.Errorf("ALTER MATERIALIZED VIEW DEPENDS ON EXTENSION is not yet supported")
+	}
+	// The rows of a materialized view are stored in a table, so the supported commands are the same as a table's
+	return nodeAlterTable(ctx, &tree.AlterTable{
+		IfExists: node.IfExists,
+		Table:    node.Name,
+		Cmds:     node.Cmds,
+	})
 }
@@ -0,0 +1,62 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast
+
+import (
+	"github.com/cockroachdb/errors"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
+)
+
+// nodeAlterPublication handles *tree.AlterPublication nodes.
+func nodeAlterPublication(ctx *Context, node *tree.AlterPublication) (vitess.Statement, error) {
+	if node == nil {
+		return nil, nil
+	}
+	alter := &pgnodes.AlterPublication{Name: string(node.Name)}
+	var err error
+	switch cmd := node.Cmd.(type) {
+	case *tree.AlterPublicationObjects:
+		switch cmd.Action {
+		case tree.AlterSubscriptionPublicationSet:
+			alter.Action = pgnodes.AlterPublicationSetObjects
+		case tree.AlterSubscriptionPublicationAdd:
+			alter.Action = pgnodes.AlterPublicationAddObjects
+		case tree.AlterSubscriptionPublicationDrop:
+			alter.Action = pgnodes.AlterPublicationDropObjects
+		}
+		alter.Objects, err = nodePublicationObjects(ctx, cmd.Objects)
+	case *tree.AlterPublicationSetOptions:
+		alter.Action = pgnodes.AlterPublicationSetOptions
+		alter.Actions, err = nodePublicationOptions(cmd.Options)
+	case *tree.AlterPublicationOwner:
+		alter.Action = pgnodes.AlterPublicationOwner
+		alter.Owner = cmd.Owner
+	case *tree.AlterPublicationRename:
+		alter.Action = pgnodes.AlterPublicationRename
+		alter.NewName = string(cmd.NewName)
+	default:
+		return nil, errors.Errorf("ALTER PUBLICATION with unsupported command type %T", cmd)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return vitess.InjectedStatement{
+		Statement: alter,
+		Children:  nil,
+	}, nil
+}
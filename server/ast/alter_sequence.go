@@ -15,8 +15,6 @@
 package ast
 
 import (
-	"fmt"
-
 	"github.com/cockroachdb/errors"
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
@@ -54,6 +52,7 @@ func nodeAlterSequence(ctx *Context, node *tree.AlterSequence) (vitess.Statement
 	}
 
 	ownedBy := pgnodes.AlterSequenceOwnedBy{}
+	var otherOptions tree.SequenceOptions
 	for _, option := range node.Options {
 		switch option.Name {
 		case tree.SeqOptOwnedBy:
@@ -81,9 +80,13 @@ func nodeAlterSequence(ctx *Context, node *tree.AlterSequence) (vitess.Statement
 				ownedBy.Column = colName.Name.String()
 			}
 		default:
-			return NotYetSupportedError(fmt.Sprintf("%s is not yet supported", option.Name))
+			otherOptions = append(otherOptions, option)
 		}
 	}
+	options, err := nodeAlterSequenceOptions(ctx, otherOptions)
+	if err != nil {
+		return nil, err
+	}
 
 	return vitess.InjectedStatement{
 		Statement: pgnodes.NewAlterSequence(
@@ -91,6 +94,7 @@ func nodeAlterSequence(ctx *Context, node *tree.AlterSequence) (vitess.Statement
 			name.SchemaQualifier.String(),
 			name.Name.String(),
 			ownedBy,
+			options,
 			warnings...),
 		Children: nil,
 		Auth: vitess.AuthInformation{
@@ -100,3 +104,48 @@ func nodeAlterSequence(ctx *Context, node *tree.AlterSequence) (vitess.Statement
 		},
 	}, nil
 }
+
+// nodeAlterSequenceOptions converts the options that change the parameters or the state of a sequence.
+func nodeAlterSequenceOptions(ctx *Context, seqOptions tree.SequenceOptions) (pgnodes.AlterSequenceOptions, error) {
+	var options pgnodes.AlterSequenceOptions
+	seen := make(map[string]struct{})
+	for _, option := range seqOptions {
+		name := option.Name
+		if name == tree.SeqOptNoCycle {
+			name = tree.SeqOptCycle
+		}
+		if _, ok := seen[name]; ok {
+			return pgnodes.AlterSequenceOptions{}, errors.Errorf("conflicting or redundant options")
+		}
+		seen[name] = struct{}{}
+		switch option.Name {
+		case tree.SeqOptAs:
+			_, dataType, err := nodeResolvableTypeReference(ctx, option.AsType, false)
+			if err != nil {
+				return pgnodes.AlterSequenceOptions{}, err
+			}
+			options.DataType = dataType
+		case tree.SeqOptIncrement:
+			options.Increment = option.IntVal
+		case tree.SeqOptMinValue:
+			options.Minimum = option.IntVal
+			options.NoMinimum = option.IntVal == nil
+		case tree.SeqOptMaxValue:
+			options.Maximum = option.IntVal
+			options.NoMaximum = option.IntVal == nil
+		case tree.SeqOptStart:
+			options.Start = option.IntVal
+		case tree.SeqOptRestart:
+			options.Restart = true
+			options.RestartWith = option.IntVal
+		case tree.SeqOptCache:
+			options.Cache = option.IntVal
+		case tree.SeqOptCycle, tree.SeqOptNoCycle:
+			cycle := option.Name == tree.SeqOptCycle
+			options.Cycle = &cycle
+		default:
+			return pgnodes.AlterSequenceOptions{}, errors.Errorf("%s is not yet supported", option.Name)
+		}
+	}
+	return options, nil
+}
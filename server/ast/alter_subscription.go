@@ -0,0 +1,72 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast
+
+import (
+	"github.com/cockroachdb/errors"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
+)
+
+// nodeAlterSubscription handles *tree.AlterSubscription nodes.
+func nodeAlterSubscription(ctx *Context, node *tree.AlterSubscription) (vitess.Statement, error) {
+	if node == nil {
+		return nil, nil
+	}
+	alter := &pgnodes.AlterSubscription{Name: string(node.Name)}
+	var err error
+	switch cmd := node.Cmd.(type) {
+	case *tree.AlterSubscriptionConnection:
+		alter.Action = pgnodes.AlterSubscriptionConnection
+		alter.ConnInfo = cmd.ConnInfo
+	case *tree.AlterSubscriptionPublication:
+		switch cmd.Action {
+		case tree.AlterSubscriptionPublicationSet:
+			alter.Action = pgnodes.AlterSubscriptionSetPublication
+		case tree.AlterSubscriptionPublicationAdd:
+			alter.Action = pgnodes.AlterSubscriptionAddPublication
+		case tree.AlterSubscriptionPublicationDrop:
+			alter.Action = pgnodes.AlterSubscriptionDropPublication
+		}
+		alter.Publications = nodeSubscriptionPublications(cmd.Publications)
+		alter.Options, err = nodeSubscriptionOptions(cmd.Options, "refresh", "copy_data")
+	case *tree.AlterSubscriptionRefresh:
+		alter.Action = pgnodes.AlterSubscriptionRefresh
+		alter.Options, err = nodeSubscriptionOptions(cmd.Options, "copy_data")
+	case *tree.AlterSubscriptionEnable:
+		alter.Action = pgnodes.AlterSubscriptionEnable
+		alter.Enabled = cmd.Enable
+	case *tree.AlterSubscriptionSetOptions:
+		alter.Action = pgnodes.AlterSubscriptionSetOptions
+		alter.Options, err = nodeSubscriptionOptions(cmd.Options, "slot_name")
+	case *tree.AlterSubscriptionOwner:
+		alter.Action = pgnodes.AlterSubscriptionOwner
+		alter.Owner = cmd.Owner
+	case *tree.AlterSubscriptionRename:
+		alter.Action = pgnodes.AlterSubscriptionRename
+		alter.NewName = string(cmd.NewName)
+	default:
+		return nil, errors.Errorf("ALTER SUBSCRIPTION with unsupported command type %T", cmd)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return vitess.InjectedStatement{
+		Statement: alter,
+		Children:  nil,
+	}, nil
+}
@@ -18,10 +18,11 @@ import (
 	"fmt"
 
 	"github.com/cockroachdb/errors"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
-	"github.com/sirupsen/logrus"
 
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	"github.com/dolthub/doltgresql/server/dependencies"
 	pgnodes "github.com/dolthub/doltgresql/server/node"
 	pgtypes "github.com/dolthub/doltgresql/server/types"
 )
@@ -38,13 +39,61 @@ func nodeAlterTable(ctx *Context, node *tree.AlterTable) (vitess.Statement, erro
 		return nil, err
 	}
 
+	// Row-level security settings are stored outside of the table, so they may only be combined with each other
+	if rlsActions, ok := nodeAlterTableRowLevelSecurity(node.Cmds); ok {
+		return vitess.InjectedStatement{
+			Statement: &pgnodes.AlterTableRowSecurity{
+				Schema:   tableName.SchemaQualifier.String(),
+				Table:    tableName.Name.String(),
+				IfExists: node.IfExists,
+				Actions:  rlsActions,
+			},
+			Children: nil,
+		}, nil
+	}
+
+	// CASCADE drops the column's dependents before the column itself, so it's only supported on its own
+	if len(node.Cmds) == 1 {
+		if dropCmd, ok := node.Cmds[0].(*tree.AlterTableDropColumn); ok && dropCmd.DropBehavior == tree.DropCascade {
+			restrictCmd := *dropCmd
+			restrictCmd.DropBehavior = tree.DropDefault
+			restrict := *node
+			restrict.Cmds = tree.AlterTableCmds{&restrictCmd}
+			return vitess.InjectedStatement{
+				Statement: &pgnodes.DropCascade{
+					Statement: tree.AsString(&restrict),
+					Relations: []doltdb.TableName{{Name: tableName.Name.String(), Schema: tableName.SchemaQualifier.String()}},
+					Kind:      dependencies.ObjectKind_Column,
+					Column:    dropCmd.Column.String(),
+				},
+				Children: nil,
+			}, nil
+		}
+	}
+
 	// We don't implement sequence addition/modification as a DDL since it's not in GMS, so we only support one of these
 	// at a time. If there are more than one, then we'll return an error in the command loop.
 	if len(node.Cmds) == 1 {
 		cmd, ok := node.Cmds[0].(*tree.AlterTableComputed)
 		if ok {
+			if len(cmd.Defs) > 0 {
+				return nodeAlterTableIdentity(ctx, tableName, node.IfExists, cmd)
+			}
 			return nodeAlterTableComputed(ctx, treeTableName, cmd)
 		}
+		if dropCmd, ok := node.Cmds[0].(*tree.AlterTableDropExprIden); ok && dropCmd.IsIdentity {
+			return vitess.InjectedStatement{
+				Statement: pgnodes.NewDropIdentity(
+					node.IfExists,
+					tableName.DbQualifier.String(),
+					tableName.SchemaQualifier.String(),
+					tableName.Name.String(),
+					dropCmd.Column.String(),
+					dropCmd.IfExists,
+				),
+				Children: nil,
+			}, nil
+		}
 		if vcmd, ok := node.Cmds[0].(*tree.AlterTableValidateConstraint); ok {
 			return vitess.InjectedStatement{
 				Statement: pgnodes.NewValidateConstraint(
@@ -171,10 +220,15 @@ func nodeAlterTableCmds(
 			unsupportedWarnings = append(unsupportedWarnings, fmt.Sprintf("ALTER TABLE %s OWNER TO %s", tableName.String(), cmd.Owner))
 		case *tree.AlterTableComputed:
 			return nil, nil, errors.New("This command does not currently support multiple actions in one statement")
+		case *tree.AlterTableDropExprIden:
+			if cmd.IsIdentity {
+				return nil, nil, errors.New("This command does not currently support multiple actions in one statement")
+			}
+			return nil, nil, errors.Errorf("ALTER TABLE with unsupported command type %T", cmd)
 		case *tree.AlterTableSetStatistics:
 			// is unsupported and ignored
 		case *tree.AlterTableRowLevelSecurity:
-			// is unsupported and ignored
+			return nil, nil, errors.New("This command does not currently support multiple actions in one statement")
 		case *tree.AlterTableReplicaIdentity:
 			// is unsupported and ignored
 		default:
@@ -283,11 +337,9 @@ func nodeAlterTableDropColumn(ctx *Context, node *tree.AlterTableDropColumn, tab
 	}
 
 	switch node.DropBehavior {
-	case tree.DropDefault:
-	case tree.DropRestrict:
-		return nil, errors.Errorf("ALTER TABLE DROP COLUMN does not support RESTRICT option")
+	case tree.DropDefault, tree.DropRestrict:
 	case tree.DropCascade:
-		logrus.Warnf("CASCADE option on DROP COLUMN is not yet supported, ignoring")
+		return nil, errors.Errorf("CASCADE on DROP COLUMN cannot be combined with other ALTER TABLE commands")
 	default:
 		return nil, errors.Errorf("ALTER TABLE with unsupported drop behavior %v", node.DropBehavior)
 	}
@@ -445,9 +497,6 @@ func nodeAlterTablePartition(ctx *Context, node *tree.AlterTablePartition) (*vit
 
 // nodeAlterTableComputed converts a tree.AlterTableComputed instance into an equivalent CREATE/ALTER SEQUENCE statement.
 func nodeAlterTableComputed(ctx *Context, tableName tree.TableName, node *tree.AlterTableComputed) (vitess.Statement, error) {
-	if len(node.Defs) > 0 {
-		return NotYetSupportedError("ALTER TABLE variant is not yet supported")
-	}
 	var persistence tree.Persistence
 	var seqName tree.TableName
 	var trimmedOptions []tree.SequenceOption
@@ -461,8 +510,6 @@ func nodeAlterTableComputed(ctx *Context, tableName tree.TableName, node *tree.A
 			switch opt.Name {
 			case tree.SeqOptOwnedBy:
 				return NotYetSupportedError("OWNED BY is invalid here")
-			case tree.SeqOptRestart:
-				return NotYetSupportedError("RESTART is not yet supported")
 			case tree.SeqOptName:
 				seqName = opt.SeqName
 			case tree.SeqOptLogged:
@@ -492,3 +539,78 @@ func nodeAlterTableComputed(ctx *Context, tableName tree.TableName, node *tree.A
 		Options:     trimmedOptions,
 	})
 }
+
+// nodeAlterTableIdentity converts a tree.AlterTableComputed instance that alters an existing identity column (through
+// SET GENERATED, SET with a sequence option, or RESTART) into an AlterIdentity node.
+func nodeAlterTableIdentity(ctx *Context, tableName vitess.TableName, ifExists bool, node *tree.AlterTableComputed) (vitess.Statement, error) {
+	generation := pgnodes.IdentityGeneration_Unchanged
+	var seqOptions tree.SequenceOptions
+	for _, def := range node.Defs {
+		switch {
+		case def.IsRestart:
+			restart := tree.SequenceOption{Name: tree.SeqOptRestart}
+			if def.Restart != nil {
+				numVal, ok := def.Restart.(*tree.NumVal)
+				if !ok {
+					return nil, errors.Errorf("RESTART value must be an integer")
+				}
+				restartWith, err := numVal.AsInt64()
+				if err != nil {
+					return nil, err
+				}
+				restart.IntVal = &restartWith
+			}
+			seqOptions = append(seqOptions, restart)
+		case len(def.Options) > 0:
+			seqOptions = append(seqOptions, def.Options...)
+		case def.ByDefault:
+			generation = pgnodes.IdentityGeneration_ByDefault
+		default:
+			generation = pgnodes.IdentityGeneration_Always
+		}
+	}
+	options, err := nodeAlterSequenceOptions(ctx, seqOptions)
+	if err != nil {
+		return nil, err
+	}
+	return vitess.InjectedStatement{
+		Statement: pgnodes.NewAlterIdentity(
+			ifExists,
+			tableName.DbQualifier.String(),
+			tableName.SchemaQualifier.String(),
+			tableName.Name.String(),
+			node.Column.String(),
+			generation,
+			options,
+		),
+		Children: nil,
+	}, nil
+}
+
+// nodeAlterTableRowLevelSecurity returns the row-level security actions of the given commands. Returns false if any of
+// the commands are not row-level security commands.
+func nodeAlterTableRowLevelSecurity(cmds tree.AlterTableCmds) ([]pgnodes.AlterTableRowSecurityAction, bool) {
+	if len(cmds) == 0 {
+		return nil, false
+	}
+	actions := make([]pgnodes.AlterTableRowSecurityAction, len(cmds))
+	for i, cmd := range cmds {
+		rlsCmd, ok := cmd.(*tree.AlterTableRowLevelSecurity)
+		if !ok {
+			return nil, false
+		}
+		switch rlsCmd.Type {
+		case tree.RowLevelSecurityEnable:
+			actions[i] = pgnodes.AlterTableRowSecurity_Enable
+		case tree.RowLevelSecurityDisable:
+			actions[i] = pgnodes.AlterTableRowSecurity_Disable
+		case tree.RowLevelSecurityForce:
+			actions[i] = pgnodes.AlterTableRowSecurity_Force
+		case tree.RowLevelSecurityNoForce:
+			actions[i] = pgnodes.AlterTableRowSecurity_NoForce
+		default:
+			return nil, false
+		}
+	}
+	return actions, true
+}
@@ -15,9 +15,12 @@
 package ast
 
 import (
+	"github.com/cockroachdb/errors"
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
 )
 
 // nodeAlterType handles *tree.AlterType nodes.
@@ -31,5 +34,78 @@ func nodeAlterType(ctx *Context, node *tree.AlterType) (vitess.Statement, error)
 		return NewNoOp("OWNER TO is unsupported and ignored"), nil
 	}
 
-	return NotYetSupportedError("ALTER TYPE is not yet supported")
+	name, err := nodeUnresolvedObjectName(ctx, node.Type)
+	if err != nil {
+		return nil, err
+	}
+	alterType := &pgnodes.AlterType{
+		Schema: name.SchemaQualifier.String(),
+		Name:   name.Name.String(),
+	}
+	switch cmd := node.Cmd.(type) {
+	case *tree.AlterTypeAddValue:
+		alterType.AddValue = &pgnodes.AlterTypeAddValue{
+			Value:       cmd.NewVal,
+			IfNotExists: cmd.IfNotExists,
+		}
+		if cmd.Placement != nil {
+			alterType.AddValue.Neighbor = cmd.Placement.ExistingVal
+			alterType.AddValue.Before = cmd.Placement.Before
+		}
+	case *tree.AlterTypeRenameValue:
+		alterType.RenameValue = &pgnodes.AlterTypeRenameValue{
+			Value:    cmd.OldVal,
+			NewValue: cmd.NewVal,
+		}
+	case *tree.AlterTypeRename:
+		alterType.NewName = cmd.NewName
+	case *tree.AlterTypeSetSchema:
+		alterType.NewSchema = cmd.Schema
+	case *tree.AlterTypeRenameAttribute:
+		alterType.Attributes = []pgnodes.AlterTypeAttribute{{
+			Action:  pgnodes.AlterTypeAttributeAction_Rename,
+			Name:    string(cmd.ColName),
+			NewName: string(cmd.NewColName),
+		}}
+		alterType.Cascade = cmd.DropBehavior == tree.DropCascade
+	case *tree.AlterTypeAlterAttribute:
+		for _, action := range cmd.Actions {
+			attr := pgnodes.AlterTypeAttribute{
+				Name:      string(action.ColName),
+				IfExists:  action.IfExists,
+				Collation: action.Collate,
+			}
+			switch action.Action {
+			case "add":
+				attr.Action = pgnodes.AlterTypeAttributeAction_Add
+			case "drop":
+				attr.Action = pgnodes.AlterTypeAttributeAction_Drop
+			case "alter":
+				attr.Action = pgnodes.AlterTypeAttributeAction_Alter
+			default:
+				return nil, errors.Errorf("unknown ALTER TYPE attribute action: %s", action.Action)
+			}
+			if action.TypeName != nil {
+				_, attr.Type, err = nodeResolvableTypeReference(ctx, action.TypeName, false)
+				if err != nil {
+					return nil, err
+				}
+				if attr.Type == pgtypes.Record {
+					return nil, errors.Errorf(`column "%s" has pseudo-type record`, attr.Name)
+				}
+			}
+			if action.DropBehavior == tree.DropCascade {
+				alterType.Cascade = true
+			}
+			alterType.Attributes = append(alterType.Attributes, attr)
+		}
+	case *tree.AlterTypeSetProperty:
+		return NotYetSupportedError("ALTER TYPE SET is not yet supported")
+	default:
+		return nil, errors.Errorf("unknown ALTER TYPE command: %T", cmd)
+	}
+	return vitess.InjectedStatement{
+		Statement: alterType,
+		Children:  nil,
+	}, nil
 }
@@ -15,11 +15,14 @@
 package ast
 
 import (
+	"strings"
+
 	"github.com/cockroachdb/errors"
 
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	"github.com/dolthub/doltgresql/server/isolation"
 )
 
 // nodeBeginTransaction handles *tree.BeginTransaction nodes.
@@ -27,12 +30,6 @@ func nodeBeginTransaction(ctx *Context, node *tree.BeginTransaction) (*vitess.Be
 	if node == nil {
 		return nil, nil
 	}
-
-	// TODO: When Dolt supports additional transaction isolation levels, we can plug them
-	//       in here based on what the user has set in node.Modes.Isolation
-	//       For now, we follow Dolt's lead and support the syntax for isolation levels, but
-	//       always use REPEATABLE READ semantics.
-
 	if node.Modes.UserPriority != tree.UnspecifiedUserPriority {
 		return nil, errors.Errorf("user priority is not yet supported")
 	}
@@ -42,18 +39,61 @@ func nodeBeginTransaction(ctx *Context, node *tree.BeginTransaction) (*vitess.Be
 	if node.Modes.Deferrable != tree.UnspecifiedDeferrableMode {
 		return nil, errors.Errorf("deferrability is not yet supported")
 	}
-	var characteristic string
+	// The isolation level is applied by the connection handler, which reads it from the characteristic
+	var characteristics []string
+	switch node.Modes.Isolation {
+	case tree.UnspecifiedIsolation:
+	case tree.ReadUncommittedIsolation:
+		characteristics = append(characteristics, vitess.IsolationLevelReadUncommitted)
+	case tree.ReadCommittedIsolation:
+		characteristics = append(characteristics, vitess.IsolationLevelReadCommitted)
+	case tree.RepeatableReadIsolation:
+		characteristics = append(characteristics, vitess.IsolationLevelRepeatableRead)
+	case tree.SerializableIsolation:
+		characteristics = append(characteristics, vitess.IsolationLevelSerializable)
+	default:
+		return nil, errors.Errorf("unknown isolation level")
+	}
 	switch node.Modes.ReadWriteMode {
 	case tree.UnspecifiedReadWriteMode:
-		characteristic = vitess.TxReadWrite
+		characteristics = append(characteristics, vitess.TxReadWrite)
 	case tree.ReadOnly:
-		characteristic = vitess.TxReadOnly
+		characteristics = append(characteristics, vitess.TxReadOnly)
 	case tree.ReadWrite:
-		characteristic = vitess.TxReadWrite
+		characteristics = append(characteristics, vitess.TxReadWrite)
 	default:
 		return nil, errors.Errorf("unknown READ/WRITE setting")
 	}
 	return &vitess.Begin{
-		TransactionCharacteristic: characteristic,
+		TransactionCharacteristic: strings.Join(characteristics, ", "),
 	}, nil
 }
+
+// nodeTransactionIsolation returns the isolation level from the given transaction modes, which are used by SET
+// TRANSACTION and SET SESSION CHARACTERISTICS. Only the isolation level may be given.
+func nodeTransactionIsolation(modes tree.TransactionModes) (isolation.Level, error) {
+	if modes.UserPriority != tree.UnspecifiedUserPriority {
+		return "", errors.Errorf("user priority is not yet supported")
+	}
+	if modes.AsOf.Expr != nil {
+		return "", errors.Errorf("AS OF is not yet supported")
+	}
+	if modes.ReadWriteMode != tree.UnspecifiedReadWriteMode {
+		return "", errors.Errorf("setting READ ONLY or READ WRITE outside of BEGIN is not yet supported")
+	}
+	if modes.Deferrable != tree.UnspecifiedDeferrableMode {
+		return "", errors.Errorf("deferrability is not yet supported")
+	}
+	switch modes.Isolation {
+	case tree.ReadUncommittedIsolation:
+		return isolation.ReadUncommitted, nil
+	case tree.ReadCommittedIsolation:
+		return isolation.ReadCommitted, nil
+	case tree.RepeatableReadIsolation:
+		return isolation.RepeatableRead, nil
+	case tree.SerializableIsolation:
+		return isolation.Serializable, nil
+	default:
+		return "", errors.Errorf("unknown isolation level")
+	}
+}
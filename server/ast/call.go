@@ -44,6 +44,11 @@ func nodeCall(ctx *Context, node *tree.Call) (vitess.Statement, error) {
 	if len(node.Procedure.OrderBy) > 0 {
 		return nil, errors.Errorf("procedure ORDER BY is not yet supported")
 	}
+	for _, argExpr := range node.Procedure.Exprs {
+		if _, ok := argExpr.(*tree.NamedArgExpr); ok {
+			return nil, errors.Errorf("procedure named arguments are not yet supported")
+		}
+	}
 
 	ctx.Auth().PushAuthType(auth.AuthType_EXECUTE)
 	defer ctx.Auth().PopAuthType()
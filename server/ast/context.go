@@ -16,6 +16,7 @@ package ast
 
 import (
 	"github.com/dolthub/doltgresql/postgres/parser/parser"
+	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
 	"github.com/dolthub/doltgresql/server/auth"
 )
 
@@ -25,6 +26,8 @@ import (
 type Context struct {
 	authContext   *auth.AuthContext
 	originalQuery string
+	// windows are the named windows of the SELECT statements that are being converted, with the innermost last.
+	windows []tree.Window
 }
 
 // NewContext returns a new *Context.
@@ -39,3 +42,28 @@ func NewContext(postgresStmt parser.Statement) *Context {
 func (ctx *Context) Auth() *auth.AuthContext {
 	return ctx.authContext
 }
+
+// pushWindows makes the given named windows visible to window functions, until popWindows is called. This should be
+// called by each SELECT statement, as named windows are only visible within their own statement.
+func (ctx *Context) pushWindows(windows tree.Window) {
+	ctx.windows = append(ctx.windows, windows)
+}
+
+// popWindows removes the named windows that were added by the last call to pushWindows.
+func (ctx *Context) popWindows() {
+	ctx.windows = ctx.windows[:len(ctx.windows)-1]
+}
+
+// namedWindow returns the named window of the innermost SELECT statement with the given name. Returns nil if the
+// window does not exist.
+func (ctx *Context) namedWindow(name tree.Name) *tree.WindowDef {
+	if len(ctx.windows) == 0 {
+		return nil
+	}
+	for _, window := range ctx.windows[len(ctx.windows)-1] {
+		if window.Name == name {
+			return window
+		}
+	}
+	return nil
+}
@@ -20,19 +20,43 @@ import (
 	"github.com/cockroachdb/errors"
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
+	"github.com/dolthub/doltgresql/core"
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
 )
 
 // nodeCreateIndex handles *tree.CreateIndex nodes.
-func nodeCreateIndex(ctx *Context, node *tree.CreateIndex) (*vitess.AlterTable, error) {
+func nodeCreateIndex(ctx *Context, node *tree.CreateIndex) (vitess.Statement, error) {
 	if node == nil {
 		return nil, nil
 	}
 	if node.Concurrently {
 		return nil, errors.Errorf("concurrent index creation is not yet supported")
 	}
-	if node.Using != "" && strings.ToLower(node.Using) != "btree" {
+	accessMethod := strings.ToLower(node.Using)
+	switch accessMethod {
+	case "", "btree":
+	case "hash", "brin", "gin":
+		if node.Unique {
+			return nil, errors.Errorf(`access method "%s" does not support unique indexes`, accessMethod)
+		}
+		if accessMethod != "brin" && len(node.Columns) > 1 {
+			return nil, errors.Errorf(`access method "%s" does not support multicolumn indexes`, accessMethod)
+		}
+		if accessMethod == "gin" {
+			return nodeCreateGinIndex(ctx, node)
+		}
+		// Hash and BRIN indexes are stored as B-tree indexes, so the operator classes that are specific to them would
+		// have no effect
+		for _, column := range node.Columns {
+			if column.OpClass != nil {
+				return nil, errors.Errorf(`operator classes are not yet supported for access method "%s"`, accessMethod)
+			}
+		}
+	case "gist", "spgist":
 		return nil, errors.Errorf("index method %s is not yet supported", node.Using)
+	default:
+		return nil, errors.Errorf(`access method "%s" does not exist`, node.Using)
 	}
 	indexDef, err := nodeIndexTableDef(ctx, &tree.IndexTableDef{
 		Name:        node.Name,
@@ -57,6 +81,16 @@ func nodeCreateIndex(ctx *Context, node *tree.CreateIndex) (*vitess.AlterTable,
 			return nil, err
 		}
 	}
+	// Hash and BRIN indexes are stored as B-tree indexes, which support every lookup that they do. The access method is
+	// carried to the analyzer by the comment, which records it so that the catalogs are able to describe the index as it
+	// was created.
+	options := indexDef.Options
+	if accessMethod == "hash" || accessMethod == "brin" {
+		options = append(options, &vitess.IndexOption{
+			Name:  "comment",
+			Value: vitess.NewStrVal([]byte(core.IndexAccessMethodComment(accessMethod))),
+		})
+	}
 	return &vitess.AlterTable{
 		Table: tableName,
 		Statements: []*vitess.DDL{
@@ -70,10 +104,42 @@ func nodeCreateIndex(ctx *Context, node *tree.CreateIndex) (*vitess.AlterTable,
 					ToName:    indexDef.Info.Name,
 					Type:      indexType,
 					Fields:    indexDef.Fields,
-					Options:   indexDef.Options,
+					Options:   options,
 					Predicate: predicate,
 				},
 			},
 		},
 	}, nil
 }
+
+// nodeCreateGinIndex handles *tree.CreateIndex nodes that use the GIN access method.
+func nodeCreateGinIndex(ctx *Context, node *tree.CreateIndex) (vitess.Statement, error) {
+	if node.Predicate != nil {
+		return nil, errors.Errorf("partial GIN indexes are not yet supported")
+	}
+	if node.IndexParams.IncludeColumns != nil {
+		return nil, errors.Errorf("include columns is not yet supported")
+	}
+	if len(node.Columns) != 1 || node.Columns[0].Column == "" {
+		return nil, errors.Errorf("GIN indexes on expressions are not yet supported")
+	}
+	tableName, err := nodeTableName(ctx, &node.Table)
+	if err != nil {
+		return nil, err
+	}
+	var opClass string
+	if node.Columns[0].OpClass != nil {
+		opClass = strings.ToLower(node.Columns[0].OpClass.Name)
+	}
+	return vitess.InjectedStatement{
+		Statement: &pgnodes.CreateGinIndex{
+			Name:        string(node.Name),
+			SchemaName:  tableName.SchemaQualifier.String(),
+			Table:       tableName.Name.String(),
+			Column:      string(node.Columns[0].Column),
+			OpClass:     opClass,
+			IfNotExists: node.IfNotExists,
+		},
+		Children: nil,
+	}, nil
+}
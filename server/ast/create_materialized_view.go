@@ -15,9 +15,11 @@
 package ast
 
 import (
+	"github.com/cockroachdb/errors"
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
 )
 
 // nodeCreateMaterializedView handles *tree.CreateMaterializedView nodes.
@@ -25,6 +27,36 @@ func nodeCreateMaterializedView(ctx *Context, node *tree.CreateMaterializedView)
 	if node == nil {
 		return nil, nil
 	}
-
-	return NotYetSupportedError("CREATE MATERIALIZED VIEW is not yet supported")
+	if len(node.Params) > 0 {
+		return nil, errors.Errorf("storage parameters are not yet supported")
+	}
+	if node.Using != "" {
+		return nil, errors.Errorf("USING is not yet supported")
+	}
+	if node.Tablespace != "" {
+		return nil, errors.Errorf("TABLESPACE is not yet supported")
+	}
+	tableName, err := nodeTableName(ctx, &node.Name)
+	if err != nil {
+		return nil, err
+	}
+	// The query is converted to catch anything that isn't supported, but it's stored as text so that it may be rerun
+	if _, err = nodeSelect(ctx, node.AsSource); err != nil {
+		return nil, err
+	}
+	columnNames := make([]string, len(node.ColumnNames))
+	for i, col := range node.ColumnNames {
+		columnNames[i] = col.String()
+	}
+	return vitess.InjectedStatement{
+		Statement: &pgnodes.CreateMaterializedView{
+			Schema:      tableName.SchemaQualifier.String(),
+			Name:        tableName.Name.String(),
+			ColumnNames: columnNames,
+			Definition:  node.AsSource.String(),
+			IfNotExists: node.IfNotExists,
+			WithNoData:  node.WithNoData,
+		},
+		Children: nil,
+	}, nil
 }
@@ -0,0 +1,82 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast
+
+import (
+	"github.com/cockroachdb/errors"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/core/policies"
+	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
+)
+
+// nodeCreatePolicy handles *tree.CreatePolicy nodes.
+func nodeCreatePolicy(ctx *Context, node *tree.CreatePolicy) (vitess.Statement, error) {
+	if node == nil {
+		return nil, nil
+	}
+	tableName, err := nodeUnresolvedObjectName(ctx, node.Table)
+	if err != nil {
+		return nil, err
+	}
+	switch node.Command {
+	case tree.PolicyCommandInsert:
+		if node.Using != nil {
+			return nil, errors.New("only WITH CHECK expression allowed for INSERT")
+		}
+	case tree.PolicyCommandSelect, tree.PolicyCommandDelete:
+		if node.WithCheck != nil {
+			return nil, errors.New("WITH CHECK cannot be applied to SELECT or DELETE")
+		}
+	}
+	return vitess.InjectedStatement{
+		Statement: &pgnodes.CreatePolicy{
+			Name:        string(node.Name),
+			Schema:      tableName.SchemaQualifier.String(),
+			Table:       tableName.Name.String(),
+			Restrictive: node.Restrictive,
+			Command:     nodePolicyCommand(node.Command),
+			Roles:       node.Roles,
+			Using:       nodePolicyExpression(node.Using),
+			WithCheck:   nodePolicyExpression(node.WithCheck),
+		},
+		Children: nil,
+	}, nil
+}
+
+// nodePolicyCommand converts the parser's policy command to the one used by the policies collection.
+func nodePolicyCommand(command tree.PolicyCommand) policies.PolicyCommand {
+	switch command {
+	case tree.PolicyCommandSelect:
+		return policies.PolicyCommand_Select
+	case tree.PolicyCommandInsert:
+		return policies.PolicyCommand_Insert
+	case tree.PolicyCommandUpdate:
+		return policies.PolicyCommand_Update
+	case tree.PolicyCommandDelete:
+		return policies.PolicyCommand_Delete
+	default:
+		return policies.PolicyCommand_All
+	}
+}
+
+// nodePolicyExpression returns the text of a policy's expression, which is empty when the expression was not given.
+func nodePolicyExpression(expr tree.Expr) string {
+	if expr == nil {
+		return ""
+	}
+	return expr.String()
+}
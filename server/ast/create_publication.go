@@ -0,0 +1,120 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast
+
+import (
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
+	"github.com/dolthub/doltgresql/server/walsender"
+)
+
+// nodeCreatePublication handles *tree.CreatePublication nodes.
+func nodeCreatePublication(ctx *Context, node *tree.CreatePublication) (vitess.Statement, error) {
+	if node == nil {
+		return nil, nil
+	}
+	objects, err := nodePublicationObjects(ctx, node.Objects)
+	if err != nil {
+		return nil, err
+	}
+	actions, err := nodePublicationOptions(node.Options)
+	if err != nil {
+		return nil, err
+	}
+	return vitess.InjectedStatement{
+		Statement: &pgnodes.CreatePublication{
+			Name:      string(node.Name),
+			AllTables: node.AllTables,
+			Objects:   objects,
+			Actions:   actions,
+		},
+		Children: nil,
+	}, nil
+}
+
+// nodePublicationObjects handles the tables and schemas that are given to the publication statements.
+func nodePublicationObjects(ctx *Context, objects tree.PublicationObjects) (pgnodes.PublicationObjects, error) {
+	var pubObjects pgnodes.PublicationObjects
+	for _, object := range objects {
+		switch object.Kind {
+		case tree.PublicationObjectSchema:
+			pubObjects.Schemas = append(pubObjects.Schemas, string(object.Schema))
+		default:
+			tableName, err := nodeUnresolvedObjectName(ctx, object.Table)
+			if err != nil {
+				return pgnodes.PublicationObjects{}, err
+			}
+			pubObjects.Tables = append(pubObjects.Tables, walsender.PublishedTable{
+				Schema: tableName.SchemaQualifier.String(),
+				Name:   tableName.Name.String(),
+			})
+		}
+	}
+	return pubObjects, nil
+}
+
+// nodePublicationOptions handles the WITH clause of the publication statements. Returns nil if the publish option was
+// not given.
+func nodePublicationOptions(params tree.StorageParams) (*pgnodes.PublicationActions, error) {
+	var actions *pgnodes.PublicationActions
+	seen := make(map[string]struct{})
+	for _, param := range params {
+		name := strings.ToLower(string(param.Key))
+		if _, ok := seen[name]; ok {
+			return nil, errors.New("conflicting or redundant options")
+		}
+		seen[name] = struct{}{}
+
+		value := ""
+		switch paramValue := param.Value.(type) {
+		case nil:
+		case *tree.StrVal:
+			value = paramValue.RawString()
+		default:
+			value = tree.AsStringWithFlags(paramValue, tree.FmtBareStrings)
+		}
+		switch name {
+		case "publish":
+			actions = &pgnodes.PublicationActions{}
+			for _, action := range strings.Split(value, ",") {
+				switch strings.ToLower(strings.TrimSpace(action)) {
+				case "insert":
+					actions.Insert = true
+				case "update":
+					actions.Update = true
+				case "delete":
+					actions.Delete = true
+				case "truncate":
+					actions.Truncate = true
+				case "":
+				default:
+					return nil, errors.Errorf(`unrecognized value for publication option "publish": "%s"`, strings.TrimSpace(action))
+				}
+			}
+		case "publish_via_partition_root", "publish_generated_columns":
+			if _, err := NotYetSupportedError(`publication parameter "` + name + `" is not yet supported.`); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, errors.Errorf(`unrecognized publication parameter: "%s"`, name)
+		}
+	}
+	return actions, nil
+}
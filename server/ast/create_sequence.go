@@ -33,9 +33,6 @@ func nodeCreateSequence(ctx *Context, node *tree.CreateSequence) (vitess.Stateme
 	if node == nil {
 		return nil, nil
 	}
-	if node.Persistence.IsTemporary() {
-		return nil, errors.Errorf("temporary sequences are not yet supported")
-	}
 	if node.Persistence.IsUnlogged() {
 		return nil, errors.Errorf("unlogged sequences are not yet supported")
 	}
@@ -43,8 +40,16 @@ func nodeCreateSequence(ctx *Context, node *tree.CreateSequence) (vitess.Stateme
 	if err != nil {
 		return nil, err
 	}
-	if len(name.DbQualifier.String()) > 0 {
-		return nil, errors.Errorf("CREATE SEQUENCE is currently only supported for the current database")
+	name, isTemporary, err := temporaryRelationName(name, node.Persistence.IsTemporary())
+	if err != nil {
+		return nil, err
+	}
+	persistence := sequences.Persistence_Permanent
+	if isTemporary {
+		persistence = sequences.Persistence_Temporary
+	}
+	if isTemporary && len(name.DbQualifier.String()) > 0 {
+		return nil, errors.Errorf("cannot create temporary relation in another database")
 	}
 	// Read all options and check whether they've been set (if not, we'll use the defaults)
 	minValueLimit := int64(math.MinInt64)
@@ -53,6 +58,7 @@ func nodeCreateSequence(ctx *Context, node *tree.CreateSequence) (vitess.Stateme
 	var minValue int64
 	var maxValue int64
 	var start int64
+	var restart *int64
 	var dataType *pgtypes.DoltgresType
 	var ownerTableName string
 	var ownerColumnName string
@@ -60,6 +66,9 @@ func nodeCreateSequence(ctx *Context, node *tree.CreateSequence) (vitess.Stateme
 	maxValueSet := false
 	incrementSet := false
 	startSet := false
+	restartSet := false
+	cache := int64(1)
+	cacheSet := false
 	cycle := false
 	fromAlter := false
 	for _, option := range node.Options {
@@ -101,16 +110,26 @@ func nodeCreateSequence(ctx *Context, node *tree.CreateSequence) (vitess.Stateme
 			if colName.Qualifier.SchemaQualifier.String() != name.SchemaQualifier.String() {
 				return nil, errors.New("CREATE SEQUENCE must use the same schema for the sequence and owned table")
 			}
-			if len(colName.Qualifier.DbQualifier.String()) > 0 {
-				return nil, errors.New("database specification is not yet supported for sequences")
+			if colName.Qualifier.DbQualifier.String() != name.DbQualifier.String() {
+				return nil, errors.New("CREATE SEQUENCE must use the same database for the sequence and owned table")
 			}
 			ownerTableName = colName.Qualifier.Name.String()
 			ownerColumnName = colName.Name.String()
 		case tree.SeqOptCache:
-			// TODO: implement caching
-			if *option.IntVal != 1 {
-				return nil, errors.Errorf("sequence caching for values other than 1 are not yet supported")
+			cache = *option.IntVal
+			if cacheSet {
+				return nil, errors.Errorf("conflicting or redundant options")
 			}
+			if cache <= 0 {
+				return nil, errors.Errorf("CACHE (%d) must be greater than zero", cache)
+			}
+			cacheSet = true
+		case tree.SeqOptRestart:
+			restart = option.IntVal
+			if restartSet {
+				return nil, errors.Errorf("conflicting or redundant options")
+			}
+			restartSet = true
 		case tree.SeqOptIncrement:
 			increment = *option.IntVal
 			if incrementSet {
@@ -180,22 +199,33 @@ func nodeCreateSequence(ctx *Context, node *tree.CreateSequence) (vitess.Stateme
 	} else {
 		start = maxValue
 	}
+	// A new sequence begins at its start value, unless RESTART gives it a different value to begin at
+	current := start
+	if restart != nil {
+		current = *restart
+		if current < minValue {
+			return nil, errors.Errorf("RESTART value (%d) cannot be less than MINVALUE (%d)", current, minValue)
+		}
+		if current > maxValue {
+			return nil, errors.Errorf("RESTART value (%d) cannot be greater than MAXVALUE (%d)", current, maxValue)
+		}
+	}
 	if dataType.IsEmptyType() {
 		dataType = pgtypes.Int64
 	}
 	// Returns the stored procedure call with all options
 	return vitess.InjectedStatement{
-		Statement: pgnodes.NewCreateSequence(node.IfNotExists, name.SchemaQualifier.String(), fromAlter, &sequences.Sequence{
+		Statement: pgnodes.NewCreateSequence(node.IfNotExists, name.DbQualifier.String(), name.SchemaQualifier.String(), fromAlter, &sequences.Sequence{
 			DataTypeID:  dataType.ID,
-			Persistence: sequences.Persistence_Permanent,
+			Persistence: persistence,
 			SequenceState: sequences.SequenceState{
 				Id:        id.NewSequence("", name.Name.String()),
 				Start:     start,
-				Current:   start,
+				Current:   current,
 				Increment: increment,
 				Minimum:   minValue,
 				Maximum:   maxValue,
-				Cache:     1,
+				Cache:     cache,
 				Cycle:     cycle,
 				IsAtEnd:   false,
 			},
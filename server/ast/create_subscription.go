@@ -0,0 +1,125 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast
+
+import (
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
+)
+
+// nodeCreateSubscription handles *tree.CreateSubscription nodes.
+func nodeCreateSubscription(ctx *Context, node *tree.CreateSubscription) (vitess.Statement, error) {
+	if node == nil {
+		return nil, nil
+	}
+	options, err := nodeSubscriptionOptions(node.Options, "connect", "enabled", "create_slot", "copy_data", "slot_name")
+	if err != nil {
+		return nil, err
+	}
+	return vitess.InjectedStatement{
+		Statement: &pgnodes.CreateSubscription{
+			Name:         string(node.Name),
+			ConnInfo:     node.ConnInfo,
+			Publications: nodeSubscriptionPublications(node.Publications),
+			Options:      options,
+		},
+		Children: nil,
+	}, nil
+}
+
+// nodeSubscriptionPublications returns the names of the publications of a subscription statement.
+func nodeSubscriptionPublications(publications tree.NameList) []string {
+	names := make([]string, len(publications))
+	for i, publication := range publications {
+		names[i] = string(publication)
+	}
+	return names
+}
+
+// nodeSubscriptionOptions handles the WITH clause of the subscription statements, which may only contain the options
+// that are allowed by the statement.
+func nodeSubscriptionOptions(params tree.StorageParams, allowed ...string) (pgnodes.SubscriptionOptions, error) {
+	var options pgnodes.SubscriptionOptions
+	seen := make(map[string]struct{})
+	for _, param := range params {
+		name := strings.ToLower(string(param.Key))
+		isAllowed := false
+		for _, allowedName := range allowed {
+			isAllowed = isAllowed || name == allowedName
+		}
+		if !isAllowed {
+			switch name {
+			case "binary", "streaming", "synchronous_commit", "two_phase", "disable_on_error", "password_required",
+				"run_as_owner", "origin", "failover":
+				if _, err := NotYetSupportedError(`subscription parameter "` + name + `" is not yet supported.`); err != nil {
+					return options, err
+				}
+				continue
+			default:
+				return options, errors.Errorf(`unrecognized subscription parameter: "%s"`, name)
+			}
+		}
+		if _, ok := seen[name]; ok {
+			return options, errors.New("conflicting or redundant options")
+		}
+		seen[name] = struct{}{}
+
+		value := ""
+		switch paramValue := param.Value.(type) {
+		case nil:
+		case *tree.StrVal:
+			value = paramValue.RawString()
+		default:
+			value = tree.AsStringWithFlags(paramValue, tree.FmtBareStrings)
+		}
+		if name == "slot_name" {
+			if strings.ToLower(value) == "none" {
+				value = ""
+			}
+			options.SlotName = &value
+			continue
+		}
+
+		// An option without a value is true
+		boolValue := true
+		if param.Value != nil {
+			switch strings.ToLower(value) {
+			case "true", "on", "t", "yes", "y", "1":
+			case "false", "off", "f", "no", "n", "0":
+				boolValue = false
+			default:
+				return options, errors.Errorf("%s requires a Boolean value", name)
+			}
+		}
+		switch name {
+		case "connect":
+			options.Connect = &boolValue
+		case "enabled":
+			options.Enabled = &boolValue
+		case "create_slot":
+			options.CreateSlot = &boolValue
+		case "copy_data":
+			options.CopyData = &boolValue
+		case "refresh":
+			options.Refresh = &boolValue
+		}
+	}
+	return options, nil
+}
@@ -15,29 +15,27 @@
 package ast
 
 import (
+	"go/constant"
+	"strings"
+
 	"github.com/cockroachdb/errors"
 
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
 	"github.com/dolthub/doltgresql/server/auth"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
+	"github.com/dolthub/doltgresql/server/temporary"
 )
 
 // nodeCreateTable handles *tree.CreateTable nodes.
-func nodeCreateTable(ctx *Context, node *tree.CreateTable) (*vitess.DDL, error) {
+func nodeCreateTable(ctx *Context, node *tree.CreateTable) (vitess.Statement, error) {
 	if node == nil {
 		return nil, nil
 	}
 	if len(node.StorageParams) > 0 {
 		return nil, errors.Errorf("storage parameters are not yet supported")
 	}
-	// TODO: support tree.CreateTableOnCommitDrop and tree.CreateTableOnCommitDeleteRows
-	switch node.OnCommit {
-	case tree.CreateTableOnCommitDrop:
-		// is unsupported and ignored
-	case tree.CreateTableOnCommitDeleteRows:
-		// is unsupported and ignored
-	}
 	tableName, err := nodeTableName(ctx, &node.Table)
 	if err != nil {
 		return nil, err
@@ -53,6 +51,47 @@ func nodeCreateTable(ctx *Context, node *tree.CreateTable) (*vitess.DDL, error)
 	default:
 		return nil, errors.Errorf("unknown persistence strategy encountered")
 	}
+	if tableName, isTemporary, err = temporaryRelationName(tableName, isTemporary); err != nil {
+		return nil, err
+	}
+	if node.OnCommit != tree.CreateTableOnCommitUnset && !isTemporary {
+		return nil, errors.Errorf("ON COMMIT can only be used on temporary tables")
+	}
+	// Actions other than PRESERVE ROWS are performed whenever a transaction ends, so the table is created by a node
+	// that records the action alongside the table.
+	if node.OnCommit == tree.CreateTableOnCommitDeleteRows || node.OnCommit == tree.CreateTableOnCommitDrop {
+		onCommit := temporary.OnCommit_DeleteRows
+		if node.OnCommit == tree.CreateTableOnCommitDrop {
+			onCommit = temporary.OnCommit_Drop
+		}
+		withoutOnCommit := *node
+		withoutOnCommit.OnCommit = tree.CreateTableOnCommitUnset
+		withoutOnCommit.Persistence = tree.PersistenceTemporary
+		return vitess.InjectedStatement{
+			Statement: &pgnodes.CreateTemporaryTable{
+				Name:      tableName.Name.String(),
+				OnCommit:  onCommit,
+				Statement: tree.AsString(&withoutOnCommit),
+			},
+			Children: nil,
+		}, nil
+	}
+	// The sequences of identity columns are created alongside the table, so their options are applied afterward by
+	// altering each identity column.
+	if withoutOptions, alterations, err := splitIdentityOptions(node); err != nil {
+		return nil, err
+	} else if len(alterations) > 0 {
+		return vitess.InjectedStatement{
+			Statement: &pgnodes.CreateTableWithIdentityOptions{
+				Database:    tableName.DbQualifier.String(),
+				Schema:      tableName.SchemaQualifier.String(),
+				Name:        tableName.Name.String(),
+				Statement:   tree.AsString(withoutOptions),
+				Alterations: alterations,
+			},
+			Children: nil,
+		}, nil
+	}
 	var optSelect *vitess.OptSelect
 	if node.Using != "" {
 		return nil, errors.Errorf("USING is not yet supported")
@@ -123,3 +162,68 @@ func nodeCreateTable(ctx *Context, node *tree.CreateTable) (*vitess.DDL, error)
 	}
 	return ddl, nil
 }
+
+// splitIdentityOptions returns the statement with the sequence options removed from its identity columns, along with
+// the ALTER TABLE statements that apply those options. No statements are returned when none of the identity columns
+// have sequence options.
+func splitIdentityOptions(node *tree.CreateTable) (*tree.CreateTable, []string, error) {
+	var alterations []string
+	defs := make(tree.TableDefs, len(node.Defs))
+	for i, def := range node.Defs {
+		defs[i] = def
+		colDef, ok := def.(*tree.ColumnTableDef)
+		if !ok || !colDef.IsComputed() || colDef.Computed.Expr != nil || len(colDef.Computed.Options) == 0 {
+			continue
+		}
+		alterCmd := &tree.AlterTableComputed{Column: colDef.Name}
+		for _, option := range colDef.Computed.Options {
+			switch option.Name {
+			case tree.SeqOptIncrement, tree.SeqOptMinValue, tree.SeqOptMaxValue, tree.SeqOptStart, tree.SeqOptCache,
+				tree.SeqOptCycle, tree.SeqOptNoCycle:
+				alterCmd.Defs = append(alterCmd.Defs, tree.AlterColComputed{Options: tree.SequenceOptions{option}})
+				// The sequence has already begun at the default start value, so it must begin again at the new one
+				if option.Name == tree.SeqOptStart {
+					alterCmd.Defs = append(alterCmd.Defs, tree.AlterColComputed{
+						IsRestart: true,
+						Restart:   tree.NewNumVal(constant.MakeInt64(*option.IntVal), "", false),
+					})
+				}
+			default:
+				return nil, nil, errors.Errorf("%s is not yet supported for identity columns", option.Name)
+			}
+		}
+		withoutOptions := *colDef
+		withoutOptions.Computed.Options = nil
+		defs[i] = &withoutOptions
+		alterations = append(alterations, "ALTER TABLE "+tree.AsString(&node.Table)+tree.AsString(alterCmd))
+	}
+	if len(alterations) == 0 {
+		return node, nil, nil
+	}
+	withoutOptions := *node
+	withoutOptions.Defs = defs
+	return &withoutOptions, alterations, nil
+}
+
+// temporaryRelationName returns the name of a relation that is being created, along with whether the relation is
+// temporary. Relations that are created in the temporary schema are always temporary, and temporary relations may
+// not be created in any other schema. The temporary schema is removed from the returned name, as temporary relations
+// are found ahead of the schemas on the search path.
+func temporaryRelationName(name vitess.TableName, isTemporary bool) (vitess.TableName, bool, error) {
+	schemaName := name.SchemaQualifier.String()
+	if isTemporarySchemaName(schemaName) {
+		name.SchemaQualifier = vitess.NewTableIdent("")
+		return name, true, nil
+	}
+	if isTemporary && len(schemaName) > 0 {
+		return name, true, errors.Errorf("cannot create temporary relation in non-temporary schema")
+	}
+	return name, isTemporary, nil
+}
+
+// isTemporarySchemaName returns whether the schema name refers to a temporary schema. As the connection is not known
+// while converting statements, this accepts the temporary schema of any connection.
+func isTemporarySchemaName(schemaName string) bool {
+	schemaName = strings.ToLower(schemaName)
+	return schemaName == "pg_temp" || strings.HasPrefix(schemaName, "pg_temp_")
+}
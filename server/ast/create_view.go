@@ -28,9 +28,6 @@ func nodeCreateView(ctx *Context, node *tree.CreateView) (*vitess.DDL, error) {
 	if node == nil {
 		return nil, nil
 	}
-	if node.Persistence.IsTemporary() {
-		return nil, errors.Errorf("CREATE TEMPORARY VIEW is not yet supported")
-	}
 	if node.IsRecursive {
 		return nil, errors.Errorf("CREATE RECURSIVE VIEW is not yet supported")
 	}
@@ -83,6 +80,14 @@ func nodeCreateView(ctx *Context, node *tree.CreateView) (*vitess.DDL, error) {
 	if err != nil {
 		return nil, err
 	}
+	// Temporary views are created within the temporary schema, which holds them for the session
+	tableName, isTemporary, err := temporaryRelationName(tableName, node.Persistence.IsTemporary())
+	if err != nil {
+		return nil, err
+	}
+	if isTemporary {
+		tableName.SchemaQualifier = vitess.NewTableIdent("pg_temp")
+	}
 	selectStmt, err := nodeSelect(ctx, node.AsSource)
 	if err != nil {
 		return nil, err
@@ -29,10 +29,6 @@ func nodeDropProcedure(ctx *Context, node *tree.DropProcedure) (vitess.Statement
 		return nil, nil
 	}
 
-	if node.DropBehavior == tree.DropCascade {
-		return nil, fmt.Errorf("DROP PROCEDURE with CASCADE is not supported yet")
-	}
-
 	if len(node.Procedures) == 0 {
 		return nil, fmt.Errorf("no function name specified for DROP PROCEDURE")
 	}
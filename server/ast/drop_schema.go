@@ -18,6 +18,7 @@ import (
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
 	"github.com/dolthub/doltgresql/server/auth"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
 
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
 )
@@ -33,12 +34,20 @@ func nodeDropSchema(ctx *Context, node *tree.DropSchema) (vitess.Statement, erro
 		return NotYetSupportedError("DROP SCHEMA with multiple schema names is not yet supported.")
 	}
 
+	schemaName := node.Names[0]
+
 	if node.DropBehavior == tree.DropCascade {
-		return NotYetSupportedError("DROP SCHEMA with CASCADE behavior is not yet supported.")
+		restrict := *node
+		restrict.DropBehavior = tree.DropDefault
+		return vitess.InjectedStatement{
+			Statement: &pgnodes.DropCascade{
+				Statement: tree.AsString(&restrict),
+				Schema:    schemaName,
+			},
+			Children: nil,
+		}, nil
 	}
 
-	schemaName := node.Names[0]
-
 	return &vitess.DBDDL{
 		Action:           vitess.DropStr,
 		SchemaOrDatabase: "schema",
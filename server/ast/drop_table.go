@@ -15,26 +15,35 @@
 package ast
 
 import (
-	"github.com/cockroachdb/errors"
-
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
 	"github.com/dolthub/doltgresql/server/auth"
+	"github.com/dolthub/doltgresql/server/dependencies"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
 )
 
 // nodeDropTable handles *tree.DropTable nodes.
-func nodeDropTable(ctx *Context, node *tree.DropTable) (*vitess.DDL, error) {
+func nodeDropTable(ctx *Context, node *tree.DropTable) (vitess.Statement, error) {
 	if node == nil || len(node.Names) == 0 {
 		return nil, nil
 	}
-	switch node.DropBehavior {
-	case tree.DropDefault:
-		// Default behavior, nothing to do
-	case tree.DropRestrict:
-		return nil, errors.Errorf("RESTRICT is not yet supported")
-	case tree.DropCascade:
-		return nil, errors.Errorf("CASCADE is not yet supported")
+	if node.DropBehavior == tree.DropCascade {
+		names, err := nodeDropCascadeNames(ctx, node.Names)
+		if err != nil {
+			return nil, err
+		}
+		restrict := *node
+		restrict.DropBehavior = tree.DropDefault
+		return vitess.InjectedStatement{
+			Statement: &pgnodes.DropCascade{
+				Statement: tree.AsString(&restrict),
+				Relations: names,
+				Kind:      dependencies.ObjectKind_Table,
+			},
+			Children: nil,
+		}, nil
 	}
 	tableNames := make([]vitess.TableName, len(node.Names))
 	authTableNames := make([]string, 0, len(node.Names)*3)
@@ -58,3 +67,16 @@ func nodeDropTable(ctx *Context, node *tree.DropTable) (*vitess.DDL, error) {
 		},
 	}, nil
 }
+
+// nodeDropCascadeNames returns the names of the relations being dropped by a DROP statement using CASCADE.
+func nodeDropCascadeNames(ctx *Context, treeNames tree.TableNames) ([]doltdb.TableName, error) {
+	names := make([]doltdb.TableName, len(treeNames))
+	for i := range treeNames {
+		tableName, err := nodeTableName(ctx, &treeNames[i])
+		if err != nil {
+			return nil, err
+		}
+		names[i] = doltdb.TableName{Name: tableName.Name.String(), Schema: tableName.SchemaQualifier.String()}
+	}
+	return names, nil
+}
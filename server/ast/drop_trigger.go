@@ -15,7 +15,6 @@
 package ast
 
 import (
-	"github.com/cockroachdb/errors"
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
@@ -24,14 +23,7 @@ import (
 
 // nodeDropTrigger handles *tree.DropTrigger nodes.
 func nodeDropTrigger(ctx *Context, node *tree.DropTrigger) (vitess.Statement, error) {
-	switch node.DropBehavior {
-	case tree.DropDefault:
-		// Default behavior, nothing to do
-	case tree.DropRestrict:
-		return nil, errors.Errorf("RESTRICT is not yet supported")
-	case tree.DropCascade:
-		return nil, errors.Errorf("CASCADE is not yet supported")
-	}
+	// No objects may depend on a trigger, so RESTRICT and CASCADE behave the same
 	return vitess.InjectedStatement{
 		Statement: pgnodes.NewDropTrigger(
 			node.IfExists,
@@ -15,25 +15,34 @@
 package ast
 
 import (
-	"github.com/cockroachdb/errors"
-
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	"github.com/dolthub/doltgresql/server/dependencies"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
 )
 
 // nodeDropView handles *tree.DropView nodes.
-func nodeDropView(ctx *Context, node *tree.DropView) (*vitess.DDL, error) {
+func nodeDropView(ctx *Context, node *tree.DropView) (vitess.Statement, error) {
 	if node == nil || len(node.Names) == 0 {
 		return nil, nil
 	}
-	switch node.DropBehavior {
-	case tree.DropDefault:
-		// Default behavior, nothing to do
-	case tree.DropRestrict:
-		return nil, errors.Errorf("RESTRICT is not yet supported")
-	case tree.DropCascade:
-		return nil, errors.Errorf("CASCADE is not yet supported")
+	if node.DropBehavior == tree.DropCascade && !node.IsMaterialized {
+		names, err := nodeDropCascadeNames(ctx, node.Names)
+		if err != nil {
+			return nil, err
+		}
+		restrict := *node
+		restrict.DropBehavior = tree.DropDefault
+		return vitess.InjectedStatement{
+			Statement: &pgnodes.DropCascade{
+				Statement: tree.AsString(&restrict),
+				Relations: names,
+				Kind:      dependencies.ObjectKind_View,
+			},
+			Children: nil,
+		}, nil
 	}
 	tableNames := make([]vitess.TableName, len(node.Names))
 	for i := range node.Names {
@@ -43,7 +52,20 @@ func nodeDropView(ctx *Context, node *tree.DropView) (*vitess.DDL, error) {
 			return nil, err
 		}
 	}
-	//TODO: handle IsMaterialized
+	if node.IsMaterialized {
+		names := make([]doltdb.TableName, len(tableNames))
+		for i, tableName := range tableNames {
+			names[i] = doltdb.TableName{Name: tableName.Name.String(), Schema: tableName.SchemaQualifier.String()}
+		}
+		return vitess.InjectedStatement{
+			Statement: &pgnodes.DropMaterializedView{
+				Names:    names,
+				IfExists: node.IfExists,
+				Cascade:  node.DropBehavior == tree.DropCascade,
+			},
+			Children: nil,
+		}, nil
+	}
 	return &vitess.DDL{
 		Action:    vitess.DropStr,
 		IfExists:  node.IfExists,
@@ -18,6 +18,7 @@ import (
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
 )
 
 // nodeExecute handles *tree.Execute nodes.
@@ -25,6 +26,19 @@ func nodeExecute(ctx *Context, node *tree.Execute) (vitess.Statement, error) {
 	if node == nil {
 		return nil, nil
 	}
-
-	return NotYetSupportedError("EXECUTE is not yet supported")
+	params := make([]vitess.Expr, len(node.Params))
+	for i, param := range node.Params {
+		expr, err := nodeExpr(ctx, param)
+		if err != nil {
+			return nil, err
+		}
+		params[i] = expr
+	}
+	return vitess.InjectedStatement{
+		Statement: &pgnodes.ExecuteStatement{
+			Name:   string(node.Name),
+			Params: params,
+		},
+		Children: nil,
+	}, nil
 }
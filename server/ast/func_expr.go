@@ -23,6 +23,7 @@ import (
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
 	"github.com/dolthub/doltgresql/server/auth"
 	pgexprs "github.com/dolthub/doltgresql/server/expression"
+	"github.com/dolthub/doltgresql/server/functions/framework"
 )
 
 // nodeFuncExpr handles *tree.FuncExpr nodes.
@@ -61,14 +62,78 @@ func nodeFuncExpr(ctx *Context, node *tree.FuncExpr) (vitess.Expr, error) {
 	default:
 		return nil, errors.Errorf("unknown function spec type %d", node.Type)
 	}
-	windowDef, err := nodeWindowDef(ctx, node.WindowDef)
-	if err != nil {
-		return nil, err
+	// Arguments may be given by the name of their parameter, in which case they must follow every positional argument
+	argNames := make([]string, len(node.Exprs))
+	argExprs := make(tree.Exprs, len(node.Exprs))
+	for i, argExpr := range node.Exprs {
+		if namedArg, ok := argExpr.(*tree.NamedArgExpr); ok {
+			argNames[i] = string(namedArg.Name)
+			argExprs[i] = namedArg.Value
+		} else if i > 0 && len(argNames[i-1]) > 0 {
+			return nil, errors.Errorf("positional argument cannot follow named argument")
+		} else {
+			argExprs[i] = argExpr
+		}
 	}
-	exprs, err := nodeExprsToSelectExprs(ctx, node.Exprs)
+	exprs, err := nodeExprsToSelectExprs(ctx, argExprs)
 	if err != nil {
 		return nil, err
 	}
+	for i, argName := range argNames {
+		if len(argName) == 0 {
+			continue
+		}
+		namedArg, ok := exprs[i].(*vitess.AliasedExpr)
+		if !ok {
+			return nil, errors.Errorf("unexpected named argument")
+		}
+		exprs[i] = &vitess.AliasedExpr{Expr: vitess.InjectedExpr{
+			Expression: &framework.NamedArgument{Name: argName},
+			Children:   vitess.Exprs{namedArg.Expr},
+		}}
+	}
+	if node.Variadic {
+		switch strings.ToLower(name.String()) {
+		case "string_agg", "array_agg", "greatest", "least":
+			return nil, errors.Errorf("VARIADIC is not supported for %s", name.String())
+		}
+		// The VARIADIC argument is always the last one, and supplies every value of the function's variadic parameter
+		last, ok := exprs[len(exprs)-1].(*vitess.AliasedExpr)
+		if !ok {
+			return nil, errors.Errorf("unexpected VARIADIC argument")
+		}
+		exprs[len(exprs)-1] = &vitess.AliasedExpr{Expr: vitess.InjectedExpr{
+			Expression: &framework.VariadicArgument{},
+			Children:   vitess.Exprs{last.Expr},
+		}}
+	}
+
+	var windowDef *vitess.WindowDef
+	if flattenedDef := flattenWindowDef(ctx, node.WindowDef); usesWindowFrameSpec(flattenedDef) {
+		if !framework.IsFrameAwareWindowFunction(name.String()) {
+			// This reports the frame as unsupported, as no other function can evaluate it
+			_, err = nodeWindowDef(ctx, flattenedDef)
+			return nil, err
+		}
+		// GMS cannot represent this frame, so the function is given its whole partition along with the frame as a
+		// trailing argument, which it then evaluates itself
+		frameSpec, offsets, err := nodeWindowFrameSpec(ctx, flattenedDef.Frame)
+		if err != nil {
+			return nil, err
+		}
+		unframedDef := *flattenedDef
+		unframedDef.Frame = nil
+		windowDef, err = nodeWindowDef(ctx, &unframedDef)
+		if err != nil {
+			return nil, err
+		}
+		exprs = append(exprs, &vitess.AliasedExpr{Expr: vitess.InjectedExpr{Expression: frameSpec, Children: offsets}})
+	} else {
+		windowDef, err = nodeWindowDef(ctx, node.WindowDef)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	switch strings.ToLower(name.String()) {
 	// special case for string_agg, which maps to the mysql aggregate function group_concat
@@ -15,9 +15,13 @@
 package ast
 
 import (
+	"github.com/cockroachdb/errors"
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
+	"github.com/dolthub/doltgresql/postgres/parser/parser"
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
 )
 
 // nodePrepare handles *tree.Prepare nodes.
@@ -25,6 +29,37 @@ func nodePrepare(ctx *Context, node *tree.Prepare) (vitess.Statement, error) {
 	if node == nil {
 		return nil, nil
 	}
-
-	return NotYetSupportedError("PREPARE is not yet supported")
+	parameterTypes := make([]*pgtypes.DoltgresType, len(node.Types))
+	for i, typ := range node.Types {
+		_, resolvedType, err := nodeResolvableTypeReference(ctx, typ, false)
+		if err != nil {
+			return nil, err
+		}
+		if resolvedType.IsEmptyType() {
+			return nil, errors.Errorf("the type %s is not yet supported for PREPARE", typ.SQLString())
+		}
+		parameterTypes[i] = resolvedType
+	}
+	query := node.Statement.String()
+	statement, err := Convert(parser.Statement{
+		AST: node.Statement,
+		SQL: query,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if statement == nil {
+		return nil, errors.Errorf("%s is not yet supported for PREPARE", node.Statement.StatementTag())
+	}
+	return vitess.InjectedStatement{
+		Statement: &pgnodes.PrepareStatement{
+			Name:           string(node.Name),
+			ParameterTypes: parameterTypes,
+			Definition:     ctx.originalQuery,
+			Query:          query,
+			Statement:      statement,
+			StatementTag:   node.Statement.StatementTag(),
+		},
+		Children: nil,
+	}, nil
 }
@@ -1,4 +1,4 @@
-// Copyright 2023 Dolthub, Inc.
+// Copyright 2026 Dolthub, Inc.
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -18,6 +18,7 @@ import (
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
 )
 
 // nodeRefreshMaterializedView handles *tree.RefreshMaterializedView nodes.
@@ -25,6 +26,17 @@ func nodeRefreshMaterializedView(ctx *Context, node *tree.RefreshMaterializedVie
 	if node == nil {
 		return nil, nil
 	}
-
-	return NotYetSupportedError("REFRESH MATERIALIZED VIEW is not yet supported")
+	tableName, err := nodeUnresolvedObjectName(ctx, node.Name)
+	if err != nil {
+		return nil, err
+	}
+	return vitess.InjectedStatement{
+		Statement: &pgnodes.RefreshMaterializedView{
+			Schema:       tableName.SchemaQualifier.String(),
+			Name:         tableName.Name.String(),
+			Concurrently: node.Concurrently,
+			WithNoData:   node.RefreshDataOption == tree.RefreshDataClear,
+		},
+		Children: nil,
+	}, nil
 }
@@ -38,6 +38,11 @@ func nodeSelect(ctx *Context, node *tree.Select) (vitess.SelectStatement, error)
 			Rows: []tree.Exprs{},
 		}
 	}
+	// The ORDER BY may reference the named windows of the SELECT that it belongs to
+	if selectClause, ok := node.Select.(*tree.SelectClause); ok {
+		ctx.pushWindows(selectClause.Window)
+		defer ctx.popWindows()
+	}
 	selectStmt, err := nodeSelectStatement(ctx, node.Select)
 	if err != nil {
 		return nil, err
@@ -28,6 +28,8 @@ func nodeSelectClause(ctx *Context, node *tree.SelectClause) (*vitess.Select, er
 	if node == nil {
 		return nil, nil
 	}
+	ctx.pushWindows(node.Window)
+	defer ctx.popWindows()
 	selectExprs, err := nodeSelectExprs(ctx, node.Exprs)
 	if err != nil {
 		return nil, err
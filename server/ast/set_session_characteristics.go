@@ -15,11 +15,10 @@
 package ast
 
 import (
-	"github.com/cockroachdb/errors"
-
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	pgexprs "github.com/dolthub/doltgresql/server/expression"
 )
 
 // nodeSetSessionCharacteristics handles *tree.SetSessionCharacteristics nodes.
@@ -27,5 +26,19 @@ func nodeSetSessionCharacteristics(ctx *Context, node *tree.SetSessionCharacteri
 	if node == nil {
 		return nil, nil
 	}
-	return nil, errors.Errorf("SET SESSION CHARACTERISTICS is not yet supported")
+	level, err := nodeTransactionIsolation(node.Modes)
+	if err != nil {
+		return nil, err
+	}
+	return &vitess.Set{
+		Exprs: vitess.SetVarExprs{&vitess.SetVarExpr{
+			Scope: vitess.SetScope_Session,
+			Name: &vitess.ColName{
+				Name: vitess.NewColIdent("default_transaction_isolation"),
+			},
+			Expr: vitess.InjectedExpr{
+				Expression: pgexprs.NewUnknownLiteral(string(level)),
+			},
+		}},
+	}, nil
 }
@@ -18,6 +18,7 @@ import (
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
 )
 
 // nodeSetTransaction handles *tree.SetTransaction nodes.
@@ -25,6 +26,14 @@ func nodeSetTransaction(ctx *Context, node *tree.SetTransaction) (vitess.Stateme
 	if node == nil {
 		return nil, nil
 	}
-
-	return NotYetSupportedError("SET TRANSACTION is not yet supported")
+	level, err := nodeTransactionIsolation(node.Modes)
+	if err != nil {
+		return nil, err
+	}
+	return vitess.InjectedStatement{
+		Statement: &pgnodes.SetTransaction{
+			IsolationLevel: string(level),
+		},
+		Children: nil,
+	}, nil
 }
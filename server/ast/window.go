@@ -15,12 +15,16 @@
 package ast
 
 import (
+	"go/constant"
+
 	"github.com/cockroachdb/errors"
 
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
+	"github.com/dolthub/doltgresql/postgres/parser/duration"
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
 	pgexprs "github.com/dolthub/doltgresql/server/expression"
+	"github.com/dolthub/doltgresql/server/functions/framework"
 )
 
 // nodeWindow handles *tree.Window nodes.
@@ -30,6 +34,12 @@ func nodeWindow(ctx *Context, node tree.Window) (vitess.Window, error) {
 	}
 	windows := make(vitess.Window, len(node))
 	for i, def := range node {
+		if usesWindowFrameSpec(def) {
+			// GMS cannot represent this frame, so it's given to each function that uses the window instead
+			unframedDef := *def
+			unframedDef.Frame = nil
+			def = &unframedDef
+		}
 		windowDef, err := nodeWindowDef(ctx, def)
 		if err != nil {
 			return nil, err
@@ -54,6 +64,9 @@ func nodeWindowDef(ctx *Context, node *tree.WindowDef) (*vitess.WindowDef, error
 	}
 	var frame *vitess.Frame
 	if node.Frame != nil {
+		if node.Frame.Exclusion != tree.NoExclusion {
+			return nil, errors.Errorf("EXCLUDE is not yet supported")
+		}
 		var unit vitess.FrameUnit
 		switch node.Frame.Mode {
 		case tree.RANGE:
@@ -85,18 +98,9 @@ func nodeWindowDef(ctx *Context, node *tree.WindowDef) (*vitess.WindowDef, error
 			default:
 				return nil, errors.Errorf("unknown window frame bound type")
 			}
-			var boundExpr vitess.Expr
-			if intervalOffset, ok := bound.OffsetExpr.(*tree.DInterval); ok {
-				// GMS's window framer only recognizes date+interval arithmetic via its
-				// expression.DeltaExpression hook. nodeExpr's usual interval-literal conversion doesn't
-				// implement that hook, so build the offset directly as *pgexprs.Interval instead.
-				boundExpr = vitess.InjectedExpr{Expression: pgexprs.NewInterval(intervalOffset.Duration)}
-			} else {
-				var err error
-				boundExpr, err = nodeExpr(ctx, bound.OffsetExpr)
-				if err != nil {
-					return nil, err
-				}
+			boundExpr, err := nodeWindowFrameOffset(ctx, bound.OffsetExpr)
+			if err != nil {
+				return nil, err
 			}
 			bounds[i] = &vitess.FrameBound{
 				Expr: boundExpr,
@@ -119,3 +123,180 @@ func nodeWindowDef(ctx *Context, node *tree.WindowDef) (*vitess.WindowDef, error
 		Frame:       frame,
 	}, nil
 }
+
+// flattenWindowDef returns the given window with the named windows that it references merged into it, when the
+// resulting frame can only be represented by a framework.WindowFrameSpec. Such frames are removed from the named
+// windows that GMS is given, so the functions that use them must carry the whole window themselves. Returns the window
+// unchanged otherwise.
+func flattenWindowDef(ctx *Context, node *tree.WindowDef) *tree.WindowDef {
+	flattened := node
+	visited := make(map[tree.Name]struct{})
+	for flattened != nil && len(flattened.RefName) > 0 {
+		// Windows that reference themselves are left for GMS to report
+		if _, ok := visited[flattened.RefName]; ok {
+			return node
+		}
+		visited[flattened.RefName] = struct{}{}
+		ref := ctx.namedWindow(flattened.RefName)
+		if ref == nil {
+			return node
+		}
+		merged := &tree.WindowDef{
+			RefName:    ref.RefName,
+			Partitions: ref.Partitions,
+			OrderBy:    ref.OrderBy,
+			Frame:      ref.Frame,
+		}
+		if len(flattened.OrderBy) > 0 {
+			merged.OrderBy = flattened.OrderBy
+		}
+		if flattened.Frame != nil {
+			merged.Frame = flattened.Frame
+		}
+		flattened = merged
+	}
+	if !usesWindowFrameSpec(flattened) {
+		return node
+	}
+	return flattened
+}
+
+// usesWindowFrameSpec returns whether the given window's frame can only be represented by a framework.WindowFrameSpec,
+// which is any frame in GROUPS mode or with an EXCLUDE clause.
+func usesWindowFrameSpec(node *tree.WindowDef) bool {
+	return node != nil && node.Frame != nil && (node.Frame.Mode == tree.GROUPS || node.Frame.Exclusion != tree.NoExclusion)
+}
+
+// nodeWindowFrameSpec converts the given frame into a framework.WindowFrameSpec. This is only used for frames that GMS
+// cannot represent. The offsets of RANGE frames are returned as expressions, which become the children of the spec.
+func nodeWindowFrameSpec(ctx *Context, node *tree.WindowFrame) (*framework.WindowFrameSpec, vitess.Exprs, error) {
+	spec := &framework.WindowFrameSpec{}
+	switch node.Mode {
+	case tree.RANGE:
+		spec.Mode = framework.WindowFrameMode_Range
+	case tree.ROWS:
+		spec.Mode = framework.WindowFrameMode_Rows
+	case tree.GROUPS:
+		spec.Mode = framework.WindowFrameMode_Groups
+	default:
+		return nil, nil, errors.Errorf("unknown window frame mode")
+	}
+	switch node.Exclusion {
+	case tree.NoExclusion:
+		spec.Exclusion = framework.WindowFrameExclude_NoOthers
+	case tree.ExcludeCurrentRow:
+		spec.Exclusion = framework.WindowFrameExclude_CurrentRow
+	case tree.ExcludeGroup:
+		spec.Exclusion = framework.WindowFrameExclude_Group
+	case tree.ExcludeTies:
+		spec.Exclusion = framework.WindowFrameExclude_Ties
+	default:
+		return nil, nil, errors.Errorf("unknown window frame exclusion")
+	}
+	var offsets vitess.Exprs
+	var offset vitess.Expr
+	var err error
+	if spec.Start, offset, err = nodeWindowFrameSpecBound(ctx, node.Bounds.StartBound, node.Mode, "starting"); err != nil {
+		return nil, nil, err
+	}
+	if offset != nil {
+		offsets = append(offsets, offset)
+	}
+	// A frame without an end bound ends at the current row
+	spec.End = framework.WindowFrameBound{Type: framework.WindowFrameBound_CurrentRow}
+	if node.Bounds.EndBound != nil {
+		if spec.End, offset, err = nodeWindowFrameSpecBound(ctx, node.Bounds.EndBound, node.Mode, "ending"); err != nil {
+			return nil, nil, err
+		}
+		if offset != nil {
+			offsets = append(offsets, offset)
+		}
+	}
+	if spec.Start.Type == framework.WindowFrameBound_UnboundedFollowing {
+		return nil, nil, errors.Errorf("frame start cannot be UNBOUNDED FOLLOWING")
+	}
+	if spec.End.Type == framework.WindowFrameBound_UnboundedPreceding {
+		return nil, nil, errors.Errorf("frame end cannot be UNBOUNDED PRECEDING")
+	}
+	return spec, offsets, nil
+}
+
+// nodeWindowFrameSpecBound converts a single bound for nodeWindowFrameSpec. The position is used in error messages, and
+// is either "starting" or "ending". The offsets of RANGE frames are returned as an expression, as they're values of the
+// ORDER BY column's type (or of a type that may be added to it), while other offsets must be integer constants.
+func nodeWindowFrameSpecBound(ctx *Context, node *tree.WindowFrameBound, mode tree.WindowFrameMode, position string) (framework.WindowFrameBound, vitess.Expr, error) {
+	var bound framework.WindowFrameBound
+	switch node.BoundType {
+	case tree.UnboundedPreceding:
+		bound.Type = framework.WindowFrameBound_UnboundedPreceding
+	case tree.OffsetPreceding:
+		bound.Type = framework.WindowFrameBound_OffsetPreceding
+	case tree.CurrentRow:
+		bound.Type = framework.WindowFrameBound_CurrentRow
+	case tree.OffsetFollowing:
+		bound.Type = framework.WindowFrameBound_OffsetFollowing
+	case tree.UnboundedFollowing:
+		bound.Type = framework.WindowFrameBound_UnboundedFollowing
+	default:
+		return bound, nil, errors.Errorf("unknown window frame bound type")
+	}
+	if !node.HasOffset() {
+		return bound, nil, nil
+	}
+	if mode == tree.RANGE {
+		offset, err := nodeWindowFrameOffset(ctx, node.OffsetExpr)
+		if err != nil {
+			return bound, nil, err
+		}
+		if isNegativeWindowFrameOffset(node.OffsetExpr) {
+			return bound, nil, errors.Errorf("invalid preceding or following size in window function")
+		}
+		return bound, offset, nil
+	}
+	switch offset := node.OffsetExpr.(type) {
+	case *tree.NumVal:
+		n, err := offset.AsInt64()
+		if err != nil {
+			return bound, nil, errors.Errorf("frame %s offset must be an integer constant", position)
+		}
+		bound.Offset = n
+	case *tree.DInt:
+		bound.Offset = int64(*offset)
+	default:
+		return bound, nil, errors.Errorf("frame %s offset must be an integer constant", position)
+	}
+	if bound.Offset < 0 {
+		return bound, nil, errors.Errorf("frame %s offset must not be negative", position)
+	}
+	return bound, nil, nil
+}
+
+// nodeWindowFrameOffset converts the offset of a RANGE frame bound.
+func nodeWindowFrameOffset(ctx *Context, node tree.Expr) (vitess.Expr, error) {
+	if intervalOffset, ok := node.(*tree.DInterval); ok {
+		// GMS's window framer only recognizes date+interval arithmetic via its
+		// expression.DeltaExpression hook. nodeExpr's usual interval-literal conversion doesn't
+		// implement that hook, so build the offset directly as *pgexprs.Interval instead.
+		return vitess.InjectedExpr{Expression: pgexprs.NewInterval(intervalOffset.Duration)}, nil
+	}
+	return nodeExpr(ctx, node)
+}
+
+// isNegativeWindowFrameOffset returns whether the given RANGE offset is a negative constant. Offsets that are not
+// constants are not checked.
+func isNegativeWindowFrameOffset(node tree.Expr) bool {
+	switch offset := node.(type) {
+	case *tree.NumVal:
+		return constant.Sign(offset.AsConstantValue()) < 0
+	case *tree.DInt:
+		return *offset < 0
+	case *tree.DDecimal:
+		return offset.Negative
+	case *tree.DFloat:
+		return *offset < 0
+	case *tree.DInterval:
+		return offset.Duration.Compare(duration.Duration{}) < 0
+	default:
+		return false
+	}
+}
@@ -60,31 +60,6 @@ func jsonbGetInterface(ctx *sql.Context, val any) (any, error) {
 	}
 }
 
-// jsonbNumberToDecimal converts various numeric types from JSON deserialization to decimal.Decimal.
-func jsonbNumberToDecimal(v any) (*apd.Decimal, bool) {
-	switch n := v.(type) {
-	case float64:
-		d, _ := apd.New(0, 0).SetFloat64(n)
-		return d, true
-	case float32:
-		d, _ := apd.New(0, 0).SetFloat64(float64(n))
-		return d, true
-	case json.Number:
-		d, _, err := apd.NewFromString(n.String())
-		if err != nil {
-			return nil, false
-		}
-		return d, true
-	case int64:
-		return apd.New(n, 0), true
-	case int32:
-		return apd.New(int64(n), 0), true
-	case *apd.Decimal:
-		return n, true
-	}
-	return nil, false
-}
-
 // jsonbDecimalToInt rounds the decimal half-to-even (matching Postgres'
 // rules for numeric → integer casts) and then verifies that the rounded
 // value lies within [min, max]. Rounding happens before the bounds check so
@@ -165,7 +140,7 @@ func jsonbExplicit(builtInCasts map[id.Cast]casts.Cast) {
 			case nil:
 				return nil, errors.Errorf("cannot cast jsonb null to type %s", targetType.String())
 			default:
-				if _, ok := jsonbNumberToDecimal(v); ok {
+				if _, ok := pgtypes.JsonNumberToDecimal(v); ok {
 					return nil, errors.Errorf("cannot cast jsonb numeric to type %s", targetType.String())
 				}
 				return nil, errors.Errorf("unexpected jsonb value type: %T", v)
@@ -192,7 +167,7 @@ func jsonbExplicit(builtInCasts map[id.Cast]casts.Cast) {
 			case nil:
 				return nil, errors.Errorf("cannot cast jsonb null to type %s", targetType.String())
 			default:
-				d, ok := jsonbNumberToDecimal(v)
+				d, ok := pgtypes.JsonNumberToDecimal(v)
 				if !ok {
 					return nil, errors.Errorf("unexpected jsonb value type: %T", v)
 				}
@@ -220,7 +195,7 @@ func jsonbExplicit(builtInCasts map[id.Cast]casts.Cast) {
 			case nil:
 				return nil, errors.Errorf("cannot cast jsonb null to type %s", targetType.String())
 			default:
-				d, ok := jsonbNumberToDecimal(v)
+				d, ok := pgtypes.JsonNumberToDecimal(v)
 				if !ok {
 					return nil, errors.Errorf("unexpected jsonb value type: %T", v)
 				}
@@ -248,7 +223,7 @@ func jsonbExplicit(builtInCasts map[id.Cast]casts.Cast) {
 			case nil:
 				return nil, errors.Errorf("cannot cast jsonb null to type %s", targetType.String())
 			default:
-				d, ok := jsonbNumberToDecimal(v)
+				d, ok := pgtypes.JsonNumberToDecimal(v)
 				if !ok {
 					return nil, errors.Errorf("unexpected jsonb value type: %T", v)
 				}
@@ -280,7 +255,7 @@ func jsonbExplicit(builtInCasts map[id.Cast]casts.Cast) {
 			case nil:
 				return nil, errors.Errorf("cannot cast jsonb null to type %s", targetType.String())
 			default:
-				d, ok := jsonbNumberToDecimal(v)
+				d, ok := pgtypes.JsonNumberToDecimal(v)
 				if !ok {
 					return nil, errors.Errorf("unexpected jsonb value type: %T", v)
 				}
@@ -312,7 +287,7 @@ func jsonbExplicit(builtInCasts map[id.Cast]casts.Cast) {
 			case nil:
 				return nil, errors.Errorf("cannot cast jsonb null to type %s", targetType.String())
 			default:
-				d, ok := jsonbNumberToDecimal(v)
+				d, ok := pgtypes.JsonNumberToDecimal(v)
 				if !ok {
 					return nil, errors.Errorf("unexpected jsonb value type: %T", v)
 				}
@@ -340,7 +315,7 @@ func jsonbExplicit(builtInCasts map[id.Cast]casts.Cast) {
 			case nil:
 				return nil, errors.Errorf("cannot cast jsonb null to type %s", targetType.String())
 			default:
-				d, ok := jsonbNumberToDecimal(v)
+				d, ok := pgtypes.JsonNumberToDecimal(v)
 				if !ok {
 					return nil, errors.Errorf("unexpected jsonb value type: %T", v)
 				}
@@ -106,6 +106,9 @@ type PreparedStatementData struct {
 	Query        ConvertedQuery
 	ReturnFields []pgproto3.FieldDescription
 	BindVarTypes []uint32
+	// ReturnsOkResult is true when the statement does not return any rows, in which case ReturnFields describes the
+	// number of affected rows.
+	ReturnsOkResult bool
 }
 
 // extractBindVarTypes returns types based on the given query plan.
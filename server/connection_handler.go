@@ -45,6 +45,7 @@ import (
 	"github.com/sirupsen/logrus"
 
 	"github.com/dolthub/doltgresql/core/dataloader"
+	"github.com/dolthub/doltgresql/core/sequences"
 	"github.com/dolthub/doltgresql/postgres/parser/parser"
 	psql "github.com/dolthub/doltgresql/postgres/parser/parser/sql"
 	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
@@ -52,7 +53,10 @@ import (
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
 	"github.com/dolthub/doltgresql/server/ast"
 	"github.com/dolthub/doltgresql/server/functions/framework"
+	"github.com/dolthub/doltgresql/server/isolation"
 	"github.com/dolthub/doltgresql/server/node"
+	"github.com/dolthub/doltgresql/server/prepared"
+	"github.com/dolthub/doltgresql/server/temporary"
 	pgtypes "github.com/dolthub/doltgresql/server/types"
 )
 
@@ -77,6 +81,15 @@ type ConnectionHandler struct {
 	// Failed (an error occurred inside an explicit transaction block, and all statements are rejected until the client ends the transaction block)
 	// See https://www.postgresql.org/docs/current/protocol-flow.html for the full ruleset.
 	transactionState transactionState
+	// transactionBlock holds the characteristics of the explicit transaction block, and is nil when there isn't one.
+	transactionBlock *transactionBlock
+
+	// replicationDatabase is set when this connection was started in the logical replication mode, meaning it accepts
+	// replication commands (such as START_REPLICATION) in addition to SQL statements.
+	replicationDatabase bool
+	// temporarySlots are the temporary replication slots created by this connection, which are dropped when the
+	// connection ends.
+	temporarySlots []string
 }
 
 // transactionState is the transaction block state of a connection. See the field of the same name on
@@ -200,6 +213,11 @@ func (h *ConnectionHandler) HandleConnection() {
 	}()
 	h.doltgresHandler.NewConnection(h.mysqlConn)
 	defer func() {
+		h.dropTemporarySlots()
+		isolation.Rollback(h.mysqlConn.ConnectionID)
+		prepared.RemoveAll(h.mysqlConn.ConnectionID)
+		temporary.RemoveAll(h.mysqlConn.ConnectionID)
+		sequences.RemoveSessionCache(h.mysqlConn.ConnectionID)
 		h.doltgresHandler.ConnectionClosed(h.mysqlConn)
 	}()
 
@@ -343,6 +361,10 @@ func (h *ConnectionHandler) chooseInitialParameters(startupMessage *pgproto3.Sta
 			if err != nil {
 				return err
 			}
+		case "replication":
+			if err := h.setReplicationMode(value); err != nil {
+				return err
+			}
 		case "timezone":
 			// timezone is set via a real SET statement rather than InitSessionParameterDefault because we want
 			// this value set for the current session, but NOT set as the default for all sessions.
@@ -461,6 +483,12 @@ func (h *ConnectionHandler) handleMessage(msg pgproto3.Message) (stop, endOfMess
 		// We don't buffer output, so Flush is a no-op
 		return false, false, nil
 	case *pgproto3.Query:
+		if h.replicationDatabase {
+			var handled bool
+			if handled, stop, endOfMessages, err = h.handleReplicationCommand(message.String); handled {
+				return stop, endOfMessages, err
+			}
+		}
 		endOfMessages, err = h.handleQuery(message)
 		return false, endOfMessages, err
 	case *pgproto3.Parse:
@@ -473,7 +501,7 @@ func (h *ConnectionHandler) handleMessage(msg pgproto3.Message) (stop, endOfMess
 		return false, false, h.handleExecute(message)
 	case *pgproto3.Close:
 		if message.ObjectType == 'S' {
-			delete(h.preparedStatements, message.Name)
+			h.removePreparedStatement(message.Name)
 		} else {
 			delete(h.portals, message.Name)
 		}
@@ -518,7 +546,10 @@ func (h *ConnectionHandler) handleQuery(message *pgproto3.Query) (endOfMessages
 		if handled {
 			return endOfMessages, err
 		}
-		return true, h.query(queries[0])
+		if err = h.prepareTransactionSnapshot(queries[0]); err != nil {
+			return true, err
+		}
+		return true, h.autocommitQuery(queries[0])
 	}
 
 	// Multiple statements in a single Query message run in an implicit transaction block, which is committed
@@ -543,7 +574,8 @@ func (h *ConnectionHandler) handleQuery(message *pgproto3.Query) (endOfMessages
 		// For multi-statement queries, we start an implicit transaction block before the first statement and commit
 		// it on the last statement. This involves manipulating the session's auto-commit behavior so that the engine
 		// automatically commits only the final statement. This is cheaper than running BEGIN and COMMIT statements
-		// separately through the engine, and has the same effect.
+		// separately through the engine, and has the same effect. SERIALIZABLE transactions must be checked for conflicts
+		// before committing, so the final implicit COMMIT is used for them instead.
 		if implicitTransactionControl {
 			if err = h.startImplicitTransaction(query); err != nil {
 				return true, err
@@ -553,14 +585,23 @@ func (h *ConnectionHandler) handleQuery(message *pgproto3.Query) (endOfMessages
 				if err != nil {
 					return false, err
 				}
-				ctx.SetIgnoreAutoCommit(false)
+				if level, _ := isolation.TrackedLevel(h.mysqlConn.ConnectionID); level != isolation.Serializable {
+					ctx.SetIgnoreAutoCommit(false)
+				}
 			}
 		}
+		if err = h.prepareTransactionSnapshot(query); err != nil {
+			return true, err
+		}
 
 		err = h.query(query)
 		if err != nil {
 			return true, err
 		}
+		// A COMMIT or ROLLBACK within the message ends the transaction before the remaining statements run
+		if h.transactionState == idleTransactionState {
+			h.applyOnCommitActions()
+		}
 	}
 
 	// For some statement sequences, a final implicit COMMIT may be necessary
@@ -590,20 +631,13 @@ func (h *ConnectionHandler) handleQueryOutsideEngine(query ConvertedQuery) (hand
 			// be replaced by a READ ONLY one, causing writes to be rejected and changes to be lost).
 			return true, true, h.send(makeCommandComplete(query.StatementTag, 0))
 		}
-		if h.transactionState == implicitTransactionState {
-			// A BEGIN inside an implicit transaction block converts it into a regular (explicit) transaction
-			// block: the statements already executed in the implicit block are NOT committed, but instead are
-			// retroactively included in the new explicit block. The engine transaction backing the implicit
-			// block simply continues as the explicit block's transaction, so we don't involve the engine here.
-			h.transactionState = explicitTransactionState
-			return true, true, h.send(makeCommandComplete(query.StatementTag, 0))
-		}
-		h.transactionState = explicitTransactionState
+		return true, true, h.beginTransactionBlock(stmt, query)
 	case *sqlparser.Commit:
 		if h.transactionState == failedTransactionState {
 			// A COMMIT issued inside a failed transaction block ends the block by rolling it back, and reports
 			// ROLLBACK to the client to indicate that the transaction's effects were discarded.
 			h.transactionState = idleTransactionState
+			h.rollbackTransactionIsolation()
 			if err := h.runEngineTransactionControl("ROLLBACK"); err != nil {
 				return true, true, err
 			}
@@ -611,29 +645,41 @@ func (h *ConnectionHandler) handleQueryOutsideEngine(query ConvertedQuery) (hand
 		}
 		// A COMMIT closes the current transaction block, whether explicit or implicit. Any statements that
 		// follow it in the same Query message (or extended-query batch) run in a new implicit transaction block.
+		// A SERIALIZABLE transaction that conflicts with a concurrent transaction is rolled back instead.
 		h.transactionState = idleTransactionState
+		if err := h.commitTransactionIsolation(); err != nil {
+			return true, true, err
+		}
 	case *sqlparser.Rollback:
 		// Like COMMIT, a ROLLBACK closes the current transaction block, whether explicit, implicit, or failed.
 		h.transactionState = idleTransactionState
+		h.rollbackTransactionIsolation()
 	case *sqlparser.Savepoint:
 		if !h.transactionState.inExplicitTransactionBlock() {
 			return true, true, noActiveTransactionError("SAVEPOINT")
 		}
+		h.trackSavepoint(stmt)
 	case *sqlparser.RollbackSavepoint:
 		if !h.transactionState.inExplicitTransactionBlock() {
 			return true, true, noActiveTransactionError("ROLLBACK TO SAVEPOINT")
 		}
 		h.transactionState = explicitTransactionState
+		h.trackSavepoint(stmt)
 	case *sqlparser.ReleaseSavepoint:
 		if !h.transactionState.inExplicitTransactionBlock() {
 			return true, true, noActiveTransactionError("RELEASE SAVEPOINT")
 		}
+		h.trackSavepoint(stmt)
 	case *sqlparser.Deallocate:
-		return true, true, h.deallocatePreparedStatement(stmt.Name, h.preparedStatements, query, h.Conn())
+		return true, true, h.deallocatePreparedStatement(stmt.Name, query)
 	case sqlparser.InjectedStatement:
 		switch injectedStmt := stmt.Statement.(type) {
 		case node.DiscardStatement:
 			return true, true, h.discardAll(query)
+		case *node.PrepareStatement:
+			return true, true, h.prepareStatement(injectedStmt, query)
+		case *node.SetTransaction:
+			return true, true, h.setTransaction(injectedStmt)
 		case *node.CopyFrom:
 			// When copying data from STDIN, the data is sent to the server as CopyData messages
 			// We send endOfMessages=false since the server will be in COPY DATA mode and won't
@@ -682,49 +728,11 @@ func (h *ConnectionHandler) handleParse(message *pgproto3.Parse) error {
 		return nil
 	}
 
-	ctx, err := h.doltgresHandler.sm.NewContextWithQuery(context.Background(), h.mysqlConn, query.String)
-	if err != nil {
-		return err
-	}
-	parsedQuery, fields, err := h.doltgresHandler.ComPrepareParsed(ctx, h.mysqlConn, query.String, query.AST)
-	if err != nil {
-		return err
-	}
-
-	analyzedPlan, ok := parsedQuery.(sql.Node)
-	if !ok {
-		return errors.Errorf("expected a sql.Node, got %T", parsedQuery)
-	}
-
-	// A valid Parse message must have ParameterObjectIDs if there are any binding variables.
-	bindVarTypes := message.ParameterOIDs
-
-	// Clients can specify an OID of zero for a parameter, or omit trailing parameters from
-	// ParameterOIDs entirely (the Postgres protocol allows specifying types for only a prefix
-	// of the placeholders), to indicate that a parameter's type should be inferred. We always
-	// compute the plan-inferred types (we can't know whether bindVarTypes is missing
-	// trailing entries without first inspecting the analyzed plan) but only use an inferred
-	// type to fill a position the client left unspecified. An explicit, non-zero OID from the
-	// client must never be overwritten by an inferred type, since the client will encode that
-	// parameter's Bind value using its own declared type.
-	inferredTypes, err := extractBindVarTypes(ctx, analyzedPlan)
+	preparedData, err := h.prepareQuery(query, message.ParameterOIDs)
 	if err != nil {
 		return err
 	}
-	merged := make([]uint32, len(inferredTypes))
-	copy(merged, inferredTypes)
-	for i, oid := range bindVarTypes {
-		if oid != 0 && i < len(merged) {
-			merged[i] = oid
-		}
-	}
-	bindVarTypes = merged
-
-	h.preparedStatements[message.Name] = PreparedStatementData{
-		Query:        query,
-		ReturnFields: fields,
-		BindVarTypes: bindVarTypes,
-	}
+	h.setPreparedStatement(message.Name, preparedData, message.Query, false)
 	return h.send(&pgproto3.ParseComplete{})
 }
 
@@ -754,7 +762,7 @@ func (h *ConnectionHandler) handleDescribe(message *pgproto3.Describe) error {
 		query = portalData.Query
 	}
 
-	return h.sendDescribeResponse(fields, bindvarTypes, query)
+	return h.sendDescribeResponse(fields, bindvarTypes, h.executedQuery(query))
 }
 
 // handleBind handles a bind message, returning any error that occurs
@@ -782,6 +790,15 @@ func (h *ConnectionHandler) handleBind(message *pgproto3.Bind) error {
 		return h.send(&pgproto3.BindComplete{})
 	}
 
+	// Statements executed via the extended query protocol run in an implicit transaction block (see handleExecute).
+	// The statement is analyzed while binding, so the transaction must be in place beforehand.
+	if err := h.startImplicitTransaction(preparedData.Query); err != nil {
+		return err
+	}
+	if err := h.prepareTransactionSnapshot(preparedData.Query); err != nil {
+		return err
+	}
+
 	analyzedPlan, fields, err := h.doltgresHandler.ComBind(
 		context.Background(),
 		h.mysqlConn,
@@ -851,13 +868,14 @@ func (h *ConnectionHandler) handleExecute(message *pgproto3.Execute) error {
 	// |rowsAffected| gets altered by the callback below
 	rowsAffected := int32(0)
 
-	callback := h.spoolRowsCallback(query, &rowsAffected, true)
+	executedQuery := h.executedQuery(query)
+	callback := h.spoolRowsCallback(executedQuery, &rowsAffected, true)
 	err = h.doltgresHandler.ComExecuteBound(context.Background(), h.mysqlConn, query.String, portalData.BoundPlan, portalData.FormatCodes, callback)
 	if err != nil {
 		return err
 	}
 
-	return h.send(makeCommandComplete(query.StatementTag, rowsAffected))
+	return h.send(makeCommandComplete(executedQuery.StatementTag, rowsAffected))
 }
 
 func makeCommandComplete(tag string, rows int32) *pgproto3.CommandComplete {
@@ -1316,6 +1334,7 @@ func (h *ConnectionHandler) startImplicitTransaction(query ConvertedQuery) error
 
 	ctx.SetIgnoreAutoCommit(true)
 	h.transactionState = implicitTransactionState
+	isolation.Begin(h.mysqlConn.ConnectionID, isolation.DefaultLevel(ctx), false)
 	return nil
 }
 
@@ -1326,6 +1345,14 @@ func (h *ConnectionHandler) commitImplicitTransaction() error {
 		return nil
 	}
 	h.transactionState = idleTransactionState
+	if err := isolation.Commit(h.mysqlConn.ConnectionID); err != nil {
+		if !h.restoredAutoCommitWithoutTransaction() {
+			if rollbackErr := h.runEngineTransactionControl("ROLLBACK"); rollbackErr != nil {
+				logrus.Warnf("error rolling back implicit transaction after serialization failure: %s", rollbackErr)
+			}
+		}
+		return err
+	}
 	if h.restoredAutoCommitWithoutTransaction() {
 		return nil
 	}
@@ -1344,6 +1371,7 @@ func (h *ConnectionHandler) rollbackImplicitTransaction() {
 		return
 	}
 	h.transactionState = idleTransactionState
+	isolation.Rollback(h.mysqlConn.ConnectionID)
 	if h.restoredAutoCommitWithoutTransaction() {
 		return
 	}
@@ -1435,17 +1463,15 @@ func startTransactionIfNecessary(ctx *sql.Context) error {
 // deallocatePreparedStatement handles a DEALLOCATE statement by deleting the corresponding prepared statement from the
 // handler's prepared statement map, and sending a CommandComplete message back to the client. Pass an empty |name|
 // for `ALL`. This matches the behavior in the parser, which doesn't include a separate field for ALL.
-func (h *ConnectionHandler) deallocatePreparedStatement(name string, preparedStatements map[string]PreparedStatementData, query ConvertedQuery, conn net.Conn) error {
+func (h *ConnectionHandler) deallocatePreparedStatement(name string, query ConvertedQuery) error {
 	if name == "" {
-		for name := range preparedStatements {
-			delete(preparedStatements, name)
-		}
+		h.removeAllPreparedStatements()
 	} else {
-		_, ok := preparedStatements[name]
+		_, ok := h.preparedStatements[name]
 		if !ok {
-			return errors.Errorf("prepared statement %s does not exist", name)
+			return errors.Errorf(`prepared statement "%s" does not exist`, name)
 		}
-		delete(preparedStatements, name)
+		h.removePreparedStatement(name)
 	}
 
 	return h.send(&pgproto3.CommandComplete{
@@ -1458,7 +1484,8 @@ func (h *ConnectionHandler) query(query ConvertedQuery) error {
 	// |rowsAffected| gets altered by the callback below
 	rowsAffected := int32(0)
 
-	callback := h.spoolRowsCallback(query, &rowsAffected, false)
+	executedQuery := h.executedQuery(query)
+	callback := h.spoolRowsCallback(executedQuery, &rowsAffected, false)
 	err := h.doltgresHandler.ComQuery(context.Background(), h.mysqlConn, query.String, query.AST, callback)
 	if err != nil {
 		if strings.HasPrefix(err.Error(), "syntax error at position") {
@@ -1467,7 +1494,7 @@ func (h *ConnectionHandler) query(query ConvertedQuery) error {
 		return err
 	}
 
-	return h.send(makeCommandComplete(query.StatementTag, rowsAffected))
+	return h.send(makeCommandComplete(executedQuery.StatementTag, rowsAffected))
 }
 
 // spoolRowsCallback returns a callback function that will send RowDescription message,
@@ -1561,6 +1588,10 @@ func (h *ConnectionHandler) endOfMessages(err error) {
 		}
 		h.sendError(err)
 	}
+	// Once no transaction is in progress, any transaction that ran for these messages has ended
+	if h.transactionState == idleTransactionState {
+		h.applyOnCommitActions()
+	}
 	ti := ReadyForQueryTransactionIndicator_Idle
 	switch h.transactionState {
 	case failedTransactionState:
@@ -1629,6 +1660,8 @@ func (h *ConnectionHandler) discardAll(query ConvertedQuery) error {
 	if err != nil {
 		return err
 	}
+	// DISCARD ALL also deallocates every prepared statement
+	h.removeAllPreparedStatements()
 
 	return h.send(&pgproto3.CommandComplete{
 		CommandTag: []byte(query.StatementTag),
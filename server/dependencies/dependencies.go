@@ -0,0 +1,404 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dependencies
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/doltgresql/core/id"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// ObjectKind is the kind of database object that takes part in a dependency.
+type ObjectKind uint8
+
+const (
+	ObjectKind_Table ObjectKind = iota
+	ObjectKind_Column
+	ObjectKind_ColumnDefault
+	ObjectKind_View
+	ObjectKind_MaterializedView
+	ObjectKind_Function
+	ObjectKind_Procedure
+	ObjectKind_Type
+	ObjectKind_Domain
+	ObjectKind_Sequence
+	ObjectKind_Trigger
+	ObjectKind_Extension
+	ObjectKind_Policy
+)
+
+// DependencyType is the type of a dependency, using the same characters as the deptype column of pg_depend.
+type DependencyType byte
+
+const (
+	// DependencyType_Normal means that the dependent object must be dropped before the referenced object, which
+	// requires CASCADE when dropping the referenced object.
+	DependencyType_Normal DependencyType = 'n'
+	// DependencyType_Auto means that the dependent object is dropped automatically alongside the referenced object.
+	DependencyType_Auto DependencyType = 'a'
+	// DependencyType_Extension means that the dependent object is a member of the referenced extension.
+	DependencyType_Extension DependencyType = 'e'
+)
+
+// Object is a database object that may depend on, or be depended upon by, other objects. Columns use the ID of their
+// table alongside their name.
+type Object struct {
+	Kind   ObjectKind
+	ID     id.Id
+	Column string
+}
+
+// Dependency states that the dependent object relies on the referenced object.
+type Dependency struct {
+	Dependent  Object
+	Referenced Object
+	Type       DependencyType
+}
+
+// objectKey identifies an object regardless of the kind that the caller assumed it to be.
+type objectKey struct {
+	id     id.Id
+	column string
+}
+
+// Graph contains every dependency between the objects of a database.
+type Graph struct {
+	Dependencies []Dependency
+	objects      map[objectKey]Object
+	referencedBy map[objectKey][]Dependency
+	parts        map[objectKey][]Object
+	columns      map[id.Table][]string
+	schemas      map[string][]Object
+	searchPath   []string
+}
+
+// NewObject returns the object with the given ID. Columns should instead use NewColumnObject.
+func NewObject(kind ObjectKind, objectID id.Id) Object {
+	return Object{Kind: kind, ID: objectID}
+}
+
+// NewColumnObject returns the object representing the given column.
+func NewColumnObject(tableID id.Table, column string) Object {
+	return Object{Kind: ObjectKind_Column, ID: tableID.AsId(), Column: column}
+}
+
+// key returns the key for the object.
+func (obj Object) key() objectKey {
+	return objectKey{id: obj.ID, column: obj.Column}
+}
+
+// newGraph returns an empty graph using the given search path to decide which names are qualified.
+func newGraph(searchPath []string) *Graph {
+	return &Graph{
+		objects:      make(map[objectKey]Object),
+		referencedBy: make(map[objectKey][]Dependency),
+		parts:        make(map[objectKey][]Object),
+		columns:      make(map[id.Table][]string),
+		schemas:      make(map[string][]Object),
+		searchPath:   searchPath,
+	}
+}
+
+// addObject adds the object to the graph, recording it under the given schema when the schema is not empty.
+func (g *Graph) addObject(obj Object, schema string) {
+	g.objects[obj.key()] = obj
+	if len(schema) > 0 {
+		g.schemas[schema] = append(g.schemas[schema], obj)
+	}
+}
+
+// addPart records that the part is dropped whenever its owner is dropped, such as a table's columns and row type.
+func (g *Graph) addPart(owner Object, part Object) {
+	g.objects[part.key()] = part
+	g.parts[owner.key()] = append(g.parts[owner.key()], part)
+}
+
+// addDependency adds the dependency to the graph.
+func (g *Graph) addDependency(dependent Object, referenced Object, depType DependencyType) {
+	if dependent.key() == referenced.key() {
+		return
+	}
+	dep := Dependency{Dependent: dependent, Referenced: referenced, Type: depType}
+	for _, existing := range g.referencedBy[referenced.key()] {
+		if existing == dep {
+			return
+		}
+	}
+	g.Dependencies = append(g.Dependencies, dep)
+	g.referencedBy[referenced.key()] = append(g.referencedBy[referenced.key()], dep)
+}
+
+// Object returns the object as it's known by the graph, which corrects its kind. Returns false if the graph does not
+// contain the object.
+func (g *Graph) Object(obj Object) (Object, bool) {
+	known, ok := g.objects[obj.key()]
+	if !ok {
+		return obj, false
+	}
+	return known, true
+}
+
+// SchemaObjects returns every object that is contained within the given schema.
+func (g *Graph) SchemaObjects(schema string) []Object {
+	if objects, ok := g.schemas[schema]; ok {
+		return objects
+	}
+	// Schemas are otherwise matched case-insensitively, just like when they're resolved for a statement
+	for name, objects := range g.schemas {
+		if strings.EqualFold(name, schema) {
+			return objects
+		}
+	}
+	return nil
+}
+
+// ColumnIndex returns the position of the column within its table, starting from zero. Returns -1 if the column could
+// not be found.
+func (g *Graph) ColumnIndex(obj Object) int {
+	for i, column := range g.columns[id.Table(obj.ID)] {
+		if column == obj.Column {
+			return i
+		}
+	}
+	return -1
+}
+
+// RequiredExtension returns the extension that the object is a member of. Returns false if the object does not belong
+// to an extension.
+func (g *Graph) RequiredExtension(obj Object) (Object, bool) {
+	for _, dep := range g.Dependencies {
+		if dep.Type == DependencyType_Extension && dep.Dependent.key() == obj.key() {
+			return dep.Referenced, true
+		}
+	}
+	return Object{}, false
+}
+
+// owned returns the object alongside every object that is dropped automatically whenever the object is dropped.
+func (g *Graph) owned(obj Object) []Object {
+	seen := map[objectKey]struct{}{obj.key(): {}}
+	result := []Object{obj}
+	for i := 0; i < len(result); i++ {
+		current := result[i]
+		for _, part := range g.parts[current.key()] {
+			if _, ok := seen[part.key()]; !ok {
+				seen[part.key()] = struct{}{}
+				result = append(result, part)
+			}
+		}
+		for _, dep := range g.referencedBy[current.key()] {
+			if dep.Type == DependencyType_Normal {
+				continue
+			}
+			if _, ok := seen[dep.Dependent.key()]; !ok {
+				seen[dep.Dependent.key()] = struct{}{}
+				result = append(result, dep.Dependent)
+			}
+		}
+	}
+	return result
+}
+
+// DropPlan contains the objects that must be dropped alongside a set of objects.
+type DropPlan struct {
+	// Order contains every object that must be dropped, ordered so that each object comes before the objects that it
+	// depends on. This includes the objects that the plan was created for. Objects that are dropped automatically
+	// alongside their owner are not included.
+	Order []Object
+	// Reasons contains the dependency that caused each additional object to be included in the plan, in the order in
+	// which they were found.
+	Reasons []Dependency
+	roots   map[objectKey]struct{}
+	leaders []Object
+}
+
+// IsRoot returns whether the plan was created for the given object, rather than the object being a dependent.
+func (plan DropPlan) IsRoot(obj Object) bool {
+	_, ok := plan.roots[obj.key()]
+	return ok
+}
+
+// Dependents returns the objects that are dropped only because they depend on the plan's objects, in drop order.
+func (plan DropPlan) Dependents() []Object {
+	var dependents []Object
+	for _, obj := range plan.Order {
+		if !plan.IsRoot(obj) {
+			dependents = append(dependents, obj)
+		}
+	}
+	return dependents
+}
+
+// PlanDrop returns the plan for dropping the given objects, which includes every object that depends on them.
+func (g *Graph) PlanDrop(objects ...Object) DropPlan {
+	plan := DropPlan{roots: make(map[objectKey]struct{})}
+	// Each dropped object leads a group, which contains the objects that are dropped automatically alongside it
+	leaders := make(map[objectKey]Object)
+	var leaderOrder []Object
+	addLeader := func(obj Object) bool {
+		if _, ok := leaders[obj.key()]; ok {
+			return false
+		}
+		leaderOrder = append(leaderOrder, obj)
+		for _, member := range g.owned(obj) {
+			if _, ok := leaders[member.key()]; !ok {
+				leaders[member.key()] = obj
+			}
+		}
+		return true
+	}
+	// Objects that are owned by another dropped object, such as a sequence owned by a dropped table's column, are
+	// dropped alongside their owner rather than on their own
+	roots := make([]Object, len(objects))
+	ownedBy := make(map[objectKey]objectKey)
+	for i, obj := range objects {
+		roots[i], _ = g.Object(obj)
+		plan.roots[roots[i].key()] = struct{}{}
+		for _, member := range g.owned(roots[i])[1:] {
+			ownedBy[member.key()] = roots[i].key()
+		}
+	}
+	for _, obj := range roots {
+		if _, ok := ownedBy[obj.key()]; ok {
+			continue
+		}
+		if addLeader(obj) {
+			plan.leaders = append(plan.leaders, obj)
+		}
+	}
+	// Every object with a normal dependency on a group member leads a group of its own
+	for i := 0; i < len(leaderOrder); i++ {
+		for _, member := range g.owned(leaderOrder[i]) {
+			if leaders[member.key()].key() != leaderOrder[i].key() {
+				continue
+			}
+			for _, dep := range g.referencedBy[member.key()] {
+				if dep.Type != DependencyType_Normal {
+					continue
+				}
+				if addLeader(dep.Dependent) {
+					plan.Reasons = append(plan.Reasons, dep)
+				}
+			}
+		}
+	}
+	// A group must be dropped before the groups that it depends on, so we order them depth-first
+	dependentsOf := make(map[objectKey][]Object)
+	for _, dep := range g.Dependencies {
+		if dep.Type != DependencyType_Normal {
+			continue
+		}
+		dependentLeader, ok := leaders[dep.Dependent.key()]
+		if !ok {
+			continue
+		}
+		referencedLeader, ok := leaders[dep.Referenced.key()]
+		if !ok || dependentLeader.key() == referencedLeader.key() {
+			continue
+		}
+		dependentsOf[referencedLeader.key()] = append(dependentsOf[referencedLeader.key()], dependentLeader)
+	}
+	visited := make(map[objectKey]struct{})
+	var visit func(obj Object)
+	visit = func(obj Object) {
+		if _, ok := visited[obj.key()]; ok {
+			return
+		}
+		visited[obj.key()] = struct{}{}
+		for _, dependent := range dependentsOf[obj.key()] {
+			visit(dependent)
+		}
+		plan.Order = append(plan.Order, obj)
+	}
+	for _, leader := range leaderOrder {
+		visit(leader)
+	}
+	return plan
+}
+
+// Describe returns the description of the object, which matches the descriptions that PostgreSQL uses in its
+// dependency messages.
+func (g *Graph) Describe(obj Object) string {
+	switch obj.Kind {
+	case ObjectKind_Table:
+		tableID := id.Table(obj.ID)
+		return "table " + g.qualifiedName(tableID.SchemaName(), tableID.TableName())
+	case ObjectKind_Column:
+		tableID := id.Table(obj.ID)
+		return fmt.Sprintf("column %s of table %s", obj.Column, g.qualifiedName(tableID.SchemaName(), tableID.TableName()))
+	case ObjectKind_ColumnDefault:
+		defaultID := id.ColumnDefault(obj.ID)
+		return fmt.Sprintf("default value for column %s of table %s", defaultID.ColumnName(),
+			g.qualifiedName(defaultID.SchemaName(), defaultID.TableName()))
+	case ObjectKind_View:
+		viewID := id.View(obj.ID)
+		return "view " + g.qualifiedName(viewID.SchemaName(), viewID.ViewName())
+	case ObjectKind_MaterializedView:
+		tableID := id.Table(obj.ID)
+		return "materialized view " + g.qualifiedName(tableID.SchemaName(), tableID.TableName())
+	case ObjectKind_Function:
+		funcID := id.Function(obj.ID)
+		return fmt.Sprintf("function %s(%s)", g.qualifiedName(funcID.SchemaName(), funcID.FunctionName()),
+			g.describeParameters(funcID.Parameters()))
+	case ObjectKind_Procedure:
+		// Postgres describes procedures as functions in its dependency messages
+		procID := id.Procedure(obj.ID)
+		return fmt.Sprintf("function %s(%s)", g.qualifiedName(procID.SchemaName(), procID.ProcedureName()),
+			g.describeParameters(procID.Parameters()))
+	case ObjectKind_Type, ObjectKind_Domain:
+		typeID := id.Type(obj.ID)
+		return "type " + g.qualifiedName(typeID.SchemaName(), typeID.TypeName())
+	case ObjectKind_Sequence:
+		seqID := id.Sequence(obj.ID)
+		return "sequence " + g.qualifiedName(seqID.SchemaName(), seqID.SequenceName())
+	case ObjectKind_Trigger:
+		trigID := id.Trigger(obj.ID)
+		return fmt.Sprintf("trigger %s on table %s", trigID.TriggerName(),
+			g.qualifiedName(trigID.SchemaName(), trigID.TableName()))
+	case ObjectKind_Extension:
+		return "extension " + id.Extension(obj.ID).Name()
+	case ObjectKind_Policy:
+		policyID := id.Policy(obj.ID)
+		return fmt.Sprintf("policy %s on table %s", policyID.PolicyName(),
+			g.qualifiedName(policyID.SchemaName(), policyID.TableName()))
+	default:
+		return string(obj.ID)
+	}
+}
+
+// describeParameters returns the parameter types of a function or procedure for use in a description.
+func (g *Graph) describeParameters(params []id.Type) string {
+	strTypes := make([]string, len(params))
+	for i, param := range params {
+		if builtIn, ok := pgtypes.IDToBuiltInDoltgresType[param]; ok {
+			strTypes[i] = builtIn.String()
+		} else {
+			strTypes[i] = g.qualifiedName(param.SchemaName(), param.TypeName())
+		}
+	}
+	return strings.Join(strTypes, ", ")
+}
+
+// qualifiedName returns the name, qualified by its schema when the schema is not on the search path.
+func (g *Graph) qualifiedName(schema string, name string) string {
+	for _, pathSchema := range g.searchPath {
+		if pathSchema == schema {
+			return name
+		}
+	}
+	return schema + "." + name
+}
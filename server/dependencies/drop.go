@@ -0,0 +1,209 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dependencies
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/jackc/pgx/v5/pgproto3"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/id"
+	"github.com/dolthub/doltgresql/utils"
+)
+
+// PrepareDrop is called before the given objects are dropped. Without cascade, this returns an error if any other
+// object depends on the given objects. With cascade, every object that depends on the given objects is dropped,
+// leaving only the given objects (and the objects that are dropped automatically alongside them) for the caller to
+// drop. Objects that do not exist are ignored.
+func PrepareDrop(ctx *sql.Context, cascade bool, objects ...Object) error {
+	g, err := Load(ctx)
+	if err != nil {
+		return err
+	}
+	var roots []Object
+	for _, obj := range objects {
+		known, ok := g.Object(obj)
+		if !ok {
+			continue
+		}
+		if ext, ok := g.RequiredExtension(known); ok {
+			return errors.Errorf("cannot drop %s because %s requires it", g.Describe(known), g.Describe(ext))
+		}
+		roots = append(roots, known)
+	}
+	if len(roots) == 0 {
+		return nil
+	}
+	plan := g.PlanDrop(roots...)
+	if len(plan.Reasons) == 0 {
+		return nil
+	}
+	if !cascade {
+		return g.restrictError(roots, plan)
+	}
+	dependents := make([]Object, len(plan.Reasons))
+	for i, reason := range plan.Reasons {
+		dependents[i] = reason.Dependent
+	}
+	g.cascadeNotice(ctx, dependents)
+	return dropObjects(ctx, plan.Dependents())
+}
+
+// DropSchemaObjects drops every object within the given schema, alongside every object in other schemas that
+// depends on them.
+func DropSchemaObjects(ctx *sql.Context, schema string) error {
+	g, err := Load(ctx)
+	if err != nil {
+		return err
+	}
+	objects := g.SchemaObjects(schema)
+	if len(objects) == 0 {
+		return nil
+	}
+	plan := g.PlanDrop(objects...)
+	dropped := append([]Object{}, plan.leaders...)
+	for _, reason := range plan.Reasons {
+		dropped = append(dropped, reason.Dependent)
+	}
+	g.cascadeNotice(ctx, dropped)
+	return dropObjects(ctx, plan.Order)
+}
+
+// restrictError returns the error for dropping the given objects without CASCADE when the plan has dependents.
+func (g *Graph) restrictError(roots []Object, plan DropPlan) error {
+	sb := strings.Builder{}
+	if len(roots) == 1 {
+		sb.WriteString(fmt.Sprintf("cannot drop %s because other objects depend on it", g.Describe(roots[0])))
+	} else {
+		sb.WriteString("cannot drop desired object(s) because other objects depend on them")
+	}
+	// TODO: portion after newline should be in DETAILS but we don't yet support that in our error messages
+	for _, reason := range plan.Reasons {
+		sb.WriteString(fmt.Sprintf("\n%s depends on %s", g.Describe(reason.Dependent), g.Describe(reason.Referenced)))
+	}
+	return errors.New(sb.String())
+}
+
+// cascadeNotice sends the notice that lists the objects being dropped by CASCADE.
+func (g *Graph) cascadeNotice(ctx *sql.Context, objects []Object) {
+	notice := &pgproto3.NoticeResponse{Severity: "NOTICE"}
+	if len(objects) == 1 {
+		notice.Message = "drop cascades to " + g.Describe(objects[0])
+	} else {
+		details := make([]string, len(objects))
+		for i, obj := range objects {
+			details[i] = "drop cascades to " + g.Describe(obj)
+		}
+		notice.Message = fmt.Sprintf("drop cascades to %d other objects", len(objects))
+		notice.Detail = strings.Join(details, "\n")
+	}
+	dsess.DSessFromSess(ctx.Session).Notice(notice)
+}
+
+// dropObjects drops each object in the given order. Each object is dropped by its own statement, so that it goes
+// through the same validation as though it had been dropped directly.
+func dropObjects(ctx *sql.Context, objects []Object) error {
+	runner, err := core.GetRunnerFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	for _, obj := range objects {
+		statement, err := dropStatement(obj)
+		if err != nil {
+			return err
+		}
+		// We run the statement as though it's interpreted since we're running new statements inside the original
+		_, err = sql.RunInterpreted(ctx, func(subCtx *sql.Context) ([]sql.Row, error) {
+			_, rowIter, _, err := runner.QueryWithBindings(subCtx, statement, nil, nil, nil)
+			if err != nil {
+				return nil, err
+			}
+			return sql.RowIterToRows(subCtx, rowIter)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// dropStatement returns the statement that drops the given object.
+func dropStatement(obj Object) (string, error) {
+	switch obj.Kind {
+	case ObjectKind_Table:
+		tableID := id.Table(obj.ID)
+		return "DROP TABLE " + utils.QuoteQualifiedIdentifier(tableID.SchemaName(), tableID.TableName()) + ";", nil
+	case ObjectKind_Column:
+		tableID := id.Table(obj.ID)
+		return fmt.Sprintf("ALTER TABLE %s DROP COLUMN %s;",
+			utils.QuoteQualifiedIdentifier(tableID.SchemaName(), tableID.TableName()), utils.QuoteIdentifier(obj.Column)), nil
+	case ObjectKind_ColumnDefault:
+		defaultID := id.ColumnDefault(obj.ID)
+		return fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s DROP DEFAULT;",
+			utils.QuoteQualifiedIdentifier(defaultID.SchemaName(), defaultID.TableName()),
+			utils.QuoteIdentifier(defaultID.ColumnName())), nil
+	case ObjectKind_View:
+		viewID := id.View(obj.ID)
+		return "DROP VIEW " + utils.QuoteQualifiedIdentifier(viewID.SchemaName(), viewID.ViewName()) + ";", nil
+	case ObjectKind_MaterializedView:
+		tableID := id.Table(obj.ID)
+		return "DROP MATERIALIZED VIEW " + utils.QuoteQualifiedIdentifier(tableID.SchemaName(), tableID.TableName()) + ";", nil
+	case ObjectKind_Function:
+		funcID := id.Function(obj.ID)
+		return fmt.Sprintf("DROP FUNCTION %s(%s);",
+			utils.QuoteQualifiedIdentifier(funcID.SchemaName(), funcID.FunctionName()), parameterTypes(funcID.Parameters())), nil
+	case ObjectKind_Procedure:
+		procID := id.Procedure(obj.ID)
+		return fmt.Sprintf("DROP PROCEDURE %s(%s);",
+			utils.QuoteQualifiedIdentifier(procID.SchemaName(), procID.ProcedureName()), parameterTypes(procID.Parameters())), nil
+	case ObjectKind_Type:
+		typeID := id.Type(obj.ID)
+		return "DROP TYPE " + utils.QuoteQualifiedIdentifier(typeID.SchemaName(), typeID.TypeName()) + ";", nil
+	case ObjectKind_Domain:
+		typeID := id.Type(obj.ID)
+		return "DROP DOMAIN " + utils.QuoteQualifiedIdentifier(typeID.SchemaName(), typeID.TypeName()) + ";", nil
+	case ObjectKind_Sequence:
+		seqID := id.Sequence(obj.ID)
+		return "DROP SEQUENCE " + utils.QuoteQualifiedIdentifier(seqID.SchemaName(), seqID.SequenceName()) + ";", nil
+	case ObjectKind_Trigger:
+		trigID := id.Trigger(obj.ID)
+		return fmt.Sprintf("DROP TRIGGER %s ON %s;", utils.QuoteIdentifier(trigID.TriggerName()),
+			utils.QuoteQualifiedIdentifier(trigID.SchemaName(), trigID.TableName())), nil
+	case ObjectKind_Policy:
+		policyID := id.Policy(obj.ID)
+		return fmt.Sprintf("DROP POLICY %s ON %s;", utils.QuoteIdentifier(policyID.PolicyName()),
+			utils.QuoteQualifiedIdentifier(policyID.SchemaName(), policyID.TableName())), nil
+	default:
+		return "", errors.Errorf("dropping %s is not yet supported", string(obj.ID))
+	}
+}
+
+// parameterTypes returns the parameter types of a function or procedure signature.
+func parameterTypes(params []id.Type) string {
+	strTypes := make([]string, len(params))
+	for i, param := range params {
+		if param.SchemaName() == "pg_catalog" {
+			strTypes[i] = param.TypeName()
+		} else {
+			strTypes[i] = utils.QuoteQualifiedIdentifier(param.SchemaName(), param.TypeName())
+		}
+	}
+	return strings.Join(strTypes, ", ")
+}
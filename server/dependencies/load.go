@@ -0,0 +1,349 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dependencies
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/id"
+	"github.com/dolthub/doltgresql/core/policies"
+	"github.com/dolthub/doltgresql/core/triggers"
+	"github.com/dolthub/doltgresql/server/functions"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// nextValRegex matches the sequence named by a call to nextval within a column default.
+var nextValRegex = regexp.MustCompile(`(?i)nextval\('((?:[^']|'')+)'`)
+
+// graphLoader holds the objects of the database while the graph is being built, since dependencies are resolved only
+// after every object has been found.
+type graphLoader struct {
+	g              *Graph
+	relations      map[id.Table]Object
+	tables         []functions.ItemTable
+	types          map[id.Type]*pgtypes.DoltgresType
+	domains        []*pgtypes.DoltgresType
+	sequences      map[id.Sequence]Object
+	columnDefaults []functions.ItemColumnDefault
+	views          []loadedQuery
+	functions      []*functions.ItemFunction
+	procedures     []*functions.ItemProcedure
+}
+
+// loadedQuery is a view or materialized view alongside its defining query.
+type loadedQuery struct {
+	obj   Object
+	query string
+}
+
+// Load returns the dependency graph of the current database.
+func Load(ctx *sql.Context) (*Graph, error) {
+	searchPath, err := core.SearchPath(ctx)
+	if err != nil {
+		return nil, err
+	}
+	loader := &graphLoader{
+		g:         newGraph(searchPath),
+		relations: make(map[id.Table]Object),
+		types:     make(map[id.Type]*pgtypes.DoltgresType),
+		sequences: make(map[id.Sequence]Object),
+	}
+	if err = loader.loadObjects(ctx); err != nil {
+		return nil, err
+	}
+	if err = loader.loadDependencies(ctx); err != nil {
+		return nil, err
+	}
+	return loader.g, nil
+}
+
+// loadObjects adds every object of the current database to the graph.
+func (loader *graphLoader) loadObjects(ctx *sql.Context) error {
+	g := loader.g
+	matviewColl, err := core.GetMaterializedViewsCollectionFromContext(ctx, "")
+	if err != nil {
+		return err
+	}
+	return functions.IterateCurrentDatabase(ctx, functions.Callbacks{
+		Table: func(ctx *sql.Context, schema functions.ItemSchema, table functions.ItemTable) (cont bool, err error) {
+			if schema.IsSystemSchema() {
+				return true, nil
+			}
+			obj := NewObject(ObjectKind_Table, table.OID.AsId())
+			viewID := id.NewView(table.OID.SchemaName(), table.OID.TableName())
+			if matviewColl.HasMaterializedView(ctx, viewID) {
+				obj.Kind = ObjectKind_MaterializedView
+				mv, err := matviewColl.GetMaterializedView(ctx, viewID)
+				if err != nil {
+					return false, err
+				}
+				loader.views = append(loader.views, loadedQuery{obj: obj, query: mv.Definition})
+			}
+			g.addObject(obj, schema.Item.SchemaName())
+			// Tables also define a type matching their rows, which is dropped alongside the table
+			g.addPart(obj, NewObject(ObjectKind_Type, id.NewType(table.OID.SchemaName(), table.OID.TableName()).AsId()))
+			for _, col := range table.Item.Schema(ctx) {
+				g.addPart(obj, NewColumnObject(table.OID, col.Name))
+				g.columns[table.OID] = append(g.columns[table.OID], col.Name)
+			}
+			loader.relations[table.OID] = obj
+			loader.tables = append(loader.tables, table)
+			return true, nil
+		},
+		View: func(ctx *sql.Context, schema functions.ItemSchema, view functions.ItemView) (cont bool, err error) {
+			if schema.IsSystemSchema() {
+				return true, nil
+			}
+			obj := NewObject(ObjectKind_View, view.OID.AsId())
+			g.addObject(obj, schema.Item.SchemaName())
+			query := view.Item.TextDefinition
+			if len(query) == 0 {
+				query = view.Item.CreateViewStatement
+			}
+			loader.views = append(loader.views, loadedQuery{obj: obj, query: query})
+			return true, nil
+		},
+		Sequence: func(ctx *sql.Context, schema functions.ItemSchema, sequence functions.ItemSequence) (cont bool, err error) {
+			obj := NewObject(ObjectKind_Sequence, sequence.OID.AsId())
+			g.addObject(obj, schema.Item.SchemaName())
+			loader.sequences[sequence.OID] = obj
+			// Sequences owned by a column (SERIAL columns, OWNED BY clauses) are dropped alongside that column
+			if sequence.Item.OwnerTable.IsValid() {
+				g.addDependency(obj, NewColumnObject(sequence.Item.OwnerTable, sequence.Item.OwnerColumn), DependencyType_Auto)
+			}
+			return true, nil
+		},
+		ColumnDefault: func(ctx *sql.Context, schema functions.ItemSchema, table functions.ItemTable, colDefault functions.ItemColumnDefault) (cont bool, err error) {
+			if schema.IsSystemSchema() {
+				return true, nil
+			}
+			loader.columnDefaults = append(loader.columnDefaults, colDefault)
+			return true, nil
+		},
+		Function: func(ctx *sql.Context, schema functions.ItemSchema, function functions.ItemFunction) (cont bool, err error) {
+			if schema.IsSystemSchema() {
+				return true, nil
+			}
+			g.addObject(NewObject(ObjectKind_Function, function.OID.AsId()), schema.Item.SchemaName())
+			loader.functions = append(loader.functions, &function)
+			return true, nil
+		},
+		Procedure: func(ctx *sql.Context, schema functions.ItemSchema, procedure functions.ItemProcedure) (cont bool, err error) {
+			if schema.IsSystemSchema() {
+				return true, nil
+			}
+			g.addObject(NewObject(ObjectKind_Procedure, procedure.OID.AsId()), schema.Item.SchemaName())
+			loader.procedures = append(loader.procedures, &procedure)
+			return true, nil
+		},
+		Type: func(ctx *sql.Context, schema functions.ItemSchema, typ functions.ItemType) (cont bool, err error) {
+			if schema.IsSystemSchema() {
+				return true, nil
+			}
+			loader.types[typ.Oid] = typ.Item
+			// Array types are dropped alongside their element type, so only the element type is an object
+			if typ.Item.IsArrayType() {
+				return true, nil
+			}
+			kind := ObjectKind_Type
+			if typ.Item.TypType == pgtypes.TypeType_Domain {
+				kind = ObjectKind_Domain
+				loader.domains = append(loader.domains, typ.Item)
+			}
+			g.addObject(NewObject(kind, typ.Oid.AsId()), schema.Item.SchemaName())
+			return true, nil
+		},
+	})
+}
+
+// loadDependencies adds the dependencies between the objects that were loaded.
+func (loader *graphLoader) loadDependencies(ctx *sql.Context) error {
+	g := loader.g
+	// Columns depend on their types
+	for _, table := range loader.tables {
+		for _, col := range table.Item.Schema(ctx) {
+			if typ, ok := col.Type.(*pgtypes.DoltgresType); ok {
+				if typeObj, ok := loader.typeObject(typ.ID); ok {
+					g.addDependency(NewColumnObject(table.OID, col.Name), typeObj, DependencyType_Normal)
+				}
+			}
+		}
+	}
+	// Column defaults are dropped alongside their column, and depend on the sequences that they call nextval on
+	for _, colDefault := range loader.columnDefaults {
+		defaultObj := NewObject(ObjectKind_ColumnDefault, colDefault.OID.AsId())
+		g.objects[defaultObj.key()] = defaultObj
+		tableID := id.NewTable(colDefault.OID.SchemaName(), colDefault.OID.TableName())
+		g.addDependency(defaultObj, NewColumnObject(tableID, colDefault.OID.ColumnName()), DependencyType_Auto)
+		if colDefault.Item.Column.Default == nil {
+			continue
+		}
+		for _, match := range nextValRegex.FindAllStringSubmatch(colDefault.Item.Column.Default.String(), -1) {
+			if seqObj, ok := loader.sequenceObject(ctx, strings.ReplaceAll(match[1], "''", "'")); ok {
+				g.addDependency(defaultObj, seqObj, DependencyType_Normal)
+			}
+		}
+	}
+	// Views and materialized views depend on the relations and columns that their queries reference
+	for _, view := range loader.views {
+		refs := collectQueryReferences(view.query)
+		for _, relation := range refs.relations {
+			var schema string
+			if relation.ExplicitSchema {
+				schema = string(relation.SchemaName)
+			}
+			relationObj, ok := loader.relationObject(schema, relation.Table())
+			if !ok {
+				continue
+			}
+			g.addDependency(view.obj, relationObj, DependencyType_Normal)
+			if relationObj.Kind == ObjectKind_View {
+				continue
+			}
+			tableID := id.Table(relationObj.ID)
+			for _, column := range g.columns[tableID] {
+				if refs.usesColumn(column) {
+					g.addDependency(view.obj, NewColumnObject(tableID, column), DependencyType_Normal)
+				}
+			}
+		}
+	}
+	// Functions and procedures depend on their parameter and return types, and functions may belong to an extension
+	for _, function := range loader.functions {
+		funcObj := NewObject(ObjectKind_Function, function.OID.AsId())
+		for _, param := range function.Item.AllParams {
+			if typeObj, ok := loader.typeObject(param.Type); ok {
+				g.addDependency(funcObj, typeObj, DependencyType_Normal)
+			}
+		}
+		if typeObj, ok := loader.typeObject(function.Item.ReturnType); ok {
+			g.addDependency(funcObj, typeObj, DependencyType_Normal)
+		}
+		if len(function.Item.ExtensionName) > 0 {
+			extObj := NewObject(ObjectKind_Extension, id.NewExtension(function.Item.ExtensionName).AsId())
+			g.objects[extObj.key()] = extObj
+			g.addDependency(funcObj, extObj, DependencyType_Extension)
+		}
+	}
+	for _, procedure := range loader.procedures {
+		procObj := NewObject(ObjectKind_Procedure, procedure.OID.AsId())
+		for _, param := range procedure.Item.AllParams {
+			if typeObj, ok := loader.typeObject(param.Type); ok {
+				g.addDependency(procObj, typeObj, DependencyType_Normal)
+			}
+		}
+	}
+	// Domains depend on their base type
+	for _, domain := range loader.domains {
+		if domain.BaseTypeType == nil {
+			continue
+		}
+		if baseObj, ok := loader.typeObject(domain.BaseTypeType.ID); ok {
+			g.addDependency(NewObject(ObjectKind_Domain, domain.ID.AsId()), baseObj, DependencyType_Normal)
+		}
+	}
+	// Triggers are dropped alongside their table, and depend on the function that they execute
+	trigColl, err := core.GetTriggersCollectionFromContext(ctx, "")
+	if err != nil {
+		return err
+	}
+	err = trigColl.IterateTriggers(ctx, func(trig triggers.Trigger) (stop bool, err error) {
+		tableObj, ok := loader.relations[id.NewTable(trig.ID.SchemaName(), trig.ID.TableName())]
+		if !ok {
+			return false, nil
+		}
+		trigObj := NewObject(ObjectKind_Trigger, trig.ID.AsId())
+		g.addObject(trigObj, "")
+		g.addDependency(trigObj, tableObj, DependencyType_Auto)
+		if _, ok = g.objects[objectKey{id: trig.Function.AsId()}]; ok {
+			g.addDependency(trigObj, NewObject(ObjectKind_Function, trig.Function.AsId()), DependencyType_Normal)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+	// Policies are dropped alongside their table
+	policyColl, err := core.GetPoliciesCollectionFromContext(ctx, "")
+	if err != nil {
+		return err
+	}
+	return policyColl.IteratePolicies(ctx, func(policy policies.Policy) (stop bool, err error) {
+		tableObj, ok := loader.relations[policy.TableID()]
+		if !ok {
+			return false, nil
+		}
+		policyObj := NewObject(ObjectKind_Policy, policy.ID.AsId())
+		g.addObject(policyObj, "")
+		g.addDependency(policyObj, tableObj, DependencyType_Auto)
+		return false, nil
+	})
+}
+
+// typeObject returns the object for the given type. Array types resolve to their element type, and table row types
+// resolve to the type owned by the table. Returns false for built-in types, which cannot be dropped.
+func (loader *graphLoader) typeObject(typeID id.Type) (Object, bool) {
+	if _, ok := pgtypes.IDToBuiltInDoltgresType[typeID]; ok {
+		return Object{}, false
+	}
+	if typ, ok := loader.types[typeID]; ok && typ.IsArrayType() {
+		typeID = typ.Elem.ID
+	}
+	if obj, ok := loader.g.objects[objectKey{id: typeID.AsId()}]; ok {
+		return obj, true
+	}
+	return Object{}, false
+}
+
+// relationObject returns the table, view, or materialized view with the given name. Unqualified names are resolved
+// using the search path.
+func (loader *graphLoader) relationObject(schema string, name string) (Object, bool) {
+	schemas := loader.g.searchPath
+	if len(schema) > 0 {
+		schemas = []string{schema}
+	}
+	for _, schemaName := range schemas {
+		if obj, ok := loader.relations[id.NewTable(schemaName, name)]; ok {
+			return obj, true
+		}
+		if obj, ok := loader.g.objects[objectKey{id: id.NewView(schemaName, name).AsId()}]; ok {
+			return obj, true
+		}
+	}
+	return Object{}, false
+}
+
+// sequenceObject returns the sequence named by the given relation name. Unqualified names are resolved using the
+// search path.
+func (loader *graphLoader) sequenceObject(ctx *sql.Context, relationName string) (Object, bool) {
+	schema, name, err := functions.ParseRelationName(ctx, relationName)
+	if err != nil {
+		return Object{}, false
+	}
+	schemas := loader.g.searchPath
+	if len(schema) > 0 {
+		schemas = []string{schema}
+	}
+	for _, schemaName := range schemas {
+		if obj, ok := loader.sequences[id.NewSequence(schemaName, name)]; ok {
+			return obj, true
+		}
+	}
+	return Object{}, false
+}
@@ -0,0 +1,174 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dependencies
+
+import (
+	"github.com/dolthub/doltgresql/postgres/parser/parser"
+	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+)
+
+// queryReferences holds the relations and columns that a query refers to.
+type queryReferences struct {
+	relations  []tree.TableName
+	columns    map[string]struct{}
+	allColumns bool
+	ctes       map[string]struct{}
+}
+
+var _ tree.Visitor = (*queryReferences)(nil)
+
+// collectQueryReferences parses the given query and returns everything that it refers to. Queries that cannot be
+// parsed are treated as though they refer to nothing.
+func collectQueryReferences(query string) *queryReferences {
+	refs := &queryReferences{
+		columns: make(map[string]struct{}),
+		ctes:    make(map[string]struct{}),
+	}
+	stmt, err := parser.ParseOne(query)
+	if err != nil {
+		return refs
+	}
+	switch ast := stmt.AST.(type) {
+	case *tree.CreateView:
+		refs.selectStatement(ast.AsSource)
+	case tree.SelectStatement:
+		refs.selectStatement(ast)
+	}
+	return refs
+}
+
+// usesColumn returns whether the given column name is referenced, either directly or through a star.
+func (refs *queryReferences) usesColumn(column string) bool {
+	if refs.allColumns {
+		return true
+	}
+	_, ok := refs.columns[column]
+	return ok
+}
+
+// selectStatement collects the references of the given statement.
+func (refs *queryReferences) selectStatement(stmt tree.SelectStatement) {
+	switch stmt := stmt.(type) {
+	case *tree.Select:
+		if stmt == nil {
+			return
+		}
+		if stmt.With != nil {
+			for _, cte := range stmt.With.CTEList {
+				refs.ctes[string(cte.Name.Alias)] = struct{}{}
+				if cteSelect, ok := cte.Stmt.(tree.SelectStatement); ok {
+					refs.selectStatement(cteSelect)
+				}
+			}
+		}
+		refs.selectStatement(stmt.Select)
+		for _, order := range stmt.OrderBy {
+			refs.expr(order.Expr)
+		}
+	case *tree.ParenSelect:
+		refs.selectStatement(stmt.Select)
+	case *tree.SelectClause:
+		for _, selectExpr := range stmt.Exprs {
+			refs.expr(selectExpr.Expr)
+		}
+		for _, tableExpr := range stmt.From.Tables {
+			refs.tableExpr(tableExpr)
+		}
+		if stmt.Where != nil {
+			refs.expr(stmt.Where.Expr)
+		}
+		for _, groupBy := range stmt.GroupBy {
+			refs.expr(groupBy)
+		}
+		if stmt.Having != nil {
+			refs.expr(stmt.Having.Expr)
+		}
+	case *tree.UnionClause:
+		refs.selectStatement(stmt.Left)
+		refs.selectStatement(stmt.Right)
+	case *tree.ValuesClause:
+		for _, row := range stmt.Rows {
+			for _, expr := range row {
+				refs.expr(expr)
+			}
+		}
+	}
+}
+
+// tableExpr collects the references of the given table expression.
+func (refs *queryReferences) tableExpr(tableExpr tree.TableExpr) {
+	switch tableExpr := tableExpr.(type) {
+	case *tree.AliasedTableExpr:
+		refs.tableExpr(tableExpr.Expr)
+	case *tree.ParenTableExpr:
+		refs.tableExpr(tableExpr.Expr)
+	case *tree.JoinTableExpr:
+		refs.tableExpr(tableExpr.Left)
+		refs.tableExpr(tableExpr.Right)
+		switch cond := tableExpr.Cond.(type) {
+		case *tree.OnJoinCond:
+			refs.expr(cond.Expr)
+		case *tree.UsingJoinCond:
+			for _, col := range cond.Cols {
+				refs.columns[string(col)] = struct{}{}
+			}
+		case tree.NaturalJoinCond:
+			refs.allColumns = true
+		}
+	case *tree.TableName:
+		if _, ok := refs.ctes[tableExpr.Table()]; ok && !tableExpr.ExplicitSchema {
+			return
+		}
+		refs.relations = append(refs.relations, *tableExpr)
+	case *tree.Subquery:
+		refs.selectStatement(tableExpr.Select)
+	case *tree.RowsFromExpr:
+		for _, item := range tableExpr.Items {
+			refs.expr(item)
+		}
+	}
+}
+
+// expr collects the references of the given expression.
+func (refs *queryReferences) expr(expr tree.Expr) {
+	if expr != nil {
+		tree.WalkExprConst(refs, expr)
+	}
+}
+
+// VisitPre implements the interface tree.Visitor.
+func (refs *queryReferences) VisitPre(expr tree.Expr) (recurse bool, newExpr tree.Expr) {
+	switch expr := expr.(type) {
+	case *tree.Subquery:
+		refs.selectStatement(expr.Select)
+		return false, expr
+	case *tree.UnresolvedName:
+		if expr.Star {
+			refs.allColumns = true
+		} else {
+			refs.columns[expr.Parts[0]] = struct{}{}
+		}
+		return false, expr
+	case tree.UnqualifiedStar, *tree.AllColumnsSelector:
+		refs.allColumns = true
+		return false, expr
+	}
+	return true, expr
+}
+
+// VisitPost implements the interface tree.Visitor.
+func (refs *queryReferences) VisitPost(expr tree.Expr) tree.Expr {
+	return expr
+}
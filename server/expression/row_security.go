@@ -0,0 +1,90 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// RowSecurity is the combined expression of every row-level security policy that applies to a table. When used as a
+// USING expression, rows that do not satisfy the policies are filtered away. When used as a WITH CHECK expression, new
+// rows that do not satisfy the policies return an error.
+type RowSecurity struct {
+	expression.UnaryExpressionStub
+	Table   string
+	IsCheck bool
+}
+
+var _ sql.Expression = (*RowSecurity)(nil)
+var _ sql.CollationCoercible = (*RowSecurity)(nil)
+
+// NewRowSecurityUsing returns a new RowSecurity that filters the rows of the given table.
+func NewRowSecurityUsing(child sql.Expression, table string) *RowSecurity {
+	return &RowSecurity{UnaryExpressionStub: expression.UnaryExpressionStub{Child: child}, Table: table, IsCheck: false}
+}
+
+// NewRowSecurityCheck returns a new RowSecurity that verifies the new rows of the given table.
+func NewRowSecurityCheck(child sql.Expression, table string) *RowSecurity {
+	return &RowSecurity{UnaryExpressionStub: expression.UnaryExpressionStub{Child: child}, Table: table, IsCheck: true}
+}
+
+// Type implements the Expression interface.
+func (e *RowSecurity) Type(ctx *sql.Context) sql.Type {
+	return pgtypes.Bool
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*RowSecurity) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 5
+}
+
+// IsNullable implements the Expression interface.
+func (e *RowSecurity) IsNullable(ctx *sql.Context) bool {
+	return false
+}
+
+// Eval implements the Expression interface.
+func (e *RowSecurity) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	v, err := e.Child.Eval(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	// A NULL result is treated the same as false
+	passed, _ := v.(bool)
+	if !passed && e.IsCheck {
+		return nil, errors.Errorf(`new row violates row-level security policy for table "%s"`, e.Table)
+	}
+	return passed, nil
+}
+
+func (e *RowSecurity) String() string {
+	return e.Child.String()
+}
+
+func (e *RowSecurity) DebugString(ctx *sql.Context) string {
+	return sql.DebugString(ctx, e.Child)
+}
+
+// WithChildren implements the Expression interface.
+func (e *RowSecurity) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(e, len(children), 1)
+	}
+	return &RowSecurity{UnaryExpressionStub: expression.UnaryExpressionStub{Child: children[0]}, Table: e.Table, IsCheck: e.IsCheck}, nil
+}
@@ -17,6 +17,7 @@ package functions
 import (
 	"time"
 
+	"github.com/cockroachdb/errors"
 	"github.com/dolthub/go-mysql-server/sql"
 
 	"github.com/dolthub/doltgresql/postgres/parser/duration"
@@ -33,6 +34,8 @@ func initAge() {
 	framework.RegisterFunction(age_timestamptz)
 }
 
+var errTimestampOutOfRange = errors.Errorf(`timestamp out of range`)
+
 // age_xid represents the PostgreSQL function of the same name, taking the same parameters.
 var age_xid = framework.Function1{
 	Name:       "age",
@@ -55,6 +58,9 @@ var age_timestamp_timestamp = framework.Function2{
 	Callable: func(ctx *sql.Context, _ [3]*pgtypes.DoltgresType, val1, val2 any) (any, error) {
 		t1 := val1.(time.Time)
 		t2 := val2.(time.Time)
+		if isInfiniteTime(t1) || isInfiniteTime(t2) {
+			return nil, errTimestampOutOfRange
+		}
 		return diffTimes(t1, t2), nil
 	},
 }
@@ -69,7 +75,15 @@ var age_timestamptz_timestamptz = framework.Function2{
 	Callable: func(ctx *sql.Context, _ [3]*pgtypes.DoltgresType, val1, val2 any) (any, error) {
 		t1 := val1.(time.Time)
 		t2 := val2.(time.Time)
-		return diffTimes(t1, t2), nil
+		if isInfiniteTime(t1) || isInfiniteTime(t2) {
+			return nil, errTimestampOutOfRange
+		}
+		// The fields are compared as they're seen in the session's time zone
+		loc, err := GetServerLocation(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return diffTimes(t1.In(loc), t2.In(loc)), nil
 	},
 }
 
@@ -82,6 +96,9 @@ var age_timestamp = framework.Function1{
 	Strict:             true,
 	Callable: func(ctx *sql.Context, _ [2]*pgtypes.DoltgresType, val any) (any, error) {
 		t := val.(time.Time)
+		if isInfiniteTime(t) {
+			return nil, errTimestampOutOfRange
+		}
 		// current_date (at midnight)
 		cur, err := time.Parse("2006-01-02", time.Now().Format("2006-01-02"))
 		if err != nil {
@@ -100,6 +117,9 @@ var age_timestamptz = framework.Function1{
 	Strict:             true,
 	Callable: func(ctx *sql.Context, _ [2]*pgtypes.DoltgresType, val any) (any, error) {
 		t := val.(time.Time)
+		if isInfiniteTime(t) {
+			return nil, errTimestampOutOfRange
+		}
 		// current_date (at midnight)
 		cur, err := time.Parse("2006-01-02", time.Now().Format("2006-01-02"))
 		if err != nil {
@@ -144,9 +164,9 @@ func diffTimes(t1, t2 time.Time) duration.Duration {
 		hours += 24
 		days--
 	}
-	if days < 0 {
-		// TODO use days of the month of given date
-		days += 30
+	// Like PostgreSQL, borrowed months use the length of the month of the earlier time
+	for days < 0 {
+		days += int64(daysInMonth(t2.Year(), t2.Month()))
 		months--
 	}
 	if months < 0 {
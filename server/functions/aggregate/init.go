@@ -19,4 +19,5 @@ func Init() {
 	initNumericAggs()
 	initAvgAggs()
 	initVarianceAggs()
+	initRegressionAggs()
 }
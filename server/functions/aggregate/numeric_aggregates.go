@@ -34,11 +34,10 @@ func decimalIdentity(v *apd.Decimal) *apd.Decimal { return v }
 
 // initNumericAggs registers the functions to the catalog.
 //
-// Note that overload resolution for aggregates does not insert implicit widening casts the way it does for
-// scalar functions (CompiledAggregateFunction hands NewBuffer/NewWindowFunc the raw, uncast Arguments) - so
-// every distinct argument type sum/avg can be called on needs its own overload registered below; there's no
-// way to cover e.g. both int2 and int4 with a single numeric-ish overload. This mirrors how other multi-type
-// functions in this package (e.g. abs.go) already register one overload per Postgres type.
+// Note that sum/avg's result type depends on the argument type (e.g. sum(int4) promotes to bigint, while
+// sum(float4) stays real), so every distinct argument type they can be called on needs its own overload
+// registered below, even though CompiledAggregateFunction applies the implicit casts chosen by overload
+// resolution. This mirrors how other multi-type functions (e.g. abs.go) register one overload per Postgres type.
 func initNumericAggs() {
 	framework.RegisterAggregateFunction(sumOverload("sum", pgtypes.Int16, pgtypes.Int64, newIntSumBuffer[int16], newIntSumWindowFunction[int16]))
 	framework.RegisterAggregateFunction(sumOverload("sum", pgtypes.Int32, pgtypes.Int64, newIntSumBuffer[int32], newIntSumWindowFunction[int32]))
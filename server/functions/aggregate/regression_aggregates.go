@@ -0,0 +1,303 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggregate
+
+import (
+	"math"
+
+	"github.com/cockroachdb/errors"
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initRegressionAggs registers the two-argument statistical aggregates (corr, covar_pop, covar_samp, and the regr_*
+// family) to the catalog. Postgres only defines these over (double precision, double precision), and every other
+// numeric argument type reaches them through an implicit cast, so each function has a single overload.
+func initRegressionAggs() {
+	for _, o := range []struct {
+		name       string
+		returnType *pgtypes.DoltgresType
+		final      regrFinalFn
+	}{
+		{"corr", pgtypes.Float64, regrCorr},
+		{"covar_pop", pgtypes.Float64, regrCovarPop},
+		{"covar_samp", pgtypes.Float64, regrCovarSamp},
+		{"regr_avgx", pgtypes.Float64, regrAvgX},
+		{"regr_avgy", pgtypes.Float64, regrAvgY},
+		{"regr_count", pgtypes.Int64, regrCount},
+		{"regr_intercept", pgtypes.Float64, regrIntercept},
+		{"regr_r2", pgtypes.Float64, regrR2},
+		{"regr_slope", pgtypes.Float64, regrSlope},
+		{"regr_sxx", pgtypes.Float64, regrSxx},
+		{"regr_sxy", pgtypes.Float64, regrSxy},
+		{"regr_syy", pgtypes.Float64, regrSyy},
+	} {
+		framework.RegisterAggregateFunction(framework.Func2Aggregate{
+			Function2: framework.Function2{
+				Name:   o.name,
+				Return: o.returnType,
+				Parameters: [2]*pgtypes.DoltgresType{
+					pgtypes.Float64,
+					pgtypes.Float64,
+				},
+				Callable: func(ctx *sql.Context, paramsAndReturn [3]*pgtypes.DoltgresType, val1, val2 any) (any, error) {
+					return nil, nil
+				},
+			},
+			NewAggBuffer:     newRegrBuffer(o.final),
+			NewAggWindowFunc: newRegrWindowFunction(o.final),
+		})
+	}
+}
+
+// regrState holds the running transition values of the two-argument statistical aggregates, matching the float8[]
+// state that Postgres's float8_regr_accum maintains: the count of (y, x) pairs where neither value is NULL, the sums
+// of each variable, and the sums of squares and cross products of their deviations from the mean.
+type regrState struct {
+	n   float64
+	sx  float64
+	sxx float64
+	sy  float64
+	syy float64
+	sxy float64
+}
+
+// regrFinalFn computes an aggregate's result from its final state.
+type regrFinalFn func(s *regrState) any
+
+// update folds the next (y, x) pair into the state using the Youngs-Cramer algorithm, exactly as float8_regr_accum
+// does, including its handling of infinite and NaN inputs.
+func (s *regrState) update(y, x float64) error {
+	oldN, oldSx, oldSy := s.n, s.sx, s.sy
+	s.n++
+	s.sx += x
+	s.sy += y
+	if oldN > 0 {
+		tmpX := x*s.n - s.sx
+		tmpY := y*s.n - s.sy
+		scale := 1.0 / (s.n * oldN)
+		s.sxx += tmpX * tmpX * scale
+		s.syy += tmpY * tmpY * scale
+		s.sxy += tmpX * tmpY * scale
+		// Overflow is only an error when none of the inputs were infinite, as infinite inputs legitimately produce
+		// infinite sums, in which case the deviations become NaN
+		if math.IsInf(s.sx, 0) || math.IsInf(s.sxx, 0) || math.IsInf(s.sy, 0) || math.IsInf(s.syy, 0) || math.IsInf(s.sxy, 0) {
+			if ((math.IsInf(s.sx, 0) || math.IsInf(s.sxx, 0)) && !math.IsInf(oldSx, 0) && !math.IsInf(x, 0)) ||
+				((math.IsInf(s.sy, 0) || math.IsInf(s.syy, 0)) && !math.IsInf(oldSy, 0) && !math.IsInf(y, 0)) ||
+				(math.IsInf(s.sxy, 0) && !math.IsInf(oldSx, 0) && !math.IsInf(x, 0) && !math.IsInf(oldSy, 0) && !math.IsInf(y, 0)) {
+				return errors.Errorf("value out of range: overflow")
+			}
+			if math.IsInf(s.sxx, 0) {
+				s.sxx = math.NaN()
+			}
+			if math.IsInf(s.syy, 0) {
+				s.syy = math.NaN()
+			}
+			if math.IsInf(s.sxy, 0) {
+				s.sxy = math.NaN()
+			}
+		}
+	} else {
+		if math.IsNaN(x) || math.IsInf(x, 0) {
+			s.sxx = math.NaN()
+			s.sxy = math.NaN()
+		}
+		if math.IsNaN(y) || math.IsInf(y, 0) {
+			s.syy = math.NaN()
+			s.sxy = math.NaN()
+		}
+	}
+	return nil
+}
+
+// regrCorr is the final function of corr(y, x).
+func regrCorr(s *regrState) any {
+	if s.n < 1 || s.sxx == 0 || s.syy == 0 {
+		return nil
+	}
+	return s.sxy / math.Sqrt(s.sxx*s.syy)
+}
+
+// regrCovarPop is the final function of covar_pop(y, x).
+func regrCovarPop(s *regrState) any {
+	if s.n < 1 {
+		return nil
+	}
+	return s.sxy / s.n
+}
+
+// regrCovarSamp is the final function of covar_samp(y, x).
+func regrCovarSamp(s *regrState) any {
+	if s.n < 2 {
+		return nil
+	}
+	return s.sxy / (s.n - 1)
+}
+
+// regrAvgX is the final function of regr_avgx(y, x).
+func regrAvgX(s *regrState) any {
+	if s.n < 1 {
+		return nil
+	}
+	return s.sx / s.n
+}
+
+// regrAvgY is the final function of regr_avgy(y, x).
+func regrAvgY(s *regrState) any {
+	if s.n < 1 {
+		return nil
+	}
+	return s.sy / s.n
+}
+
+// regrCount is the final function of regr_count(y, x), which (like count) returns zero rather than NULL when there
+// are no rows.
+func regrCount(s *regrState) any {
+	return int64(s.n)
+}
+
+// regrIntercept is the final function of regr_intercept(y, x).
+func regrIntercept(s *regrState) any {
+	if s.n < 1 || s.sxx == 0 {
+		return nil
+	}
+	return (s.sy - s.sx*s.sxy/s.sxx) / s.n
+}
+
+// regrR2 is the final function of regr_r2(y, x).
+func regrR2(s *regrState) any {
+	if s.n < 1 || s.sxx == 0 {
+		return nil
+	}
+	if s.syy == 0 {
+		return float64(1)
+	}
+	return (s.sxy * s.sxy) / (s.sxx * s.syy)
+}
+
+// regrSlope is the final function of regr_slope(y, x).
+func regrSlope(s *regrState) any {
+	if s.n < 1 || s.sxx == 0 {
+		return nil
+	}
+	return s.sxy / s.sxx
+}
+
+// regrSxx is the final function of regr_sxx(y, x).
+func regrSxx(s *regrState) any {
+	if s.n < 1 {
+		return nil
+	}
+	return s.sxx
+}
+
+// regrSxy is the final function of regr_sxy(y, x).
+func regrSxy(s *regrState) any {
+	if s.n < 1 {
+		return nil
+	}
+	return s.sxy
+}
+
+// regrSyy is the final function of regr_syy(y, x).
+func regrSyy(s *regrState) any {
+	if s.n < 1 {
+		return nil
+	}
+	return s.syy
+}
+
+// regrBuffer is the GROUP BY buffer shared by all of the two-argument statistical aggregates, which only differ in
+// their final function.
+type regrBuffer struct {
+	yExpr sql.Expression
+	xExpr sql.Expression
+	state regrState
+	final regrFinalFn
+}
+
+var _ sql.AggregationBuffer = (*regrBuffer)(nil)
+
+func newRegrBuffer(final regrFinalFn) framework.NewBufferFn {
+	return func(exprs []sql.Expression) (sql.AggregationBuffer, error) {
+		return &regrBuffer{yExpr: exprs[0], xExpr: exprs[1], final: final}, nil
+	}
+}
+
+func (b *regrBuffer) Dispose(ctx *sql.Context) {}
+
+func (b *regrBuffer) Eval(ctx *sql.Context) (interface{}, error) {
+	return b.final(&b.state), nil
+}
+
+// Update folds the next row into the state. Rows where either argument is NULL are ignored.
+func (b *regrBuffer) Update(ctx *sql.Context, row sql.Row) error {
+	y, err := b.yExpr.Eval(ctx, row)
+	if err != nil {
+		return err
+	}
+	if y == nil {
+		return nil
+	}
+	x, err := b.xExpr.Eval(ctx, row)
+	if err != nil {
+		return err
+	}
+	if x == nil {
+		return nil
+	}
+	yf, ok := y.(float64)
+	if !ok {
+		return errors.Errorf("regression aggregate: expected float64, got %T", y)
+	}
+	xf, ok := x.(float64)
+	if !ok {
+		return errors.Errorf("regression aggregate: expected float64, got %T", x)
+	}
+	return b.state.update(yf, xf)
+}
+
+// regrWindowFunction is the sql.WindowFunction used for the two-argument statistical aggregates within an OVER(...)
+// clause.
+type regrWindowFunction struct {
+	framework.WindowFramerState
+	yExpr sql.Expression
+	xExpr sql.Expression
+	final regrFinalFn
+}
+
+var _ sql.WindowFunction = (*regrWindowFunction)(nil)
+
+func newRegrWindowFunction(final regrFinalFn) framework.NewWindowFunctionFn {
+	return func(exprs []sql.Expression, window *sql.WindowDefinition) (sql.WindowFunction, error) {
+		wf := &regrWindowFunction{yExpr: exprs[0], xExpr: exprs[1], final: final}
+		if err := wf.BindFramer(window); err != nil {
+			return nil, err
+		}
+		return wf, nil
+	}
+}
+
+func (w *regrWindowFunction) Compute(ctx *sql.Context, interval sql.WindowInterval, buf sql.WindowBuffer) (interface{}, error) {
+	b := &regrBuffer{yExpr: w.yExpr, xExpr: w.xExpr, final: w.final}
+	for i := interval.Start; i < interval.End; i++ {
+		if err := b.Update(ctx, buf[i]); err != nil {
+			return nil, err
+		}
+	}
+	return b.Eval(ctx)
+}
@@ -0,0 +1,53 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initAnyCompatible registers the functions to the catalog.
+func initAnyCompatible() {
+	framework.RegisterFunction(anycompatible_in)
+	framework.RegisterFunction(anycompatible_out)
+}
+
+// anycompatible_in represents the PostgreSQL function of anycompatible type IO input.
+var anycompatible_in = framework.Function1{
+	Name:       "anycompatible_in",
+	Return:     pgtypes.AnyCompatible,
+	Parameters: [1]*pgtypes.DoltgresType{pgtypes.Cstring},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [2]*pgtypes.DoltgresType, val any) (any, error) {
+		// Pseudo-types cannot hold values of their own, which matches Postgres
+		return nil, errors.Errorf("cannot accept a value of type anycompatible")
+	},
+}
+
+// anycompatible_out represents the PostgreSQL function of anycompatible type IO output.
+var anycompatible_out = framework.Function1{
+	Name:       "anycompatible_out",
+	Return:     pgtypes.Cstring,
+	Parameters: [1]*pgtypes.DoltgresType{pgtypes.AnyCompatible},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [2]*pgtypes.DoltgresType, val any) (any, error) {
+		// Pseudo-types cannot hold values of their own, which matches Postgres
+		return nil, errors.Errorf("cannot display a value of type anycompatible")
+	},
+}
@@ -0,0 +1,114 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binary
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// These functions can be gathered using the following query from a Postgres 15 instance:
+// SELECT * FROM pg_operator o WHERE o.oprname IN ('@>', '<@', '&&') ORDER BY o.oprcode::varchar;
+
+// initBinaryContains registers the functions to the catalog.
+func initBinaryContains() {
+	framework.RegisterBinaryFunction(framework.Operator_BinaryJSONContainsRight, arraycontains)
+	framework.RegisterBinaryFunction(framework.Operator_BinaryJSONContainsLeft, arraycontained)
+	framework.RegisterBinaryFunction(framework.Operator_BinaryOverlap, arrayoverlap)
+	// TODO: range and geometric containment and overlap
+}
+
+// arraycontains represents the PostgreSQL function of the same name, taking the same parameters.
+var arraycontains = framework.Function2{
+	Name:       "arraycontains",
+	Return:     pgtypes.Bool,
+	Parameters: [2]*pgtypes.DoltgresType{pgtypes.AnyArray, pgtypes.AnyArray},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, t [3]*pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		return arrayContainsAll(ctx, t[0], t[1], val1.([]any), val2.([]any))
+	},
+}
+
+// arraycontained represents the PostgreSQL function of the same name, taking the same parameters.
+var arraycontained = framework.Function2{
+	Name:       "arraycontained",
+	Return:     pgtypes.Bool,
+	Parameters: [2]*pgtypes.DoltgresType{pgtypes.AnyArray, pgtypes.AnyArray},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, t [3]*pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		return arrayContainsAll(ctx, t[1], t[0], val2.([]any), val1.([]any))
+	},
+}
+
+// arrayoverlap represents the PostgreSQL function of the same name, taking the same parameters.
+var arrayoverlap = framework.Function2{
+	Name:       "arrayoverlap",
+	Return:     pgtypes.Bool,
+	Parameters: [2]*pgtypes.DoltgresType{pgtypes.AnyArray, pgtypes.AnyArray},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, t [3]*pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		if !t[0].Equals(t[1]) {
+			// TODO: Postgres allows arrays of different types when an equality operator exists between them
+			return nil, errors.Errorf("different type comparison is not supported yet")
+		}
+		for _, element := range val2.([]any) {
+			found, err := arrayHasElement(ctx, t[0], val1.([]any), element)
+			if err != nil || found {
+				return found, err
+			}
+		}
+		return false, nil
+	},
+}
+
+// arrayContainsAll returns whether every element of the contained array is found within the container array. As in
+// Postgres, duplicates are not considered, and NULL elements are never found.
+func arrayContainsAll(ctx *sql.Context, containerType *pgtypes.DoltgresType, containedType *pgtypes.DoltgresType, container []any, contained []any) (bool, error) {
+	if !containerType.Equals(containedType) {
+		// TODO: Postgres allows arrays of different types when an equality operator exists between them
+		return false, errors.Errorf("different type comparison is not supported yet")
+	}
+	for _, element := range contained {
+		found, err := arrayHasElement(ctx, containerType, container, element)
+		if err != nil || !found {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// arrayHasElement returns whether the array contains the given non-NULL element.
+func arrayHasElement(ctx *sql.Context, arrayType *pgtypes.DoltgresType, array []any, element any) (bool, error) {
+	if element == nil {
+		return false, nil
+	}
+	baseType := arrayType.ArrayBaseType()
+	for _, arrayElement := range array {
+		if arrayElement == nil {
+			continue
+		}
+		res, err := baseType.Compare(ctx, arrayElement, element)
+		if err != nil {
+			return false, err
+		}
+		if res == 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -20,6 +20,7 @@ func Init() {
 	initBinaryBitOr()
 	initBinaryBitXor()
 	initBinaryConcatenate()
+	initBinaryContains()
 	initBinaryDivide()
 	initBinaryEqual()
 	initBinaryGreaterOrEqual()
@@ -617,10 +617,102 @@ var jsonb_contains = framework.Function2{
 	Parameters: [2]*pgtypes.DoltgresType{pgtypes.JsonB, pgtypes.JsonB},
 	Strict:     true,
 	Callable: func(ctx *sql.Context, _ [3]*pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
-		return nil, errors.Errorf("JSON contains is not yet supported")
+		wrapper1, err := toJSONWrapper(ctx, val1)
+		if err != nil || wrapper1 == nil {
+			return nil, err
+		}
+		wrapper2, err := toJSONWrapper(ctx, val2)
+		if err != nil || wrapper2 == nil {
+			return nil, err
+		}
+		container, err := wrapper1.ToInterface(ctx)
+		if err != nil {
+			return nil, err
+		}
+		contained, err := wrapper2.ToInterface(ctx)
+		if err != nil {
+			return nil, err
+		}
+		// A top-level array is allowed to contain a primitive value, which is not true of nested arrays
+		if containerArray, ok := container.([]any); ok {
+			switch contained.(type) {
+			case map[string]any, []any:
+			default:
+				for _, item := range containerArray {
+					if jsonValuesEqual(item, contained) {
+						return true, nil
+					}
+				}
+				return false, nil
+			}
+		}
+		return jsonContains(container, contained), nil
 	},
 }
 
+// jsonContains returns whether the container JSON value contains the other value, following the containment rules
+// of Postgres: objects contain objects whose pairs they all contain, arrays contain arrays whose elements they all
+// contain (ignoring order and duplicates), and primitive values only contain equal primitive values.
+func jsonContains(container any, contained any) bool {
+	switch containedValue := contained.(type) {
+	case map[string]any:
+		containerObject, ok := container.(map[string]any)
+		if !ok {
+			return false
+		}
+		for key, value := range containedValue {
+			containerValue, ok := containerObject[key]
+			if !ok || !jsonContains(containerValue, value) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		containerArray, ok := container.([]any)
+		if !ok {
+			return false
+		}
+		for _, value := range containedValue {
+			found := false
+			for _, containerValue := range containerArray {
+				if jsonContains(containerValue, value) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+		return true
+	default:
+		return jsonValuesEqual(container, contained)
+	}
+}
+
+// jsonValuesEqual returns whether the two primitive JSON values are equal. Numbers are compared by their numeric value.
+func jsonValuesEqual(val1 any, val2 any) bool {
+	switch v1 := val1.(type) {
+	case nil:
+		return val2 == nil
+	case string:
+		v2, ok := val2.(string)
+		return ok && v1 == v2
+	case bool:
+		v2, ok := val2.(bool)
+		return ok && v1 == v2
+	case map[string]any, []any:
+		return false
+	default:
+		d1, ok := pgtypes.JsonNumberToDecimal(val1)
+		if !ok {
+			return false
+		}
+		d2, ok := pgtypes.JsonNumberToDecimal(val2)
+		return ok && d1.Cmp(d2) == 0
+	}
+}
+
 // jsonb_contained represents the PostgreSQL function of the same name, taking the same parameters.
 var jsonb_contained = framework.Function2{
 	Name:       "jsonb_contained",
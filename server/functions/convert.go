@@ -0,0 +1,249 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/cockroachdb/errors"
+	"github.com/dolthub/go-mysql-server/sql"
+	"golang.org/x/text/encoding/charmap"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initConvert registers the functions to the catalog.
+func initConvert() {
+	framework.RegisterFunction(convert_bytea_name_name)
+	framework.RegisterFunction(convert_from_bytea_name)
+	framework.RegisterFunction(convert_to_text_name)
+}
+
+// convert_bytea_name_name represents the PostgreSQL function of the same name, taking the same parameters.
+var convert_bytea_name_name = framework.Function3{
+	Name:       "convert",
+	Return:     pgtypes.Bytea,
+	Parameters: [3]*pgtypes.DoltgresType{pgtypes.Bytea, pgtypes.Name, pgtypes.Name},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [4]*pgtypes.DoltgresType, val1, val2, val3 any) (any, error) {
+		data, err := framework.UnwrapBytes(ctx, val1)
+		if err != nil {
+			return nil, err
+		}
+		src, err := lookupConversionEncoding(ctx, val2, "source")
+		if err != nil {
+			return nil, err
+		}
+		dest, err := lookupConversionEncoding(ctx, val3, "destination")
+		if err != nil {
+			return nil, err
+		}
+		str, err := src.toUTF8(data)
+		if err != nil {
+			return nil, err
+		}
+		return dest.fromUTF8(str)
+	},
+}
+
+// convert_from_bytea_name represents the PostgreSQL function of the same name, taking the same parameters.
+var convert_from_bytea_name = framework.Function2{
+	Name:       "convert_from",
+	Return:     pgtypes.Text,
+	Parameters: [2]*pgtypes.DoltgresType{pgtypes.Bytea, pgtypes.Name},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [3]*pgtypes.DoltgresType, val1, val2 any) (any, error) {
+		data, err := framework.UnwrapBytes(ctx, val1)
+		if err != nil {
+			return nil, err
+		}
+		src, err := lookupConversionEncoding(ctx, val2, "source")
+		if err != nil {
+			return nil, err
+		}
+		return src.toUTF8(data)
+	},
+}
+
+// convert_to_text_name represents the PostgreSQL function of the same name, taking the same parameters.
+var convert_to_text_name = framework.Function2{
+	Name:       "convert_to",
+	Return:     pgtypes.Bytea,
+	Parameters: [2]*pgtypes.DoltgresType{pgtypes.Text, pgtypes.Name},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [3]*pgtypes.DoltgresType, val1, val2 any) (any, error) {
+		str, err := framework.UnwrapString(ctx, val1)
+		if err != nil {
+			return nil, err
+		}
+		dest, err := lookupConversionEncoding(ctx, val2, "destination")
+		if err != nil {
+			return nil, err
+		}
+		return dest.fromUTF8(str)
+	},
+}
+
+// pgEncoding is a character set encoding that is supported by the encoding conversion functions.
+type pgEncoding struct {
+	// name is the canonical name, as returned by pg_encoding_to_char.
+	name string
+	// id is PostgreSQL's internal identifier for the encoding.
+	id int32
+	// charmap is the single-byte character map of the encoding, which is nil for UTF8 and SQL_ASCII.
+	charmap *charmap.Charmap
+}
+
+var (
+	encodingSQLASCII = &pgEncoding{name: "SQL_ASCII", id: 0}
+	encodingUTF8     = &pgEncoding{name: "UTF8", id: 6}
+	encodingLATIN1   = &pgEncoding{name: "LATIN1", id: 8, charmap: charmap.ISO8859_1}
+	encodingWIN1252  = &pgEncoding{name: "WIN1252", id: 24, charmap: charmap.Windows1252}
+)
+
+// pgEncodingsByName maps each normalized encoding name (see normalizeEncodingName) to its encoding.
+var pgEncodingsByName = map[string]*pgEncoding{
+	"sqlascii":    encodingSQLASCII,
+	"utf8":        encodingUTF8,
+	"unicode":     encodingUTF8,
+	"latin1":      encodingLATIN1,
+	"iso88591":    encodingLATIN1,
+	"win1252":     encodingWIN1252,
+	"windows1252": encodingWIN1252,
+}
+
+// lookupEncoding returns the encoding with the given name, or nil if the encoding is not supported.
+func lookupEncoding(name string) *pgEncoding {
+	return pgEncodingsByName[normalizeEncodingName(name)]
+}
+
+// lookupEncodingByID returns the encoding with the given identifier, or nil if the encoding is not supported.
+func lookupEncodingByID(id int32) *pgEncoding {
+	for _, encoding := range []*pgEncoding{encodingSQLASCII, encodingUTF8, encodingLATIN1, encodingWIN1252} {
+		if encoding.id == id {
+			return encoding
+		}
+	}
+	return nil
+}
+
+// normalizeEncodingName lowercases the encoding name and drops any characters that are not ASCII letters or digits,
+// matching Postgres (e.g. "UTF-8" matches "utf8").
+func normalizeEncodingName(name string) string {
+	var sb strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
+
+// lookupConversionEncoding returns the encoding named by the given argument of an encoding conversion function. The
+// role is either "source" or "destination", and is only used in the error message.
+func lookupConversionEncoding(ctx *sql.Context, val any, role string) (*pgEncoding, error) {
+	name, err := framework.UnwrapString(ctx, val)
+	if err != nil {
+		return nil, err
+	}
+	encoding := lookupEncoding(name)
+	if encoding == nil {
+		return nil, errors.Errorf(`invalid %s encoding name "%s"`, role, name)
+	}
+	return encoding, nil
+}
+
+// toUTF8 converts the data from this encoding into a UTF8 string. Text values cannot contain a zero byte, so one is
+// reported as an invalid byte sequence, just like bytes that are invalid in this encoding.
+func (e *pgEncoding) toUTF8(data []byte) (string, error) {
+	switch {
+	case e.charmap != nil:
+		sb := strings.Builder{}
+		sb.Grow(len(data))
+		for _, b := range data {
+			if b == 0 {
+				return "", errors.Errorf(`invalid byte sequence for encoding "%s": 0x00`, e.name)
+			}
+			// Bytes that have no character in the encoding are decoded as the replacement character
+			r := e.charmap.DecodeByte(b)
+			if r == utf8.RuneError {
+				return "", errors.Errorf(`character with byte sequence 0x%02x in encoding "%s" has no equivalent in encoding "UTF8"`, b, e.name)
+			}
+			sb.WriteRune(r)
+		}
+		return sb.String(), nil
+	default:
+		// SQL_ASCII is not a real encoding, so its bytes are never converted, but the result must still be valid text
+		return validateUTF8(data)
+	}
+}
+
+// fromUTF8 converts the UTF8 string into this encoding.
+func (e *pgEncoding) fromUTF8(str string) ([]byte, error) {
+	if e.charmap == nil {
+		return []byte(str), nil
+	}
+	result := make([]byte, 0, len(str))
+	for _, r := range str {
+		b, ok := e.charmap.EncodeRune(r)
+		if !ok {
+			return nil, errors.Errorf(`character with byte sequence %s in encoding "UTF8" has no equivalent in encoding "%s"`,
+				formatByteSequence([]byte(string(r))), e.name)
+		}
+		result = append(result, b)
+	}
+	return result, nil
+}
+
+// validateUTF8 returns the data as a string if it's valid UTF8 without any zero bytes. Otherwise, the error reports
+// the bytes of the invalid character.
+func validateUTF8(data []byte) (string, error) {
+	for i := 0; i < len(data); {
+		r, size := utf8.DecodeRune(data[i:])
+		if r == 0 || (r == utf8.RuneError && size <= 1) {
+			// Postgres reports as many bytes as the first byte claims the character has, limited to the input's length
+			length := utf8SequenceLength(data[i])
+			return "", errors.Errorf(`invalid byte sequence for encoding "UTF8": %s`, formatByteSequence(data[i:min(i+length, len(data))]))
+		}
+		i += size
+	}
+	return string(data), nil
+}
+
+// utf8SequenceLength returns the length of the UTF8 character that begins with the given byte.
+func utf8SequenceLength(b byte) int {
+	switch {
+	case b&0xe0 == 0xc0:
+		return 2
+	case b&0xf0 == 0xe0:
+		return 3
+	case b&0xf8 == 0xf0:
+		return 4
+	default:
+		return 1
+	}
+}
+
+// formatByteSequence formats the bytes as they're shown in Postgres' encoding errors, such as "0xe2 0x82 0xac".
+func formatByteSequence(data []byte) string {
+	parts := make([]string, len(data))
+	for i, b := range data {
+		parts[i] = fmt.Sprintf("0x%02x", b)
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,122 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/postgres/parser/duration"
+	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initDateAdd registers the functions to the catalog.
+func initDateAdd() {
+	framework.RegisterFunction(date_add_timestamptz_interval)
+	framework.RegisterFunction(date_add_timestamptz_interval_text)
+	framework.RegisterFunction(date_subtract_timestamptz_interval)
+	framework.RegisterFunction(date_subtract_timestamptz_interval_text)
+}
+
+// date_add_timestamptz_interval represents the PostgreSQL function of the same name, taking the same parameters.
+var date_add_timestamptz_interval = framework.Function2{
+	Name:       "date_add",
+	Return:     pgtypes.TimestampTZ,
+	Parameters: [2]*pgtypes.DoltgresType{pgtypes.TimestampTZ, pgtypes.Interval},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [3]*pgtypes.DoltgresType, val1, val2 any) (any, error) {
+		loc, err := GetServerLocation(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return addIntervalInLocation(val1.(time.Time), val2.(duration.Duration), loc)
+	},
+}
+
+// date_add_timestamptz_interval_text represents the PostgreSQL function of the same name, taking the same parameters.
+var date_add_timestamptz_interval_text = framework.Function3{
+	Name:       "date_add",
+	Return:     pgtypes.TimestampTZ,
+	Parameters: [3]*pgtypes.DoltgresType{pgtypes.TimestampTZ, pgtypes.Interval, pgtypes.Text},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [4]*pgtypes.DoltgresType, val1, val2, val3 any) (any, error) {
+		loc, err := dateArithmeticLocation(ctx, val3)
+		if err != nil {
+			return nil, err
+		}
+		return addIntervalInLocation(val1.(time.Time), val2.(duration.Duration), loc)
+	},
+}
+
+// date_subtract_timestamptz_interval represents the PostgreSQL function of the same name, taking the same parameters.
+var date_subtract_timestamptz_interval = framework.Function2{
+	Name:       "date_subtract",
+	Return:     pgtypes.TimestampTZ,
+	Parameters: [2]*pgtypes.DoltgresType{pgtypes.TimestampTZ, pgtypes.Interval},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [3]*pgtypes.DoltgresType, val1, val2 any) (any, error) {
+		loc, err := GetServerLocation(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return addIntervalInLocation(val1.(time.Time), val2.(duration.Duration).Mul(-1), loc)
+	},
+}
+
+// date_subtract_timestamptz_interval_text represents the PostgreSQL function of the same name, taking the same parameters.
+var date_subtract_timestamptz_interval_text = framework.Function3{
+	Name:       "date_subtract",
+	Return:     pgtypes.TimestampTZ,
+	Parameters: [3]*pgtypes.DoltgresType{pgtypes.TimestampTZ, pgtypes.Interval, pgtypes.Text},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [4]*pgtypes.DoltgresType, val1, val2, val3 any) (any, error) {
+		loc, err := dateArithmeticLocation(ctx, val3)
+		if err != nil {
+			return nil, err
+		}
+		return addIntervalInLocation(val1.(time.Time), val2.(duration.Duration).Mul(-1), loc)
+	},
+}
+
+// dateArithmeticLocation returns the location of the time zone argument of date_add and date_subtract.
+func dateArithmeticLocation(ctx *sql.Context, val any) (*time.Location, error) {
+	tz, err := framework.UnwrapString(ctx, val)
+	if err != nil {
+		return nil, err
+	}
+	loc, _, _, err := convertTzToOffsetSecs(time.Now().UTC(), tz)
+	if err != nil {
+		return nil, errors.Errorf(`time zone "%s" not recognized`, tz)
+	}
+	return loc, nil
+}
+
+// addIntervalInLocation adds the interval to the timestamp as it is seen in the given location, so that the month and
+// day fields of the interval respect the location's calendar (such as daylight saving transitions). Infinite timestamps
+// are returned unchanged.
+func addIntervalInLocation(t time.Time, d duration.Duration, loc *time.Location) (time.Time, error) {
+	if isInfiniteTime(t) {
+		return t, nil
+	}
+	result := duration.Add(t.In(loc), d)
+	if result.After(tree.MaxSupportedTime) || result.Before(tree.MinSupportedTime) {
+		return time.Time{}, errTimestampOutOfRange
+	}
+	return result, nil
+}
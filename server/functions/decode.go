@@ -0,0 +1,188 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/cockroachdb/errors"
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initDecode registers the functions to the catalog.
+func initDecode() {
+	framework.RegisterFunction(decode)
+}
+
+// decode represents the PostgreSQL function of the same name, taking the same parameters.
+var decode = framework.Function2{
+	Name:       "decode",
+	Return:     pgtypes.Bytea,
+	Parameters: [2]*pgtypes.DoltgresType{pgtypes.Text, pgtypes.Text},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [3]*pgtypes.DoltgresType, val1, val2 any) (any, error) {
+		data, err := framework.UnwrapString(ctx, val1)
+		if err != nil {
+			return nil, err
+		}
+		format, err := framework.UnwrapString(ctx, val2)
+		if err != nil {
+			return nil, err
+		}
+		switch strings.ToLower(format) {
+		case "hex":
+			return decodeHex(data)
+		case "base64":
+			return decodeBase64(data)
+		case "escape":
+			return decodeEscape(data)
+		default:
+			return nil, errors.Errorf(`unrecognized encoding: "%s"`, format)
+		}
+	},
+}
+
+// isDecodeWhitespace returns whether the byte is whitespace that the hex and base64 formats ignore.
+func isDecodeWhitespace(b byte) bool {
+	return b == ' ' || b == '\t' || b == '\n' || b == '\r'
+}
+
+// decodeHex decodes data in PostgreSQL's "hex" format, which ignores whitespace between pairs of digits.
+func decodeHex(data string) ([]byte, error) {
+	result := make([]byte, 0, len(data)/2)
+	for i := 0; i < len(data); {
+		if isDecodeWhitespace(data[i]) {
+			i++
+			continue
+		}
+		high, err := decodeHexDigit(data, i)
+		if err != nil {
+			return nil, err
+		}
+		i++
+		if i >= len(data) {
+			return nil, errors.Errorf("invalid hexadecimal data: odd number of digits")
+		}
+		low, err := decodeHexDigit(data, i)
+		if err != nil {
+			return nil, err
+		}
+		i++
+		result = append(result, high<<4|low)
+	}
+	return result, nil
+}
+
+// decodeHexDigit returns the value of the hexadecimal digit at the given index.
+func decodeHexDigit(data string, idx int) (byte, error) {
+	c := data[idx]
+	switch {
+	case c >= '0' && c <= '9':
+		return c - '0', nil
+	case c >= 'a' && c <= 'f':
+		return c - 'a' + 10, nil
+	case c >= 'A' && c <= 'F':
+		return c - 'A' + 10, nil
+	default:
+		_, size := utf8.DecodeRuneInString(data[idx:])
+		return 0, errors.Errorf(`invalid hexadecimal digit: "%s"`, data[idx:idx+size])
+	}
+}
+
+// decodeBase64 decodes data in PostgreSQL's "base64" format. Whitespace is ignored, and the padding is required.
+func decodeBase64(data string) ([]byte, error) {
+	result := make([]byte, 0, len(data)*3/4)
+	var buf uint32
+	pos := 0
+	// end is the number of data characters in the final group, as determined by the first padding character
+	end := 0
+	for i := 0; i < len(data); i++ {
+		c := data[i]
+		if isDecodeWhitespace(c) {
+			continue
+		}
+		var b uint32
+		if c == '=' {
+			if end == 0 {
+				switch pos {
+				case 2:
+					end = 1
+				case 3:
+					end = 2
+				default:
+					return nil, errors.Errorf(`unexpected "=" while decoding base64 sequence`)
+				}
+			}
+		} else {
+			idx := strings.IndexByte(base64Alphabet, c)
+			if idx < 0 {
+				_, size := utf8.DecodeRuneInString(data[i:])
+				return nil, errors.Errorf(`invalid symbol "%s" found while decoding base64 sequence`, data[i:i+size])
+			}
+			b = uint32(idx)
+		}
+		buf = buf<<6 | b
+		pos++
+		if pos == 4 {
+			result = append(result, byte(buf>>16))
+			if end == 0 || end > 1 {
+				result = append(result, byte(buf>>8))
+			}
+			if end == 0 || end > 2 {
+				result = append(result, byte(buf))
+			}
+			buf = 0
+			pos = 0
+		}
+	}
+	if pos != 0 {
+		return nil, errors.Errorf("invalid base64 end sequence")
+	}
+	return result, nil
+}
+
+// base64Alphabet contains the characters of the base64 format, in the order of their values.
+const base64Alphabet = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789+/"
+
+// decodeEscape decodes data in PostgreSQL's "escape" format, where a doubled backslash is a single backslash, and a
+// backslash followed by three octal digits is the byte with that value. Every other byte is passed through as-is.
+func decodeEscape(data string) ([]byte, error) {
+	result := make([]byte, 0, len(data))
+	for i := 0; i < len(data); {
+		switch {
+		case data[i] != '\\':
+			result = append(result, data[i])
+			i++
+		case i+3 < len(data) && data[i+1] >= '0' && data[i+1] <= '3' && isOctalDigit(data[i+2]) && isOctalDigit(data[i+3]):
+			result = append(result, (data[i+1]-'0')<<6|(data[i+2]-'0')<<3|(data[i+3]-'0'))
+			i += 4
+		case i+1 < len(data) && data[i+1] == '\\':
+			result = append(result, '\\')
+			i += 2
+		default:
+			return nil, errors.Errorf("invalid input syntax for type bytea")
+		}
+	}
+	return result, nil
+}
+
+// isOctalDigit returns whether the byte is an octal digit.
+func isOctalDigit(b byte) bool {
+	return b >= '0' && b <= '7'
+}
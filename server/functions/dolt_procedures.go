@@ -17,7 +17,9 @@ package functions
 import (
 	"io"
 	"reflect"
+	"slices"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/cockroachdb/errors"
@@ -28,6 +30,7 @@ import (
 	"github.com/dolthub/go-mysql-server/sql/plan"
 	"github.com/dolthub/go-mysql-server/sql/types"
 
+	"github.com/dolthub/doltgresql/core"
 	"github.com/dolthub/doltgresql/server/auth"
 	"github.com/dolthub/doltgresql/server/functions/framework"
 	pgtypes "github.com/dolthub/doltgresql/server/types"
@@ -225,6 +228,10 @@ func invokeDoltProcedure(ctx *sql.Context, p *plan.ExternalProcedure, funcVal re
 	if !ok {
 		return nil, sql.ErrExternalProcedureInvalidParamType.New(reflect.TypeOf(val1).String())
 	}
+	values, err = withGinPostingTables(ctx, p.Name, values)
+	if err != nil {
+		return nil, err
+	}
 
 	funcParams := make([]reflect.Value, len(values)+1)
 	funcParams[0] = reflect.ValueOf(ctx)
@@ -268,6 +275,42 @@ func invokeDoltProcedure(ctx *sql.Context, p *plan.ExternalProcedure, funcVal re
 	return sql.RowsToRowIter(), nil
 }
 
+// withGinPostingTables appends the tables that hold the posting lists of GIN indexes to the arguments of procedures
+// that stage, check out, or reset tables by name, so that the posting lists never drift from the tables they index.
+// Arguments that do not name a table with GIN indexes, such as flags, branches, and commits, add nothing.
+func withGinPostingTables(ctx *sql.Context, procedureName string, values []any) ([]any, error) {
+	switch strings.ToLower(procedureName) {
+	case "dolt_add", "dolt_reset":
+	case "dolt_checkout":
+		// Checking out a branch may take a name that is also a table, so only plain table checkouts are handled
+		for _, value := range values {
+			if str, ok := value.(string); ok && strings.HasPrefix(str, "-") && str != "--" {
+				return values, nil
+			}
+		}
+	default:
+		return values, nil
+	}
+	var postingTables []any
+	for _, value := range values {
+		tableName, ok := value.(string)
+		if !ok || len(tableName) == 0 || strings.HasPrefix(tableName, "-") {
+			continue
+		}
+		names, err := core.GinPostingTableNames(ctx, tableName)
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range names {
+			postingTables = append(postingTables, name)
+		}
+	}
+	if len(postingTables) == 0 {
+		return values, nil
+	}
+	return append(slices.Clone(values), postingTables...), nil
+}
+
 // noArgCallableForDoltProcedure creates a callable function that does not take any parameters. This is equivalent to
 // calling "DOLT_PROC_NAME()".
 func noArgCallableForDoltProcedure(p *plan.ExternalProcedure, funcVal reflect.Value, outSchema sql.Schema) func(ctx *sql.Context) (any, error) {
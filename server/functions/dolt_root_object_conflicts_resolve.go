@@ -0,0 +1,216 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/conflicts"
+	"github.com/dolthub/doltgresql/core/rootobject/objinterface"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initDoltRootObjectConflictsResolve registers the functions to the catalog.
+func initDoltRootObjectConflictsResolve() {
+	framework.RegisterFunction(dolt_root_object_conflicts_resolve_text_text)
+}
+
+// dolt_root_object_conflicts_resolve_text_text resolves the root object conflicts that are displayed in the
+// dolt_root_object_conflicts table. The first parameter is the name of the root object (or "." for every root object
+// conflict), and the second parameter is the resolution. The resolution may be "--ours", "--theirs", or "--base" to
+// take that side of the conflict in its entirety, or it may be a CREATE statement that replaces the root object. The
+// conflicting root object is dropped before the CREATE statement is executed, and the statement must create a root
+// object with the same identity (such as a function with the same signature).
+var dolt_root_object_conflicts_resolve_text_text = framework.Function2{
+	Name:               "dolt_root_object_conflicts_resolve",
+	Return:             pgtypes.Int64,
+	Parameters:         [2]*pgtypes.DoltgresType{pgtypes.Text, pgtypes.Text},
+	IsNonDeterministic: true,
+	Strict:             true,
+	Callable: func(ctx *sql.Context, _ [3]*pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		name, err := framework.UnwrapString(ctx, val1)
+		if err != nil {
+			return nil, err
+		}
+		resolution, err := framework.UnwrapString(ctx, val2)
+		if err != nil {
+			return nil, err
+		}
+		conflictsColl, err := core.GetConflictsCollectionFromContext(ctx, "")
+		if err != nil {
+			return nil, err
+		}
+		var targets []conflicts.Conflict
+		if name == "." {
+			err = conflictsColl.IterateConflicts(ctx, func(conflict conflicts.Conflict) (stop bool, err error) {
+				targets = append(targets, conflict)
+				return false, nil
+			})
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			_, conflictID, err := conflictsColl.ResolveName(ctx, rootObjectConflictTableName(name))
+			if err != nil {
+				return nil, err
+			}
+			conflict, err := conflictsColl.GetConflict(ctx, conflictID)
+			if err != nil {
+				return nil, err
+			}
+			if !conflict.ID.IsValid() {
+				return nil, errors.Errorf(`root object "%s" does not have a conflict`, name)
+			}
+			targets = append(targets, conflict)
+		}
+
+		switch strings.ToLower(resolution) {
+		case "--ours":
+			for _, conflict := range targets {
+				if err = resolveRootObjectConflict(ctx, conflict, conflict.Ours); err != nil {
+					return nil, err
+				}
+			}
+		case "--theirs":
+			for _, conflict := range targets {
+				if err = resolveRootObjectConflict(ctx, conflict, conflict.Theirs); err != nil {
+					return nil, err
+				}
+			}
+		case "--base":
+			for _, conflict := range targets {
+				if err = resolveRootObjectConflict(ctx, conflict, conflict.Ancestor); err != nil {
+					return nil, err
+				}
+			}
+		default:
+			if !strings.HasPrefix(strings.ToUpper(strings.TrimSpace(resolution)), "CREATE") {
+				return nil, errors.Errorf(`invalid resolution "%s": expected --ours, --theirs, --base, or a CREATE statement`, resolution)
+			}
+			if name == "." {
+				return nil, errors.New("a CREATE statement may only resolve the conflict of a single root object")
+			}
+			if err = replaceRootObjectConflict(ctx, targets[0], resolution); err != nil {
+				return nil, err
+			}
+		}
+		return int64(0), nil
+	},
+}
+
+// rootObjectConflictTableName returns the table name for the given root object name, which may be qualified with a
+// schema. Function signatures may contain periods within their parameter types, so only the portion before the
+// parameters is searched for a schema.
+func rootObjectConflictTableName(name string) doltdb.TableName {
+	prefix := name
+	if parenIdx := strings.IndexByte(name, '('); parenIdx >= 0 {
+		prefix = name[:parenIdx]
+	}
+	if dotIdx := strings.IndexByte(prefix, '.'); dotIdx >= 0 {
+		return doltdb.TableName{Name: name[dotIdx+1:], Schema: name[:dotIdx]}
+	}
+	return doltdb.TableName{Name: name}
+}
+
+// resolveRootObjectConflict removes the conflict and replaces the conflicting root object with the given root object.
+// If the given root object is nil, then the conflicting root object is dropped.
+func resolveRootObjectConflict(ctx *sql.Context, conflict conflicts.Conflict, rootObj objinterface.RootObject) error {
+	conflictsColl, err := core.GetConflictsCollectionFromContext(ctx, "")
+	if err != nil {
+		return err
+	}
+	if conflictsColl.HasConflict(ctx, conflict.ID) {
+		if err = conflictsColl.DropConflict(ctx, conflict.ID); err != nil {
+			return err
+		}
+	}
+	coll, err := core.GetRootObjectCollectionFromContext(ctx, "", conflict.RootObjectID)
+	if err != nil {
+		return err
+	}
+	exists, err := coll.HasRootObject(ctx, conflict.ID)
+	if err != nil {
+		return err
+	}
+	if exists {
+		if err = coll.DropRootObject(ctx, conflict.ID); err != nil {
+			return err
+		}
+	}
+	if rootObj == nil {
+		return nil
+	}
+	return coll.PutRootObject(ctx, rootObj)
+}
+
+// replaceRootObjectConflict removes the conflict and the conflicting root object, and then executes the given CREATE
+// statement in its place. If the statement fails, or does not create a root object with the same identity, then the
+// conflict is restored.
+func replaceRootObjectConflict(ctx *sql.Context, conflict conflicts.Conflict, statement string) (err error) {
+	runner, err := core.GetRunnerFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	if runner == nil {
+		return errors.New("cannot execute the replacement statement outside of a query")
+	}
+	if err = resolveRootObjectConflict(ctx, conflict, nil); err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			if restoreErr := restoreRootObjectConflict(ctx, conflict); restoreErr != nil {
+				err = errors.CombineErrors(err, restoreErr)
+			}
+		}
+	}()
+	_, rowIter, _, err := runner.QueryWithBindings(ctx, statement, nil, nil, nil)
+	if err != nil {
+		return err
+	}
+	if _, err = sql.RowIterToRows(ctx, rowIter); err != nil {
+		return err
+	}
+	coll, err := core.GetRootObjectCollectionFromContext(ctx, "", conflict.RootObjectID)
+	if err != nil {
+		return err
+	}
+	exists, err := coll.HasRootObject(ctx, conflict.ID)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return errors.Errorf(`replacement statement did not create "%s"`, conflict.Name().String())
+	}
+	return nil
+}
+
+// restoreRootObjectConflict restores the conflict and our side of the conflict, undoing a failed replacement.
+func restoreRootObjectConflict(ctx *sql.Context, conflict conflicts.Conflict) error {
+	if err := resolveRootObjectConflict(ctx, conflict, conflict.Ours); err != nil {
+		return err
+	}
+	conflictsColl, err := core.GetConflictsCollectionFromContext(ctx, "")
+	if err != nil {
+		return err
+	}
+	return conflictsColl.AddConflict(ctx, conflict)
+}
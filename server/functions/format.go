@@ -0,0 +1,292 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/cockroachdb/errors"
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+	"github.com/dolthub/doltgresql/utils"
+)
+
+// initFormat registers the functions to the catalog.
+func initFormat() {
+	framework.RegisterFunction(format_text_any)
+}
+
+// format_text_any represents the PostgreSQL function of the same name, taking the same parameters. This covers both
+// format(text) and format(text, VARIADIC "any").
+var format_text_any = framework.Function1N{
+	Name:       "format",
+	Return:     pgtypes.Text,
+	Parameters: [1]*pgtypes.DoltgresType{pgtypes.Text},
+	Strict:     false,
+	Callable: func(ctx *sql.Context, t []*pgtypes.DoltgresType, val1 any, vals []any) (any, error) {
+		if val1 == nil {
+			return nil, nil
+		}
+		formatStr, err := framework.UnwrapString(ctx, val1)
+		if err != nil {
+			return nil, err
+		}
+		return formatString(ctx, formatStr, t[1:len(t)-1], vals)
+	},
+}
+
+// formatSpec is a single parsed format specifier of format().
+type formatSpec struct {
+	// argPos is the explicit (one-based) argument position, or zero to use the next argument
+	argPos int
+	// leftAlign is set by the "-" flag
+	leftAlign bool
+	// width is the minimum width of the output, which is only used when widthPos is negative
+	width int
+	// widthPos is negative when the width is given directly, zero when the width is taken from the next argument, and
+	// the (one-based) argument position of the width otherwise
+	widthPos int
+	// conversion is the type of the specifier, which is one of 's', 'I', or 'L'
+	conversion byte
+}
+
+// formatString implements format(), following text_format in Postgres's varlena.c.
+func formatString(ctx *sql.Context, formatStr string, argTypes []*pgtypes.DoltgresType, args []any) (string, error) {
+	sb := strings.Builder{}
+	// arg is the one-based position of the last argument that was used
+	arg := 0
+	for i := 0; i < len(formatStr); i++ {
+		if formatStr[i] != '%' {
+			sb.WriteByte(formatStr[i])
+			continue
+		}
+		i++
+		if i >= len(formatStr) {
+			return "", errors.Errorf(`unterminated format() type specifier`)
+		}
+		if formatStr[i] == '%' {
+			sb.WriteByte('%')
+			continue
+		}
+		spec, next, err := parseFormatSpec(formatStr, i)
+		if err != nil {
+			return "", err
+		}
+		i = next
+		width := spec.width
+		if spec.widthPos >= 0 {
+			if spec.widthPos > 0 {
+				arg = spec.widthPos
+			} else {
+				arg++
+			}
+			if arg > len(args) {
+				return "", errors.Errorf("too few arguments for format()")
+			}
+			width, err = formatWidthArgument(ctx, argTypes[arg-1], args[arg-1])
+			if err != nil {
+				return "", err
+			}
+		}
+		if spec.argPos > 0 {
+			arg = spec.argPos
+		} else {
+			arg++
+		}
+		if arg > len(args) {
+			return "", errors.Errorf("too few arguments for format()")
+		}
+		str, err := formatConversion(ctx, spec.conversion, argTypes[arg-1], args[arg-1])
+		if err != nil {
+			return "", err
+		}
+		formatAppendPadded(&sb, str, spec.leftAlign, width)
+	}
+	return sb.String(), nil
+}
+
+// parseFormatSpec parses the format specifier that begins at the given index (just after the "%"), returning the
+// specifier along with the index of its conversion character.
+func parseFormatSpec(formatStr string, i int) (formatSpec, int, error) {
+	spec := formatSpec{widthPos: -1}
+	unterminated := errors.Errorf(`unterminated format() type specifier`)
+	n, next, ok, err := parseFormatDigits(formatStr, i)
+	if err != nil {
+		return spec, 0, err
+	}
+	if ok {
+		i = next
+		if i >= len(formatStr) {
+			return spec, 0, unterminated
+		}
+		if formatStr[i] != '$' {
+			// A leading number without a "$" is just the width, so the conversion must follow
+			spec.width = n
+			return spec, i, validateFormatConversion(formatStr, i, &spec)
+		}
+		if n == 0 {
+			return spec, 0, errors.Errorf("format specifies argument 0, but arguments are numbered from 1")
+		}
+		spec.argPos = n
+		i++
+	}
+	for i < len(formatStr) && formatStr[i] == '-' {
+		spec.leftAlign = true
+		i++
+	}
+	if i >= len(formatStr) {
+		return spec, 0, unterminated
+	}
+	if formatStr[i] == '*' {
+		i++
+		n, next, ok, err = parseFormatDigits(formatStr, i)
+		if err != nil {
+			return spec, 0, err
+		}
+		spec.widthPos = 0
+		if ok {
+			i = next
+			if i >= len(formatStr) || formatStr[i] != '$' {
+				return spec, 0, errors.Errorf(`width argument position must be ended by "$"`)
+			}
+			if n == 0 {
+				return spec, 0, errors.Errorf("format specifies argument 0, but arguments are numbered from 1")
+			}
+			spec.widthPos = n
+			i++
+		}
+	} else {
+		n, next, ok, err = parseFormatDigits(formatStr, i)
+		if err != nil {
+			return spec, 0, err
+		}
+		if ok {
+			spec.width = n
+			i = next
+		}
+	}
+	return spec, i, validateFormatConversion(formatStr, i, &spec)
+}
+
+// parseFormatDigits parses the run of digits that begins at the given index, returning false if there are none.
+func parseFormatDigits(formatStr string, i int) (n int, next int, ok bool, err error) {
+	for ; i < len(formatStr) && formatStr[i] >= '0' && formatStr[i] <= '9'; i++ {
+		n = n*10 + int(formatStr[i]-'0')
+		if n > math.MaxInt32 {
+			return 0, 0, false, errors.Errorf("number is out of range")
+		}
+		ok = true
+	}
+	return n, i, ok, nil
+}
+
+// validateFormatConversion checks that the character at the given index is a valid conversion, storing it in the spec.
+func validateFormatConversion(formatStr string, i int, spec *formatSpec) error {
+	if i >= len(formatStr) {
+		return errors.Errorf(`unterminated format() type specifier`)
+	}
+	switch formatStr[i] {
+	case 's', 'I', 'L':
+		spec.conversion = formatStr[i]
+		return nil
+	default:
+		r, _ := utf8.DecodeRuneInString(formatStr[i:])
+		return errors.Errorf(`unrecognized format() type specifier "%c"`, r)
+	}
+}
+
+// formatWidthArgument returns the width given by an argument of a "*" width. A NULL width is treated as zero.
+func formatWidthArgument(ctx *sql.Context, argType *pgtypes.DoltgresType, val any) (int, error) {
+	switch val := val.(type) {
+	case nil:
+		return 0, nil
+	case int16:
+		return int(val), nil
+	case int32:
+		return int(val), nil
+	default:
+		// Less common types are converted to text and then parsed as an integer
+		str, err := argType.IoOutput(ctx, val)
+		if err != nil {
+			return 0, err
+		}
+		width, err := strconv.ParseInt(strings.TrimSpace(str), 10, 32)
+		if err != nil {
+			return 0, errors.Errorf(`invalid input syntax for type integer: "%s"`, str)
+		}
+		return int(width), nil
+	}
+}
+
+// formatConversion converts an argument to its string form for the given conversion.
+func formatConversion(ctx *sql.Context, conversion byte, argType *pgtypes.DoltgresType, val any) (string, error) {
+	if val == nil {
+		switch conversion {
+		case 'I':
+			return "", errors.Errorf("null values cannot be formatted as an SQL identifier")
+		case 'L':
+			return "NULL", nil
+		default:
+			return "", nil
+		}
+	}
+	var str string
+	if argType.ID == pgtypes.Bool.ID {
+		// Within this context, `bool` returns 't' rather than 'true'
+		if val.(bool) {
+			str = "t"
+		} else {
+			str = "f"
+		}
+	} else {
+		var err error
+		str, err = argType.IoOutput(ctx, val)
+		if err != nil {
+			return "", err
+		}
+	}
+	switch conversion {
+	case 'I':
+		return utils.QuoteIdentifierIfNeeded(str), nil
+	case 'L':
+		return quoteLiteral(str), nil
+	default:
+		return str, nil
+	}
+}
+
+// formatAppendPadded writes the string padded with spaces to the given width. A negative width aligns the string to
+// the left, just like the "-" flag.
+func formatAppendPadded(sb *strings.Builder, str string, leftAlign bool, width int) {
+	if width < 0 {
+		leftAlign = true
+		width = -width
+	}
+	padding := width - utf8.RuneCountInString(str)
+	if leftAlign {
+		sb.WriteString(str)
+	}
+	if padding > 0 {
+		sb.WriteString(strings.Repeat(" ", padding))
+	}
+	if !leftAlign {
+		sb.WriteString(str)
+	}
+}
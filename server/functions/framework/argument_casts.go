@@ -0,0 +1,109 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/core/casts"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// castArgumentsToParameters wraps each argument whose type differs from its resolved parameter type in a cast to that
+// parameter type. Aggregate and window functions evaluate their arguments directly rather than through
+// CompiledFunction.Eval, so the implicit casts chosen during overload resolution must be applied here instead.
+// Polymorphic parameters (other than anycompatible, which resolves to a concrete common type) are left alone, as they
+// accept their arguments as-is.
+func (c *CompiledFunction) castArgumentsToParameters(args []sql.Expression) []sql.Expression {
+	if !c.overload.Valid() || len(c.overload.casts) == 0 {
+		return args
+	}
+	for i, paramType := range c.overload.params.paramTypes {
+		if i >= len(args) || i >= len(c.originalTypes) || i >= len(c.overload.casts) {
+			break
+		}
+		targetType := paramType
+		if paramType.ID == pgtypes.AnyCompatible.ID {
+			targetType = c.overload.anyCompatibleType
+		} else if paramType.IsPolymorphicType() {
+			continue
+		}
+		if targetType == nil || c.originalTypes[i].ID == targetType.ID || !c.overload.casts[i].ID.IsValid() {
+			continue
+		}
+		args[i] = &argumentCastExpr{
+			child:      args[i],
+			cast:       c.overload.casts[i],
+			sourceType: c.originalTypes[i],
+			targetType: targetType,
+		}
+	}
+	return args
+}
+
+// argumentCastExpr applies the implicit cast that overload resolution chose for an aggregate or window function
+// argument.
+type argumentCastExpr struct {
+	child      sql.Expression
+	cast       casts.Cast
+	sourceType *pgtypes.DoltgresType
+	targetType *pgtypes.DoltgresType
+}
+
+var _ sql.Expression = (*argumentCastExpr)(nil)
+
+// Children implements the sql.Expression interface.
+func (c *argumentCastExpr) Children() []sql.Expression {
+	return []sql.Expression{c.child}
+}
+
+// Eval implements the sql.Expression interface.
+func (c *argumentCastExpr) Eval(ctx *sql.Context, row sql.Row) (any, error) {
+	val, err := c.child.Eval(ctx, row)
+	if err != nil || val == nil {
+		return val, err
+	}
+	return c.cast.Eval(ctx, val, c.sourceType, c.targetType)
+}
+
+// IsNullable implements the sql.Expression interface.
+func (c *argumentCastExpr) IsNullable(ctx *sql.Context) bool {
+	return c.child.IsNullable(ctx)
+}
+
+// Resolved implements the sql.Expression interface.
+func (c *argumentCastExpr) Resolved() bool {
+	return c.child.Resolved()
+}
+
+// String implements the sql.Expression interface.
+func (c *argumentCastExpr) String() string {
+	return c.child.String()
+}
+
+// Type implements the sql.Expression interface.
+func (c *argumentCastExpr) Type(ctx *sql.Context) sql.Type {
+	return c.targetType
+}
+
+// WithChildren implements the sql.Expression interface.
+func (c *argumentCastExpr) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(c, len(children), 1)
+	}
+	nc := *c
+	nc.child = children[0]
+	return &nc, nil
+}
@@ -37,6 +37,9 @@ var AggregateCatalog = map[string][]AggregateFunctionInterface{}
 // WindowCatalog contains all of the PostgreSQL functions that may only be used as window functions.
 var WindowCatalog = map[string][]WindowFunctionInterface{}
 
+// ParameterCatalog contains the parameters of the PostgreSQL functions whose arguments may be given by name.
+var ParameterCatalog = map[string][]NamedParameter{}
+
 // initializedFunctions simply states whether Initialize has been called yet.
 var initializedFunctions = false
 
@@ -95,6 +98,9 @@ func RegisterAggregateFunction(f AggregateFunctionInterface) {
 	case Func1Aggregate:
 		name := strings.ToLower(f.Name)
 		AggregateCatalog[name] = append(AggregateCatalog[name], f)
+	case Func2Aggregate:
+		name := strings.ToLower(f.Name)
+		AggregateCatalog[name] = append(AggregateCatalog[name], f)
 	default:
 		panic(fmt.Sprintf("unhandled function type %T", f))
 	}
@@ -107,7 +113,7 @@ func RegisterWindowFunction(f WindowFunctionInterface) {
 		panic("attempted to register a function after the init() phase")
 	}
 	switch f.(type) {
-	case Func0Window, Func1Window, Func2Window:
+	case Func0Window, Func1Window, Func2Window, Func3Window:
 		name := strings.ToLower(f.GetName())
 		WindowCatalog[name] = append(WindowCatalog[name], f)
 	default:
@@ -115,6 +121,33 @@ func RegisterWindowFunction(f WindowFunctionInterface) {
 	}
 }
 
+// RegisterNamedParameters registers the parameters of the function with the given name, in the order that they're
+// declared, so that calls may give their arguments by name. This should be called from within an init().
+func RegisterNamedParameters(name string, params ...NamedParameter) {
+	if initializedFunctions {
+		panic("attempted to register function parameters after the init() phase")
+	}
+	ParameterCatalog[strings.ToLower(name)] = params
+}
+
+// IsFrameAwareWindowFunction returns whether the window-only function with the given name evaluates its own frame, and
+// may therefore be given a WindowFrameSpec.
+func IsFrameAwareWindowFunction(name string) bool {
+	for _, f := range WindowCatalog[strings.ToLower(name)] {
+		switch f := f.(type) {
+		case Func1Window:
+			if f.FrameAware {
+				return true
+			}
+		case Func2Window:
+			if f.FrameAware {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // Initialize handles the initialization of the catalog by overwriting the built-in GMS functions, since they do not
 // apply to PostgreSQL (and functions of the same name often have different behavior).
 func Initialize(astConvert func(parser.Statement) (sqlparser.Statement, error)) {
@@ -55,6 +55,9 @@ type CompiledFunction struct {
 	callResolved  []*pgtypes.DoltgresType
 	runner        sql.StatementRunner
 	stashedErr    error
+	// variadicArray is set when the last argument is a VariadicArgument, whose array elements are passed to the
+	// function as individual values
+	variadicArray bool
 }
 
 var _ sql.FunctionExpression = (*CompiledFunction)(nil)
@@ -65,7 +68,18 @@ var _ sql.ExtendedTableFunction = (*CompiledFunction)(nil)
 
 // NewCompiledFunction returns a newly compiled function.
 func NewCompiledFunction(ctx *sql.Context, name string, args []sql.Expression, functions *Overloads, isOperator bool) *CompiledFunction {
-	return newCompiledFunctionInternal(ctx, name, args, functions, functions.overloadsForParams(len(args)), isOperator, nil)
+	positionalArgs, err := positionalArguments(ctx, name, args)
+	if err != nil {
+		// Errors should be returned from the call to Eval, so we'll stash it for now
+		return &CompiledFunction{
+			Name:       name,
+			Arguments:  args,
+			IsOperator: isOperator,
+			overloads:  functions,
+			stashedErr: err,
+		}
+	}
+	return newCompiledFunctionInternal(ctx, name, positionalArgs, functions, functions.overloadsForParams(len(positionalArgs)), isOperator, nil)
 }
 
 // newCompiledFunctionInternal is called internally, which skips steps that may have already been processed.
@@ -128,13 +142,25 @@ func newCompiledFunctionInternal(
 	}
 
 	fn := overload.Function()
+	if len(args) > 0 {
+		if _, ok := args[len(args)-1].(*VariadicArgument); ok {
+			if err = c.validateVariadicArgument(fn, originalTypes); err != nil {
+				c.stashedErr = err
+				return c
+			}
+			c.variadicArray = true
+		}
+	}
 
 	// Then we'll handle the polymorphic types
 	// https://www.postgresql.org/docs/15/extend-type-system.html#EXTEND-TYPES-POLYMORPHIC
 	c.callResolved = make([]*pgtypes.DoltgresType, len(overload.params.paramTypes)+1)
 	hasPolymorphicParam := false
 	for i, param := range overload.params.paramTypes {
-		if param.IsPolymorphicType() {
+		if param.ID == pgtypes.AnyCompatible.ID {
+			// anycompatible arguments are cast to their common type before the function is called
+			c.callResolved[i] = overload.anyCompatibleType
+		} else if param.IsPolymorphicType() {
 			// resolve will ensure that the parameter types are valid, so we can just assign them here
 			hasPolymorphicParam = true
 			c.callResolved[i] = originalTypes[i]
@@ -156,7 +182,13 @@ func newCompiledFunctionInternal(
 	}
 	returnType := fn.GetReturn()
 	c.callResolved[len(c.callResolved)-1] = returnType
-	if returnType.IsPolymorphicType() {
+	if returnType.ID == pgtypes.AnyCompatible.ID {
+		if overload.anyCompatibleType == nil {
+			c.stashedErr = cerrors.Errorf("A result of type %s requires at least one input of type anycompatible.", returnType.String())
+			return c
+		}
+		c.callResolved[len(c.callResolved)-1] = overload.anyCompatibleType
+	} else if returnType.IsPolymorphicType() {
 		if hasPolymorphicParam {
 			c.callResolved[len(c.callResolved)-1] = c.resolvePolymorphicReturnType(overload.params.paramTypes, originalTypes, returnType)
 		} else if c.Name == "array_in" || c.Name == "array_recv" || c.Name == "enum_in" || c.Name == "enum_recv" || c.Name == "anyenum_in" || c.Name == "anyenum_recv" {
@@ -453,6 +485,8 @@ func (c *CompiledFunction) Eval(ctx *sql.Context, row sql.Row) (interface{}, err
 				//       processing, then we don't need this check here anymore.
 				if targetType.ID == pgtypes.AnyArray.ID {
 					targetType = c.resolvePolymorphicReturnType(targetParamTypes, exprTypes, targetType)
+				} else if targetType.ID == pgtypes.AnyCompatible.ID {
+					targetType = c.overload.anyCompatibleType
 				}
 			}
 
@@ -472,6 +506,45 @@ func (c *CompiledFunction) Eval(ctx *sql.Context, row sql.Row) (interface{}, err
 	return c.callFunction(ctx, args)
 }
 
+// validateVariadicArgument returns an error if the VARIADIC argument given as the last argument cannot be used with
+// the resolved function. Only functions that accept a variable number of "any" arguments (Function1N and Function2N)
+// support VARIADIC arguments, and the argument must be an array in a position that belongs to those arguments.
+func (c *CompiledFunction) validateVariadicArgument(fn FunctionInterface, argTypes []*pgtypes.DoltgresType) error {
+	if !fn.IsCVariadic() {
+		return cerrors.Errorf("VARIADIC arguments are not yet supported for function %s", c.Name)
+	}
+	variadicIdx := len(argTypes) - 1
+	if !argTypes[variadicIdx].IsArrayType() {
+		return cerrors.Errorf("VARIADIC argument must be an array")
+	}
+	declared := fn.GetInputParameterTypes()
+	if variadicIdx < len(declared)-1 || (variadicIdx == len(declared)-1 && declared[variadicIdx].ID != pgtypes.Any.ID) {
+		return ErrFunctionDoesNotExist.New(c.OverloadString(argTypes))
+	}
+	return nil
+}
+
+// expandVariadicArray replaces the trailing VARIADIC array argument with its elements, returning the new argument
+// values along with their types (followed by the return type, as with callResolved). A NULL array contributes no
+// values, and missing values up to minArgs are filled with NULL.
+func (c *CompiledFunction) expandVariadicArray(args []any, minArgs int) ([]any, []*pgtypes.DoltgresType) {
+	variadicIdx := len(args) - 1
+	elemType := c.originalTypes[variadicIdx].ArrayBaseType()
+	values := append(make([]any, 0, len(args)), args[:variadicIdx]...)
+	argTypes := append(make([]*pgtypes.DoltgresType, 0, len(args)+1), c.callResolved[:variadicIdx]...)
+	if arr, ok := args[variadicIdx].([]any); ok {
+		for _, elem := range arr {
+			values = append(values, elem)
+			argTypes = append(argTypes, elemType)
+		}
+	}
+	for len(values) < minArgs {
+		values = append(values, nil)
+		argTypes = append(argTypes, elemType)
+	}
+	return values, append(argTypes, c.callResolved[len(c.callResolved)-1])
+}
+
 // callFunction invokes the resolved overload with the given argument values.
 func (c *CompiledFunction) callFunction(ctx *sql.Context, args []any) (interface{}, error) {
 	switch f := c.overload.Function().(type) {
@@ -480,11 +553,19 @@ func (c *CompiledFunction) callFunction(ctx *sql.Context, args []any) (interface
 	case Function1:
 		return f.Callable(ctx, ([2]*pgtypes.DoltgresType)(c.callResolved), args[0])
 	case Function1N:
-		return f.Callable(ctx, c.callResolved, args[0], args[1:])
+		argTypes := c.callResolved
+		if c.variadicArray {
+			args, argTypes = c.expandVariadicArray(args, 1)
+		}
+		return f.Callable(ctx, argTypes, args[0], args[1:])
 	case Function2:
 		return f.Callable(ctx, ([3]*pgtypes.DoltgresType)(c.callResolved), args[0], args[1])
 	case Function2N:
-		return f.Callable(ctx, c.callResolved, args[0], args[1], args[2:])
+		argTypes := c.callResolved
+		if c.variadicArray {
+			args, argTypes = c.expandVariadicArray(args, 2)
+		}
+		return f.Callable(ctx, argTypes, args[0], args[1], args[2:])
 	case Function3:
 		return f.Callable(ctx, ([4]*pgtypes.DoltgresType)(c.callResolved), args[0], args[1], args[2])
 	case Function4:
@@ -777,9 +858,13 @@ func (c *CompiledFunction) typeCompatibleOverloads(ctx *sql.Context, fnOverloads
 		// Polymorphic parameters must be gathered so that we can later verify that they all have matching base types
 		var polymorphicParameters []*pgtypes.DoltgresType
 		var polymorphicTargets []*pgtypes.DoltgresType
+		// The anycompatible parameters form their own type variable, which only requires a common type
+		var anyCompatibleIndexes []int
 		for i := range argTypes {
 			paramType := overload.argTypes[i]
-			if paramType.IsValidForPolymorphicType(argTypes[i]) {
+			if paramType.ID == pgtypes.AnyCompatible.ID {
+				anyCompatibleIndexes = append(anyCompatibleIndexes, i)
+			} else if paramType.IsValidForPolymorphicType(argTypes[i]) {
 				overloadCasts[i] = casts.Cast{
 					ID:       id.NewCast(argTypes[i].ID, paramType.ID),
 					CastType: casts.CastType_Explicit,
@@ -809,13 +894,48 @@ func (c *CompiledFunction) typeCompatibleOverloads(ctx *sql.Context, fnOverloads
 			}
 		}
 
+		var anyCompatibleType *pgtypes.DoltgresType
+		if isConvertible && len(anyCompatibleIndexes) > 0 {
+			anyCompatibleType, err = c.anyCompatibleCasts(ctx, castsColl, argTypes, anyCompatibleIndexes, overloadCasts)
+			if err != nil {
+				return nil, err
+			}
+			isConvertible = anyCompatibleType != nil
+		}
+
 		if isConvertible && c.polymorphicTypesCompatible(polymorphicParameters, polymorphicTargets) {
-			compatible = append(compatible, overloadMatch{params: overload, casts: overloadCasts})
+			compatible = append(compatible, overloadMatch{params: overload, casts: overloadCasts, anyCompatibleType: anyCompatibleType})
 		}
 	}
 	return compatible, nil
 }
 
+// anyCompatibleCasts finds the common type of the arguments at the given indexes, which are all declared as
+// anycompatible, and fills in the implicit casts from each argument to that common type. Returns a nil type if the
+// arguments do not have a common type.
+// https://www.postgresql.org/docs/15/extend-type-system.html#EXTEND-TYPES-POLYMORPHIC
+func (*CompiledFunction) anyCompatibleCasts(ctx *sql.Context, castsColl *casts.Collection, argTypes []*pgtypes.DoltgresType, indexes []int, overloadCasts []casts.Cast) (*pgtypes.DoltgresType, error) {
+	compatibleTypes := make([]*pgtypes.DoltgresType, len(indexes))
+	for i, argIdx := range indexes {
+		compatibleTypes[i] = argTypes[argIdx]
+	}
+	commonType, _, err := FindCommonType(ctx, compatibleTypes)
+	if err != nil {
+		// Not having a common type only means that this overload isn't a match
+		return nil, nil
+	}
+	for _, argIdx := range indexes {
+		overloadCasts[argIdx], err = castsColl.GetImplicitCast(ctx, argTypes[argIdx], commonType)
+		if err != nil {
+			return nil, err
+		}
+		if !overloadCasts[argIdx].ID.IsValid() {
+			return nil, nil
+		}
+	}
+	return commonType, nil
+}
+
 // closestTypeMatches returns the set of overload candidates that have the most exact type matches for the arg types
 // provided.
 func (*CompiledFunction) closestTypeMatches(argTypes []*pgtypes.DoltgresType, candidates []overloadMatch) []overloadMatch {
@@ -33,15 +33,34 @@ type WindowOnlyFunction interface {
 // CompiledWindowFunction is an expression that represents a fully-analyzed PostgreSQL window-only function.
 type CompiledWindowFunction struct {
 	*CompiledFunction
-	windowId sql.ColumnId
-	window   *sql.WindowDefinition
+	windowId  sql.ColumnId
+	window    *sql.WindowDefinition
+	frameSpec *WindowFrameSpec
 }
 
 var _ WindowOnlyFunction = (*CompiledWindowFunction)(nil)
 
-// NewCompiledWindowFunction returns a newly compiled function.
+// frameSpecWindowFunction is a sql.WindowFunction that evaluates its own frame, and therefore accepts frames that
+// GMS cannot represent. This is implemented by every window function that embeds WindowFramerState.
+type frameSpecWindowFunction interface {
+	sql.WindowFunction
+	SetFrameSpec(spec *WindowFrameSpec)
+}
+
+// NewCompiledWindowFunction returns a newly compiled function. A trailing WindowFrameSpec argument (added by the AST
+// conversion for frames that GMS cannot represent) is not a real argument, so it's removed before the overload is
+// resolved.
 func NewCompiledWindowFunction(ctx *sql.Context, name string, args []sql.Expression, functions *Overloads) *CompiledWindowFunction {
-	return newCompiledWindowFunctionInternal(ctx, name, args, functions, functions.overloadsForParams(len(args)))
+	var frameSpec *WindowFrameSpec
+	if len(args) > 0 {
+		if spec, ok := args[len(args)-1].(*WindowFrameSpec); ok {
+			frameSpec = spec
+			args = args[:len(args)-1]
+		}
+	}
+	c := newCompiledWindowFunctionInternal(ctx, name, args, functions, functions.overloadsForParams(len(args)))
+	c.frameSpec = frameSpec
+	return c
 }
 
 // newCompiledWindowFunctionInternal is called internally, which skips steps that may have already been processed.
@@ -97,6 +116,7 @@ func (c *CompiledWindowFunction) WithChildren(ctx *sql.Context, children ...sql.
 	// WithId/WithWindow state that was already bound onto c.
 	nc.windowId = c.windowId
 	nc.window = c.window
+	nc.frameSpec = c.frameSpec
 	if len(children) > numArgs && c.window != nil {
 		w, err := c.window.FromExpressions(ctx, children[numArgs:])
 		if err != nil {
@@ -170,7 +190,17 @@ func (c *CompiledWindowFunction) NewWindowFunction(ctx *sql.Context) (sql.Window
 	if err != nil {
 		return nil, err
 	}
-	return newWindowFunc(args, c.window)
+	args = c.castArgumentsToParameters(args)
+	wf, err := newWindowFunc(args, c.window)
+	if err != nil || c.frameSpec == nil {
+		return wf, err
+	}
+	framedWf, ok := wf.(frameSpecWindowFunction)
+	if !ok {
+		return nil, cerrors.Errorf("%s() does not support the window frame %s", c.Name, c.frameSpec.String())
+	}
+	framedWf.SetFrameSpec(c.frameSpec)
+	return framedWf, nil
 }
 
 // WithWindow implements the interface sql.WindowAdaptableExpression.
@@ -195,5 +225,8 @@ func (c *CompiledWindowFunction) String() string {
 	if c.window != nil {
 		s += " " + c.window.String()
 	}
+	if c.frameSpec != nil {
+		s += " " + c.frameSpec.String()
+	}
 	return s
 }
@@ -690,6 +690,25 @@ func (f Func1Aggregate) NewWindowFunc() NewWindowFunctionFn {
 	return f.NewAggWindowFunc
 }
 
+// Func2Aggregate is a function that takes two parameters and is an aggregate function.
+type Func2Aggregate struct {
+	Function2
+	NewAggBuffer func([]sql.Expression) (sql.AggregationBuffer, error)
+	// NewAggWindowFunc optionally builds this aggregate's runtime sql.WindowFunction for use within an
+	// OVER(...) clause. Leave nil if this aggregate does not yet support window use.
+	NewAggWindowFunc NewWindowFunctionFn
+}
+
+var _ AggregateFunctionInterface = Func2Aggregate{}
+
+func (f Func2Aggregate) NewBuffer(exprs []sql.Expression) (sql.AggregationBuffer, error) {
+	return f.NewAggBuffer(exprs)
+}
+
+func (f Func2Aggregate) NewWindowFunc() NewWindowFunctionFn {
+	return f.NewAggWindowFunc
+}
+
 // Func0Window is a PostgreSQL function that takes no parameters and may only be used as a window function
 // (within an OVER(...) clause), such as row_number().
 type Func0Window struct {
@@ -710,6 +729,9 @@ func (f Func0Window) NewWindowFunc() NewWindowFunctionFn {
 type Func1Window struct {
 	Function1
 	NewWinFunc NewWindowFunctionFn
+	// FrameAware is set when the window function evaluates its own frame through WindowFramerState, which allows it
+	// to be used with frames that GMS cannot represent, such as GROUPS frames and EXCLUDE clauses.
+	FrameAware bool
 }
 
 var _ WindowFunctionInterface = Func1Window{}
@@ -723,6 +745,9 @@ func (f Func1Window) NewWindowFunc() NewWindowFunctionFn {
 type Func2Window struct {
 	Function2
 	NewWinFunc NewWindowFunctionFn
+	// FrameAware is set when the window function evaluates its own frame through WindowFramerState, which allows it
+	// to be used with frames that GMS cannot represent, such as GROUPS frames and EXCLUDE clauses.
+	FrameAware bool
 }
 
 var _ WindowFunctionInterface = Func2Window{}
@@ -730,3 +755,16 @@ var _ WindowFunctionInterface = Func2Window{}
 func (f Func2Window) NewWindowFunc() NewWindowFunctionFn {
 	return f.NewWinFunc
 }
+
+// Func3Window is a PostgreSQL function that takes three parameters and may only be used as a window function
+// (within an OVER(...) clause), such as lag() with a default value.
+type Func3Window struct {
+	Function3
+	NewWinFunc NewWindowFunctionFn
+}
+
+var _ WindowFunctionInterface = Func3Window{}
+
+func (f Func3Window) NewWindowFunc() NewWindowFunctionFn {
+	return f.NewWinFunc
+}
@@ -0,0 +1,175 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	"context"
+	"strings"
+
+	cerrors "github.com/cockroachdb/errors"
+	"github.com/dolthub/go-mysql-server/sql"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+)
+
+// NamedArgument marks an argument of a function call that was given by the name of its parameter, such as
+// make_interval(days => 10). Before the function's overload is resolved, such arguments are moved to the position of
+// their parameter. It otherwise evaluates exactly like its child.
+type NamedArgument struct {
+	Name  string
+	child sql.Expression
+}
+
+// NamedParameter is a parameter of a built-in function, which allows calls to give its argument by name. When Default
+// is not nil, then the parameter may be omitted from a call, and the default is used in its place.
+type NamedParameter struct {
+	Name    string
+	Default sql.Expression
+}
+
+var _ sql.Expression = (*NamedArgument)(nil)
+var _ vitess.Injectable = (*NamedArgument)(nil)
+
+// Children implements the sql.Expression interface.
+func (n *NamedArgument) Children() []sql.Expression {
+	if n.child == nil {
+		return nil
+	}
+	return []sql.Expression{n.child}
+}
+
+// Eval implements the sql.Expression interface.
+func (n *NamedArgument) Eval(ctx *sql.Context, row sql.Row) (any, error) {
+	return n.child.Eval(ctx, row)
+}
+
+// IsNullable implements the sql.Expression interface.
+func (n *NamedArgument) IsNullable(ctx *sql.Context) bool {
+	return n.child.IsNullable(ctx)
+}
+
+// Resolved implements the sql.Expression interface.
+func (n *NamedArgument) Resolved() bool {
+	return n.child != nil && n.child.Resolved()
+}
+
+// String implements the sql.Expression interface.
+func (n *NamedArgument) String() string {
+	return n.Name + " => " + n.child.String()
+}
+
+// Type implements the sql.Expression interface.
+func (n *NamedArgument) Type(ctx *sql.Context) sql.Type {
+	return n.child.Type(ctx)
+}
+
+// WithChildren implements the sql.Expression interface.
+func (n *NamedArgument) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(n, len(children), 1)
+	}
+	return &NamedArgument{Name: n.Name, child: children[0]}, nil
+}
+
+// WithResolvedChildren implements the vitess.Injectable interface.
+func (n *NamedArgument) WithResolvedChildren(ctx context.Context, children []any) (any, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(n, len(children), 1)
+	}
+	child, ok := children[0].(sql.Expression)
+	if !ok {
+		return nil, cerrors.Errorf("expected vitess child to be an expression but has type `%T`", children[0])
+	}
+	return &NamedArgument{Name: n.Name, child: child}, nil
+}
+
+// positionalArguments returns the arguments of a call to the named function in the order of its parameters, moving
+// each NamedArgument to the position of the parameter with its name. Parameters that were not given an argument, but
+// precede one that was, are given their default. If no arguments were given by name, then the arguments are returned
+// as-is.
+func positionalArguments(ctx *sql.Context, name string, args []sql.Expression) ([]sql.Expression, error) {
+	firstNamed := len(args)
+	for i, arg := range args {
+		if _, ok := arg.(*NamedArgument); ok {
+			firstNamed = i
+			break
+		}
+	}
+	if firstNamed == len(args) {
+		return args, nil
+	}
+	params := ParameterCatalog[strings.ToLower(name)]
+	if firstNamed >= len(params) {
+		return nil, ErrFunctionDoesNotExist.New(namedArgumentsString(ctx, name, args))
+	}
+	positional := make([]sql.Expression, len(params))
+	copy(positional, args[:firstNamed])
+	lastIndex := firstNamed - 1
+	for _, arg := range args[firstNamed:] {
+		namedArg, ok := arg.(*NamedArgument)
+		if !ok {
+			return nil, cerrors.Errorf("positional argument cannot follow named argument")
+		}
+		paramIndex := -1
+		for i, param := range params {
+			if param.Name == namedArg.Name {
+				paramIndex = i
+				break
+			}
+		}
+		if paramIndex < 0 {
+			return nil, ErrFunctionDoesNotExist.New(namedArgumentsString(ctx, name, args))
+		}
+		if positional[paramIndex] != nil {
+			if paramIndex >= firstNamed {
+				return nil, cerrors.Errorf(`argument name "%s" used more than once`, namedArg.Name)
+			}
+			// The parameter was already given an argument by position
+			return nil, ErrFunctionDoesNotExist.New(namedArgumentsString(ctx, name, args))
+		}
+		positional[paramIndex] = namedArg.child
+		if paramIndex > lastIndex {
+			lastIndex = paramIndex
+		}
+	}
+	positional = positional[:lastIndex+1]
+	for i := range positional {
+		if positional[i] != nil {
+			continue
+		}
+		if params[i].Default == nil {
+			return nil, ErrFunctionDoesNotExist.New(namedArgumentsString(ctx, name, args))
+		}
+		positional[i] = params[i].Default
+	}
+	return positional, nil
+}
+
+// namedArgumentsString returns the call to the named function with the given arguments, in the same form as
+// CompiledFunction.OverloadString, with each NamedArgument preceded by its name.
+func namedArgumentsString(ctx *sql.Context, name string, args []sql.Expression) string {
+	sb := strings.Builder{}
+	sb.WriteString(name + "(")
+	for i, arg := range args {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		if namedArg, ok := arg.(*NamedArgument); ok {
+			sb.WriteString(namedArg.Name + " => ")
+		}
+		sb.WriteString(arg.Type(ctx).String())
+	}
+	sb.WriteString(")")
+	return sb.String()
+}
@@ -56,6 +56,7 @@ const (
 	Operator_BinaryNegInnerProduct                     // <#>
 	Operator_BinaryJaccardDistance                     // <%>
 	Operator_BinaryHammingDistance                     // <~>
+	Operator_BinaryOverlap                             // &&
 	Operator_UnaryPlus                                 // +
 	Operator_UnaryMinus                                // -
 	// NOTE: Any new operator should also be added to Operator.String() and GetOperatorFromString() functions.
@@ -217,6 +218,8 @@ func (o Operator) String() string {
 		return "<%>"
 	case Operator_BinaryHammingDistance:
 		return "<~>"
+	case Operator_BinaryOverlap:
+		return "&&"
 	default:
 		return "unknown operator"
 	}
@@ -306,6 +309,8 @@ func GetOperatorFromString(op string) (Operator, error) {
 		return Operator_BinaryJaccardDistance, nil
 	case "<~>":
 		return Operator_BinaryHammingDistance, nil
+	case "&&":
+		return Operator_BinaryOverlap, nil
 	default:
 		return 0, errors.Errorf("unhandled Operator `%s`", op)
 	}
@@ -203,6 +203,9 @@ func (o *Overload) coalesceVariadicValues(returnValues []any) []any {
 type overloadMatch struct {
 	params Overload
 	casts  []casts.Cast
+	// anyCompatibleType is the common type that all anycompatible arguments are cast to. This is only set when the
+	// overload declares at least one anycompatible parameter.
+	anyCompatibleType *pgtypes.DoltgresType
 }
 
 // Valid returns whether this overload is valid (has a callable function)
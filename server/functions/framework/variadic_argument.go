@@ -0,0 +1,86 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	"context"
+
+	cerrors "github.com/cockroachdb/errors"
+	"github.com/dolthub/go-mysql-server/sql"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+)
+
+// VariadicArgument marks the last argument of a function call that was given with the VARIADIC keyword, such as
+// format('%s %s', VARIADIC ARRAY['a', 'b']). The argument is an array that supplies all of the values of the
+// function's variadic parameter, rather than a single value. It otherwise evaluates exactly like its child.
+type VariadicArgument struct {
+	child sql.Expression
+}
+
+var _ sql.Expression = (*VariadicArgument)(nil)
+var _ vitess.Injectable = (*VariadicArgument)(nil)
+
+// Children implements the sql.Expression interface.
+func (v *VariadicArgument) Children() []sql.Expression {
+	if v.child == nil {
+		return nil
+	}
+	return []sql.Expression{v.child}
+}
+
+// Eval implements the sql.Expression interface.
+func (v *VariadicArgument) Eval(ctx *sql.Context, row sql.Row) (any, error) {
+	return v.child.Eval(ctx, row)
+}
+
+// IsNullable implements the sql.Expression interface.
+func (v *VariadicArgument) IsNullable(ctx *sql.Context) bool {
+	return v.child.IsNullable(ctx)
+}
+
+// Resolved implements the sql.Expression interface.
+func (v *VariadicArgument) Resolved() bool {
+	return v.child != nil && v.child.Resolved()
+}
+
+// String implements the sql.Expression interface.
+func (v *VariadicArgument) String() string {
+	return "VARIADIC " + v.child.String()
+}
+
+// Type implements the sql.Expression interface.
+func (v *VariadicArgument) Type(ctx *sql.Context) sql.Type {
+	return v.child.Type(ctx)
+}
+
+// WithChildren implements the sql.Expression interface.
+func (v *VariadicArgument) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(v, len(children), 1)
+	}
+	return &VariadicArgument{child: children[0]}, nil
+}
+
+// WithResolvedChildren implements the vitess.Injectable interface.
+func (v *VariadicArgument) WithResolvedChildren(ctx context.Context, children []any) (any, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(v, len(children), 1)
+	}
+	child, ok := children[0].(sql.Expression)
+	if !ok {
+		return nil, cerrors.Errorf("expected vitess child to be an expression but has type `%T`", children[0])
+	}
+	return &VariadicArgument{child: child}, nil
+}
@@ -0,0 +1,525 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+
+	cerrors "github.com/cockroachdb/errors"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// WindowFrameMode is the unit of a window frame's bounds.
+type WindowFrameMode uint8
+
+const (
+	WindowFrameMode_Range WindowFrameMode = iota
+	WindowFrameMode_Rows
+	WindowFrameMode_Groups
+)
+
+// WindowFrameBoundType is the type of a single window frame bound.
+type WindowFrameBoundType uint8
+
+const (
+	WindowFrameBound_UnboundedPreceding WindowFrameBoundType = iota
+	WindowFrameBound_OffsetPreceding
+	WindowFrameBound_CurrentRow
+	WindowFrameBound_OffsetFollowing
+	WindowFrameBound_UnboundedFollowing
+)
+
+// WindowFrameExclusion is the EXCLUDE option of a window frame.
+type WindowFrameExclusion uint8
+
+const (
+	WindowFrameExclude_NoOthers WindowFrameExclusion = iota
+	WindowFrameExclude_CurrentRow
+	WindowFrameExclude_Group
+	WindowFrameExclude_Ties
+)
+
+// WindowFrameBound is a single bound of a WindowFrameSpec. Offset is only used by the offset bound types of ROWS and
+// GROUPS frames, while OffsetExpr is only used by the offset bound types of RANGE frames, as their offsets are values
+// that are added to or subtracted from the ORDER BY value of each row.
+type WindowFrameBound struct {
+	Type       WindowFrameBoundType
+	Offset     int64
+	OffsetExpr sql.Expression
+}
+
+// WindowFrameSpec describes a window frame that GMS's sql.WindowFrame cannot represent, which is any frame in GROUPS
+// mode or with an EXCLUDE clause. The AST conversion appends it as a trailing argument to window-only functions that
+// evaluate their own frame (see Func1Window.FrameAware), and NewCompiledWindowFunction removes it before overload
+// resolution. The window itself is given no frame, so these functions always receive their entire partition and use
+// WindowFramerState.FrameRows to find the rows of each frame.
+type WindowFrameSpec struct {
+	Mode      WindowFrameMode
+	Start     WindowFrameBound
+	End       WindowFrameBound
+	Exclusion WindowFrameExclusion
+}
+
+var _ sql.Expression = (*WindowFrameSpec)(nil)
+var _ vitess.Injectable = (*WindowFrameSpec)(nil)
+
+// Children implements the sql.Expression interface.
+func (w *WindowFrameSpec) Children() []sql.Expression {
+	var children []sql.Expression
+	for _, bound := range w.rangeOffsetBounds() {
+		children = append(children, bound.OffsetExpr)
+	}
+	return children
+}
+
+// Eval implements the sql.Expression interface.
+func (w *WindowFrameSpec) Eval(ctx *sql.Context, row sql.Row) (any, error) {
+	return nil, cerrors.New("window frame specifications may only be used within an OVER clause")
+}
+
+// IsNullable implements the sql.Expression interface.
+func (w *WindowFrameSpec) IsNullable(ctx *sql.Context) bool {
+	return false
+}
+
+// Resolved implements the sql.Expression interface.
+func (w *WindowFrameSpec) Resolved() bool {
+	return true
+}
+
+// String implements the sql.Expression interface.
+func (w *WindowFrameSpec) String() string {
+	sb := strings.Builder{}
+	switch w.Mode {
+	case WindowFrameMode_Range:
+		sb.WriteString("RANGE BETWEEN ")
+	case WindowFrameMode_Rows:
+		sb.WriteString("ROWS BETWEEN ")
+	case WindowFrameMode_Groups:
+		sb.WriteString("GROUPS BETWEEN ")
+	}
+	sb.WriteString(w.Start.String())
+	sb.WriteString(" AND ")
+	sb.WriteString(w.End.String())
+	switch w.Exclusion {
+	case WindowFrameExclude_CurrentRow:
+		sb.WriteString(" EXCLUDE CURRENT ROW")
+	case WindowFrameExclude_Group:
+		sb.WriteString(" EXCLUDE GROUP")
+	case WindowFrameExclude_Ties:
+		sb.WriteString(" EXCLUDE TIES")
+	}
+	return sb.String()
+}
+
+// Type implements the sql.Expression interface.
+func (w *WindowFrameSpec) Type(ctx *sql.Context) sql.Type {
+	return pgtypes.Internal
+}
+
+// WithChildren implements the sql.Expression interface.
+func (w *WindowFrameSpec) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	nw := *w
+	bounds := nw.rangeOffsetBounds()
+	if len(children) != len(bounds) {
+		return nil, sql.ErrInvalidChildrenNumber.New(w, len(children), len(bounds))
+	}
+	for i, bound := range bounds {
+		bound.OffsetExpr = children[i]
+	}
+	return &nw, nil
+}
+
+// WithResolvedChildren implements the vitess.Injectable interface. The children are the offsets of the RANGE frame's
+// bounds, in the order that the bounds appear.
+func (w *WindowFrameSpec) WithResolvedChildren(ctx context.Context, children []any) (any, error) {
+	nw := *w
+	bounds := nw.rangeOffsetBounds()
+	if len(children) != len(bounds) {
+		return nil, sql.ErrInvalidChildrenNumber.New(w, len(children), len(bounds))
+	}
+	for i, bound := range bounds {
+		offsetExpr, ok := children[i].(sql.Expression)
+		if !ok {
+			return nil, cerrors.Errorf("expected vitess child to be an expression but has type `%T`", children[i])
+		}
+		bound.OffsetExpr = offsetExpr
+	}
+	return &nw, nil
+}
+
+// rangeOffsetBounds returns the bounds of a RANGE frame that have an offset, which is evaluated by each partition.
+// Returns nothing for ROWS and GROUPS frames, as their offsets are always integer constants.
+func (w *WindowFrameSpec) rangeOffsetBounds() []*WindowFrameBound {
+	if w.Mode != WindowFrameMode_Range {
+		return nil
+	}
+	var bounds []*WindowFrameBound
+	for _, bound := range []*WindowFrameBound{&w.Start, &w.End} {
+		if bound.Type == WindowFrameBound_OffsetPreceding || bound.Type == WindowFrameBound_OffsetFollowing {
+			bounds = append(bounds, bound)
+		}
+	}
+	return bounds
+}
+
+// String returns the bound as it would appear in a frame clause.
+func (b WindowFrameBound) String() string {
+	switch b.Type {
+	case WindowFrameBound_UnboundedPreceding:
+		return "UNBOUNDED PRECEDING"
+	case WindowFrameBound_OffsetPreceding:
+		return b.offsetString() + " PRECEDING"
+	case WindowFrameBound_CurrentRow:
+		return "CURRENT ROW"
+	case WindowFrameBound_OffsetFollowing:
+		return b.offsetString() + " FOLLOWING"
+	case WindowFrameBound_UnboundedFollowing:
+		return "UNBOUNDED FOLLOWING"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// offsetString returns the offset of the bound as it would appear in a frame clause.
+func (b WindowFrameBound) offsetString() string {
+	if b.OffsetExpr != nil {
+		return b.OffsetExpr.String()
+	}
+	return strconv.FormatInt(b.Offset, 10)
+}
+
+// windowPeerGroups holds the peer groups of a single partition, which are the runs of rows that share the same values
+// for the window's ORDER BY expressions. Without an ORDER BY, every row in the partition is a peer of every other row.
+type windowPeerGroups struct {
+	// starts contains the index of the first row of each peer group, followed by the end of the partition.
+	starts []int
+}
+
+// newWindowPeerGroups computes the peer groups of the given partition.
+func newWindowPeerGroups(ctx *sql.Context, partition sql.WindowInterval, buf sql.WindowBuffer, orderBy []sql.Expression) (windowPeerGroups, error) {
+	starts := make([]int, 0, 8)
+	if partition.End > partition.Start {
+		starts = append(starts, partition.Start)
+	}
+	for i := partition.Start + 1; i < partition.End; i++ {
+		isPeer, err := windowRowsArePeers(ctx, orderBy, buf[i-1], buf[i])
+		if err != nil {
+			return windowPeerGroups{}, err
+		}
+		if !isPeer {
+			starts = append(starts, i)
+		}
+	}
+	starts = append(starts, partition.End)
+	return windowPeerGroups{starts: starts}, nil
+}
+
+// groupOf returns the index of the peer group that contains the given row.
+func (g windowPeerGroups) groupOf(row int) int {
+	// starts is sorted, so we find the last group that begins at or before the row
+	return sort.SearchInts(g.starts, row+1) - 1
+}
+
+// count returns the number of peer groups.
+func (g windowPeerGroups) count() int {
+	return len(g.starts) - 1
+}
+
+// windowRowsArePeers returns whether the two rows have equal values for every ORDER BY expression.
+func windowRowsArePeers(ctx *sql.Context, orderBy []sql.Expression, left sql.Row, right sql.Row) (bool, error) {
+	for _, expr := range orderBy {
+		leftVal, err := expr.Eval(ctx, left)
+		if err != nil {
+			return false, err
+		}
+		rightVal, err := expr.Eval(ctx, right)
+		if err != nil {
+			return false, err
+		}
+		cmp, err := expr.Type(ctx).Compare(ctx, leftVal, rightVal)
+		if err != nil {
+			return false, err
+		}
+		if cmp != 0 {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// WindowFrameRows are the rows that make up the frame of a single row. Rows are referenced by their index within the
+// window buffer, and are returned in the order of the window.
+type WindowFrameRows struct {
+	Start int
+	End   int
+	// excludeStart and excludeEnd are the rows removed from the frame by an EXCLUDE clause, clipped to the frame.
+	excludeStart int
+	excludeEnd   int
+	// keepCurrent is set by EXCLUDE TIES, which excludes the peers of the current row but not the row itself.
+	keepCurrent bool
+	current     int
+}
+
+// Nth returns the nth (zero-based) row of the frame, or false if the frame has fewer rows.
+func (r WindowFrameRows) Nth(n int) (int, bool) {
+	for idx := r.Start; idx < r.End; {
+		if idx == r.excludeStart && r.excludeEnd > r.excludeStart {
+			if r.keepCurrent {
+				if n == 0 {
+					return r.current, true
+				}
+				n--
+			}
+			idx = r.excludeEnd
+			continue
+		}
+		if n == 0 {
+			return idx, true
+		}
+		n--
+		idx++
+	}
+	return 0, false
+}
+
+// Last returns the last row of the frame, or false if the frame is empty.
+func (r WindowFrameRows) Last() (int, bool) {
+	idx := r.End - 1
+	if idx == r.excludeEnd-1 && r.excludeEnd > r.excludeStart {
+		if r.keepCurrent {
+			return r.current, true
+		}
+		idx = r.excludeStart - 1
+	}
+	if idx < r.Start {
+		return 0, false
+	}
+	return idx, true
+}
+
+// rows returns the frame of the given row within its partition.
+func (w *WindowFrameSpec) rows(partition sql.WindowInterval, groups windowPeerGroups, ranges windowRangeBounds, current int) WindowFrameRows {
+	group := groups.groupOf(current)
+	start := w.boundRow(w.Start, false, partition, groups, current, group)
+	if ranges.starts != nil {
+		start = ranges.starts[current-partition.Start]
+	}
+	end := w.boundRow(w.End, true, partition, groups, current, group)
+	if ranges.ends != nil {
+		end = ranges.ends[current-partition.Start]
+	}
+	if end < start {
+		end = start
+	}
+	frame := WindowFrameRows{Start: start, End: end, current: current}
+	switch w.Exclusion {
+	case WindowFrameExclude_CurrentRow:
+		frame.excludeStart, frame.excludeEnd = current, current+1
+	case WindowFrameExclude_Group:
+		frame.excludeStart, frame.excludeEnd = groups.starts[group], groups.starts[group+1]
+	case WindowFrameExclude_Ties:
+		frame.excludeStart, frame.excludeEnd = groups.starts[group], groups.starts[group+1]
+		frame.keepCurrent = current >= start && current < end
+	}
+	// The exclusion is clipped to the frame, so that iteration only needs to check for its first row
+	frame.excludeStart = max(frame.excludeStart, start)
+	frame.excludeEnd = min(frame.excludeEnd, end)
+	if frame.excludeEnd <= frame.excludeStart {
+		frame.excludeStart, frame.excludeEnd = 0, 0
+		frame.keepCurrent = false
+	}
+	return frame
+}
+
+// boundRow returns the buffer index of the given bound. Start bounds return the first row in the frame, while end
+// bounds return the row after the last row in the frame, and both are clamped to the partition.
+func (w *WindowFrameSpec) boundRow(bound WindowFrameBound, isEnd bool, partition sql.WindowInterval, groups windowPeerGroups, current int, group int) int {
+	clamp := func(idx int) int {
+		return min(max(idx, partition.Start), partition.End)
+	}
+	groupStart := func(g int) int {
+		return groups.starts[min(max(g, 0), groups.count())]
+	}
+	switch bound.Type {
+	case WindowFrameBound_UnboundedPreceding:
+		return partition.Start
+	case WindowFrameBound_UnboundedFollowing:
+		return partition.End
+	}
+	var offset int64
+	switch bound.Type {
+	case WindowFrameBound_OffsetPreceding:
+		offset = -bound.Offset
+	case WindowFrameBound_OffsetFollowing:
+		offset = bound.Offset
+	}
+	// Offsets are bounded to the partition size, so that adding them to an index cannot overflow
+	offset = min(max(offset, int64(partition.Start-partition.End)), int64(partition.End-partition.Start))
+	endAdjust := 0
+	if isEnd {
+		endAdjust = 1
+	}
+	switch w.Mode {
+	case WindowFrameMode_Rows:
+		return clamp(current + int(offset) + endAdjust)
+	case WindowFrameMode_Groups:
+		return groupStart(group + int(offset) + endAdjust)
+	default:
+		// RANGE frames with offsets are found by newWindowRangeBounds, so only CURRENT ROW remains, which refers to the
+		// current row's peer group just like GROUPS does
+		return groupStart(group + endAdjust)
+	}
+}
+
+// windowRangeBounds holds the offset bounds of a RANGE frame for every row of a single partition, indexed by the row's
+// position within the partition. Bounds without an offset are nil, as boundRow finds those.
+type windowRangeBounds struct {
+	starts []int
+	ends   []int
+}
+
+// newWindowRangeBounds finds the offset bounds of a RANGE frame for every row of the given partition. These are found
+// by comparing the ORDER BY value of each row against the current row's value plus or minus the offset, using the
+// operators of the ORDER BY column's type, so that offsets such as intervals work just as they do in Postgres.
+func (w *WindowFrameSpec) newWindowRangeBounds(ctx *sql.Context, partition sql.WindowInterval, buf sql.WindowBuffer, groups windowPeerGroups, orderBy sql.SortConditions) (windowRangeBounds, error) {
+	if len(w.rangeOffsetBounds()) == 0 {
+		return windowRangeBounds{}, nil
+	}
+	if len(orderBy) != 1 {
+		return windowRangeBounds{}, cerrors.Errorf("RANGE with offset PRECEDING/FOLLOWING requires exactly one ORDER BY column")
+	}
+	values := make([]any, partition.End-partition.Start)
+	// NULLs are sorted together at one end of the partition, and are never within an offset of a non-NULL value
+	nonNullStart, nonNullEnd := partition.End, partition.End
+	for i := partition.Start; i < partition.End; i++ {
+		val, err := orderBy[0].Expr.Eval(ctx, buf[i])
+		if err != nil {
+			return windowRangeBounds{}, err
+		}
+		values[i-partition.Start] = val
+		if val != nil {
+			nonNullStart = min(nonNullStart, i)
+			nonNullEnd = i + 1
+		}
+	}
+	var ranges windowRangeBounds
+	var err error
+	if w.Start.Type == WindowFrameBound_OffsetPreceding || w.Start.Type == WindowFrameBound_OffsetFollowing {
+		ranges.starts, err = w.Start.rangeRows(ctx, false, orderBy[0], partition, groups, values, nonNullStart, nonNullEnd)
+		if err != nil {
+			return windowRangeBounds{}, err
+		}
+	}
+	if w.End.Type == WindowFrameBound_OffsetPreceding || w.End.Type == WindowFrameBound_OffsetFollowing {
+		ranges.ends, err = w.End.rangeRows(ctx, true, orderBy[0], partition, groups, values, nonNullStart, nonNullEnd)
+		if err != nil {
+			return windowRangeBounds{}, err
+		}
+	}
+	return ranges, nil
+}
+
+// rangeRows returns the buffer index of this RANGE offset bound for every row of the partition. Start bounds return the
+// first row whose value is within the offset, while end bounds return the row after the last row within the offset.
+// Rows with a NULL value are only within range of each other, so their bounds are those of their peer group.
+func (b WindowFrameBound) rangeRows(ctx *sql.Context, isEnd bool, orderBy sql.SortCondition, partition sql.WindowInterval, groups windowPeerGroups, values []any, nonNullStart int, nonNullEnd int) ([]int, error) {
+	position := "starting"
+	if isEnd {
+		position = "ending"
+	}
+	offset, err := b.OffsetExpr.Eval(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	if offset == nil {
+		return nil, cerrors.Errorf("frame %s offset must not be null", position)
+	}
+	// PRECEDING always moves towards the start of the partition, which holds the larger values when descending
+	preceding := b.Type == WindowFrameBound_OffsetPreceding
+	descending := orderBy.Order == sql.Descending
+	arithmeticOp := Operator_BinaryPlus
+	if preceding != descending {
+		arithmeticOp = Operator_BinaryMinus
+	}
+	var comparisonOp Operator
+	switch {
+	case !isEnd && !descending:
+		comparisonOp = Operator_BinaryGreaterOrEqual
+	case !isEnd && descending:
+		comparisonOp = Operator_BinaryLessOrEqual
+	case isEnd && !descending:
+		comparisonOp = Operator_BinaryGreaterThan
+	default:
+		comparisonOp = Operator_BinaryLessThan
+	}
+	orderByType := orderBy.Expr.Type(ctx)
+	target := GetBinaryFunction(arithmeticOp).Compile(ctx, "internal_binary_operator_func_"+arithmeticOp.String(),
+		expression.NewGetField(0, orderByType, "value", true), b.OffsetExpr)
+	if target == nil || target.StashedError() != nil {
+		return nil, cerrors.Errorf("RANGE with offset PRECEDING/FOLLOWING is not supported for column type %s and offset type %s",
+			orderByType.String(), b.OffsetExpr.Type(ctx).String())
+	}
+	inRange := GetBinaryFunction(comparisonOp).Compile(ctx, "internal_binary_operator_func_"+comparisonOp.String(),
+		expression.NewGetField(0, orderByType, "value", true), expression.NewGetField(1, target.Type(ctx), "target", true))
+	if inRange == nil || inRange.StashedError() != nil {
+		return nil, cerrors.Errorf("RANGE with offset PRECEDING/FOLLOWING is not supported for column type %s and offset type %s",
+			orderByType.String(), b.OffsetExpr.Type(ctx).String())
+	}
+	rows := make([]int, len(values))
+	for i := range values {
+		current := partition.Start + i
+		if values[i] == nil {
+			group := groups.groupOf(current)
+			if isEnd {
+				rows[i] = groups.starts[group+1]
+			} else {
+				rows[i] = groups.starts[group]
+			}
+			continue
+		}
+		targetValue, err := target.Eval(ctx, sql.Row{values[i]})
+		if err != nil || targetValue == nil {
+			// The target is only invalid when it's out of the range of its type, in which case no value is beyond it
+			if preceding {
+				rows[i] = nonNullStart
+			} else {
+				rows[i] = nonNullEnd
+			}
+			continue
+		}
+		var searchErr error
+		rows[i] = nonNullStart + sort.Search(nonNullEnd-nonNullStart, func(j int) bool {
+			result, err := inRange.Eval(ctx, sql.Row{values[nonNullStart+j-partition.Start], targetValue})
+			if err != nil {
+				searchErr = err
+				return true
+			}
+			isInRange, _ := result.(bool)
+			return isInRange
+		})
+		if searchErr != nil {
+			return nil, searchErr
+		}
+	}
+	return rows, nil
+}
@@ -25,9 +25,19 @@ import (
 // was given; with no explicit frame, DefaultFramer supplies Postgres's default frame: RANGE UNBOUNDED
 // PRECEDING TO CURRENT ROW when the window has an ORDER BY (so rows tied on the ORDER BY value share a
 // peer group), otherwise the whole partition.
+//
+// Frames that GMS cannot represent (GROUPS frames and EXCLUDE clauses) are given as a WindowFrameSpec
+// instead, in which case the function receives its whole partition and finds each row's frame using
+// FrameRows.
 type WindowFramerState struct {
 	framer sql.WindowFramer
 	window *sql.WindowDefinition
+	spec   *WindowFrameSpec
+	// partition, groups, ranges, and pos are only tracked when spec is set
+	partition sql.WindowInterval
+	groups    windowPeerGroups
+	ranges    windowRangeBounds
+	pos       int
 }
 
 // BindFramer builds and stores this window's framer, if it declared an explicit frame clause; with no
@@ -45,15 +55,55 @@ func (s *WindowFramerState) BindFramer(window *sql.WindowDefinition) error {
 	return nil
 }
 
+// SetFrameSpec sets the frame that is used in place of the window's own frame, which is left unset in this
+// case.
+func (s *WindowFramerState) SetFrameSpec(spec *WindowFrameSpec) {
+	s.spec = spec
+}
+
 // StartPartition implements the sql.WindowFunction interface.
 func (s *WindowFramerState) StartPartition(ctx *sql.Context, interval sql.WindowInterval, buf sql.WindowBuffer) error {
+	if s.spec == nil {
+		return nil
+	}
+	var orderBy sql.SortConditions
+	if s.window != nil {
+		orderBy = s.window.OrderBy
+	}
+	groups, err := newWindowPeerGroups(ctx, interval, buf, orderBy.ToExpressions())
+	if err != nil {
+		return err
+	}
+	ranges, err := s.spec.newWindowRangeBounds(ctx, interval, buf, groups, orderBy)
+	if err != nil {
+		return err
+	}
+	s.partition = interval
+	s.groups = groups
+	s.ranges = ranges
+	s.pos = interval.Start
 	return nil
 }
 
+// FrameRows returns the rows of the current row's frame, given the interval that was passed to Compute. This
+// must be called exactly once for each call to Compute, as the current row is tracked internally when a
+// WindowFrameSpec is set.
+func (s *WindowFramerState) FrameRows(interval sql.WindowInterval) WindowFrameRows {
+	if s.spec == nil {
+		return WindowFrameRows{Start: interval.Start, End: max(interval.Start, interval.End)}
+	}
+	rows := s.spec.rows(s.partition, s.groups, s.ranges, s.pos)
+	s.pos++
+	return rows
+}
+
 // DefaultFramer implements the sql.WindowFunction interface; with no explicit frame, this supplies
 // Postgres's default frame: RANGE UNBOUNDED PRECEDING TO CURRENT ROW when the window has an ORDER BY (so
 // rows tied on the ORDER BY value share a peer group), otherwise the whole partition.
 func (s *WindowFramerState) DefaultFramer() sql.WindowFramer {
+	if s.spec != nil {
+		return aggregation.NewPartitionFramer()
+	}
 	if s.framer != nil {
 		return s.framer
 	}
@@ -18,6 +18,7 @@ package functions
 func initTypeFunctions() {
 	initAny()
 	initAnyArray()
+	initAnyCompatible()
 	initAnyElement()
 	initAnyEnum()
 	initAnyNonArray()
@@ -33,6 +34,7 @@ func initTypeFunctions() {
 	initDateBin()
 	initDatePart()
 	initDateTrunc()
+	initDecode()
 	initDomain()
 	initEncode()
 	initEnum()
@@ -100,6 +102,7 @@ func Init() {
 	initChr()
 	initColDescription()
 	initConcat()
+	initConvert()
 	initCos()
 	initCosd()
 	initCosh()
@@ -109,14 +112,17 @@ func Init() {
 	initCurrentSchema()
 	initCurrentSetting()
 	initCurrentSchemas()
+	initDateAdd()
 	initDegrees()
 	initDiv()
 	initDoltProcedures()
 	initDoltRecordTrim()
+	initDoltRootObjectConflictsResolve()
 	initExp()
 	initExtract()
 	initFactorial()
 	initFloor()
+	initFormat()
 	initFormatType()
 	initGcd()
 	initGenRandomUuid()
@@ -126,6 +132,8 @@ func Init() {
 	initHasDatabasePrivilege()
 	initHasSchemaPrivilege()
 	initInitcap()
+	initIsFinite()
+	initJustify()
 	initLcm()
 	initLeft()
 	initLength()
@@ -135,6 +143,8 @@ func Init() {
 	initLower()
 	initLpad()
 	initLtrim()
+	initMakeDate()
+	initMakeInterval()
 	initMakeTimestamp()
 	initMd5()
 	initMinScale()
@@ -166,6 +176,7 @@ func Init() {
 	initPgIndexesSize()
 	initPgIsInRecovery()
 	initPgIsWalReplayPaused()
+	initPgMyTempSchema()
 	initPgOpclassIsVisible()
 	initPgOperatorIsVisible()
 	initPgOpfamilyIsVisible()
@@ -191,6 +202,7 @@ func Init() {
 	initPi()
 	initPower()
 	initQuoteIdent()
+	initQuoteLiteral()
 	initRadians()
 	initRandom()
 	initRepeat()
@@ -204,6 +216,7 @@ func Init() {
 	initScale()
 	initSetConfig()
 	initSetVal()
+	initSha2()
 	initShobjDescription()
 	initSign()
 	initSin()
@@ -0,0 +1,94 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/postgres/parser/pgdate"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initIsFinite registers the functions to the catalog.
+func initIsFinite() {
+	framework.RegisterFunction(isfinite_date)
+	framework.RegisterFunction(isfinite_timestamp)
+	framework.RegisterFunction(isfinite_timestamptz)
+	framework.RegisterFunction(isfinite_interval)
+}
+
+// isfinite_date represents the PostgreSQL function of the same name, taking the same parameters.
+var isfinite_date = framework.Function1{
+	Name:       "isfinite",
+	Return:     pgtypes.Bool,
+	Parameters: [1]*pgtypes.DoltgresType{pgtypes.Date},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [2]*pgtypes.DoltgresType, val any) (any, error) {
+		return !isInfiniteTime(val.(time.Time)), nil
+	},
+}
+
+// isfinite_timestamp represents the PostgreSQL function of the same name, taking the same parameters.
+var isfinite_timestamp = framework.Function1{
+	Name:       "isfinite",
+	Return:     pgtypes.Bool,
+	Parameters: [1]*pgtypes.DoltgresType{pgtypes.Timestamp},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [2]*pgtypes.DoltgresType, val any) (any, error) {
+		return !isInfiniteTime(val.(time.Time)), nil
+	},
+}
+
+// isfinite_timestamptz represents the PostgreSQL function of the same name, taking the same parameters.
+var isfinite_timestamptz = framework.Function1{
+	Name:       "isfinite",
+	Return:     pgtypes.Bool,
+	Parameters: [1]*pgtypes.DoltgresType{pgtypes.TimestampTZ},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [2]*pgtypes.DoltgresType, val any) (any, error) {
+		return !isInfiniteTime(val.(time.Time)), nil
+	},
+}
+
+// isfinite_interval represents the PostgreSQL function of the same name, taking the same parameters.
+var isfinite_interval = framework.Function1{
+	Name:       "isfinite",
+	Return:     pgtypes.Bool,
+	Parameters: [1]*pgtypes.DoltgresType{pgtypes.Interval},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [2]*pgtypes.DoltgresType, val any) (any, error) {
+		// Intervals cannot currently represent infinity, so every interval is finite
+		return true, nil
+	},
+}
+
+// isInfiniteTime returns whether the given time is one of the sentinel values that the 'infinity' and '-infinity'
+// inputs are parsed into.
+func isInfiniteTime(t time.Time) bool {
+	return t.Equal(pgdate.TimeInfinity) || t.Equal(pgdate.TimeNegativeInfinity)
+}
+
+// formatInfiniteTime returns the PostgreSQL output of the given time if it is infinite, and false if it is finite.
+func formatInfiniteTime(t time.Time) (string, bool) {
+	if t.Equal(pgdate.TimeInfinity) {
+		return "infinity", true
+	} else if t.Equal(pgdate.TimeNegativeInfinity) {
+		return "-infinity", true
+	}
+	return "", false
+}
@@ -0,0 +1,115 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/postgres/parser/duration"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initJustify registers the functions to the catalog.
+func initJustify() {
+	framework.RegisterFunction(justify_days)
+	framework.RegisterFunction(justify_hours)
+	framework.RegisterFunction(justify_interval)
+}
+
+// nanosPerDay is the number of nanoseconds in a day, which justify_hours assumes is always 24 hours.
+const nanosPerDay = NanosPerSec * duration.SecsPerDay
+
+// justify_days represents the PostgreSQL function of the same name, taking the same parameters.
+var justify_days = framework.Function1{
+	Name:       "justify_days",
+	Return:     pgtypes.Interval,
+	Parameters: [1]*pgtypes.DoltgresType{pgtypes.Interval},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [2]*pgtypes.DoltgresType, val any) (any, error) {
+		dur := val.(duration.Duration)
+		months, days := justifyDays(dur.Months, dur.Days)
+		// The days and months must keep the same sign, as they're not converted into the time field
+		if months > 0 && days < 0 {
+			days += duration.DaysPerMonth
+			months--
+		} else if months < 0 && days > 0 {
+			days -= duration.DaysPerMonth
+			months++
+		}
+		return duration.MakeDuration(dur.Nanos(), days, months), nil
+	},
+}
+
+// justify_hours represents the PostgreSQL function of the same name, taking the same parameters.
+var justify_hours = framework.Function1{
+	Name:       "justify_hours",
+	Return:     pgtypes.Interval,
+	Parameters: [1]*pgtypes.DoltgresType{pgtypes.Interval},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [2]*pgtypes.DoltgresType, val any) (any, error) {
+		dur := val.(duration.Duration)
+		days, nanos := justifyHours(dur.Days, dur.Nanos())
+		if days > 0 && nanos < 0 {
+			nanos += nanosPerDay
+			days--
+		} else if days < 0 && nanos > 0 {
+			nanos -= nanosPerDay
+			days++
+		}
+		return duration.MakeDuration(nanos, days, dur.Months), nil
+	},
+}
+
+// justify_interval represents the PostgreSQL function of the same name, taking the same parameters.
+var justify_interval = framework.Function1{
+	Name:       "justify_interval",
+	Return:     pgtypes.Interval,
+	Parameters: [1]*pgtypes.DoltgresType{pgtypes.Interval},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [2]*pgtypes.DoltgresType, val any) (any, error) {
+		dur := val.(duration.Duration)
+		days, nanos := justifyHours(dur.Days, dur.Nanos())
+		months, days := justifyDays(dur.Months, days)
+		// Every field must end up with the same sign, so we borrow from the larger fields to fix the smaller ones
+		if months > 0 && (days < 0 || (days == 0 && nanos < 0)) {
+			days += duration.DaysPerMonth
+			months--
+		} else if months < 0 && (days > 0 || (days == 0 && nanos > 0)) {
+			days -= duration.DaysPerMonth
+			months++
+		}
+		if days > 0 && nanos < 0 {
+			nanos += nanosPerDay
+			days--
+		} else if days < 0 && nanos > 0 {
+			nanos -= nanosPerDay
+			days++
+		}
+		return duration.MakeDuration(nanos, days, months), nil
+	},
+}
+
+// justifyDays moves each whole 30-day period from the days into the months.
+func justifyDays(months int64, days int64) (int64, int64) {
+	wholeMonths := days / duration.DaysPerMonth
+	return months + wholeMonths, days - wholeMonths*duration.DaysPerMonth
+}
+
+// justifyHours moves each whole 24-hour period from the nanoseconds into the days.
+func justifyHours(days int64, nanos int64) (int64, int64) {
+	wholeDays := nanos / nanosPerDay
+	return days + wholeDays, nanos - wholeDays*nanosPerDay
+}
@@ -0,0 +1,95 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"math"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/postgres/parser/timeofday"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initMakeDate registers the functions to the catalog.
+func initMakeDate() {
+	framework.RegisterFunction(make_date)
+	framework.RegisterFunction(make_time)
+}
+
+// make_date represents the PostgreSQL function of the same name, taking the same parameters.
+var make_date = framework.Function3{
+	Name:       "make_date",
+	Return:     pgtypes.Date,
+	Parameters: [3]*pgtypes.DoltgresType{pgtypes.Int32, pgtypes.Int32, pgtypes.Int32},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [4]*pgtypes.DoltgresType, val1, val2, val3 any) (any, error) {
+		year, month, day := val1.(int32), val2.(int32), val3.(int32)
+		if err := validateDateFields(year, month, day); err != nil {
+			return nil, errors.Errorf("%s: %d-%02d-%02d", err.Error(), year, month, day)
+		}
+		if year < 0 {
+			// PostgreSQL: year -1 = 1 BC, which is year 0 in Go's proleptic Gregorian calendar
+			year++
+		}
+		return time.Date(int(year), time.Month(month), int(day), 0, 0, 0, 0, time.UTC), nil
+	},
+}
+
+// make_time represents the PostgreSQL function of the same name, taking the same parameters.
+var make_time = framework.Function3{
+	Name:       "make_time",
+	Return:     pgtypes.Time,
+	Parameters: [3]*pgtypes.DoltgresType{pgtypes.Int32, pgtypes.Int32, pgtypes.Float64},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [4]*pgtypes.DoltgresType, val1, val2, val3 any) (any, error) {
+		hour, minute, sec := val1.(int32), val2.(int32), val3.(float64)
+		// 24:00:00 is allowed, as is a leap second of 60
+		if hour < 0 || hour > 24 || minute < 0 || minute > 59 || math.IsNaN(sec) || sec < 0 || sec > 60 ||
+			(hour == 24 && (minute > 0 || sec > 0)) {
+			return nil, errors.Errorf("%s: %d:%02d:%02g", errTimeFieldOutOfRange.Error(), hour, minute, sec)
+		}
+		micros := (int64(hour)*60+int64(minute))*60*1000000 + int64(math.RoundToEven(sec*1000000))
+		if micros >= int64(timeofday.Time2400) {
+			return timeofday.Time2400, nil
+		}
+		return timeofday.FromInt(micros), nil
+	},
+}
+
+// validateDateFields returns an error if the given year, month and day do not form a valid date. As in PostgreSQL,
+// there is no year zero, and negative years are BC.
+func validateDateFields(year, month, day int32) error {
+	if year == 0 || month < 1 || month > 12 || day < 1 {
+		return errDateFieldOutOfRange
+	}
+	goYear := int(year)
+	if year < 0 {
+		goYear++
+	}
+	if int(day) > daysInMonth(goYear, time.Month(month)) {
+		return errDateFieldOutOfRange
+	}
+	return nil
+}
+
+// daysInMonth returns the number of days in the given month of the given year.
+func daysInMonth(year int, month time.Month) int {
+	// The zeroth day of the next month normalizes to the last day of this month
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
@@ -0,0 +1,169 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"math"
+
+	"github.com/cockroachdb/errors"
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/postgres/parser/duration"
+	"github.com/dolthub/doltgresql/postgres/parser/utils"
+	pgexprs "github.com/dolthub/doltgresql/server/expression"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initMakeInterval registers the functions to the catalog.
+func initMakeInterval() {
+	framework.RegisterFunction(make_interval)
+	framework.RegisterFunction(make_interval_int32)
+	framework.RegisterFunction(make_interval_int32_int32)
+	framework.RegisterFunction(make_interval_int32_int32_int32)
+	framework.RegisterFunction(make_interval_int32_int32_int32_int32)
+	framework.RegisterFunction(make_interval_int32_int32_int32_int32_int32)
+	framework.RegisterFunction(make_interval_int32_int32_int32_int32_int32_int32)
+	framework.RegisterFunction(make_interval_int32_int32_int32_int32_int32_int32_float64)
+	framework.RegisterNamedParameters("make_interval",
+		framework.NamedParameter{Name: "years", Default: pgexprs.NewRawLiteralInt32(0)},
+		framework.NamedParameter{Name: "months", Default: pgexprs.NewRawLiteralInt32(0)},
+		framework.NamedParameter{Name: "weeks", Default: pgexprs.NewRawLiteralInt32(0)},
+		framework.NamedParameter{Name: "days", Default: pgexprs.NewRawLiteralInt32(0)},
+		framework.NamedParameter{Name: "hours", Default: pgexprs.NewRawLiteralInt32(0)},
+		framework.NamedParameter{Name: "mins", Default: pgexprs.NewRawLiteralInt32(0)},
+		framework.NamedParameter{Name: "secs", Default: pgexprs.NewRawLiteralFloat64(0)},
+	)
+}
+
+var errIntervalOutOfRange = errors.Errorf(`interval out of range`)
+
+// Every parameter of make_interval has a default of zero in PostgreSQL, so each trailing subset of the parameters is
+// registered as its own overload. Arguments given by name are placed in position by the framework, which fills any
+// parameters that precede them with their defaults.
+
+// make_interval represents the PostgreSQL function of the same name, taking the same parameters.
+var make_interval = framework.Function0{
+	Name:   "make_interval",
+	Return: pgtypes.Interval,
+	Strict: true,
+	Callable: func(ctx *sql.Context) (any, error) {
+		return makeInterval(0, 0, 0, 0, 0, 0, 0)
+	},
+}
+
+// make_interval_int32 represents the PostgreSQL function of the same name, taking the same parameters.
+var make_interval_int32 = framework.Function1{
+	Name:       "make_interval",
+	Return:     pgtypes.Interval,
+	Parameters: [1]*pgtypes.DoltgresType{pgtypes.Int32},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [2]*pgtypes.DoltgresType, val1 any) (any, error) {
+		return makeInterval(val1.(int32), 0, 0, 0, 0, 0, 0)
+	},
+}
+
+// make_interval_int32_int32 represents the PostgreSQL function of the same name, taking the same parameters.
+var make_interval_int32_int32 = framework.Function2{
+	Name:       "make_interval",
+	Return:     pgtypes.Interval,
+	Parameters: [2]*pgtypes.DoltgresType{pgtypes.Int32, pgtypes.Int32},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [3]*pgtypes.DoltgresType, val1, val2 any) (any, error) {
+		return makeInterval(val1.(int32), val2.(int32), 0, 0, 0, 0, 0)
+	},
+}
+
+// make_interval_int32_int32_int32 represents the PostgreSQL function of the same name, taking the same parameters.
+var make_interval_int32_int32_int32 = framework.Function3{
+	Name:       "make_interval",
+	Return:     pgtypes.Interval,
+	Parameters: [3]*pgtypes.DoltgresType{pgtypes.Int32, pgtypes.Int32, pgtypes.Int32},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [4]*pgtypes.DoltgresType, val1, val2, val3 any) (any, error) {
+		return makeInterval(val1.(int32), val2.(int32), val3.(int32), 0, 0, 0, 0)
+	},
+}
+
+// make_interval_int32_int32_int32_int32 represents the PostgreSQL function of the same name, taking the same parameters.
+var make_interval_int32_int32_int32_int32 = framework.Function4{
+	Name:       "make_interval",
+	Return:     pgtypes.Interval,
+	Parameters: [4]*pgtypes.DoltgresType{pgtypes.Int32, pgtypes.Int32, pgtypes.Int32, pgtypes.Int32},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [5]*pgtypes.DoltgresType, val1, val2, val3, val4 any) (any, error) {
+		return makeInterval(val1.(int32), val2.(int32), val3.(int32), val4.(int32), 0, 0, 0)
+	},
+}
+
+// make_interval_int32_int32_int32_int32_int32 represents the PostgreSQL function of the same name, taking the same parameters.
+var make_interval_int32_int32_int32_int32_int32 = framework.Function5{
+	Name:       "make_interval",
+	Return:     pgtypes.Interval,
+	Parameters: [5]*pgtypes.DoltgresType{pgtypes.Int32, pgtypes.Int32, pgtypes.Int32, pgtypes.Int32, pgtypes.Int32},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [6]*pgtypes.DoltgresType, val1, val2, val3, val4, val5 any) (any, error) {
+		return makeInterval(val1.(int32), val2.(int32), val3.(int32), val4.(int32), val5.(int32), 0, 0)
+	},
+}
+
+// make_interval_int32_int32_int32_int32_int32_int32 represents the PostgreSQL function of the same name, taking the same parameters.
+var make_interval_int32_int32_int32_int32_int32_int32 = framework.Function6{
+	Name:       "make_interval",
+	Return:     pgtypes.Interval,
+	Parameters: [6]*pgtypes.DoltgresType{pgtypes.Int32, pgtypes.Int32, pgtypes.Int32, pgtypes.Int32, pgtypes.Int32, pgtypes.Int32},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [7]*pgtypes.DoltgresType, val1, val2, val3, val4, val5, val6 any) (any, error) {
+		return makeInterval(val1.(int32), val2.(int32), val3.(int32), val4.(int32), val5.(int32), val6.(int32), 0)
+	},
+}
+
+// make_interval_int32_int32_int32_int32_int32_int32_float64 represents the PostgreSQL function of the same name, taking the same parameters.
+var make_interval_int32_int32_int32_int32_int32_int32_float64 = framework.Function7{
+	Name:       "make_interval",
+	Return:     pgtypes.Interval,
+	Parameters: [7]*pgtypes.DoltgresType{pgtypes.Int32, pgtypes.Int32, pgtypes.Int32, pgtypes.Int32, pgtypes.Int32, pgtypes.Int32, pgtypes.Float64},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [8]*pgtypes.DoltgresType, val1, val2, val3, val4, val5, val6, val7 any) (any, error) {
+		return makeInterval(val1.(int32), val2.(int32), val3.(int32), val4.(int32), val5.(int32), val6.(int32), val7.(float64))
+	},
+}
+
+// makeInterval returns the interval built from the given fields. Like PostgreSQL, the month and day fields must fit
+// within an int32, and the seconds are rounded to the nearest microsecond.
+func makeInterval(years, months, weeks, days, hours, mins int32, secs float64) (duration.Duration, error) {
+	if math.IsInf(secs, 0) || math.IsNaN(secs) {
+		return duration.Duration{}, errIntervalOutOfRange
+	}
+	totalMonths := int64(years)*duration.MonthsPerYear + int64(months)
+	totalDays := int64(weeks)*7 + int64(days)
+	if totalMonths > math.MaxInt32 || totalMonths < math.MinInt32 || totalDays > math.MaxInt32 || totalDays < math.MinInt32 {
+		return duration.Duration{}, errIntervalOutOfRange
+	}
+	const microsPerSec = duration.MicrosPerMilli * duration.MillisPerSec
+	secMicros := math.RoundToEven(secs * microsPerSec)
+	if secMicros >= math.MaxInt64 || secMicros < math.MinInt64 {
+		return duration.Duration{}, errIntervalOutOfRange
+	}
+	micros, ok := utils.AddWithOverflow(int64(hours)*duration.SecsPerHour*microsPerSec, int64(mins)*duration.SecsPerMinute*microsPerSec)
+	if ok {
+		micros, ok = utils.AddWithOverflow(micros, int64(secMicros))
+	}
+	// Durations store nanoseconds rather than microseconds, so their time field has a smaller range than PostgreSQL's
+	if !ok || micros > math.MaxInt64/NanosPerMicro || micros < math.MinInt64/NanosPerMicro {
+		return duration.Duration{}, errIntervalOutOfRange
+	}
+	return duration.MakeDuration(micros*NanosPerMicro, totalDays, totalMonths), nil
+}
@@ -83,22 +83,15 @@ var make_timestamptz_int32_int32_int32_int32_int32_float64_text = framework.Func
 }
 
 func getTimestampInServerLocation(year, month, day, hour, minute int32, partialSecond float64, loc *time.Location) (time.Time, error) {
-	if year == 0 {
-		return time.Time{}, errDateFieldOutOfRange
-	} else if year < 0 {
+	if err := validateDateFields(year, month, day); err != nil {
+		return time.Time{}, err
+	}
+	if year < 0 {
 		// PostgreSQL: year 0 = 1 BC, year -1 = 2 BC, etc.
 		// which formatting it is handled in FormatDateTimeWithBC using calculation: (1 - year)
 		year++
 	}
 
-	if month < 1 || month > 12 {
-		return time.Time{}, errDateFieldOutOfRange
-	}
-
-	if day < 1 || day > 31 {
-		return time.Time{}, errDateFieldOutOfRange
-	}
-
 	if hour < 0 || hour > 23 {
 		return time.Time{}, errTimeFieldOutOfRange
 	}
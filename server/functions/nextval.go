@@ -24,6 +24,7 @@ import (
 	"github.com/dolthub/doltgresql/core/id"
 	"github.com/dolthub/doltgresql/core/sequences"
 	"github.com/dolthub/doltgresql/server/functions/framework"
+	"github.com/dolthub/doltgresql/server/temporary"
 	pgtypes "github.com/dolthub/doltgresql/server/types"
 )
 
@@ -33,26 +34,33 @@ func initNextVal() {
 	framework.RegisterFunction(nextval_regclass)
 }
 
-func nextval(ctx *sql.Context, ait *sequences.SequenceTracker, relationName string) (int64, error) {
-	// TODO: this needs a database name to support inserts into other databases (including inserts on other branches than the current one)
-	collection, err := core.GetSequencesCollectionFromContext(ctx, ctx.GetCurrentDatabase())
+func nextval(ctx *sql.Context, relationName string) (int64, error) {
+	database, schema, relationBaseName, err := ParseQualifiedRelationName(ctx, relationName)
 	if err != nil {
 		return 0, err
 	}
-
-	db, err := getDb(ctx)
+	if len(database) == 0 {
+		if isTemporarySequence(ctx, schema, relationBaseName) {
+			next, _, err := temporary.NextVal(ctx.Session.ID(), ctx.GetCurrentDatabase(), relationBaseName)
+			return next, err
+		} else if schema != "" && temporary.IsSchema(ctx.Session.ID(), schema) {
+			return 0, errors.Errorf(`sequence "%s" does not exist`, relationName)
+		}
+		database = ctx.GetCurrentDatabase()
+	}
+	collection, err := core.GetSequencesCollectionFromContext(ctx, database)
 	if err != nil {
 		return 0, err
 	}
-	root, err := db.GetRoot(ctx)
+	db, err := getDb(ctx, database)
 	if err != nil {
 		return 0, err
 	}
-	var sequenceName doltdb.TableName
-	schema, relationBaseName, err := ParseRelationName(ctx, relationName)
+	root, err := db.GetRoot(ctx)
 	if err != nil {
 		return 0, err
 	}
+	var sequenceName doltdb.TableName
 	if schema != "" {
 		sequenceName = doltdb.TableName{Schema: schema, Name: relationBaseName}
 	} else {
@@ -66,19 +74,61 @@ func nextval(ctx *sql.Context, ait *sequences.SequenceTracker, relationName stri
 		}
 	}
 	sequenceId := id.NewSequence(sequenceName.Schema, sequenceName.Name)
+	sequence, err := collection.GetSequence(ctx, sequenceId)
+	if err != nil {
+		return 0, err
+	}
+	if sequence == nil {
+		return 0, errors.Errorf(`sequence "%s" does not exist`, relationName)
+	}
+	// Values that the session has already allocated are handed out without touching the shared sequence state
+	if next, ok := sequences.NextCachedValue(ctx.Session.ID(), db.RevisionQualifiedName(), sequenceId); ok {
+		return next, nil
+	}
 
+	ait, err := getSequenceTracker(ctx, database)
+	if err != nil {
+		return 0, err
+	}
 	next, err := ait.Next(ctx, sequenceName, nil)
 	if err != nil {
 		return 0, err
 	}
+	last := next
+	if sequence.Cache > 1 {
+		cached := make([]int64, 0, sequence.Cache-1)
+		for int64(len(cached)) < sequence.Cache-1 {
+			val, err := ait.Next(ctx, sequenceName, nil)
+			if err != nil {
+				// Reaching the end of the sequence is only an error once the session needs a value that doesn't exist
+				break
+			}
+			cached = append(cached, val)
+		}
+		if len(cached) > 0 {
+			last = cached[len(cached)-1]
+		}
+		sequences.SetCachedValues(ctx.Session.ID(), db.RevisionQualifiedName(), sequenceId, cached)
+	}
 
-	err = collection.SetVal(ctx, sequenceId, next, true, true)
+	err = collection.SetVal(ctx, sequenceId, last, true, true)
 	if err != nil {
 		return 0, err
 	}
 	return next, err
 }
 
+// isTemporarySequence returns whether the relation refers to one of the session's temporary sequences. The temporary
+// schema is searched ahead of the schemas on the search path, so an unqualified name refers to a temporary sequence
+// whenever one exists.
+func isTemporarySequence(ctx *sql.Context, schema string, relation string) bool {
+	if schema != "" && !temporary.IsSchema(ctx.Session.ID(), schema) {
+		return false
+	}
+	_, ok := temporary.GetSequence(ctx.Session.ID(), ctx.GetCurrentDatabase(), relation)
+	return ok
+}
+
 // nextval_text represents the PostgreSQL function of the same name, taking the same parameters.
 //
 // TODO: Even though we can implicitly convert a text param to a regclass param, it's an expensive process
@@ -92,15 +142,11 @@ var nextval_text = framework.Function1{
 	IsNonDeterministic: true,
 	Strict:             true,
 	Callable: func(ctx *sql.Context, _ [2]*pgtypes.DoltgresType, val any) (any, error) {
-		ait, err := getSequenceTracker(ctx)
-		if err != nil {
-			return 0, err
-		}
 		relationName, err := framework.UnwrapString(ctx, val)
 		if err != nil {
 			return 0, err
 		}
-		return nextval(ctx, ait, relationName)
+		return nextval(ctx, relationName)
 	},
 }
 
@@ -116,10 +162,6 @@ var nextval_regclass = framework.Function1{
 		if err != nil {
 			return nil, err
 		}
-		ait, err := getSequenceTracker(ctx)
-		if err != nil {
-			return 0, err
-		}
-		return nextval(ctx, ait, relationName)
+		return nextval(ctx, relationName)
 	},
 }
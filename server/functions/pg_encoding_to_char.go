@@ -34,11 +34,10 @@ var pg_encoding_to_char_int = framework.Function1{
 	IsNonDeterministic: true,
 	Strict:             true,
 	Callable: func(ctx *sql.Context, _ [2]*pgtypes.DoltgresType, val any) (any, error) {
-		encoding := val.(int32)
-		if encoding == int32(6) {
-			return "UTF8", nil
+		if encoding := lookupEncodingByID(val.(int32)); encoding != nil {
+			return encoding.name, nil
 		}
-		// TODO: encoding is not supported yet; if invalid val, return empty
+		// TODO: only the encodings supported by the conversion functions are recognized; if invalid val, return empty
 		return "", nil
 	},
 }
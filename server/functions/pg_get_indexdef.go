@@ -21,6 +21,7 @@ import (
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/plan"
 
+	"github.com/dolthub/doltgresql/core"
 	"github.com/dolthub/doltgresql/core/id"
 	"github.com/dolthub/doltgresql/server/functions/framework"
 	pgtypes "github.com/dolthub/doltgresql/server/types"
@@ -44,8 +45,8 @@ var pg_get_indexdef_oid = framework.Function1{
 		result := ""
 		err := RunCallback(ctx, oidVal, Callbacks{
 			Index: func(ctx *sql.Context, schema ItemSchema, table ItemTable, index ItemIndex) (cont bool, err error) {
-				result = buildIndexDef(ctx, index.Item, table.Item, schema.Item.SchemaName())
-				return false, nil
+				result, err = buildIndexDef(ctx, index.Item, table.Item, schema.Item.SchemaName())
+				return false, err
 			},
 		})
 		if err != nil {
@@ -56,25 +57,32 @@ var pg_get_indexdef_oid = framework.Function1{
 }
 
 // buildIndexDef generates a CREATE INDEX DDL statement for the given index.
-func buildIndexDef(ctx *sql.Context, index sql.Index, table sql.Table, schemaName string) string {
+func buildIndexDef(ctx *sql.Context, index sql.Index, table sql.Table, schemaName string) (string, error) {
 	name := index.ID()
 	if name == "PRIMARY" {
 		// Primary key indexes are displayed with their postgres-convention name, matching pg_class
 		name = fmt.Sprintf("%s_pkey", index.Table())
 	}
-	using := strings.ToLower(index.IndexType())
+	accessMethod, err := core.GetIndexAccessMethod(ctx, schemaName, index)
+	if err != nil {
+		return "", err
+	}
 	unique := ""
 	if index.IsUnique() {
 		unique = " UNIQUE"
 	}
 
-	colsStr := strings.Join(indexColumnExprs(ctx, index, table), ", ")
+	cols := indexColumnExprs(ctx, index, table)
+	if len(accessMethod.OpClass) > 0 && len(cols) == 1 {
+		cols[0] += " " + accessMethod.OpClass
+	}
+	colsStr := strings.Join(cols, ", ")
 
-	def := fmt.Sprintf("CREATE%s INDEX %s ON %s.%s USING %s (%s)", unique, name, schemaName, index.Table(), using, colsStr)
+	def := fmt.Sprintf("CREATE%s INDEX %s ON %s.%s USING %s (%s)", unique, name, schemaName, index.Table(), accessMethod.Name, colsStr)
 	if pi, ok := index.(sql.PartialIndex); ok && pi.Predicate() != "" {
 		def += " WHERE (" + pi.Predicate() + ")"
 	}
-	return def
+	return def, nil
 }
 
 // indexColumnExprs returns the rendered text of each column of the given index, in index column
@@ -128,8 +136,8 @@ var pg_get_indexdef_oid_integer_bool = framework.Function3{
 		err := RunCallback(ctx, oidVal, Callbacks{
 			Index: func(ctx *sql.Context, schema ItemSchema, table ItemTable, index ItemIndex) (cont bool, err error) {
 				if colNo == 0 {
-					result = buildIndexDef(ctx, index.Item, table.Item, schema.Item.SchemaName())
-					return false, nil
+					result, err = buildIndexDef(ctx, index.Item, table.Item, schema.Item.SchemaName())
+					return false, err
 				}
 				// A non-zero column number selects just that column's definition, or an empty
 				// string if the index has no such column.
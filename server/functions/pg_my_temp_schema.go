@@ -0,0 +1,69 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/id"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	"github.com/dolthub/doltgresql/server/temporary"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initPgMyTempSchema registers the functions to the catalog.
+func initPgMyTempSchema() {
+	framework.RegisterFunction(pg_my_temp_schema)
+}
+
+// pg_my_temp_schema represents the PostgreSQL system information function of the same name, taking no parameters.
+var pg_my_temp_schema = framework.Function0{
+	Name:               "pg_my_temp_schema",
+	Return:             pgtypes.Oid,
+	IsNonDeterministic: true,
+	Strict:             true,
+	Callable: func(ctx *sql.Context) (any, error) {
+		hasTemporarySchema, err := HasTemporarySchema(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !hasTemporarySchema {
+			return id.NewOID(0).AsId(), nil
+		}
+		return id.NewNamespace(temporary.SchemaName(ctx.Session.ID())).AsId(), nil
+	},
+}
+
+// HasTemporarySchema returns whether the session has created its temporary schema, which happens once the session
+// creates its first temporary object.
+func HasTemporarySchema(ctx *sql.Context) (bool, error) {
+	if temporary.Used(ctx.Session.ID()) {
+		return true, nil
+	}
+	db, err := core.GetSqlDatabaseFromContext(ctx, "")
+	if err != nil {
+		return false, err
+	}
+	tempDb, ok := db.(sql.TemporaryTableDatabase)
+	if !ok {
+		return false, nil
+	}
+	tempTables, err := tempDb.GetAllTemporaryTables(ctx)
+	if err != nil {
+		return false, err
+	}
+	return len(tempTables) > 0, nil
+}
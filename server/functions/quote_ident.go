@@ -15,13 +15,11 @@
 package functions
 
 import (
-	"fmt"
-	"strings"
-
 	"github.com/dolthub/go-mysql-server/sql"
 
 	"github.com/dolthub/doltgresql/server/functions/framework"
 	pgtypes "github.com/dolthub/doltgresql/server/types"
+	"github.com/dolthub/doltgresql/utils"
 )
 
 // initQuoteIdent registers the functions to the catalog.
@@ -40,6 +38,6 @@ var quote_ident_text = framework.Function1{
 		if err != nil {
 			return nil, err
 		}
-		return fmt.Sprintf(`"%s"`, strings.Replace(valStr, "\"", "\"\"", -1)), nil
+		return utils.QuoteIdentifierIfNeeded(valStr), nil
 	},
 }
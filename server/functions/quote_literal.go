@@ -0,0 +1,118 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initQuoteLiteral registers the functions to the catalog.
+func initQuoteLiteral() {
+	framework.RegisterFunction(quote_literal_text)
+	framework.RegisterFunction(quote_literal_anyelement)
+	framework.RegisterFunction(quote_nullable_text)
+	framework.RegisterFunction(quote_nullable_anyelement)
+}
+
+// quote_literal_text represents the PostgreSQL function of the same name, taking the same parameters.
+var quote_literal_text = framework.Function1{
+	Name:       "quote_literal",
+	Return:     pgtypes.Text,
+	Parameters: [1]*pgtypes.DoltgresType{pgtypes.Text},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [2]*pgtypes.DoltgresType, val any) (any, error) {
+		valStr, err := framework.UnwrapString(ctx, val)
+		if err != nil {
+			return nil, err
+		}
+		return quoteLiteral(valStr), nil
+	},
+}
+
+// quote_literal_anyelement represents the PostgreSQL function of the same name, taking the same parameters.
+var quote_literal_anyelement = framework.Function1{
+	Name:       "quote_literal",
+	Return:     pgtypes.Text,
+	Parameters: [1]*pgtypes.DoltgresType{pgtypes.AnyElement},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, t [2]*pgtypes.DoltgresType, val any) (any, error) {
+		valStr, err := t[0].IoOutput(ctx, val)
+		if err != nil {
+			return nil, err
+		}
+		return quoteLiteral(valStr), nil
+	},
+}
+
+// quote_nullable_text represents the PostgreSQL function of the same name, taking the same parameters.
+var quote_nullable_text = framework.Function1{
+	Name:       "quote_nullable",
+	Return:     pgtypes.Text,
+	Parameters: [1]*pgtypes.DoltgresType{pgtypes.Text},
+	Strict:     false,
+	Callable: func(ctx *sql.Context, _ [2]*pgtypes.DoltgresType, val any) (any, error) {
+		if val == nil {
+			return "NULL", nil
+		}
+		valStr, err := framework.UnwrapString(ctx, val)
+		if err != nil {
+			return nil, err
+		}
+		return quoteLiteral(valStr), nil
+	},
+}
+
+// quote_nullable_anyelement represents the PostgreSQL function of the same name, taking the same parameters.
+var quote_nullable_anyelement = framework.Function1{
+	Name:       "quote_nullable",
+	Return:     pgtypes.Text,
+	Parameters: [1]*pgtypes.DoltgresType{pgtypes.AnyElement},
+	Strict:     false,
+	Callable: func(ctx *sql.Context, t [2]*pgtypes.DoltgresType, val any) (any, error) {
+		if val == nil {
+			return "NULL", nil
+		}
+		valStr, err := t[0].IoOutput(ctx, val)
+		if err != nil {
+			return nil, err
+		}
+		return quoteLiteral(valStr), nil
+	},
+}
+
+// quoteLiteral returns the string as a quoted SQL string literal. Single quotes and backslashes are doubled, and a
+// string containing backslashes uses the escape string syntax (E'...') so that it's read back correctly regardless of
+// standard_conforming_strings, matching quote_literal_cstr in Postgres.
+func quoteLiteral(str string) string {
+	sb := strings.Builder{}
+	sb.Grow(len(str) + 3)
+	if strings.ContainsRune(str, '\\') {
+		sb.WriteByte('E')
+	}
+	sb.WriteByte('\'')
+	for i := 0; i < len(str); i++ {
+		if str[i] == '\'' || str[i] == '\\' {
+			sb.WriteByte(str[i])
+		}
+		sb.WriteByte(str[i])
+	}
+	sb.WriteByte('\'')
+	return sb.String()
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/dolthub/doltgresql/core/id"
 	"github.com/dolthub/doltgresql/server/functions/framework"
 	"github.com/dolthub/doltgresql/server/settings"
+	"github.com/dolthub/doltgresql/server/temporary"
 	pgtypes "github.com/dolthub/doltgresql/server/types"
 	"github.com/dolthub/doltgresql/utils"
 )
@@ -86,6 +87,13 @@ var regclassin = framework.Function1{
 			return id.Null, errors.Errorf("unexpected input for regclass: %s", input)
 		}
 
+		// The session's temporary sequences are found ahead of the schemas on the search path
+		if len(sections) == 1 || (len(sections) == 3 && temporary.IsSchema(ctx.Session.ID(), sections[0])) {
+			if sequence, ok := temporary.GetSequence(ctx.Session.ID(), database, relationName); ok {
+				return sequence.Id.AsId(), nil
+			}
+		}
+
 		// Iterate over all of the items to find which relation matches.
 		// Postgres does not need to worry about name conflicts since everything is created in the same naming space, but
 		// GMS and Dolt use different naming spaces, so for now we just ignore potential name conflicts and return the first
@@ -155,6 +163,10 @@ var regclassout = framework.Function1{
 		if input.Section() == id.Section_OID {
 			return input.Segment(0), nil
 		}
+		// The temporary schema is always implicitly part of the search path
+		if input.Section() == id.Section_Sequence && temporary.IsSchema(ctx.Session.ID(), id.Sequence(input).SchemaName()) {
+			return id.Sequence(input).SequenceName(), nil
+		}
 		var output string
 		err = RunCallback(ctx, input, Callbacks{
 			Index: func(ctx *sql.Context, schema ItemSchema, table ItemTable, index ItemIndex) (cont bool, err error) {
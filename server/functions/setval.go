@@ -30,6 +30,7 @@ import (
 	"github.com/dolthub/doltgresql/core/id"
 	"github.com/dolthub/doltgresql/core/sequences"
 	"github.com/dolthub/doltgresql/server/functions/framework"
+	"github.com/dolthub/doltgresql/server/temporary"
 	pgtypes "github.com/dolthub/doltgresql/server/types"
 )
 
@@ -60,18 +61,32 @@ var setval_text_int64_boolean = framework.Function3{
 	IsNonDeterministic: true,
 	Strict:             true,
 	Callable: func(ctx *sql.Context, _ [4]*pgtypes.DoltgresType, val1 any, val2 any, val3 any) (any, error) {
-		// TODO: this needs a database name to support inserts into other databases (including inserts on other branches than the current one)
 		relationName, err := framework.UnwrapString(ctx, val1)
 		if err != nil {
 			return nil, err
 		}
 		newVal := val2.(int64)
 		autoAdvance := val3.(bool)
-		collection, err := core.GetSequencesCollectionFromContext(ctx, ctx.GetCurrentDatabase())
+		database, schema, relationBaseName, err := ParseQualifiedRelationName(ctx, relationName)
 		if err != nil {
 			return nil, err
 		}
-		db, err := getDb(ctx)
+		if len(database) == 0 {
+			if isTemporarySequence(ctx, schema, relationBaseName) {
+				if _, err = temporary.SetVal(ctx.Session.ID(), ctx.GetCurrentDatabase(), relationBaseName, newVal, autoAdvance); err != nil {
+					return nil, err
+				}
+				return newVal, nil
+			} else if schema != "" && temporary.IsSchema(ctx.Session.ID(), schema) {
+				return nil, errors.Errorf(`sequence "%s" does not exist`, relationName)
+			}
+			database = ctx.GetCurrentDatabase()
+		}
+		collection, err := core.GetSequencesCollectionFromContext(ctx, database)
+		if err != nil {
+			return nil, err
+		}
+		db, err := getDb(ctx, database)
 		if err != nil {
 			return nil, err
 		}
@@ -82,10 +97,6 @@ var setval_text_int64_boolean = framework.Function3{
 		var sequenceName doltdb.TableName
 		var sequence *sequences.Sequence
 		var seqId id.Sequence
-		schema, relationBaseName, err := ParseRelationName(ctx, relationName)
-		if err != nil {
-			return nil, err
-		}
 		if schema != "" {
 			sequenceName = doltdb.TableName{Schema: schema, Name: relationBaseName}
 			seqId = id.NewSequence(sequenceName.Schema, sequenceName.Name)
@@ -139,6 +150,8 @@ var setval_text_int64_boolean = framework.Function3{
 		if err != nil {
 			return nil, err
 		}
+		// Any values that this session allocated ahead of time would ignore the new value, so they're discarded
+		sequences.DiscardCachedValues(ctx.Session.ID(), db.RevisionQualifiedName(), seqId)
 		return newVal, nil
 	},
 }
@@ -194,32 +207,45 @@ func ParseRelationBaseName(ctx *sql.Context, name string) (string, error) {
 // identifier quotes used in the name. If the schema is not specified, an empty string is returned.
 // For example, passing in 'public."MyTable"' would return 'public' and 'MyTable'.
 func ParseRelationName(ctx *sql.Context, name string) (schema string, relation string, err error) {
+	_, schema, relation, err = ParseQualifiedRelationName(ctx, name)
+	return schema, relation, err
+}
+
+// ParseQualifiedRelationName is the same as ParseRelationName, except that it also returns the database name. If the
+// database is not specified, an empty string is returned.
+// For example, passing in '"postgres/main".public.test' would return 'postgres/main', 'public', and 'test'.
+func ParseQualifiedRelationName(ctx *sql.Context, name string) (database string, schema string, relation string, err error) {
 	pathElems := strings.Split(name, ".")
 	switch len(pathElems) {
 	case 1:
-		schema = ""
 		relation = pathElems[0]
 	case 2:
 		schema = pathElems[0]
 		relation = pathElems[1]
 	case 3:
-		// database is not used atm
+		database = pathElems[0]
 		schema = pathElems[1]
 		relation = pathElems[2]
 	default:
-		return "", "", errors.Errorf(`cannot parse relation: %s`, name)
+		return "", "", "", errors.Errorf(`cannot parse relation: %s`, name)
 	}
 
-	// Trim any quotes from the schema and the relation name
+	// Trim any quotes from the database, the schema, and the relation name
+	database = strings.Trim(database, `"`)
 	schema = strings.Trim(schema, `"`)
 	relation = strings.Trim(relation, `"`)
 
-	return schema, relation, nil
+	return database, schema, relation, nil
 }
 
-func getSequenceTracker(ctx *sql.Context) (*sequences.SequenceTracker, error) {
+// getSequenceTracker returns the sequence tracker for the given database. Uses the current database if an empty
+// string is provided.
+func getSequenceTracker(ctx *sql.Context, database string) (*sequences.SequenceTracker, error) {
 	sess := dsess.DSessFromSess(ctx.Session)
-	db, err := sess.Provider().Database(ctx, sess.GetCurrentDatabase())
+	if len(database) == 0 {
+		database = sess.GetCurrentDatabase()
+	}
+	db, err := sess.Provider().Database(ctx, database)
 	if err != nil {
 		return nil, err
 	}
@@ -230,9 +256,13 @@ func getSequenceTracker(ctx *sql.Context) (*sequences.SequenceTracker, error) {
 	return dsess.GetSequenceTracker(ctx, globalStateProvider.GetGlobalState(), sequences.SequenceTrackerKey)
 }
 
-func getDb(ctx *sql.Context) (sqle.Database, error) {
+// getDb returns the given database. Uses the current database if an empty string is provided.
+func getDb(ctx *sql.Context, database string) (sqle.Database, error) {
 	sess := dsess.DSessFromSess(ctx.Session)
-	db, err := sess.Provider().Database(ctx, sess.GetCurrentDatabase())
+	if len(database) == 0 {
+		database = sess.GetCurrentDatabase()
+	}
+	db, err := sess.Provider().Database(ctx, database)
 	if err != nil {
 		return sqle.Database{}, err
 	}
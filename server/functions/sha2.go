@@ -0,0 +1,97 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initSha2 registers the functions to the catalog.
+func initSha2() {
+	framework.RegisterFunction(sha224_bytea)
+	framework.RegisterFunction(sha256_bytea)
+	framework.RegisterFunction(sha384_bytea)
+	framework.RegisterFunction(sha512_bytea)
+}
+
+// sha224_bytea represents the PostgreSQL function of the same name, taking the same parameters.
+var sha224_bytea = framework.Function1{
+	Name:       "sha224",
+	Return:     pgtypes.Bytea,
+	Parameters: [1]*pgtypes.DoltgresType{pgtypes.Bytea},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [2]*pgtypes.DoltgresType, val any) (any, error) {
+		data, err := framework.UnwrapBytes(ctx, val)
+		if err != nil {
+			return nil, err
+		}
+		sum := sha256.Sum224(data)
+		return sum[:], nil
+	},
+}
+
+// sha256_bytea represents the PostgreSQL function of the same name, taking the same parameters.
+var sha256_bytea = framework.Function1{
+	Name:       "sha256",
+	Return:     pgtypes.Bytea,
+	Parameters: [1]*pgtypes.DoltgresType{pgtypes.Bytea},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [2]*pgtypes.DoltgresType, val any) (any, error) {
+		data, err := framework.UnwrapBytes(ctx, val)
+		if err != nil {
+			return nil, err
+		}
+		sum := sha256.Sum256(data)
+		return sum[:], nil
+	},
+}
+
+// sha384_bytea represents the PostgreSQL function of the same name, taking the same parameters.
+var sha384_bytea = framework.Function1{
+	Name:       "sha384",
+	Return:     pgtypes.Bytea,
+	Parameters: [1]*pgtypes.DoltgresType{pgtypes.Bytea},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [2]*pgtypes.DoltgresType, val any) (any, error) {
+		data, err := framework.UnwrapBytes(ctx, val)
+		if err != nil {
+			return nil, err
+		}
+		sum := sha512.Sum384(data)
+		return sum[:], nil
+	},
+}
+
+// sha512_bytea represents the PostgreSQL function of the same name, taking the same parameters.
+var sha512_bytea = framework.Function1{
+	Name:       "sha512",
+	Return:     pgtypes.Bytea,
+	Parameters: [1]*pgtypes.DoltgresType{pgtypes.Bytea},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [2]*pgtypes.DoltgresType, val any) (any, error) {
+		data, err := framework.UnwrapBytes(ctx, val)
+		if err != nil {
+			return nil, err
+		}
+		sum := sha512.Sum512(data)
+		return sum[:], nil
+	},
+}
@@ -71,6 +71,9 @@ var timestamp_out = framework.Function1{
 	Strict:     true,
 	Callable: func(ctx *sql.Context, _ [2]*pgtypes.DoltgresType, val any) (any, error) {
 		t := val.(time.Time)
+		if str, ok := formatInfiniteTime(t); ok {
+			return str, nil
+		}
 		return FormatDateTimeWithBC(t, getLayoutStringFormat(ctx, false), false), nil
 	},
 }
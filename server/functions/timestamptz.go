@@ -71,6 +71,9 @@ var timestamptz_out = framework.Function1{
 	Parameters: [1]*pgtypes.DoltgresType{pgtypes.TimestampTZ},
 	Strict:     true,
 	Callable: func(ctx *sql.Context, _ [2]*pgtypes.DoltgresType, val any) (any, error) {
+		if str, ok := formatInfiniteTime(val.(time.Time)); ok {
+			return str, nil
+		}
 		serverLoc, err := GetServerLocation(ctx)
 		if err != nil {
 			return "", err
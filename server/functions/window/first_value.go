@@ -0,0 +1,93 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package window
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// firstValue represents the PostgreSQL first_value(value) window function.
+var firstValue = framework.Func1Window{
+	Function1: framework.Function1{
+		Name:       "first_value",
+		Return:     pgtypes.AnyElement,
+		Parameters: [1]*pgtypes.DoltgresType{pgtypes.AnyElement},
+		Callable: func(ctx *sql.Context, paramsAndReturn [2]*pgtypes.DoltgresType, val1 any) (any, error) {
+			return nil, nil
+		},
+	},
+	NewWinFunc: func(exprs []sql.Expression, window *sql.WindowDefinition) (sql.WindowFunction, error) {
+		return newFrameValueWindowFunction(exprs, window, false)
+	},
+	FrameAware: true,
+}
+
+// lastValue represents the PostgreSQL last_value(value) window function.
+var lastValue = framework.Func1Window{
+	Function1: framework.Function1{
+		Name:       "last_value",
+		Return:     pgtypes.AnyElement,
+		Parameters: [1]*pgtypes.DoltgresType{pgtypes.AnyElement},
+		Callable: func(ctx *sql.Context, paramsAndReturn [2]*pgtypes.DoltgresType, val1 any) (any, error) {
+			return nil, nil
+		},
+	},
+	NewWinFunc: func(exprs []sql.Expression, window *sql.WindowDefinition) (sql.WindowFunction, error) {
+		return newFrameValueWindowFunction(exprs, window, true)
+	},
+	FrameAware: true,
+}
+
+// frameValueWindowFunction is the sql.WindowFunction used for first_value() and last_value() within an OVER(...)
+// clause. Like nth_value(), both respect the window's frame, so the default frame of a window with an ORDER BY ends at
+// the current row's last peer.
+type frameValueWindowFunction struct {
+	framework.WindowFramerState
+	valueExpr sql.Expression
+	last      bool
+}
+
+var _ sql.WindowFunction = (*frameValueWindowFunction)(nil)
+
+// newFrameValueWindowFunction creates the sql.WindowFunction for first_value() and last_value().
+func newFrameValueWindowFunction(exprs []sql.Expression, window *sql.WindowDefinition, last bool) (sql.WindowFunction, error) {
+	wf := &frameValueWindowFunction{valueExpr: exprs[0], last: last}
+	if err := wf.BindFramer(window); err != nil {
+		return nil, err
+	}
+	return wf, nil
+}
+
+// Compute implements the sql.WindowFunction interface.
+func (w *frameValueWindowFunction) Compute(ctx *sql.Context, interval sql.WindowInterval, buf sql.WindowBuffer) (interface{}, error) {
+	if interval.End <= interval.Start {
+		return nil, nil
+	}
+	rows := w.FrameRows(interval)
+	var idx int
+	var ok bool
+	if w.last {
+		idx, ok = rows.Last()
+	} else {
+		idx, ok = rows.Nth(0)
+	}
+	if !ok {
+		return nil, nil
+	}
+	return w.valueExpr.Eval(ctx, buf[idx])
+}
@@ -18,4 +18,5 @@ package window
 
 func Init() {
 	initRanking()
+	initValue()
 }
@@ -0,0 +1,209 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package window
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression/function/aggregation"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initValue registers the window functions that return a value from another row of the window to the catalog.
+func initValue() {
+	framework.RegisterWindowFunction(lag_anyelement)
+	framework.RegisterWindowFunction(lag_anyelement_int32)
+	framework.RegisterWindowFunction(lag_anycompatible_int32_anycompatible)
+	framework.RegisterWindowFunction(lead_anyelement)
+	framework.RegisterWindowFunction(lead_anyelement_int32)
+	framework.RegisterWindowFunction(lead_anycompatible_int32_anycompatible)
+	framework.RegisterWindowFunction(firstValue)
+	framework.RegisterWindowFunction(lastValue)
+}
+
+// lag_anyelement represents the PostgreSQL lag(value) window function.
+var lag_anyelement = framework.Func1Window{
+	Function1: framework.Function1{
+		Name:       "lag",
+		Return:     pgtypes.AnyElement,
+		Parameters: [1]*pgtypes.DoltgresType{pgtypes.AnyElement},
+		Callable: func(ctx *sql.Context, paramsAndReturn [2]*pgtypes.DoltgresType, val1 any) (any, error) {
+			return nil, nil
+		},
+	},
+	NewWinFunc: newLagWindowFunction,
+}
+
+// lag_anyelement_int32 represents the PostgreSQL lag(value, offset) window function.
+var lag_anyelement_int32 = framework.Func2Window{
+	Function2: framework.Function2{
+		Name:       "lag",
+		Return:     pgtypes.AnyElement,
+		Parameters: [2]*pgtypes.DoltgresType{pgtypes.AnyElement, pgtypes.Int32},
+		Callable: func(ctx *sql.Context, paramsAndReturn [3]*pgtypes.DoltgresType, val1, val2 any) (any, error) {
+			return nil, nil
+		},
+	},
+	NewWinFunc: newLagWindowFunction,
+}
+
+// lag_anycompatible_int32_anycompatible represents the PostgreSQL lag(value, offset, default) window function. The
+// value and default only need to share a common type, which is also the return type.
+var lag_anycompatible_int32_anycompatible = framework.Func3Window{
+	Function3: framework.Function3{
+		Name:       "lag",
+		Return:     pgtypes.AnyCompatible,
+		Parameters: [3]*pgtypes.DoltgresType{pgtypes.AnyCompatible, pgtypes.Int32, pgtypes.AnyCompatible},
+		Callable: func(ctx *sql.Context, paramsAndReturn [4]*pgtypes.DoltgresType, val1, val2, val3 any) (any, error) {
+			return nil, nil
+		},
+	},
+	NewWinFunc: newLagWindowFunction,
+}
+
+// lead_anyelement represents the PostgreSQL lead(value) window function.
+var lead_anyelement = framework.Func1Window{
+	Function1: framework.Function1{
+		Name:       "lead",
+		Return:     pgtypes.AnyElement,
+		Parameters: [1]*pgtypes.DoltgresType{pgtypes.AnyElement},
+		Callable: func(ctx *sql.Context, paramsAndReturn [2]*pgtypes.DoltgresType, val1 any) (any, error) {
+			return nil, nil
+		},
+	},
+	NewWinFunc: newLeadWindowFunction,
+}
+
+// lead_anyelement_int32 represents the PostgreSQL lead(value, offset) window function.
+var lead_anyelement_int32 = framework.Func2Window{
+	Function2: framework.Function2{
+		Name:       "lead",
+		Return:     pgtypes.AnyElement,
+		Parameters: [2]*pgtypes.DoltgresType{pgtypes.AnyElement, pgtypes.Int32},
+		Callable: func(ctx *sql.Context, paramsAndReturn [3]*pgtypes.DoltgresType, val1, val2 any) (any, error) {
+			return nil, nil
+		},
+	},
+	NewWinFunc: newLeadWindowFunction,
+}
+
+// lead_anycompatible_int32_anycompatible represents the PostgreSQL lead(value, offset, default) window function. The
+// value and default only need to share a common type, which is also the return type.
+var lead_anycompatible_int32_anycompatible = framework.Func3Window{
+	Function3: framework.Function3{
+		Name:       "lead",
+		Return:     pgtypes.AnyCompatible,
+		Parameters: [3]*pgtypes.DoltgresType{pgtypes.AnyCompatible, pgtypes.Int32, pgtypes.AnyCompatible},
+		Callable: func(ctx *sql.Context, paramsAndReturn [4]*pgtypes.DoltgresType, val1, val2, val3 any) (any, error) {
+			return nil, nil
+		},
+	},
+	NewWinFunc: newLeadWindowFunction,
+}
+
+// leadLagWindowFunction is the sql.WindowFunction used for lag() and lead() within an OVER(...) clause. Both functions
+// ignore the window's frame and always read from the whole partition, so DefaultFramer always returns a
+// partition-wide framer regardless of window.Frame. The offset and default are evaluated against the current row, and
+// a NULL offset produces a NULL result.
+type leadLagWindowFunction struct {
+	name        string
+	valueExpr   sql.Expression
+	offsetExpr  sql.Expression
+	defaultExpr sql.Expression
+	// direction is -1 for lag (which reads rows before the current row) and 1 for lead
+	direction int
+
+	partitionStart, partitionEnd int
+	pos                          int
+}
+
+var _ sql.WindowFunction = (*leadLagWindowFunction)(nil)
+
+// newLagWindowFunction creates the sql.WindowFunction for every overload of lag().
+func newLagWindowFunction(exprs []sql.Expression, _ *sql.WindowDefinition) (sql.WindowFunction, error) {
+	return newLeadLagWindowFunction("lag", -1, exprs)
+}
+
+// newLeadWindowFunction creates the sql.WindowFunction for every overload of lead().
+func newLeadWindowFunction(exprs []sql.Expression, _ *sql.WindowDefinition) (sql.WindowFunction, error) {
+	return newLeadLagWindowFunction("lead", 1, exprs)
+}
+
+// newLeadLagWindowFunction creates the sql.WindowFunction shared by lag() and lead(), whose optional offset and
+// default arguments are the second and third expressions.
+func newLeadLagWindowFunction(name string, direction int, exprs []sql.Expression) (sql.WindowFunction, error) {
+	if len(exprs) < 1 || len(exprs) > 3 {
+		return nil, sql.ErrInvalidArgumentNumber.New(name, "1, 2, or 3", len(exprs))
+	}
+	wf := &leadLagWindowFunction{name: name, valueExpr: exprs[0], direction: direction}
+	if len(exprs) > 1 {
+		wf.offsetExpr = exprs[1]
+	}
+	if len(exprs) > 2 {
+		wf.defaultExpr = exprs[2]
+	}
+	return wf, nil
+}
+
+// StartPartition implements the sql.WindowFunction interface.
+func (w *leadLagWindowFunction) StartPartition(ctx *sql.Context, interval sql.WindowInterval, buf sql.WindowBuffer) error {
+	w.partitionStart, w.partitionEnd = interval.Start, interval.End
+	w.pos = interval.Start
+	return nil
+}
+
+// DefaultFramer implements the sql.WindowFunction interface.
+func (w *leadLagWindowFunction) DefaultFramer() sql.WindowFramer {
+	return aggregation.NewPartitionFramer()
+}
+
+// Compute implements the sql.WindowFunction interface.
+func (w *leadLagWindowFunction) Compute(ctx *sql.Context, interval sql.WindowInterval, buf sql.WindowBuffer) (interface{}, error) {
+	if interval.End <= interval.Start {
+		return nil, nil
+	}
+	defer func() { w.pos++ }()
+	current := buf[w.pos]
+
+	offset := int64(1)
+	if w.offsetExpr != nil {
+		offsetVal, err := w.offsetExpr.Eval(ctx, current)
+		if err != nil {
+			return nil, err
+		}
+		if offsetVal == nil {
+			return nil, nil
+		}
+		n, ok := offsetVal.(int32)
+		if !ok {
+			return nil, errors.Errorf("%s: expected int32 offset, got %T", w.name, offsetVal)
+		}
+		offset = int64(n)
+	}
+
+	idx := int64(w.pos) + int64(w.direction)*offset
+	if idx >= int64(w.partitionStart) && idx < int64(w.partitionEnd) {
+		return w.valueExpr.Eval(ctx, buf[idx])
+	}
+	if w.defaultExpr != nil {
+		return w.defaultExpr.Eval(ctx, current)
+	}
+	return nil, nil
+}
+
+// Dispose implements the sql.WindowFunction interface.
+func (w *leadLagWindowFunction) Dispose(ctx *sql.Context) {}
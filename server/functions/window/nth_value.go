@@ -37,6 +37,7 @@ var nthValue = framework.Func2Window{
 		},
 	},
 	NewWinFunc: newNthValueWindowFunction,
+	FrameAware: true,
 }
 
 // nthValueWindowFunction is the sql.WindowFunction used for nth_value() within an OVER(...) clause.
@@ -65,6 +66,7 @@ func (w *nthValueWindowFunction) Compute(ctx *sql.Context, interval sql.WindowIn
 	if interval.End <= interval.Start {
 		return nil, nil
 	}
+	rows := w.FrameRows(interval)
 	nVal, err := w.nExpr.Eval(ctx, buf[interval.Start])
 	if err != nil {
 		return nil, err
@@ -80,8 +82,8 @@ func (w *nthValueWindowFunction) Compute(ctx *sql.Context, interval sql.WindowIn
 		return nil, sql.ErrInvalidArgument.New("NTH_VALUE")
 	}
 
-	idx := interval.Start + int(n) - 1
-	if idx >= interval.End {
+	idx, ok := rows.Nth(int(n) - 1)
+	if !ok {
 		return nil, nil
 	}
 	return w.valueExpr.Eval(ctx, buf[idx])
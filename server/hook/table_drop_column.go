@@ -24,9 +24,30 @@ import (
 
 	"github.com/dolthub/doltgresql/core"
 	"github.com/dolthub/doltgresql/core/id"
+	"github.com/dolthub/doltgresql/server/dependencies"
 	pgtypes "github.com/dolthub/doltgresql/server/types"
 )
 
+// BeforeTableDropColumn ensures that no other objects depend on the column being dropped.
+func BeforeTableDropColumn(ctx *sql.Context, _ sql.StatementRunner, nodeInterface sql.Node) (sql.Node, error) {
+	n, ok := nodeInterface.(*plan.DropColumn)
+	if !ok {
+		return nil, errors.Errorf("DROP COLUMN pre-hook expected `*plan.DropColumn` but received `%T`", nodeInterface)
+	}
+	doltTable := core.SQLNodeToDoltTable(n.Table)
+	if doltTable == nil {
+		// If this table isn't a Dolt table then we don't have anything to check
+		return n, nil
+	}
+	tableName := doltTable.TableName()
+	// CASCADE drops the dependent objects before this statement runs, so anything that remains is an error
+	column := dependencies.NewColumnObject(id.NewTable(tableName.Schema, tableName.Name), n.Column)
+	if err := dependencies.PrepareDrop(ctx, false, column); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
 // AfterTableDropColumn handles updating various table columns, alongside other validation that's unique to Doltgres.
 func AfterTableDropColumn(ctx *sql.Context, runner sql.StatementRunner, nodeInterface sql.Node) error {
 	n, ok := nodeInterface.(*plan.DropColumn)
@@ -36,6 +36,8 @@ import (
 	"github.com/dolthub/doltgresql/server/functions/framework"
 	"github.com/dolthub/doltgresql/server/functions/unary"
 	"github.com/dolthub/doltgresql/server/functions/window"
+	"github.com/dolthub/doltgresql/server/logrepl"
+	"github.com/dolthub/doltgresql/server/node"
 	"github.com/dolthub/doltgresql/server/tables"
 	"github.com/dolthub/doltgresql/server/tables/dtables"
 	"github.com/dolthub/doltgresql/server/tables/information_schema"
@@ -75,5 +77,6 @@ func Initialize(dEnv *env.DoltEnv, cfg *doltgresservercfg.DoltgresConfig) {
 		pgcatalog.Init()
 		information_schema.Init()
 		dtables.Init()
+		logrepl.WithGinIndexMaintenance = node.WithGinIndexMaintenance
 	})
 }
@@ -0,0 +1,71 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package isolation
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// Level is a transaction isolation level. The value matches the spelling used by the "transaction_isolation" and
+// "default_transaction_isolation" parameters.
+type Level string
+
+const (
+	ReadUncommitted Level = "read uncommitted"
+	ReadCommitted   Level = "read committed"
+	RepeatableRead  Level = "repeatable read"
+	Serializable    Level = "serializable"
+)
+
+// UsesStatementSnapshots returns whether each statement within a transaction should see all data that was committed
+// before the statement began. Postgres treats READ UNCOMMITTED as READ COMMITTED, so we do the same.
+func (l Level) UsesStatementSnapshots() bool {
+	return l == ReadCommitted || l == ReadUncommitted
+}
+
+// DefaultLevel returns the isolation level that new transactions will use when one is not explicitly given.
+func DefaultLevel(ctx *sql.Context) Level {
+	return levelFromVariable(ctx, "default_transaction_isolation")
+}
+
+// CurrentLevel returns the isolation level of the current transaction.
+func CurrentLevel(ctx *sql.Context) Level {
+	return levelFromVariable(ctx, "transaction_isolation")
+}
+
+// SetCurrentLevel sets the isolation level that is reported for the current transaction.
+func SetCurrentLevel(ctx *sql.Context, level Level) error {
+	return ctx.SetSessionVariable(ctx, "transaction_isolation", string(level))
+}
+
+// levelFromVariable reads the isolation level from the given session variable, defaulting to READ COMMITTED.
+func levelFromVariable(ctx *sql.Context, name string) Level {
+	val, err := ctx.GetSessionVariable(ctx, name)
+	if err != nil {
+		return ReadCommitted
+	}
+	str, ok := val.(string)
+	if !ok {
+		return ReadCommitted
+	}
+	switch level := Level(strings.ToLower(str)); level {
+	case ReadUncommitted, ReadCommitted, RepeatableRead, Serializable:
+		return level
+	default:
+		return ReadCommitted
+	}
+}
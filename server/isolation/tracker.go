@@ -0,0 +1,244 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package isolation
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/dolthub/doltgresql/core/id"
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/postgres/parser/pgerror"
+)
+
+// Table identifies a table that has been read or written by a transaction.
+type Table struct {
+	Database string
+	Table    id.Table
+}
+
+// transaction is the bookkeeping for an active transaction on a connection. inConflict is set once a concurrent
+// transaction has read a table that this transaction writes, and outConflict is set once this transaction has read a
+// table that a concurrent transaction wrote and committed first.
+type transaction struct {
+	level       Level
+	autocommit  bool
+	start       uint64
+	reads       map[Table]struct{}
+	writes      map[Table]struct{}
+	inConflict  bool
+	outConflict bool
+}
+
+// commitRecord is the bookkeeping for a committed SERIALIZABLE transaction, which is kept for as long as it may still
+// have a read/write dependency with an active SERIALIZABLE transaction.
+type commitRecord struct {
+	sequence    uint64
+	reads       map[Table]struct{}
+	writes      map[Table]struct{}
+	inConflict  bool
+	outConflict bool
+}
+
+// tracker detects read/write dependencies between concurrent SERIALIZABLE transactions, in the same manner as the
+// serializable snapshot isolation used by Postgres. Dolt already gives every transaction a consistent snapshot and
+// merges concurrent writes at commit, so the only anomalies left to prevent are those caused by a cycle of read/write
+// dependencies, such as write skew. Every such cycle contains a "pivot": a transaction that has both an incoming
+// dependency (a concurrent transaction read a table that it writes) and an outgoing dependency (it read a table that
+// a concurrent transaction writes). A transaction is therefore only failed when committing it would leave itself, or
+// an already-committed transaction, with both.
+//
+// Unlike Postgres, which tracks dependencies at the granularity of rows and index ranges, dependencies are tracked per
+// table, so transactions that read and write different rows of the same table may still fail. Postgres does not
+// track the reads of transactions that are not SERIALIZABLE, so neither do we.
+var tracker = struct {
+	mutex        sync.Mutex
+	sequence     uint64
+	transactions map[uint32]*transaction
+	commits      []*commitRecord
+}{
+	transactions: make(map[uint32]*transaction),
+}
+
+// Begin starts tracking a new transaction for the given connection, replacing any existing transaction. Autocommit
+// transactions have already been committed by the engine by the time that Commit is called, so they never fail
+// themselves, but their reads and writes are still recorded so that other transactions may be checked against them.
+func Begin(connectionID uint32, level Level, autocommit bool) {
+	tracker.mutex.Lock()
+	defer tracker.mutex.Unlock()
+	tracker.transactions[connectionID] = &transaction{
+		level:      level,
+		autocommit: autocommit,
+		start:      tracker.sequence,
+		reads:      make(map[Table]struct{}),
+		writes:     make(map[Table]struct{}),
+	}
+}
+
+// SetLevel changes the isolation level of the connection's current transaction.
+func SetLevel(connectionID uint32, level Level) {
+	tracker.mutex.Lock()
+	defer tracker.mutex.Unlock()
+	if tx, ok := tracker.transactions[connectionID]; ok {
+		tx.level = level
+	}
+}
+
+// TrackedLevel returns the isolation level of the connection's current transaction. Returns false if the connection
+// does not have a tracked transaction.
+func TrackedLevel(connectionID uint32) (Level, bool) {
+	tracker.mutex.Lock()
+	defer tracker.mutex.Unlock()
+	if tx, ok := tracker.transactions[connectionID]; ok {
+		return tx.level, true
+	}
+	return "", false
+}
+
+// Track records the tables that were read and written by a statement in the connection's current transaction. Only
+// SERIALIZABLE transactions are tracked, so the reads may be omitted for all other levels.
+func Track(connectionID uint32, reads []Table, writes []Table) {
+	tracker.mutex.Lock()
+	defer tracker.mutex.Unlock()
+	tx, ok := tracker.transactions[connectionID]
+	if !ok || tx.level != Serializable {
+		return
+	}
+	for _, table := range reads {
+		tx.reads[table] = struct{}{}
+	}
+	for _, table := range writes {
+		tx.writes[table] = struct{}{}
+	}
+}
+
+// Commit ends the connection's current transaction. If the transaction is SERIALIZABLE and its commit would complete
+// a dangerous structure of read/write dependencies among concurrent transactions, then this returns a
+// serialization_failure error, and the caller is expected to roll back the transaction.
+func Commit(connectionID uint32) error {
+	tracker.mutex.Lock()
+	defer tracker.mutex.Unlock()
+	tx, ok := tracker.transactions[connectionID]
+	if !ok {
+		return nil
+	}
+	delete(tracker.transactions, connectionID)
+	if tx.level != Serializable || (len(tx.reads) == 0 && len(tx.writes) == 0) {
+		pruneCommits()
+		return nil
+	}
+	// Dependencies are only applied once we know that the commit succeeds, so we first gather everything that they
+	// would change. Postgres only considers a pivot to be dangerous once the transaction on its outgoing side has
+	// committed first, so an outgoing dependency is only recorded against a transaction that has already committed.
+	inConflict, outConflict := tx.inConflict, tx.outConflict
+	var gainsIn []*commitRecord
+	for _, record := range tracker.commits {
+		if record.sequence <= tx.start {
+			// This committed before we started, so we can see all of its writes
+			continue
+		}
+		if intersects(tx.reads, record.writes) {
+			if record.outConflict && !tx.autocommit {
+				// The record would become a committed pivot, which can no longer be failed
+				return errSerializationFailure()
+			}
+			outConflict = true
+			gainsIn = append(gainsIn, record)
+		}
+		if intersects(record.reads, tx.writes) {
+			inConflict = true
+		}
+	}
+	var activeGainsIn, activeGainsOut []*transaction
+	for otherID, other := range tracker.transactions {
+		if otherID == connectionID || other.level != Serializable {
+			continue
+		}
+		if intersects(tx.reads, other.writes) {
+			activeGainsIn = append(activeGainsIn, other)
+		}
+		if intersects(other.reads, tx.writes) {
+			inConflict = true
+			activeGainsOut = append(activeGainsOut, other)
+		}
+	}
+	if inConflict && outConflict && !tx.autocommit {
+		return errSerializationFailure()
+	}
+	for _, record := range gainsIn {
+		record.inConflict = true
+	}
+	for _, other := range activeGainsIn {
+		other.inConflict = true
+	}
+	for _, other := range activeGainsOut {
+		other.outConflict = true
+	}
+	tracker.sequence++
+	tracker.commits = append(tracker.commits, &commitRecord{
+		sequence:    tracker.sequence,
+		reads:       tx.reads,
+		writes:      tx.writes,
+		inConflict:  inConflict,
+		outConflict: outConflict,
+	})
+	pruneCommits()
+	return nil
+}
+
+// Rollback ends the connection's current transaction without recording any of its writes.
+func Rollback(connectionID uint32) {
+	tracker.mutex.Lock()
+	defer tracker.mutex.Unlock()
+	delete(tracker.transactions, connectionID)
+	pruneCommits()
+}
+
+// pruneCommits removes all commit records that can no longer conflict with an active SERIALIZABLE transaction. This
+// assumes that the mutex is held.
+func pruneCommits() {
+	oldestStart := tracker.sequence
+	for _, tx := range tracker.transactions {
+		if tx.level == Serializable && tx.start < oldestStart {
+			oldestStart = tx.start
+		}
+	}
+	idx := 0
+	for idx < len(tracker.commits) && tracker.commits[idx].sequence <= oldestStart {
+		idx++
+	}
+	if idx > 0 {
+		tracker.commits = append([]*commitRecord(nil), tracker.commits[idx:]...)
+	}
+}
+
+// intersects returns whether the two sets of tables have any tables in common.
+func intersects(left map[Table]struct{}, right map[Table]struct{}) bool {
+	if len(right) < len(left) {
+		left, right = right, left
+	}
+	for table := range left {
+		if _, ok := right[table]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// errSerializationFailure returns the error that Postgres uses when it detects a dangerous structure between
+// SERIALIZABLE transactions. Clients are expected to retry the transaction when they receive this error.
+func errSerializationFailure() error {
+	return pgerror.WithCandidateCode(fmt.Errorf("could not serialize access due to read/write dependencies among transactions"), pgcode.SerializationFailure)
+}
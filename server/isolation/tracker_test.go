@@ -0,0 +1,135 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package isolation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/doltgresql/core/id"
+)
+
+// TestTrackerDependencies verifies that a SERIALIZABLE transaction only fails when its commit would leave a
+// transaction with both an incoming and an outgoing read/write dependency.
+func TestTrackerDependencies(t *testing.T) {
+	tableA := Table{Database: "postgres", Table: id.NewTable("public", "a")}
+	tableB := Table{Database: "postgres", Table: id.NewTable("public", "b")}
+	otherA := Table{Database: "other", Table: id.NewTable("public", "a")}
+
+	t.Run("write skew", func(t *testing.T) {
+		Begin(1, Serializable, false)
+		Begin(2, Serializable, false)
+		Track(1, []Table{tableA, tableB}, []Table{tableA})
+		Track(2, []Table{tableA, tableB}, []Table{tableB})
+		require.NoError(t, Commit(1))
+		err := Commit(2)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "could not serialize access")
+		Rollback(2)
+	})
+	t.Run("outgoing dependency only", func(t *testing.T) {
+		Begin(1, Serializable, false)
+		Begin(2, Serializable, false)
+		Track(1, []Table{tableA}, []Table{tableB})
+		Track(2, nil, []Table{tableA})
+		require.NoError(t, Commit(2))
+		require.NoError(t, Commit(1))
+	})
+	t.Run("incoming dependency only", func(t *testing.T) {
+		Begin(1, Serializable, false)
+		Begin(2, Serializable, false)
+		Track(1, []Table{tableA}, []Table{tableB})
+		Track(2, []Table{tableB}, nil)
+		require.NoError(t, Commit(2))
+		require.NoError(t, Commit(1))
+	})
+	t.Run("committed pivot", func(t *testing.T) {
+		// 2 reads what 1 writes after 1 commits first, and 3 then reads what 2 writes, so 2 is a committed pivot
+		Begin(1, Serializable, false)
+		Begin(2, Serializable, false)
+		Begin(3, Serializable, false)
+		Track(2, []Table{tableA}, []Table{tableB})
+		Track(1, nil, []Table{tableA})
+		require.NoError(t, Commit(1))
+		require.NoError(t, Commit(2))
+		Track(3, []Table{tableB}, []Table{otherA})
+		err := Commit(3)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "could not serialize access")
+		Rollback(3)
+	})
+	t.Run("pivot whose outgoing side commits last", func(t *testing.T) {
+		Begin(1, Serializable, false)
+		Begin(2, Serializable, false)
+		Begin(3, Serializable, false)
+		Track(3, []Table{tableB}, nil)
+		Track(2, []Table{tableA}, []Table{tableB})
+		Track(1, nil, []Table{tableA})
+		require.NoError(t, Commit(2))
+		require.NoError(t, Commit(1))
+		require.NoError(t, Commit(3))
+	})
+	t.Run("serial transactions", func(t *testing.T) {
+		Begin(1, Serializable, false)
+		Track(1, []Table{tableA, tableB}, []Table{tableA})
+		require.NoError(t, Commit(1))
+		Begin(2, Serializable, false)
+		Track(2, []Table{tableA, tableB}, []Table{tableB})
+		require.NoError(t, Commit(2))
+	})
+	t.Run("different databases", func(t *testing.T) {
+		Begin(1, Serializable, false)
+		Begin(2, Serializable, false)
+		Track(1, []Table{tableA, tableB}, []Table{tableA})
+		Track(2, []Table{otherA, tableB}, []Table{tableB})
+		require.NoError(t, Commit(1))
+		require.NoError(t, Commit(2))
+	})
+	t.Run("other isolation levels", func(t *testing.T) {
+		Begin(1, ReadCommitted, false)
+		Begin(2, Serializable, false)
+		Track(1, []Table{tableA, tableB}, []Table{tableA})
+		Track(2, []Table{tableA, tableB}, []Table{tableB})
+		require.NoError(t, Commit(1))
+		require.NoError(t, Commit(2))
+	})
+	t.Run("autocommit", func(t *testing.T) {
+		// Autocommit statements never fail themselves, but they may still be part of a dangerous structure
+		Begin(1, Serializable, false)
+		Track(1, []Table{tableA, tableB}, nil)
+		Begin(2, Serializable, true)
+		Track(2, []Table{tableA, tableB}, []Table{tableB})
+		require.NoError(t, Commit(2))
+		Track(1, nil, []Table{tableA})
+		err := Commit(1)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "could not serialize access")
+		Rollback(1)
+	})
+	t.Run("rollback", func(t *testing.T) {
+		Begin(1, Serializable, false)
+		Begin(2, Serializable, false)
+		Track(1, []Table{tableA, tableB}, []Table{tableA})
+		Track(2, []Table{tableA, tableB}, []Table{tableB})
+		Rollback(1)
+		require.NoError(t, Commit(2))
+	})
+	tracker.mutex.Lock()
+	defer tracker.mutex.Unlock()
+	assert.Empty(t, tracker.transactions)
+	assert.Empty(t, tracker.commits)
+}
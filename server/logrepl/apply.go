@@ -0,0 +1,687 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logrepl
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env/actions"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/jackc/pglogrepl"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/id"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// relationKeyFlag is the flag set on the columns of a relation message that are part of the relation's replica identity.
+const relationKeyFlag = 1
+
+// WithGinIndexMaintenance is a forward declaration to get around import cycles. It wraps a table so that the rows
+// written through its editors also update the posting lists of its GIN indexes.
+var WithGinIndexMaintenance func(ctx *sql.Context, table sql.Table) (sql.Table, error)
+
+// rowEditor is the set of methods shared by the engine's row inserters, updaters, and deleters.
+type rowEditor interface {
+	sql.EditOpenerCloser
+	sql.Closer
+}
+
+// applyInsert inserts the row described by the tuple into the relation's table.
+func applyInsert(ctx *sql.Context, rel *pglogrepl.RelationMessageV2, tuple *pglogrepl.TupleData) error {
+	table, err := getReplicaTable(ctx, rel)
+	if err != nil {
+		return err
+	}
+	editTable, err := WithGinIndexMaintenance(ctx, table)
+	if err != nil {
+		return err
+	}
+	insertable, ok := editTable.(sql.InsertableTable)
+	if !ok {
+		return errors.Errorf(`logical replication target relation "%s.%s" does not support inserts`, rel.Namespace, rel.RelationName)
+	}
+	row, err := tupleToRow(ctx, rel, table.Schema(ctx), tuple, nil)
+	if err != nil {
+		return err
+	}
+	inserter := insertable.Inserter(ctx)
+	return applyEdit(ctx, inserter, func() error {
+		return inserter.Insert(ctx, row)
+	})
+}
+
+// applyUpdate updates the row identified by the message's old tuple (or the new tuple, when the replica identity was
+// not changed) to the contents of the new tuple. Changes to the replica identity, including primary key changes, are
+// handled by the engine's updater, since it's given both the stored row and its replacement.
+func applyUpdate(ctx *sql.Context, rel *pglogrepl.RelationMessageV2, msg *pglogrepl.UpdateMessageV2) error {
+	table, err := getReplicaTable(ctx, rel)
+	if err != nil {
+		return err
+	}
+	editTable, err := WithGinIndexMaintenance(ctx, table)
+	if err != nil {
+		return err
+	}
+	updatable, ok := editTable.(sql.UpdatableTable)
+	if !ok {
+		return errors.Errorf(`logical replication target relation "%s.%s" does not support updates`, rel.Namespace, rel.RelationName)
+	}
+	sch := table.Schema(ctx)
+	identity := msg.OldTuple
+	if msg.OldTupleType == pglogrepl.UpdateMessageTupleTypeNone {
+		identity = msg.NewTuple
+	}
+	oldRow, err := findRow(ctx, table, rel, sch, identity, msg.OldTupleType == pglogrepl.UpdateMessageTupleTypeOld)
+	if err != nil {
+		return err
+	}
+	if oldRow == nil {
+		// Postgres only logs this case, as the row may have been deleted on the replica
+		log.Printf(`logical replication did not find row to be updated in replication target relation "%s.%s"`, rel.Namespace, rel.RelationName)
+		return nil
+	}
+	newRow, err := tupleToRow(ctx, rel, sch, msg.NewTuple, oldRow)
+	if err != nil {
+		return err
+	}
+	updater := updatable.Updater(ctx)
+	return applyEdit(ctx, updater, func() error {
+		return updater.Update(ctx, oldRow, newRow)
+	})
+}
+
+// applyDelete deletes the row identified by the message's old tuple.
+func applyDelete(ctx *sql.Context, rel *pglogrepl.RelationMessageV2, msg *pglogrepl.DeleteMessageV2) error {
+	table, err := getReplicaTable(ctx, rel)
+	if err != nil {
+		return err
+	}
+	editTable, err := WithGinIndexMaintenance(ctx, table)
+	if err != nil {
+		return err
+	}
+	deletable, ok := editTable.(sql.DeletableTable)
+	if !ok {
+		return errors.Errorf(`logical replication target relation "%s.%s" does not support deletes`, rel.Namespace, rel.RelationName)
+	}
+	oldRow, err := findRow(ctx, table, rel, table.Schema(ctx), msg.OldTuple, msg.OldTupleType == pglogrepl.DeleteMessageTupleTypeOld)
+	if err != nil {
+		return err
+	}
+	if oldRow == nil {
+		// Postgres only logs this case, as the row may have already been deleted on the replica
+		log.Printf(`logical replication did not find row to be deleted in replication target relation "%s.%s"`, rel.Namespace, rel.RelationName)
+		return nil
+	}
+	deleter := deletable.Deleter(ctx)
+	return applyEdit(ctx, deleter, func() error {
+		return deleter.Delete(ctx, oldRow)
+	})
+}
+
+// applyTruncate removes all rows from the tables of the given relations. Doltgres does not support restarting the
+// identity columns of a table, so the RESTART IDENTITY option is ignored. Like Postgres' apply worker, CASCADE is also
+// ignored, since the primary sends every table that it truncated.
+func applyTruncate(ctx *sql.Context, rels []*pglogrepl.RelationMessageV2) error {
+	for _, rel := range rels {
+		table, err := getReplicaTable(ctx, rel)
+		if err != nil {
+			return err
+		}
+		if table, err = WithGinIndexMaintenance(ctx, table); err != nil {
+			return err
+		}
+		truncatable, ok := table.(sql.TruncateableTable)
+		if !ok {
+			return errors.Errorf(`logical replication target relation "%s.%s" does not support truncation`, rel.Namespace, rel.RelationName)
+		}
+		if _, err = truncatable.Truncate(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// applyRelation brings the local table's columns in line with the relation's description from the primary. Columns that
+// are missing from the local table are added, and columns that were dropped since the primary's previous description
+// of the relation are dropped. Type changes and renames cannot be applied safely, so they return an error that stops
+// replication until the replica is altered to match.
+func applyRelation(ctx *sql.Context, state *replicationState, rel *pglogrepl.RelationMessageV2) error {
+	table, err := core.GetSqlTableFromContext(ctx, "", doltdb.TableName{Name: rel.RelationName, Schema: rel.Namespace})
+	if err != nil || table == nil {
+		// A missing table is reported once a change must be applied to it
+		return err
+	}
+	prev := state.relations[rel.RelationID]
+	if prev != nil {
+		if err = checkRelationColumns(prev, rel); err != nil {
+			return err
+		}
+		for _, prevCol := range prev.Columns {
+			if relationColumn(rel, prevCol.Name) != nil || table.Schema(ctx).IndexOfColName(prevCol.Name) < 0 {
+				continue
+			}
+			alterable, ok := table.(sql.AlterableTable)
+			if !ok {
+				return errors.Errorf(`logical replication target relation "%s.%s" does not support dropping column "%s"`, rel.Namespace, rel.RelationName, prevCol.Name)
+			}
+			log.Printf(`Dropping column "%s" from "%s.%s", as it was dropped on the primary`, prevCol.Name, rel.Namespace, rel.RelationName)
+			if err = alterable.DropColumn(ctx, prevCol.Name); err != nil {
+				return err
+			}
+			// The table must be reloaded after every alteration, as it does not reflect the altered schema
+			if table, err = getReplicaTable(ctx, rel); err != nil {
+				return err
+			}
+		}
+	}
+	for _, col := range rel.Columns {
+		if table.Schema(ctx).IndexOfColName(col.Name) >= 0 {
+			continue
+		}
+		alterable, ok := table.(sql.AlterableTable)
+		if !ok {
+			return errors.Errorf(`logical replication target relation "%s.%s" does not support adding column "%s"`, rel.Namespace, rel.RelationName, col.Name)
+		}
+		typ, err := relationColumnType(ctx, state, rel, col)
+		if err != nil {
+			return err
+		}
+		log.Printf(`Adding column "%s" to "%s.%s", as it was added on the primary`, col.Name, rel.Namespace, rel.RelationName)
+		err = alterable.AddColumn(ctx, &sql.Column{
+			Name:           col.Name,
+			Type:           typ,
+			Nullable:       true,
+			Source:         table.Name(),
+			DatabaseSource: ctx.GetCurrentDatabase(),
+		}, nil)
+		if err != nil {
+			return err
+		}
+		if table, err = getReplicaTable(ctx, rel); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkRelationColumns returns an error if any column of the relation changed type or was renamed since the previous
+// description of the relation. Renames are only detected when the number of columns is unchanged, as the primary only
+// identifies columns by name.
+func checkRelationColumns(prev *pglogrepl.RelationMessageV2, rel *pglogrepl.RelationMessageV2) error {
+	for i, col := range rel.Columns {
+		prevCol := relationColumn(prev, col.Name)
+		if prevCol == nil {
+			if len(prev.Columns) == len(rel.Columns) && relationColumn(rel, prev.Columns[i].Name) == nil {
+				return errors.Errorf(`column "%s" of logical replication target relation "%s.%s" was renamed to "%s" on the primary, `+
+					`which must be applied to the replica manually`, prev.Columns[i].Name, rel.Namespace, rel.RelationName, col.Name)
+			}
+			continue
+		}
+		if prevCol.DataType != col.DataType || prevCol.TypeModifier != col.TypeModifier {
+			return errors.Errorf(`column "%s" of logical replication target relation "%s.%s" changed type on the primary, `+
+				`which must be applied to the replica manually`, col.Name, rel.Namespace, rel.RelationName)
+		}
+	}
+	return nil
+}
+
+// relationColumn returns the relation's column with the given name, or nil if the relation does not have the column.
+func relationColumn(rel *pglogrepl.RelationMessageV2, name string) *pglogrepl.RelationMessageColumn {
+	for _, col := range rel.Columns {
+		if col.Name == name {
+			return col
+		}
+	}
+	return nil
+}
+
+// relationColumnType returns the local type of the relation's column. Built-in types share their OIDs with the primary,
+// while other types are found by the name that the primary sent in a type message.
+func relationColumnType(ctx *sql.Context, state *replicationState, rel *pglogrepl.RelationMessageV2, col *pglogrepl.RelationMessageColumn) (*pgtypes.DoltgresType, error) {
+	var typ *pgtypes.DoltgresType
+	if internalID := id.Cache().ToInternal(col.DataType); internalID.IsValid() {
+		typ = pgtypes.GetTypeByID(id.Type(internalID))
+	}
+	if typ == nil {
+		if typeMsg, ok := state.types[col.DataType]; ok {
+			typeColl, err := core.GetTypesCollectionFromContext(ctx, "")
+			if err != nil {
+				return nil, err
+			}
+			typ, err = typeColl.GetType(ctx, id.NewType(typeMsg.Namespace, typeMsg.Name))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	if typ == nil {
+		return nil, errors.Errorf(`cannot add column "%s" to logical replication target relation "%s.%s": type with OID %d does not exist`,
+			col.Name, rel.Namespace, rel.RelationName, col.DataType)
+	}
+	if col.TypeModifier != -1 {
+		typ = typ.WithAttTypMod(col.TypeModifier)
+	}
+	return typ, nil
+}
+
+// applyEdit runs the edit as a single statement against the given editor, discarding its changes if it fails.
+func applyEdit(ctx *sql.Context, editor rowEditor, edit func() error) error {
+	editor.StatementBegin(ctx)
+	if err := edit(); err != nil {
+		_ = editor.DiscardChanges(ctx, err)
+		_ = editor.Close(ctx)
+		return err
+	}
+	if err := editor.StatementComplete(ctx); err != nil {
+		_ = editor.Close(ctx)
+		return err
+	}
+	return editor.Close(ctx)
+}
+
+// getReplicaTable returns the local table that the relation replicates into.
+func getReplicaTable(ctx *sql.Context, rel *pglogrepl.RelationMessageV2) (sql.Table, error) {
+	table, err := core.GetSqlTableFromContext(ctx, "", doltdb.TableName{Name: rel.RelationName, Schema: rel.Namespace})
+	if err != nil {
+		return nil, err
+	}
+	if table == nil {
+		return nil, errors.Errorf(`logical replication target relation "%s.%s" does not exist`, rel.Namespace, rel.RelationName)
+	}
+	return table, nil
+}
+
+// tupleToRow converts the tuple into a row matching the local table's schema. Columns whose TOASTed value was not
+// changed are not sent by the primary, so they're taken from the stored row, which is nil for inserts. Local columns
+// that the primary doesn't have are left NULL for inserts.
+func tupleToRow(ctx *sql.Context, rel *pglogrepl.RelationMessageV2, sch sql.Schema, tuple *pglogrepl.TupleData, storedRow sql.Row) (sql.Row, error) {
+	row := make(sql.Row, len(sch))
+	copy(row, storedRow)
+	for relIdx, col := range tuple.Columns {
+		schIdx, err := replicaColumnIndex(rel, sch, relIdx)
+		if err != nil {
+			return nil, err
+		}
+		if col.DataType == pglogrepl.TupleDataTypeToast {
+			if storedRow == nil {
+				return nil, errors.Errorf(`logical replication received an unchanged TOAST value for column "%s" without a stored row`, sch[schIdx].Name)
+			}
+			continue
+		}
+		row[schIdx], err = decodeTupleColumn(ctx, sch[schIdx], col)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return row, nil
+}
+
+// findRow returns the stored row of the table that matches the identity tuple, or nil if no row matches. When |full|
+// is true, the tuple holds the entire old row (REPLICA IDENTITY FULL), so every column sent is compared, and the first
+// matching row is returned as a keyless table may have duplicates. Otherwise, only the relation's key columns are
+// compared.
+func findRow(ctx *sql.Context, table sql.Table, rel *pglogrepl.RelationMessageV2, sch sql.Schema, tuple *pglogrepl.TupleData, full bool) (sql.Row, error) {
+	if tuple == nil {
+		return nil, errors.Errorf(`logical replication target relation "%s.%s" has no replica identity`, rel.Namespace, rel.RelationName)
+	}
+	var schIndexes []int
+	var values []any
+	for relIdx, col := range tuple.Columns {
+		if col.DataType == pglogrepl.TupleDataTypeToast {
+			continue
+		}
+		if !full && relIdx < len(rel.Columns) && rel.Columns[relIdx].Flags&relationKeyFlag == 0 {
+			continue
+		}
+		schIdx, err := replicaColumnIndex(rel, sch, relIdx)
+		if err != nil {
+			return nil, err
+		}
+		val, err := decodeTupleColumn(ctx, sch[schIdx], col)
+		if err != nil {
+			return nil, err
+		}
+		schIndexes = append(schIndexes, schIdx)
+		values = append(values, val)
+	}
+	if len(schIndexes) == 0 {
+		return nil, errors.Errorf(`logical replication target relation "%s.%s" has no replica identity`, rel.Namespace, rel.RelationName)
+	}
+	if row, ok, err := lookupRowByIndex(ctx, table, sch, schIndexes, values); err != nil || ok {
+		return row, err
+	}
+
+	// The table has no key that the identity covers, so the row can only be found by scanning the table
+	partitions, err := table.Partitions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	iter := sql.NewTableRowIter(ctx, table, partitions)
+	defer iter.Close(ctx)
+	for {
+		row, err := iter.Next(ctx)
+		if err == io.EOF {
+			return nil, nil
+		} else if err != nil {
+			return nil, err
+		}
+		matches, err := rowMatchesIdentity(ctx, sch, row, schIndexes, values)
+		if err != nil {
+			return nil, err
+		}
+		if matches {
+			return row, nil
+		}
+	}
+}
+
+// lookupRowByIndex returns the stored row that matches the identity values, using the table's primary key or a unique
+// index whose columns are all part of the identity to find it. Returns false if the table has no such index, in which
+// case the table must be scanned instead.
+func lookupRowByIndex(ctx *sql.Context, table sql.Table, sch sql.Schema, schIndexes []int, values []any) (sql.Row, bool, error) {
+	indexAddressable, ok := table.(sql.IndexAddressableTable)
+	if !ok {
+		return nil, false, nil
+	}
+	indexes, err := indexAddressable.GetIndexes(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	identityValues := make(map[string]any, len(schIndexes))
+	for i, schIdx := range schIndexes {
+		identityValues[sch[schIdx].Name] = values[i]
+	}
+	idx := identityIndex(indexes, identityValues)
+	if idx == nil {
+		return nil, false, nil
+	}
+	builder := sql.NewMySQLIndexBuilder(ctx, idx)
+	for _, expr := range idx.Expressions() {
+		colName := strings.TrimPrefix(expr, idx.Table()+".")
+		builder = builder.Equals(ctx, strings.ToLower(expr), sch[sch.IndexOfColName(colName)].Type, identityValues[colName])
+	}
+	lookup, err := builder.Build(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	indexedTable := indexAddressable.IndexedAccess(ctx, lookup)
+	if indexedTable == nil {
+		return nil, false, nil
+	}
+	partitions, err := indexedTable.LookupPartitions(ctx, lookup)
+	if err != nil {
+		return nil, false, err
+	}
+	iter := sql.NewTableRowIter(ctx, indexedTable, partitions)
+	defer iter.Close(ctx)
+	for {
+		row, err := iter.Next(ctx)
+		if err == io.EOF {
+			return nil, true, nil
+		} else if err != nil {
+			return nil, false, err
+		}
+		// With REPLICA IDENTITY FULL, the remaining columns of the identity must also match
+		matches, err := rowMatchesIdentity(ctx, sch, row, schIndexes, values)
+		if err != nil {
+			return nil, false, err
+		}
+		if matches {
+			return row, true, nil
+		}
+	}
+}
+
+// identityIndex returns the index that may be used to find a row from its identity values, which is the primary key or
+// a unique index whose columns all have non-NULL identity values. Returns nil if there is no such index.
+func identityIndex(indexes []sql.Index, identityValues map[string]any) sql.Index {
+	var uniqueIndex sql.Index
+	for _, idx := range indexes {
+		if !idx.IsUnique() || len(idx.Expressions()) == 0 {
+			continue
+		}
+		covered := true
+		for _, expr := range idx.Expressions() {
+			if val, ok := identityValues[strings.TrimPrefix(expr, idx.Table()+".")]; !ok || val == nil {
+				covered = false
+				break
+			}
+		}
+		if !covered {
+			continue
+		}
+		if idx.ID() == "PRIMARY" {
+			return idx
+		}
+		if uniqueIndex == nil {
+			uniqueIndex = idx
+		}
+	}
+	return uniqueIndex
+}
+
+// rowMatchesIdentity returns whether the row's values at the given schema indexes equal the identity values. As with
+// Postgres' apply worker, two NULL values are considered equal.
+func rowMatchesIdentity(ctx *sql.Context, sch sql.Schema, row sql.Row, schIndexes []int, values []any) (bool, error) {
+	for i, schIdx := range schIndexes {
+		if row[schIdx] == nil || values[i] == nil {
+			if row[schIdx] != values[i] {
+				return false, nil
+			}
+			continue
+		}
+		cmp, err := sch[schIdx].Type.Compare(ctx, row[schIdx], values[i])
+		if err != nil {
+			return false, err
+		}
+		if cmp != 0 {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// replicaColumnIndex returns the index in the local schema of the relation's column at the given index. Columns are
+// matched by name, as the primary's column order may differ from the replica's.
+func replicaColumnIndex(rel *pglogrepl.RelationMessageV2, sch sql.Schema, relIdx int) (int, error) {
+	if relIdx >= len(rel.Columns) {
+		return 0, errors.Errorf(`logical replication received a tuple with more columns than relation "%s.%s"`, rel.Namespace, rel.RelationName)
+	}
+	schIdx := sch.IndexOfColName(rel.Columns[relIdx].Name)
+	if schIdx < 0 {
+		return 0, errors.Errorf(`logical replication target relation "%s.%s" is missing replicated column: "%s"`,
+			rel.Namespace, rel.RelationName, rel.Columns[relIdx].Name)
+	}
+	return schIdx, nil
+}
+
+// decodeTupleColumn decodes the tuple column into a value of the local column's type, using the type's input function
+// for the text format and its receive function for the binary format.
+func decodeTupleColumn(ctx *sql.Context, col *sql.Column, tupleCol *pglogrepl.TupleDataColumn) (any, error) {
+	if tupleCol.DataType == pglogrepl.TupleDataTypeNull {
+		return nil, nil
+	}
+	typ, ok := col.Type.(*pgtypes.DoltgresType)
+	if !ok {
+		return nil, errors.Errorf(`column "%s" has unsupported type %s`, col.Name, col.Type.String())
+	}
+	switch tupleCol.DataType {
+	case pglogrepl.TupleDataTypeText:
+		return typ.IoInput(ctx, string(tupleCol.Data))
+	case pglogrepl.TupleDataTypeBinary:
+		return typ.CallReceive(ctx, tupleCol.Data)
+	default:
+		return nil, errors.Errorf("unknown column data type: %c", tupleCol.DataType)
+	}
+}
+
+// beginTransaction starts the transaction that the changes of an upstream transaction are applied in.
+func beginTransaction(ctx *sql.Context) error {
+	ts, ok := ctx.Session.(sql.TransactionSession)
+	if !ok {
+		return nil
+	}
+	if tx := ctx.GetTransaction(); tx != nil {
+		// An upstream transaction whose commit never arrived is incomplete, so it must not be applied
+		if err := ts.Rollback(ctx, tx); err != nil {
+			return err
+		}
+	}
+	tx, err := ts.StartTransaction(ctx, sql.ReadWrite)
+	if err != nil {
+		return err
+	}
+	ctx.SetTransaction(tx)
+	ctx.SetIgnoreAutoCommit(true)
+	return nil
+}
+
+// commitTransaction commits the transaction started by beginTransaction.
+func commitTransaction(ctx *sql.Context) error {
+	ts, ok := ctx.Session.(sql.TransactionSession)
+	if !ok || ctx.GetTransaction() == nil {
+		return nil
+	}
+	if err := ts.CommitTransaction(ctx, ctx.GetTransaction()); err != nil {
+		return err
+	}
+	ctx.SetIgnoreAutoCommit(false)
+	ctx.SetTransaction(nil)
+	return nil
+}
+
+// The keys of the trailer lines in the message of the Dolt commit that is created for a replicated transaction. The
+// message format is a stable contract, so that the upstream transaction of any commit can be found with dolt_log: the
+// first line is a summary, followed by a blank line, and then one "key: value" line for each of these keys. The origin
+// line is only present for transactions that were themselves replicated to the primary. The commit's date is also the
+// upstream commit time.
+const (
+	ReplicatedCommitLSNKey    = "upstream_lsn"
+	ReplicatedCommitTimeKey   = "upstream_commit_time"
+	ReplicatedCommitOriginKey = "upstream_origin"
+)
+
+// replicatedCommitSummaryFormat is the format of the first line of the message of a replicated transaction's commit.
+const replicatedCommitSummaryFormat = "Replicated transaction at LSN %s"
+
+// doltCommitTransaction creates a Dolt commit of the tables that the current upstream transaction changed. Other
+// changes in the working set are left uncommitted, and the commit is refused if any changes were already staged, as
+// they would otherwise become part of the commit. The commit is dated with the upstream commit time, and its message
+// records the transaction's LSN and origin in the format described by ReplicatedCommitLSNKey. A commit is created even
+// when the transaction had no effect on the replica, so that every upstream transaction has a commit.
+func doltCommitTransaction(ctx *sql.Context, state *replicationState, commitLSN pglogrepl.LSN, commitTime time.Time) error {
+	message := strings.Builder{}
+	fmt.Fprintf(&message, replicatedCommitSummaryFormat+"\n\n", commitLSN)
+	fmt.Fprintf(&message, "%s: %s\n", ReplicatedCommitLSNKey, commitLSN)
+	fmt.Fprintf(&message, "%s: %s\n", ReplicatedCommitTimeKey, commitTime.UTC().Format(time.RFC3339Nano))
+	if len(state.currentTransactionOrigin) > 0 {
+		fmt.Fprintf(&message, "%s: %s\n", ReplicatedCommitOriginKey, state.currentTransactionOrigin)
+	}
+	dbName := ctx.GetCurrentDatabase()
+	sess := dsess.DSessFromSess(ctx.Session)
+	roots, ok := sess.GetRoots(ctx, dbName)
+	if !ok {
+		return errors.Errorf("unable to get roots for database %s", dbName)
+	}
+	headHash, err := roots.Head.HashOf()
+	if err != nil {
+		return err
+	}
+	stagedHash, err := roots.Staged.HashOf()
+	if err != nil {
+		return err
+	}
+	if headHash != stagedHash {
+		return errors.Errorf("database %s has staged changes, which must be committed or reset before replicated "+
+			"transactions can be committed", dbName)
+	}
+	tables, err := replicatedTables(ctx, state)
+	if err != nil {
+		return err
+	}
+	roots, err = actions.StageTables(ctx, roots, tables, false)
+	if err != nil {
+		return err
+	}
+	if err = sess.SetRoots(ctx, dbName, roots); err != nil {
+		return err
+	}
+	pendingCommit, err := sess.NewPendingCommit(ctx, dbName, roots, actions.CommitStagedProps{
+		Message:    message.String(),
+		Date:       commitTime.UTC(),
+		AllowEmpty: true,
+		Name:       ctx.Client().User,
+		Email:      fmt.Sprintf("%s@%s", ctx.Client().User, ctx.Client().Address),
+	})
+	if err != nil {
+		return err
+	}
+	_, err = sess.DoltCommit(ctx, dbName, ctx.GetTransaction(), pendingCommit)
+	return err
+}
+
+// replicatedTables returns the local tables that the current upstream transaction changed, along with the tables that
+// hold the posting lists of their GIN indexes, as those change along with them.
+func replicatedTables(ctx *sql.Context, state *replicationState) ([]doltdb.TableName, error) {
+	collection, err := core.GetIndexAccessMethodsCollectionFromContext(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	var tables []doltdb.TableName
+	for relationID := range state.currentTransactionRelations {
+		rel, err := state.relation(relationID)
+		if err != nil {
+			return nil, err
+		}
+		table, err := core.GetSqlTableFromContext(ctx, "", doltdb.TableName{Name: rel.RelationName, Schema: rel.Namespace})
+		if err != nil {
+			return nil, err
+		}
+		schemaTable, ok := table.(sql.DatabaseSchemaTable)
+		if !ok {
+			// The relation was only described, as changes to missing tables fail before they're applied
+			continue
+		}
+		schema := schemaTable.DatabaseSchema().SchemaName()
+		tables = append(tables, doltdb.TableName{Name: table.Name(), Schema: schema})
+		for _, accessMethod := range collection.GetAccessMethodsForTable(ctx, id.NewTable(schema, table.Name())) {
+			if accessMethod.IsGin() {
+				tables = append(tables, doltdb.TableName{Name: accessMethod.Postings, Schema: schema})
+			}
+		}
+	}
+	return tables, nil
+}
+
+// rollbackTransaction discards the transaction started by beginTransaction, if there is one.
+func rollbackTransaction(ctx *sql.Context) error {
+	ts, ok := ctx.Session.(sql.TransactionSession)
+	if !ok || ctx.GetTransaction() == nil {
+		return nil
+	}
+	err := ts.Rollback(ctx, ctx.GetTransaction())
+	ctx.SetIgnoreAutoCommit(false)
+	ctx.SetTransaction(nil)
+	return err
+}
@@ -25,14 +25,12 @@ import (
 	"time"
 
 	"github.com/cockroachdb/errors"
+	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/jackc/pglogrepl"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgproto3"
 	"github.com/jackc/pgx/v5/pgtype"
-	"github.com/lib/pq/oid"
-
-	"github.com/dolthub/doltgresql/postgres/parser/uuid"
 )
 
 const outputPlugin = "pgoutput"
@@ -42,9 +40,21 @@ type rcvMsg struct {
 	err error
 }
 
+// SqlContextFactory returns a new context for applying replicated changes. The context's session must use the
+// database that changes are replicated into as its current database.
+type SqlContextFactory func(ctx context.Context) (*sql.Context, error)
+
 type LogicalReplicator struct {
-	primaryDns     string
-	replicationDns string
+	primaryDns        string
+	sqlContextFactory SqlContextFactory
+	// doltCommits is whether a Dolt commit is created for each replicated transaction.
+	doltCommits bool
+	// publications are the publications whose changes are replicated. When empty, the publication with the same name
+	// as the replication slot is used.
+	publications []string
+
+	// status is the progress of replication, which is protected by mu.
+	status ReplicationStatus
 
 	walFilePath     string
 	running         bool
@@ -53,18 +63,41 @@ type LogicalReplicator struct {
 	mu              *sync.Mutex
 }
 
-// NewLogicalReplicator creates a new logical replicator instance which connects to the primary and replication
-// databases using the connection strings provided. The connection to the replica is established immediately, and the
-// connection to the primary is established when StartReplication is called.
-func NewLogicalReplicator(walFilePath string, primaryDns string, replicationDns string) (*LogicalReplicator, error) {
+// NewLogicalReplicator creates a new logical replicator instance which connects to the primary database using the
+// connection string provided, and applies the changes it receives through the contexts returned by the given factory.
+// The connection to the primary is established when StartReplication is called.
+func NewLogicalReplicator(walFilePath string, primaryDns string, sqlContextFactory SqlContextFactory) (*LogicalReplicator, error) {
 	return &LogicalReplicator{
-		primaryDns:     primaryDns,
-		replicationDns: replicationDns,
-		walFilePath:    walFilePath,
-		mu:             &sync.Mutex{},
+		primaryDns:        primaryDns,
+		sqlContextFactory: sqlContextFactory,
+		walFilePath:       walFilePath,
+		mu:                &sync.Mutex{},
 	}, nil
 }
 
+// SetDoltCommits sets whether a Dolt commit is created for each replicated transaction, in addition to committing the
+// transaction to the working set. Each commit only includes the tables that its transaction changed, and its message
+// records the upstream transaction's LSN, commit timestamp, and origin in the format described by
+// ReplicatedCommitLSNKey, so that the replica's history can be used to find the state of the primary as of any
+// transaction. This must be set before StartReplication is called.
+func (r *LogicalReplicator) SetDoltCommits(doltCommits bool) {
+	r.doltCommits = doltCommits
+}
+
+// SetPublications sets the publications whose changes are replicated. By default, the publication with the same name
+// as the replication slot is used. This must be set before SynchronizeTables or StartReplication is called.
+func (r *LogicalReplicator) SetPublications(publications []string) {
+	r.publications = publications
+}
+
+// publicationNames returns the names of the publications whose changes are replicated through the given slot.
+func (r *LogicalReplicator) publicationNames(slotName string) []string {
+	if len(r.publications) == 0 {
+		return []string{slotName}
+	}
+	return r.publications
+}
+
 // PrimaryDns returns the DNS for the primary database. Not suitable for RPCs used in replication e.g.
 // StartReplication. See ReplicationDns.
 func (r *LogicalReplicator) PrimaryDns() string {
@@ -72,8 +105,11 @@ func (r *LogicalReplicator) PrimaryDns() string {
 }
 
 // ReplicationDns returns the DNS for the primary database with the replication query parameter appended. Not suitable
-// for normal query RPCs.
+// for normal query RPCs. Both URLs and keyword/value connection strings are supported.
 func (r *LogicalReplicator) ReplicationDns() string {
+	if !strings.HasPrefix(r.primaryDns, "postgres://") && !strings.HasPrefix(r.primaryDns, "postgresql://") {
+		return fmt.Sprintf("%s replication=database", r.primaryDns)
+	}
 	if strings.Contains(r.primaryDns, "?") {
 		return fmt.Sprintf("%s&replication=database", r.primaryDns)
 	}
@@ -133,6 +169,43 @@ func (r *LogicalReplicator) CaughtUp(threshold int) (bool, error) {
 	return true, nil
 }
 
+// ReplicationStatus is the progress of a replicator, as reported by pg_stat_subscription.
+type ReplicationStatus struct {
+	// ReceivedLSN is the last WAL location received from the primary.
+	ReceivedLSN pglogrepl.LSN
+	// LastMsgSendTime is the time that the last message received from the primary was sent.
+	LastMsgSendTime time.Time
+	// LastMsgReceiptTime is the time that the last message from the primary was received.
+	LastMsgReceiptTime time.Time
+	// LatestEndLSN is the last WAL location reported to the primary in response to a keepalive message.
+	LatestEndLSN pglogrepl.LSN
+	// LatestEndTime is the time of the last WAL location reported to the primary.
+	LatestEndTime time.Time
+}
+
+// Status returns the progress of replication. The times are zero until the first message is received.
+func (r *LogicalReplicator) Status() ReplicationStatus {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.status
+}
+
+// recordReceived updates the replication status for a message received from the primary, which ends at the given
+// WAL location. Keepalive messages are answered with our position, which is recorded as the latest end location.
+func (r *LogicalReplicator) recordReceived(endLSN pglogrepl.LSN, sendTime time.Time, keepalive bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.status.ReceivedLSN < endLSN {
+		r.status.ReceivedLSN = endLSN
+	}
+	r.status.LastMsgSendTime = sendTime
+	r.status.LastMsgReceiptTime = time.Now()
+	if keepalive {
+		r.status.LatestEndLSN = r.status.ReceivedLSN
+		r.status.LatestEndTime = sendTime
+	}
+}
+
 // maxConsecutiveFailures is the maximum number of consecutive RPC errors that can occur before we stop
 // the replication thread
 const maxConsecutiveFailures = 10
@@ -140,8 +213,8 @@ const maxConsecutiveFailures = 10
 var errShutdownRequested = errors.New("shutdown requested")
 
 type replicationState struct {
-	// replicaConn is the current connection to the replica database, which can be re-established if it fails
-	replicaConn *pgx.Conn
+	// replicaCtx is the context that changes are applied to the replica database with
+	replicaCtx *sql.Context
 
 	// lastWrittenLSN is the LSN of the commit record of the last transaction that was successfully replicated to the
 	// database.
@@ -155,10 +228,27 @@ type replicationState struct {
 	// when we get a CommitMessage
 	currentTransactionLSN pglogrepl.LSN
 
+	// currentTransactionOrigin is the name of the replication origin of the current transaction, which is only sent
+	// for transactions that were themselves replicated to the primary from elsewhere.
+	currentTransactionOrigin string
+
+	// currentTransactionRelations are the IDs of the relations that the current transaction changed, whose tables are
+	// the only ones included in the Dolt commit of the transaction.
+	currentTransactionRelations map[uint32]struct{}
+
 	// inStream tracks the state of the replication stream. When we receive a StreamStartMessage, we set inStream to
 	// true, and then back to false when we receive a StreamStopMessage.
 	inStream bool
 
+	// streamXid is the ID of the in-progress transaction whose changes are being streamed, which is only valid while
+	// inStream is true.
+	streamXid uint32
+
+	// streamedTransactions holds the changes of in-progress transactions that the primary has streamed to us, keyed by
+	// the ID of the top-level transaction. The changes are buffered until the transaction's StreamCommitMessage, as the
+	// transaction may still be aborted by a StreamAbortMessage.
+	streamedTransactions map[uint32][]streamedChange
+
 	// We selectively ignore messages that are from before our last flush, which can be resent by postgres in certain
 	// crash scenarios. Postgres sends messages in batches based on changes in a transaction, beginning with a Begin
 	// message that records the last WAL position of the transaction. The individual INSERT, UPDATE, DELETE messages are
@@ -168,7 +258,18 @@ type replicationState struct {
 	// process or ignore all messages until a corresponding Commit message.
 	processMessages bool
 	relations       map[uint32]*pglogrepl.RelationMessageV2
-	typeMap         *pgtype.Map
+	types           map[uint32]*pglogrepl.TypeMessageV2
+
+	// syncedTables holds the consistent point that each table's contents were copied at by SynchronizeTables.
+	syncedTables map[syncedTableKey]pglogrepl.LSN
+}
+
+// streamedChange is a change that belongs to a streamed in-progress transaction.
+type streamedChange struct {
+	// xid is the ID of the (sub)transaction that made the change.
+	xid uint32
+	// walData is a copy of the message's WAL data, as the buffer of a received message is reused.
+	walData []byte
 }
 
 // StartReplication starts the replication process for the given slot name. This function blocks until replication is
@@ -182,17 +283,24 @@ func (r *LogicalReplicator) StartReplication(slotName string) error {
 		return err
 	}
 
-	// TODO: we need to be able to re-establish this connection if it goes bad
-	replicationConn, err := pgx.Connect(context.Background(), r.replicationDns)
+	syncStatus, err := r.readTableSyncStatus()
+	if err != nil {
+		return err
+	}
+
+	replicaCtx, err := r.sqlContextFactory(context.Background())
 	if err != nil {
 		return err
 	}
 
 	state := &replicationState{
 		lastWrittenLSN: lastWrittenLsn,
-		replicaConn:    replicationConn,
+		replicaCtx:     replicaCtx,
 		relations:      map[uint32]*pglogrepl.RelationMessageV2{},
-		typeMap:        pgtype.NewMap(),
+		types:          map[uint32]*pglogrepl.TypeMessageV2{},
+		syncedTables:   syncStatus.syncedTableLSNs(),
+
+		currentTransactionRelations: map[uint32]struct{}{},
 	}
 
 	var primaryConn *pgconn.PgConn
@@ -200,9 +308,9 @@ func (r *LogicalReplicator) StartReplication(slotName string) error {
 		if primaryConn != nil {
 			_ = primaryConn.Close(context.Background())
 		}
-		if state.replicaConn != nil {
-			_ = state.replicaConn.Close(context.Background())
-		}
+		// Any transaction that we're in the middle of applying is incomplete, so it's discarded
+		_ = rollbackTransaction(state.replicaCtx)
+		sql.SessionEnd(state.replicaCtx.Session)
 		// We always shut down here and only here, so we do the cleanup on thread exit in exactly one place
 		r.shutdown()
 	}()
@@ -264,6 +372,10 @@ func (r *LogicalReplicator) StartReplication(slotName string) error {
 			}
 
 			if primaryConn == nil {
+				// The primary resends any in-progress transactions from their beginning when replication restarts
+				state.inStream = false
+				state.streamedTransactions = map[uint32][]streamedChange{}
+
 				var err error
 				primaryConn, err = r.beginReplication(slotName, state.lastWrittenLSN)
 				if err != nil {
@@ -336,6 +448,7 @@ func (r *LogicalReplicator) StartReplication(slotName string) error {
 
 				log.Println("Primary Keepalive Message =>", "ServerWALEnd:", pkm.ServerWALEnd, "ServerTime:", pkm.ServerTime, "ReplyRequested:", pkm.ReplyRequested)
 				state.lastReceivedLSN = pkm.ServerWALEnd
+				r.recordReceived(pkm.ServerWALEnd, pkm.ServerTime, true)
 
 				if pkm.ReplyRequested {
 					// Send our reply the next time through the loop
@@ -346,10 +459,16 @@ func (r *LogicalReplicator) StartReplication(slotName string) error {
 				if err != nil {
 					return err
 				}
+				r.recordReceived(xld.WALStart+pglogrepl.LSN(len(xld.WALData)), xld.ServerTime, false)
 
 				committed, err := r.processMessage(xld, state)
 				if err != nil {
-					// TODO: do we need more than one handler, one for each connection?
+					// The primary resends the entire transaction when we reconnect, so the partially applied changes
+					// must be discarded
+					if rollbackErr := rollbackTransaction(state.replicaCtx); rollbackErr != nil {
+						return rollbackErr
+					}
+					state.processMessages = false
 					return handleErrWithRetry(err, true)
 				}
 
@@ -414,13 +533,6 @@ func (r *LogicalReplicator) Stop() {
 	<-r.stop
 }
 
-// replicateQuery executes the query provided on the replica connection
-func (r *LogicalReplicator) replicateQuery(replicationConn *pgx.Conn, query string) error {
-	log.Printf("replicating query: %s", query)
-	_, err := replicationConn.Exec(context.Background(), query)
-	return err
-}
-
 // beginReplication starts a new replication connection to the primary server and returns it. The LSN provided is the
 // last one we have confirmed that we flushed to disk.
 func (r *LogicalReplicator) beginReplication(slotName string, lastFlushLsn pglogrepl.LSN) (*pgconn.PgConn, error) {
@@ -433,7 +545,7 @@ func (r *LogicalReplicator) beginReplication(slotName string, lastFlushLsn pglog
 	// we also need to set 'streaming' to 'true'
 	pluginArguments := []string{
 		"proto_version '2'",
-		fmt.Sprintf("publication_names '%s'", slotName),
+		fmt.Sprintf("publication_names '%s'", quotePublicationNames(r.publicationNames(slotName))),
 		"messages 'true'",
 		"streaming 'true'",
 	}
@@ -454,6 +566,16 @@ func (r *LogicalReplicator) beginReplication(slotName string, lastFlushLsn pglog
 	return conn, nil
 }
 
+// quotePublicationNames returns the publication names as a comma-separated list of quoted identifiers, escaped for use
+// within a string literal of the plugin arguments.
+func quotePublicationNames(publications []string) string {
+	quoted := make([]string, len(publications))
+	for i, publication := range publications {
+		quoted[i] = pgx.Identifier{publication}.Sanitize()
+	}
+	return strings.ReplaceAll(strings.Join(quoted, ","), "'", "''")
+}
+
 // DropPublication drops the publication with the given name if it exists. Mostly useful for testing.
 func DropPublication(primaryDns, slotName string) error {
 	conn, err := pgconn.Connect(context.Background(), primaryDns)
@@ -493,16 +615,17 @@ func (r *LogicalReplicator) DropReplicationSlot(slotName string) error {
 	return nil
 }
 
-// CreateReplicationSlotIfNecessary creates the replication slot named if it doesn't already exist.
-func (r *LogicalReplicator) CreateReplicationSlotIfNecessary(slotName string) error {
+// replicationSlotExists returns whether the replication slot with the given name exists on the primary.
+func (r *LogicalReplicator) replicationSlotExists(slotName string) (bool, error) {
 	conn, err := pgx.Connect(context.Background(), r.PrimaryDns())
 	if err != nil {
-		return err
+		return false, err
 	}
+	defer conn.Close(context.Background())
 
 	rows, err := conn.Query(context.Background(), "select * from pg_replication_slots where slot_name = $1", slotName)
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	slotExists := false
@@ -510,17 +633,24 @@ func (r *LogicalReplicator) CreateReplicationSlotIfNecessary(slotName string) er
 	for rows.Next() {
 		_, err := rows.Values()
 		if err != nil {
-			return err
+			return false, err
 		}
 		slotExists = true
 	}
 
-	if rows.Err() != nil {
-		return rows.Err()
+	return slotExists, rows.Err()
+}
+
+// CreateReplicationSlotIfNecessary creates the replication slot named if it doesn't already exist. Use
+// SynchronizeTables instead to also copy the existing contents of the published tables.
+func (r *LogicalReplicator) CreateReplicationSlotIfNecessary(slotName string) error {
+	slotExists, err := r.replicationSlotExists(slotName)
+	if err != nil {
+		return err
 	}
 
-	// We need a different connection to create the replication slot
-	conn, err = pgx.Connect(context.Background(), r.ReplicationDns())
+	// We need a replication connection to create the replication slot
+	conn, err := pgx.Connect(context.Background(), r.ReplicationDns())
 	if err != nil {
 		return err
 	}
@@ -543,9 +673,12 @@ func (r *LogicalReplicator) CreateReplicationSlotIfNecessary(slotName string) er
 }
 
 // processMessage processes a logical replication message as appropriate. A couple important aspects:
-//  1. Relation messages describe tables being replicated and are used to build a type map for decoding tuples
-//  2. INSERT/UPDATE/DELETE messages describe changes to rows that must be applied to the replica.
-//     These describe a row in the form of a tuple, and are used to construct a query to apply the change to the replica.
+//  1. Relation messages describe tables being replicated and are used to map tuples onto the replica's tables. Columns
+//     that are added or dropped on the primary are added or dropped on the replica as well.
+//  2. INSERT/UPDATE/DELETE/TRUNCATE messages describe changes to rows that must be applied to the replica.
+//     These describe a row in the form of a tuple, which is decoded and applied through the table's row editors.
+//  3. Large transactions may be streamed before they're committed on the primary. Their changes are buffered until
+//     the transaction is committed or aborted.
 //
 // Returns a boolean true if the message was a commit that should be acknowledged, and an error if one occurred.
 func (r *LogicalReplicator) processMessage(
@@ -561,9 +694,13 @@ func (r *LogicalReplicator) processMessage(
 	log.Printf("XLogData (%T) => WALStart %s ServerWALEnd %s ServerTime %s", logicalMsg, xld.WALStart, xld.ServerWALEnd, xld.ServerTime)
 	state.lastReceivedLSN = xld.ServerWALEnd
 
+	ctx := state.replicaCtx
+	if err = sql.SessionCommandBegin(ctx.Session); err != nil {
+		return false, err
+	}
+	defer sql.SessionCommandEnd(ctx.Session)
+
 	switch logicalMsg := logicalMsg.(type) {
-	case *pglogrepl.RelationMessageV2:
-		state.relations[logicalMsg.RelationID] = logicalMsg
 	case *pglogrepl.BeginMessage:
 		// Indicates the beginning of a group of changes in a transaction.
 		// This is only sent for committed transactions. We won't get any events from rolled back transactions.
@@ -576,196 +713,217 @@ func (r *LogicalReplicator) processMessage(
 
 		state.processMessages = true
 		state.currentTransactionLSN = logicalMsg.FinalLSN
+		state.currentTransactionOrigin = ""
+		state.currentTransactionRelations = map[uint32]struct{}{}
 
 		log.Printf("BeginMessage: %v", logicalMsg)
-		err = r.replicateQuery(state.replicaConn, "START TRANSACTION")
+		err = beginTransaction(ctx)
 		if err != nil {
 			return false, err
 		}
 	case *pglogrepl.CommitMessage:
 		log.Printf("CommitMessage: %v", logicalMsg)
-		err = r.replicateQuery(state.replicaConn, "COMMIT")
+		err = r.commitUpstreamTransaction(ctx, state, logicalMsg.CommitLSN, logicalMsg.CommitTime)
 		if err != nil {
 			return false, err
 		}
 		state.processMessages = false
 
 		return true, nil
-	case *pglogrepl.InsertMessageV2:
-		if !state.processMessages {
-			log.Printf("Received stale message, ignoring. Last written LSN: %s Message LSN: %s", state.lastWrittenLSN, xld.ServerWALEnd)
-			return false, nil
-		}
-
-		rel, ok := state.relations[logicalMsg.RelationID]
-		if !ok {
-			log.Fatalf("unknown relation ID %d", logicalMsg.RelationID)
+	case *pglogrepl.OriginMessage:
+		log.Printf("originMessage for xid %s\n", logicalMsg.Name)
+		state.currentTransactionOrigin = logicalMsg.Name
+	case *pglogrepl.LogicalDecodingMessageV2:
+		log.Printf("Logical decoding message: %q, %q, %d", logicalMsg.Prefix, logicalMsg.Content, logicalMsg.Xid)
+	case *pglogrepl.StreamStartMessageV2:
+		state.inStream = true
+		state.streamXid = logicalMsg.Xid
+		log.Printf("Stream start message: xid %d, first segment? %d", logicalMsg.Xid, logicalMsg.FirstSegment)
+	case *pglogrepl.StreamStopMessageV2:
+		state.inStream = false
+		log.Printf("Stream stop message")
+	case *pglogrepl.StreamCommitMessageV2:
+		log.Printf("Stream commit message: xid %d", logicalMsg.Xid)
+		return r.applyStreamedTransaction(ctx, state, logicalMsg)
+	case *pglogrepl.StreamAbortMessageV2:
+		log.Printf("Stream abort message: xid %d", logicalMsg.Xid)
+		if logicalMsg.SubXid == logicalMsg.Xid {
+			delete(state.streamedTransactions, logicalMsg.Xid)
+		} else {
+			// Only a subtransaction was rolled back, so we only discard its changes
+			changes := state.streamedTransactions[logicalMsg.Xid]
+			remaining := changes[:0]
+			for _, change := range changes {
+				if change.xid != logicalMsg.SubXid {
+					remaining = append(remaining, change)
+				}
+			}
+			state.streamedTransactions[logicalMsg.Xid] = remaining
 		}
-
-		columnStr := strings.Builder{}
-		valuesStr := strings.Builder{}
-		for idx, col := range logicalMsg.Tuple.Columns {
-			if idx > 0 {
-				columnStr.WriteString(", ")
-				valuesStr.WriteString(", ")
+	default:
+		if state.inStream {
+			xid, ok := streamedMessageXid(logicalMsg)
+			if !ok {
+				log.Printf("Unknown message type in pgoutput stream: %T", logicalMsg)
+				return false, nil
 			}
-
-			colName := rel.Columns[idx].Name
-			columnStr.WriteString(colName)
-
-			switch col.DataType {
-			case 'n': // null
-				valuesStr.WriteString("NULL")
-			case 't': // text
-
-				// We have to round-trip the data through the encodings to get an accurate text rep back
-				val, err := decodeTextColumnData(state.typeMap, col.Data, rel.Columns[idx].DataType)
-				if err != nil {
-					log.Fatalln("error decoding column data:", err)
-				}
-				colData, err := encodeColumnData(state.typeMap, val, rel.Columns[idx].DataType)
-				if err != nil {
-					return false, err
-				}
-				valuesStr.WriteString(colData)
+			state.streamedTransactions[state.streamXid] = append(state.streamedTransactions[state.streamXid], streamedChange{
+				xid:     xid,
+				walData: append([]byte(nil), walData...),
+			})
+			return false, nil
+		}
+		if !state.processMessages {
+			// The changes of a stale transaction were already applied, but the relations and types that it describes
+			// are still current
+			switch logicalMsg := logicalMsg.(type) {
+			case *pglogrepl.RelationMessageV2:
+				state.relations[logicalMsg.RelationID] = logicalMsg
+			case *pglogrepl.TypeMessageV2:
+				state.types[logicalMsg.DataType] = logicalMsg
 			default:
-				log.Printf("unknown column data type: %c", col.DataType)
+				log.Printf("Received stale message, ignoring. Last written LSN: %s Message LSN: %s", state.lastWrittenLSN, xld.ServerWALEnd)
 			}
+			return false, nil
 		}
+		return false, r.applyChange(ctx, state, logicalMsg)
+	}
 
-		err = r.replicateQuery(state.replicaConn, fmt.Sprintf("INSERT INTO %s.%s (%s) VALUES (%s)", rel.Namespace, rel.RelationName, columnStr.String(), valuesStr.String()))
+	return false, nil
+}
+
+// applyChange applies a message that describes a relation, a type, or a change to a relation's rows.
+func (r *LogicalReplicator) applyChange(ctx *sql.Context, state *replicationState, logicalMsg pglogrepl.Message) error {
+	switch logicalMsg := logicalMsg.(type) {
+	case *pglogrepl.RelationMessageV2:
+		err := applyRelation(ctx, state, logicalMsg)
 		if err != nil {
-			return false, err
+			return err
 		}
+		state.relations[logicalMsg.RelationID] = logicalMsg
+		state.currentTransactionRelations[logicalMsg.RelationID] = struct{}{}
+	case *pglogrepl.TypeMessageV2:
+		log.Printf("typeMessage for xid %d\n", logicalMsg.Xid)
+		state.types[logicalMsg.DataType] = logicalMsg
+	case *pglogrepl.InsertMessageV2:
+		rel, err := state.relation(logicalMsg.RelationID)
+		if err != nil || state.alreadySynced(rel) {
+			return err
+		}
+		state.currentTransactionRelations[rel.RelationID] = struct{}{}
+		return applyInsert(ctx, rel, logicalMsg.Tuple)
 	case *pglogrepl.UpdateMessageV2:
-		if !state.processMessages {
-			log.Printf("Received stale message, ignoring. Last written LSN: %s Message LSN: %s", state.lastWrittenLSN, xld.ServerWALEnd)
-			return false, nil
+		rel, err := state.relation(logicalMsg.RelationID)
+		if err != nil || state.alreadySynced(rel) {
+			return err
 		}
-
-		// TODO: this won't handle primary key changes correctly
-		// TODO: this probably doesn't work for unkeyed tables
-		rel, ok := state.relations[logicalMsg.RelationID]
-		if !ok {
-			log.Fatalf("unknown relation ID %d", logicalMsg.RelationID)
+		state.currentTransactionRelations[rel.RelationID] = struct{}{}
+		return applyUpdate(ctx, rel, logicalMsg)
+	case *pglogrepl.DeleteMessageV2:
+		rel, err := state.relation(logicalMsg.RelationID)
+		if err != nil || state.alreadySynced(rel) {
+			return err
 		}
-
-		updateStr := strings.Builder{}
-		whereStr := strings.Builder{}
-		for idx, col := range logicalMsg.NewTuple.Columns {
-			colName := rel.Columns[idx].Name
-			colFlags := rel.Columns[idx].Flags
-
-			var stringVal string
-			switch col.DataType {
-			case 'n': // null
-				stringVal = "NULL"
-			case 'u': // unchanged toast
-			case 't': // text
-				val, err := decodeTextColumnData(state.typeMap, col.Data, rel.Columns[idx].DataType)
-				if err != nil {
-					log.Fatalln("error decoding column data:", err)
-				}
-
-				stringVal, err = encodeColumnData(state.typeMap, val, rel.Columns[idx].DataType)
-				if err != nil {
-					return false, err
-				}
-			default:
-				log.Printf("unknown column data type: %c", col.DataType)
+		state.currentTransactionRelations[rel.RelationID] = struct{}{}
+		return applyDelete(ctx, rel, logicalMsg)
+	case *pglogrepl.TruncateMessageV2:
+		log.Printf("truncate for xid %d\n", logicalMsg.Xid)
+		rels := make([]*pglogrepl.RelationMessageV2, 0, len(logicalMsg.RelationIDs))
+		for _, relationID := range logicalMsg.RelationIDs {
+			rel, err := state.relation(relationID)
+			if err != nil {
+				return err
 			}
-
-			// TODO: quote column names?
-			if colFlags == 0 {
-				if updateStr.Len() > 0 {
-					updateStr.WriteString(", ")
-				}
-				updateStr.WriteString(fmt.Sprintf("%s = %v", colName, stringVal))
-			} else {
-				if whereStr.Len() > 0 {
-					updateStr.WriteString(", ")
-				}
-				whereStr.WriteString(fmt.Sprintf("%s = %v", colName, stringVal))
+			if !state.alreadySynced(rel) {
+				rels = append(rels, rel)
+				state.currentTransactionRelations[rel.RelationID] = struct{}{}
 			}
 		}
+		return applyTruncate(ctx, rels)
+	default:
+		log.Printf("Unknown message type in pgoutput stream: %T", logicalMsg)
+	}
+	return nil
+}
 
-		err = r.replicateQuery(state.replicaConn, fmt.Sprintf("UPDATE %s.%s SET %s%s", rel.Namespace, rel.RelationName, updateStr.String(), whereClause(whereStr)))
+// applyStreamedTransaction applies the buffered changes of the streamed transaction that was committed, in a single
+// transaction of its own. Returns true if the transaction was committed and should be acknowledged.
+func (r *LogicalReplicator) applyStreamedTransaction(ctx *sql.Context, state *replicationState, msg *pglogrepl.StreamCommitMessageV2) (bool, error) {
+	changes := state.streamedTransactions[msg.Xid]
+	delete(state.streamedTransactions, msg.Xid)
+
+	if state.lastWrittenLSN > msg.CommitLSN {
+		log.Printf("Received stale message, ignoring. Last written LSN: %s Message LSN: %s", state.lastWrittenLSN, msg.CommitLSN)
+		return false, nil
+	}
+	state.currentTransactionLSN = msg.CommitLSN
+	state.currentTransactionOrigin = ""
+	state.currentTransactionRelations = map[uint32]struct{}{}
+
+	if err := beginTransaction(ctx); err != nil {
+		return false, err
+	}
+	for _, change := range changes {
+		logicalMsg, err := pglogrepl.ParseV2(change.walData, true)
 		if err != nil {
 			return false, err
 		}
-	case *pglogrepl.DeleteMessageV2:
-		if !state.processMessages {
-			log.Printf("Received stale message, ignoring. Last written LSN: %s Message LSN: %s", state.lastWrittenLSN, xld.ServerWALEnd)
-			return false, nil
-		}
-
-		// TODO: this probably doesn't work for unkeyed tables
-		rel, ok := state.relations[logicalMsg.RelationID]
-		if !ok {
-			log.Fatalf("unknown relation ID %d", logicalMsg.RelationID)
+		if err = r.applyChange(ctx, state, logicalMsg); err != nil {
+			return false, err
 		}
+	}
+	if err := r.commitUpstreamTransaction(ctx, state, msg.CommitLSN, msg.CommitTime); err != nil {
+		return false, err
+	}
+	return true, nil
+}
 
-		whereStr := strings.Builder{}
-		for idx, col := range logicalMsg.OldTuple.Columns {
-			colName := rel.Columns[idx].Name
-			colFlags := rel.Columns[idx].Flags
-
-			var stringVal string
-			switch col.DataType {
-			case 'n': // null
-				stringVal = "NULL"
-			case 'u': // unchanged toast
-			case 't': // text
-				val, err := decodeTextColumnData(state.typeMap, col.Data, rel.Columns[idx].DataType)
-				if err != nil {
-					log.Fatalln("error decoding column data:", err)
-				}
-
-				stringVal, err = encodeColumnData(state.typeMap, val, rel.Columns[idx].DataType)
-				if err != nil {
-					return false, err
-				}
-			default:
-				log.Printf("unknown column data type: %c", col.DataType)
-			}
-
-			if colFlags == 0 {
-				// nothing to do
-			} else {
-				if whereStr.Len() > 0 {
-					whereStr.WriteString(", ")
-				}
-				whereStr.WriteString(fmt.Sprintf("%s = %v", colName, stringVal))
-			}
+// commitUpstreamTransaction commits the transaction that the changes of an upstream transaction were applied in,
+// creating a Dolt commit for it if the replicator was configured to do so.
+func (r *LogicalReplicator) commitUpstreamTransaction(ctx *sql.Context, state *replicationState, commitLSN pglogrepl.LSN, commitTime time.Time) error {
+	if r.doltCommits {
+		if err := doltCommitTransaction(ctx, state, commitLSN, commitTime); err != nil {
+			return err
 		}
+	}
+	return commitTransaction(ctx)
+}
 
-		err = r.replicateQuery(state.replicaConn, fmt.Sprintf("DELETE FROM %s.%s WHERE %s", rel.Namespace, rel.RelationName, whereStr.String()))
-		if err != nil {
-			return false, err
-		}
-	case *pglogrepl.TruncateMessageV2:
-		log.Printf("truncate for xid %d\n", logicalMsg.Xid)
+// streamedMessageXid returns the ID of the (sub)transaction that a message received within a stream belongs to, along
+// with whether the message is one that belongs to a transaction.
+func streamedMessageXid(logicalMsg pglogrepl.Message) (uint32, bool) {
+	switch logicalMsg := logicalMsg.(type) {
+	case *pglogrepl.RelationMessageV2:
+		return logicalMsg.Xid, true
 	case *pglogrepl.TypeMessageV2:
-		log.Printf("typeMessage for xid %d\n", logicalMsg.Xid)
-	case *pglogrepl.OriginMessage:
-		log.Printf("originMessage for xid %s\n", logicalMsg.Name)
-	case *pglogrepl.LogicalDecodingMessageV2:
-		log.Printf("Logical decoding message: %q, %q, %d", logicalMsg.Prefix, logicalMsg.Content, logicalMsg.Xid)
-	case *pglogrepl.StreamStartMessageV2:
-		state.inStream = true
-		log.Printf("Stream start message: xid %d, first segment? %d", logicalMsg.Xid, logicalMsg.FirstSegment)
-	case *pglogrepl.StreamStopMessageV2:
-		state.inStream = false
-		log.Printf("Stream stop message")
-	case *pglogrepl.StreamCommitMessageV2:
-		log.Printf("Stream commit message: xid %d", logicalMsg.Xid)
-	case *pglogrepl.StreamAbortMessageV2:
-		log.Printf("Stream abort message: xid %d", logicalMsg.Xid)
+		return logicalMsg.Xid, true
+	case *pglogrepl.InsertMessageV2:
+		return logicalMsg.Xid, true
+	case *pglogrepl.UpdateMessageV2:
+		return logicalMsg.Xid, true
+	case *pglogrepl.DeleteMessageV2:
+		return logicalMsg.Xid, true
+	case *pglogrepl.TruncateMessageV2:
+		return logicalMsg.Xid, true
 	default:
-		log.Printf("Unknown message type in pgoutput stream: %T", logicalMsg)
+		return 0, false
+	}
+}
+
+// relation returns the relation with the given ID, which the primary always describes before sending any changes to it.
+func (s *replicationState) relation(relationID uint32) (*pglogrepl.RelationMessageV2, error) {
+	rel, ok := s.relations[relationID]
+	if !ok {
+		return nil, errors.Errorf("unknown relation ID %d", relationID)
 	}
+	return rel, nil
+}
 
-	return false, nil
+// alreadySynced returns whether the changes of the current transaction to the relation are already part of the
+// relation's contents, as they were committed before the snapshot that the contents were copied in.
+func (s *replicationState) alreadySynced(rel *pglogrepl.RelationMessageV2) bool {
+	lsn, ok := s.syncedTables[syncedTableKey{schema: rel.Namespace, name: rel.RelationName}]
+	return ok && s.currentTransactionLSN < lsn
 }
 
 // readWALPosition reads the recorded WAL position from the WAL position file
@@ -786,60 +944,3 @@ func (r *LogicalReplicator) readWALPosition() (pglogrepl.LSN, error) {
 func (r *LogicalReplicator) writeWALPosition(lsn pglogrepl.LSN) error {
 	return os.WriteFile(r.walFilePath, []byte(lsn.String()), 0644)
 }
-
-// whereClause returns a WHERE clause string with the contents of the builder if it's non-empty, or the empty
-// string otherwise
-func whereClause(str strings.Builder) string {
-	if str.Len() > 0 {
-		return " WHERE " + str.String()
-	}
-	return ""
-}
-
-// decodeTextColumnData decodes the given data using the given data type OID and returns the result as a golang value
-func decodeTextColumnData(mi *pgtype.Map, data []byte, dataType uint32) (interface{}, error) {
-	switch oid.Oid(dataType) {
-	case oid.T_date, oid.T_time, oid.T_timestamp, oid.T_timestamptz, oid.T__date, oid.T__time, oid.T__timestamp, oid.T__timestamptz:
-		// The codec converts time values into a format that breaks our assumptions later on, which is unnecessary as
-		// the server sends the correctly-formatted time anyway.
-		return string(data), nil
-	default:
-		if dt, ok := mi.TypeForOID(dataType); ok {
-			return dt.Codec.DecodeValue(mi, dataType, pgtype.TextFormatCode, data)
-		}
-		return string(data), nil
-	}
-}
-
-// encodeColumnData encodes the given data using the given data type OID and returns the result as a string to be
-// used in an INSERT or other DML query.
-func encodeColumnData(mi *pgtype.Map, data interface{}, dataType uint32) (string, error) {
-	var value string
-	if dt, ok := mi.TypeForOID(dataType); ok {
-		e := dt.Codec.PlanEncode(mi, dataType, pgtype.TextFormatCode, data)
-		if e != nil {
-			encoded, err := e.Encode(data, nil)
-			if err != nil {
-				return "", err
-			}
-			value = string(encoded)
-		} else {
-			// no encoder for this type, use the string representation
-			value = fmt.Sprintf("%v", data)
-		}
-	} else {
-		value = fmt.Sprintf("%v", data)
-	}
-
-	// Some types need additional quoting after encoding
-	switch data := data.(type) {
-	case string, time.Time, pgtype.Time, bool:
-		return fmt.Sprintf("'%s'", value), nil
-	case [16]byte:
-		// TODO: should we actually register an encoder for this type?
-		uid := uuid.UUID(data)
-		return fmt.Sprintf("'%s'", uid.String()), nil
-	default:
-		return value, nil
-	}
-}
@@ -0,0 +1,461 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logrepl
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/dolthub/doltgresql/utils"
+)
+
+// Subscription is a logical replication subscription created by CREATE SUBSCRIPTION, which replicates the tables of
+// one or more publications on a primary into a database of this server.
+type Subscription struct {
+	// ID identifies the subscription for its entire lifetime, and names the files that its replication state is
+	// persisted to, so that renaming the subscription does not affect its state.
+	ID       uint64 `json:"id"`
+	Name     string `json:"name"`
+	Owner    string `json:"owner"`
+	Database string `json:"database"`
+	ConnInfo string `json:"conninfo"`
+	// SlotName is the replication slot on the primary, which is empty when the subscription has no slot.
+	SlotName     string   `json:"slot_name"`
+	Publications []string `json:"publications"`
+	Enabled      bool     `json:"enabled"`
+}
+
+// SubscriptionStatus is the state of a subscription, as reported by pg_subscription_rel and pg_stat_subscription.
+type SubscriptionStatus struct {
+	Subscription
+	// Running is whether the subscription's worker is currently streaming changes from the primary.
+	Running bool
+	// Replication is the progress of the subscription's worker, which is empty when the worker isn't running.
+	Replication ReplicationStatus
+	// Tables are the synchronization states of the subscription's tables.
+	Tables []SyncedTable
+}
+
+// subscriptionsFile is the persisted form of every subscription.
+type subscriptionsFile struct {
+	NextID        uint64          `json:"next_id"`
+	Subscriptions []*Subscription `json:"subscriptions"`
+}
+
+// subscriptionWorker replicates the changes of a single enabled subscription in the background.
+type subscriptionWorker struct {
+	replicator *LogicalReplicator
+	stop       chan struct{}
+	done       chan struct{}
+}
+
+// subscriptionManager owns every subscription, along with the workers of the enabled ones.
+type subscriptionManager struct {
+	dir            string
+	contextFactory func(database string) SqlContextFactory
+	file           subscriptionsFile
+	workers        map[uint64]*subscriptionWorker
+	mu             sync.Mutex
+}
+
+// subscriptionRetryInterval is how long a worker waits to restart after replication fails.
+const subscriptionRetryInterval = 5 * time.Second
+
+// subscriptions is the manager initialized by InitSubscriptions.
+var subscriptions *subscriptionManager
+
+// InitSubscriptions loads the subscriptions that were persisted in the given directory, and starts a worker for each
+// enabled subscription. Changes are replicated through the contexts returned by the factory for each subscription's
+// database. This must be called once the server has started, before any subscription statements are run.
+func InitSubscriptions(dir string, contextFactory func(database string) SqlContextFactory) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	m := &subscriptionManager{
+		dir:            dir,
+		contextFactory: contextFactory,
+		file:           subscriptionsFile{NextID: 1},
+		workers:        make(map[uint64]*subscriptionWorker),
+	}
+	contents, err := os.ReadFile(m.filePath())
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	} else if err == nil {
+		if err = json.Unmarshal(contents, &m.file); err != nil {
+			return err
+		}
+	}
+	for _, sub := range m.file.Subscriptions {
+		if sub.Enabled {
+			m.startWorker(sub)
+		}
+	}
+	subscriptions = m
+	return nil
+}
+
+// Subscriptions returns every subscription, sorted by name.
+func Subscriptions() []Subscription {
+	m := subscriptions
+	if m == nil {
+		return nil
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.sortedSubscriptions()
+}
+
+// GetSubscription returns the subscription with the given name, and whether it exists.
+func GetSubscription(name string) (Subscription, bool) {
+	m := subscriptions
+	if m == nil {
+		return Subscription{}, false
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if sub := m.find(name); sub != nil {
+		return *sub, true
+	}
+	return Subscription{}, false
+}
+
+// SubscriptionStatuses returns the state of every subscription, sorted by name.
+func SubscriptionStatuses() ([]SubscriptionStatus, error) {
+	m := subscriptions
+	if m == nil {
+		return nil, nil
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var statuses []SubscriptionStatus
+	for _, sub := range m.sortedSubscriptions() {
+		status := SubscriptionStatus{Subscription: sub}
+		replicator := m.newReplicator(&sub)
+		if worker, ok := m.workers[sub.ID]; ok {
+			replicator = worker.replicator
+			status.Running = worker.replicator.Running()
+			status.Replication = worker.replicator.Status()
+		}
+		tables, err := replicator.SyncedTables()
+		if err != nil {
+			return nil, err
+		}
+		status.Tables = tables
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+// CreateSubscription creates the given subscription, assigning its ID. When connect is true, the primary is contacted
+// to record the tables of the subscription's publications, which are copied by the subscription's worker when copyData
+// is true, and the subscription's replication slot is created on the primary when createSlot is true. The worker is
+// started when the subscription is enabled.
+func CreateSubscription(sub Subscription, connect bool, createSlot bool, copyData bool) error {
+	m, err := manager()
+	if err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.find(sub.Name) != nil {
+		return errors.Errorf(`subscription "%s" already exists`, sub.Name)
+	}
+
+	sub.ID = m.file.NextID
+	if connect {
+		replicator := m.newReplicator(&sub)
+		if createSlot {
+			if err = replicator.CreateReplicationSlotIfNecessary(sub.SlotName); err != nil {
+				return err
+			}
+		}
+		if err = replicator.RefreshTables(sub.SlotName, copyData); err != nil {
+			return err
+		}
+	}
+	m.file.NextID++
+	m.file.Subscriptions = append(m.file.Subscriptions, &sub)
+	if err = m.persist(); err != nil {
+		return err
+	}
+	if sub.Enabled {
+		m.startWorker(&sub)
+	}
+	return nil
+}
+
+// DropSubscription stops the subscription's worker, drops its replication slot on the primary if it has one, and
+// removes the subscription along with its replication state.
+func DropSubscription(name string) error {
+	return alterSubscription(name, func(m *subscriptionManager, sub *Subscription) error {
+		m.stopWorker(sub)
+		replicator := m.newReplicator(sub)
+		if sub.SlotName != "" {
+			if err := replicator.DropReplicationSlot(sub.SlotName); err != nil {
+				// The subscription still exists, so it should keep replicating
+				m.restartWorker(sub)
+				return errors.Wrapf(err, `could not drop replication slot "%s" on the publisher`, sub.SlotName)
+			}
+		}
+		if err := replicator.RemoveReplicationState(); err != nil {
+			m.restartWorker(sub)
+			return err
+		}
+		for i, existing := range m.file.Subscriptions {
+			if existing.ID == sub.ID {
+				m.file.Subscriptions = append(m.file.Subscriptions[:i], m.file.Subscriptions[i+1:]...)
+				break
+			}
+		}
+		return nil
+	})
+}
+
+// SetSubscriptionEnabled enables or disables the subscription, starting or stopping its worker.
+func SetSubscriptionEnabled(name string, enabled bool) error {
+	return alterSubscription(name, func(m *subscriptionManager, sub *Subscription) error {
+		if enabled && sub.SlotName == "" {
+			return errors.New("cannot enable subscription that does not have a slot name")
+		}
+		sub.Enabled = enabled
+		m.restartWorker(sub)
+		return nil
+	})
+}
+
+// SetSubscriptionConnection changes the connection string of the subscription's primary, restarting its worker.
+func SetSubscriptionConnection(name string, connInfo string) error {
+	return alterSubscription(name, func(m *subscriptionManager, sub *Subscription) error {
+		sub.ConnInfo = connInfo
+		m.restartWorker(sub)
+		return nil
+	})
+}
+
+// SetSubscriptionPublications changes the publications of the subscription, restarting its worker. When refresh is
+// true, the subscription's tables are refreshed as described by RefreshSubscription.
+func SetSubscriptionPublications(name string, publications []string, refresh bool, copyData bool) error {
+	return alterSubscription(name, func(m *subscriptionManager, sub *Subscription) error {
+		if refresh && !sub.Enabled {
+			return errors.New("ALTER SUBSCRIPTION with refresh is not allowed for disabled subscriptions")
+		}
+		sub.Publications = publications
+		if refresh {
+			if err := m.newReplicator(sub).RefreshTables(sub.SlotName, copyData); err != nil {
+				return err
+			}
+		}
+		m.restartWorker(sub)
+		return nil
+	})
+}
+
+// RefreshSubscription fetches the tables that are currently published to the subscription, restarting its worker.
+// The contents of new tables are copied when copyData is true, and tables that are no longer published stop being
+// tracked.
+func RefreshSubscription(name string, copyData bool) error {
+	return alterSubscription(name, func(m *subscriptionManager, sub *Subscription) error {
+		if !sub.Enabled {
+			return errors.New("ALTER SUBSCRIPTION ... REFRESH is not allowed for disabled subscriptions")
+		}
+		m.stopWorker(sub)
+		if err := m.newReplicator(sub).RefreshTables(sub.SlotName, copyData); err != nil {
+			// Keep replicating the tables that were previously published
+			m.startWorker(sub)
+			return err
+		}
+		m.startWorker(sub)
+		return nil
+	})
+}
+
+// SetSubscriptionSlotName changes the replication slot of the subscription. An empty slot name removes the slot from
+// the subscription, which is only allowed for disabled subscriptions.
+func SetSubscriptionSlotName(name string, slotName string) error {
+	return alterSubscription(name, func(m *subscriptionManager, sub *Subscription) error {
+		if slotName == "" && sub.Enabled {
+			return errors.New("cannot set slot_name = NONE for enabled subscription")
+		}
+		sub.SlotName = slotName
+		m.restartWorker(sub)
+		return nil
+	})
+}
+
+// SetSubscriptionOwner changes the owner of the subscription.
+func SetSubscriptionOwner(name string, owner string) error {
+	return alterSubscription(name, func(m *subscriptionManager, sub *Subscription) error {
+		sub.Owner = owner
+		return nil
+	})
+}
+
+// RenameSubscription renames the subscription.
+func RenameSubscription(name string, newName string) error {
+	return alterSubscription(name, func(m *subscriptionManager, sub *Subscription) error {
+		if m.find(newName) != nil {
+			return errors.Errorf(`subscription "%s" already exists`, newName)
+		}
+		sub.Name = newName
+		return nil
+	})
+}
+
+// manager returns the subscription manager, or an error if subscriptions have not been initialized.
+func manager() (*subscriptionManager, error) {
+	if subscriptions == nil {
+		return nil, errors.New("subscriptions are not available on this server")
+	}
+	return subscriptions, nil
+}
+
+// alterSubscription runs the given function against the named subscription, and persists the subscriptions
+// afterward. The changes are discarded if the function returns an error.
+func alterSubscription(name string, alter func(m *subscriptionManager, sub *Subscription) error) error {
+	m, err := manager()
+	if err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	sub := m.find(name)
+	if sub == nil {
+		return errors.Errorf(`subscription "%s" does not exist`, name)
+	}
+	original := *sub
+	if err = alter(m, sub); err != nil {
+		*sub = original
+		return err
+	}
+	return m.persist()
+}
+
+// find returns the subscription with the given name, or nil if it does not exist.
+func (m *subscriptionManager) find(name string) *Subscription {
+	for _, sub := range m.file.Subscriptions {
+		if sub.Name == name {
+			return sub
+		}
+	}
+	return nil
+}
+
+// sortedSubscriptions returns a copy of every subscription, sorted by name.
+func (m *subscriptionManager) sortedSubscriptions() []Subscription {
+	subs := make([]Subscription, len(m.file.Subscriptions))
+	for i, sub := range m.file.Subscriptions {
+		subs[i] = *sub
+	}
+	sort.Slice(subs, func(i, j int) bool {
+		return subs[i].Name < subs[j].Name
+	})
+	return subs
+}
+
+// newReplicator returns a replicator for the subscription, using the subscription's persisted replication state.
+func (m *subscriptionManager) newReplicator(sub *Subscription) *LogicalReplicator {
+	walFilePath := filepath.Join(m.dir, fmt.Sprintf("%d_wal_location", sub.ID))
+	replicator, _ := NewLogicalReplicator(walFilePath, sub.ConnInfo, m.contextFactory(sub.Database))
+	replicator.SetPublications(sub.Publications)
+	return replicator
+}
+
+// startWorker starts replicating the subscription's changes in the background. The worker copies the contents of any
+// tables that have yet to be synchronized before streaming changes, and restarts whenever replication fails until it
+// is stopped.
+func (m *subscriptionManager) startWorker(sub *Subscription) {
+	worker := &subscriptionWorker{
+		replicator: m.newReplicator(sub),
+		stop:       make(chan struct{}),
+		done:       make(chan struct{}),
+	}
+	m.workers[sub.ID] = worker
+	name, slotName := sub.Name, sub.SlotName
+	go func() {
+		defer close(worker.done)
+		for {
+			err := worker.replicator.SynchronizeTables(slotName)
+			if err == nil {
+				select {
+				case <-worker.stop:
+					return
+				default:
+				}
+				err = worker.replicator.StartReplication(slotName)
+			}
+			if err != nil {
+				log.Printf(`logical replication worker for subscription "%s" failed: %v`, name, err)
+			}
+			select {
+			case <-worker.stop:
+				return
+			case <-time.After(subscriptionRetryInterval):
+			}
+		}
+	}()
+}
+
+// stopWorker stops the subscription's worker if it's running, blocking until it exits. A worker that is copying
+// tables stops once the copy has finished.
+func (m *subscriptionManager) stopWorker(sub *Subscription) {
+	worker, ok := m.workers[sub.ID]
+	if !ok {
+		return
+	}
+	delete(m.workers, sub.ID)
+	close(worker.stop)
+	for {
+		// The replicator may not have started streaming yet when it's first stopped, so we keep stopping it until
+		// the worker exits
+		worker.replicator.Stop()
+		select {
+		case <-worker.done:
+			return
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+}
+
+// restartWorker stops the subscription's worker, and starts a new one if the subscription is enabled, so that the
+// subscription's changes take effect.
+func (m *subscriptionManager) restartWorker(sub *Subscription) {
+	m.stopWorker(sub)
+	if sub.Enabled {
+		m.startWorker(sub)
+	}
+}
+
+// filePath returns the path of the file that the subscriptions are persisted to.
+func (m *subscriptionManager) filePath() string {
+	return filepath.Join(m.dir, "subscriptions.json")
+}
+
+// persist writes every subscription to disk.
+func (m *subscriptionManager) persist() error {
+	contents, err := json.Marshal(&m.file)
+	if err != nil {
+		return err
+	}
+	// Connection strings may contain passwords, so the file is only readable by the server's user
+	return utils.WriteFileAtomic(m.filePath(), contents, 0600)
+}
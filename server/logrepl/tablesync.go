@@ -0,0 +1,534 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logrepl
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/jackc/pglogrepl"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// tableSyncState is the synchronization state of a published table. These mirror the states of pg_subscription_rel.
+type tableSyncState string
+
+const (
+	// tableSyncInit is the state of a table whose contents have not been copied yet.
+	tableSyncInit tableSyncState = "i"
+	// tableSyncReady is the state of a table whose contents have been copied, so that it only needs streamed changes.
+	tableSyncReady tableSyncState = "r"
+)
+
+// syncedTable records the synchronization state of a single published table.
+type syncedTable struct {
+	Schema string         `json:"schema"`
+	Name   string         `json:"name"`
+	State  tableSyncState `json:"state"`
+	// LSN is the consistent point of the snapshot that the table's contents were copied in. Streamed transactions that
+	// committed before this point are already part of the copy, so they're skipped for this table.
+	LSN pglogrepl.LSN `json:"lsn"`
+}
+
+// tableSyncStatus is the synchronization state of every published table, which is persisted so that an interrupted
+// synchronization resumes with the tables that were not copied yet.
+type tableSyncStatus struct {
+	Tables []*syncedTable `json:"tables"`
+}
+
+// syncedTableKey identifies a table in the map of synchronized tables.
+type syncedTableKey struct {
+	schema string
+	name   string
+}
+
+// SynchronizeTables copies the contents of every published table that has not been synchronized yet, and must be
+// called before StartReplication. When the replication slot does not exist, it's created with an exported snapshot,
+// so that the tables are copied as of the exact point that streaming begins from. When the slot already exists (such
+// as when resuming an interrupted synchronization), the remaining tables are copied in the snapshot of a temporary
+// slot instead, and the changes that the slot streams for those tables are skipped up to that snapshot's consistent
+// point. Only the tables that are published when the synchronization first runs are copied; tables that are added to
+// the publication afterward only receive the changes that are streamed to them.
+func (r *LogicalReplicator) SynchronizeTables(slotName string) error {
+	status, err := r.readTableSyncStatus()
+	if err != nil {
+		return err
+	}
+	slotExists, err := r.replicationSlotExists(slotName)
+	if err != nil {
+		return err
+	}
+	if status == nil {
+		lastWrittenLsn, err := r.readWALPosition()
+		if err != nil {
+			return err
+		}
+		if slotExists && lastWrittenLsn != 0 {
+			// Replication was already underway before tables were synchronized, so there's nothing to copy
+			return r.writeTableSyncStatus(&tableSyncStatus{})
+		}
+		status, err = r.publishedTables(slotName, tableSyncInit)
+		if err != nil {
+			return err
+		}
+		if err = r.writeTableSyncStatus(status); err != nil {
+			return err
+		}
+	}
+
+	var pending []*syncedTable
+	for _, table := range status.Tables {
+		if table.State != tableSyncReady {
+			pending = append(pending, table)
+		}
+	}
+	if len(pending) == 0 && slotExists {
+		return nil
+	}
+
+	replicationConn, err := pgconn.Connect(context.Background(), r.ReplicationDns())
+	if err != nil {
+		return err
+	}
+	// The exported snapshot is only valid until the connection that created the slot is closed, which also drops a
+	// temporary slot
+	defer replicationConn.Close(context.Background())
+
+	snapshotSlotName := slotName
+	if slotExists {
+		snapshotSlotName = fmt.Sprintf("%s_sync_%d", slotName, time.Now().UnixNano())
+	}
+	slot, err := pglogrepl.CreateReplicationSlot(context.Background(), replicationConn, snapshotSlotName, outputPlugin, pglogrepl.CreateReplicationSlotOptions{
+		Temporary:      slotExists,
+		SnapshotAction: "EXPORT_SNAPSHOT",
+	})
+	if err != nil {
+		return err
+	}
+	consistentPoint, err := pglogrepl.ParseLSN(slot.ConsistentPoint)
+	if err != nil {
+		return err
+	}
+	log.Printf("Created replication slot %s with snapshot %s at consistent point %s", snapshotSlotName, slot.SnapshotName, consistentPoint)
+
+	replicaCtx, err := r.sqlContextFactory(context.Background())
+	if err != nil {
+		return err
+	}
+	defer sql.SessionEnd(replicaCtx.Session)
+
+	for _, table := range pending {
+		if err = r.copyTable(replicaCtx, slot.SnapshotName, table); err != nil {
+			return err
+		}
+		table.State = tableSyncReady
+		table.LSN = consistentPoint
+		if err = r.writeTableSyncStatus(status); err != nil {
+			return err
+		}
+		log.Printf("Synchronized table %s.%s", table.Schema, table.Name)
+	}
+	return nil
+}
+
+// RefreshTables updates the synchronization state with the tables that are currently published through the given
+// slot. When copyData is true, the contents of newly published tables are copied by the next call to
+// SynchronizeTables, and otherwise those tables only receive the changes that are streamed to them. Tables that are no
+// longer published are removed from the synchronization state.
+func (r *LogicalReplicator) RefreshTables(slotName string, copyData bool) error {
+	status, err := r.readTableSyncStatus()
+	if err != nil {
+		return err
+	}
+	state := tableSyncReady
+	if copyData {
+		state = tableSyncInit
+	}
+	refreshed, err := r.publishedTables(slotName, state)
+	if err != nil {
+		return err
+	}
+	if status != nil {
+		existing := make(map[syncedTableKey]*syncedTable, len(status.Tables))
+		for _, table := range status.Tables {
+			existing[syncedTableKey{schema: table.Schema, name: table.Name}] = table
+		}
+		for i, table := range refreshed.Tables {
+			if existingTable, ok := existing[syncedTableKey{schema: table.Schema, name: table.Name}]; ok {
+				refreshed.Tables[i] = existingTable
+			}
+		}
+	}
+	return r.writeTableSyncStatus(refreshed)
+}
+
+// SyncedTable is the synchronization state of a replicated table, as reported by pg_subscription_rel.
+type SyncedTable struct {
+	Schema string
+	Name   string
+	// State is "i" when the table's contents have yet to be copied, and "r" when the table is ready.
+	State string
+	// LSN is the location that the table's contents were copied at, which is zero when they were not copied.
+	LSN pglogrepl.LSN
+}
+
+// SyncedTables returns the synchronization state of each replicated table, which is empty when tables have never
+// been synchronized.
+func (r *LogicalReplicator) SyncedTables() ([]SyncedTable, error) {
+	status, err := r.readTableSyncStatus()
+	if err != nil || status == nil {
+		return nil, err
+	}
+	tables := make([]SyncedTable, len(status.Tables))
+	for i, table := range status.Tables {
+		tables[i] = SyncedTable{
+			Schema: table.Schema,
+			Name:   table.Name,
+			State:  string(table.State),
+			LSN:    table.LSN,
+		}
+	}
+	return tables, nil
+}
+
+// RemoveReplicationState deletes the persisted WAL position and table synchronization state, so that a replicator
+// using the same files starts over from the beginning.
+func (r *LogicalReplicator) RemoveReplicationState() error {
+	for _, path := range []string{r.walFilePath, r.tableSyncFilePath()} {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// copyTable copies the contents of the table on the primary, as seen by the given snapshot, into the replica's table.
+// The copy is applied in a single transaction that first removes the table's rows, so that a copy that was interrupted
+// after being committed, but before its table was marked as ready, does not duplicate any rows.
+func (r *LogicalReplicator) copyTable(ctx *sql.Context, snapshotName string, table *syncedTable) (err error) {
+	conn, err := pgx.Connect(context.Background(), r.PrimaryDns())
+	if err != nil {
+		return err
+	}
+	defer conn.Close(context.Background())
+
+	tx, err := conn.BeginTx(context.Background(), pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(context.Background())
+	if _, err = tx.Exec(context.Background(), fmt.Sprintf("SET TRANSACTION SNAPSHOT '%s'", snapshotName)); err != nil {
+		return err
+	}
+	qualifiedName := pgx.Identifier{table.Schema, table.Name}.Sanitize()
+	rel, err := primaryRelation(tx, table, qualifiedName)
+	if err != nil {
+		return err
+	}
+
+	if err = sql.SessionCommandBegin(ctx.Session); err != nil {
+		return err
+	}
+	defer sql.SessionCommandEnd(ctx.Session)
+	if err = beginTransaction(ctx); err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = rollbackTransaction(ctx)
+		}
+	}()
+	if err = applyTruncate(ctx, []*pglogrepl.RelationMessageV2{rel}); err != nil {
+		return err
+	}
+	replicaTable, err := getReplicaTable(ctx, rel)
+	if err != nil {
+		return err
+	}
+	editTable, err := WithGinIndexMaintenance(ctx, replicaTable)
+	if err != nil {
+		return err
+	}
+	insertable, ok := editTable.(sql.InsertableTable)
+	if !ok {
+		return errors.Errorf(`logical replication target relation "%s.%s" does not support inserts`, rel.Namespace, rel.RelationName)
+	}
+
+	columnNames := make([]string, len(rel.Columns))
+	for i, col := range rel.Columns {
+		columnNames[i] = pgx.Identifier{col.Name}.Sanitize()
+	}
+	inserter := insertable.Inserter(ctx)
+	copier := &tableCopier{
+		ctx:      ctx,
+		rel:      rel,
+		sch:      replicaTable.Schema(ctx),
+		inserter: inserter,
+	}
+	err = applyEdit(ctx, inserter, func() error {
+		_, err := tx.Conn().PgConn().CopyTo(context.Background(), copier,
+			fmt.Sprintf("COPY %s (%s) TO STDOUT", qualifiedName, strings.Join(columnNames, ", ")))
+		if err != nil {
+			return err
+		}
+		return copier.finish()
+	})
+	if err != nil {
+		return err
+	}
+	return commitTransaction(ctx)
+}
+
+// primaryRelation returns a relation describing the columns of the table on the primary, in the same form as the
+// relation messages of the replication stream. Generated columns are not copied, matching the replication stream.
+func primaryRelation(tx pgx.Tx, table *syncedTable, qualifiedName string) (*pglogrepl.RelationMessageV2, error) {
+	rows, err := tx.Query(context.Background(), `SELECT a.attname, a.atttypid, a.atttypmod FROM pg_catalog.pg_attribute a
+		WHERE a.attrelid = $1::regclass AND a.attnum > 0 AND NOT a.attisdropped AND a.attgenerated = ''
+		ORDER BY a.attnum`, qualifiedName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	rel := &pglogrepl.RelationMessageV2{}
+	rel.Namespace = table.Schema
+	rel.RelationName = table.Name
+	for rows.Next() {
+		col := &pglogrepl.RelationMessageColumn{}
+		if err = rows.Scan(&col.Name, &col.DataType, &col.TypeModifier); err != nil {
+			return nil, err
+		}
+		rel.Columns = append(rel.Columns, col)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	rel.ColumnNum = uint16(len(rel.Columns))
+	return rel, nil
+}
+
+// tableCopier inserts the rows that it receives in COPY's text format into a replica table.
+type tableCopier struct {
+	ctx      *sql.Context
+	rel      *pglogrepl.RelationMessageV2
+	sch      sql.Schema
+	inserter sql.RowInserter
+	// partial is the data of a row that has not been completely received.
+	partial []byte
+}
+
+var _ io.Writer = (*tableCopier)(nil)
+
+// Write implements the interface io.Writer.
+func (c *tableCopier) Write(data []byte) (int, error) {
+	c.partial = append(c.partial, data...)
+	for {
+		end := bytes.IndexByte(c.partial, '\n')
+		if end < 0 {
+			return len(data), nil
+		}
+		if err := c.insertRow(c.partial[:end]); err != nil {
+			return 0, err
+		}
+		c.partial = c.partial[end+1:]
+	}
+}
+
+// finish returns an error if the copied data ended in the middle of a row.
+func (c *tableCopier) finish() error {
+	if len(c.partial) > 0 {
+		return errors.Errorf(`logical replication received an incomplete row while copying relation "%s.%s"`, c.rel.Namespace, c.rel.RelationName)
+	}
+	return nil
+}
+
+// insertRow decodes a single line of COPY's text format and inserts it.
+func (c *tableCopier) insertRow(line []byte) error {
+	fields := bytes.Split(line, []byte{'\t'})
+	if len(fields) != len(c.rel.Columns) {
+		return errors.Errorf(`logical replication received %d columns while copying relation "%s.%s", expected %d`,
+			len(fields), c.rel.Namespace, c.rel.RelationName, len(c.rel.Columns))
+	}
+	tuple := &pglogrepl.TupleData{
+		ColumnNum: uint16(len(fields)),
+		Columns:   make([]*pglogrepl.TupleDataColumn, len(fields)),
+	}
+	for i, field := range fields {
+		if string(field) == `\N` {
+			tuple.Columns[i] = &pglogrepl.TupleDataColumn{DataType: pglogrepl.TupleDataTypeNull}
+			continue
+		}
+		data := unescapeCopyText(field)
+		tuple.Columns[i] = &pglogrepl.TupleDataColumn{
+			DataType: pglogrepl.TupleDataTypeText,
+			Length:   uint32(len(data)),
+			Data:     data,
+		}
+	}
+	row, err := tupleToRow(c.ctx, c.rel, c.sch, tuple, nil)
+	if err != nil {
+		return err
+	}
+	return c.inserter.Insert(c.ctx, row)
+}
+
+// unescapeCopyText removes the backslash escapes from a field of COPY's text format.
+func unescapeCopyText(field []byte) []byte {
+	if bytes.IndexByte(field, '\\') < 0 {
+		return field
+	}
+	result := make([]byte, 0, len(field))
+	for i := 0; i < len(field); i++ {
+		if field[i] != '\\' || i+1 >= len(field) {
+			result = append(result, field[i])
+			continue
+		}
+		i++
+		switch c := field[i]; c {
+		case 'b':
+			result = append(result, '\b')
+		case 'f':
+			result = append(result, '\f')
+		case 'n':
+			result = append(result, '\n')
+		case 'r':
+			result = append(result, '\r')
+		case 't':
+			result = append(result, '\t')
+		case 'v':
+			result = append(result, '\v')
+		case 'x':
+			// One or two hexadecimal digits
+			var value byte
+			digits := 0
+			for digits < 2 && i+1 < len(field) && isHexDigit(field[i+1]) {
+				i++
+				value = value<<4 | hexDigitValue(field[i])
+				digits++
+			}
+			if digits == 0 {
+				result = append(result, 'x')
+			} else {
+				result = append(result, value)
+			}
+		case '0', '1', '2', '3', '4', '5', '6', '7':
+			// One to three octal digits
+			value := c - '0'
+			for digits := 1; digits < 3 && i+1 < len(field) && field[i+1] >= '0' && field[i+1] <= '7'; digits++ {
+				i++
+				value = value<<3 | (field[i] - '0')
+			}
+			result = append(result, value)
+		default:
+			result = append(result, c)
+		}
+	}
+	return result
+}
+
+// isHexDigit returns whether the byte is a hexadecimal digit.
+func isHexDigit(b byte) bool {
+	return (b >= '0' && b <= '9') || (b >= 'a' && b <= 'f') || (b >= 'A' && b <= 'F')
+}
+
+// hexDigitValue returns the value of the hexadecimal digit.
+func hexDigitValue(b byte) byte {
+	switch {
+	case b >= 'a':
+		return b - 'a' + 10
+	case b >= 'A':
+		return b - 'A' + 10
+	default:
+		return b - '0'
+	}
+}
+
+// publishedTables returns the tables of the publications replicated through the given slot, all of which are given
+// the synchronization state provided.
+func (r *LogicalReplicator) publishedTables(slotName string, state tableSyncState) (*tableSyncStatus, error) {
+	conn, err := pgx.Connect(context.Background(), r.PrimaryDns())
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close(context.Background())
+
+	rows, err := conn.Query(context.Background(), "SELECT DISTINCT schemaname, tablename FROM pg_catalog.pg_publication_tables WHERE pubname = ANY($1) ORDER BY schemaname, tablename", r.publicationNames(slotName))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	status := &tableSyncStatus{}
+	for rows.Next() {
+		table := &syncedTable{State: state}
+		if err = rows.Scan(&table.Schema, &table.Name); err != nil {
+			return nil, err
+		}
+		status.Tables = append(status.Tables, table)
+	}
+	return status, rows.Err()
+}
+
+// syncedTableLSNs returns the consistent point that each synchronized table was copied at, keyed by the table.
+func (s *tableSyncStatus) syncedTableLSNs() map[syncedTableKey]pglogrepl.LSN {
+	lsns := make(map[syncedTableKey]pglogrepl.LSN)
+	if s == nil {
+		return lsns
+	}
+	for _, table := range s.Tables {
+		lsns[syncedTableKey{schema: table.Schema, name: table.Name}] = table.LSN
+	}
+	return lsns
+}
+
+// tableSyncFilePath returns the path of the file that the table synchronization state is persisted to.
+func (r *LogicalReplicator) tableSyncFilePath() string {
+	return r.walFilePath + "_tablesync"
+}
+
+// readTableSyncStatus reads the table synchronization state, returning nil if tables have never been synchronized.
+func (r *LogicalReplicator) readTableSyncStatus() (*tableSyncStatus, error) {
+	contents, err := os.ReadFile(r.tableSyncFilePath())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	status := &tableSyncStatus{}
+	if err = json.Unmarshal(contents, status); err != nil {
+		return nil, err
+	}
+	return status, nil
+}
+
+// writeTableSyncStatus persists the table synchronization state.
+func (r *LogicalReplicator) writeTableSyncStatus(status *tableSyncStatus) error {
+	contents, err := json.Marshal(status)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(r.tableSyncFilePath(), contents, 0644)
+}
@@ -0,0 +1,330 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/id"
+	"github.com/dolthub/doltgresql/postgres/parser/parser"
+	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	"github.com/dolthub/doltgresql/server/types"
+	"github.com/dolthub/doltgresql/utils"
+)
+
+// AlterDomainAction is the action taken by an ALTER DOMAIN statement.
+type AlterDomainAction uint8
+
+const (
+	AlterDomainAction_SetDefault AlterDomainAction = iota
+	AlterDomainAction_DropDefault
+	AlterDomainAction_SetNotNull
+	AlterDomainAction_DropNotNull
+	AlterDomainAction_AddConstraint
+	AlterDomainAction_DropConstraint
+	AlterDomainAction_RenameConstraint
+	AlterDomainAction_ValidateConstraint
+	AlterDomainAction_Rename
+)
+
+// AlterDomain handles the ALTER DOMAIN statement.
+type AlterDomain struct {
+	SchemaName        string
+	Name              string
+	Action            AlterDomainAction
+	DefaultExpr       sql.Expression
+	ConstraintName    string
+	NewConstraintName string
+	CheckExpr         sql.Expression
+	NotValid          bool
+	IfExists          bool
+	NewName           string
+	NewSchema         string
+	overrides         sql.EngineOverrides
+}
+
+var _ sql.ExecSourceRel = (*AlterDomain)(nil)
+var _ sql.NodeOverriding = (*AlterDomain)(nil)
+var _ vitess.Injectable = (*AlterDomain)(nil)
+
+// Children implements the interface sql.ExecSourceRel.
+func (c *AlterDomain) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (c *AlterDomain) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (c *AlterDomain) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (c *AlterDomain) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	schema, err := core.GetSchemaName(ctx, nil, c.SchemaName)
+	if err != nil {
+		return nil, err
+	}
+	collection, err := core.GetTypesCollectionFromContext(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	domain, err := collection.GetType(ctx, id.NewType(schema, c.Name))
+	if err != nil {
+		return nil, err
+	}
+	if domain == nil {
+		return nil, types.ErrTypeDoesNotExist.New(c.Name)
+	}
+	if domain.TypType != types.TypeType_Domain {
+		return nil, errors.Errorf(`%s is not a domain`, domain.Name())
+	}
+	if c.Action == AlterDomainAction_Rename {
+		if err = renameType(ctx, collection, domain, c.NewName, c.NewSchema); err != nil {
+			return nil, err
+		}
+		return sql.RowsToRowIter(), nil
+	}
+	columns, err := typeColumns(ctx, domain.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	newDomain := domain.Copy()
+	// Copies share their checks, so we create new ones
+	newDomain.Checks = make([]*sql.CheckDefinition, len(domain.Checks))
+	for i, check := range domain.Checks {
+		checkCopy := *check
+		newDomain.Checks[i] = &checkCopy
+	}
+	findCheck := func(name string) int {
+		for i, check := range newDomain.Checks {
+			if check.Name == name {
+				return i
+			}
+		}
+		return -1
+	}
+	switch c.Action {
+	case AlterDomainAction_SetDefault:
+		newDomain.Default = c.DefaultExpr.String()
+	case AlterDomainAction_DropDefault:
+		newDomain.Default = ""
+	case AlterDomainAction_SetNotNull:
+		if domain.NotNull {
+			return sql.RowsToRowIter(), nil
+		}
+		for _, col := range columns {
+			if col.typ.ID != domain.ID {
+				continue
+			}
+			if err = validateDomainColumn(ctx, col, fmt.Sprintf("%s IS NULL", utils.QuoteIdentifier(col.column)),
+				`column "%s" of table "%s" contains null values`); err != nil {
+				return nil, err
+			}
+		}
+		newDomain.NotNull = true
+	case AlterDomainAction_DropNotNull:
+		newDomain.NotNull = false
+	case AlterDomainAction_AddConstraint:
+		if len(c.ConstraintName) > 0 && findCheck(c.ConstraintName) != -1 {
+			return nil, errors.Errorf(`constraint "%s" for domain "%s" already exists`, c.ConstraintName, domain.Name())
+		}
+		checkName := c.ConstraintName
+		if len(checkName) == 0 {
+			names := make([]string, len(newDomain.Checks))
+			for i, check := range newDomain.Checks {
+				names[i] = check.Name
+			}
+			checkName = generateCheckNameForDomain(domain.Name(), names)
+		}
+		checkDef, err := plan.NewCheckDefinition(ctx, &sql.CheckConstraint{
+			Name:     checkName,
+			Expr:     c.CheckExpr,
+			Enforced: true,
+		}, sql.GetSchemaFormatter(c.overrides))
+		if err != nil {
+			return nil, err
+		}
+		checkDef.IsNotValid = c.NotValid
+		if !c.NotValid {
+			if err = validateDomainCheck(ctx, columns, domain.ID, checkDef); err != nil {
+				return nil, err
+			}
+		}
+		newDomain.Checks = append(newDomain.Checks, checkDef)
+	case AlterDomainAction_DropConstraint:
+		idx := findCheck(c.ConstraintName)
+		if idx == -1 {
+			if c.IfExists {
+				alterTypeNotice(ctx, fmt.Sprintf(`constraint "%s" of domain "%s" does not exist, skipping`, c.ConstraintName, domain.Name()))
+				return sql.RowsToRowIter(), nil
+			}
+			return nil, errors.Errorf(`constraint "%s" of domain "%s" does not exist`, c.ConstraintName, domain.Name())
+		}
+		newDomain.Checks = append(newDomain.Checks[:idx], newDomain.Checks[idx+1:]...)
+	case AlterDomainAction_RenameConstraint:
+		idx := findCheck(c.ConstraintName)
+		if idx == -1 {
+			return nil, errors.Errorf(`constraint "%s" of domain "%s" does not exist`, c.ConstraintName, domain.Name())
+		}
+		if findCheck(c.NewConstraintName) != -1 {
+			return nil, errors.Errorf(`constraint "%s" for domain "%s" already exists`, c.NewConstraintName, domain.Name())
+		}
+		newDomain.Checks[idx].Name = c.NewConstraintName
+	case AlterDomainAction_ValidateConstraint:
+		idx := findCheck(c.ConstraintName)
+		if idx == -1 {
+			return nil, errors.Errorf(`constraint "%s" of domain "%s" does not exist`, c.ConstraintName, domain.Name())
+		}
+		if !newDomain.Checks[idx].IsNotValid {
+			return sql.RowsToRowIter(), nil
+		}
+		if err = validateDomainCheck(ctx, columns, domain.ID, newDomain.Checks[idx]); err != nil {
+			return nil, err
+		}
+		newDomain.Checks[idx].IsNotValid = false
+	default:
+		return nil, errors.Errorf("unknown ALTER DOMAIN action: %d", c.Action)
+	}
+
+	if err = collection.UpdateType(ctx, newDomain); err != nil {
+		return nil, err
+	}
+	// Columns hold their own copy of the domain, which is where its constraints and default are read from
+	for _, col := range columns {
+		if col.typ.ID == domain.ID {
+			if err = alterTypeColumn(ctx, col, domain.ID, false); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (c *AlterDomain) Schema(ctx *sql.Context) sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (c *AlterDomain) String() string {
+	return fmt.Sprintf("ALTER DOMAIN %s", c.Name)
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (c *AlterDomain) WithChildren(ctx *sql.Context, children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(c, children...)
+}
+
+// WithOverrides implements the interface sql.NodeOverriding.
+func (c *AlterDomain) WithOverrides(overrides sql.EngineOverrides) sql.Node {
+	c.overrides = overrides
+	return c
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (c *AlterDomain) WithResolvedChildren(ctx context.Context, children []any) (any, error) {
+	expectedChildren := 0
+	if c.Action == AlterDomainAction_SetDefault || c.Action == AlterDomainAction_AddConstraint {
+		expectedChildren = 1
+	}
+	if len(children) != expectedChildren {
+		return nil, ErrVitessChildCount.New(expectedChildren, len(children))
+	}
+	if expectedChildren == 0 {
+		return c, nil
+	}
+	expr, ok := children[0].(sql.Expression)
+	if !ok {
+		return nil, errors.Errorf("invalid vitess child, expected sql.Expression but got %T", children[0])
+	}
+	newC := *c
+	if c.Action == AlterDomainAction_SetDefault {
+		newC.DefaultExpr = expr
+	} else {
+		newC.CheckExpr = expr
+	}
+	return &newC, nil
+}
+
+// validateDomainCheck returns an error if any column of the domain contains a value that violates the check.
+func validateDomainCheck(ctx *sql.Context, columns []typeColumn, domainID id.Type, check *sql.CheckDefinition) error {
+	for _, col := range columns {
+		// TODO: validate values that are nested within other types, such as arrays of the domain
+		if col.typ.ID != domainID {
+			continue
+		}
+		checkExpr, err := domainCheckForColumn(check.CheckExpression, col.column)
+		if err != nil {
+			return err
+		}
+		if err = validateDomainColumn(ctx, col, fmt.Sprintf("NOT (%s)", checkExpr),
+			`column "%s" of table "%s" contains values that violate the new constraint`); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateDomainColumn returns the formatted error if any row of the column's table matches the condition.
+func validateDomainColumn(ctx *sql.Context, col typeColumn, condition string, errorFormat string) error {
+	rows, err := runNestedStatement(ctx, fmt.Sprintf("SELECT 1 FROM %s WHERE %s LIMIT 1;",
+		utils.QuoteQualifiedIdentifier(col.table.SchemaName(), col.table.TableName()), condition))
+	if err != nil {
+		return err
+	}
+	if len(rows) > 0 {
+		return errors.Errorf(errorFormat, col.column, col.table.TableName())
+	}
+	return nil
+}
+
+// domainCheckForColumn returns the check expression of a domain with each reference to VALUE replaced by the column.
+func domainCheckForColumn(checkExpr string, column string) (string, error) {
+	stmt, err := parser.ParseOne("SELECT " + checkExpr)
+	if err != nil {
+		return "", err
+	}
+	selectStmt, ok := stmt.AST.(*tree.Select)
+	if !ok {
+		return "", sql.ErrInvalidCheckConstraint.New(checkExpr)
+	}
+	selectClause, ok := selectStmt.Select.(*tree.SelectClause)
+	if !ok || len(selectClause.Exprs) != 1 {
+		return "", sql.ErrInvalidCheckConstraint.New(checkExpr)
+	}
+	expr, err := tree.SimpleVisit(selectClause.Exprs[0].Expr, func(visitingExpr tree.Expr) (recurse bool, newExpr tree.Expr, err error) {
+		if name, ok := visitingExpr.(*tree.UnresolvedName); ok && strings.EqualFold(name.String(), "value") {
+			return false, &tree.ColumnItem{ColumnName: tree.Name(column)}, nil
+		}
+		return true, visitingExpr, nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return tree.AsString(expr), nil
+}
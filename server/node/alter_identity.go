@@ -0,0 +1,268 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+	"github.com/jackc/pgx/v5/pgproto3"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/sequences"
+	"github.com/dolthub/doltgresql/server/dependencies"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+)
+
+// IdentityGeneration is the way that an identity column generates its values.
+type IdentityGeneration uint8
+
+const (
+	IdentityGeneration_Unchanged IdentityGeneration = iota
+	IdentityGeneration_Always
+	IdentityGeneration_ByDefault
+)
+
+// AlterIdentity handles the identity variants of ALTER TABLE ... ALTER COLUMN, which are SET GENERATED, the sequence
+// options (including RESTART), and DROP IDENTITY.
+type AlterIdentity struct {
+	ifExists     bool
+	database     string
+	schema       string
+	table        string
+	column       string
+	generation   IdentityGeneration
+	options      AlterSequenceOptions
+	drop         bool
+	dropIfExists bool
+}
+
+var _ sql.ExecSourceRel = (*AlterIdentity)(nil)
+var _ vitess.Injectable = (*AlterIdentity)(nil)
+
+// NewAlterIdentity returns a new *AlterIdentity that changes the generation or sequence options of an identity column.
+// The table is found in the current database if the database is empty.
+func NewAlterIdentity(ifExists bool, database string, schema string, table string, column string, generation IdentityGeneration, options AlterSequenceOptions) *AlterIdentity {
+	return &AlterIdentity{
+		ifExists:   ifExists,
+		database:   database,
+		schema:     schema,
+		table:      table,
+		column:     column,
+		generation: generation,
+		options:    options,
+	}
+}
+
+// NewDropIdentity returns a new *AlterIdentity that removes the identity from a column. The table is found in the
+// current database if the database is empty.
+func NewDropIdentity(ifExists bool, database string, schema string, table string, column string, dropIfExists bool) *AlterIdentity {
+	return &AlterIdentity{
+		ifExists:     ifExists,
+		database:     database,
+		schema:       schema,
+		table:        table,
+		column:       column,
+		drop:         true,
+		dropIfExists: dropIfExists,
+	}
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (c *AlterIdentity) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (c *AlterIdentity) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (c *AlterIdentity) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (c *AlterIdentity) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	session := dsess.DSessFromSess(ctx.Session)
+	database := c.database
+	if len(database) == 0 {
+		database = ctx.GetCurrentDatabase()
+		if c.schema == "" {
+			if _, ok := session.GetTemporaryTable(ctx, database, c.table); ok {
+				return nil, errors.Errorf("identity columns are not yet supported on temporary tables")
+			}
+		}
+	}
+	table, err := core.GetSqlTableFromContext(ctx, database, doltdb.TableName{Name: c.table, Schema: c.schema})
+	if err != nil {
+		return nil, err
+	}
+	if table == nil {
+		if c.ifExists {
+			session.Notice(&pgproto3.NoticeResponse{
+				Severity: "NOTICE",
+				Message:  fmt.Sprintf(`relation "%s" does not exist, skipping`, c.table),
+			})
+			return sql.RowsToRowIter(), nil
+		}
+		return nil, errors.Errorf(`relation "%s" does not exist`, c.table)
+	}
+	schemaTable, ok := table.(sql.DatabaseSchemaTable)
+	if !ok {
+		return nil, errors.Errorf("table %s does not implement sql.DatabaseSchemaTable", c.table)
+	}
+	schema := schemaTable.DatabaseSchema().SchemaName()
+	var tableColumn *sql.Column
+	for _, col := range table.Schema(ctx) {
+		if col.Name == c.column {
+			tableColumn = col.Copy()
+			break
+		}
+	}
+	if tableColumn == nil {
+		return nil, errors.Errorf(`column "%s" of relation "%s" does not exist`, c.column, c.table)
+	}
+	collection, err := core.GetSequencesCollectionFromContext(ctx, database)
+	if err != nil {
+		return nil, err
+	}
+	seq, err := identitySequence(ctx, collection, schema, c.table, tableColumn)
+	if err != nil {
+		return nil, err
+	}
+	if seq == nil {
+		if c.drop && c.dropIfExists {
+			session.Notice(&pgproto3.NoticeResponse{
+				Severity: "NOTICE",
+				Message:  fmt.Sprintf(`column "%s" of relation "%s" is not an identity column, skipping`, c.column, c.table),
+			})
+			return sql.RowsToRowIter(), nil
+		}
+		return nil, errors.Errorf(`column "%s" of relation "%s" is not an identity column`, c.column, c.table)
+	}
+	if c.drop {
+		return c.dropIdentity(ctx, collection, table, tableColumn, seq)
+	}
+	if err = alterSequenceOptions(ctx, database, seq, c.options); err != nil {
+		return nil, err
+	}
+	// Identity columns that are GENERATED ALWAYS store their nextval call as the generated expression, while those
+	// that are GENERATED BY DEFAULT store it as the default expression.
+	switch c.generation {
+	case IdentityGeneration_Always:
+		if tableColumn.Generated == nil {
+			tableColumn.Generated = tableColumn.Default
+			tableColumn.Default = nil
+			return sql.RowsToRowIter(), modifyIdentityColumn(ctx, table, tableColumn)
+		}
+	case IdentityGeneration_ByDefault:
+		if tableColumn.Default == nil {
+			tableColumn.Default = tableColumn.Generated
+			tableColumn.Generated = nil
+			return sql.RowsToRowIter(), modifyIdentityColumn(ctx, table, tableColumn)
+		}
+	}
+	// Any changes made to the sequence will be persisted at the end of the transaction, so we can just return now
+	return sql.RowsToRowIter(), nil
+}
+
+// dropIdentity removes the nextval call from the column, and then drops the sequence that the column owns.
+func (c *AlterIdentity) dropIdentity(ctx *sql.Context, collection *sequences.Collection, table sql.Table, tableColumn *sql.Column, seq *sequences.Sequence) (sql.RowIter, error) {
+	tableColumn.Default = nil
+	tableColumn.Generated = nil
+	if err := modifyIdentityColumn(ctx, table, tableColumn); err != nil {
+		return nil, err
+	}
+	if err := dependencies.PrepareDrop(ctx, false, dependencies.NewObject(dependencies.ObjectKind_Sequence, seq.Id.AsId())); err != nil {
+		return nil, err
+	}
+	if err := collection.DropSequence(ctx, seq.Id); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (c *AlterIdentity) Schema(ctx *sql.Context) sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (c *AlterIdentity) String() string {
+	return "ALTER TABLE"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (c *AlterIdentity) WithChildren(ctx *sql.Context, children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(c, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (c *AlterIdentity) WithResolvedChildren(ctx context.Context, children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, ErrVitessChildCount.New(0, len(children))
+	}
+	return c, nil
+}
+
+// identitySequence returns the sequence that generates the values of the given column. Returns nil if the column is not
+// an identity column. As we do not record whether a column was declared as SERIAL or as an identity, every column that
+// owns a sequence and calls nextval for its values is treated as an identity column.
+func identitySequence(ctx *sql.Context, collection *sequences.Collection, schema string, table string, column *sql.Column) (*sequences.Sequence, error) {
+	if !callsNextval(ctx, column.Default) && !callsNextval(ctx, column.Generated) {
+		return nil, nil
+	}
+	seqs, err := collection.GetSequencesWithTable(ctx, doltdb.TableName{Name: table, Schema: schema})
+	if err != nil {
+		return nil, err
+	}
+	for _, seq := range seqs {
+		if seq.OwnerColumn == column.Name {
+			return seq, nil
+		}
+	}
+	return nil, nil
+}
+
+// callsNextval returns whether the given default or generated expression calls nextval.
+func callsNextval(ctx *sql.Context, defaultValue *sql.ColumnDefaultValue) bool {
+	if defaultValue == nil || defaultValue.Expr == nil {
+		return false
+	}
+	return transform.InspectExpr(ctx, defaultValue.Expr, func(ctx *sql.Context, expr sql.Expression) bool {
+		compiledFunction, ok := expr.(*framework.CompiledFunction)
+		return ok && strings.EqualFold(compiledFunction.Name, "nextval")
+	})
+}
+
+// modifyIdentityColumn replaces the column in the table with the given column.
+func modifyIdentityColumn(ctx *sql.Context, table sql.Table, tableColumn *sql.Column) error {
+	alterableTable, ok := table.(*sqle.AlterableDoltTable)
+	if !ok {
+		return errors.Errorf(`expected a Dolt table but received "%T"`, table)
+	}
+	return alterableTable.ModifyColumn(ctx, tableColumn.Name, tableColumn, nil)
+}
@@ -0,0 +1,144 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/id"
+	"github.com/dolthub/doltgresql/core/policies"
+)
+
+// AlterPolicy implements ALTER POLICY. When NewName is set, the policy is renamed. Otherwise, the roles and
+// expressions that are set replace those of the policy, with nil roles and empty expressions remaining unchanged.
+type AlterPolicy struct {
+	Name      string
+	Schema    string
+	Table     string
+	NewName   string
+	Roles     []string
+	Using     string
+	WithCheck string
+}
+
+var _ sql.ExecSourceRel = (*AlterPolicy)(nil)
+var _ vitess.Injectable = (*AlterPolicy)(nil)
+
+// Children implements the interface sql.ExecSourceRel.
+func (c *AlterPolicy) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (c *AlterPolicy) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (c *AlterPolicy) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (c *AlterPolicy) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	tableID, err := resolvePolicyTable(ctx, c.Schema, c.Table)
+	if err != nil {
+		return nil, err
+	}
+	if !tableID.IsValid() {
+		return nil, errors.Errorf(`relation "%s" does not exist`, c.Table)
+	}
+	collection, err := core.GetPoliciesCollectionFromContext(ctx, ctx.GetCurrentDatabase())
+	if err != nil {
+		return nil, err
+	}
+	policy, err := collection.GetPolicy(ctx, id.NewPolicy(tableID.SchemaName(), tableID.TableName(), c.Name))
+	if err != nil {
+		return nil, err
+	}
+	if !policy.ID.IsValid() {
+		return nil, errors.Errorf(`policy "%s" for table "%s" does not exist`, c.Name, c.Table)
+	}
+	if len(c.NewName) > 0 {
+		newID := id.NewPolicy(tableID.SchemaName(), tableID.TableName(), c.NewName)
+		if collection.HasPolicy(ctx, newID) {
+			return nil, errors.Errorf(`policy "%s" for table "%s" already exists`, c.NewName, c.Table)
+		}
+		if err = collection.DropPolicy(ctx, policy.ID); err != nil {
+			return nil, err
+		}
+		policy.ID = newID
+		if err = collection.AddPolicy(ctx, policy); err != nil {
+			return nil, err
+		}
+		return sql.RowsToRowIter(), nil
+	}
+	if c.Roles != nil {
+		if policy.Roles, err = resolvePolicyRoles(ctx, c.Roles); err != nil {
+			return nil, err
+		}
+	}
+	if len(c.Using) > 0 && policy.Command == policies.PolicyCommand_Insert {
+		return nil, errors.New("only WITH CHECK expression allowed for INSERT")
+	}
+	if len(c.Using) > 0 {
+		if err = validatePolicyExpression(ctx, tableID, c.Using); err != nil {
+			return nil, err
+		}
+		policy.Using = c.Using
+	}
+	if len(c.WithCheck) > 0 {
+		if policy.Command == policies.PolicyCommand_Select || policy.Command == policies.PolicyCommand_Delete {
+			return nil, errors.New("WITH CHECK cannot be applied to SELECT or DELETE")
+		}
+		if err = validatePolicyExpression(ctx, tableID, c.WithCheck); err != nil {
+			return nil, err
+		}
+		policy.WithCheck = c.WithCheck
+	}
+	if err = collection.UpdatePolicy(ctx, policy); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (c *AlterPolicy) Schema(ctx *sql.Context) sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (c *AlterPolicy) String() string {
+	return "ALTER POLICY"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (c *AlterPolicy) WithChildren(ctx *sql.Context, children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(c, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (c *AlterPolicy) WithResolvedChildren(ctx context.Context, children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, ErrVitessChildCount.New(0, len(children))
+	}
+	return c, nil
+}
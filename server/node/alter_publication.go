@@ -0,0 +1,203 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/server/auth"
+	"github.com/dolthub/doltgresql/server/walsender"
+)
+
+// AlterPublicationAction is the modification made by an ALTER PUBLICATION statement.
+type AlterPublicationAction uint8
+
+const (
+	AlterPublicationSetObjects AlterPublicationAction = iota
+	AlterPublicationAddObjects
+	AlterPublicationDropObjects
+	AlterPublicationSetOptions
+	AlterPublicationOwner
+	AlterPublicationRename
+)
+
+// AlterPublication handles the ALTER PUBLICATION statement.
+type AlterPublication struct {
+	Name   string
+	Action AlterPublicationAction
+	// Objects are the tables and schemas that are set, added, or dropped.
+	Objects PublicationObjects
+	// Actions are the published operations for AlterPublicationSetOptions, which are nil when the publish option was
+	// not given.
+	Actions *PublicationActions
+	// Owner is the new owner for AlterPublicationOwner.
+	Owner string
+	// NewName is the new name for AlterPublicationRename.
+	NewName string
+}
+
+var _ sql.ExecSourceRel = (*AlterPublication)(nil)
+var _ vitess.Injectable = (*AlterPublication)(nil)
+
+// Children implements the interface sql.ExecSourceRel.
+func (c *AlterPublication) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (c *AlterPublication) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (c *AlterPublication) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (c *AlterPublication) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	pub, err := checkPublicationOwner(ctx, c.Name)
+	if err != nil {
+		return nil, err
+	}
+	var userRole auth.Role
+	auth.LockRead(func() {
+		userRole = auth.GetRole(ctx.Client().User)
+	})
+
+	var objects PublicationObjects
+	var ownerName string
+	switch c.Action {
+	case AlterPublicationSetObjects, AlterPublicationAddObjects, AlterPublicationDropObjects:
+		if pub.AllTables {
+			return nil, errors.Errorf(`publication "%s" is defined as FOR ALL TABLES`, c.Name)
+		}
+		if c.Action != AlterPublicationDropObjects && len(c.Objects.Schemas) > 0 && !userRole.IsSuperUser {
+			return nil, errors.New("must be superuser to add or set schemas")
+		}
+		if objects, err = resolvePublicationObjects(ctx, c.Objects); err != nil {
+			return nil, err
+		}
+	case AlterPublicationOwner:
+		ownerName = c.Owner
+		switch ownerName {
+		case "current_role", "current_user", "session_user":
+			ownerName = ctx.Client().User
+		}
+		var newOwner auth.Role
+		auth.LockRead(func() {
+			newOwner = auth.GetRole(ownerName)
+		})
+		if !newOwner.IsValid() {
+			return nil, errors.Errorf(`role "%s" does not exist`, ownerName)
+		}
+		if (pub.AllTables || len(pub.Schemas) > 0) && !newOwner.IsSuperUser {
+			return nil, errors.Errorf(`permission denied to change owner of publication "%s"`, c.Name)
+		}
+		ownerName = newOwner.Name
+	}
+
+	err = walsender.AlterPublication(ctx.GetCurrentDatabase(), c.Name, func(pub *walsender.Publication) error {
+		switch c.Action {
+		case AlterPublicationSetObjects:
+			pub.Tables = objects.Tables
+			pub.Schemas = objects.Schemas
+		case AlterPublicationAddObjects:
+			for _, table := range objects.Tables {
+				if containsPublishedTable(pub.Tables, table) {
+					return errors.Errorf(`relation "%s" is already member of publication "%s"`, table.Name, c.Name)
+				}
+				pub.Tables = append(pub.Tables, table)
+			}
+			for _, schemaName := range objects.Schemas {
+				if containsString(pub.Schemas, schemaName) {
+					return errors.Errorf(`schema "%s" is already member of publication "%s"`, schemaName, c.Name)
+				}
+				pub.Schemas = append(pub.Schemas, schemaName)
+			}
+		case AlterPublicationDropObjects:
+			var tables []walsender.PublishedTable
+			for _, table := range objects.Tables {
+				if !containsPublishedTable(pub.Tables, table) {
+					return errors.Errorf(`relation "%s" is not part of the publication`, table.Name)
+				}
+			}
+			for _, table := range pub.Tables {
+				if !containsPublishedTable(objects.Tables, table) {
+					tables = append(tables, table)
+				}
+			}
+			var schemas []string
+			for _, schemaName := range objects.Schemas {
+				if !containsString(pub.Schemas, schemaName) {
+					return errors.Errorf(`tables from schema "%s" are not part of the publication`, schemaName)
+				}
+			}
+			for _, schemaName := range pub.Schemas {
+				if !containsString(objects.Schemas, schemaName) {
+					schemas = append(schemas, schemaName)
+				}
+			}
+			pub.Tables = tables
+			pub.Schemas = schemas
+		case AlterPublicationSetOptions:
+			if c.Actions != nil {
+				pub.Insert = c.Actions.Insert
+				pub.Update = c.Actions.Update
+				pub.Delete = c.Actions.Delete
+				pub.Truncate = c.Actions.Truncate
+			}
+		case AlterPublicationOwner:
+			pub.Owner = ownerName
+		case AlterPublicationRename:
+			pub.Name = c.NewName
+		default:
+			return errors.Errorf("unknown ALTER PUBLICATION action: %d", c.Action)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (c *AlterPublication) Schema(ctx *sql.Context) sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (c *AlterPublication) String() string {
+	return "ALTER PUBLICATION"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (c *AlterPublication) WithChildren(ctx *sql.Context, children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(c, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (c *AlterPublication) WithResolvedChildren(ctx context.Context, children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, ErrVitessChildCount.New(0, len(children))
+	}
+	return c, nil
+}
@@ -16,10 +16,13 @@ package node
 
 import (
 	"context"
+	"math"
 
 	"github.com/cockroachdb/errors"
 	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/globalstate"
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/plan"
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
@@ -27,6 +30,8 @@ import (
 
 	"github.com/dolthub/doltgresql/core"
 	"github.com/dolthub/doltgresql/core/id"
+	"github.com/dolthub/doltgresql/core/sequences"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
 )
 
 // AlterSequence handles the ALTER SEQUENCE statement.
@@ -35,6 +40,7 @@ type AlterSequence struct {
 	targetSchema   string
 	targetSequence string
 	ownedBy        AlterSequenceOwnedBy
+	options        AlterSequenceOptions
 	warnings       []string
 }
 
@@ -45,16 +51,33 @@ type AlterSequenceOwnedBy struct {
 	Column string
 }
 
+// AlterSequenceOptions are the options in AlterSequence that change the parameters or the state of the sequence. Any
+// options that were not given are nil.
+type AlterSequenceOptions struct {
+	DataType    *pgtypes.DoltgresType
+	Increment   *int64
+	Minimum     *int64
+	NoMinimum   bool
+	Maximum     *int64
+	NoMaximum   bool
+	Start       *int64
+	Restart     bool
+	RestartWith *int64
+	Cache       *int64
+	Cycle       *bool
+}
+
 var _ sql.ExecSourceRel = (*AlterSequence)(nil)
 var _ vitess.Injectable = (*AlterSequence)(nil)
 
 // NewAlterSequence returns a new *AlterSequence.
-func NewAlterSequence(ifExists bool, targetSchema string, targetSequence string, ownedBy AlterSequenceOwnedBy, warnings ...string) *AlterSequence {
+func NewAlterSequence(ifExists bool, targetSchema string, targetSequence string, ownedBy AlterSequenceOwnedBy, options AlterSequenceOptions, warnings ...string) *AlterSequence {
 	return &AlterSequence{
 		ifExists:       ifExists,
 		targetSchema:   targetSchema,
 		targetSequence: targetSequence,
 		ownedBy:        ownedBy,
+		options:        options,
 		warnings:       warnings,
 	}
 }
@@ -95,6 +118,10 @@ func (c *AlterSequence) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error
 	if err != nil {
 		return nil, err
 	}
+	// Validate the options before making any changes, so that an invalid statement doesn't partially apply
+	if _, err = c.options.apply(*seq); err != nil {
+		return nil, err
+	}
 
 	if c.ownedBy.IsSet {
 		if len(c.ownedBy.Table) > 0 {
@@ -134,6 +161,9 @@ func (c *AlterSequence) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error
 			seq.OwnerColumn = ""
 		}
 	}
+	if err = alterSequenceOptions(ctx, ctx.GetCurrentDatabase(), seq, c.options); err != nil {
+		return nil, err
+	}
 	// Display any warnings that were encountered during parsing
 	for _, warning := range c.warnings {
 		noticeResponse := &pgproto3.NoticeResponse{
@@ -169,3 +199,170 @@ func (c *AlterSequence) WithResolvedChildren(ctx context.Context, children []any
 	}
 	return c, nil
 }
+
+// IsSet returns whether any options were given.
+func (options AlterSequenceOptions) IsSet() bool {
+	return options.DataType != nil || options.Increment != nil || options.Minimum != nil || options.NoMinimum ||
+		options.Maximum != nil || options.NoMaximum || options.Start != nil || options.Restart ||
+		options.Cache != nil || options.Cycle != nil
+}
+
+// apply returns the sequence with the options applied, following the same rules as Postgres. Returns an error if the
+// resulting sequence is invalid.
+func (options AlterSequenceOptions) apply(seq sequences.Sequence) (sequences.Sequence, error) {
+	oldMinLimit, oldMaxLimit := sequenceTypeLimits(seq.DataTypeID)
+	dataType := sequenceType(seq.DataTypeID)
+	if options.DataType != nil {
+		switch options.DataType.ID {
+		case pgtypes.Int16.ID, pgtypes.Int32.ID, pgtypes.Int64.ID:
+			seq.DataTypeID = options.DataType.ID
+			dataType = options.DataType
+		default:
+			return seq, errors.Errorf("sequence type must be smallint, integer, or bigint")
+		}
+	}
+	minLimit, maxLimit := sequenceTypeLimits(seq.DataTypeID)
+	if options.Increment != nil {
+		if *options.Increment == 0 {
+			return seq, errors.Errorf("INCREMENT must not be zero")
+		}
+		seq.Increment = *options.Increment
+	}
+	// When the type changes, bounds that were at the limits of the old type move to the limits of the new type
+	resetMaximum := options.DataType != nil && seq.Maximum == oldMaxLimit
+	resetMinimum := options.DataType != nil && seq.Minimum == oldMinLimit
+	if options.Maximum != nil {
+		seq.Maximum = *options.Maximum
+	} else if options.NoMaximum || resetMaximum {
+		if seq.Increment > 0 || resetMaximum {
+			seq.Maximum = maxLimit
+		} else {
+			seq.Maximum = -1
+		}
+	}
+	if seq.Maximum < minLimit || seq.Maximum > maxLimit {
+		return seq, errors.Errorf("MAXVALUE (%d) is out of range for sequence data type %s", seq.Maximum, dataType.String())
+	}
+	if options.Minimum != nil {
+		seq.Minimum = *options.Minimum
+	} else if options.NoMinimum || resetMinimum {
+		if seq.Increment < 0 || resetMinimum {
+			seq.Minimum = minLimit
+		} else {
+			seq.Minimum = 1
+		}
+	}
+	if seq.Minimum < minLimit || seq.Minimum > maxLimit {
+		return seq, errors.Errorf("MINVALUE (%d) is out of range for sequence data type %s", seq.Minimum, dataType.String())
+	}
+	if seq.Minimum >= seq.Maximum {
+		return seq, errors.Errorf("MINVALUE (%d) must be less than MAXVALUE (%d)", seq.Minimum, seq.Maximum)
+	}
+	if options.Start != nil {
+		seq.Start = *options.Start
+	}
+	if seq.Start < seq.Minimum {
+		return seq, errors.Errorf("START value (%d) cannot be less than MINVALUE (%d)", seq.Start, seq.Minimum)
+	}
+	if seq.Start > seq.Maximum {
+		return seq, errors.Errorf("START value (%d) cannot be greater than MAXVALUE (%d)", seq.Start, seq.Maximum)
+	}
+	if options.Restart {
+		seq.Current = seq.Start
+		if options.RestartWith != nil {
+			seq.Current = *options.RestartWith
+		}
+		seq.IsAtEnd = false
+		seq.HasBeenCalled = false
+	} else if seq.IsAtEnd {
+		// The current value has already been handed out, so the sequence continues from the following value if the
+		// new bounds allow it
+		if seq.Increment > 0 && seq.Current <= seq.Maximum-seq.Increment {
+			seq.Current += seq.Increment
+			seq.IsAtEnd = false
+		} else if seq.Increment < 0 && seq.Current >= seq.Minimum-seq.Increment {
+			seq.Current += seq.Increment
+			seq.IsAtEnd = false
+		}
+	}
+	if seq.Current < seq.Minimum {
+		return seq, errors.Errorf("RESTART value (%d) cannot be less than MINVALUE (%d)", seq.Current, seq.Minimum)
+	}
+	if seq.Current > seq.Maximum {
+		return seq, errors.Errorf("RESTART value (%d) cannot be greater than MAXVALUE (%d)", seq.Current, seq.Maximum)
+	}
+	if options.Cache != nil {
+		if *options.Cache <= 0 {
+			return seq, errors.Errorf("CACHE (%d) must be greater than zero", *options.Cache)
+		}
+		seq.Cache = *options.Cache
+	}
+	if options.Cycle != nil {
+		seq.Cycle = *options.Cycle
+	}
+	return seq, nil
+}
+
+// alterSequenceOptions applies the options to the sequence, which must have been loaded from the sequence collection
+// of the given database. The shared sequence state is also updated, so that every session observes the changes.
+func alterSequenceOptions(ctx *sql.Context, database string, seq *sequences.Sequence, options AlterSequenceOptions) error {
+	if !options.IsSet() {
+		return nil
+	}
+	newSeq, err := options.apply(*seq)
+	if err != nil {
+		return err
+	}
+	sess := dsess.DSessFromSess(ctx.Session)
+	db, err := sess.Provider().Database(ctx, database)
+	if err != nil {
+		return err
+	}
+	globalStateProvider, ok := db.(globalstate.GlobalStateProvider)
+	if !ok {
+		return errors.Errorf("database %s does not implement globalstate.GlobalStateProvider", db.Name())
+	}
+	sqleDb, ok := db.(sqle.Database)
+	if !ok {
+		return errors.Errorf(`expected a Dolt database but received "%T"`, db)
+	}
+	sequenceTracker, err := dsess.GetSequenceTracker(ctx, globalStateProvider.GetGlobalState(), sequences.SequenceTrackerKey)
+	if err != nil {
+		return err
+	}
+	ws, err := sqleDb.GetWorkingSet(ctx)
+	if err != nil {
+		return err
+	}
+	if _, err = sequenceTracker.Set(ctx, seq.Name(), seq, ws.Ref(), newSeq.SequenceState); err != nil {
+		return err
+	}
+	// The sequence is owned by the collection, so the changes are persisted at the end of the transaction
+	*seq = newSeq
+	sequences.DiscardCachedValues(ctx.Session.ID(), sqleDb.RevisionQualifiedName(), seq.Id)
+	return nil
+}
+
+// sequenceType returns the type of a sequence with the given type ID.
+func sequenceType(typeID id.Type) *pgtypes.DoltgresType {
+	switch typeID {
+	case pgtypes.Int16.ID:
+		return pgtypes.Int16
+	case pgtypes.Int32.ID:
+		return pgtypes.Int32
+	default:
+		return pgtypes.Int64
+	}
+}
+
+// sequenceTypeLimits returns the smallest and largest values of a sequence with the given type ID.
+func sequenceTypeLimits(typeID id.Type) (minimum int64, maximum int64) {
+	switch typeID {
+	case pgtypes.Int16.ID:
+		return math.MinInt16, math.MaxInt16
+	case pgtypes.Int32.ID:
+		return math.MinInt32, math.MaxInt32
+	default:
+		return math.MinInt64, math.MaxInt64
+	}
+}
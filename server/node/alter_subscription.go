@@ -0,0 +1,200 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/server/auth"
+	"github.com/dolthub/doltgresql/server/logrepl"
+	"github.com/dolthub/doltgresql/server/walsender"
+)
+
+// AlterSubscriptionAction is the modification made by an ALTER SUBSCRIPTION statement.
+type AlterSubscriptionAction uint8
+
+const (
+	AlterSubscriptionConnection AlterSubscriptionAction = iota
+	AlterSubscriptionSetPublication
+	AlterSubscriptionAddPublication
+	AlterSubscriptionDropPublication
+	AlterSubscriptionRefresh
+	AlterSubscriptionEnable
+	AlterSubscriptionSetOptions
+	AlterSubscriptionOwner
+	AlterSubscriptionRename
+)
+
+// AlterSubscription handles the ALTER SUBSCRIPTION statement.
+type AlterSubscription struct {
+	Name   string
+	Action AlterSubscriptionAction
+	// ConnInfo is the connection string for AlterSubscriptionConnection.
+	ConnInfo string
+	// Publications are the publications that are set, added, or dropped.
+	Publications []string
+	// Enabled is whether the subscription is enabled or disabled for AlterSubscriptionEnable.
+	Enabled bool
+	// Owner is the new owner for AlterSubscriptionOwner.
+	Owner string
+	// NewName is the new name for AlterSubscriptionRename.
+	NewName string
+	Options SubscriptionOptions
+}
+
+var _ sql.ExecSourceRel = (*AlterSubscription)(nil)
+var _ vitess.Injectable = (*AlterSubscription)(nil)
+
+// Children implements the interface sql.ExecSourceRel.
+func (c *AlterSubscription) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (c *AlterSubscription) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (c *AlterSubscription) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (c *AlterSubscription) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	sub, err := checkSubscriptionOwner(ctx, c.Name)
+	if err != nil {
+		return nil, err
+	}
+	refresh := optionOrDefault(c.Options.Refresh, true)
+	copyData := optionOrDefault(c.Options.CopyData, true)
+	if !refresh && optionOrDefault(c.Options.CopyData, false) {
+		return nil, errors.New("refresh = false and copy_data = true are mutually exclusive options")
+	}
+
+	switch c.Action {
+	case AlterSubscriptionConnection:
+		err = logrepl.SetSubscriptionConnection(c.Name, c.ConnInfo)
+	case AlterSubscriptionSetPublication:
+		if err = checkDuplicatePublications(c.Publications); err != nil {
+			return nil, err
+		}
+		err = logrepl.SetSubscriptionPublications(c.Name, c.Publications, refresh, copyData)
+	case AlterSubscriptionAddPublication:
+		if err = checkDuplicatePublications(c.Publications); err != nil {
+			return nil, err
+		}
+		publications := append([]string{}, sub.Publications...)
+		for _, publication := range c.Publications {
+			for _, existing := range sub.Publications {
+				if publication == existing {
+					return nil, errors.Errorf(`publication "%s" is already in subscription "%s"`, publication, c.Name)
+				}
+			}
+			publications = append(publications, publication)
+		}
+		err = logrepl.SetSubscriptionPublications(c.Name, publications, refresh, copyData)
+	case AlterSubscriptionDropPublication:
+		if err = checkDuplicatePublications(c.Publications); err != nil {
+			return nil, err
+		}
+		var publications []string
+		for _, existing := range sub.Publications {
+			dropped := false
+			for _, publication := range c.Publications {
+				dropped = dropped || publication == existing
+			}
+			if !dropped {
+				publications = append(publications, existing)
+			}
+		}
+		for _, publication := range c.Publications {
+			found := false
+			for _, existing := range sub.Publications {
+				found = found || publication == existing
+			}
+			if !found {
+				return nil, errors.Errorf(`publication "%s" is not in subscription "%s"`, publication, c.Name)
+			}
+		}
+		if len(publications) == 0 {
+			return nil, errors.New("cannot drop all the publications from a subscription")
+		}
+		err = logrepl.SetSubscriptionPublications(c.Name, publications, refresh, copyData)
+	case AlterSubscriptionRefresh:
+		err = logrepl.RefreshSubscription(c.Name, copyData)
+	case AlterSubscriptionEnable:
+		err = logrepl.SetSubscriptionEnabled(c.Name, c.Enabled)
+	case AlterSubscriptionSetOptions:
+		if c.Options.SlotName != nil {
+			if *c.Options.SlotName != "" {
+				if err = walsender.ValidateSlotName(*c.Options.SlotName); err != nil {
+					return nil, err
+				}
+			}
+			err = logrepl.SetSubscriptionSlotName(c.Name, *c.Options.SlotName)
+		}
+	case AlterSubscriptionOwner:
+		ownerName := c.Owner
+		switch ownerName {
+		case "current_role", "current_user", "session_user":
+			ownerName = ctx.Client().User
+		}
+		var newOwner auth.Role
+		auth.LockRead(func() {
+			newOwner = auth.GetRole(ownerName)
+		})
+		if !newOwner.IsValid() {
+			return nil, errors.Errorf(`role "%s" does not exist`, ownerName)
+		}
+		err = logrepl.SetSubscriptionOwner(c.Name, newOwner.Name)
+	case AlterSubscriptionRename:
+		err = logrepl.RenameSubscription(c.Name, c.NewName)
+	default:
+		return nil, errors.Errorf("unknown ALTER SUBSCRIPTION action: %d", c.Action)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (c *AlterSubscription) Schema(ctx *sql.Context) sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (c *AlterSubscription) String() string {
+	return "ALTER SUBSCRIPTION"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (c *AlterSubscription) WithChildren(ctx *sql.Context, children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(c, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (c *AlterSubscription) WithResolvedChildren(ctx context.Context, children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, ErrVitessChildCount.New(0, len(children))
+	}
+	return c, nil
+}
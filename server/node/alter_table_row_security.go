@@ -0,0 +1,121 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/core"
+)
+
+// AlterTableRowSecurityAction is a row-level security change made by ALTER TABLE.
+type AlterTableRowSecurityAction uint8
+
+const (
+	AlterTableRowSecurity_Enable AlterTableRowSecurityAction = iota
+	AlterTableRowSecurity_Disable
+	AlterTableRowSecurity_Force
+	AlterTableRowSecurity_NoForce
+)
+
+// AlterTableRowSecurity implements the ENABLE, DISABLE, FORCE, and NO FORCE ROW LEVEL SECURITY forms of ALTER TABLE.
+type AlterTableRowSecurity struct {
+	Schema   string
+	Table    string
+	IfExists bool
+	Actions  []AlterTableRowSecurityAction
+}
+
+var _ sql.ExecSourceRel = (*AlterTableRowSecurity)(nil)
+var _ vitess.Injectable = (*AlterTableRowSecurity)(nil)
+
+// Children implements the interface sql.ExecSourceRel.
+func (c *AlterTableRowSecurity) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (c *AlterTableRowSecurity) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (c *AlterTableRowSecurity) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (c *AlterTableRowSecurity) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	tableID, err := resolvePolicyTable(ctx, c.Schema, c.Table)
+	if err != nil {
+		return nil, err
+	}
+	if !tableID.IsValid() {
+		if c.IfExists {
+			return sql.RowsToRowIter(), nil
+		}
+		return nil, errors.Errorf(`relation "%s" does not exist`, c.Table)
+	}
+	collection, err := core.GetPoliciesCollectionFromContext(ctx, ctx.GetCurrentDatabase())
+	if err != nil {
+		return nil, err
+	}
+	security := collection.GetTableSecurity(ctx, tableID)
+	security.ID = tableID
+	for _, action := range c.Actions {
+		switch action {
+		case AlterTableRowSecurity_Enable:
+			security.Enabled = true
+		case AlterTableRowSecurity_Disable:
+			security.Enabled = false
+		case AlterTableRowSecurity_Force:
+			security.Forced = true
+		case AlterTableRowSecurity_NoForce:
+			security.Forced = false
+		}
+	}
+	if err = collection.SetTableSecurity(ctx, security); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (c *AlterTableRowSecurity) Schema(ctx *sql.Context) sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (c *AlterTableRowSecurity) String() string {
+	return "ALTER TABLE"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (c *AlterTableRowSecurity) WithChildren(ctx *sql.Context, children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(c, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (c *AlterTableRowSecurity) WithResolvedChildren(ctx context.Context, children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, ErrVitessChildCount.New(0, len(children))
+	}
+	return c, nil
+}
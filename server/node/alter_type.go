@@ -0,0 +1,605 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cockroachdb/errors"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+	"github.com/jackc/pgx/v5/pgproto3"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/id"
+	"github.com/dolthub/doltgresql/core/typecollection"
+	"github.com/dolthub/doltgresql/server/auth"
+	"github.com/dolthub/doltgresql/server/dependencies"
+	"github.com/dolthub/doltgresql/server/functions"
+	"github.com/dolthub/doltgresql/server/types"
+	"github.com/dolthub/doltgresql/utils"
+)
+
+// AlterType handles the ALTER TYPE statement. Only one of the commands is set.
+type AlterType struct {
+	Schema      string
+	Name        string
+	AddValue    *AlterTypeAddValue
+	RenameValue *AlterTypeRenameValue
+	NewName     string
+	NewSchema   string
+	Attributes  []AlterTypeAttribute
+	Cascade     bool
+}
+
+// AlterTypeAddValue adds a label to an enum type.
+type AlterTypeAddValue struct {
+	Value       string
+	IfNotExists bool
+	Neighbor    string
+	Before      bool
+}
+
+// AlterTypeRenameValue renames a label of an enum type.
+type AlterTypeRenameValue struct {
+	Value    string
+	NewValue string
+}
+
+// AlterTypeAttributeAction is the action taken on an attribute of a composite type.
+type AlterTypeAttributeAction uint8
+
+const (
+	AlterTypeAttributeAction_Add AlterTypeAttributeAction = iota
+	AlterTypeAttributeAction_Drop
+	AlterTypeAttributeAction_Alter
+	AlterTypeAttributeAction_Rename
+)
+
+// AlterTypeAttribute is a change to an attribute of a composite type.
+type AlterTypeAttribute struct {
+	Action    AlterTypeAttributeAction
+	Name      string
+	NewName   string
+	Type      *types.DoltgresType
+	Collation string
+	IfExists  bool
+}
+
+// typeColumn is a table column whose type uses a type that is being altered.
+type typeColumn struct {
+	table  id.Table
+	column string
+	typ    *types.DoltgresType
+}
+
+var _ sql.ExecSourceRel = (*AlterType)(nil)
+var _ vitess.Injectable = (*AlterType)(nil)
+
+// Children implements the interface sql.ExecSourceRel.
+func (c *AlterType) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (c *AlterType) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (c *AlterType) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (c *AlterType) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	var userRole auth.Role
+	auth.LockRead(func() {
+		userRole = auth.GetRole(ctx.Client().User)
+	})
+	if !userRole.IsValid() {
+		return nil, errors.Errorf(`role "%s" does not exist`, ctx.Client().User)
+	}
+
+	schema, err := core.GetSchemaName(ctx, nil, c.Schema)
+	if err != nil {
+		return nil, err
+	}
+	collection, err := core.GetTypesCollectionFromContext(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	typ, err := collection.GetType(ctx, id.NewType(schema, c.Name))
+	if err != nil {
+		return nil, err
+	}
+	if typ == nil {
+		return nil, types.ErrTypeDoesNotExist.New(c.Name)
+	}
+	if _, ok := types.IDToBuiltInDoltgresType[typ.ID]; ok {
+		return nil, errors.Errorf(`cannot alter type %s because it is required by the database system`, typ.Name())
+	}
+	if typ.IsArrayType() {
+		return nil, errors.Errorf(`cannot alter array type %s`, typ.String())
+	}
+	if typ.TypType == types.TypeType_Composite && typ.RelID.IsValid() {
+		return nil, errors.Errorf(`%s is a table's row type`, typ.Name())
+	}
+
+	switch {
+	case c.AddValue != nil:
+		err = c.addValue(ctx, collection, typ)
+	case c.RenameValue != nil:
+		err = c.renameValue(ctx, collection, typ)
+	case len(c.NewName) > 0 || len(c.NewSchema) > 0:
+		err = renameType(ctx, collection, typ, c.NewName, c.NewSchema)
+	case len(c.Attributes) > 0:
+		err = c.alterAttributes(ctx, collection, typ)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// addValue handles ALTER TYPE ... ADD VALUE.
+func (c *AlterType) addValue(ctx *sql.Context, collection *typecollection.TypeCollection, typ *types.DoltgresType) error {
+	if typ.TypType != types.TypeType_Enum {
+		return errors.Errorf(`%s is not an enum`, typ.Name())
+	}
+	if len(c.AddValue.Value) > 63 {
+		return errors.Errorf(`invalid enum label "%s"`, c.AddValue.Value)
+	}
+	if _, ok := typ.EnumLabels[c.AddValue.Value]; ok {
+		if c.AddValue.IfNotExists {
+			alterTypeNotice(ctx, fmt.Sprintf(`enum label "%s" already exists, skipping`, c.AddValue.Value))
+			return nil
+		}
+		return errors.Errorf(`enum label "%s" already exists`, c.AddValue.Value)
+	}
+	labels, err := types.PlaceEnumLabel(typ.ID, typ.EnumLabels, c.AddValue.Value, c.AddValue.Neighbor, c.AddValue.Before)
+	if err != nil {
+		return err
+	}
+	columns, err := typeColumns(ctx, typ.ID)
+	if err != nil {
+		return err
+	}
+	newType := typ.Copy()
+	newType.EnumLabels = labels
+	if err = collection.UpdateType(ctx, newType); err != nil {
+		return err
+	}
+	// Values are validated against the labels of their column's type, so only columns of the enum itself need to be
+	// updated. Other types that use the enum (such as arrays) load the enum from the collection.
+	for _, col := range columns {
+		if col.typ.ID == typ.ID {
+			if err = alterTypeColumn(ctx, col, typ.ID, false); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// renameValue handles ALTER TYPE ... RENAME VALUE.
+func (c *AlterType) renameValue(ctx *sql.Context, collection *typecollection.TypeCollection, typ *types.DoltgresType) error {
+	if typ.TypType != types.TypeType_Enum {
+		return errors.Errorf(`%s is not an enum`, typ.Name())
+	}
+	oldLabel, ok := typ.EnumLabels[c.RenameValue.Value]
+	if !ok {
+		return errors.Errorf(`"%s" is not an existing enum label`, c.RenameValue.Value)
+	}
+	if _, ok = typ.EnumLabels[c.RenameValue.NewValue]; ok {
+		return errors.Errorf(`enum label "%s" already exists`, c.RenameValue.NewValue)
+	}
+	columns, err := typeColumns(ctx, typ.ID)
+	if err != nil {
+		return err
+	}
+	// TODO: values that are nested within other types (such as arrays) store the old label, so we can only update
+	//  columns of the enum itself for now
+	for _, col := range columns {
+		if col.typ.ID != typ.ID {
+			return errors.Errorf(`cannot alter type "%s" because column "%s.%s" uses it`,
+				typ.Name(), col.table.TableName(), col.column)
+		}
+	}
+	// The renamed label keeps its sort order, which is how existing values find their new label
+	labels := make(map[string]types.EnumLabel, len(typ.EnumLabels))
+	for name, label := range typ.EnumLabels {
+		labels[name] = label
+	}
+	delete(labels, c.RenameValue.Value)
+	labels[c.RenameValue.NewValue] = types.EnumLabel{
+		ID:        id.NewEnumLabel(typ.ID, c.RenameValue.NewValue),
+		SortOrder: oldLabel.SortOrder,
+	}
+	newType := typ.Copy()
+	newType.EnumLabels = labels
+	if err = collection.UpdateType(ctx, newType); err != nil {
+		return err
+	}
+	for _, col := range columns {
+		if err = alterTypeColumn(ctx, col, typ.ID, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// renameType handles RENAME TO and SET SCHEMA for types and domains. An empty name or schema keeps the current one.
+// The type is recreated under its new name, after which every column and type that referenced the old name is updated
+// to the new name.
+func renameType(ctx *sql.Context, collection *typecollection.TypeCollection, typ *types.DoltgresType, name string, schema string) error {
+	newSchema := typ.ID.SchemaName()
+	newName := typ.ID.TypeName()
+	if len(schema) > 0 {
+		if err := checkSchemaExists(ctx, schema); err != nil {
+			return err
+		}
+		if schema == newSchema {
+			return errors.Errorf(`type %s is already in schema "%s"`, typ.Name(), newSchema)
+		}
+		newSchema = schema
+	}
+	if len(name) > 0 {
+		newName = name
+	}
+	newID := id.NewType(newSchema, newName)
+	if collection.HasType(ctx, newID) {
+		return types.ErrTypeAlreadyExists.New(newName)
+	}
+	renamed := map[id.Type]id.Type{typ.ID: newID}
+	var newArrayID id.Type
+	hasArray := typ.Array != nil && typ.Array.ID != id.NullType && collection.HasType(ctx, typ.Array.ID)
+	if hasArray {
+		newArrayID = id.NewType(newSchema, "_"+newName)
+		if collection.HasType(ctx, newArrayID) {
+			return types.ErrTypeAlreadyExists.New(newArrayID.TypeName())
+		}
+		renamed[typ.Array.ID] = newArrayID
+	}
+	// Functions are identified by their parameter types, so we can't rename types that they use
+	graph, err := dependencies.Load(ctx)
+	if err != nil {
+		return err
+	}
+	for _, dep := range graph.Dependencies {
+		if dep.Referenced.ID == typ.ID.AsId() &&
+			(dep.Dependent.Kind == dependencies.ObjectKind_Function || dep.Dependent.Kind == dependencies.ObjectKind_Procedure) {
+			return errors.Errorf(`cannot alter type %s because %s depends on it`, typ.Name(), graph.Describe(dep.Dependent))
+		}
+	}
+	columns, err := typeColumns(ctx, typ.ID)
+	if err != nil {
+		return err
+	}
+
+	newType := typ.Copy()
+	newType.ID = newID
+	if typ.TypType == types.TypeType_Enum {
+		newType.EnumLabels = make(map[string]types.EnumLabel, len(typ.EnumLabels))
+		for name, label := range typ.EnumLabels {
+			newType.EnumLabels[name] = types.EnumLabel{ID: id.NewEnumLabel(newID, name), SortOrder: label.SortOrder}
+		}
+	}
+	if hasArray {
+		newType.Array = &types.DoltgresType{ID: newArrayID, IsUnresolved: true}
+	}
+	if err = collection.CreateType(ctx, newType); err != nil {
+		return err
+	}
+	replacements := map[id.Type]*types.DoltgresType{newID: newType}
+	if hasArray {
+		arrayType := types.CreateArrayTypeFromBaseType(newType)
+		if err = collection.CreateType(ctx, arrayType); err != nil {
+			return err
+		}
+		newType.Array = arrayType
+		replacements[typ.Array.ID] = arrayType
+	}
+	replacements[typ.ID] = newType
+
+	// Other types reference the type by its ID, so they're updated to reference the new ID
+	var updatedTypes []*types.DoltgresType
+	err = collection.IterateTypes(ctx, func(t *types.DoltgresType) (stop bool, err error) {
+		if _, ok := types.IDToBuiltInDoltgresType[t.ID]; ok || t.ID == typ.ID || t.ID == newID {
+			return false, nil
+		}
+		if updated, ok := replaceTypeReferences(t, replacements); ok {
+			updatedTypes = append(updatedTypes, updated)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, updated := range updatedTypes {
+		if err = collection.UpdateType(ctx, updated); err != nil {
+			return err
+		}
+	}
+
+	// Columns are rewritten to use the new type, which converts their values between the old and new names
+	castsColl, err := core.GetCastsCollectionFromContext(ctx, "")
+	if err != nil {
+		return err
+	}
+	err = castsColl.WithRenamedTypes(renamed, func() error {
+		for _, col := range columns {
+			var err error
+			switch {
+			case col.typ.ID == typ.ID:
+				err = alterTypeColumn(ctx, col, newID, false)
+			case hasArray && col.typ.ID == typ.Array.ID:
+				err = alterTypeColumn(ctx, col, newID, true)
+			default:
+				// Types that are nested deeper are loaded by their ID, so only types that directly reference the
+				// renamed type need to be refreshed
+				if _, ok := replaceTypeReferences(col.typ, replacements); ok {
+					err = alterTypeColumn(ctx, col, col.typ.ID, false)
+				}
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// Nested statements may have replaced the collection, so we fetch it again before dropping the old type
+	collection, err = core.GetTypesCollectionFromContext(ctx, "")
+	if err != nil {
+		return err
+	}
+	if hasArray {
+		return collection.DropType(ctx, typ.Array.ID, typ.ID)
+	}
+	return collection.DropType(ctx, typ.ID)
+}
+
+// alterAttributes handles the attribute actions of ALTER TYPE for composite types.
+func (c *AlterType) alterAttributes(ctx *sql.Context, collection *typecollection.TypeCollection, typ *types.DoltgresType) error {
+	if typ.TypType != types.TypeType_Composite {
+		return errors.Errorf(`%s is not a composite type`, typ.Name())
+	}
+	attrs := make([]types.CompositeAttribute, len(typ.CompositeAttrs))
+	copy(attrs, typ.CompositeAttrs)
+	findAttr := func(name string) int {
+		for i, attr := range attrs {
+			if attr.Name == name {
+				return i
+			}
+		}
+		return -1
+	}
+	onlyRenames := true
+	for _, action := range c.Attributes {
+		idx := findAttr(action.Name)
+		switch action.Action {
+		case AlterTypeAttributeAction_Add:
+			onlyRenames = false
+			if idx != -1 {
+				return errors.Errorf(`column "%s" of relation "%s" already exists`, action.Name, typ.Name())
+			}
+			num := int16(1)
+			for _, attr := range attrs {
+				if attr.Num >= num {
+					num = attr.Num + 1
+				}
+			}
+			attrs = append(attrs, types.NewCompositeAttribute(ctx, typ.RelID, action.Name, action.Type, num, action.Collation))
+			continue
+		case AlterTypeAttributeAction_Drop:
+			onlyRenames = false
+			if idx == -1 {
+				if action.IfExists {
+					alterTypeNotice(ctx, fmt.Sprintf(`column "%s" of relation "%s" does not exist, skipping`, action.Name, typ.Name()))
+					continue
+				}
+				return errors.Errorf(`column "%s" of relation "%s" does not exist`, action.Name, typ.Name())
+			}
+			attrs = append(attrs[:idx], attrs[idx+1:]...)
+			continue
+		}
+		if idx == -1 {
+			return errors.Errorf(`column "%s" of relation "%s" does not exist`, action.Name, typ.Name())
+		}
+		switch action.Action {
+		case AlterTypeAttributeAction_Alter:
+			onlyRenames = false
+			attrs[idx].Type = action.Type
+			attrs[idx].Collation = action.Collation
+		case AlterTypeAttributeAction_Rename:
+			if findAttr(action.NewName) != -1 {
+				return errors.Errorf(`column "%s" of relation "%s" already exists`, action.NewName, typ.Name())
+			}
+			attrs[idx].Name = action.NewName
+		}
+	}
+
+	columns, err := typeColumns(ctx, typ.ID)
+	if err != nil {
+		return err
+	}
+	// Renaming an attribute does not change the stored values, so it does not require CASCADE
+	if !onlyRenames {
+		for _, col := range columns {
+			// TODO: values that are nested within other types (such as arrays) still need to be rewritten
+			if !c.Cascade || col.typ.ID != typ.ID {
+				return errors.Errorf(`cannot alter type "%s" because column "%s.%s" uses it`,
+					typ.Name(), col.table.TableName(), col.column)
+			}
+		}
+	}
+	newType := typ.Copy()
+	newType.CompositeAttrs = attrs
+	if err = collection.UpdateType(ctx, newType); err != nil {
+		return err
+	}
+	for _, col := range columns {
+		if col.typ.ID == typ.ID {
+			if err = alterTypeColumn(ctx, col, typ.ID, false); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (c *AlterType) Schema(ctx *sql.Context) sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (c *AlterType) String() string {
+	return "ALTER TYPE"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (c *AlterType) WithChildren(ctx *sql.Context, children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(c, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (c *AlterType) WithResolvedChildren(ctx context.Context, children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, ErrVitessChildCount.New(0, len(children))
+	}
+	return c, nil
+}
+
+// typeColumns returns every column in the current database whose type is, or contains, the given type.
+func typeColumns(ctx *sql.Context, typeID id.Type) ([]typeColumn, error) {
+	var columns []typeColumn
+	err := functions.IterateCurrentDatabase(ctx, functions.Callbacks{
+		Table: func(ctx *sql.Context, schema functions.ItemSchema, table functions.ItemTable) (cont bool, err error) {
+			if schema.IsSystemSchema() {
+				return true, nil
+			}
+			for _, col := range table.Item.Schema(ctx) {
+				if colType, ok := col.Type.(*types.DoltgresType); ok && typeUsesType(colType, typeID, make(map[id.Type]struct{})) {
+					columns = append(columns, typeColumn{table: table.OID, column: col.Name, typ: colType})
+				}
+			}
+			return true, nil
+		},
+	})
+	return columns, err
+}
+
+// typeUsesType returns whether the type is, or contains, the type with the given ID.
+func typeUsesType(typ *types.DoltgresType, typeID id.Type, visited map[id.Type]struct{}) bool {
+	if typ == nil || typ.ID == id.NullType {
+		return false
+	}
+	if typ.ID == typeID {
+		return true
+	}
+	if _, ok := visited[typ.ID]; ok {
+		return false
+	}
+	visited[typ.ID] = struct{}{}
+	if typ.IsArrayType() && typeUsesType(typ.Elem, typeID, visited) {
+		return true
+	}
+	if typ.TypType == types.TypeType_Domain && typeUsesType(typ.BaseTypeType, typeID, visited) {
+		return true
+	}
+	for _, attr := range typ.CompositeAttrs {
+		if typeUsesType(attr.Type, typeID, visited) {
+			return true
+		}
+	}
+	return false
+}
+
+// replaceTypeReferences returns a copy of the type where every direct reference to a type in the map, such as a
+// domain's base type or the type of a composite attribute, has been replaced. Returns false if the type does not
+// directly reference any of the types.
+func replaceTypeReferences(typ *types.DoltgresType, replacements map[id.Type]*types.DoltgresType) (*types.DoltgresType, bool) {
+	var newType *types.DoltgresType
+	if typ.TypType == types.TypeType_Domain && typ.BaseTypeType != nil {
+		if replacement, ok := replacements[typ.BaseTypeType.ID]; ok {
+			newType = typ.Copy()
+			newType.BaseTypeType = replacement
+		}
+	}
+	var attrs []types.CompositeAttribute
+	for i, attr := range typ.CompositeAttrs {
+		if replacement, ok := replacements[attr.Type.ID]; ok {
+			if attrs == nil {
+				// Copies share their attributes, so we create new ones
+				attrs = make([]types.CompositeAttribute, len(typ.CompositeAttrs))
+				copy(attrs, typ.CompositeAttrs)
+			}
+			attrs[i].Type = replacement
+		}
+	}
+	if attrs != nil {
+		if newType == nil {
+			newType = typ.Copy()
+		}
+		newType.CompositeAttrs = attrs
+	}
+	return newType, newType != nil
+}
+
+// alterTypeColumn runs ALTER TABLE on the column to change it to the given type, which also refreshes the column's
+// copy of the type when the type has been altered.
+func alterTypeColumn(ctx *sql.Context, col typeColumn, typeID id.Type, isArray bool) error {
+	typeName := utils.QuoteQualifiedIdentifier(typeID.SchemaName(), typeID.TypeName())
+	if isArray {
+		typeName += "[]"
+	}
+	_, err := runNestedStatement(ctx, fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s TYPE %s;",
+		utils.QuoteQualifiedIdentifier(col.table.SchemaName(), col.table.TableName()), utils.QuoteIdentifier(col.column), typeName))
+	return err
+}
+
+// checkSchemaExists returns an error if the schema does not exist in the current database.
+func checkSchemaExists(ctx *sql.Context, schemaName string) error {
+	_, root, err := core.GetRootFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	dbSchemas, err := root.GetDatabaseSchemas(ctx)
+	if err != nil {
+		return err
+	}
+	for _, dbSchema := range dbSchemas {
+		if dbSchema.Name == schemaName {
+			return nil
+		}
+	}
+	return errors.Errorf(`schema "%s" does not exist`, schemaName)
+}
+
+// alterTypeNotice sends the given message as a notice.
+func alterTypeNotice(ctx *sql.Context, message string) {
+	dsess.DSessFromSess(ctx.Session).Notice(&pgproto3.NoticeResponse{
+		Severity: "NOTICE",
+		Message:  message,
+	})
+}
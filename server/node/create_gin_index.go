@@ -0,0 +1,240 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+	"github.com/jackc/pgx/v5/pgproto3"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/id"
+	"github.com/dolthub/doltgresql/core/indexmethods"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+	"github.com/dolthub/doltgresql/utils"
+)
+
+// CreateGinIndex handles the CREATE INDEX statement for indexes that use the GIN access method.
+type CreateGinIndex struct {
+	Name        string
+	SchemaName  string
+	Table       string
+	Column      string
+	OpClass     string
+	IfNotExists bool
+}
+
+var _ sql.ExecSourceRel = (*CreateGinIndex)(nil)
+var _ vitess.Injectable = (*CreateGinIndex)(nil)
+
+// Children implements the interface sql.ExecSourceRel.
+func (c *CreateGinIndex) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (c *CreateGinIndex) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (c *CreateGinIndex) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (c *CreateGinIndex) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	table, err := core.GetSqlTableFromContext(ctx, "", doltdb.TableName{Name: c.Table, Schema: c.SchemaName})
+	if err != nil {
+		return nil, err
+	}
+	if table == nil {
+		return nil, errors.Errorf(`relation "%s" does not exist`, c.Table)
+	}
+	schemaTable, ok := table.(sql.DatabaseSchemaTable)
+	if !ok {
+		return nil, errors.Errorf(`relation "%s" does not support indexes`, c.Table)
+	}
+	schema := schemaTable.DatabaseSchema().SchemaName()
+	sch := table.Schema(ctx)
+	column := sch.IndexOfColName(c.Column)
+	if column == -1 {
+		return nil, errors.Errorf(`column "%s" does not exist`, c.Column)
+	}
+	typ, ok := sch[column].Type.(*pgtypes.DoltgresType)
+	if !ok {
+		return nil, errors.Errorf(`data type %s has no default operator class for access method "gin"`, sch[column].Type.String())
+	}
+	opClass := c.OpClass
+	if len(opClass) == 0 {
+		if opClass = ginDefaultOpClass(typ); len(opClass) == 0 {
+			return nil, errors.Errorf(`data type %s has no default operator class for access method "gin"`, typ.String())
+		}
+	} else if accepted, err := ginOpClassAcceptsType(opClass, typ); err != nil {
+		return nil, err
+	} else if !accepted {
+		return nil, errors.Errorf(`operator class "%s" does not accept data type %s`, opClass, typ.String())
+	}
+	// Posting lists reference rows by their primary key, so the table must have one. Materialized views are the
+	// exception, as they're only written by refreshes, which rebuild the posting lists from every row.
+	keyColumns, keyless := ginKeyColumns(sch)
+	if keyless {
+		_, mv, err := resolveMaterializedView(ctx, schema, c.Table)
+		if err != nil {
+			return nil, err
+		}
+		if !mv.ID.IsValid() {
+			return nil, errors.Errorf(`GIN indexes are not yet supported on tables without a primary key`)
+		}
+	}
+
+	nameValidator, ok := schemaTable.DatabaseSchema().(sql.SchemaObjectNameValidator)
+	if !ok {
+		return nil, errors.Errorf(`relation "%s" does not support indexes`, c.Table)
+	}
+	indexName := c.Name
+	if len(indexName) == 0 {
+		if indexName, err = generateIndexName(ctx, nameValidator, c.Table, c.Column); err != nil {
+			return nil, err
+		}
+	} else if exists, err := nameValidator.ValidateNewIndexName(ctx, indexName, c.IfNotExists); err != nil {
+		return nil, err
+	} else if exists {
+		dsess.DSessFromSess(ctx.Session).Notice(&pgproto3.NoticeResponse{
+			Severity: "NOTICE",
+			Message:  fmt.Sprintf(`relation "%s" already exists, skipping`, indexName),
+		})
+		return sql.RowsToRowIter(), nil
+	}
+
+	// The posting lists are stored in a table with a random name, so that it's never reused by a later index
+	randomBytes := make([]byte, 8)
+	if _, err = rand.Read(randomBytes); err != nil {
+		return nil, err
+	}
+	postingsName := core.GinPostingTablePrefix + hex.EncodeToString(randomBytes)
+	postingColumns := []string{`"entry" text NOT NULL`}
+	postingKeyColumns := []string{`"entry"`}
+	for i := range keyColumns {
+		postingColumns = append(postingColumns, fmt.Sprintf(`"key%d" text NOT NULL`, i+1))
+		postingKeyColumns = append(postingKeyColumns, fmt.Sprintf(`"key%d"`, i+1))
+	}
+	if _, err = runNestedStatement(ctx, fmt.Sprintf("CREATE TABLE %s (%s, PRIMARY KEY (%s));",
+		utils.QuoteQualifiedIdentifier(schema, postingsName), strings.Join(postingColumns, ", "), strings.Join(postingKeyColumns, ", "))); err != nil {
+		return nil, err
+	}
+
+	// The nested statement changed the root, so we reload the table before building the index
+	table, err = core.GetSqlTableFromContext(ctx, "", doltdb.TableName{Name: c.Table, Schema: schema})
+	if err != nil {
+		return nil, err
+	}
+	indexAlterable, ok := table.(sql.IndexAlterableTable)
+	if !ok {
+		return nil, errors.Errorf(`relation "%s" does not support indexes`, c.Table)
+	}
+	if err = indexAlterable.CreateIndex(ctx, sql.IndexDef{
+		Name:       indexName,
+		Columns:    []sql.IndexColumn{{Name: sch[column].Name}},
+		Constraint: sql.IndexConstraint_None,
+		Storage:    sql.IndexUsing_Default,
+	}); err != nil {
+		return nil, err
+	}
+	accessMethod := indexmethods.AccessMethod{
+		ID:       id.NewIndex(schema, table.Name(), indexName),
+		Name:     "gin",
+		Postings: postingsName,
+	}
+	if opClass != ginDefaultOpClass(typ) {
+		accessMethod.OpClass = opClass
+	}
+	accessMethods, err := core.GetIndexAccessMethodsCollectionFromContext(ctx, ctx.GetCurrentDatabase())
+	if err != nil {
+		return nil, err
+	}
+	if err = accessMethods.AddAccessMethod(ctx, accessMethod); err != nil {
+		return nil, err
+	}
+	index := ginIndex{
+		Name:       indexName,
+		Schema:     schema,
+		Postings:   postingsName,
+		OpClass:    opClass,
+		Column:     column,
+		KeyColumns: keyColumns,
+		Keyless:    keyless,
+	}
+	rows, err := readMaterializedViewRows(ctx, table)
+	if err != nil {
+		return nil, err
+	}
+	if err = index.rebuildPostings(ctx, sch, rows); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (c *CreateGinIndex) Schema(ctx *sql.Context) sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (c *CreateGinIndex) String() string {
+	return "CREATE INDEX USING GIN"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (c *CreateGinIndex) WithChildren(ctx *sql.Context, children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(c, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (c *CreateGinIndex) WithResolvedChildren(ctx context.Context, children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, ErrVitessChildCount.New(0, len(children))
+	}
+	return c, nil
+}
+
+// generateIndexName returns the name that Postgres gives to an unnamed index on the given column, which is the first
+// of "table_column_idx", "table_column_idx1", etc. that is not already taken by another relation.
+func generateIndexName(ctx *sql.Context, nameValidator sql.SchemaObjectNameValidator, table string, column string) (string, error) {
+	baseName := fmt.Sprintf("%s_%s_idx", table, column)
+	for i := 0; ; i++ {
+		name := baseName
+		if i > 0 {
+			name = fmt.Sprintf("%s%d", baseName, i)
+		}
+		exists, err := nameValidator.ValidateNewIndexName(ctx, name, true)
+		if err != nil {
+			return "", err
+		}
+		if !exists {
+			return name, nil
+		}
+	}
+}
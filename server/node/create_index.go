@@ -0,0 +1,166 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/id"
+	"github.com/dolthub/doltgresql/core/indexmethods"
+)
+
+// CreateIndex is a node that wraps the GMS index creation node, so that the access method of the index is recorded
+// alongside the index.
+type CreateIndex struct {
+	gmsAlterIndex *plan.AlterIndex
+	accessMethod  string
+}
+
+var _ sql.ExecBuilderNode = (*CreateIndex)(nil)
+
+// NewCreateIndex returns a new *CreateIndex. The access method is taken from the comment of the given node, which is
+// removed so that it's not stored with the index.
+func NewCreateIndex(alterIndex *plan.AlterIndex) *CreateIndex {
+	accessMethod, ok := core.ParseIndexAccessMethodComment(alterIndex.Comment)
+	if ok {
+		withoutComment := *alterIndex
+		withoutComment.Comment = ""
+		alterIndex = &withoutComment
+	}
+	return &CreateIndex{
+		gmsAlterIndex: alterIndex,
+		accessMethod:  accessMethod,
+	}
+}
+
+// Children implements the interface sql.ExecBuilderNode.
+func (c *CreateIndex) Children() []sql.Node {
+	return c.gmsAlterIndex.Children()
+}
+
+// IsReadOnly implements the interface sql.ExecBuilderNode.
+func (c *CreateIndex) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecBuilderNode.
+func (c *CreateIndex) Resolved() bool {
+	return c.gmsAlterIndex != nil && c.gmsAlterIndex.Resolved()
+}
+
+// BuildRowIter implements the interface sql.ExecBuilderNode.
+func (c *CreateIndex) BuildRowIter(ctx *sql.Context, b sql.NodeExecBuilder, r sql.Row) (sql.RowIter, error) {
+	rt, ok := c.gmsAlterIndex.Table.(*plan.ResolvedTable)
+	if !ok {
+		if len(c.accessMethod) > 0 {
+			return nil, errors.Errorf(`access method "%s" is not supported for this relation`, c.accessMethod)
+		}
+		return b.Build(ctx, c.gmsAlterIndex, r)
+	}
+	// The index may be given a generated name, so we find the new index by comparing the indexes before and after
+	existingIndexes := make(map[string]struct{})
+	indexes, err := getTableIndexes(ctx, rt.Table)
+	if err != nil {
+		return nil, err
+	}
+	for _, index := range indexes {
+		existingIndexes[index.ID()] = struct{}{}
+	}
+	alterIndexIter, err := b.Build(ctx, c.gmsAlterIndex, r)
+	if err != nil {
+		return nil, err
+	}
+
+	// The index creation changed the root, so we reload the table to find the new index
+	schemaName, err := core.GetSchemaName(ctx, rt.Database(), "")
+	if err != nil {
+		return nil, err
+	}
+	table, err := core.GetSqlTableFromContext(ctx, rt.Database().Name(), doltdb.TableName{Name: rt.Name(), Schema: schemaName})
+	if err != nil || table == nil {
+		return alterIndexIter, err
+	}
+	indexes, err = getTableIndexes(ctx, table)
+	if err != nil {
+		return nil, err
+	}
+	collection, err := core.GetIndexAccessMethodsCollectionFromContext(ctx, rt.Database().Name())
+	if err != nil {
+		return nil, err
+	}
+	for _, index := range indexes {
+		if _, ok = existingIndexes[index.ID()]; ok {
+			continue
+		}
+		indexID := id.NewIndex(schemaName, table.Name(), index.ID())
+		// An index that was removed without DROP INDEX (such as by dropping its column) leaves its access method behind
+		if collection.HasAccessMethod(ctx, indexID) {
+			if err = collection.DropAccessMethod(ctx, indexID); err != nil {
+				return nil, err
+			}
+		}
+		if len(c.accessMethod) > 0 {
+			if err = collection.AddAccessMethod(ctx, indexmethods.AccessMethod{
+				ID:   indexID,
+				Name: c.accessMethod,
+			}); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return alterIndexIter, nil
+}
+
+// Schema implements the interface sql.ExecBuilderNode.
+func (c *CreateIndex) Schema(ctx *sql.Context) sql.Schema {
+	return c.gmsAlterIndex.Schema(ctx)
+}
+
+// String implements the interface sql.ExecBuilderNode.
+func (c *CreateIndex) String() string {
+	return c.gmsAlterIndex.String()
+}
+
+// WithChildren implements the interface sql.ExecBuilderNode.
+func (c *CreateIndex) WithChildren(ctx *sql.Context, children ...sql.Node) (sql.Node, error) {
+	gmsAlterIndex, err := c.gmsAlterIndex.WithChildren(ctx, children...)
+	if err != nil {
+		return nil, err
+	}
+	return &CreateIndex{
+		gmsAlterIndex: gmsAlterIndex.(*plan.AlterIndex),
+		accessMethod:  c.accessMethod,
+	}, nil
+}
+
+// getTableIndexes returns all indexes on the given table.
+func getTableIndexes(ctx *sql.Context, table sql.Table) ([]sql.Index, error) {
+	for {
+		wrapper, ok := table.(sql.TableWrapper)
+		if !ok {
+			break
+		}
+		table = wrapper.Underlying()
+	}
+	indexAddressable, ok := table.(sql.IndexAddressable)
+	if !ok {
+		return nil, nil
+	}
+	return indexAddressable.GetIndexes(ctx)
+}
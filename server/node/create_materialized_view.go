@@ -0,0 +1,157 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+	"github.com/jackc/pgx/v5/pgproto3"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/id"
+	"github.com/dolthub/doltgresql/core/matviews"
+	"github.com/dolthub/doltgresql/utils"
+)
+
+// CreateMaterializedView implements CREATE MATERIALIZED VIEW. The rows of a materialized view are stored in a table of
+// the same name, while its definition is stored so that the table may be refreshed.
+type CreateMaterializedView struct {
+	Schema      string
+	Name        string
+	ColumnNames []string
+	Definition  string
+	IfNotExists bool
+	WithNoData  bool
+}
+
+var _ sql.ExecSourceRel = (*CreateMaterializedView)(nil)
+var _ vitess.Injectable = (*CreateMaterializedView)(nil)
+
+// Children implements the interface sql.ExecSourceRel.
+func (c *CreateMaterializedView) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (c *CreateMaterializedView) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (c *CreateMaterializedView) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (c *CreateMaterializedView) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	schema, err := core.GetSchemaName(ctx, nil, c.Schema)
+	if err != nil {
+		return nil, err
+	}
+	relationType, err := core.GetRelationType(ctx, schema, c.Name)
+	if err != nil {
+		return nil, err
+	}
+	if relationType != core.RelationType_DoesNotExist {
+		if c.IfNotExists {
+			dsess.DSessFromSess(ctx.Session).Notice(&pgproto3.NoticeResponse{
+				Severity: "NOTICE",
+				Message:  fmt.Sprintf(`relation "%s" already exists, skipping`, c.Name),
+			})
+			return sql.RowsToRowIter(), nil
+		}
+		return nil, errors.Errorf(`relation "%s" already exists`, c.Name)
+	}
+	// The table is created from the definition, so that the columns take the names and types of the query's results
+	var createTable strings.Builder
+	createTable.WriteString("CREATE TABLE ")
+	createTable.WriteString(utils.QuoteQualifiedIdentifier(schema, c.Name))
+	createTable.WriteString(" AS SELECT * FROM (")
+	createTable.WriteString(c.Definition)
+	createTable.WriteString(") AS matview_source")
+	if len(c.ColumnNames) > 0 {
+		quotedNames := make([]string, len(c.ColumnNames))
+		for i, columnName := range c.ColumnNames {
+			quotedNames[i] = utils.QuoteIdentifier(columnName)
+		}
+		createTable.WriteString("(")
+		createTable.WriteString(strings.Join(quotedNames, ", "))
+		createTable.WriteString(")")
+	}
+	if c.WithNoData {
+		createTable.WriteString(" LIMIT 0")
+	}
+	if _, err = runNestedStatement(ctx, createTable.String()); err != nil {
+		return nil, err
+	}
+	collection, err := core.GetMaterializedViewsCollectionFromContext(ctx, ctx.GetCurrentDatabase())
+	if err != nil {
+		return nil, err
+	}
+	if err = collection.AddMaterializedView(ctx, matviews.MaterializedView{
+		ID:         id.NewView(schema, c.Name),
+		Definition: c.Definition,
+		Populated:  !c.WithNoData,
+	}); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (c *CreateMaterializedView) Schema(ctx *sql.Context) sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (c *CreateMaterializedView) String() string {
+	return "CREATE MATERIALIZED VIEW"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (c *CreateMaterializedView) WithChildren(ctx *sql.Context, children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(c, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (c *CreateMaterializedView) WithResolvedChildren(ctx context.Context, children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, ErrVitessChildCount.New(0, len(children))
+	}
+	return c, nil
+}
+
+// runNestedStatement runs the given statement within the current statement, returning all of its rows.
+func runNestedStatement(ctx *sql.Context, statement string) ([]sql.Row, error) {
+	runner, err := core.GetRunnerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// We run the statement as though it's interpreted since we're running new statements inside the original
+	return sql.RunInterpreted(ctx, func(subCtx *sql.Context) ([]sql.Row, error) {
+		_, rowIter, _, err := runner.QueryWithBindings(subCtx, statement, nil, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		return sql.RowIterToRows(subCtx, rowIter)
+	})
+}
@@ -0,0 +1,171 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/id"
+	"github.com/dolthub/doltgresql/core/policies"
+	"github.com/dolthub/doltgresql/server/auth"
+	"github.com/dolthub/doltgresql/utils"
+)
+
+// CreatePolicy implements CREATE POLICY.
+type CreatePolicy struct {
+	Name        string
+	Schema      string
+	Table       string
+	Restrictive bool
+	Command     policies.PolicyCommand
+	Roles       []string
+	Using       string
+	WithCheck   string
+}
+
+var _ sql.ExecSourceRel = (*CreatePolicy)(nil)
+var _ vitess.Injectable = (*CreatePolicy)(nil)
+
+// Children implements the interface sql.ExecSourceRel.
+func (c *CreatePolicy) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (c *CreatePolicy) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (c *CreatePolicy) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (c *CreatePolicy) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	tableID, err := resolvePolicyTable(ctx, c.Schema, c.Table)
+	if err != nil {
+		return nil, err
+	}
+	if !tableID.IsValid() {
+		return nil, errors.Errorf(`relation "%s" does not exist`, c.Table)
+	}
+	roles, err := resolvePolicyRoles(ctx, c.Roles)
+	if err != nil {
+		return nil, err
+	}
+	if err = validatePolicyExpression(ctx, tableID, c.Using); err != nil {
+		return nil, err
+	}
+	if err = validatePolicyExpression(ctx, tableID, c.WithCheck); err != nil {
+		return nil, err
+	}
+	collection, err := core.GetPoliciesCollectionFromContext(ctx, ctx.GetCurrentDatabase())
+	if err != nil {
+		return nil, err
+	}
+	if err = collection.AddPolicy(ctx, policies.Policy{
+		ID:          id.NewPolicy(tableID.SchemaName(), tableID.TableName(), c.Name),
+		Restrictive: c.Restrictive,
+		Command:     c.Command,
+		Roles:       roles,
+		Using:       c.Using,
+		WithCheck:   c.WithCheck,
+	}); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (c *CreatePolicy) Schema(ctx *sql.Context) sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (c *CreatePolicy) String() string {
+	return "CREATE POLICY"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (c *CreatePolicy) WithChildren(ctx *sql.Context, children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(c, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (c *CreatePolicy) WithResolvedChildren(ctx context.Context, children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, ErrVitessChildCount.New(0, len(children))
+	}
+	return c, nil
+}
+
+// resolvePolicyTable returns the ID of the table that a policy is placed on. Returns an invalid ID if the table does
+// not exist.
+func resolvePolicyTable(ctx *sql.Context, schema string, name string) (id.Table, error) {
+	table, err := core.GetSqlTableFromContext(ctx, "", doltdb.TableName{Name: name, Schema: schema})
+	if err != nil || table == nil {
+		return id.NullTable, err
+	}
+	doltTable := core.SQLTableToDoltTable(table)
+	if doltTable == nil {
+		return id.NullTable, errors.Errorf(`"%s" is not a table`, name)
+	}
+	tableName := doltTable.TableName()
+	return id.NewTable(tableName.Schema, tableName.Name), nil
+}
+
+// resolvePolicyRoles returns the names of the given roles, ensuring that each one exists. PUBLIC is represented by an
+// empty slice, as it applies to every role.
+func resolvePolicyRoles(ctx *sql.Context, roleNames []string) ([]string, error) {
+	roles := make([]string, 0, len(roleNames))
+	for _, roleName := range roleNames {
+		switch roleName {
+		case "public":
+			return nil, nil
+		case "current_role", "current_user", "session_user":
+			roleName = ctx.Client().User
+		}
+		var exists bool
+		auth.LockRead(func() {
+			exists = auth.RoleExists(roleName)
+		})
+		if !exists {
+			return nil, errors.Errorf(`role "%s" does not exist`, roleName)
+		}
+		roles = append(roles, roleName)
+	}
+	return roles, nil
+}
+
+// validatePolicyExpression ensures that the expression is valid for the table by planning a query that uses it. Empty
+// expressions are considered valid, as they represent an absent clause.
+func validatePolicyExpression(ctx *sql.Context, tableID id.Table, expression string) error {
+	if len(strings.TrimSpace(expression)) == 0 {
+		return nil
+	}
+	_, err := runNestedStatement(ctx, fmt.Sprintf("SELECT (%s) FROM %s LIMIT 0;",
+		expression, utils.QuoteQualifiedIdentifier(tableID.SchemaName(), tableID.TableName())))
+	return err
+}
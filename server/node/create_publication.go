@@ -0,0 +1,215 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/server/auth"
+	"github.com/dolthub/doltgresql/server/walsender"
+)
+
+// PublicationActions are the operations that are published, which are set by the publish option.
+type PublicationActions struct {
+	Insert   bool
+	Update   bool
+	Delete   bool
+	Truncate bool
+}
+
+// PublicationObjects are the tables and schemas that are given to a publication statement. Tables may omit their
+// schema, in which case they're resolved using the search path.
+type PublicationObjects struct {
+	Tables  []walsender.PublishedTable
+	Schemas []string
+}
+
+// CreatePublication handles the CREATE PUBLICATION statement.
+type CreatePublication struct {
+	Name      string
+	AllTables bool
+	Objects   PublicationObjects
+	// Actions are the published operations, which are nil when the publish option was not given.
+	Actions *PublicationActions
+}
+
+var _ sql.ExecSourceRel = (*CreatePublication)(nil)
+var _ vitess.Injectable = (*CreatePublication)(nil)
+
+// Children implements the interface sql.ExecSourceRel.
+func (c *CreatePublication) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (c *CreatePublication) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (c *CreatePublication) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (c *CreatePublication) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	var userRole auth.Role
+	auth.LockRead(func() {
+		userRole = auth.GetRole(ctx.Client().User)
+	})
+	if c.AllTables && !userRole.IsSuperUser {
+		return nil, errors.New("must be superuser to create FOR ALL TABLES publication")
+	}
+	if len(c.Objects.Schemas) > 0 && !userRole.IsSuperUser {
+		return nil, errors.New("must be superuser to create FOR TABLES IN SCHEMA publication")
+	}
+	objects, err := resolvePublicationObjects(ctx, c.Objects)
+	if err != nil {
+		return nil, err
+	}
+	actions := PublicationActions{Insert: true, Update: true, Delete: true, Truncate: true}
+	if c.Actions != nil {
+		actions = *c.Actions
+	}
+	err = walsender.CreatePublication(walsender.Publication{
+		Name:      c.Name,
+		Owner:     userRole.Name,
+		Database:  ctx.GetCurrentDatabase(),
+		AllTables: c.AllTables,
+		Tables:    objects.Tables,
+		Schemas:   objects.Schemas,
+		Insert:    actions.Insert,
+		Update:    actions.Update,
+		Delete:    actions.Delete,
+		Truncate:  actions.Truncate,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (c *CreatePublication) Schema(ctx *sql.Context) sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (c *CreatePublication) String() string {
+	return "CREATE PUBLICATION"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (c *CreatePublication) WithChildren(ctx *sql.Context, children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(c, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (c *CreatePublication) WithResolvedChildren(ctx context.Context, children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, ErrVitessChildCount.New(0, len(children))
+	}
+	return c, nil
+}
+
+// resolvePublicationObjects fills in the schema of every table, and verifies that every table and schema exists.
+// Duplicate tables and schemas are removed.
+func resolvePublicationObjects(ctx *sql.Context, objects PublicationObjects) (PublicationObjects, error) {
+	var resolved PublicationObjects
+	for _, table := range objects.Tables {
+		sqlTable, err := core.GetSqlTableFromContext(ctx, "", doltdb.TableName{Name: table.Name, Schema: table.Schema})
+		if err != nil {
+			return PublicationObjects{}, err
+		}
+		if sqlTable == nil {
+			return PublicationObjects{}, errors.Errorf(`relation "%s" does not exist`, table.Name)
+		}
+		schTable, ok := sqlTable.(sql.DatabaseSchemaTable)
+		if !ok {
+			return PublicationObjects{}, errors.Errorf(`"%s" is not a table`, table.Name)
+		}
+		table.Schema = schTable.DatabaseSchema().SchemaName()
+		if !containsPublishedTable(resolved.Tables, table) {
+			resolved.Tables = append(resolved.Tables, table)
+		}
+	}
+	if len(objects.Schemas) > 0 {
+		_, root, err := core.GetRootFromContext(ctx)
+		if err != nil {
+			return PublicationObjects{}, err
+		}
+		dbSchemas, err := root.GetDatabaseSchemas(ctx)
+		if err != nil {
+			return PublicationObjects{}, err
+		}
+		for _, schemaName := range objects.Schemas {
+			found := false
+			for _, dbSchema := range dbSchemas {
+				found = found || dbSchema.Name == schemaName
+			}
+			if !found {
+				return PublicationObjects{}, errors.Errorf(`schema "%s" does not exist`, schemaName)
+			}
+			if !containsString(resolved.Schemas, schemaName) {
+				resolved.Schemas = append(resolved.Schemas, schemaName)
+			}
+		}
+	}
+	return resolved, nil
+}
+
+// checkPublicationOwner returns the publication with the given name, or an error if it does not exist or the user is
+// neither its owner nor a superuser.
+func checkPublicationOwner(ctx *sql.Context, name string) (walsender.Publication, error) {
+	pub, ok := walsender.GetPublication(ctx.GetCurrentDatabase(), name)
+	if !ok {
+		return walsender.Publication{}, errors.Errorf(`publication "%s" does not exist`, name)
+	}
+	var userRole auth.Role
+	auth.LockRead(func() {
+		userRole = auth.GetRole(ctx.Client().User)
+	})
+	if !userRole.IsSuperUser && userRole.Name != pub.Owner {
+		return walsender.Publication{}, errors.Errorf("must be owner of publication %s", name)
+	}
+	return pub, nil
+}
+
+// containsPublishedTable returns whether the table is in the given slice.
+func containsPublishedTable(tables []walsender.PublishedTable, table walsender.PublishedTable) bool {
+	for _, existing := range tables {
+		if existing == table {
+			return true
+		}
+	}
+	return false
+}
+
+// containsString returns whether the string is in the given slice.
+func containsString(values []string, value string) bool {
+	for _, existing := range values {
+		if existing == value {
+			return true
+		}
+	}
+	return false
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/plan"
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+	"github.com/jackc/pgx/v5/pgproto3"
 
 	"github.com/dolthub/doltgresql/core"
 	"github.com/dolthub/doltgresql/core/id"
@@ -35,11 +36,13 @@ import (
 	pgexprs "github.com/dolthub/doltgresql/server/expression"
 	"github.com/dolthub/doltgresql/server/functions/framework"
 	"github.com/dolthub/doltgresql/server/tables"
+	"github.com/dolthub/doltgresql/server/temporary"
 	pgtypes "github.com/dolthub/doltgresql/server/types"
 )
 
 // CreateSequence handles the CREATE SEQUENCE statement, along with SERIAL type definitions.
 type CreateSequence struct {
+	database    string
 	schema      string
 	ifNotExists bool
 	fromAlter   bool
@@ -49,9 +52,11 @@ type CreateSequence struct {
 var _ sql.ExecSourceRel = (*CreateSequence)(nil)
 var _ vitess.Injectable = (*CreateSequence)(nil)
 
-// NewCreateSequence returns a new *CreateSequence.
-func NewCreateSequence(ifNotExists bool, schema string, fromAlter bool, sequence *sequences.Sequence) *CreateSequence {
+// NewCreateSequence returns a new *CreateSequence. The sequence is created in the current database if the database is
+// empty.
+func NewCreateSequence(ifNotExists bool, database string, schema string, fromAlter bool, sequence *sequences.Sequence) *CreateSequence {
 	return &CreateSequence{
+		database:    database,
 		schema:      schema,
 		ifNotExists: ifNotExists,
 		fromAlter:   fromAlter,
@@ -79,6 +84,13 @@ func (c *CreateSequence) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, erro
 	if strings.HasPrefix(strings.ToLower(c.sequence.Id.SequenceName()), "dolt") {
 		return nil, errors.Errorf("sequences cannot be prefixed with 'dolt'")
 	}
+	if c.sequence.Persistence == sequences.Persistence_Temporary {
+		return c.createTemporarySequence(ctx)
+	}
+	database := c.database
+	if len(database) == 0 {
+		database = ctx.GetCurrentDatabase()
+	}
 	schema, err := core.GetSchemaName(ctx, nil, c.schema)
 	if err != nil {
 		return nil, err
@@ -90,27 +102,28 @@ func (c *CreateSequence) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, erro
 	// GetSqlDatabaseFromContext returns a plain sqle.Database (not a PgDatabase), so we wrap it
 	// with tables.WrapSqleDatabase before calling GetSchema so that ValidateNewRelationName is available.
 	// TODO: Consider always wrapping the returned db from GetSqlDatabaseFromContext()
-	rawDb, err := core.GetSqlDatabaseFromContext(ctx, "")
+	rawDb, err := core.GetSqlDatabaseFromContext(ctx, database)
 	if err != nil {
 		return nil, err
 	}
-	if rawDb != nil {
-		if sdb, ok := rawDb.(sqle.Database); ok {
-			pgDb := tables.WrapSqleDatabase(sdb)
-			if schemaDb, found, dbErr := pgDb.GetSchema(ctx, schema); dbErr != nil {
-				return nil, dbErr
-			} else if found {
-				if v, ok := schemaDb.(sql.SchemaObjectNameValidator); ok {
-					nameAlreadyUsed, err := v.ValidateNewSequenceName(ctx, c.sequence.Id.SequenceName(), c.ifNotExists)
-					if err != nil {
-						return nil, err
-					} else if nameAlreadyUsed && c.ifNotExists {
-						return sql.RowsToRowIter(), nil
-					}
+	if rawDb == nil {
+		return nil, sql.ErrDatabaseNotFound.New(database)
+	}
+	if sdb, ok := rawDb.(sqle.Database); ok {
+		pgDb := tables.WrapSqleDatabase(sdb)
+		if schemaDb, found, dbErr := pgDb.GetSchema(ctx, schema); dbErr != nil {
+			return nil, dbErr
+		} else if found {
+			if v, ok := schemaDb.(sql.SchemaObjectNameValidator); ok {
+				nameAlreadyUsed, err := v.ValidateNewSequenceName(ctx, c.sequence.Id.SequenceName(), c.ifNotExists)
+				if err != nil {
+					return nil, err
+				} else if nameAlreadyUsed && c.ifNotExists {
+					return sql.RowsToRowIter(), nil
 				}
-			} else if !found {
-				return nil, fmt.Errorf(`schema "%s" not found`, schema)
 			}
+		} else if !found {
+			return nil, fmt.Errorf(`schema "%s" not found`, schema)
 		}
 	}
 	// Check that the OWNED BY is valid, if it exists
@@ -120,7 +133,7 @@ func (c *CreateSequence) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, erro
 	if c.sequence.OwnerTable.IsValid() {
 		// The table will only have its name set, so we need to fill in the schema as well
 		c.sequence.OwnerTable = id.NewTable(schema, c.sequence.OwnerTable.TableName())
-		relationType, err := core.GetRelationType(ctx, schema, c.sequence.OwnerTable.TableName())
+		relationType, err := core.GetRelationTypeInDatabase(ctx, database, schema, c.sequence.OwnerTable.TableName())
 		if err != nil {
 			return nil, err
 		}
@@ -130,7 +143,7 @@ func (c *CreateSequence) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, erro
 			return nil, errors.Errorf(`sequence cannot be owned by relation "%s"`, c.sequence.OwnerTable.TableName())
 		}
 
-		table, err = core.GetSqlTableFromContext(ctx, "", doltdb.TableName{Name: c.sequence.OwnerTable.TableName(), Schema: schema})
+		table, err = core.GetSqlTableFromContext(ctx, database, doltdb.TableName{Name: c.sequence.OwnerTable.TableName(), Schema: schema})
 		if err != nil {
 			return nil, err
 		}
@@ -183,9 +196,7 @@ func (c *CreateSequence) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, erro
 		}
 	}
 	// Create the sequence since we know it's completely valid
-	// TODO: we always create the sequence in the current database, but there's no need to require this, and in fact we
-	//  need to support it to create a sequence on another branch than the current one
-	collection, err := core.GetSequencesCollectionFromContext(ctx, ctx.GetCurrentDatabase())
+	collection, err := core.GetSequencesCollectionFromContext(ctx, database)
 	if err != nil {
 		return nil, err
 	}
@@ -193,7 +204,7 @@ func (c *CreateSequence) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, erro
 		return nil, err
 	}
 	sess := dsess.DSessFromSess(ctx.Session)
-	db, err := sess.Provider().Database(ctx, sess.GetCurrentDatabase())
+	db, err := sess.Provider().Database(ctx, database)
 	if err != nil {
 		return nil, err
 	}
@@ -244,6 +255,46 @@ func (c *CreateSequence) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, erro
 	return sql.RowsToRowIter(), nil
 }
 
+// createTemporarySequence creates the sequence within the session's temporary schema. Temporary sequences are held by
+// the session, so they're never written to the working set, and may only be owned by temporary tables.
+func (c *CreateSequence) createTemporarySequence(ctx *sql.Context) (sql.RowIter, error) {
+	connectionID := ctx.Session.ID()
+	database := ctx.GetCurrentDatabase()
+	schema := temporary.SchemaName(connectionID)
+	sequenceName := c.sequence.Id.SequenceName()
+	session := dsess.DSessFromSess(ctx.Session)
+	_, isTable := session.GetTemporaryTable(ctx, database, sequenceName)
+	_, isView := temporary.GetView(connectionID, database, sequenceName)
+	_, isSequence := temporary.GetSequence(connectionID, database, sequenceName)
+	if isTable || isView || isSequence {
+		if c.ifNotExists {
+			session.Notice(&pgproto3.NoticeResponse{
+				Severity: "NOTICE",
+				Message:  fmt.Sprintf(`relation "%s" already exists, skipping`, sequenceName),
+			})
+			return sql.RowsToRowIter(), nil
+		}
+		return nil, errors.Errorf(`relation "%s" already exists`, sequenceName)
+	}
+	if c.fromAlter {
+		return nil, errors.Errorf("identity columns are not yet supported on temporary tables")
+	}
+	if c.sequence.OwnerTable.IsValid() {
+		table, ok := session.GetTemporaryTable(ctx, database, c.sequence.OwnerTable.TableName())
+		if !ok {
+			return nil, errors.Errorf(`relation "%s" does not exist`, c.sequence.OwnerTable.TableName())
+		}
+		if table.Schema(ctx).IndexOfColName(c.sequence.OwnerColumn) < 0 {
+			return nil, errors.Errorf(`column "%s" of relation "%s" does not exist`,
+				c.sequence.OwnerColumn, c.sequence.OwnerTable.TableName())
+		}
+		c.sequence.OwnerTable = id.NewTable(schema, c.sequence.OwnerTable.TableName())
+	}
+	c.sequence.Id = id.NewSequence(schema, sequenceName)
+	temporary.AddSequence(connectionID, database, c.sequence)
+	return sql.RowsToRowIter(), nil
+}
+
 // Schema implements the interface sql.ExecSourceRel.
 func (c *CreateSequence) Schema(ctx *sql.Context) sql.Schema {
 	return nil
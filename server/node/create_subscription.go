@@ -0,0 +1,187 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/server/auth"
+	"github.com/dolthub/doltgresql/server/logrepl"
+	"github.com/dolthub/doltgresql/server/walsender"
+)
+
+// SubscriptionOptions are the options given in the WITH clause of the subscription statements. Options that were not
+// given are nil.
+type SubscriptionOptions struct {
+	Connect    *bool
+	Enabled    *bool
+	CreateSlot *bool
+	CopyData   *bool
+	Refresh    *bool
+	// SlotName is the name of the replication slot, which is empty for slot_name = NONE.
+	SlotName *string
+}
+
+// CreateSubscription handles the CREATE SUBSCRIPTION statement.
+type CreateSubscription struct {
+	Name         string
+	ConnInfo     string
+	Publications []string
+	Options      SubscriptionOptions
+}
+
+var _ sql.ExecSourceRel = (*CreateSubscription)(nil)
+var _ vitess.Injectable = (*CreateSubscription)(nil)
+
+// Children implements the interface sql.ExecSourceRel.
+func (c *CreateSubscription) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (c *CreateSubscription) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (c *CreateSubscription) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (c *CreateSubscription) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	var userRole auth.Role
+	auth.LockRead(func() {
+		userRole = auth.GetRole(ctx.Client().User)
+	})
+	if !userRole.IsSuperUser {
+		return nil, errors.New("permission denied to create subscription")
+	}
+	if err := checkDuplicatePublications(c.Publications); err != nil {
+		return nil, err
+	}
+
+	opts := c.Options
+	connect := optionOrDefault(opts.Connect, true)
+	enabled := optionOrDefault(opts.Enabled, true)
+	createSlot := optionOrDefault(opts.CreateSlot, true)
+	copyData := optionOrDefault(opts.CopyData, true)
+	if !connect {
+		if optionOrDefault(opts.Enabled, false) {
+			return nil, errors.New("connect = false and enabled = true are mutually exclusive options")
+		} else if optionOrDefault(opts.CreateSlot, false) {
+			return nil, errors.New("connect = false and create_slot = true are mutually exclusive options")
+		} else if optionOrDefault(opts.CopyData, false) {
+			return nil, errors.New("connect = false and copy_data = true are mutually exclusive options")
+		}
+		enabled, createSlot, copyData = false, false, false
+	}
+	slotName := c.Name
+	if opts.SlotName != nil {
+		slotName = *opts.SlotName
+	}
+	if slotName == "" {
+		if optionOrDefault(opts.Enabled, false) {
+			return nil, errors.New("slot_name = NONE and enabled = true are mutually exclusive options")
+		} else if optionOrDefault(opts.CreateSlot, false) {
+			return nil, errors.New("slot_name = NONE and create_slot = true are mutually exclusive options")
+		} else if enabled {
+			return nil, errors.New("subscription with slot_name = NONE must also set enabled = false")
+		} else if createSlot {
+			return nil, errors.New("subscription with slot_name = NONE must also set create_slot = false")
+		}
+	} else if err := walsender.ValidateSlotName(slotName); err != nil {
+		return nil, err
+	}
+
+	err := logrepl.CreateSubscription(logrepl.Subscription{
+		Name:         c.Name,
+		Owner:        userRole.Name,
+		Database:     ctx.GetCurrentDatabase(),
+		ConnInfo:     c.ConnInfo,
+		SlotName:     slotName,
+		Publications: c.Publications,
+		Enabled:      enabled,
+	}, connect, createSlot, copyData)
+	if err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (c *CreateSubscription) Schema(ctx *sql.Context) sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (c *CreateSubscription) String() string {
+	return "CREATE SUBSCRIPTION"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (c *CreateSubscription) WithChildren(ctx *sql.Context, children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(c, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (c *CreateSubscription) WithResolvedChildren(ctx context.Context, children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, ErrVitessChildCount.New(0, len(children))
+	}
+	return c, nil
+}
+
+// optionOrDefault returns the value of the option if it was given, and the default value otherwise.
+func optionOrDefault(option *bool, defaultValue bool) bool {
+	if option == nil {
+		return defaultValue
+	}
+	return *option
+}
+
+// checkDuplicatePublications returns an error if a publication is named more than once.
+func checkDuplicatePublications(publications []string) error {
+	seen := make(map[string]struct{}, len(publications))
+	for _, publication := range publications {
+		if _, ok := seen[publication]; ok {
+			return errors.Errorf(`publication name "%s" used more than once`, publication)
+		}
+		seen[publication] = struct{}{}
+	}
+	return nil
+}
+
+// checkSubscriptionOwner returns the subscription with the given name, or an error if it does not exist or the user
+// is neither its owner nor a superuser.
+func checkSubscriptionOwner(ctx *sql.Context, name string) (logrepl.Subscription, error) {
+	sub, ok := logrepl.GetSubscription(name)
+	if !ok {
+		return logrepl.Subscription{}, errors.Errorf(`subscription "%s" does not exist`, name)
+	}
+	var userRole auth.Role
+	auth.LockRead(func() {
+		userRole = auth.GetRole(ctx.Client().User)
+	})
+	if !userRole.IsSuperUser && userRole.Name != sub.Owner {
+		return logrepl.Subscription{}, errors.Errorf("must be owner of subscription %s", name)
+	}
+	return sub, nil
+}
@@ -0,0 +1,100 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"context"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/core"
+)
+
+// CreateTableWithIdentityOptions handles CREATE TABLE statements that give sequence options to identity columns. The
+// table is created by the statement without the sequence options, and each identity column is then altered to apply
+// its options to the sequence that was created for it.
+type CreateTableWithIdentityOptions struct {
+	Database string
+	Schema   string
+	Name     string
+	// Statement is the original statement without the sequence options.
+	Statement string
+	// Alterations are the ALTER TABLE statements that apply the sequence options to each identity column.
+	Alterations []string
+}
+
+var _ sql.ExecSourceRel = (*CreateTableWithIdentityOptions)(nil)
+var _ vitess.Injectable = (*CreateTableWithIdentityOptions)(nil)
+
+// Children implements the interface sql.ExecSourceRel.
+func (c *CreateTableWithIdentityOptions) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (c *CreateTableWithIdentityOptions) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (c *CreateTableWithIdentityOptions) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (c *CreateTableWithIdentityOptions) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	// A table that already exists keeps its original sequences when IF NOT EXISTS is given
+	table, err := core.GetSqlTableFromContext(ctx, c.Database, doltdb.TableName{Name: c.Name, Schema: c.Schema})
+	if err != nil {
+		return nil, err
+	}
+	if _, err = runNestedStatement(ctx, c.Statement); err != nil {
+		return nil, err
+	}
+	if table == nil {
+		for _, alteration := range c.Alterations {
+			if _, err = runNestedStatement(ctx, alteration); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (c *CreateTableWithIdentityOptions) Schema(ctx *sql.Context) sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (c *CreateTableWithIdentityOptions) String() string {
+	return c.Statement
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (c *CreateTableWithIdentityOptions) WithChildren(ctx *sql.Context, children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(c, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (c *CreateTableWithIdentityOptions) WithResolvedChildren(ctx context.Context, children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, ErrVitessChildCount.New(0, len(children))
+	}
+	return c, nil
+}
@@ -0,0 +1,98 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"context"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/server/temporary"
+)
+
+// CreateTemporaryTable handles CREATE TEMPORARY TABLE statements that have an ON COMMIT action of DELETE ROWS or
+// DROP. The table is created by the statement without its ON COMMIT clause, and the action is then recorded so that
+// it may be performed whenever a transaction ends.
+type CreateTemporaryTable struct {
+	Name     string
+	OnCommit temporary.OnCommit
+	// Statement is the original statement without its ON COMMIT clause.
+	Statement string
+}
+
+var _ sql.ExecSourceRel = (*CreateTemporaryTable)(nil)
+var _ vitess.Injectable = (*CreateTemporaryTable)(nil)
+
+// Children implements the interface sql.ExecSourceRel.
+func (c *CreateTemporaryTable) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (c *CreateTemporaryTable) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (c *CreateTemporaryTable) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (c *CreateTemporaryTable) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	// A table that already exists keeps its original action when IF NOT EXISTS is given
+	database := ctx.GetCurrentDatabase()
+	_, existed := dsess.DSessFromSess(ctx.Session).GetTemporaryTable(ctx, database, c.Name)
+	if _, err := runNestedStatement(ctx, c.Statement); err != nil {
+		return nil, err
+	}
+	if !existed {
+		temporary.SetOnCommit(ctx.Session.ID(), database, c.Name, c.OnCommit)
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (c *CreateTemporaryTable) Schema(ctx *sql.Context) sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (c *CreateTemporaryTable) String() string {
+	switch c.OnCommit {
+	case temporary.OnCommit_DeleteRows:
+		return c.Statement + " ON COMMIT DELETE ROWS"
+	case temporary.OnCommit_Drop:
+		return c.Statement + " ON COMMIT DROP"
+	default:
+		return c.Statement
+	}
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (c *CreateTemporaryTable) WithChildren(ctx *sql.Context, children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(c, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (c *CreateTemporaryTable) WithResolvedChildren(ctx context.Context, children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, ErrVitessChildCount.New(0, len(children))
+	}
+	return c, nil
+}
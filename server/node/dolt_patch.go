@@ -0,0 +1,526 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/types"
+
+	"github.com/dolthub/doltgresql/core"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+	"github.com/dolthub/doltgresql/utils"
+)
+
+// DoltPatchName is the name of the dolt_patch table function.
+const DoltPatchName = "dolt_patch"
+
+// DoltPatch wraps Dolt's dolt_patch table function so that it emits statements that may be run against PostgreSQL.
+// Dolt generates the table schema statements, while the data statements are regenerated using PostgreSQL literals,
+// and statements for root objects (types, sequences, functions, procedures, triggers, and extensions) are added
+// around the table statements.
+type DoltPatch struct {
+	provider sql.TableFunctionProvider
+	patch    sql.TableFunction
+	inner    sql.Node
+}
+
+var _ sql.TableFunction = (*DoltPatch)(nil)
+var _ sql.ExecSourceRel = (*DoltPatch)(nil)
+
+// NewDoltPatch returns a new *DoltPatch that wraps Dolt's dolt_patch table function. The provider is used to create
+// the dolt_diff table functions that the data statements are generated from.
+func NewDoltPatch(provider sql.TableFunctionProvider, patch sql.TableFunction) *DoltPatch {
+	return &DoltPatch{
+		provider: provider,
+		patch:    patch,
+		inner:    patch,
+	}
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (d *DoltPatch) Children() []sql.Node {
+	return nil
+}
+
+// Database implements the interface sql.Databaser.
+func (d *DoltPatch) Database() sql.Database {
+	return d.inner.(sql.Databaser).Database()
+}
+
+// Expressions implements the interface sql.Expressioner.
+func (d *DoltPatch) Expressions() []sql.Expression {
+	return d.inner.(sql.Expressioner).Expressions()
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (d *DoltPatch) IsReadOnly() bool {
+	return true
+}
+
+// Name implements the interface sql.TableFunction.
+func (d *DoltPatch) Name() string {
+	return DoltPatchName
+}
+
+// NewInstance implements the interface sql.TableFunction.
+func (d *DoltPatch) NewInstance(ctx *sql.Context, db sql.Database, args []sql.Expression) (sql.Node, error) {
+	inner, err := d.patch.NewInstance(ctx, db, args)
+	if err != nil {
+		return nil, err
+	}
+	nd := *d
+	nd.inner = inner
+	return &nd, nil
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (d *DoltPatch) Resolved() bool {
+	return d.inner.Resolved()
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (d *DoltPatch) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	innerRowIter, ok := d.inner.(rowIterNode)
+	if !ok {
+		return nil, errors.Errorf("%s does not support row iteration", d.inner.String())
+	}
+	iter, err := innerRowIter.RowIter(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	innerRows, err := sql.RowIterToRows(ctx, iter)
+	if err != nil {
+		return nil, err
+	}
+	sch := d.inner.Schema(ctx)
+	cols := patchColumns{
+		order:     sch.IndexOfColName("statement_order"),
+		fromHash:  sch.IndexOfColName("from_commit_hash"),
+		toHash:    sch.IndexOfColName("to_commit_hash"),
+		tableName: sch.IndexOfColName("table_name"),
+		diffType:  sch.IndexOfColName("diff_type"),
+		statement: sch.IndexOfColName("statement"),
+	}
+	if cols.order < 0 || cols.tableName < 0 || cols.diffType < 0 || cols.statement < 0 {
+		return sql.RowsToRowIter(innerRows...), nil
+	}
+	args, err := d.evaluateArguments(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if args.threeDot {
+		// The statements would otherwise be generated by Dolt, which are not valid for PostgreSQL
+		return nil, errors.New("dolt_patch does not support three-dot ranges, use the merge base as the from revision instead")
+	}
+	sess := dsess.DSessFromSess(ctx.Session)
+	dbName := d.Database().Name()
+	fromRoot, _, fromHash, err := sess.ResolveRootForRef(ctx, dbName, args.fromRef)
+	if err != nil {
+		return nil, err
+	}
+	toRoot, _, toHash, err := sess.ResolveRootForRef(ctx, dbName, args.toRef)
+	if err != nil {
+		return nil, err
+	}
+	fromCoreRoot, fromOk := fromRoot.(*core.RootValue)
+	toCoreRoot, toOk := toRoot.(*core.RootValue)
+	if !fromOk || !toOk {
+		return nil, errors.New("dolt_patch encountered an unexpected root value type")
+	}
+
+	var rootObjPatch rootObjectPatch
+	if args.tableName == "" {
+		// Root objects are not tied to a single table, so they're only included when the whole patch is requested
+		if rootObjPatch, err = generateRootObjectPatch(ctx, fromCoreRoot, toCoreRoot); err != nil {
+			return nil, err
+		}
+	}
+	var rows []sql.Row
+	appendStatements := func(statements []patchStatement) {
+		for _, stmt := range statements {
+			row := make(sql.Row, len(sch))
+			if cols.fromHash >= 0 {
+				row[cols.fromHash] = fromHash
+			}
+			if cols.toHash >= 0 {
+				row[cols.toHash] = toHash
+			}
+			row[cols.tableName] = stmt.name
+			row[cols.diffType] = "schema"
+			row[cols.statement] = stmt.statement
+			rows = append(rows, row)
+		}
+	}
+	appendStatements(rootObjPatch.before)
+	replacedTables := make(map[string]struct{})
+	for _, innerRow := range innerRows {
+		statement, _ := innerRow[cols.statement].(string)
+		if innerRow[cols.diffType] != "data" || !isDataStatement(statement) {
+			rows = append(rows, innerRow)
+			continue
+		}
+		tableName, _ := innerRow[cols.tableName].(string)
+		if _, ok := replacedTables[tableName]; ok {
+			continue
+		}
+		replacedTables[tableName] = struct{}{}
+		dataStatements, err := d.generateDataStatements(ctx, args, tableName, fromCoreRoot, toCoreRoot)
+		if err != nil {
+			return nil, err
+		}
+		for _, dataStatement := range dataStatements {
+			row := innerRow.Copy()
+			row[cols.statement] = dataStatement
+			rows = append(rows, row)
+		}
+	}
+	appendStatements(rootObjPatch.after)
+	for i := range rows {
+		rows[i][cols.order], _, err = sch[cols.order].Type.Convert(ctx, uint64(i+1))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return sql.RowsToRowIter(rows...), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (d *DoltPatch) Schema(ctx *sql.Context) sql.Schema {
+	return d.inner.Schema(ctx)
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (d *DoltPatch) String() string {
+	return d.inner.String()
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (d *DoltPatch) WithChildren(ctx *sql.Context, children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(d, children...)
+}
+
+// WithDatabase implements the interface sql.Databaser.
+func (d *DoltPatch) WithDatabase(db sql.Database) (sql.Node, error) {
+	inner, err := d.inner.(sql.Databaser).WithDatabase(db)
+	if err != nil {
+		return nil, err
+	}
+	nd := *d
+	nd.inner = inner
+	return &nd, nil
+}
+
+// WithExpressions implements the interface sql.Expressioner.
+func (d *DoltPatch) WithExpressions(ctx *sql.Context, exprs ...sql.Expression) (sql.Node, error) {
+	inner, err := d.inner.(sql.Expressioner).WithExpressions(ctx, exprs...)
+	if err != nil {
+		return nil, err
+	}
+	nd := *d
+	nd.inner = inner
+	return &nd, nil
+}
+
+// rowIterNode is a node that is able to directly return its rows, which Dolt's table functions implement.
+type rowIterNode interface {
+	RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error)
+}
+
+// patchColumns are the indexes of the columns of the dolt_patch schema.
+type patchColumns struct {
+	order     int
+	fromHash  int
+	toHash    int
+	tableName int
+	diffType  int
+	statement int
+}
+
+// patchArguments are the evaluated arguments of a dolt_patch call.
+type patchArguments struct {
+	fromRef   string
+	toRef     string
+	tableName string
+	// threeDot is set when the revisions are given as a three-dot range, which is compared against the merge base.
+	threeDot bool
+}
+
+// evaluateArguments evaluates the arguments given to dolt_patch, which are either the from and to revisions as separate
+// arguments, or as a range in a single argument. Either form may be followed by a table name.
+func (d *DoltPatch) evaluateArguments(ctx *sql.Context) (patchArguments, error) {
+	exprs := d.Expressions()
+	strs := make([]string, len(exprs))
+	for i, expr := range exprs {
+		val, err := expr.Eval(ctx, nil)
+		if err != nil {
+			return patchArguments{}, err
+		}
+		str, ok := val.(string)
+		if !ok {
+			return patchArguments{}, errors.Errorf("dolt_patch arguments must be strings, but found: %v", val)
+		}
+		strs[i] = str
+	}
+	var args patchArguments
+	if len(strs) > 0 && strings.Contains(strs[0], "..") {
+		if strings.Contains(strs[0], "...") {
+			return patchArguments{threeDot: true}, nil
+		}
+		refs := strings.SplitN(strs[0], "..", 2)
+		args.fromRef, args.toRef = refs[0], refs[1]
+		strs = strs[1:]
+	} else if len(strs) >= 2 {
+		args.fromRef, args.toRef = strs[0], strs[1]
+		strs = strs[2:]
+	} else {
+		return patchArguments{}, errors.New("dolt_patch requires a from and to revision")
+	}
+	if len(strs) > 0 {
+		args.tableName = strs[0]
+	}
+	return args, nil
+}
+
+// generateDataStatements returns the INSERT, UPDATE, and DELETE statements for the given table, which are generated
+// from the rows of the dolt_diff table function.
+func (d *DoltPatch) generateDataStatements(ctx *sql.Context, args patchArguments, qualifiedName string, fromRoot *core.RootValue, toRoot *core.RootValue) ([]string, error) {
+	tableName := doltdb.TableName{Name: qualifiedName}
+	if idx := strings.IndexByte(qualifiedName, '.'); idx >= 0 {
+		tableName = doltdb.TableName{Schema: qualifiedName[:idx], Name: qualifiedName[idx+1:]}
+	}
+	toCols, toPkCols, err := patchTableColumns(ctx, toRoot, tableName)
+	if err != nil {
+		return nil, err
+	}
+	fromCols, fromPkCols, err := patchTableColumns(ctx, fromRoot, tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	diffFunc, ok := d.provider.TableFunction(ctx, "dolt_diff")
+	if !ok {
+		return nil, errors.New("unable to find the dolt_diff table function")
+	}
+	diffNode, err := diffFunc.NewInstance(ctx, d.Database(), []sql.Expression{
+		expression.NewLiteral(args.fromRef, types.LongText),
+		expression.NewLiteral(args.toRef, types.LongText),
+		expression.NewLiteral(qualifiedName, types.LongText),
+	})
+	if err != nil {
+		return nil, err
+	}
+	diffRowIter, ok := diffNode.(rowIterNode)
+	if !ok {
+		return nil, errors.Errorf("%s does not support row iteration", diffNode.String())
+	}
+	iter, err := diffRowIter.RowIter(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	diffRows, err := sql.RowIterToRows(ctx, iter)
+	if err != nil {
+		return nil, err
+	}
+	diffSch := diffNode.Schema(ctx)
+	diffTypeIdx := diffSch.IndexOfColName("diff_type")
+	if diffTypeIdx < 0 {
+		return nil, errors.New("dolt_diff did not return a diff_type column")
+	}
+
+	quotedTable := utils.QuoteQualifiedIdentifier(tableName.Schema, tableName.Name)
+	var statements []string
+	for _, diffRow := range diffRows {
+		switch diffRow[diffTypeIdx] {
+		case "added":
+			names := make([]string, 0, len(toCols))
+			values := make([]string, 0, len(toCols))
+			for _, col := range toCols {
+				val, ok, err := patchDiffValue(ctx, diffSch, diffRow, "to_"+col)
+				if err != nil {
+					return nil, err
+				}
+				if !ok {
+					continue
+				}
+				names = append(names, utils.QuoteIdentifier(col))
+				values = append(values, val)
+			}
+			statements = append(statements, fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s);",
+				quotedTable, strings.Join(names, ","), strings.Join(values, ",")))
+		case "removed":
+			where, err := patchRowCondition(ctx, quotedTable, diffSch, diffRow, fromCols, fromPkCols)
+			if err != nil {
+				return nil, err
+			}
+			statements = append(statements, fmt.Sprintf("DELETE FROM %s WHERE %s;", quotedTable, where))
+		case "modified":
+			var sets []string
+			for _, col := range toCols {
+				toVal, ok, err := patchDiffValue(ctx, diffSch, diffRow, "to_"+col)
+				if err != nil {
+					return nil, err
+				}
+				if !ok {
+					continue
+				}
+				fromVal, ok, err := patchDiffValue(ctx, diffSch, diffRow, "from_"+col)
+				if err != nil {
+					return nil, err
+				}
+				if ok && fromVal == toVal {
+					continue
+				}
+				sets = append(sets, fmt.Sprintf("%s=%s", utils.QuoteIdentifier(col), toVal))
+			}
+			if len(sets) == 0 {
+				continue
+			}
+			where, err := patchRowCondition(ctx, quotedTable, diffSch, diffRow, fromCols, fromPkCols)
+			if err != nil {
+				return nil, err
+			}
+			statements = append(statements, fmt.Sprintf("UPDATE %s SET %s WHERE %s;", quotedTable, strings.Join(sets, ","), where))
+		}
+	}
+	return statements, nil
+}
+
+// patchTableColumns returns the names of the non-generated columns and the primary key columns of the table in the
+// given root. No primary key columns are returned for keyless tables.
+func patchTableColumns(ctx *sql.Context, root *core.RootValue, tableName doltdb.TableName) (cols []string, pkCols []string, err error) {
+	tbl, ok, err := root.GetTable(ctx, tableName)
+	if err != nil || !ok {
+		return nil, nil, err
+	}
+	sch, err := tbl.GetSchema(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, col := range sch.GetAllCols().GetColumns() {
+		if col.Generated != "" {
+			continue
+		}
+		cols = append(cols, col.Name)
+		if col.IsPartOfPK {
+			pkCols = append(pkCols, col.Name)
+		}
+	}
+	return cols, pkCols, nil
+}
+
+// patchRowCondition returns the condition that matches the row being deleted or updated by the given dolt_diff row.
+// Rows are matched on their primary key. Keyless tables may contain duplicate rows, so a single row that matches every
+// column is chosen by its ctid, which mirrors the single row that Dolt removed or changed.
+func patchRowCondition(ctx *sql.Context, quotedTable string, diffSch sql.Schema, diffRow sql.Row, cols []string, pkCols []string) (string, error) {
+	if len(pkCols) > 0 {
+		return patchWhereClause(ctx, diffSch, diffRow, pkCols)
+	}
+	where, err := patchWhereClause(ctx, diffSch, diffRow, cols)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("ctid = (SELECT ctid FROM %s WHERE %s LIMIT 1)", quotedTable, where), nil
+}
+
+// patchWhereClause returns a WHERE clause that matches the "from" values of the given primary key columns.
+func patchWhereClause(ctx *sql.Context, diffSch sql.Schema, diffRow sql.Row, pkCols []string) (string, error) {
+	conditions := make([]string, 0, len(pkCols))
+	for _, col := range pkCols {
+		val, ok, err := patchDiffValue(ctx, diffSch, diffRow, "from_"+col)
+		if err != nil {
+			return "", err
+		}
+		if !ok {
+			continue
+		}
+		if val == "NULL" {
+			conditions = append(conditions, fmt.Sprintf("%s IS NULL", utils.QuoteIdentifier(col)))
+		} else {
+			conditions = append(conditions, fmt.Sprintf("%s=%s", utils.QuoteIdentifier(col), val))
+		}
+	}
+	return "(" + strings.Join(conditions, " AND ") + ")", nil
+}
+
+// patchDiffValue returns the PostgreSQL literal for the given column of a dolt_diff row. Returns false if the column
+// does not exist in the dolt_diff schema.
+func patchDiffValue(ctx *sql.Context, diffSch sql.Schema, diffRow sql.Row, colName string) (string, bool, error) {
+	idx := diffSch.IndexOfColName(colName)
+	if idx < 0 {
+		return "", false, nil
+	}
+	literal, err := postgresLiteral(ctx, diffSch[idx].Type, diffRow[idx])
+	return literal, true, err
+}
+
+// postgresLiteral returns the given value as a PostgreSQL literal. Values are written using their type's output
+// function, and quoted using standard-conforming strings, which PostgreSQL coerces into the column's type. Numbers and
+// booleans are written without quotes.
+func postgresLiteral(ctx *sql.Context, typ sql.Type, val any) (string, error) {
+	if val == nil {
+		return "NULL", nil
+	}
+	if dgType, ok := typ.(*pgtypes.DoltgresType); ok {
+		output, err := dgType.IoOutput(ctx, val)
+		if err != nil {
+			return "", err
+		}
+		switch dgType.TypCategory {
+		case pgtypes.TypeCategory_BooleanTypes:
+			if output == "t" {
+				return "true", nil
+			}
+			return "false", nil
+		case pgtypes.TypeCategory_NumericTypes:
+			if f, err := strconv.ParseFloat(output, 64); err == nil && !math.IsInf(f, 0) && !math.IsNaN(f) {
+				return output, nil
+			}
+		}
+		return quoteString(output), nil
+	}
+	sqlVal, err := typ.SQL(ctx, nil, val)
+	if err != nil {
+		return "", err
+	}
+	if types.IsNumber(typ) {
+		return sqlVal.ToString(), nil
+	}
+	return quoteString(sqlVal.ToString()), nil
+}
+
+// isDataStatement returns whether the statement is an INSERT, UPDATE, or DELETE statement.
+func isDataStatement(statement string) bool {
+	for _, prefix := range []string{"INSERT ", "UPDATE ", "DELETE "} {
+		if strings.HasPrefix(statement, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// quoteString returns the string as a PostgreSQL string literal.
+func quoteString(str string) string {
+	return "'" + strings.ReplaceAll(str, "'", "''") + "'"
+}
@@ -0,0 +1,621 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/extensions"
+	"github.com/dolthub/doltgresql/core/functions"
+	"github.com/dolthub/doltgresql/core/id"
+	"github.com/dolthub/doltgresql/core/procedures"
+	"github.com/dolthub/doltgresql/core/rootobject"
+	"github.com/dolthub/doltgresql/core/rootobject/objinterface"
+	"github.com/dolthub/doltgresql/core/sequences"
+	"github.com/dolthub/doltgresql/core/triggers"
+	"github.com/dolthub/doltgresql/core/typecollection"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+	"github.com/dolthub/doltgresql/utils"
+)
+
+// patchStatement is a statement generated by dolt_patch for a root object.
+type patchStatement struct {
+	// name is the schema-qualified name of the root object, which is displayed in the table_name column.
+	name      string
+	statement string
+}
+
+// rootObjectPatch contains the statements for root objects, split into those that must run before the table
+// statements (as tables may reference them), and those that must run after.
+type rootObjectPatch struct {
+	before []patchStatement
+	after  []patchStatement
+}
+
+// rootObjectChange is a root object that differs between two roots. The from object is nil when the root object was
+// added, and the to object is nil when the root object was removed.
+type rootObjectChange struct {
+	from objinterface.RootObject
+	to   objinterface.RootObject
+}
+
+// createStatementRegex matches the beginning of a CREATE FUNCTION, CREATE PROCEDURE, or CREATE TRIGGER statement.
+var createStatementRegex = regexp.MustCompile(`(?is)^\s*CREATE\s+(OR\s+REPLACE\s+)?`)
+
+// generateRootObjectPatch returns the statements that convert the root objects in the from root to those in the to
+// root. Extensions, types, sequences, functions, and procedures are created before the tables, while triggers and
+// sequence ownership are set afterward. Removed root objects are dropped after the tables, in reverse dependency order.
+func generateRootObjectPatch(ctx *sql.Context, fromRoot *core.RootValue, toRoot *core.RootValue) (rootObjectPatch, error) {
+	changes := make(map[objinterface.RootObjectID][]rootObjectChange)
+	for _, rootObjID := range []objinterface.RootObjectID{
+		objinterface.RootObjectID_Extensions,
+		objinterface.RootObjectID_Types,
+		objinterface.RootObjectID_Sequences,
+		objinterface.RootObjectID_Functions,
+		objinterface.RootObjectID_Procedures,
+		objinterface.RootObjectID_Triggers,
+	} {
+		collChanges, err := diffRootObjectCollection(ctx, fromRoot, toRoot, rootObjID)
+		if err != nil {
+			return rootObjectPatch{}, err
+		}
+		changes[rootObjID] = collChanges
+	}
+
+	var patch rootObjectPatch
+	// Triggers are dropped first, as they may reference functions that are replaced
+	for _, change := range changes[objinterface.RootObjectID_Triggers] {
+		if change.from != nil {
+			trigger := change.from.(triggers.Trigger)
+			patch.before = append(patch.before, patchStatement{
+				name: rootObjectDisplayName(trigger),
+				statement: fmt.Sprintf("DROP TRIGGER IF EXISTS %s ON %s;", utils.QuoteIdentifier(trigger.ID.TriggerName()),
+					utils.QuoteQualifiedIdentifier(trigger.ID.SchemaName(), trigger.ID.TableName())),
+			})
+		}
+	}
+	for _, change := range changes[objinterface.RootObjectID_Extensions] {
+		if change.from == nil {
+			ext := change.to.(extensions.Extension)
+			patch.before = append(patch.before, patchStatement{name: ext.ExtName.Name(), statement: extensionCreateStatement(ext)})
+		}
+	}
+	for _, change := range changes[objinterface.RootObjectID_Types] {
+		if change.to != nil {
+			var from *pgtypes.DoltgresType
+			if change.from != nil {
+				from = change.from.(typecollection.TypeWrapper).Type
+			}
+			to := change.to.(typecollection.TypeWrapper).Type
+			for _, stmt := range typePatchStatements(from, to) {
+				patch.before = append(patch.before, patchStatement{name: rootObjectDisplayName(change.to), statement: stmt})
+			}
+		}
+	}
+	for _, change := range changes[objinterface.RootObjectID_Sequences] {
+		if change.to != nil {
+			var from *sequences.Sequence
+			if change.from != nil {
+				from = change.from.(*sequences.Sequence)
+			}
+			to := change.to.(*sequences.Sequence)
+			for _, stmt := range sequencePatchStatements(from, to) {
+				patch.before = append(patch.before, patchStatement{name: rootObjectDisplayName(to), statement: stmt})
+			}
+			if ownerStmt := sequenceOwnerStatement(from, to); ownerStmt != "" {
+				patch.after = append(patch.after, patchStatement{name: rootObjectDisplayName(to), statement: ownerStmt})
+			}
+		}
+	}
+	for _, change := range changes[objinterface.RootObjectID_Functions] {
+		if change.to != nil {
+			to := change.to.(functions.Function)
+			if to.ExtensionName != "" {
+				// Extension functions are created by their extension
+				continue
+			}
+			var stmts []string
+			if change.from != nil && change.from.(functions.Function).ReturnType != to.ReturnType {
+				// The return type of a function cannot be changed by CREATE OR REPLACE
+				stmts = append(stmts, functionDropStatement(change.from.(functions.Function)))
+			}
+			stmts = append(stmts, createOrReplaceStatement(to.Definition))
+			for _, stmt := range stmts {
+				patch.before = append(patch.before, patchStatement{name: rootObjectDisplayName(to), statement: stmt})
+			}
+		}
+	}
+	for _, change := range changes[objinterface.RootObjectID_Procedures] {
+		if change.to != nil {
+			to := change.to.(procedures.Procedure)
+			if to.ExtensionName != "" {
+				continue
+			}
+			patch.before = append(patch.before, patchStatement{
+				name:      rootObjectDisplayName(to),
+				statement: createOrReplaceStatement(to.Definition),
+			})
+		}
+	}
+
+	for _, change := range changes[objinterface.RootObjectID_Triggers] {
+		if change.to != nil {
+			trigger := change.to.(triggers.Trigger)
+			patch.after = append(patch.after, patchStatement{
+				name:      rootObjectDisplayName(trigger),
+				statement: terminateStatement(trigger.Definition),
+			})
+		}
+	}
+	for _, change := range changes[objinterface.RootObjectID_Procedures] {
+		if change.to == nil {
+			procedure := change.from.(procedures.Procedure)
+			if procedure.ExtensionName != "" {
+				continue
+			}
+			patch.after = append(patch.after, patchStatement{
+				name: rootObjectDisplayName(procedure),
+				statement: fmt.Sprintf("DROP PROCEDURE IF EXISTS %s(%s);",
+					utils.QuoteQualifiedIdentifier(procedure.ID.SchemaName(), procedure.ID.ProcedureName()),
+					patchParameterTypes(procedure.ID.SchemaName(), procedure.ID.Parameters())),
+			})
+		}
+	}
+	for _, change := range changes[objinterface.RootObjectID_Functions] {
+		if change.to == nil {
+			function := change.from.(functions.Function)
+			if function.ExtensionName != "" {
+				continue
+			}
+			patch.after = append(patch.after, patchStatement{
+				name:      rootObjectDisplayName(function),
+				statement: functionDropStatement(function),
+			})
+		}
+	}
+	for _, change := range changes[objinterface.RootObjectID_Sequences] {
+		if change.to == nil {
+			sequence := change.from.(*sequences.Sequence)
+			// Sequences that are owned by a dropped table have already been dropped alongside the table
+			patch.after = append(patch.after, patchStatement{
+				name:      rootObjectDisplayName(sequence),
+				statement: fmt.Sprintf("DROP SEQUENCE IF EXISTS %s;", sequenceName(sequence)),
+			})
+		}
+	}
+	for _, change := range changes[objinterface.RootObjectID_Types] {
+		if change.to == nil {
+			typ := change.from.(typecollection.TypeWrapper).Type
+			if !isPatchableType(typ) {
+				continue
+			}
+			patch.after = append(patch.after, patchStatement{
+				name:      rootObjectDisplayName(change.from),
+				statement: typeDropStatement(typ),
+			})
+		}
+	}
+	for _, change := range changes[objinterface.RootObjectID_Extensions] {
+		if change.to == nil {
+			ext := change.from.(extensions.Extension)
+			patch.after = append(patch.after, patchStatement{
+				name:      ext.ExtName.Name(),
+				statement: fmt.Sprintf("DROP EXTENSION IF EXISTS %s;", utils.QuoteIdentifier(ext.ExtName.Name())),
+			})
+		}
+	}
+	return patch, nil
+}
+
+// diffRootObjectCollection returns the root objects of the given collection that differ between the two roots, sorted
+// by their IDs.
+func diffRootObjectCollection(ctx *sql.Context, fromRoot *core.RootValue, toRoot *core.RootValue, rootObjID objinterface.RootObjectID) ([]rootObjectChange, error) {
+	fromObjects, err := rootobject.LoadRootObjects(ctx, fromRoot, rootObjID)
+	if err != nil {
+		return nil, err
+	}
+	toObjects, err := rootobject.LoadRootObjects(ctx, toRoot, rootObjID)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]id.Id, 0, len(fromObjects)+len(toObjects))
+	for objID := range fromObjects {
+		ids = append(ids, objID)
+	}
+	for objID := range toObjects {
+		if _, ok := fromObjects[objID]; !ok {
+			ids = append(ids, objID)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+	var changes []rootObjectChange
+	for _, objID := range ids {
+		from, fromOk := fromObjects[objID]
+		to, toOk := toObjects[objID]
+		if fromOk && toOk {
+			fromData, err := from.Serialize(ctx)
+			if err != nil {
+				return nil, err
+			}
+			toData, err := to.Serialize(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if bytes.Equal(fromData, toData) {
+				continue
+			}
+		}
+		changes = append(changes, rootObjectChange{from: from, to: to})
+	}
+	return changes, nil
+}
+
+// rootObjectDisplayName returns the schema-qualified name of the root object.
+func rootObjectDisplayName(rootObj objinterface.RootObject) string {
+	name := rootObj.Name()
+	if name.Schema == "" {
+		return name.Name
+	}
+	return name.Schema + "." + name.Name
+}
+
+// createOrReplaceStatement returns the given CREATE statement as a CREATE OR REPLACE statement.
+func createOrReplaceStatement(definition string) string {
+	return terminateStatement(createStatementRegex.ReplaceAllString(definition, "CREATE OR REPLACE "))
+}
+
+// terminateStatement trims the statement and ensures that it ends with a semicolon.
+func terminateStatement(statement string) string {
+	statement = strings.TrimSpace(statement)
+	if !strings.HasSuffix(statement, ";") {
+		statement += ";"
+	}
+	return statement
+}
+
+// extensionCreateStatement returns the CREATE EXTENSION statement for the given extension.
+func extensionCreateStatement(ext extensions.Extension) string {
+	stmt := fmt.Sprintf("CREATE EXTENSION IF NOT EXISTS %s", utils.QuoteIdentifier(ext.ExtName.Name()))
+	if ext.Namespace.IsValid() {
+		stmt += " WITH SCHEMA " + utils.QuoteIdentifier(ext.Namespace.SchemaName())
+	}
+	if ext.Version != "" {
+		stmt += " VERSION " + quoteString(ext.Version)
+	}
+	return stmt + ";"
+}
+
+// functionDropStatement returns the DROP FUNCTION statement for the given function.
+func functionDropStatement(function functions.Function) string {
+	return fmt.Sprintf("DROP FUNCTION IF EXISTS %s(%s);",
+		utils.QuoteQualifiedIdentifier(function.ID.SchemaName(), function.ID.FunctionName()),
+		patchParameterTypes(function.ID.SchemaName(), function.ID.Parameters()))
+}
+
+// patchParameterTypes returns the parameter types of a function or procedure signature. Types from pg_catalog and
+// from the routine's own schema are not qualified, matching the names used by root objects.
+func patchParameterTypes(schemaName string, paramTypes []id.Type) string {
+	strTypes := make([]string, len(paramTypes))
+	for i, paramType := range paramTypes {
+		if paramType.SchemaName() == "pg_catalog" || paramType.SchemaName() == schemaName {
+			strTypes[i] = paramType.TypeName()
+		} else {
+			strTypes[i] = utils.QuoteQualifiedIdentifier(paramType.SchemaName(), paramType.TypeName())
+		}
+	}
+	return strings.Join(strTypes, ", ")
+}
+
+// sequenceName returns the quoted, schema-qualified name of the sequence.
+func sequenceName(sequence *sequences.Sequence) string {
+	return utils.QuoteQualifiedIdentifier(sequence.Id.SchemaName(), sequence.Id.SequenceName())
+}
+
+// sequencePatchStatements returns the statements that create the sequence when from is nil, or that alter the from
+// sequence to match the to sequence. Ownership is handled separately by sequenceOwnerStatement.
+func sequencePatchStatements(from *sequences.Sequence, to *sequences.Sequence) []string {
+	var stmts []string
+	name := sequenceName(to)
+	cycle := func(seq *sequences.Sequence) string {
+		if seq.Cycle {
+			return "CYCLE"
+		}
+		return "NO CYCLE"
+	}
+	if from == nil {
+		persistence := ""
+		if to.Persistence == sequences.Persistence_Unlogged {
+			persistence = "UNLOGGED "
+		}
+		stmts = append(stmts, fmt.Sprintf("CREATE %sSEQUENCE %s AS %s INCREMENT BY %d MINVALUE %d MAXVALUE %d START WITH %d CACHE %d %s;",
+			persistence, name, to.DataTypeID.TypeName(), to.Increment, to.Minimum, to.Maximum, to.Start, to.Cache, cycle(to)))
+		if to.HasBeenCalled {
+			stmts = append(stmts, fmt.Sprintf("SELECT setval(%s, %d, true);", quoteString(name), to.Current))
+		}
+		return stmts
+	}
+	var options []string
+	if from.DataTypeID != to.DataTypeID {
+		options = append(options, "AS "+to.DataTypeID.TypeName())
+	}
+	if from.Increment != to.Increment {
+		options = append(options, fmt.Sprintf("INCREMENT BY %d", to.Increment))
+	}
+	if from.Minimum != to.Minimum {
+		options = append(options, fmt.Sprintf("MINVALUE %d", to.Minimum))
+	}
+	if from.Maximum != to.Maximum {
+		options = append(options, fmt.Sprintf("MAXVALUE %d", to.Maximum))
+	}
+	if from.Start != to.Start {
+		options = append(options, fmt.Sprintf("START WITH %d", to.Start))
+	}
+	if from.Cache != to.Cache {
+		options = append(options, fmt.Sprintf("CACHE %d", to.Cache))
+	}
+	if from.Cycle != to.Cycle {
+		options = append(options, cycle(to))
+	}
+	if len(options) > 0 {
+		stmts = append(stmts, fmt.Sprintf("ALTER SEQUENCE %s %s;", name, strings.Join(options, " ")))
+	}
+	if from.Persistence != to.Persistence {
+		if to.Persistence == sequences.Persistence_Unlogged {
+			stmts = append(stmts, fmt.Sprintf("ALTER SEQUENCE %s SET UNLOGGED;", name))
+		} else {
+			stmts = append(stmts, fmt.Sprintf("ALTER SEQUENCE %s SET LOGGED;", name))
+		}
+	}
+	if from.Current != to.Current || from.HasBeenCalled != to.HasBeenCalled {
+		stmts = append(stmts, fmt.Sprintf("SELECT setval(%s, %d, %t);", quoteString(name), to.Current, to.HasBeenCalled))
+	}
+	return stmts
+}
+
+// sequenceOwnerStatement returns the statement that sets the owner of the to sequence, which must run after the tables
+// have been created. Returns an empty string if the owner has not changed.
+func sequenceOwnerStatement(from *sequences.Sequence, to *sequences.Sequence) string {
+	if from != nil && from.OwnerTable == to.OwnerTable && from.OwnerColumn == to.OwnerColumn {
+		return ""
+	}
+	if !to.OwnerTable.IsValid() || to.OwnerColumn == "" {
+		if from == nil {
+			return ""
+		}
+		return fmt.Sprintf("ALTER SEQUENCE %s OWNED BY NONE;", sequenceName(to))
+	}
+	return fmt.Sprintf("ALTER SEQUENCE %s OWNED BY %s.%s;", sequenceName(to),
+		utils.QuoteQualifiedIdentifier(to.OwnerTable.SchemaName(), to.OwnerTable.TableName()), utils.QuoteIdentifier(to.OwnerColumn))
+}
+
+// isPatchableType returns whether the type is created by a statement. Array types are created alongside their base
+// type, and the composite types of tables are created alongside the table.
+func isPatchableType(typ *pgtypes.DoltgresType) bool {
+	if typ == nil || typ.IsArrayType() {
+		return false
+	}
+	switch typ.TypType {
+	case pgtypes.TypeType_Domain, pgtypes.TypeType_Enum:
+		return true
+	case pgtypes.TypeType_Composite:
+		return !typ.RelID.IsValid()
+	default:
+		return false
+	}
+}
+
+// patchTypeName returns the name of the type as used within a statement.
+func patchTypeName(typ *pgtypes.DoltgresType) string {
+	if typ.ID.SchemaName() == "pg_catalog" || typ.ID.SchemaName() == "" {
+		return typ.String()
+	}
+	return utils.QuoteQualifiedIdentifier(typ.ID.SchemaName(), typ.ID.TypeName())
+}
+
+// typeDropStatement returns the DROP statement for the given type.
+func typeDropStatement(typ *pgtypes.DoltgresType) string {
+	if typ.TypType == pgtypes.TypeType_Domain {
+		return fmt.Sprintf("DROP DOMAIN IF EXISTS %s;", patchTypeName(typ))
+	}
+	return fmt.Sprintf("DROP TYPE IF EXISTS %s;", patchTypeName(typ))
+}
+
+// typeCreateStatement returns the CREATE statement for the given type.
+func typeCreateStatement(typ *pgtypes.DoltgresType) string {
+	switch typ.TypType {
+	case pgtypes.TypeType_Domain:
+		var sb strings.Builder
+		fmt.Fprintf(&sb, "CREATE DOMAIN %s AS %s", patchTypeName(typ), patchTypeName(typ.BaseTypeType))
+		if typ.Default != "" {
+			sb.WriteString(" DEFAULT " + typ.Default)
+		}
+		if typ.NotNull {
+			sb.WriteString(" NOT NULL")
+		}
+		for _, check := range typ.Checks {
+			fmt.Fprintf(&sb, " CONSTRAINT %s CHECK (%s)", utils.QuoteIdentifier(check.Name), check.CheckExpression)
+		}
+		sb.WriteString(";")
+		return sb.String()
+	case pgtypes.TypeType_Enum:
+		labels := sortedEnumLabels(typ)
+		for i, label := range labels {
+			labels[i] = quoteString(label)
+		}
+		return fmt.Sprintf("CREATE TYPE %s AS ENUM (%s);", patchTypeName(typ), strings.Join(labels, ", "))
+	default:
+		attrs := make([]string, len(typ.CompositeAttrs))
+		for i, attr := range sortedCompositeAttributes(typ) {
+			attrs[i] = fmt.Sprintf("%s %s", utils.QuoteIdentifier(attr.Name), patchTypeName(attr.Type))
+		}
+		return fmt.Sprintf("CREATE TYPE %s AS (%s);", patchTypeName(typ), strings.Join(attrs, ", "))
+	}
+}
+
+// typePatchStatements returns the statements that create the type when from is nil, or that alter the from type to
+// match the to type. Changes that cannot be made through ALTER drop and recreate the type.
+func typePatchStatements(from *pgtypes.DoltgresType, to *pgtypes.DoltgresType) []string {
+	if !isPatchableType(to) {
+		return nil
+	}
+	if from == nil {
+		return []string{typeCreateStatement(to)}
+	}
+	recreate := []string{typeDropStatement(from), typeCreateStatement(to)}
+	if from.TypType != to.TypType {
+		return recreate
+	}
+	name := patchTypeName(to)
+	var stmts []string
+	switch to.TypType {
+	case pgtypes.TypeType_Domain:
+		if from.BaseTypeType.ID != to.BaseTypeType.ID {
+			return recreate
+		}
+		if from.Default != to.Default {
+			if to.Default == "" {
+				stmts = append(stmts, fmt.Sprintf("ALTER DOMAIN %s DROP DEFAULT;", name))
+			} else {
+				stmts = append(stmts, fmt.Sprintf("ALTER DOMAIN %s SET DEFAULT %s;", name, to.Default))
+			}
+		}
+		if from.NotNull != to.NotNull {
+			if to.NotNull {
+				stmts = append(stmts, fmt.Sprintf("ALTER DOMAIN %s SET NOT NULL;", name))
+			} else {
+				stmts = append(stmts, fmt.Sprintf("ALTER DOMAIN %s DROP NOT NULL;", name))
+			}
+		}
+		fromChecks := make(map[string]string)
+		for _, check := range from.Checks {
+			fromChecks[check.Name] = check.CheckExpression
+		}
+		toChecks := make(map[string]string)
+		for _, check := range to.Checks {
+			toChecks[check.Name] = check.CheckExpression
+		}
+		for _, check := range from.Checks {
+			if expr, ok := toChecks[check.Name]; !ok || expr != check.CheckExpression {
+				stmts = append(stmts, fmt.Sprintf("ALTER DOMAIN %s DROP CONSTRAINT %s;", name, utils.QuoteIdentifier(check.Name)))
+			}
+		}
+		for _, check := range to.Checks {
+			if expr, ok := fromChecks[check.Name]; !ok || expr != check.CheckExpression {
+				stmts = append(stmts, fmt.Sprintf("ALTER DOMAIN %s ADD CONSTRAINT %s CHECK (%s);",
+					name, utils.QuoteIdentifier(check.Name), check.CheckExpression))
+			}
+		}
+	case pgtypes.TypeType_Enum:
+		fromLabels := sortedEnumLabels(from)
+		toLabels := sortedEnumLabels(to)
+		// Labels may only be added or renamed, so any other change requires recreating the type. Renamed labels keep
+		// their sort order.
+		renamedLabels := make(map[string]struct{})
+		for _, label := range fromLabels {
+			if _, ok := to.EnumLabels[label]; ok {
+				continue
+			}
+			renamed := false
+			for newLabel, enumLabel := range to.EnumLabels {
+				if _, ok := from.EnumLabels[newLabel]; !ok && enumLabel.SortOrder == from.EnumLabels[label].SortOrder {
+					stmts = append(stmts, fmt.Sprintf("ALTER TYPE %s RENAME VALUE %s TO %s;", name, quoteString(label), quoteString(newLabel)))
+					renamedLabels[newLabel] = struct{}{}
+					renamed = true
+					break
+				}
+			}
+			if !renamed {
+				return recreate
+			}
+		}
+		for i, label := range toLabels {
+			if _, ok := from.EnumLabels[label]; ok {
+				continue
+			}
+			if _, ok := renamedLabels[label]; ok {
+				continue
+			}
+			if i > 0 {
+				stmts = append(stmts, fmt.Sprintf("ALTER TYPE %s ADD VALUE %s AFTER %s;", name, quoteString(label), quoteString(toLabels[i-1])))
+			} else if len(toLabels) > 1 {
+				stmts = append(stmts, fmt.Sprintf("ALTER TYPE %s ADD VALUE %s BEFORE %s;", name, quoteString(label), quoteString(toLabels[1])))
+			} else {
+				stmts = append(stmts, fmt.Sprintf("ALTER TYPE %s ADD VALUE %s;", name, quoteString(label)))
+			}
+		}
+	case pgtypes.TypeType_Composite:
+		fromAttrs := make(map[string]pgtypes.CompositeAttribute)
+		for _, attr := range from.CompositeAttrs {
+			fromAttrs[attr.Name] = attr
+		}
+		toAttrs := make(map[string]pgtypes.CompositeAttribute)
+		for _, attr := range to.CompositeAttrs {
+			toAttrs[attr.Name] = attr
+		}
+		var actions []string
+		for _, attr := range sortedCompositeAttributes(from) {
+			if _, ok := toAttrs[attr.Name]; !ok {
+				actions = append(actions, "DROP ATTRIBUTE "+utils.QuoteIdentifier(attr.Name))
+			}
+		}
+		for _, attr := range sortedCompositeAttributes(to) {
+			fromAttr, ok := fromAttrs[attr.Name]
+			if !ok {
+				actions = append(actions, fmt.Sprintf("ADD ATTRIBUTE %s %s", utils.QuoteIdentifier(attr.Name), patchTypeName(attr.Type)))
+			} else if fromAttr.Type.ID != attr.Type.ID {
+				actions = append(actions, fmt.Sprintf("ALTER ATTRIBUTE %s TYPE %s", utils.QuoteIdentifier(attr.Name), patchTypeName(attr.Type)))
+			}
+		}
+		if len(actions) > 0 {
+			stmts = append(stmts, fmt.Sprintf("ALTER TYPE %s %s;", name, strings.Join(actions, ", ")))
+		}
+	}
+	return stmts
+}
+
+// sortedEnumLabels returns the labels of the enum type in their sort order.
+func sortedEnumLabels(typ *pgtypes.DoltgresType) []string {
+	labels := make([]pgtypes.EnumLabel, 0, len(typ.EnumLabels))
+	for _, label := range typ.EnumLabels {
+		labels = append(labels, label)
+	}
+	sort.Slice(labels, func(i, j int) bool {
+		return labels[i].SortOrder < labels[j].SortOrder
+	})
+	names := make([]string, len(labels))
+	for i, label := range labels {
+		names[i] = label.ID.Label()
+	}
+	return names
+}
+
+// sortedCompositeAttributes returns the attributes of the composite type in their column order.
+func sortedCompositeAttributes(typ *pgtypes.DoltgresType) []pgtypes.CompositeAttribute {
+	attrs := make([]pgtypes.CompositeAttribute, len(typ.CompositeAttrs))
+	copy(attrs, typ.CompositeAttrs)
+	sort.Slice(attrs, func(i, j int) bool {
+		return attrs[i].Num < attrs[j].Num
+	})
+	return attrs
+}
@@ -0,0 +1,118 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"context"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/id"
+	"github.com/dolthub/doltgresql/server/dependencies"
+)
+
+// DropCascade handles the CASCADE option for the DROP statements that are otherwise handled by GMS, which are DROP
+// TABLE, DROP VIEW, DROP SCHEMA, and ALTER TABLE ... DROP COLUMN. Every object that depends on the named objects is
+// dropped first, after which the original statement is run without CASCADE.
+type DropCascade struct {
+	// Statement is the original statement without CASCADE.
+	Statement string
+	// Schema is set when dropping a schema, in which case every object within the schema is dropped.
+	Schema string
+	// Relations are the tables or views being dropped, or the table containing the column being dropped.
+	Relations []doltdb.TableName
+	// Kind is the kind of object being dropped when Schema is not set.
+	Kind dependencies.ObjectKind
+	// Column is the column being dropped when Kind is a column.
+	Column string
+}
+
+var _ sql.ExecSourceRel = (*DropCascade)(nil)
+var _ vitess.Injectable = (*DropCascade)(nil)
+
+// Children implements the interface sql.ExecSourceRel.
+func (c *DropCascade) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (c *DropCascade) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (c *DropCascade) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (c *DropCascade) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	if len(c.Schema) > 0 {
+		if err := dependencies.DropSchemaObjects(ctx, c.Schema); err != nil {
+			return nil, err
+		}
+	} else {
+		objects := make([]dependencies.Object, len(c.Relations))
+		for i, relation := range c.Relations {
+			schema, err := core.GetSchemaName(ctx, nil, relation.Schema)
+			if err != nil {
+				return nil, err
+			}
+			switch c.Kind {
+			case dependencies.ObjectKind_Column:
+				objects[i] = dependencies.NewColumnObject(id.NewTable(schema, relation.Name), c.Column)
+			case dependencies.ObjectKind_View:
+				objects[i] = dependencies.NewObject(dependencies.ObjectKind_View, id.NewView(schema, relation.Name).AsId())
+			default:
+				objects[i] = dependencies.NewObject(dependencies.ObjectKind_Table, id.NewTable(schema, relation.Name).AsId())
+			}
+		}
+		if err := dependencies.PrepareDrop(ctx, true, objects...); err != nil {
+			return nil, err
+		}
+	}
+	// Objects that don't exist were ignored, so the original statement handles IF EXISTS and any errors
+	if _, err := runNestedStatement(ctx, c.Statement); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (c *DropCascade) Schema(ctx *sql.Context) sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (c *DropCascade) String() string {
+	return c.Statement + " CASCADE"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (c *DropCascade) WithChildren(ctx *sql.Context, children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(c, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (c *DropCascade) WithResolvedChildren(ctx context.Context, children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, ErrVitessChildCount.New(0, len(children))
+	}
+	return c, nil
+}
@@ -25,6 +25,7 @@ import (
 
 	"github.com/dolthub/doltgresql/core"
 	"github.com/dolthub/doltgresql/core/id"
+	"github.com/dolthub/doltgresql/server/dependencies"
 	"github.com/dolthub/doltgresql/server/types"
 )
 
@@ -93,37 +94,13 @@ func (c *DropDomain) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
 			return nil, types.ErrTypeDoesNotExist.New(c.domain)
 		}
 	}
-	if c.cascade {
-		// TODO: handle cascade
-		return nil, errors.Errorf(`cascading domain drops are not yet supported`)
-	}
-
-	// iterate on all table columns to check if this domain is currently used.
-	db, err := core.GetSqlDatabaseFromContext(ctx, "")
-	if err != nil {
+	if err = dependencies.PrepareDrop(ctx, c.cascade, dependencies.NewObject(dependencies.ObjectKind_Domain, typeID.AsId())); err != nil {
 		return nil, err
 	}
-	tableNames, err := db.GetTableNames(ctx)
-	if err != nil {
+	// Dropping the dependent objects may have replaced the collection, so we load it again
+	if collection, err = core.GetTypesCollectionFromContext(ctx, ""); err != nil {
 		return nil, err
 	}
-	for _, tableName := range tableNames {
-		t, ok, err := db.GetTableInsensitive(ctx, tableName)
-		if err != nil {
-			return nil, err
-		}
-		if ok {
-			for _, col := range t.Schema(ctx) {
-				if dt, isDoltgresType := col.Type.(*types.DoltgresType); isDoltgresType && dt.TypType == types.TypeType_Domain {
-					if dt.Name() == domain.Name() {
-						// TODO: issue a detail (list of all columns and tables that uses this domain)
-						//  and a hint (when we support CASCADE)
-						return nil, errors.Errorf(`cannot drop type %s because other objects depend on it - column %s of table %s depends on type %s'`, c.domain, col.Name, t.Name(), c.domain)
-					}
-				}
-			}
-		}
-	}
 
 	if err = collection.DropType(ctx, typeID); err != nil {
 		return nil, err
@@ -23,8 +23,8 @@ import (
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
 	"github.com/dolthub/doltgresql/core"
-	"github.com/dolthub/doltgresql/core/functions"
 	"github.com/dolthub/doltgresql/core/id"
+	"github.com/dolthub/doltgresql/server/dependencies"
 )
 
 // RoutineWithParams represent a function or a procedure with schema name, routine name and its parameters.
@@ -86,13 +86,8 @@ func (d *DropFunction) IsReadOnly() bool {
 
 // RowIter implements the interface sql.ExecSourceRel.
 func (d *DropFunction) RowIter(ctx *sql.Context, r sql.Row) (iter sql.RowIter, err error) {
-	funcColl, err := core.GetFunctionsCollectionFromContext(ctx, "")
-	if err != nil {
-		return nil, err
-	}
-
 	for _, routineWithArgs := range d.RoutinesWithArgs {
-		err = dropFunction(ctx, funcColl, routineWithArgs, d.IfExists)
+		err = dropFunction(ctx, routineWithArgs, d.IfExists, d.Cascade)
 		if err != nil {
 			return nil, err
 		}
@@ -109,13 +104,17 @@ func (d *DropFunction) WithResolvedChildren(ctx context.Context, children []any)
 	return d, nil
 }
 
-// dropFunction drops a function from given function collection.
-func dropFunction(ctx *sql.Context, funcColl *functions.Collection, fn *RoutineWithParams, ifExists bool) error {
+// dropFunction drops a function from the current database.
+func dropFunction(ctx *sql.Context, fn *RoutineWithParams, ifExists bool, cascade bool) error {
 	// TODO: provide db
 	schema, err := core.GetSchemaName(ctx, nil, fn.SchemaName)
 	if err != nil {
 		return err
 	}
+	funcColl, err := core.GetFunctionsCollectionFromContext(ctx, "")
+	if err != nil {
+		return err
+	}
 	var funcId = id.NewFunction(schema, fn.RoutineName)
 	if fn.NoArgDefined {
 		funcs, err := funcColl.GetFunctionOverloads(ctx, funcId)
@@ -141,5 +140,12 @@ func dropFunction(ctx *sql.Context, funcColl *functions.Collection, fn *RoutineW
 	if !funcExists && ifExists {
 		return nil
 	}
+	if err = dependencies.PrepareDrop(ctx, cascade, dependencies.NewObject(dependencies.ObjectKind_Function, funcId.AsId())); err != nil {
+		return err
+	}
+	// Dropping the dependent objects may have replaced the collection, so we load it again
+	if funcColl, err = core.GetFunctionsCollectionFromContext(ctx, ""); err != nil {
+		return err
+	}
 	return funcColl.DropFunction(ctx, funcId)
 }
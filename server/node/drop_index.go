@@ -0,0 +1,128 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/id"
+)
+
+// DropIndex is a node that wraps the GMS index dropping node, so that the access method of the index and the posting
+// lists of GIN indexes are dropped along with the index.
+type DropIndex struct {
+	gmsAlterIndex *plan.AlterIndex
+}
+
+var _ sql.ExecBuilderNode = (*DropIndex)(nil)
+
+// NewDropIndex returns a new *DropIndex.
+func NewDropIndex(alterIndex *plan.AlterIndex) *DropIndex {
+	return &DropIndex{
+		gmsAlterIndex: alterIndex,
+	}
+}
+
+// Children implements the interface sql.ExecBuilderNode.
+func (c *DropIndex) Children() []sql.Node {
+	return c.gmsAlterIndex.Children()
+}
+
+// IsReadOnly implements the interface sql.ExecBuilderNode.
+func (c *DropIndex) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecBuilderNode.
+func (c *DropIndex) Resolved() bool {
+	return c.gmsAlterIndex != nil && c.gmsAlterIndex.Resolved()
+}
+
+// BuildRowIter implements the interface sql.ExecBuilderNode.
+func (c *DropIndex) BuildRowIter(ctx *sql.Context, b sql.NodeExecBuilder, r sql.Row) (sql.RowIter, error) {
+	// The posting lists are found before the index is dropped, since the access method of the index holds the name of the posting table
+	var droppedIndexes []ginIndex
+	rt, ok := c.gmsAlterIndex.Table.(*plan.ResolvedTable)
+	if ok {
+		indexes, err := getGinIndexes(ctx, rt.Table)
+		if err != nil {
+			return nil, err
+		}
+		for _, index := range indexes {
+			if strings.EqualFold(index.Name, c.gmsAlterIndex.IndexName) {
+				droppedIndexes = append(droppedIndexes, index)
+			}
+		}
+	}
+	alterIndexIter, err := b.Build(ctx, c.gmsAlterIndex, r)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		if err = dropIndexAccessMethod(ctx, rt, c.gmsAlterIndex.IndexName); err != nil {
+			return nil, err
+		}
+	}
+	for _, index := range droppedIndexes {
+		if err = dropGinPostings(ctx, index); err != nil {
+			return nil, err
+		}
+	}
+	return alterIndexIter, nil
+}
+
+// Schema implements the interface sql.ExecBuilderNode.
+func (c *DropIndex) Schema(ctx *sql.Context) sql.Schema {
+	return c.gmsAlterIndex.Schema(ctx)
+}
+
+// String implements the interface sql.ExecBuilderNode.
+func (c *DropIndex) String() string {
+	return c.gmsAlterIndex.String()
+}
+
+// WithChildren implements the interface sql.ExecBuilderNode.
+func (c *DropIndex) WithChildren(ctx *sql.Context, children ...sql.Node) (sql.Node, error) {
+	gmsAlterIndex, err := c.gmsAlterIndex.WithChildren(ctx, children...)
+	if err != nil {
+		return nil, err
+	}
+	return &DropIndex{
+		gmsAlterIndex: gmsAlterIndex.(*plan.AlterIndex),
+	}, nil
+}
+
+// dropIndexAccessMethod removes the access method of the index with the given name on the given table, if the index
+// has one.
+func dropIndexAccessMethod(ctx *sql.Context, rt *plan.ResolvedTable, indexName string) error {
+	schemaName, err := core.GetSchemaName(ctx, rt.Database(), "")
+	if err != nil {
+		return err
+	}
+	collection, err := core.GetIndexAccessMethodsCollectionFromContext(ctx, rt.Database().Name())
+	if err != nil {
+		return err
+	}
+	for _, accessMethod := range collection.GetAccessMethodsForTable(ctx, id.NewTable(schemaName, rt.Name())) {
+		if strings.EqualFold(accessMethod.ID.IndexName(), indexName) {
+			return collection.DropAccessMethod(ctx, accessMethod.ID)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cockroachdb/errors"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+	"github.com/jackc/pgx/v5/pgproto3"
+
+	"github.com/dolthub/doltgresql/core/id"
+	"github.com/dolthub/doltgresql/server/dependencies"
+	"github.com/dolthub/doltgresql/utils"
+)
+
+// DropMaterializedView implements DROP MATERIALIZED VIEW.
+type DropMaterializedView struct {
+	Names    []doltdb.TableName
+	IfExists bool
+	Cascade  bool
+}
+
+var _ sql.ExecSourceRel = (*DropMaterializedView)(nil)
+var _ vitess.Injectable = (*DropMaterializedView)(nil)
+
+// Children implements the interface sql.ExecSourceRel.
+func (c *DropMaterializedView) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (c *DropMaterializedView) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (c *DropMaterializedView) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (c *DropMaterializedView) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	// Every materialized view is checked before any are dropped, so that an error leaves all of them intact
+	var statements []string
+	var objects []dependencies.Object
+	for _, name := range c.Names {
+		table, mv, err := resolveMaterializedView(ctx, name.Schema, name.Name)
+		if err != nil {
+			return nil, err
+		}
+		if table == nil {
+			if c.IfExists {
+				dsess.DSessFromSess(ctx.Session).Notice(&pgproto3.NoticeResponse{
+					Severity: "NOTICE",
+					Message:  fmt.Sprintf(`materialized view "%s" does not exist, skipping`, name.Name),
+				})
+				continue
+			}
+			return nil, errors.Errorf(`materialized view "%s" does not exist`, name.Name)
+		}
+		if !mv.ID.IsValid() {
+			return nil, errors.Errorf(`"%s" is not a materialized view`, name.Name)
+		}
+		objects = append(objects, dependencies.NewObject(dependencies.ObjectKind_MaterializedView,
+			id.NewTable(mv.ID.SchemaName(), mv.ID.ViewName()).AsId()))
+		// The materialized view's definition is removed alongside its table
+		statements = append(statements, fmt.Sprintf("DROP TABLE %s;",
+			utils.QuoteQualifiedIdentifier(mv.ID.SchemaName(), mv.ID.ViewName())))
+	}
+	if err := dependencies.PrepareDrop(ctx, c.Cascade, objects...); err != nil {
+		return nil, err
+	}
+	for _, statement := range statements {
+		if _, err := runNestedStatement(ctx, statement); err != nil {
+			return nil, err
+		}
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (c *DropMaterializedView) Schema(ctx *sql.Context) sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (c *DropMaterializedView) String() string {
+	return "DROP MATERIALIZED VIEW"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (c *DropMaterializedView) WithChildren(ctx *sql.Context, children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(c, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (c *DropMaterializedView) WithResolvedChildren(ctx context.Context, children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, ErrVitessChildCount.New(0, len(children))
+	}
+	return c, nil
+}
@@ -0,0 +1,116 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cockroachdb/errors"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+	"github.com/jackc/pgx/v5/pgproto3"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/id"
+)
+
+// DropPolicy implements DROP POLICY.
+type DropPolicy struct {
+	Name     string
+	Schema   string
+	Table    string
+	IfExists bool
+}
+
+var _ sql.ExecSourceRel = (*DropPolicy)(nil)
+var _ vitess.Injectable = (*DropPolicy)(nil)
+
+// Children implements the interface sql.ExecSourceRel.
+func (c *DropPolicy) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (c *DropPolicy) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (c *DropPolicy) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (c *DropPolicy) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	tableID, err := resolvePolicyTable(ctx, c.Schema, c.Table)
+	if err != nil {
+		return nil, err
+	}
+	if !tableID.IsValid() {
+		if c.IfExists {
+			dsess.DSessFromSess(ctx.Session).Notice(&pgproto3.NoticeResponse{
+				Severity: "NOTICE",
+				Message:  fmt.Sprintf(`relation "%s" does not exist, skipping`, c.Table),
+			})
+			return sql.RowsToRowIter(), nil
+		}
+		return nil, errors.Errorf(`relation "%s" does not exist`, c.Table)
+	}
+	collection, err := core.GetPoliciesCollectionFromContext(ctx, ctx.GetCurrentDatabase())
+	if err != nil {
+		return nil, err
+	}
+	policyID := id.NewPolicy(tableID.SchemaName(), tableID.TableName(), c.Name)
+	if !collection.HasPolicy(ctx, policyID) {
+		if c.IfExists {
+			dsess.DSessFromSess(ctx.Session).Notice(&pgproto3.NoticeResponse{
+				Severity: "NOTICE",
+				Message:  fmt.Sprintf(`policy "%s" for relation "%s" does not exist, skipping`, c.Name, c.Table),
+			})
+			return sql.RowsToRowIter(), nil
+		}
+		return nil, errors.Errorf(`policy "%s" for table "%s" does not exist`, c.Name, c.Table)
+	}
+	if err = collection.DropPolicy(ctx, policyID); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (c *DropPolicy) Schema(ctx *sql.Context) sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (c *DropPolicy) String() string {
+	return "DROP POLICY"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (c *DropPolicy) WithChildren(ctx *sql.Context, children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(c, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (c *DropPolicy) WithResolvedChildren(ctx context.Context, children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, ErrVitessChildCount.New(0, len(children))
+	}
+	return c, nil
+}
@@ -24,7 +24,7 @@ import (
 
 	"github.com/dolthub/doltgresql/core"
 	"github.com/dolthub/doltgresql/core/id"
-	"github.com/dolthub/doltgresql/core/procedures"
+	"github.com/dolthub/doltgresql/server/dependencies"
 )
 
 // DropProcedure implements DROP PROCEDURE.
@@ -78,13 +78,8 @@ func (d *DropProcedure) IsReadOnly() bool {
 
 // RowIter implements the interface sql.ExecSourceRel.
 func (d *DropProcedure) RowIter(ctx *sql.Context, r sql.Row) (iter sql.RowIter, err error) {
-	procColl, err := core.GetProceduresCollectionFromContext(ctx, "")
-	if err != nil {
-		return nil, err
-	}
-
 	for _, routineWithArgs := range d.RoutinesWithArgs {
-		err = dropProcedure(ctx, procColl, routineWithArgs, d.IfExists)
+		err = dropProcedure(ctx, routineWithArgs, d.IfExists, d.Cascade)
 		if err != nil {
 			return nil, err
 		}
@@ -101,12 +96,16 @@ func (d *DropProcedure) WithResolvedChildren(ctx context.Context, children []any
 	return d, nil
 }
 
-func dropProcedure(ctx *sql.Context, procColl *procedures.Collection, fn *RoutineWithParams, ifExists bool) error {
+func dropProcedure(ctx *sql.Context, fn *RoutineWithParams, ifExists bool, cascade bool) error {
 	// TODO: provide db
 	schema, err := core.GetSchemaName(ctx, nil, fn.SchemaName)
 	if err != nil {
 		return err
 	}
+	procColl, err := core.GetProceduresCollectionFromContext(ctx, "")
+	if err != nil {
+		return err
+	}
 
 	var procId = id.NewProcedure(schema, fn.RoutineName)
 	if fn.NoArgDefined {
@@ -133,5 +132,12 @@ func dropProcedure(ctx *sql.Context, procColl *procedures.Collection, fn *Routin
 	if !procExists && ifExists {
 		return nil
 	}
+	if err = dependencies.PrepareDrop(ctx, cascade, dependencies.NewObject(dependencies.ObjectKind_Procedure, procId.AsId())); err != nil {
+		return err
+	}
+	// Dropping the dependent objects may have replaced the collection, so we load it again
+	if procColl, err = core.GetProceduresCollectionFromContext(ctx, ""); err != nil {
+		return err
+	}
 	return procColl.DropProcedure(ctx, procId)
 }
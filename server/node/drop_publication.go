@@ -0,0 +1,100 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+	"github.com/jackc/pgx/v5/pgproto3"
+
+	"github.com/dolthub/doltgresql/server/walsender"
+)
+
+// DropPublication handles the DROP PUBLICATION statement.
+type DropPublication struct {
+	Names    []string
+	IfExists bool
+}
+
+var _ sql.ExecSourceRel = (*DropPublication)(nil)
+var _ vitess.Injectable = (*DropPublication)(nil)
+
+// Children implements the interface sql.ExecSourceRel.
+func (c *DropPublication) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (c *DropPublication) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (c *DropPublication) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (c *DropPublication) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	// Every publication is checked before any are dropped, so that an error leaves all of them intact
+	var names []string
+	for _, name := range c.Names {
+		if _, ok := walsender.GetPublication(ctx.GetCurrentDatabase(), name); !ok && c.IfExists {
+			dsess.DSessFromSess(ctx.Session).Notice(&pgproto3.NoticeResponse{
+				Severity: "NOTICE",
+				Message:  fmt.Sprintf(`publication "%s" does not exist, skipping`, name),
+			})
+			continue
+		}
+		if _, err := checkPublicationOwner(ctx, name); err != nil {
+			return nil, err
+		}
+		names = append(names, name)
+	}
+	for _, name := range names {
+		if err := walsender.DropPublication(ctx.GetCurrentDatabase(), name); err != nil {
+			return nil, err
+		}
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (c *DropPublication) Schema(ctx *sql.Context) sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (c *DropPublication) String() string {
+	return "DROP PUBLICATION"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (c *DropPublication) WithChildren(ctx *sql.Context, children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(c, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (c *DropPublication) WithResolvedChildren(ctx context.Context, children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, ErrVitessChildCount.New(0, len(children))
+	}
+	return c, nil
+}
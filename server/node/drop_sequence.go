@@ -24,6 +24,8 @@ import (
 
 	"github.com/dolthub/doltgresql/core"
 	"github.com/dolthub/doltgresql/core/id"
+	"github.com/dolthub/doltgresql/server/dependencies"
+	"github.com/dolthub/doltgresql/server/temporary"
 )
 
 // DropSequence handles the DROP SEQUENCE statement.
@@ -64,6 +66,18 @@ func (c *DropSequence) Resolved() bool {
 
 // RowIter implements the interface sql.ExecSourceRel.
 func (c *DropSequence) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	// The session's temporary sequences are found ahead of the schemas on the search path
+	if c.schema == "" || temporary.IsSchema(ctx.Session.ID(), c.schema) {
+		if temporary.DropSequence(ctx.Session.ID(), ctx.GetCurrentDatabase(), c.sequence) {
+			return sql.RowsToRowIter(), nil
+		}
+		if c.schema != "" {
+			if c.ifExists {
+				return sql.RowsToRowIter(), nil
+			}
+			return nil, errors.Errorf(`sequence "%s" does not exist`, c.sequence)
+		}
+	}
 	schema, err := core.GetSchemaName(ctx, nil, c.schema)
 	if err != nil {
 		return nil, err
@@ -79,26 +93,16 @@ func (c *DropSequence) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error)
 		}
 		return nil, errors.Errorf(`sequence "%s" does not exist`, c.sequence)
 	}
+	sequenceID := id.NewSequence(schema, c.sequence)
+	if err = dependencies.PrepareDrop(ctx, c.cascade, dependencies.NewObject(dependencies.ObjectKind_Sequence, sequenceID.AsId())); err != nil {
+		return nil, err
+	}
 	// TODO: we always use the current database for this operation, but it should also be possible drop a sequence in
 	//  a different DB (e.g. on a different branch)
 	collection, err := core.GetSequencesCollectionFromContext(ctx, ctx.GetCurrentDatabase())
 	if err != nil {
 		return nil, err
 	}
-	sequenceID := id.NewSequence(schema, c.sequence)
-	sequence, err := collection.GetSequence(ctx, sequenceID)
-	if err != nil {
-		return nil, err
-	}
-	if sequence.OwnerTable.IsValid() {
-		if c.cascade {
-			// TODO: if the sequence is referenced by the column's default value, then we also need to delete the default
-			return nil, errors.Errorf(`cascading sequence drops are not yet supported`)
-		} else {
-			// TODO: this error is only true if the sequence is referenced by the column's default value
-			return nil, errors.Errorf(`cannot drop sequence %s because other objects depend on it`, c.sequence)
-		}
-	}
 	if err = collection.DropSequence(ctx, sequenceID); err != nil {
 		return nil, err
 	}
@@ -0,0 +1,93 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+	"github.com/jackc/pgx/v5/pgproto3"
+
+	"github.com/dolthub/doltgresql/server/logrepl"
+)
+
+// DropSubscription handles the DROP SUBSCRIPTION statement.
+type DropSubscription struct {
+	Name     string
+	IfExists bool
+}
+
+var _ sql.ExecSourceRel = (*DropSubscription)(nil)
+var _ vitess.Injectable = (*DropSubscription)(nil)
+
+// Children implements the interface sql.ExecSourceRel.
+func (c *DropSubscription) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (c *DropSubscription) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (c *DropSubscription) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (c *DropSubscription) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	if _, ok := logrepl.GetSubscription(c.Name); !ok && c.IfExists {
+		dsess.DSessFromSess(ctx.Session).Notice(&pgproto3.NoticeResponse{
+			Severity: "NOTICE",
+			Message:  fmt.Sprintf(`subscription "%s" does not exist, skipping`, c.Name),
+		})
+		return sql.RowsToRowIter(), nil
+	}
+	if _, err := checkSubscriptionOwner(ctx, c.Name); err != nil {
+		return nil, err
+	}
+	if err := logrepl.DropSubscription(c.Name); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (c *DropSubscription) Schema(ctx *sql.Context) sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (c *DropSubscription) String() string {
+	return "DROP SUBSCRIPTION"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (c *DropSubscription) WithChildren(ctx *sql.Context, children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(c, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (c *DropSubscription) WithResolvedChildren(ctx context.Context, children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, ErrVitessChildCount.New(0, len(children))
+	}
+	return c, nil
+}
@@ -22,6 +22,7 @@ import (
 
 	"github.com/dolthub/doltgresql/core"
 	"github.com/dolthub/doltgresql/core/id"
+	"github.com/dolthub/doltgresql/server/temporary"
 )
 
 // DropTable is a node that implements functionality specifically relevant to Doltgres' table dropping needs.
@@ -55,6 +56,17 @@ func (c *DropTable) Resolved() bool {
 
 // BuildRowIter implements the interface sql.ExecBuilderNode.
 func (c *DropTable) BuildRowIter(ctx *sql.Context, b sql.NodeExecBuilder, r sql.Row) (sql.RowIter, error) {
+	// The posting lists of GIN indexes are stored in their own tables, so we find them before the tables are dropped
+	var ginIndexes []ginIndex
+	for _, table := range c.gmsDropTable.Tables {
+		if rt, ok := table.(*plan.ResolvedTable); ok {
+			indexes, err := getGinIndexes(ctx, rt.Table)
+			if err != nil {
+				return nil, err
+			}
+			ginIndexes = append(ginIndexes, indexes...)
+		}
+	}
 	dropTableIter, err := b.Build(ctx, c.gmsDropTable, r)
 	if err != nil {
 		return nil, err
@@ -76,6 +88,10 @@ func (c *DropTable) BuildRowIter(ctx *sql.Context, b sql.NodeExecBuilder, r sql.
 			return nil, errors.Errorf("encountered unexpected table type `%T` during DROP TABLE", table)
 		}
 
+		// Temporary tables may own temporary sequences and ON COMMIT actions, which are held by the session
+		if temporaryTable, ok := table.(*plan.ResolvedTable).Table.(sql.TemporaryTable); ok && temporaryTable.IsTemporary() {
+			temporary.RemoveTable(ctx.Session.ID(), dbName, tableName)
+		}
 		tableID := id.NewTable(schemaName, tableName).AsId()
 		if err = id.ValidateOperation(ctx, id.Section_Table, id.Operation_Delete, dbName, tableID, id.Null); err != nil {
 			return nil, err
@@ -84,6 +100,11 @@ func (c *DropTable) BuildRowIter(ctx *sql.Context, b sql.NodeExecBuilder, r sql.
 			return nil, err
 		}
 	}
+	for _, index := range ginIndexes {
+		if err = dropGinPostings(ctx, index); err != nil {
+			return nil, err
+		}
+	}
 	return dropTableIter, err
 }
 
@@ -27,6 +27,7 @@ import (
 	"github.com/dolthub/doltgresql/core"
 	"github.com/dolthub/doltgresql/core/id"
 	"github.com/dolthub/doltgresql/server/auth"
+	"github.com/dolthub/doltgresql/server/dependencies"
 	"github.com/dolthub/doltgresql/server/types"
 )
 
@@ -103,11 +104,6 @@ func (c *DropType) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
 			return nil, types.ErrTypeDoesNotExist.New(c.typName)
 		}
 	}
-	if c.cascade {
-		// TODO: handle cascade
-		return nil, errors.Errorf(`cascading type drops are not yet supported`)
-	}
-
 	if _, ok := types.IDToBuiltInDoltgresType[typ.ID]; ok {
 		return nil, types.ErrCannotDropSystemType.New(typ.String())
 	}
@@ -120,32 +116,13 @@ func (c *DropType) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
 		return nil, types.ErrCannotDropArrayType.New(arrTypeName, strings.TrimSuffix(arrTypeName, "[]"))
 	}
 
-	// iterate on all table columns to check if this type is currently used.
-	db, err := core.GetSqlDatabaseFromContext(ctx, "")
-	if err != nil {
+	if err = dependencies.PrepareDrop(ctx, c.cascade, dependencies.NewObject(dependencies.ObjectKind_Type, typeID.AsId())); err != nil {
 		return nil, err
 	}
-	tableNames, err := db.GetTableNames(ctx)
-	if err != nil {
+	// Dropping the dependent objects may have replaced the collection, so we load it again
+	if collection, err = core.GetTypesCollectionFromContext(ctx, ""); err != nil {
 		return nil, err
 	}
-	for _, tableName := range tableNames {
-		t, ok, err := db.GetTableInsensitive(ctx, tableName)
-		if err != nil {
-			return nil, err
-		}
-		if ok {
-			for _, col := range t.Schema(ctx) {
-				if dt, isDoltgresType := col.Type.(*types.DoltgresType); isDoltgresType {
-					if dt.Name() == typ.Name() {
-						// TODO: issue a detail (list of all columns and tables that uses this type)
-						//  and a hint (when we support CASCADE)
-						return nil, errors.Errorf(`cannot drop type %s because other objects depend on it - column %s of table %s depends on type %s'`, c.typName, col.Name, t.Name(), c.typName)
-					}
-				}
-			}
-		}
-	}
 
 	if err = collection.DropType(ctx, typeID); err != nil {
 		return nil, err
@@ -0,0 +1,97 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/id"
+	"github.com/dolthub/doltgresql/server/dependencies"
+)
+
+// DropView is a node that ensures that no other objects depend on the views being dropped.
+type DropView struct {
+	gmsDropView *plan.DropView
+}
+
+var _ sql.ExecBuilderNode = (*DropView)(nil)
+
+// NewDropView returns a new *DropView.
+func NewDropView(dropView *plan.DropView) *DropView {
+	return &DropView{
+		gmsDropView: dropView,
+	}
+}
+
+// Children implements the interface sql.ExecBuilderNode.
+func (c *DropView) Children() []sql.Node {
+	return c.gmsDropView.Children()
+}
+
+// IsReadOnly implements the interface sql.ExecBuilderNode.
+func (c *DropView) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecBuilderNode.
+func (c *DropView) Resolved() bool {
+	return c.gmsDropView != nil && c.gmsDropView.Resolved()
+}
+
+// BuildRowIter implements the interface sql.ExecBuilderNode.
+func (c *DropView) BuildRowIter(ctx *sql.Context, b sql.NodeExecBuilder, r sql.Row) (sql.RowIter, error) {
+	var objects []dependencies.Object
+	for _, child := range c.gmsDropView.Children() {
+		singleDropView, ok := child.(*plan.SingleDropView)
+		if !ok {
+			return nil, errors.Errorf("encountered unexpected view type `%T` during DROP VIEW", child)
+		}
+		schemaName, err := core.GetSchemaName(ctx, singleDropView.Database(), "")
+		if err != nil {
+			return nil, err
+		}
+		viewID := id.NewView(schemaName, singleDropView.ViewName)
+		objects = append(objects, dependencies.NewObject(dependencies.ObjectKind_View, viewID.AsId()))
+	}
+	// CASCADE drops the dependent objects before this statement runs, so anything that remains is an error
+	if err := dependencies.PrepareDrop(ctx, false, objects...); err != nil {
+		return nil, err
+	}
+	return b.Build(ctx, c.gmsDropView, r)
+}
+
+// Schema implements the interface sql.ExecBuilderNode.
+func (c *DropView) Schema(ctx *sql.Context) sql.Schema {
+	return c.gmsDropView.Schema(ctx)
+}
+
+// String implements the interface sql.ExecBuilderNode.
+func (c *DropView) String() string {
+	return c.gmsDropView.String()
+}
+
+// WithChildren implements the interface sql.ExecBuilderNode.
+func (c *DropView) WithChildren(ctx *sql.Context, children ...sql.Node) (sql.Node, error) {
+	gmsDropView, err := c.gmsDropView.WithChildren(ctx, children...)
+	if err != nil {
+		return nil, err
+	}
+	return &DropView{
+		gmsDropView: gmsDropView.(*plan.DropView),
+	}, nil
+}
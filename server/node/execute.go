@@ -0,0 +1,86 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+)
+
+// ExecuteStatement handles the EXECUTE statement. The analyzer replaces this node with the prepared statement that it
+// executes, with the parameters bound to the given values, so that the prepared statement is planned as though it
+// were run directly.
+type ExecuteStatement struct {
+	Name   string
+	Params []vitess.Expr
+}
+
+var _ vitess.Injectable = (*ExecuteStatement)(nil)
+var _ sql.ExecSourceRel = (*ExecuteStatement)(nil)
+
+// Children implements the interface sql.ExecSourceRel.
+func (e *ExecuteStatement) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (e *ExecuteStatement) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (e *ExecuteStatement) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (e *ExecuteStatement) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	return nil, errors.New("EXECUTE should have been replaced by the prepared statement during analysis")
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (e *ExecuteStatement) Schema(ctx *sql.Context) sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (e *ExecuteStatement) String() string {
+	if len(e.Params) == 0 {
+		return "EXECUTE " + e.Name
+	}
+	params := make([]string, len(e.Params))
+	for i, param := range e.Params {
+		params[i] = vitess.String(param)
+	}
+	return "EXECUTE " + e.Name + "(" + strings.Join(params, ", ") + ")"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (e *ExecuteStatement) WithChildren(ctx *sql.Context, children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(e, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (e *ExecuteStatement) WithResolvedChildren(ctx context.Context, children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, ErrVitessChildCount.New(0, len(children))
+	}
+	return e, nil
+}
@@ -0,0 +1,592 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cockroachdb/apd/v3"
+	"github.com/cockroachdb/errors"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+	"github.com/dolthub/doltgresql/utils"
+)
+
+// ginMaxEntryLength is the longest entry that is stored as-is within a posting table. Longer entries are stored as a
+// hash, which may cause false positives that are removed when the matching rows are rechecked.
+const ginMaxEntryLength = 256
+
+const (
+	// ginKeylessNull is a NULL key value of a keyless index.
+	ginKeylessNull = "n"
+	// ginKeylessValuePrefix precedes every non-NULL key value of a keyless index.
+	ginKeylessValuePrefix = "v"
+)
+
+// ginIndex is a GIN index on a table. A GIN index stores a posting list for every entry (such as a JSON key or array
+// element) that is extracted from the values of the indexed column. The posting lists are stored within a hidden table,
+// where each row holds an entry along with the primary key of a row that the entry was extracted from. Materialized
+// views do not have a primary key, so their rows are referenced by every column instead, which is marked by Keyless.
+type ginIndex struct {
+	Name       string
+	Schema     string
+	Postings   string
+	OpClass    string
+	Column     int
+	KeyColumns []int
+	Keyless    bool
+}
+
+// ginStrategy states how the posting lists of a GIN query's entries are combined.
+type ginStrategy uint8
+
+const (
+	// ginStrategy_All matches the rows that contain every entry.
+	ginStrategy_All ginStrategy = iota
+	// ginStrategy_Any matches the rows that contain at least one entry.
+	ginStrategy_Any
+)
+
+// getGinIndexes returns all GIN indexes on the given table.
+func getGinIndexes(ctx *sql.Context, table sql.Table) ([]ginIndex, error) {
+	for {
+		wrapper, ok := table.(sql.TableWrapper)
+		if !ok {
+			break
+		}
+		table = wrapper.Underlying()
+	}
+	indexAddressable, ok := table.(sql.IndexAddressable)
+	if !ok {
+		return nil, nil
+	}
+	indexes, err := indexAddressable.GetIndexes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	schemaName := ""
+	if schemaTable, ok := table.(sql.DatabaseSchemaTable); ok {
+		schemaName = schemaTable.DatabaseSchema().SchemaName()
+	}
+	var ginIndexes []ginIndex
+	for _, index := range indexes {
+		accessMethod, err := core.GetIndexAccessMethod(ctx, schemaName, index)
+		if err != nil {
+			return nil, err
+		}
+		if !accessMethod.IsGin() {
+			continue
+		}
+		sch := ginTableSchema(ctx, table)
+		expressions := index.Expressions()
+		if len(expressions) != 1 {
+			return nil, errors.Errorf(`GIN index "%s" has an invalid definition`, index.ID())
+		}
+		column := sch.IndexOfColName(expressions[0][strings.LastIndex(expressions[0], ".")+1:])
+		if column == -1 {
+			return nil, errors.Errorf(`GIN index "%s" has an invalid definition`, index.ID())
+		}
+		keyColumns, keyless := ginKeyColumns(sch)
+		opClass := accessMethod.OpClass
+		if len(opClass) == 0 {
+			typ, ok := sch[column].Type.(*pgtypes.DoltgresType)
+			if !ok {
+				return nil, errors.Errorf(`GIN index "%s" has an invalid definition`, index.ID())
+			}
+			opClass = ginDefaultOpClass(typ)
+		}
+		ginIndexes = append(ginIndexes, ginIndex{
+			Name:       index.ID(),
+			Schema:     schemaName,
+			Postings:   accessMethod.Postings,
+			OpClass:    opClass,
+			Column:     column,
+			KeyColumns: keyColumns,
+			Keyless:    keyless,
+		})
+	}
+	return ginIndexes, nil
+}
+
+// ginKeyColumns returns the columns that the posting lists use to reference the rows of a table with the given schema,
+// which are the primary key columns. If the table does not have a primary key, then every column is returned, and
+// keyless is true.
+func ginKeyColumns(sch sql.Schema) (keyColumns []int, keyless bool) {
+	for i, col := range sch {
+		if col.PrimaryKey {
+			keyColumns = append(keyColumns, i)
+		}
+	}
+	if len(keyColumns) > 0 {
+		return keyColumns, false
+	}
+	keyColumns = make([]int, len(sch))
+	for i := range sch {
+		keyColumns[i] = i
+	}
+	return keyColumns, true
+}
+
+// ginTableSchema returns the full schema of the table, as the schema of a table with projections only contains the
+// projected columns.
+func ginTableSchema(ctx *sql.Context, table sql.Table) sql.Schema {
+	if pkTable, ok := table.(sql.PrimaryKeyTable); ok {
+		return pkTable.PrimaryKeySchema(ctx).Schema
+	}
+	return table.Schema(ctx)
+}
+
+// ginDefaultOpClass returns the default GIN operator class for the given type. Returns an empty string if the type
+// does not have a default operator class.
+func ginDefaultOpClass(typ *pgtypes.DoltgresType) string {
+	if typ.ID == pgtypes.JsonB.ID {
+		return "jsonb_ops"
+	}
+	if typ.IsArrayType() {
+		return "array_ops"
+	}
+	return ""
+}
+
+// ginOpClassAcceptsType returns whether the GIN operator class accepts the given type. Returns an error if the operator
+// class does not exist.
+func ginOpClassAcceptsType(opClass string, typ *pgtypes.DoltgresType) (bool, error) {
+	switch opClass {
+	case "jsonb_ops", "jsonb_path_ops":
+		return typ.ID == pgtypes.JsonB.ID, nil
+	case "array_ops":
+		return typ.IsArrayType(), nil
+	default:
+		return false, errors.Errorf(`operator class "%s" does not exist for access method "gin"`, opClass)
+	}
+}
+
+// PostingRows returns the rows of the posting table that belong to the given table row.
+func (gin ginIndex) PostingRows(ctx *sql.Context, sch sql.Schema, row sql.Row) ([]sql.Row, error) {
+	entries, err := ginEntries(ctx, gin.OpClass, sch[gin.Column].Type, row[gin.Column])
+	if err != nil || len(entries) == 0 {
+		return nil, err
+	}
+	key, err := gin.RowKey(ctx, sch, row)
+	if err != nil {
+		return nil, err
+	}
+	postingRows := make([]sql.Row, len(entries))
+	for i, entry := range entries {
+		postingRow := make(sql.Row, 1, len(key)+1)
+		postingRow[0] = entry
+		postingRows[i] = append(postingRow, key...)
+	}
+	return postingRows, nil
+}
+
+// RowKey returns the primary key of the given table row, in the form that it is stored within the posting table. The
+// key columns of a keyless index may be NULL, so each value is prefixed to distinguish it from NULL (see
+// ginKeylessNull).
+func (gin ginIndex) RowKey(ctx *sql.Context, sch sql.Schema, row sql.Row) ([]any, error) {
+	key := make([]any, len(gin.KeyColumns))
+	for i, keyColumn := range gin.KeyColumns {
+		if gin.Keyless && row[keyColumn] == nil {
+			key[i] = ginKeylessNull
+			continue
+		}
+		var output string
+		if dgType, ok := sch[keyColumn].Type.(*pgtypes.DoltgresType); ok {
+			var err error
+			if output, err = dgType.IoOutput(ctx, row[keyColumn]); err != nil {
+				return nil, err
+			}
+		} else {
+			sqlVal, err := sch[keyColumn].Type.SQL(ctx, nil, row[keyColumn])
+			if err != nil {
+				return nil, err
+			}
+			output = sqlVal.ToString()
+		}
+		if gin.Keyless {
+			output = ginKeylessValuePrefix + output
+		}
+		key[i] = output
+	}
+	return key, nil
+}
+
+// KeyCondition returns the condition that matches the table rows with the given key, as it was returned by RowKey.
+func (gin ginIndex) KeyCondition(sch sql.Schema, key sql.Row) string {
+	conditions := make([]string, len(gin.KeyColumns))
+	for i, keyColumn := range gin.KeyColumns {
+		column := utils.QuoteIdentifier(sch[keyColumn].Name)
+		keyVal := key[i].(string)
+		switch {
+		case !gin.Keyless:
+			conditions[i] = fmt.Sprintf("%s = %s", column, quoteString(keyVal))
+		case keyVal == ginKeylessNull:
+			conditions[i] = fmt.Sprintf("%s IS NULL", column)
+		default:
+			// Not every type has an equality operator, so the output of the value is compared instead
+			conditions[i] = fmt.Sprintf("%s::text = %s", column, quoteString(strings.TrimPrefix(keyVal, ginKeylessValuePrefix)))
+		}
+	}
+	return "(" + strings.Join(conditions, " AND ") + ")"
+}
+
+// PostingColumns returns the quoted names of the posting table's key columns.
+func (gin ginIndex) PostingColumns() []string {
+	columns := make([]string, len(gin.KeyColumns))
+	for i := range gin.KeyColumns {
+		columns[i] = utils.QuoteIdentifier(fmt.Sprintf("key%d", i+1))
+	}
+	return columns
+}
+
+// ginQueryEntries returns the entries that the given operator searches for within the index, along with how their
+// posting lists are combined. Returns false if the index's operator class does not support the operator.
+func ginQueryEntries(ctx *sql.Context, opClass string, operator framework.Operator, typ sql.Type, val any) ([]string, ginStrategy, bool, error) {
+	switch opClass {
+	case "jsonb_ops":
+		switch operator {
+		case framework.Operator_BinaryJSONContainsRight:
+			entries, err := ginEntries(ctx, opClass, typ, val)
+			return entries, ginStrategy_All, true, err
+		case framework.Operator_BinaryJSONTopLevel:
+			if val == nil {
+				return nil, ginStrategy_Any, true, nil
+			}
+			key, err := framework.UnwrapString(ctx, val)
+			if err != nil {
+				return nil, ginStrategy_All, false, err
+			}
+			return []string{ginEntry("K" + key)}, ginStrategy_All, true, nil
+		case framework.Operator_BinaryJSONTopLevelAny, framework.Operator_BinaryJSONTopLevelAll:
+			strategy := ginStrategy_All
+			if operator == framework.Operator_BinaryJSONTopLevelAny {
+				strategy = ginStrategy_Any
+			}
+			if val == nil {
+				return nil, ginStrategy_Any, true, nil
+			}
+			keys, ok := val.([]any)
+			if !ok {
+				return nil, strategy, false, nil
+			}
+			entries := make(map[string]struct{}, len(keys))
+			for _, key := range keys {
+				if key == nil {
+					continue
+				}
+				keyStr, err := framework.UnwrapString(ctx, key)
+				if err != nil {
+					return nil, strategy, false, err
+				}
+				entries[ginEntry("K"+keyStr)] = struct{}{}
+			}
+			return sortedGinEntries(entries), strategy, true, nil
+		}
+	case "jsonb_path_ops":
+		if operator == framework.Operator_BinaryJSONContainsRight {
+			entries, err := ginEntries(ctx, opClass, typ, val)
+			return entries, ginStrategy_All, true, err
+		}
+	case "array_ops":
+		switch operator {
+		case framework.Operator_BinaryJSONContainsRight:
+			entries, err := ginEntries(ctx, opClass, typ, val)
+			return entries, ginStrategy_All, true, err
+		case framework.Operator_BinaryOverlap:
+			entries, err := ginEntries(ctx, opClass, typ, val)
+			return entries, ginStrategy_Any, true, err
+		}
+	}
+	return nil, ginStrategy_All, false, nil
+}
+
+// ginEntries returns the entries that the operator class extracts from the given value.
+func ginEntries(ctx *sql.Context, opClass string, typ sql.Type, val any) ([]string, error) {
+	if val == nil {
+		return nil, nil
+	}
+	entries := make(map[string]struct{})
+	switch opClass {
+	case "jsonb_ops", "jsonb_path_ops":
+		wrapper, ok := val.(sql.JSONWrapper)
+		if !ok {
+			return nil, errors.Errorf("expected a JSON value for GIN operator class %s but received %T", opClass, val)
+		}
+		doc, err := wrapper.ToInterface(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if opClass == "jsonb_ops" {
+			// Primitive values at the top level are treated as the elements of an array, as they are in Postgres
+			if _, ok = doc.([]any); !ok {
+				if _, ok = doc.(map[string]any); !ok {
+					doc = []any{doc}
+				}
+			}
+			ginJsonEntries(doc, entries)
+		} else {
+			ginJsonPathEntries(doc, "", entries)
+		}
+	case "array_ops":
+		dgType, ok := typ.(*pgtypes.DoltgresType)
+		if !ok || !dgType.IsArrayType() {
+			return nil, errors.Errorf("expected an array type for GIN operator class %s but received %s", opClass, typ.String())
+		}
+		array, ok := val.([]any)
+		if !ok {
+			return nil, errors.Errorf("expected an array value for GIN operator class %s but received %T", opClass, val)
+		}
+		baseType := dgType.ArrayBaseType()
+		for _, element := range array {
+			// NULL elements are never matched by any operator
+			if element == nil {
+				continue
+			}
+			output, err := baseType.IoOutput(ctx, element)
+			if err != nil {
+				return nil, err
+			}
+			entries[ginEntry(output)] = struct{}{}
+		}
+	default:
+		return nil, errors.Errorf(`operator class "%s" does not exist for access method "gin"`, opClass)
+	}
+	return sortedGinEntries(entries), nil
+}
+
+// ginJsonEntries adds the entries of the jsonb_ops operator class to the given set. Object keys and the strings that
+// are elements of arrays are stored as keys, so that they're found by the existence operators, while all other values
+// are stored as values.
+func ginJsonEntries(doc any, entries map[string]struct{}) {
+	switch doc := doc.(type) {
+	case map[string]any:
+		for key, value := range doc {
+			entries[ginEntry("K"+key)] = struct{}{}
+			switch value.(type) {
+			case map[string]any, []any:
+				ginJsonEntries(value, entries)
+			default:
+				entries[ginEntry("V"+ginJsonScalar(value))] = struct{}{}
+			}
+		}
+	case []any:
+		for _, element := range doc {
+			switch element := element.(type) {
+			case map[string]any, []any:
+				ginJsonEntries(element, entries)
+			case string:
+				entries[ginEntry("K"+element)] = struct{}{}
+			default:
+				entries[ginEntry("V"+ginJsonScalar(element))] = struct{}{}
+			}
+		}
+	}
+}
+
+// ginJsonPathEntries adds the entries of the jsonb_path_ops operator class to the given set. Each primitive value is
+// stored along with the object keys that lead to it, while array positions are ignored.
+func ginJsonPathEntries(doc any, path string, entries map[string]struct{}) {
+	switch doc := doc.(type) {
+	case map[string]any:
+		for key, value := range doc {
+			// The length prefix keeps keys containing the separator from colliding
+			ginJsonPathEntries(value, fmt.Sprintf("%s%d:%s;", path, len(key), key), entries)
+		}
+	case []any:
+		for _, element := range doc {
+			ginJsonPathEntries(element, path, entries)
+		}
+	default:
+		entries[ginEntry(path+"="+ginJsonScalar(doc))] = struct{}{}
+	}
+}
+
+// ginJsonScalar returns the entry text of a primitive JSON value. Numbers are normalized so that numerically equal
+// values have the same text.
+func ginJsonScalar(val any) string {
+	switch val := val.(type) {
+	case nil:
+		return "n"
+	case bool:
+		if val {
+			return "t"
+		}
+		return "f"
+	case string:
+		return "s" + val
+	default:
+		if d, ok := pgtypes.JsonNumberToDecimal(val); ok {
+			reduced, _ := new(apd.Decimal).Reduce(d)
+			return "d" + reduced.String()
+		}
+		return fmt.Sprintf("?%v", val)
+	}
+}
+
+// ginEntry returns the entry as it's stored within a posting table.
+func ginEntry(entry string) string {
+	if len(entry) <= ginMaxEntryLength {
+		return entry
+	}
+	hash := sha256.Sum256([]byte(entry))
+	return "#" + hex.EncodeToString(hash[:])
+}
+
+// sortedGinEntries returns the set of entries as a sorted slice.
+func sortedGinEntries(entries map[string]struct{}) []string {
+	sortedEntries := make([]string, 0, len(entries))
+	for entry := range entries {
+		sortedEntries = append(sortedEntries, entry)
+	}
+	sort.Strings(sortedEntries)
+	return sortedEntries
+}
+
+// postingsTable returns the table that holds the index's posting lists.
+func (gin ginIndex) postingsTable(ctx *sql.Context) (sql.Table, error) {
+	table, err := core.GetSqlTableFromContext(ctx, "", doltdb.TableName{Name: gin.Postings, Schema: gin.Schema})
+	if err != nil {
+		return nil, err
+	}
+	if table == nil {
+		return nil, errors.Errorf(`GIN index "%s" is missing its posting lists`, gin.Name)
+	}
+	return table, nil
+}
+
+// applyPostings removes the deleted rows from the index's posting lists, and then adds the inserted rows.
+func (gin ginIndex) applyPostings(ctx *sql.Context, deletedRows []sql.Row, insertedRows []sql.Row) error {
+	if len(deletedRows) == 0 && len(insertedRows) == 0 {
+		return nil
+	}
+	table, err := gin.postingsTable(ctx)
+	if err != nil {
+		return err
+	}
+	if len(deletedRows) > 0 {
+		deletable, ok := table.(sql.DeletableTable)
+		if !ok {
+			return errors.Errorf(`GIN index "%s" does not support updates`, gin.Name)
+		}
+		deleter := deletable.Deleter(ctx)
+		if err = applyMaterializedViewEdit(ctx, deleter, func() error {
+			for _, row := range deletedRows {
+				if err := deleter.Delete(ctx, row); err != nil {
+					return err
+				}
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+	if len(insertedRows) > 0 {
+		insertable, ok := table.(sql.InsertableTable)
+		if !ok {
+			return errors.Errorf(`GIN index "%s" does not support updates`, gin.Name)
+		}
+		inserter := insertable.Inserter(ctx)
+		if err = applyMaterializedViewEdit(ctx, inserter, func() error {
+			for _, row := range insertedRows {
+				if err := inserter.Insert(ctx, row); err != nil {
+					return err
+				}
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ginOpClassSupportsOperator returns whether the index of the given operator class may be used to search for the
+// operator, with the indexed column on the left side.
+func ginOpClassSupportsOperator(opClass string, operator framework.Operator) bool {
+	switch opClass {
+	case "jsonb_ops":
+		switch operator {
+		case framework.Operator_BinaryJSONContainsRight, framework.Operator_BinaryJSONTopLevel,
+			framework.Operator_BinaryJSONTopLevelAny, framework.Operator_BinaryJSONTopLevelAll:
+			return true
+		}
+	case "jsonb_path_ops":
+		return operator == framework.Operator_BinaryJSONContainsRight
+	case "array_ops":
+		return operator == framework.Operator_BinaryJSONContainsRight || operator == framework.Operator_BinaryOverlap
+	}
+	return false
+}
+
+// rebuildPostings replaces the index's posting lists with those of the given rows, which must be every row of the
+// table. Identical rows share the same posting rows, which only happens on materialized views, as they're keyless.
+func (gin ginIndex) rebuildPostings(ctx *sql.Context, sch sql.Schema, rows []sql.Row) error {
+	if err := gin.truncatePostings(ctx); err != nil {
+		return err
+	}
+	postingRows := make(map[string]sql.Row)
+	for _, row := range rows {
+		rowPostings, err := gin.PostingRows(ctx, sch, row)
+		if err != nil {
+			return err
+		}
+		for _, postingRow := range rowPostings {
+			postingRows[ginPostingKey(postingRow)] = postingRow
+		}
+	}
+	insertedRows := make([]sql.Row, 0, len(postingRows))
+	for _, postingRow := range postingRows {
+		insertedRows = append(insertedRows, postingRow)
+	}
+	return gin.applyPostings(ctx, nil, insertedRows)
+}
+
+// truncatePostings removes every row from the index's posting lists.
+func (gin ginIndex) truncatePostings(ctx *sql.Context) error {
+	table, err := gin.postingsTable(ctx)
+	if err != nil {
+		return err
+	}
+	truncatable, ok := table.(sql.TruncateableTable)
+	if !ok {
+		return errors.Errorf(`GIN index "%s" does not support updates`, gin.Name)
+	}
+	_, err = truncatable.Truncate(ctx)
+	return err
+}
+
+// ginPostingKey returns a string that uniquely identifies the given posting row.
+func ginPostingKey(postingRow sql.Row) string {
+	keyParts := make([]string, len(postingRow))
+	for i, val := range postingRow {
+		// The length prefix keeps values containing the separator from colliding
+		keyParts[i] = fmt.Sprintf("%d:%s", len(val.(string)), val.(string))
+	}
+	return strings.Join(keyParts, ";")
+}
+
+// dropGinPostings drops the table that holds the index's posting lists.
+func dropGinPostings(ctx *sql.Context, index ginIndex) error {
+	_, err := runNestedStatement(ctx, fmt.Sprintf("DROP TABLE IF EXISTS %s;", utils.QuoteQualifiedIdentifier(index.Schema, index.Postings)))
+	return err
+}
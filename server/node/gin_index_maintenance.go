@@ -0,0 +1,337 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// GinIndexMaintenance wraps the destination of an INSERT, UPDATE, DELETE, or TRUNCATE so that the posting lists of the
+// table's GIN indexes are kept in sync with the rows that are written. This works similarly to the foreign key
+// handler, where the editors of the original table are wrapped by editors that also record the changes to the posting
+// lists.
+type GinIndexMaintenance struct {
+	Table        sql.Table
+	Sch          sql.Schema
+	OriginalNode sql.Node
+	Indexes      []ginIndex
+	log          *ginPostingLog
+}
+
+var _ sql.Node = (*GinIndexMaintenance)(nil)
+var _ sql.ExecBuilderNode = (*GinIndexMaintenance)(nil)
+var _ sql.Table = (*GinIndexMaintenance)(nil)
+var _ sql.TableWrapper = (*GinIndexMaintenance)(nil)
+var _ sql.InsertableTable = (*GinIndexMaintenance)(nil)
+var _ sql.UpdatableTable = (*GinIndexMaintenance)(nil)
+var _ sql.DeletableTable = (*GinIndexMaintenance)(nil)
+var _ sql.TruncateableTable = (*GinIndexMaintenance)(nil)
+
+// NewGinIndexMaintenance returns a new *GinIndexMaintenance that wraps the given node, which must resolve to the given
+// table. Returns the original node if the table does not have any GIN indexes.
+func NewGinIndexMaintenance(ctx *sql.Context, table sql.Table, originalNode sql.Node) (sql.Node, bool, error) {
+	indexes, err := getGinIndexes(ctx, table)
+	if err != nil || len(indexes) == 0 {
+		return originalNode, false, err
+	}
+	return &GinIndexMaintenance{
+		Table:        table,
+		Sch:          ginTableSchema(ctx, table),
+		OriginalNode: originalNode,
+		Indexes:      indexes,
+		log:          &ginPostingLog{},
+	}, true, nil
+}
+
+// WithGinIndexMaintenance returns the given table wrapped so that the rows written through its editors also update the
+// posting lists of its GIN indexes. This is for writes that are made directly through a table's editors, such as those
+// of logical replication, which the analyzer never sees. Returns the table as-is if it does not have any GIN indexes.
+func WithGinIndexMaintenance(ctx *sql.Context, table sql.Table) (sql.Table, error) {
+	wrapped, ok, err := NewGinIndexMaintenance(ctx, table, plan.NewResolvedTable(table, nil, nil))
+	if err != nil || !ok {
+		return table, err
+	}
+	return wrapped.(*GinIndexMaintenance), nil
+}
+
+// BuildRowIter implements the interface sql.ExecBuilderNode.
+func (g *GinIndexMaintenance) BuildRowIter(ctx *sql.Context, b sql.NodeExecBuilder, r sql.Row) (sql.RowIter, error) {
+	return b.Build(ctx, g.OriginalNode, r)
+}
+
+// Children implements the interface sql.Node.
+func (g *GinIndexMaintenance) Children() []sql.Node {
+	return []sql.Node{g.OriginalNode}
+}
+
+// Collation implements the interface sql.Table.
+func (g *GinIndexMaintenance) Collation() sql.CollationID {
+	return g.Table.Collation()
+}
+
+// Deleter implements the interface sql.DeletableTable.
+func (g *GinIndexMaintenance) Deleter(ctx *sql.Context) sql.RowDeleter {
+	deletable, err := plan.GetDeletable(g.OriginalNode)
+	if err != nil {
+		return &ginIndexEditor{maintenance: g, err: err}
+	}
+	return &ginIndexEditor{maintenance: g, deleter: deletable.Deleter(ctx)}
+}
+
+// Inserter implements the interface sql.InsertableTable.
+func (g *GinIndexMaintenance) Inserter(ctx *sql.Context) sql.RowInserter {
+	insertable, err := plan.GetInsertable(g.OriginalNode)
+	if err != nil {
+		return &ginIndexEditor{maintenance: g, err: err}
+	}
+	return &ginIndexEditor{maintenance: g, inserter: insertable.Inserter(ctx)}
+}
+
+// IsReadOnly implements the interface sql.Node.
+func (g *GinIndexMaintenance) IsReadOnly() bool {
+	return g.OriginalNode.IsReadOnly()
+}
+
+// Name implements the interface sql.Table.
+func (g *GinIndexMaintenance) Name() string {
+	return g.Table.Name()
+}
+
+// PartitionRows implements the interface sql.Table.
+func (g *GinIndexMaintenance) PartitionRows(ctx *sql.Context, partition sql.Partition) (sql.RowIter, error) {
+	return g.Table.PartitionRows(ctx, partition)
+}
+
+// Partitions implements the interface sql.Table.
+func (g *GinIndexMaintenance) Partitions(ctx *sql.Context) (sql.PartitionIter, error) {
+	return g.Table.Partitions(ctx)
+}
+
+// Resolved implements the interface sql.Node.
+func (g *GinIndexMaintenance) Resolved() bool {
+	return g.OriginalNode.Resolved()
+}
+
+// Schema implements the interface sql.Node.
+func (g *GinIndexMaintenance) Schema(ctx *sql.Context) sql.Schema {
+	return g.OriginalNode.Schema(ctx)
+}
+
+// String implements the interface sql.Node.
+func (g *GinIndexMaintenance) String() string {
+	return g.OriginalNode.String()
+}
+
+// Truncate implements the interface sql.TruncateableTable.
+func (g *GinIndexMaintenance) Truncate(ctx *sql.Context) (int, error) {
+	truncatable, err := plan.GetTruncatable(g.OriginalNode)
+	if err != nil {
+		return 0, err
+	}
+	removed, err := truncatable.Truncate(ctx)
+	if err != nil {
+		return 0, err
+	}
+	for _, index := range g.Indexes {
+		if err = index.truncatePostings(ctx); err != nil {
+			return 0, err
+		}
+	}
+	return removed, nil
+}
+
+// Underlying implements the interface sql.TableWrapper.
+func (g *GinIndexMaintenance) Underlying() sql.Table {
+	return g.Table
+}
+
+// Updater implements the interface sql.UpdatableTable.
+func (g *GinIndexMaintenance) Updater(ctx *sql.Context) sql.RowUpdater {
+	updatable, err := plan.GetUpdatable(g.OriginalNode)
+	if err != nil {
+		return &ginIndexEditor{maintenance: g, err: err}
+	}
+	return &ginIndexEditor{maintenance: g, updater: updatable.Updater(ctx)}
+}
+
+// WithChildren implements the interface sql.Node.
+func (g *GinIndexMaintenance) WithChildren(ctx *sql.Context, children ...sql.Node) (sql.Node, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(g, len(children), 1)
+	}
+	ng := *g
+	ng.OriginalNode = children[0]
+	return &ng, nil
+}
+
+// ginPostingLog records the posting rows that a statement adds and removes. Only the last change to each posting row
+// is kept, so that a row that is removed and then added back (or the reverse) within a statement is handled correctly.
+type ginPostingLog struct {
+	postings []map[string]ginPostingChange
+}
+
+// ginPostingChange is a single change to a posting row.
+type ginPostingChange struct {
+	row     sql.Row
+	present bool
+}
+
+// record logs the posting rows of the given table row for every index.
+func (log *ginPostingLog) record(ctx *sql.Context, g *GinIndexMaintenance, row sql.Row, present bool) error {
+	if log.postings == nil {
+		log.postings = make([]map[string]ginPostingChange, len(g.Indexes))
+		for i := range log.postings {
+			log.postings[i] = make(map[string]ginPostingChange)
+		}
+	}
+	for i, index := range g.Indexes {
+		postingRows, err := index.PostingRows(ctx, g.Sch, row)
+		if err != nil {
+			return err
+		}
+		for _, postingRow := range postingRows {
+			log.postings[i][ginPostingKey(postingRow)] = ginPostingChange{row: postingRow, present: present}
+		}
+	}
+	return nil
+}
+
+// apply writes the logged changes to the posting tables, and then clears the log. Every logged posting row is first
+// removed, and then the rows that should be present are added back, which avoids inserting duplicate rows.
+func (log *ginPostingLog) apply(ctx *sql.Context, g *GinIndexMaintenance) error {
+	postings := log.postings
+	log.postings = nil
+	for i, changes := range postings {
+		if len(changes) == 0 {
+			continue
+		}
+		deletedRows := make([]sql.Row, 0, len(changes))
+		var insertedRows []sql.Row
+		for _, change := range changes {
+			deletedRows = append(deletedRows, change.row)
+			if change.present {
+				insertedRows = append(insertedRows, change.row)
+			}
+		}
+		if err := g.Indexes[i].applyPostings(ctx, deletedRows, insertedRows); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ginIndexEditor wraps the editor of a table, recording the changes to the posting lists of the table's GIN indexes.
+// The posting lists are written once the statement completes.
+type ginIndexEditor struct {
+	maintenance *GinIndexMaintenance
+	inserter    sql.RowInserter
+	updater     sql.RowUpdater
+	deleter     sql.RowDeleter
+	err         error
+}
+
+var _ sql.RowInserter = (*ginIndexEditor)(nil)
+var _ sql.RowUpdater = (*ginIndexEditor)(nil)
+var _ sql.RowDeleter = (*ginIndexEditor)(nil)
+
+// editor returns the wrapped editor.
+func (e *ginIndexEditor) editor() sql.EditOpenerCloser {
+	switch {
+	case e.inserter != nil:
+		return e.inserter
+	case e.updater != nil:
+		return e.updater
+	default:
+		return e.deleter
+	}
+}
+
+// Close implements the interface sql.Closer.
+func (e *ginIndexEditor) Close(ctx *sql.Context) error {
+	switch {
+	case e.inserter != nil:
+		return e.inserter.Close(ctx)
+	case e.updater != nil:
+		return e.updater.Close(ctx)
+	case e.deleter != nil:
+		return e.deleter.Close(ctx)
+	default:
+		return nil
+	}
+}
+
+// Delete implements the interface sql.RowDeleter.
+func (e *ginIndexEditor) Delete(ctx *sql.Context, row sql.Row) error {
+	if e.err != nil {
+		return e.err
+	}
+	if err := e.deleter.Delete(ctx, row); err != nil {
+		return err
+	}
+	return e.maintenance.log.record(ctx, e.maintenance, row, false)
+}
+
+// DiscardChanges implements the interface sql.EditOpenerCloser.
+func (e *ginIndexEditor) DiscardChanges(ctx *sql.Context, errorEncountered error) error {
+	e.maintenance.log.postings = nil
+	if e.err != nil {
+		return nil
+	}
+	return e.editor().DiscardChanges(ctx, errorEncountered)
+}
+
+// Insert implements the interface sql.RowInserter.
+func (e *ginIndexEditor) Insert(ctx *sql.Context, row sql.Row) error {
+	if e.err != nil {
+		return e.err
+	}
+	if err := e.inserter.Insert(ctx, row); err != nil {
+		return err
+	}
+	return e.maintenance.log.record(ctx, e.maintenance, row, true)
+}
+
+// StatementBegin implements the interface sql.EditOpenerCloser.
+func (e *ginIndexEditor) StatementBegin(ctx *sql.Context) {
+	if e.err == nil {
+		e.editor().StatementBegin(ctx)
+	}
+}
+
+// StatementComplete implements the interface sql.EditOpenerCloser.
+func (e *ginIndexEditor) StatementComplete(ctx *sql.Context) error {
+	if e.err != nil {
+		return e.err
+	}
+	if err := e.editor().StatementComplete(ctx); err != nil {
+		return err
+	}
+	return e.maintenance.log.apply(ctx, e.maintenance)
+}
+
+// Update implements the interface sql.RowUpdater.
+func (e *ginIndexEditor) Update(ctx *sql.Context, old sql.Row, new sql.Row) error {
+	if e.err != nil {
+		return e.err
+	}
+	if err := e.updater.Update(ctx, old, new); err != nil {
+		return err
+	}
+	if err := e.maintenance.log.record(ctx, e.maintenance, old, false); err != nil {
+		return err
+	}
+	return e.maintenance.log.record(ctx, e.maintenance, new, true)
+}
@@ -0,0 +1,185 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	"github.com/dolthub/doltgresql/utils"
+)
+
+// ginIndexScanBatchSize is the number of rows that are fetched by each nested statement of a GIN index scan.
+const ginIndexScanBatchSize = 256
+
+// GinIndexScan reads the rows of a table that are found by searching one of its GIN indexes. The rows that are returned
+// are a superset of the rows that match the operator, so the scan is always used beneath a filter that rechecks them.
+type GinIndexScan struct {
+	Table    *plan.ResolvedTable
+	Operator framework.Operator
+	Value    sql.Expression
+	index    ginIndex
+}
+
+var _ sql.ExecSourceRel = (*GinIndexScan)(nil)
+var _ sql.TableWrapper = (*GinIndexScan)(nil)
+
+// NewGinIndexScan returns a new *GinIndexScan that searches the table for rows where the given column matches the
+// operator and value, with the column on the left side of the operator. Returns false if the table does not have a GIN
+// index that may be used for the search.
+func NewGinIndexScan(ctx *sql.Context, table *plan.ResolvedTable, column string, operator framework.Operator, value sql.Expression) (*GinIndexScan, bool, error) {
+	indexes, err := getGinIndexes(ctx, table.Table)
+	if err != nil {
+		return nil, false, err
+	}
+	sch := ginTableSchema(ctx, table.Table)
+	for _, index := range indexes {
+		if !strings.EqualFold(sch[index.Column].Name, column) || !ginOpClassSupportsOperator(index.OpClass, operator) {
+			continue
+		}
+		return &GinIndexScan{
+			Table:    table,
+			Operator: operator,
+			Value:    value,
+			index:    index,
+		}, true, nil
+	}
+	return nil, false, nil
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (g *GinIndexScan) Children() []sql.Node {
+	return nil
+}
+
+// Collation implements the interface sql.Table.
+func (g *GinIndexScan) Collation() sql.CollationID {
+	return g.Table.Collation()
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (g *GinIndexScan) IsReadOnly() bool {
+	return true
+}
+
+// Name implements the interface sql.Table.
+func (g *GinIndexScan) Name() string {
+	return g.Table.Name()
+}
+
+// PartitionRows implements the interface sql.Table.
+func (g *GinIndexScan) PartitionRows(ctx *sql.Context, partition sql.Partition) (sql.RowIter, error) {
+	return g.Table.PartitionRows(ctx, partition)
+}
+
+// Partitions implements the interface sql.Table.
+func (g *GinIndexScan) Partitions(ctx *sql.Context) (sql.PartitionIter, error) {
+	return g.Table.Partitions(ctx)
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (g *GinIndexScan) Resolved() bool {
+	return g.Table.Resolved() && g.Value.Resolved()
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (g *GinIndexScan) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	val, err := g.Value.Eval(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	// All of the supported operators are strict, so nothing matches a NULL value
+	if val == nil {
+		return sql.RowsToRowIter(), nil
+	}
+	entries, strategy, _, err := ginQueryEntries(ctx, g.index.OpClass, g.Operator, g.Value.Type(ctx), val)
+	if err != nil {
+		return nil, err
+	}
+	sch := g.Table.Schema(ctx)
+	columns := make([]string, len(sch))
+	for i, col := range sch {
+		columns[i] = utils.QuoteIdentifier(col.Name)
+	}
+	selectRows := fmt.Sprintf("SELECT %s FROM %s", strings.Join(columns, ", "),
+		utils.QuoteQualifiedIdentifier(g.index.Schema, g.Table.Name()))
+	if len(entries) == 0 {
+		if strategy == ginStrategy_Any {
+			return sql.RowsToRowIter(), nil
+		}
+		// Every row contains the empty set of entries (such as a search for an empty object), so we read every row
+		rows, err := runNestedStatement(ctx, selectRows+";")
+		if err != nil {
+			return nil, err
+		}
+		return sql.RowsToRowIter(rows...), nil
+	}
+
+	quotedEntries := make([]string, len(entries))
+	for i, entry := range entries {
+		quotedEntries[i] = quoteString(entry)
+	}
+	postingColumns := strings.Join(g.index.PostingColumns(), ", ")
+	keysQuery := fmt.Sprintf("SELECT %s FROM %s WHERE %s IN (%s) GROUP BY %s", postingColumns,
+		utils.QuoteQualifiedIdentifier(g.index.Schema, g.index.Postings), utils.QuoteIdentifier("entry"),
+		strings.Join(quotedEntries, ", "), postingColumns)
+	if strategy == ginStrategy_All {
+		keysQuery += fmt.Sprintf(" HAVING count(*) = %d", len(entries))
+	}
+	keys, err := runNestedStatement(ctx, keysQuery+";")
+	if err != nil {
+		return nil, err
+	}
+
+	fullSch := ginTableSchema(ctx, g.Table.Table)
+	var rows []sql.Row
+	for start := 0; start < len(keys); start += ginIndexScanBatchSize {
+		end := min(start+ginIndexScanBatchSize, len(keys))
+		conditions := make([]string, end-start)
+		for i, key := range keys[start:end] {
+			conditions[i] = g.index.KeyCondition(fullSch, key)
+		}
+		batchRows, err := runNestedStatement(ctx, fmt.Sprintf("%s WHERE %s;", selectRows, strings.Join(conditions, " OR ")))
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, batchRows...)
+	}
+	return sql.RowsToRowIter(rows...), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (g *GinIndexScan) Schema(ctx *sql.Context) sql.Schema {
+	return g.Table.Schema(ctx)
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (g *GinIndexScan) String() string {
+	return fmt.Sprintf("GinIndexScan(%s on %s, %s %s)", g.index.Name, g.Table.Name(), g.Operator.String(), g.Value.String())
+}
+
+// Underlying implements the interface sql.TableWrapper.
+func (g *GinIndexScan) Underlying() sql.Table {
+	return g.Table.Table
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (g *GinIndexScan) WithChildren(ctx *sql.Context, children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(g, children...)
+}
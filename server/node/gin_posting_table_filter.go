@@ -0,0 +1,131 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/core"
+)
+
+// GinPostingTableFilter is a node that removes the rows that refer to the tables that hold the posting lists of GIN
+// indexes from Dolt's system tables and table functions, such as dolt_status and dolt_diff_summary. The posting tables
+// are maintained alongside their indexes, and are not visible as tables of their own.
+type GinPostingTableFilter struct {
+	child   sql.Node
+	columns []int
+}
+
+var _ sql.DebugStringer = (*GinPostingTableFilter)(nil)
+var _ sql.ExecBuilderNode = (*GinPostingTableFilter)(nil)
+
+// NewGinPostingTableFilter returns a new *GinPostingTableFilter, which filters on the table names in the given columns.
+func NewGinPostingTableFilter(child sql.Node, columns []int) *GinPostingTableFilter {
+	return &GinPostingTableFilter{
+		child:   child,
+		columns: columns,
+	}
+}
+
+// Child returns the single child of this node
+func (f *GinPostingTableFilter) Child() sql.Node {
+	return f.child
+}
+
+// Children implements the interface sql.ExecBuilderNode.
+func (f *GinPostingTableFilter) Children() []sql.Node {
+	return []sql.Node{f.child}
+}
+
+// DebugString implements the interface sql.DebugStringer.
+func (f *GinPostingTableFilter) DebugString(ctx *sql.Context) string {
+	return sql.DebugString(ctx, f.child)
+}
+
+// IsReadOnly implements the interface sql.ExecBuilderNode.
+func (f *GinPostingTableFilter) IsReadOnly() bool {
+	return f.child.IsReadOnly()
+}
+
+// Resolved implements the interface sql.ExecBuilderNode.
+func (f *GinPostingTableFilter) Resolved() bool {
+	return f.child.Resolved()
+}
+
+// BuildRowIter implements the interface sql.ExecBuilderNode.
+func (f *GinPostingTableFilter) BuildRowIter(ctx *sql.Context, b sql.NodeExecBuilder, r sql.Row) (sql.RowIter, error) {
+	childIter, err := b.Build(ctx, f.child, r)
+	if err != nil {
+		return nil, err
+	}
+	if childIter == nil {
+		childIter = sql.RowsToRowIter()
+	}
+	return &ginPostingTableFilterIter{childIter: childIter, columns: f.columns}, nil
+}
+
+// Schema implements the interface sql.ExecBuilderNode.
+func (f *GinPostingTableFilter) Schema(ctx *sql.Context) sql.Schema {
+	return f.child.Schema(ctx)
+}
+
+// String implements the interface sql.ExecBuilderNode.
+func (f *GinPostingTableFilter) String() string {
+	return f.child.String()
+}
+
+// WithChildren implements the interface sql.ExecBuilderNode.
+func (f *GinPostingTableFilter) WithChildren(ctx *sql.Context, children ...sql.Node) (sql.Node, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(f, len(children), 1)
+	}
+	return NewGinPostingTableFilter(children[0], f.columns), nil
+}
+
+// ginPostingTableFilterIter is the iterator for *GinPostingTableFilter.
+type ginPostingTableFilterIter struct {
+	childIter sql.RowIter
+	columns   []int
+}
+
+var _ sql.RowIter = (*ginPostingTableFilterIter)(nil)
+
+// Next implements the interface sql.RowIter.
+func (iter *ginPostingTableFilterIter) Next(ctx *sql.Context) (sql.Row, error) {
+	for {
+		row, err := iter.childIter.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !iter.refersToPostingTable(row) {
+			return row, nil
+		}
+	}
+}
+
+// refersToPostingTable returns whether any of the filtered columns of the given row names a GIN posting table.
+func (iter *ginPostingTableFilterIter) refersToPostingTable(row sql.Row) bool {
+	for _, column := range iter.columns {
+		if tableName, ok := row[column].(string); ok && core.IsGinPostingTable(tableName) {
+			return true
+		}
+	}
+	return false
+}
+
+// Close implements the interface sql.RowIter.
+func (iter *ginPostingTableFilterIter) Close(ctx *sql.Context) error {
+	return iter.childIter.Close(ctx)
+}
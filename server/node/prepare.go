@@ -0,0 +1,86 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// PrepareStatement handles the PREPARE statement. Preparing a statement is handled by the connection handler, as
+// prepared statements belong to the connection and are shared with the extended query protocol, so this node only
+// holds the information that the handler needs.
+type PrepareStatement struct {
+	Name string
+	// ParameterTypes are the declared types of the parameters, which may be fewer than the number of parameters.
+	ParameterTypes []*pgtypes.DoltgresType
+	// Definition is the text of the PREPARE statement.
+	Definition   string
+	Query        string
+	Statement    vitess.Statement
+	StatementTag string
+}
+
+var _ vitess.Injectable = (*PrepareStatement)(nil)
+var _ sql.ExecSourceRel = (*PrepareStatement)(nil)
+
+// Children implements the interface sql.ExecSourceRel.
+func (p *PrepareStatement) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (p *PrepareStatement) IsReadOnly() bool {
+	return true
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (p *PrepareStatement) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (p *PrepareStatement) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	return nil, errors.New("PREPARE should be handled by the connection handler")
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (p *PrepareStatement) Schema(ctx *sql.Context) sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (p *PrepareStatement) String() string {
+	return "PREPARE " + p.Name
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (p *PrepareStatement) WithChildren(ctx *sql.Context, children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(p, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (p *PrepareStatement) WithResolvedChildren(ctx context.Context, children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, ErrVitessChildCount.New(0, len(children))
+	}
+	return p, nil
+}
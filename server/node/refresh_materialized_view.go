@@ -0,0 +1,330 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/id"
+	"github.com/dolthub/doltgresql/core/matviews"
+)
+
+// RefreshMaterializedView implements REFRESH MATERIALIZED VIEW.
+type RefreshMaterializedView struct {
+	Schema       string
+	Name         string
+	Concurrently bool
+	WithNoData   bool
+}
+
+var _ sql.ExecSourceRel = (*RefreshMaterializedView)(nil)
+var _ vitess.Injectable = (*RefreshMaterializedView)(nil)
+
+// Children implements the interface sql.ExecSourceRel.
+func (c *RefreshMaterializedView) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (c *RefreshMaterializedView) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (c *RefreshMaterializedView) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (c *RefreshMaterializedView) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	if c.Concurrently && c.WithNoData {
+		return nil, errors.Errorf("REFRESH options CONCURRENTLY and WITH NO DATA cannot be used together")
+	}
+	table, mv, err := resolveMaterializedView(ctx, c.Schema, c.Name)
+	if err != nil {
+		return nil, err
+	}
+	if table == nil {
+		return nil, errors.Errorf(`relation "%s" does not exist`, c.Name)
+	}
+	if !mv.ID.IsValid() {
+		return nil, errors.Errorf(`"%s" is not a materialized view`, c.Name)
+	}
+	if c.Concurrently {
+		if !mv.Populated {
+			return nil, errors.Errorf("CONCURRENTLY cannot be used when the materialized view is not populated")
+		}
+		hasUniqueIndex, err := materializedViewHasUniqueIndex(ctx, table)
+		if err != nil {
+			return nil, err
+		}
+		if !hasUniqueIndex {
+			return nil, errors.Errorf(`cannot refresh materialized view "%s.%s" concurrently`, mv.ID.SchemaName(), mv.ID.ViewName())
+		}
+	}
+
+	storedRows, err := readMaterializedViewRows(ctx, table)
+	if err != nil {
+		return nil, err
+	}
+	var queriedRows []sql.Row
+	if !c.WithNoData {
+		queriedRows, err = runNestedStatement(ctx, fmt.Sprintf("SELECT * FROM (%s) AS matview_source", mv.Definition))
+		if err != nil {
+			return nil, err
+		}
+	}
+	sch := table.Schema(ctx)
+	for _, row := range queriedRows {
+		if len(row) != len(sch) {
+			return nil, errors.Errorf(`materialized view "%s" has %d columns, but its query returned %d`, c.Name, len(sch), len(row))
+		}
+	}
+	// A full refresh replaces every row, while a concurrent refresh only changes the rows that differ, so that the
+	// table's history only shows what the refresh actually changed.
+	deletedRows, insertedRows := storedRows, queriedRows
+	if c.Concurrently {
+		deletedRows, insertedRows, err = diffMaterializedViewRows(ctx, sch, storedRows, queriedRows)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(deletedRows) > 0 {
+		deletable, ok := table.(sql.DeletableTable)
+		if !ok {
+			return nil, errors.Errorf(`materialized view "%s" does not support refreshing`, c.Name)
+		}
+		deleter := deletable.Deleter(ctx)
+		if err = applyMaterializedViewEdit(ctx, deleter, func() error {
+			for _, row := range deletedRows {
+				if err := deleter.Delete(ctx, row); err != nil {
+					return err
+				}
+			}
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+	if len(insertedRows) > 0 {
+		insertable, ok := table.(sql.InsertableTable)
+		if !ok {
+			return nil, errors.Errorf(`materialized view "%s" does not support refreshing`, c.Name)
+		}
+		inserter := insertable.Inserter(ctx)
+		if err = applyMaterializedViewEdit(ctx, inserter, func() error {
+			for _, row := range insertedRows {
+				if err := inserter.Insert(ctx, row); err != nil {
+					return err
+				}
+			}
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	// Rows are written through the table's own editors, so the posting lists of any GIN indexes are rebuilt from the
+	// refreshed rows, which are the same rows for both kinds of refresh
+	ginIndexes, err := getGinIndexes(ctx, table)
+	if err != nil {
+		return nil, err
+	}
+	for _, index := range ginIndexes {
+		if err = index.rebuildPostings(ctx, ginTableSchema(ctx, table), queriedRows); err != nil {
+			return nil, err
+		}
+	}
+
+	if mv.Populated == c.WithNoData {
+		collection, err := core.GetMaterializedViewsCollectionFromContext(ctx, ctx.GetCurrentDatabase())
+		if err != nil {
+			return nil, err
+		}
+		mv.Populated = !c.WithNoData
+		if err = collection.UpdateMaterializedView(ctx, mv); err != nil {
+			return nil, err
+		}
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (c *RefreshMaterializedView) Schema(ctx *sql.Context) sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (c *RefreshMaterializedView) String() string {
+	return "REFRESH MATERIALIZED VIEW"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (c *RefreshMaterializedView) WithChildren(ctx *sql.Context, children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(c, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (c *RefreshMaterializedView) WithResolvedChildren(ctx context.Context, children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, ErrVitessChildCount.New(0, len(children))
+	}
+	return c, nil
+}
+
+// resolveMaterializedView returns the table with the given name, along with its materialized view. An empty schema
+// uses the search path. Returns a nil table if the table does not exist, and a MaterializedView with an invalid ID if
+// the table is not a materialized view.
+func resolveMaterializedView(ctx *sql.Context, schema string, name string) (sql.Table, matviews.MaterializedView, error) {
+	table, err := core.GetSqlTableFromContext(ctx, "", doltdb.TableName{Name: name, Schema: schema})
+	if err != nil || table == nil {
+		return nil, matviews.MaterializedView{}, err
+	}
+	doltTable := core.SQLTableToDoltTable(table)
+	if doltTable == nil {
+		return table, matviews.MaterializedView{}, nil
+	}
+	collection, err := core.GetMaterializedViewsCollectionFromContext(ctx, ctx.GetCurrentDatabase())
+	if err != nil {
+		return nil, matviews.MaterializedView{}, err
+	}
+	tableName := doltTable.TableName()
+	mv, err := collection.GetMaterializedView(ctx, id.NewView(tableName.Schema, tableName.Name))
+	if err != nil {
+		return nil, matviews.MaterializedView{}, err
+	}
+	return table, mv, nil
+}
+
+// materializedViewHasUniqueIndex returns whether the table has a unique index, which a concurrent refresh requires.
+func materializedViewHasUniqueIndex(ctx *sql.Context, table sql.Table) (bool, error) {
+	indexAddressable, ok := table.(sql.IndexAddressable)
+	if !ok {
+		return false, nil
+	}
+	indexes, err := indexAddressable.GetIndexes(ctx)
+	if err != nil {
+		return false, err
+	}
+	for _, index := range indexes {
+		if index.IsUnique() {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// readMaterializedViewRows returns every row stored in the table.
+func readMaterializedViewRows(ctx *sql.Context, table sql.Table) ([]sql.Row, error) {
+	partitions, err := table.Partitions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	iter := sql.NewTableRowIter(ctx, table, partitions)
+	defer iter.Close(ctx)
+	var rows []sql.Row
+	for {
+		row, err := iter.Next(ctx)
+		if err == io.EOF {
+			return rows, nil
+		} else if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+}
+
+// diffMaterializedViewRows returns the stored rows that the query no longer returns, along with the queried rows that
+// are not yet stored. Rows are compared by their output representation, and duplicate rows are matched one-to-one.
+func diffMaterializedViewRows(ctx *sql.Context, sch sql.Schema, storedRows []sql.Row, queriedRows []sql.Row) (deleted []sql.Row, inserted []sql.Row, err error) {
+	queriedCounts := make(map[string]int, len(queriedRows))
+	queriedKeys := make([]string, len(queriedRows))
+	for i, row := range queriedRows {
+		if queriedKeys[i], err = materializedViewRowKey(ctx, sch, row); err != nil {
+			return nil, nil, err
+		}
+		queriedCounts[queriedKeys[i]]++
+	}
+	for _, row := range storedRows {
+		key, err := materializedViewRowKey(ctx, sch, row)
+		if err != nil {
+			return nil, nil, err
+		}
+		if queriedCounts[key] > 0 {
+			// The row is unchanged, so it's neither deleted nor inserted
+			queriedCounts[key]--
+			continue
+		}
+		deleted = append(deleted, row)
+	}
+	for i, row := range queriedRows {
+		if queriedCounts[queriedKeys[i]] > 0 {
+			queriedCounts[queriedKeys[i]]--
+			inserted = append(inserted, row)
+		}
+	}
+	return deleted, inserted, nil
+}
+
+// materializedViewRowKey returns a string that uniquely identifies the contents of the row.
+func materializedViewRowKey(ctx *sql.Context, sch sql.Schema, row sql.Row) (string, error) {
+	var key strings.Builder
+	for i, val := range row {
+		if val == nil {
+			key.WriteString("n;")
+			continue
+		}
+		sqlVal, err := sch[i].Type.SQL(ctx, nil, val)
+		if err != nil {
+			return "", err
+		}
+		str := sqlVal.ToString()
+		// The length prefix keeps values containing the separator from colliding
+		key.WriteString(fmt.Sprintf("%d:%s;", len(str), str))
+	}
+	return key.String(), nil
+}
+
+// materializedViewEditor is the set of methods shared by the engine's row inserters and deleters.
+type materializedViewEditor interface {
+	sql.EditOpenerCloser
+	sql.Closer
+}
+
+// applyMaterializedViewEdit runs the edit as a single statement of the given editor, discarding the edit's changes if
+// it returns an error.
+func applyMaterializedViewEdit(ctx *sql.Context, editor materializedViewEditor, edit func() error) error {
+	editor.StatementBegin(ctx)
+	if err := edit(); err != nil {
+		_ = editor.DiscardChanges(ctx, err)
+		_ = editor.Close(ctx)
+		return err
+	}
+	if err := editor.StatementComplete(ctx); err != nil {
+		_ = editor.Close(ctx)
+		return err
+	}
+	return editor.Close(ctx)
+}
@@ -0,0 +1,107 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/doltgresql/core/extensions"
+	"github.com/dolthub/doltgresql/core/functions"
+	"github.com/dolthub/doltgresql/core/matviews"
+	"github.com/dolthub/doltgresql/core/policies"
+	"github.com/dolthub/doltgresql/core/procedures"
+	"github.com/dolthub/doltgresql/core/rootobject/objinterface"
+	"github.com/dolthub/doltgresql/core/sequences"
+	"github.com/dolthub/doltgresql/core/triggers"
+	"github.com/dolthub/doltgresql/core/typecollection"
+	"github.com/dolthub/doltgresql/utils"
+)
+
+// RootObjectDefinition returns the SQL statements that create the given root object, separated by newlines. Functions
+// and procedures are returned as CREATE OR REPLACE statements. Returns an empty string for nil root objects, along with
+// root objects that are not created by a statement of their own (such as array types).
+func RootObjectDefinition(rootObj objinterface.RootObject) string {
+	var stmts []string
+	switch rootObj := rootObj.(type) {
+	case extensions.Extension:
+		stmts = append(stmts, extensionCreateStatement(rootObj))
+	case functions.Function:
+		stmts = append(stmts, createOrReplaceStatement(rootObj.Definition))
+	case matviews.MaterializedView:
+		withData := ""
+		if !rootObj.Populated {
+			withData = " WITH NO DATA"
+		}
+		stmts = append(stmts, fmt.Sprintf("CREATE MATERIALIZED VIEW %s AS %s%s;",
+			utils.QuoteQualifiedIdentifier(rootObj.ID.SchemaName(), rootObj.ID.ViewName()), rootObj.Definition, withData))
+	case policies.Policy:
+		stmts = append(stmts, policyCreateStatement(rootObj))
+	case policies.TableSecurity:
+		tableName := utils.QuoteQualifiedIdentifier(rootObj.ID.SchemaName(), rootObj.ID.TableName())
+		if rootObj.Enabled {
+			stmts = append(stmts, fmt.Sprintf("ALTER TABLE %s ENABLE ROW LEVEL SECURITY;", tableName))
+		}
+		if rootObj.Forced {
+			stmts = append(stmts, fmt.Sprintf("ALTER TABLE %s FORCE ROW LEVEL SECURITY;", tableName))
+		}
+	case procedures.Procedure:
+		stmts = append(stmts, createOrReplaceStatement(rootObj.Definition))
+	case *sequences.Sequence:
+		stmts = append(stmts, sequencePatchStatements(nil, rootObj)...)
+		if ownerStmt := sequenceOwnerStatement(nil, rootObj); ownerStmt != "" {
+			stmts = append(stmts, ownerStmt)
+		}
+	case triggers.Trigger:
+		stmts = append(stmts, terminateStatement(rootObj.Definition))
+	case typecollection.TypeWrapper:
+		stmts = append(stmts, typePatchStatements(nil, rootObj.Type)...)
+	}
+	return strings.Join(stmts, "\n")
+}
+
+// policyCreateStatement returns the CREATE POLICY statement for the given policy.
+func policyCreateStatement(p policies.Policy) string {
+	var sb strings.Builder
+	sb.WriteString("CREATE POLICY ")
+	sb.WriteString(utils.QuoteIdentifier(p.ID.PolicyName()))
+	sb.WriteString(" ON ")
+	sb.WriteString(utils.QuoteQualifiedIdentifier(p.ID.SchemaName(), p.ID.TableName()))
+	if p.Restrictive {
+		sb.WriteString(" AS RESTRICTIVE")
+	}
+	sb.WriteString(" FOR ")
+	sb.WriteString(p.Command.String())
+	if len(p.Roles) > 0 {
+		roles := make([]string, len(p.Roles))
+		for i, role := range p.Roles {
+			roles[i] = utils.QuoteIdentifier(role)
+		}
+		sb.WriteString(" TO ")
+		sb.WriteString(strings.Join(roles, ", "))
+	}
+	if len(p.Using) > 0 {
+		sb.WriteString(" USING (")
+		sb.WriteString(p.Using)
+		sb.WriteString(")")
+	}
+	if len(p.WithCheck) > 0 {
+		sb.WriteString(" WITH CHECK (")
+		sb.WriteString(p.WithCheck)
+		sb.WriteString(")")
+	}
+	sb.WriteString(";")
+	return sb.String()
+}
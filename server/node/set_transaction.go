@@ -0,0 +1,77 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+)
+
+// SetTransaction handles the SET TRANSACTION statement. The characteristics of a transaction are tracked by the
+// connection handler, since they must be applied before the transaction runs its first statement, so this node only
+// holds the information that the handler needs.
+type SetTransaction struct {
+	IsolationLevel string
+}
+
+var _ vitess.Injectable = (*SetTransaction)(nil)
+var _ sql.ExecSourceRel = (*SetTransaction)(nil)
+
+// Children implements the interface sql.ExecSourceRel.
+func (s *SetTransaction) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (s *SetTransaction) IsReadOnly() bool {
+	return true
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (s *SetTransaction) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (s *SetTransaction) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	return nil, errors.New("SET TRANSACTION should be handled by the connection handler")
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (s *SetTransaction) Schema(ctx *sql.Context) sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (s *SetTransaction) String() string {
+	return "SET TRANSACTION ISOLATION LEVEL " + s.IsolationLevel
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (s *SetTransaction) WithChildren(ctx *sql.Context, children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(s, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (s *SetTransaction) WithResolvedChildren(ctx context.Context, children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, ErrVitessChildCount.New(0, len(children))
+	}
+	return s, nil
+}
@@ -16,6 +16,7 @@ package server
 
 import (
 	"context"
+	"strings"
 
 	"github.com/dolthub/dolt/go/libraries/doltcore/env"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle"
@@ -24,6 +25,7 @@ import (
 	"github.com/dolthub/go-mysql-server/sql"
 
 	"github.com/dolthub/doltgresql/core/sequences"
+	"github.com/dolthub/doltgresql/server/node"
 	"github.com/dolthub/doltgresql/server/tables"
 )
 
@@ -58,6 +60,16 @@ func (p *DoltgresDatabaseProvider) AllDatabases(ctx *sql.Context) []sql.Database
 	return all
 }
 
+// TableFunction overrides DoltDatabaseProvider.TableFunction to replace Dolt's table functions with Doltgres
+// variants where their output depends on the SQL dialect, such as dolt_patch.
+func (p *DoltgresDatabaseProvider) TableFunction(ctx *sql.Context, name string) (sql.TableFunction, bool) {
+	tf, ok := p.DoltDatabaseProvider.TableFunction(ctx, name)
+	if ok && strings.ToLower(name) == node.DoltPatchName {
+		return node.NewDoltPatch(p, tf), true
+	}
+	return tf, ok
+}
+
 // UnderlyingDoltProvider implements sqle.DoltProviderUnwrapper so that NewSqlEngine can
 // access the wrapped *DoltDatabaseProvider for Dolt-specific configuration.
 func (p *DoltgresDatabaseProvider) UnderlyingDoltProvider() *sqle.DoltDatabaseProvider {
@@ -0,0 +1,101 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prepared
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/core/id"
+)
+
+// Statement is a named prepared statement, which was created by either a PREPARE statement or a Parse message.
+// Prepared statements belong to the connection that created them, and are shared between SQL and the extended query
+// protocol, so a statement that was prepared by one may be executed or deallocated by the other.
+type Statement struct {
+	Name string
+	// Definition is the text that the client sent to create the prepared statement. For statements that were created
+	// through SQL, this is the entire PREPARE statement.
+	Definition string
+	// Query is the statement that is executed, along with its parsed form and the tag that it reports on completion.
+	Query        string
+	AST          vitess.Statement
+	StatementTag string
+	// ParameterTypes are the types of the parameters, which were either declared or inferred from the statement.
+	ParameterTypes []id.Type
+	// ResultTypes are the types of the columns that are returned, which are nil when the statement does not return
+	// any rows.
+	ResultTypes []id.Type
+	PrepareTime time.Time
+	FromSQL     bool
+}
+
+// statements holds the prepared statements of every connection, keyed by the connection ID.
+var statements = struct {
+	mu          sync.Mutex
+	connections map[uint32]map[string]Statement
+}{connections: make(map[uint32]map[string]Statement)}
+
+// Add adds the prepared statement to the given connection, replacing any statement with the same name.
+func Add(connectionID uint32, statement Statement) {
+	statements.mu.Lock()
+	defer statements.mu.Unlock()
+	connection, ok := statements.connections[connectionID]
+	if !ok {
+		connection = make(map[string]Statement)
+		statements.connections[connectionID] = connection
+	}
+	connection[statement.Name] = statement
+}
+
+// Get returns the prepared statement with the given name from the given connection, and whether it exists.
+func Get(connectionID uint32, name string) (Statement, bool) {
+	statements.mu.Lock()
+	defer statements.mu.Unlock()
+	statement, ok := statements.connections[connectionID][name]
+	return statement, ok
+}
+
+// Remove removes the prepared statement with the given name from the given connection.
+func Remove(connectionID uint32, name string) {
+	statements.mu.Lock()
+	defer statements.mu.Unlock()
+	delete(statements.connections[connectionID], name)
+}
+
+// RemoveAll removes every prepared statement from the given connection.
+func RemoveAll(connectionID uint32) {
+	statements.mu.Lock()
+	defer statements.mu.Unlock()
+	delete(statements.connections, connectionID)
+}
+
+// Statements returns every prepared statement of the given connection, sorted by name.
+func Statements(connectionID uint32) []Statement {
+	statements.mu.Lock()
+	defer statements.mu.Unlock()
+	connection := statements.connections[connectionID]
+	stmts := make([]Statement, 0, len(connection))
+	for _, statement := range connection {
+		stmts = append(stmts, statement)
+	}
+	sort.Slice(stmts, func(i, j int) bool {
+		return stmts[i].Name < stmts[j].Name
+	})
+	return stmts
+}
@@ -0,0 +1,187 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/id"
+	"github.com/dolthub/doltgresql/server/node"
+	"github.com/dolthub/doltgresql/server/prepared"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// prepareQuery prepares the given query in the same way for both Parse messages and PREPARE statements. The given
+// parameter types are those that were declared by the client, where a zero OID (or a missing trailing OID) means that
+// the type should be inferred from the query.
+func (h *ConnectionHandler) prepareQuery(query ConvertedQuery, parameterOIDs []uint32) (PreparedStatementData, error) {
+	ctx, err := h.doltgresHandler.sm.NewContextWithQuery(context.Background(), h.mysqlConn, query.String)
+	if err != nil {
+		return PreparedStatementData{}, err
+	}
+	parsedQuery, fields, err := h.doltgresHandler.ComPrepareParsed(ctx, h.mysqlConn, query.String, query.AST)
+	if err != nil {
+		return PreparedStatementData{}, err
+	}
+
+	analyzedPlan, ok := parsedQuery.(sql.Node)
+	if !ok {
+		return PreparedStatementData{}, errors.Errorf("expected a sql.Node, got %T", parsedQuery)
+	}
+
+	// Clients can specify an OID of zero for a parameter, or omit trailing parameters from
+	// ParameterOIDs entirely (the Postgres protocol allows specifying types for only a prefix
+	// of the placeholders), to indicate that a parameter's type should be inferred. We always
+	// compute the plan-inferred types (we can't know whether bindVarTypes is missing
+	// trailing entries without first inspecting the analyzed plan) but only use an inferred
+	// type to fill a position the client left unspecified. An explicit, non-zero OID from the
+	// client must never be overwritten by an inferred type, since the client will encode that
+	// parameter's Bind value using its own declared type. Declared parameters that the query
+	// does not reference are still parameters of the statement.
+	inferredTypes, err := extractBindVarTypes(ctx, analyzedPlan)
+	if err != nil {
+		return PreparedStatementData{}, err
+	}
+	bindVarTypes := make([]uint32, max(len(inferredTypes), len(parameterOIDs)))
+	copy(bindVarTypes, inferredTypes)
+	for i, oid := range parameterOIDs {
+		if oid != 0 {
+			bindVarTypes[i] = oid
+		}
+	}
+
+	return PreparedStatementData{
+		Query:           query,
+		ReturnFields:    fields,
+		BindVarTypes:    bindVarTypes,
+		ReturnsOkResult: nodeReturnsOkResultSchema(ctx, analyzedPlan),
+	}, nil
+}
+
+// prepareStatement handles a PREPARE statement by preparing the statement that it contains in the same way as a Parse
+// message, and sending a CommandComplete message back to the client.
+func (h *ConnectionHandler) prepareStatement(stmt *node.PrepareStatement, query ConvertedQuery) error {
+	if _, ok := h.preparedStatements[stmt.Name]; ok {
+		return errors.Errorf(`prepared statement "%s" already exists`, stmt.Name)
+	}
+	parameterOIDs := make([]uint32, len(stmt.ParameterTypes))
+	if len(stmt.ParameterTypes) > 0 {
+		ctx, err := h.doltgresHandler.sm.NewContextWithQuery(context.Background(), h.mysqlConn, query.String)
+		if err != nil {
+			return err
+		}
+		typeColl, err := core.GetTypesCollectionFromContext(ctx, "")
+		if err != nil {
+			return err
+		}
+		for i, typ := range stmt.ParameterTypes {
+			if !typ.IsResolvedType() {
+				typ, err = typeColl.ResolveType(ctx, typ.ID)
+				if err != nil {
+					return err
+				}
+			}
+			// A parameter that is declared as unknown has its type inferred, the same as an undeclared parameter
+			if typ.ID != pgtypes.Unknown.ID {
+				parameterOIDs[i] = id.Cache().ToOID(typ.ID.AsId())
+			}
+		}
+	}
+	preparedData, err := h.prepareQuery(ConvertedQuery{
+		String:       stmt.Query,
+		AST:          stmt.Statement,
+		StatementTag: stmt.StatementTag,
+	}, parameterOIDs)
+	if err != nil {
+		return err
+	}
+	h.setPreparedStatement(stmt.Name, preparedData, stmt.Definition, true)
+	return h.send(makeCommandComplete(query.StatementTag, 0))
+}
+
+// setPreparedStatement adds the prepared statement to the connection, replacing any statement with the same name. The
+// definition is the text that the client sent to create the prepared statement.
+func (h *ConnectionHandler) setPreparedStatement(name string, data PreparedStatementData, definition string, fromSQL bool) {
+	h.preparedStatements[name] = data
+	// The unnamed statement cannot be referenced from SQL, so it is not visible to the session
+	if name == "" {
+		return
+	}
+	parameterTypes := make([]id.Type, len(data.BindVarTypes))
+	for i, oid := range data.BindVarTypes {
+		parameterTypes[i] = id.Type(id.Cache().ToInternal(oid))
+	}
+	var resultTypes []id.Type
+	if !data.ReturnsOkResult && len(data.ReturnFields) > 0 {
+		resultTypes = make([]id.Type, len(data.ReturnFields))
+		for i, field := range data.ReturnFields {
+			resultTypes[i] = id.Type(id.Cache().ToInternal(field.DataTypeOID))
+		}
+	}
+	prepared.Add(h.mysqlConn.ConnectionID, prepared.Statement{
+		Name:           name,
+		Definition:     definition,
+		Query:          data.Query.String,
+		AST:            data.Query.AST,
+		StatementTag:   data.Query.StatementTag,
+		ParameterTypes: parameterTypes,
+		ResultTypes:    resultTypes,
+		PrepareTime:    time.Now(),
+		FromSQL:        fromSQL,
+	})
+}
+
+// removePreparedStatement removes the prepared statement with the given name from the connection.
+func (h *ConnectionHandler) removePreparedStatement(name string) {
+	delete(h.preparedStatements, name)
+	prepared.Remove(h.mysqlConn.ConnectionID, name)
+}
+
+// removeAllPreparedStatements removes every prepared statement from the connection.
+func (h *ConnectionHandler) removeAllPreparedStatements() {
+	for name := range h.preparedStatements {
+		delete(h.preparedStatements, name)
+	}
+	prepared.RemoveAll(h.mysqlConn.ConnectionID)
+}
+
+// executedQuery returns the prepared statement that the given query executes if it is an EXECUTE statement, as an
+// EXECUTE returns the rows and reports the statement tag of the statement that it executes. Otherwise, returns the
+// given query.
+func (h *ConnectionHandler) executedQuery(query ConvertedQuery) ConvertedQuery {
+	injected, ok := query.AST.(sqlparser.InjectedStatement)
+	if !ok {
+		return query
+	}
+	execute, ok := injected.Statement.(*node.ExecuteStatement)
+	if !ok {
+		return query
+	}
+	statement, ok := prepared.Get(h.mysqlConn.ConnectionID, execute.Name)
+	if !ok {
+		return query
+	}
+	return ConvertedQuery{
+		String:       statement.Query,
+		AST:          statement.AST,
+		StatementTag: statement.StatementTag,
+	}
+}
@@ -0,0 +1,386 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/jackc/pglogrepl"
+	"github.com/jackc/pgx/v5/pgproto3"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/sirupsen/logrus"
+
+	"github.com/dolthub/doltgresql/server/auth"
+	"github.com/dolthub/doltgresql/server/walsender"
+)
+
+const (
+	// replicationPollInterval is how often a replication stream checks the branch for new commits.
+	replicationPollInterval = time.Second
+	// replicationKeepaliveInterval is how often a replication stream sends a keepalive message to the consumer.
+	replicationKeepaliveInterval = 10 * time.Second
+	// replicationSystemID is the system identifier reported by IDENTIFY_SYSTEM.
+	replicationSystemID = "7400000000000000000"
+)
+
+// setReplicationMode handles the replication startup parameter. Only logical replication connections, which are
+// connected to a database, are supported. Such connections accept replication commands in addition to SQL statements.
+func (h *ConnectionHandler) setReplicationMode(value string) error {
+	switch strings.ToLower(value) {
+	case "database":
+	case "true", "on", "yes", "1":
+		return errors.New("physical replication is not supported")
+	case "false", "off", "no", "0":
+		return nil
+	default:
+		return errors.Errorf(`invalid value for parameter "replication": "%s"`, value)
+	}
+	var role auth.Role
+	auth.LockRead(func() {
+		role = auth.GetRole(h.mysqlConn.User)
+	})
+	if !role.IsSuperUser && !role.IsReplicationRole {
+		return errors.New("must be superuser or replication role to start walsender")
+	}
+	h.replicationDatabase = true
+	return nil
+}
+
+// handleReplicationCommand handles a Query message on a replication connection that contains a replication command.
+// Returns false if the query is not a replication command, in which case it should be handled as a regular query.
+func (h *ConnectionHandler) handleReplicationCommand(query string) (handled bool, stop bool, endOfMessages bool, err error) {
+	cmd, ok, err := walsender.ParseCommand(query)
+	if !ok {
+		return false, false, false, nil
+	} else if err != nil {
+		return true, false, true, err
+	}
+	if h.transactionState != idleTransactionState {
+		return true, false, true, errors.New("cannot execute replication commands inside a transaction block")
+	}
+	switch cmd := cmd.(type) {
+	case walsender.IdentifySystemCommand:
+		return true, false, true, h.identifySystem()
+	case walsender.CreateReplicationSlotCommand:
+		return true, false, true, h.createReplicationSlot(cmd)
+	case walsender.DropReplicationSlotCommand:
+		if err = walsender.DropReplicationSlot(cmd.Name); err != nil {
+			return true, false, true, err
+		}
+		h.temporarySlots = removeName(h.temporarySlots, cmd.Name)
+		return true, false, true, h.send(makeCommandComplete("DROP_REPLICATION_SLOT", 0))
+	case walsender.StartReplicationCommand:
+		if stop, err = h.startReplication(cmd); stop {
+			// The stream has already reported its failure, or the connection has been lost
+			if err != nil {
+				logrus.Warnf("replication stream ended: %v", err)
+			}
+			return true, true, false, nil
+		}
+		return true, false, true, err
+	default:
+		return true, false, true, errors.Errorf("unknown replication command: %T", cmd)
+	}
+}
+
+// identifySystem handles the IDENTIFY_SYSTEM command. As each replication slot numbers its own commits, the reported
+// position is the furthest position that any slot has confirmed.
+func (h *ConnectionHandler) identifySystem() error {
+	database, err := h.currentDatabase()
+	if err != nil {
+		return err
+	}
+	position := walsender.InitialSlotLSN
+	for _, slot := range walsender.ReplicationSlots() {
+		if slot.ConfirmedLSN > position {
+			position = slot.ConfirmedLSN
+		}
+	}
+	return h.sendReplicationRow([]string{"systemid", "timeline", "xlogpos", "dbname"},
+		[]uint32{pgtype.TextOID, pgtype.Int4OID, pgtype.TextOID, pgtype.TextOID},
+		[][]byte{[]byte(replicationSystemID), []byte("1"), []byte(position.String()), []byte(database)},
+		"IDENTIFY_SYSTEM")
+}
+
+// createReplicationSlot handles the CREATE_REPLICATION_SLOT command. The new slot starts at the current HEAD of the
+// connection's branch, so that only later commits are streamed from it.
+func (h *ConnectionHandler) createReplicationSlot(cmd walsender.CreateReplicationSlotCommand) error {
+	if cmd.Physical {
+		return errors.New("physical replication slots are not supported")
+	}
+	if cmd.Plugin != "pgoutput" {
+		return errors.Errorf(`logical decoding output plugin "%s" is not supported`, cmd.Plugin)
+	}
+	if err := walsender.ValidateSlotName(cmd.Name); err != nil {
+		return err
+	}
+	database, err := h.currentDatabase()
+	if err != nil {
+		return err
+	}
+	result, err := h.replicationQuery("SELECT dolt_hashof('HEAD');")
+	if err != nil {
+		return err
+	}
+	if len(result.Rows) == 0 || len(result.Rows[0]) == 0 {
+		return errors.New("could not determine the current commit")
+	}
+	slot := walsender.ReplicationSlot{
+		Name:            cmd.Name,
+		Database:        database,
+		Plugin:          cmd.Plugin,
+		Temporary:       cmd.Temporary,
+		ConfirmedLSN:    walsender.InitialSlotLSN,
+		ConfirmedCommit: string(result.Rows[0][0]),
+	}
+	if err = walsender.CreateReplicationSlot(slot); err != nil {
+		return err
+	}
+	if slot.Temporary {
+		h.temporarySlots = append(h.temporarySlots, slot.Name)
+	}
+	return h.sendReplicationRow([]string{"slot_name", "consistent_point", "snapshot_name", "output_plugin"},
+		[]uint32{pgtype.TextOID, pgtype.TextOID, pgtype.TextOID, pgtype.TextOID},
+		[][]byte{[]byte(slot.Name), []byte(slot.ConfirmedLSN.String()), nil, []byte(slot.Plugin)},
+		"CREATE_REPLICATION_SLOT")
+}
+
+// startReplication handles the START_REPLICATION command, which streams the changes of the slot's publications until
+// the consumer ends the stream. Returns true if the connection should be closed, which happens when the stream fails,
+// as the consumer may still be sending messages.
+func (h *ConnectionHandler) startReplication(cmd walsender.StartReplicationCommand) (stop bool, err error) {
+	if cmd.Physical {
+		return false, errors.New("physical replication is not supported")
+	}
+	if cmd.Slot == "" {
+		return false, errors.New("logical replication requires a replication slot")
+	}
+	if protoVersion, ok := cmd.Options["proto_version"]; !ok {
+		return false, errors.New("proto_version option missing")
+	} else if protoVersion != "1" && protoVersion != "2" && protoVersion != "3" && protoVersion != "4" {
+		return false, errors.Errorf("client sent proto_version=%s but server only supports protocol 4 or lower", protoVersion)
+	}
+	pubNames, ok := cmd.Options["publication_names"]
+	if !ok {
+		return false, errors.New("publication_names parameter missing")
+	}
+	publications, err := walsender.ParsePublicationNames(pubNames)
+	if err != nil {
+		return false, err
+	}
+	for name := range cmd.Options {
+		switch name {
+		case "proto_version", "publication_names", "binary", "messages", "streaming", "two_phase", "origin":
+		default:
+			return false, errors.Errorf(`unrecognized pgoutput option: %s`, name)
+		}
+	}
+	if binary := strings.ToLower(cmd.Options["binary"]); binary == "true" || binary == "on" || binary == "1" {
+		return false, errors.New("the binary pgoutput option is not yet supported")
+	}
+
+	database, err := h.currentDatabase()
+	if err != nil {
+		return false, err
+	}
+	slot, err := walsender.AcquireReplicationSlot(cmd.Slot, int32(processID))
+	if err != nil {
+		return false, err
+	}
+	defer walsender.ReleaseReplicationSlot(slot.Name)
+	if slot.Database != database {
+		return false, errors.Errorf(`replication slot "%s" was not created in this database`, slot.Name)
+	}
+	stream, err := walsender.NewStream(slot, cmd.LSN, publications, h.replicationQuery,
+		func(walStart pglogrepl.LSN, walEnd pglogrepl.LSN, data []byte) error {
+			h.backend.Send(&pgproto3.CopyData{Data: walsender.EncodeXLogData(walStart, walEnd, time.Now(), data)})
+			return nil
+		})
+	if err != nil {
+		return false, err
+	}
+	if err = h.send(&pgproto3.CopyBothResponse{}); err != nil {
+		return true, err
+	}
+
+	// Messages from the consumer are read in the background, as the stream must be able to send changes while it
+	// waits for them. Only this goroutine reads from the connection until the consumer ends the stream.
+	type consumerMessage struct {
+		data []byte
+		done bool
+		err  error
+	}
+	received := make(chan consumerMessage)
+	finished := make(chan struct{})
+	defer close(finished)
+	go func() {
+		for {
+			msg, err := h.backend.Receive()
+			var cm consumerMessage
+			switch msg := msg.(type) {
+			case *pgproto3.CopyData:
+				cm.data = append([]byte(nil), msg.Data...)
+			case *pgproto3.CopyDone:
+				cm.done = true
+			case *pgproto3.Terminate:
+				cm.err = errors.New("the replication consumer terminated the connection")
+			default:
+				if err == nil {
+					err = errors.Errorf(`unexpected message "%T" during replication`, msg)
+				}
+				cm.err = err
+			}
+			select {
+			case received <- cm:
+			case <-finished:
+				return
+			}
+			if cm.done || cm.err != nil {
+				return
+			}
+		}
+	}()
+
+	// Failures while streaming are reported to the consumer, after which the connection is closed
+	fail := func(err error) (bool, error) {
+		h.sendError(err)
+		return true, nil
+	}
+	sendKeepalive := func(replyRequested bool) error {
+		return h.send(&pgproto3.CopyData{Data: walsender.EncodeKeepalive(stream.Position(), time.Now(), replyRequested)})
+	}
+	if err = stream.Poll(); err != nil {
+		return fail(err)
+	}
+	if err = sendKeepalive(false); err != nil {
+		return true, err
+	}
+	pollTicker := time.NewTicker(replicationPollInterval)
+	defer pollTicker.Stop()
+	keepaliveTicker := time.NewTicker(replicationKeepaliveInterval)
+	defer keepaliveTicker.Stop()
+	for {
+		select {
+		case msg := <-received:
+			if msg.err != nil {
+				return true, msg.err
+			}
+			if msg.done {
+				if err = h.send(&pgproto3.CopyDone{}); err != nil {
+					return true, err
+				}
+				return false, h.send(makeCommandComplete("COPY", 0))
+			}
+			status, ok, err := walsender.ParseStandbyMessage(msg.data)
+			if err != nil {
+				return fail(err)
+			} else if !ok {
+				continue
+			}
+			if err = stream.Confirm(status.FlushPosition); err != nil {
+				return fail(err)
+			}
+			if status.ReplyRequested {
+				if err = sendKeepalive(false); err != nil {
+					return true, err
+				}
+			}
+		case <-pollTicker.C:
+			if err = stream.Poll(); err != nil {
+				return fail(err)
+			}
+			if err = h.backend.Flush(); err != nil {
+				return true, err
+			}
+		case <-keepaliveTicker.C:
+			if err = sendKeepalive(false); err != nil {
+				return true, err
+			}
+		}
+	}
+}
+
+// dropTemporarySlots drops the temporary replication slots that were created by this connection.
+func (h *ConnectionHandler) dropTemporarySlots() {
+	for _, name := range h.temporarySlots {
+		walsender.ReleaseReplicationSlot(name)
+		if err := walsender.DropReplicationSlot(name); err != nil {
+			fmt.Printf("Failed to drop temporary replication slot %s:\n%v\n", name, err)
+		}
+	}
+	h.temporarySlots = nil
+}
+
+// replicationQuery runs a query for a replication stream, returning every row in its text format.
+func (h *ConnectionHandler) replicationQuery(query string) (walsender.QueryResult, error) {
+	queries, err := h.convertQuery(query)
+	if err != nil {
+		return walsender.QueryResult{}, err
+	}
+	if len(queries) != 1 {
+		return walsender.QueryResult{}, errors.Errorf("expected a single statement: %s", query)
+	}
+	var result walsender.QueryResult
+	err = h.doltgresHandler.ComQuery(context.Background(), h.mysqlConn, queries[0].String, queries[0].AST, func(_ *sql.Context, res *Result) error {
+		if result.Fields == nil {
+			result.Fields = res.Fields
+		}
+		for _, row := range res.Rows {
+			result.Rows = append(result.Rows, row.val)
+		}
+		return nil
+	})
+	return result, err
+}
+
+// sendReplicationRow sends the single-row result of a replication command.
+func (h *ConnectionHandler) sendReplicationRow(names []string, oids []uint32, values [][]byte, tag string) error {
+	fields := make([]pgproto3.FieldDescription, len(names))
+	for i, name := range names {
+		fields[i] = pgproto3.FieldDescription{
+			Name:         []byte(name),
+			DataTypeOID:  oids[i],
+			DataTypeSize: -1,
+			TypeModifier: -1,
+		}
+	}
+	h.backend.Send(&pgproto3.RowDescription{Fields: fields})
+	h.backend.Send(&pgproto3.DataRow{Values: values})
+	return h.send(&pgproto3.CommandComplete{CommandTag: []byte(tag)})
+}
+
+// currentDatabase returns the database of the connection's session.
+func (h *ConnectionHandler) currentDatabase() (string, error) {
+	ctx, err := h.doltgresHandler.NewContext(context.Background(), h.mysqlConn, "")
+	if err != nil {
+		return "", err
+	}
+	return ctx.GetCurrentDatabase(), nil
+}
+
+// removeName returns the names without the given name.
+func removeName(names []string, name string) []string {
+	for i, existing := range names {
+		if existing == name {
+			return append(names[:i], names[i+1:]...)
+		}
+	}
+	return names
+}
@@ -20,6 +20,7 @@ import (
 	_ "net/http/pprof"
 	"os"
 	"path/filepath"
+	"sync/atomic"
 
 	"github.com/cockroachdb/errors"
 	"github.com/dolthub/dolt/go/cmd/dolt/cli"
@@ -42,7 +43,9 @@ import (
 	"github.com/dolthub/doltgresql/server/auth"
 	"github.com/dolthub/doltgresql/server/initialization"
 	"github.com/dolthub/doltgresql/server/logrepl"
+	"github.com/dolthub/doltgresql/server/walsender"
 	"github.com/dolthub/doltgresql/servercfg"
+	"github.com/dolthub/doltgresql/servercfg/cfgdetails"
 )
 
 // Version should have a new line that follows, else the formatter will fail the PR created by the release GH action
@@ -89,6 +92,7 @@ func RunInMemory(cfg *servercfg.DoltgresConfig, protocolListenerFactory server.P
 // The returned WaitGroup may be used to wait for the server to close.
 func runServer(ctx context.Context, cfg *servercfg.DoltgresConfig, dEnv *env.DoltEnv, protocolListenerFactory server.ProtocolListenerFunc) (*svcs.Controller, error) {
 	initialization.Initialize(dEnv, cfg)
+	_, inMemory := dEnv.FS.(*filesys.InMemFS)
 
 	if dEnv.HasDoltDataDir() {
 		cwd, _ := dEnv.FS.Abs(".")
@@ -189,6 +193,11 @@ func runServer(ctx context.Context, cfg *servercfg.DoltgresConfig, dEnv *env.Dol
 		return nil, err
 	}
 
+	if err = startSubscriptions(controller, ssCfg, inMemory); err != nil {
+		controller.Stop()
+		return nil, err
+	}
+
 	// TODO: shutdown replication cleanly when we stop the server
 	_, err = startReplication(cfg, ssCfg)
 	if err != nil {
@@ -210,8 +219,13 @@ type doltgresEngineInitializer struct {
 
 var _ sqlserver.EngineInitializer = doltgresEngineInitializer{}
 
+// runningEngine is the engine of the most recently started server. Logical replication applies the changes that it
+// receives through this engine.
+var runningEngine atomic.Pointer[engine.SqlEngine]
+
 // InitializeEngine implements sqlserver.EngineInitializer.
 func (i doltgresEngineInitializer) InitializeEngine(ctx context.Context, se *engine.SqlEngine) error {
+	runningEngine.Store(se)
 	if err := configureAuthReplication(ctx, se.ClusterController()); err != nil {
 		return err
 	}
@@ -260,6 +274,26 @@ func getDefaultDatabaseName(userName string) string {
 	return userName
 }
 
+// NewReplicationContextFactory returns a factory for the contexts that logical replication applies its changes to the
+// given database of the running server with.
+func NewReplicationContextFactory(database string) logrepl.SqlContextFactory {
+	return func(ctx context.Context) (*sql.Context, error) {
+		se := runningEngine.Load()
+		if se == nil {
+			return nil, errors.Errorf("cannot replicate before the server has started")
+		}
+		sqlCtx, err := se.NewDefaultContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		// Like the default database initialization, the session must run as the doltgres superuser
+		user, _ := auth.GetSuperUserAndPassword()
+		sqlCtx.Session.SetClient(sql.Client{User: user, Address: "localhost", Capabilities: 0})
+		sqlCtx.SetCurrentDatabase(database)
+		return sqlCtx, nil
+	}
+}
+
 // startReplication begins the background thread that replicates from Postgres, if one is configured.
 func startReplication(cfg *servercfg.DoltgresConfig, ssCfg doltservercfg.ServerConfig) (*logrepl.LogicalReplicator, error) {
 	if cfg.PostgresReplicationConfig == nil {
@@ -276,6 +310,18 @@ func startReplication(cfg *servercfg.DoltgresConfig, ssCfg doltservercfg.ServerC
 		return nil, errors.Errorf("postgres replication slot name must be specified and not empty for replication")
 	}
 
+	doltCommits := false
+	if commitMode := cfg.PostgresReplicationConfig.CommitMode; commitMode != nil {
+		switch *commitMode {
+		case cfgdetails.PostgresReplicationCommitModeWorkingSet:
+		case cfgdetails.PostgresReplicationCommitModeDoltCommit:
+			doltCommits = true
+		default:
+			return nil, errors.Errorf(`postgres replication commit mode must be "%s" or "%s", found "%s"`,
+				cfgdetails.PostgresReplicationCommitModeWorkingSet, cfgdetails.PostgresReplicationCommitModeDoltCommit, *commitMode)
+		}
+	}
+
 	walFilePath := filepath.Join(ssCfg.CfgDir(), "pg_wal_location")
 	primaryDns := fmt.Sprintf(
 		"postgres://%s:%s@%s:%d/%s",
@@ -286,24 +332,51 @@ func startReplication(cfg *servercfg.DoltgresConfig, ssCfg doltservercfg.ServerC
 		*cfg.PostgresReplicationConfig.PostgresDatabase,
 	)
 
-	replicationDns := fmt.Sprintf(
-		"postgres://%s:%s@localhost:%d/%s",
-		ssCfg.User(),
-		ssCfg.Password(),
-		ssCfg.Port(),
-		"postgres", // TODO: this needs to come from config
-	)
-
-	replicator, err := logrepl.NewLogicalReplicator(walFilePath, primaryDns, replicationDns)
+	// TODO: the replicated database needs to come from config
+	replicator, err := logrepl.NewLogicalReplicator(walFilePath, primaryDns, NewReplicationContextFactory("postgres"))
 	if err != nil {
 		return nil, err
 	}
+	replicator.SetDoltCommits(doltCommits)
 
 	cli.Println("Starting replication")
-	go replicator.StartReplication(*cfg.PostgresReplicationConfig.SlotName)
+	go func() {
+		// The existing contents of the published tables are copied before any changes are streamed, which also resumes
+		// a synchronization that was interrupted
+		slotName := *cfg.PostgresReplicationConfig.SlotName
+		if err := replicator.SynchronizeTables(slotName); err != nil {
+			cli.PrintErrln("Unable to synchronize replicated tables:", err.Error())
+			return
+		}
+		_ = replicator.StartReplication(slotName)
+	}()
 	return replicator, nil
 }
 
+// startSubscriptions loads the subscriptions created by CREATE SUBSCRIPTION, and starts replicating the enabled ones.
+// It also loads the publications and replication slots that this server streams changes from.
+// Subscriptions are persisted alongside the server's configuration, except for in-memory servers, whose subscriptions
+// only last as long as the server, as their directory is removed once the server stops.
+func startSubscriptions(controller *svcs.Controller, ssCfg doltservercfg.ServerConfig, inMemory bool) error {
+	dir := filepath.Join(ssCfg.CfgDir(), "pg_subscriptions")
+	if inMemory {
+		var err error
+		dir, err = os.MkdirTemp("", "doltgres_subscriptions")
+		if err != nil {
+			return err
+		}
+		go func() {
+			_ = controller.WaitForStop()
+			_ = os.RemoveAll(dir)
+		}()
+	}
+	if err := logrepl.InitSubscriptions(dir, NewReplicationContextFactory); err != nil {
+		return err
+	}
+	// Publications and replication slots follow the same lifetime as subscriptions
+	return walsender.InitPublications(filepath.Join(dir, "publications"))
+}
+
 // configCliContext is a minimal implementation of CliContext that only supports Config()
 type configCliContext struct {
 	dEnv *env.DoltEnv
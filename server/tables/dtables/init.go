@@ -61,6 +61,9 @@ func Init() {
 	sqle.ConvertRowToRebasePlanStep = convertRowToRebasePlanStep
 	merge.MapCVType = mapCVType
 	merge.UnmapCVType = unmapCVType
+
+	// Doltgres-specific tables
+	initRootObjectTables()
 }
 
 // getBranchesTableName returns the name of the branches table.
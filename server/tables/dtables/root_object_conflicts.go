@@ -0,0 +1,112 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dtables
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/conflicts"
+	"github.com/dolthub/doltgresql/core/rootobject/objinterface"
+	"github.com/dolthub/doltgresql/server/node"
+	"github.com/dolthub/doltgresql/server/tables"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// RootObjectConflictsTableName is the name of the table that displays root object conflicts as SQL statements.
+const RootObjectConflictsTableName = "dolt_root_object_conflicts"
+
+// rootObjectTypeNames are the names displayed in the object_type column for each root object collection.
+var rootObjectTypeNames = map[objinterface.RootObjectID]string{
+	objinterface.RootObjectID_Sequences:          "sequence",
+	objinterface.RootObjectID_Types:              "type",
+	objinterface.RootObjectID_Functions:          "function",
+	objinterface.RootObjectID_Triggers:           "trigger",
+	objinterface.RootObjectID_Extensions:         "extension",
+	objinterface.RootObjectID_Procedures:         "procedure",
+	objinterface.RootObjectID_Casts:              "cast",
+	objinterface.RootObjectID_Operators:          "operator",
+	objinterface.RootObjectID_Aggregates:         "aggregate",
+	objinterface.RootObjectID_MaterializedViews:  "materialized view",
+	objinterface.RootObjectID_Policies:           "policy",
+	objinterface.RootObjectID_IndexAccessMethods: "index access method",
+}
+
+// RootObjectConflictsHandler is the handler for the dolt_root_object_conflicts table. Each row represents a single
+// root object conflict in the working set, with the base, ours, and theirs versions of the root object displayed as
+// the statements that would create them. Unlike the dolt_conflicts_ tables, this does not require any knowledge of the
+// fields that make up the root object. Conflicts are resolved using dolt_root_object_conflicts_resolve.
+type RootObjectConflictsHandler struct{}
+
+var _ tables.Handler = RootObjectConflictsHandler{}
+
+// Name implements the interface tables.Handler.
+func (r RootObjectConflictsHandler) Name() string {
+	return RootObjectConflictsTableName
+}
+
+// RowIter implements the interface tables.Handler.
+func (r RootObjectConflictsHandler) RowIter(ctx *sql.Context, partition sql.Partition) (sql.RowIter, error) {
+	collection, err := core.GetConflictsCollectionFromContext(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	var rows []sql.Row
+	err = collection.IterateConflicts(ctx, func(conflict conflicts.Conflict) (stop bool, err error) {
+		name := conflict.Name()
+		rows = append(rows, sql.Row{
+			name.Schema,
+			name.Name,
+			rootObjectTypeNames[conflict.RootObjectID],
+			conflict.FromHash,
+			rootObjectConflictDefinition(conflict.Ancestor),
+			rootObjectConflictDefinition(conflict.Ours),
+			rootObjectConflictDefinition(conflict.Theirs),
+		})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(rows...), nil
+}
+
+// PkSchema implements the interface tables.Handler.
+func (r RootObjectConflictsHandler) PkSchema() sql.PrimaryKeySchema {
+	return sql.PrimaryKeySchema{
+		Schema: sql.Schema{
+			{Name: "schema_name", Type: pgtypes.Text, Default: nil, Nullable: false, Source: RootObjectConflictsTableName},
+			{Name: "name", Type: pgtypes.Text, Default: nil, Nullable: false, Source: RootObjectConflictsTableName},
+			{Name: "object_type", Type: pgtypes.Text, Default: nil, Nullable: false, Source: RootObjectConflictsTableName},
+			{Name: "from_root_ish", Type: pgtypes.Text, Default: nil, Nullable: false, Source: RootObjectConflictsTableName},
+			{Name: "base_definition", Type: pgtypes.Text, Default: nil, Nullable: true, Source: RootObjectConflictsTableName},
+			{Name: "our_definition", Type: pgtypes.Text, Default: nil, Nullable: true, Source: RootObjectConflictsTableName},
+			{Name: "their_definition", Type: pgtypes.Text, Default: nil, Nullable: true, Source: RootObjectConflictsTableName},
+		},
+		PkOrdinals: nil,
+	}
+}
+
+// rootObjectConflictDefinition returns the statements that create the given side of a conflict. Returns nil if the
+// root object does not exist on that side (such as when it was deleted), or if it cannot be expressed as a statement.
+func rootObjectConflictDefinition(rootObj objinterface.RootObject) any {
+	if rootObj == nil {
+		return nil
+	}
+	if definition := node.RootObjectDefinition(rootObj); definition != "" {
+		return definition
+	}
+	return nil
+}
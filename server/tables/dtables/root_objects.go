@@ -0,0 +1,478 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dtables
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"sort"
+
+	"github.com/cockroachdb/errors"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/id"
+	"github.com/dolthub/doltgresql/core/rootobject"
+	"github.com/dolthub/doltgresql/core/rootobject/objinterface"
+	"github.com/dolthub/doltgresql/server/tables"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// rootObjectTableNames are the names used by the diff and history system tables for each root object collection.
+// Conflicts are excluded, as they're exposed through the conflict system tables.
+var rootObjectTableNames = map[objinterface.RootObjectID]string{
+	objinterface.RootObjectID_Sequences:          "sequences",
+	objinterface.RootObjectID_Types:              "types",
+	objinterface.RootObjectID_Functions:          "functions",
+	objinterface.RootObjectID_Triggers:           "triggers",
+	objinterface.RootObjectID_Extensions:         "extensions",
+	objinterface.RootObjectID_Procedures:         "procedures",
+	objinterface.RootObjectID_Casts:              "casts",
+	objinterface.RootObjectID_Operators:          "operators",
+	objinterface.RootObjectID_Aggregates:         "aggregates",
+	objinterface.RootObjectID_MaterializedViews:  "materialized_views",
+	objinterface.RootObjectID_Policies:           "policies",
+	objinterface.RootObjectID_IndexAccessMethods: "index_access_methods",
+}
+
+// initRootObjectTables registers the diff and history system tables for every root object collection, along with the
+// root object conflicts table.
+func initRootObjectTables() {
+	for rootObjID, name := range rootObjectTableNames {
+		tables.AddSystemTableHandler(RootObjectDiffHandler{rootObjID: rootObjID, name: "dolt_diff_" + name})
+		tables.AddSystemTableHandler(RootObjectHistoryHandler{rootObjID: rootObjID, name: "dolt_history_" + name})
+	}
+	tables.AddSystemTableHandler(RootObjectConflictsHandler{})
+}
+
+// RootObjectDiffHandler is the handler for the dolt_diff_ table of a root object collection, such as
+// dolt_diff_functions. Each row represents a single changed field of a root object between a commit and its first
+// parent, beginning with the working set against HEAD.
+type RootObjectDiffHandler struct {
+	rootObjID objinterface.RootObjectID
+	name      string
+	// database is the database that the table was resolved from.
+	database string
+}
+
+var _ tables.DatabaseHandler = RootObjectDiffHandler{}
+
+// Name implements the interface tables.Handler.
+func (r RootObjectDiffHandler) Name() string {
+	return r.name
+}
+
+// WithDatabase implements the interface tables.DatabaseHandler.
+func (r RootObjectDiffHandler) WithDatabase(database string) tables.Handler {
+	r.database = database
+	return r
+}
+
+// RowIter implements the interface tables.Handler.
+func (r RootObjectDiffHandler) RowIter(ctx *sql.Context, partition sql.Partition) (sql.RowIter, error) {
+	commits, err := newRootObjectCommitIter(ctx, r.database)
+	if err != nil {
+		return nil, err
+	}
+	return &rootObjectRowIter{commits: commits, commitRows: r.commitRows}, nil
+}
+
+// commitRows returns the rows for the changes between the given commit and its parent.
+func (r RootObjectDiffHandler) commitRows(ctx *sql.Context, to rootObjectCommit, from rootObjectCommit) ([]sql.Row, error) {
+	if from.root == nil {
+		return nil, nil
+	}
+	if changed, err := rootObjectCollectionChanged(ctx, r.rootObjID, to.root, from.root); err != nil || !changed {
+		return nil, err
+	}
+	toObjects, err := rootobject.LoadRootObjects(ctx, to.root, r.rootObjID)
+	if err != nil {
+		return nil, err
+	}
+	fromObjects, err := rootobject.LoadRootObjects(ctx, from.root, r.rootObjID)
+	if err != nil {
+		return nil, err
+	}
+	var rows []sql.Row
+	commitColumns := sql.Row{to.hash, to.date, from.hash, from.date}
+	for _, objID := range sortedRootObjectIDs(toObjects, fromObjects) {
+		toObj, fromObj := toObjects[objID], fromObjects[objID]
+		var objName doltdb.TableName
+		var diffType string
+		switch {
+		case fromObj == nil:
+			objName = toObj.Name()
+			diffType = "added"
+		case toObj == nil:
+			objName = fromObj.Name()
+			diffType = "removed"
+		default:
+			objName = toObj.Name()
+			diffType = "modified"
+			if same, err := rootObjectsEqual(ctx, toObj, fromObj); err != nil {
+				return nil, err
+			} else if same {
+				continue
+			}
+		}
+		objColumns := append(commitColumns.Copy(), objName.Schema, objName.Name, diffType)
+		if diffType != "modified" {
+			rows = append(rows, append(objColumns, nil, nil, nil))
+			continue
+		}
+		// Without an ancestor, each differing field is returned with "ours" holding the "to" value and "theirs"
+		// holding the "from" value.
+		diffs, _, err := rootobject.DiffRootObjects(ctx, r.rootObjID, from.hash, toObj, fromObj, nil)
+		if err != nil {
+			return nil, err
+		}
+		if len(diffs) == 0 {
+			// The objects differ in a field that does not participate in diffs
+			rows = append(rows, append(objColumns, nil, nil, nil))
+			continue
+		}
+		for _, diff := range diffs {
+			fromValue, err := rootObjectDiffValue(ctx, diff, diff.TheirValue)
+			if err != nil {
+				return nil, err
+			}
+			toValue, err := rootObjectDiffValue(ctx, diff, diff.OurValue)
+			if err != nil {
+				return nil, err
+			}
+			rows = append(rows, append(objColumns.Copy(), diff.FieldName, fromValue, toValue))
+		}
+	}
+	return rows, nil
+}
+
+// PkSchema implements the interface tables.Handler.
+func (r RootObjectDiffHandler) PkSchema() sql.PrimaryKeySchema {
+	return sql.PrimaryKeySchema{
+		Schema: sql.Schema{
+			{Name: "to_commit", Type: pgtypes.Text, Default: nil, Nullable: false, Source: r.name},
+			{Name: "to_commit_date", Type: pgtypes.Timestamp, Default: nil, Nullable: true, Source: r.name},
+			{Name: "from_commit", Type: pgtypes.Text, Default: nil, Nullable: false, Source: r.name},
+			{Name: "from_commit_date", Type: pgtypes.Timestamp, Default: nil, Nullable: true, Source: r.name},
+			{Name: "schema_name", Type: pgtypes.Text, Default: nil, Nullable: false, Source: r.name},
+			{Name: "name", Type: pgtypes.Text, Default: nil, Nullable: false, Source: r.name},
+			{Name: "diff_type", Type: pgtypes.Text, Default: nil, Nullable: false, Source: r.name},
+			{Name: "field_name", Type: pgtypes.Text, Default: nil, Nullable: true, Source: r.name},
+			{Name: "from_value", Type: pgtypes.Text, Default: nil, Nullable: true, Source: r.name},
+			{Name: "to_value", Type: pgtypes.Text, Default: nil, Nullable: true, Source: r.name},
+		},
+		PkOrdinals: nil,
+	}
+}
+
+// RootObjectHistoryHandler is the handler for the dolt_history_ table of a root object collection, such as
+// dolt_history_sequences. Each row represents a root object as it existed in a commit, walking the first parent of
+// each commit starting from HEAD.
+type RootObjectHistoryHandler struct {
+	rootObjID objinterface.RootObjectID
+	name      string
+	// database is the database that the table was resolved from.
+	database string
+}
+
+var _ tables.DatabaseHandler = RootObjectHistoryHandler{}
+
+// Name implements the interface tables.Handler.
+func (r RootObjectHistoryHandler) Name() string {
+	return r.name
+}
+
+// WithDatabase implements the interface tables.DatabaseHandler.
+func (r RootObjectHistoryHandler) WithDatabase(database string) tables.Handler {
+	r.database = database
+	return r
+}
+
+// RowIter implements the interface tables.Handler.
+func (r RootObjectHistoryHandler) RowIter(ctx *sql.Context, partition sql.Partition) (sql.RowIter, error) {
+	commits, err := newRootObjectCommitIter(ctx, r.database)
+	if err != nil {
+		return nil, err
+	}
+	return &rootObjectRowIter{commits: commits, commitRows: r.commitRows}, nil
+}
+
+// commitRows returns a row for each root object in the given commit.
+func (r RootObjectHistoryHandler) commitRows(ctx *sql.Context, commit rootObjectCommit, parent rootObjectCommit) ([]sql.Row, error) {
+	// The working set is not part of the history
+	if commit.meta == nil {
+		return nil, nil
+	}
+	objects, err := rootobject.LoadRootObjects(ctx, commit.root, r.rootObjID)
+	if err != nil {
+		return nil, err
+	}
+	// The parent's objects are only needed when the collection changed, as otherwise no object has changed
+	collectionChanged := true
+	var parentObjects map[id.Id]objinterface.RootObject
+	if parent.root != nil {
+		if collectionChanged, err = rootObjectCollectionChanged(ctx, r.rootObjID, commit.root, parent.root); err != nil {
+			return nil, err
+		}
+		if collectionChanged {
+			if parentObjects, err = rootobject.LoadRootObjects(ctx, parent.root, r.rootObjID); err != nil {
+				return nil, err
+			}
+		}
+	}
+	rows := make([]sql.Row, 0, len(objects))
+	for _, objID := range sortedRootObjectIDs(objects, nil) {
+		obj := objects[objID]
+		changed := collectionChanged
+		if parentObj, ok := parentObjects[objID]; ok {
+			same, err := rootObjectsEqual(ctx, obj, parentObj)
+			if err != nil {
+				return nil, err
+			}
+			changed = !same
+		}
+		objName := obj.Name()
+		rows = append(rows, sql.Row{
+			objName.Schema,          // schema_name
+			objName.Name,            // name
+			changed,                 // changed
+			commit.hash,             // commit_hash
+			commit.meta.Name,        // committer
+			commit.meta.Email,       // email
+			commit.date,             // commit_date
+			commit.meta.Description, // message
+		})
+	}
+	return rows, nil
+}
+
+// PkSchema implements the interface tables.Handler.
+func (r RootObjectHistoryHandler) PkSchema() sql.PrimaryKeySchema {
+	return sql.PrimaryKeySchema{
+		Schema: sql.Schema{
+			{Name: "schema_name", Type: pgtypes.Text, Default: nil, Nullable: false, Source: r.name},
+			{Name: "name", Type: pgtypes.Text, Default: nil, Nullable: false, Source: r.name},
+			{Name: "changed", Type: pgtypes.Bool, Default: nil, Nullable: false, Source: r.name},
+			{Name: "commit_hash", Type: pgtypes.Text, Default: nil, Nullable: false, Source: r.name},
+			{Name: "committer", Type: pgtypes.Text, Default: nil, Nullable: false, Source: r.name},
+			{Name: "email", Type: pgtypes.Text, Default: nil, Nullable: false, Source: r.name},
+			{Name: "commit_date", Type: pgtypes.Timestamp, Default: nil, Nullable: false, Source: r.name},
+			{Name: "message", Type: pgtypes.Text, Default: nil, Nullable: false, Source: r.name},
+		},
+		PkOrdinals: nil,
+	}
+}
+
+// rootObjectCommit is a commit (or the working set) that is returned by rootObjectCommitIter.
+type rootObjectCommit struct {
+	hash string
+	// date is the commit date, which is nil for the working set.
+	date any
+	// meta is the commit's metadata, which is nil for the working set.
+	meta *rootObjectCommitMeta
+	root objinterface.RootValue
+}
+
+// rootObjectCommitMeta is the subset of a commit's metadata that is exposed by the history tables.
+type rootObjectCommitMeta struct {
+	Name        string
+	Email       string
+	Description string
+}
+
+// rootObjectCommitIter walks the working set and HEAD, and then each commit and its first parent, starting with HEAD
+// and walking backward. Each commit is only loaded once the walk reaches it. For the initial commit, the parent has a
+// nil root.
+type rootObjectCommitIter struct {
+	ddb *doltdb.DoltDB
+	// working is the working set, which is cleared once it has been returned.
+	working *rootObjectCommit
+	// commit is the commit that will be returned next along with its parent, which is nil once the walk has ended.
+	commit *doltdb.Commit
+	// current is the loaded form of commit.
+	current rootObjectCommit
+}
+
+// newRootObjectCommitIter returns a new *rootObjectCommitIter for the given database.
+func newRootObjectCommitIter(ctx *sql.Context, dbName string) (*rootObjectCommitIter, error) {
+	sess := dsess.DSessFromSess(ctx.Session)
+	ddb, ok := sess.GetDoltDB(ctx, dbName)
+	if !ok {
+		return nil, errors.Errorf("database not found: %s", dbName)
+	}
+	roots, ok := sess.GetRoots(ctx, dbName)
+	if !ok {
+		return nil, errors.Errorf("database not found: %s", dbName)
+	}
+	workingRoot, ok := roots.Working.(*core.RootValue)
+	if !ok {
+		return nil, errors.Errorf("unexpected root value type: %T", roots.Working)
+	}
+	headCommit, err := sess.GetHeadCommit(ctx, dbName)
+	if err != nil {
+		return nil, err
+	}
+	head, err := loadRootObjectCommit(ctx, headCommit)
+	if err != nil {
+		return nil, err
+	}
+	return &rootObjectCommitIter{
+		ddb:     ddb,
+		working: &rootObjectCommit{hash: "WORKING", root: workingRoot},
+		commit:  headCommit,
+		current: head,
+	}, nil
+}
+
+// Next returns the next commit along with its parent, or io.EOF once the walk has ended.
+func (iter *rootObjectCommitIter) Next(ctx *sql.Context) (commit rootObjectCommit, parent rootObjectCommit, err error) {
+	if iter.working != nil {
+		working := *iter.working
+		iter.working = nil
+		return working, iter.current, nil
+	}
+	if iter.commit == nil {
+		return rootObjectCommit{}, rootObjectCommit{}, io.EOF
+	}
+	var parentCommit *doltdb.Commit
+	if iter.commit.NumParents() > 0 {
+		optCommit, err := iter.ddb.ResolveParent(ctx, iter.commit, 0)
+		if err != nil {
+			return rootObjectCommit{}, rootObjectCommit{}, err
+		}
+		// A ghost commit (from a shallow clone) ends the walk, as we cannot load its root
+		var ok bool
+		if parentCommit, ok = optCommit.ToCommit(); ok {
+			if parent, err = loadRootObjectCommit(ctx, parentCommit); err != nil {
+				return rootObjectCommit{}, rootObjectCommit{}, err
+			}
+		}
+	}
+	commit = iter.current
+	iter.commit, iter.current = parentCommit, parent
+	return commit, parent, nil
+}
+
+// rootObjectRowIter is a sql.RowIter that returns the rows of each commit as the walk reaches it, so that the history
+// is only read as far as the rows are consumed.
+type rootObjectRowIter struct {
+	commits *rootObjectCommitIter
+	// commitRows returns the rows for a commit and its parent.
+	commitRows func(ctx *sql.Context, commit rootObjectCommit, parent rootObjectCommit) ([]sql.Row, error)
+	rows       []sql.Row
+}
+
+var _ sql.RowIter = (*rootObjectRowIter)(nil)
+
+// Next implements the interface sql.RowIter.
+func (iter *rootObjectRowIter) Next(ctx *sql.Context) (sql.Row, error) {
+	for len(iter.rows) == 0 {
+		commit, parent, err := iter.commits.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if iter.rows, err = iter.commitRows(ctx, commit, parent); err != nil {
+			return nil, err
+		}
+	}
+	row := iter.rows[0]
+	iter.rows = iter.rows[1:]
+	return row, nil
+}
+
+// Close implements the interface sql.RowIter.
+func (iter *rootObjectRowIter) Close(ctx *sql.Context) error {
+	return nil
+}
+
+// rootObjectCollectionChanged returns whether the collection matching the given ID differs between the two roots.
+func rootObjectCollectionChanged(ctx context.Context, rootObjID objinterface.RootObjectID, root objinterface.RootValue, parentRoot objinterface.RootValue) (bool, error) {
+	coll, err := rootobject.LoadCollection(ctx, root, rootObjID)
+	if err != nil || coll == nil {
+		return true, err
+	}
+	return coll.DiffersFrom(ctx, parentRoot)
+}
+
+// loadRootObjectCommit loads the hash, metadata, and root of the given commit.
+func loadRootObjectCommit(ctx *sql.Context, commit *doltdb.Commit) (rootObjectCommit, error) {
+	commitHash, err := commit.HashOf()
+	if err != nil {
+		return rootObjectCommit{}, err
+	}
+	meta, err := commit.GetCommitMeta(ctx)
+	if err != nil {
+		return rootObjectCommit{}, err
+	}
+	root, err := commit.GetRootValue(ctx)
+	if err != nil {
+		return rootObjectCommit{}, err
+	}
+	coreRoot, ok := root.(*core.RootValue)
+	if !ok {
+		return rootObjectCommit{}, errors.Errorf("unexpected root value type: %T", root)
+	}
+	return rootObjectCommit{
+		hash: commitHash.String(),
+		date: meta.Time(),
+		meta: &rootObjectCommitMeta{
+			Name:        meta.Name,
+			Email:       meta.Email,
+			Description: meta.Description,
+		},
+		root: coreRoot,
+	}, nil
+}
+
+// sortedRootObjectIDs returns the union of the IDs in both maps, in sorted order.
+func sortedRootObjectIDs(left map[id.Id]objinterface.RootObject, right map[id.Id]objinterface.RootObject) []id.Id {
+	ids := make([]id.Id, 0, len(left)+len(right))
+	for objID := range left {
+		ids = append(ids, objID)
+	}
+	for objID := range right {
+		if _, ok := left[objID]; !ok {
+			ids = append(ids, objID)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+	return ids
+}
+
+// rootObjectsEqual returns whether both root objects have the same serialized form.
+func rootObjectsEqual(ctx context.Context, left objinterface.RootObject, right objinterface.RootObject) (bool, error) {
+	leftData, err := left.Serialize(ctx)
+	if err != nil {
+		return false, err
+	}
+	rightData, err := right.Serialize(ctx)
+	if err != nil {
+		return false, err
+	}
+	return bytes.Equal(leftData, rightData), nil
+}
+
+// rootObjectDiffValue returns the text output of a value from the given diff.
+func rootObjectDiffValue(ctx *sql.Context, diff objinterface.RootObjectDiff, value any) (any, error) {
+	if value == nil {
+		return nil, nil
+	}
+	return diff.Type.IoOutput(ctx, value)
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/dolthub/doltgresql/core/id"
 	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
 	"github.com/dolthub/doltgresql/postgres/parser/pgerror"
+	"github.com/dolthub/doltgresql/server/temporary"
 )
 
 // PgDatabase wraps a sqle.Database to add PostgreSQL-specific behavior.
@@ -93,8 +94,12 @@ func (d *PgDatabase) AllSchemas(ctx *sql.Context) ([]sql.DatabaseSchema, error)
 	return schemas, nil
 }
 
-// GetSchema overrides sqle.Database.GetSchema to apply Doltgres schema wrapping.
+// GetSchema overrides sqle.Database.GetSchema to apply Doltgres schema wrapping, and to return the session's
+// temporary schema.
 func (d *PgDatabase) GetSchema(ctx *sql.Context, schemaName string) (sql.DatabaseSchema, bool, error) {
+	if temporary.IsSchema(ctx.Session.ID(), schemaName) {
+		return newTemporarySchema(ctx, d)
+	}
 	schema, ok, err := d.Database.GetSchema(ctx, schemaName)
 	if !ok || err != nil {
 		return schema, ok, err
@@ -103,7 +108,7 @@ func (d *PgDatabase) GetSchema(ctx *sql.Context, schemaName string) (sql.Databas
 }
 
 // GetTableInsensitive overrides sqle.Database.GetTableInsensitive to check the pg_catalog
-// virtual schema before falling back to user tables.
+// virtual schema before falling back to user tables, and then to Doltgres system tables.
 func (d *PgDatabase) GetTableInsensitive(ctx *sql.Context, tblName string) (sql.Table, bool, error) {
 	if resolve.UseSearchPath && d.Database.Schema() == "" && strings.HasPrefix(strings.ToLower(tblName), "pg_") {
 		sdb, found, err := d.GetSchema(ctx, "pg_catalog")
@@ -120,7 +125,21 @@ func (d *PgDatabase) GetTableInsensitive(ctx *sql.Context, tblName string) (sql.
 			}
 		}
 	}
-	return d.Database.GetTableInsensitive(ctx, tblName)
+	tbl, ok, err := d.Database.GetTableInsensitive(ctx, tblName)
+	if ok || err != nil {
+		return tbl, ok, err
+	}
+	tbl, ok = getSystemTable(tblName, d)
+	return tbl, ok, nil
+}
+
+// GetTableNames overrides sqle.Database.GetTableNames to hide the tables that hold the posting lists of GIN indexes.
+func (d *PgDatabase) GetTableNames(ctx *sql.Context) ([]string, error) {
+	tableNames, err := d.Database.GetTableNames(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return withoutGinPostingTables(tableNames), nil
 }
 
 // DropTable overrides sqle.Database.DropTable to prevent dropping virtual pg_catalog tables.
@@ -143,6 +162,25 @@ func (d *PgDatabase) DropTable(ctx *sql.Context, tableName string) error {
 	return d.Database.DropTable(ctx, tableName)
 }
 
+// GetViewDefinition overrides sqle.Database.GetViewDefinition so that the session's temporary views are found ahead
+// of the views in the schemas on the search path. As the schema that a view is requested from is not always known,
+// this also applies when the view name is qualified with a schema.
+func (d *PgDatabase) GetViewDefinition(ctx *sql.Context, viewName string) (sql.ViewDefinition, bool, error) {
+	if view, ok := temporary.GetView(ctx.Session.ID(), d.Database.Name(), viewName); ok {
+		return temporaryViewDefinition(ctx.Session.ID(), view), true, nil
+	}
+	return d.Database.GetViewDefinition(ctx, viewName)
+}
+
+// DropView overrides sqle.Database.DropView so that the session's temporary views are dropped ahead of the views in
+// the schemas on the search path.
+func (d *PgDatabase) DropView(ctx *sql.Context, name string) error {
+	if temporary.DropView(ctx.Session.ID(), d.Database.Name(), name) {
+		return nil
+	}
+	return d.Database.DropView(ctx, name)
+}
+
 // AllSchemas overrides sqle.ReadOnlyDatabase.AllSchemas to apply Doltgres schema wrapping.
 func (d *PgReadOnlyDatabase) AllSchemas(ctx *sql.Context) ([]sql.DatabaseSchema, error) {
 	schemas, err := d.ReadOnlyDatabase.AllSchemas(ctx)
@@ -165,7 +203,7 @@ func (d *PgReadOnlyDatabase) GetSchema(ctx *sql.Context, schemaName string) (sql
 }
 
 // GetTableInsensitive overrides sqle.Database.GetTableInsensitive to check the pg_catalog
-// virtual schema before falling back to user tables.
+// virtual schema before falling back to user tables, and then to Doltgres system tables.
 func (d *PgReadOnlyDatabase) GetTableInsensitive(ctx *sql.Context, tblName string) (sql.Table, bool, error) {
 	if resolve.UseSearchPath && d.ReadOnlyDatabase.Schema() == "" && strings.HasPrefix(strings.ToLower(tblName), "pg_") {
 		sdb, found, err := d.GetSchema(ctx, "pg_catalog")
@@ -182,7 +220,34 @@ func (d *PgReadOnlyDatabase) GetTableInsensitive(ctx *sql.Context, tblName strin
 			}
 		}
 	}
-	return d.ReadOnlyDatabase.GetTableInsensitive(ctx, tblName)
+	tbl, ok, err := d.ReadOnlyDatabase.GetTableInsensitive(ctx, tblName)
+	if ok || err != nil {
+		return tbl, ok, err
+	}
+	tbl, ok = getSystemTable(tblName, d)
+	return tbl, ok, nil
+}
+
+// GetTableNames overrides sqle.ReadOnlyDatabase.GetTableNames to hide the tables that hold the posting lists of GIN
+// indexes.
+func (d *PgReadOnlyDatabase) GetTableNames(ctx *sql.Context) ([]string, error) {
+	tableNames, err := d.ReadOnlyDatabase.GetTableNames(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return withoutGinPostingTables(tableNames), nil
+}
+
+// withoutGinPostingTables removes the names of GIN posting tables from the given names. The posting tables are
+// maintained alongside their indexes, and are not visible as relations of their own.
+func withoutGinPostingTables(tableNames []string) []string {
+	filtered := tableNames[:0]
+	for _, tableName := range tableNames {
+		if !core.IsGinPostingTable(tableName) {
+			filtered = append(filtered, tableName)
+		}
+	}
+	return filtered
 }
 
 // ValidateNewIndexName implements the sql.SchemaObjectNameValidator interface
@@ -21,6 +21,7 @@ import (
 	"github.com/dolthub/doltgresql/core"
 	"github.com/dolthub/doltgresql/core/extensions"
 	"github.com/dolthub/doltgresql/core/id"
+	"github.com/dolthub/doltgresql/core/policies"
 	"github.com/dolthub/doltgresql/core/triggers"
 	"github.com/dolthub/doltgresql/server/functions"
 )
@@ -93,6 +94,9 @@ type pgCatalogCache struct {
 	// pg_trigger
 	triggers []triggers.Trigger
 
+	// pg_policy / pg_policies
+	policies []policies.Policy
+
 	// pg_rewrite
 	rewrites []pgRewrite
 
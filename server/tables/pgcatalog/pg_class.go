@@ -100,17 +100,32 @@ func cachePgClasses(ctx *sql.Context, pgCatalogCache *pgCatalogCache) error {
 	if err != nil {
 		return err
 	}
+	// Row-level security settings are stored separately from their tables
+	policiesCollection, err := core.GetPoliciesCollectionFromContext(ctx, ctx.GetCurrentDatabase())
+	if err != nil {
+		return err
+	}
+	// Materialized views store their rows in tables, so they're distinguished by their definitions
+	matviewsCollection, err := core.GetMaterializedViewsCollectionFromContext(ctx, ctx.GetCurrentDatabase())
+	if err != nil {
+		return err
+	}
 
 	err = functions.IterateCurrentDatabase(ctx, functions.Callbacks{
 		Index: func(ctx *sql.Context, schema functions.ItemSchema, table functions.ItemTable, index functions.ItemIndex) (cont bool, err error) {
 			tableHasIndexes[id.Cache().ToOID(table.OID.AsId())] = struct{}{}
 			schemaOid := schema.OID
+			accessMethod, err := core.GetIndexAccessMethod(ctx, schema.Item.SchemaName(), index.Item)
+			if err != nil {
+				return false, err
+			}
 			class := &pgClass{
 				oid:             index.OID.AsId(),
 				oidNative:       id.Cache().ToOID(index.OID.AsId()),
 				name:            formatIndexName(index.Item),
 				hasIndexes:      false,
 				kind:            "i",
+				accessMethod:    accessMethod.Name,
 				schemaOid:       schemaOid.AsId(),
 				schemaOidNative: id.Cache().ToOID(schemaOid.AsId()),
 				relType:         id.Null,
@@ -142,16 +157,23 @@ func cachePgClasses(ctx *sql.Context, pgCatalogCache *pgCatalogCache) error {
 					}
 				}
 			}
+			kind := "r"
+			if matviewsCollection.HasMaterializedView(ctx, id.NewView(table.OID.SchemaName(), table.OID.TableName())) {
+				kind = "m"
+			}
+			security := policiesCollection.GetTableSecurity(ctx, table.OID)
 			class := &pgClass{
-				oid:             table.OID.AsId(),
-				oidNative:       id.Cache().ToOID(table.OID.AsId()),
-				name:            table.Item.Name(),
-				hasIndexes:      hasIndexes,
-				hasTriggers:     hasTriggers,
-				kind:            "r",
-				schemaOid:       schema.OID.AsId(),
-				schemaOidNative: id.Cache().ToOID(schema.OID.AsId()),
-				relType:         id.NewType(table.OID.SchemaName(), table.OID.SchemaName()).AsId(),
+				oid:              table.OID.AsId(),
+				oidNative:        id.Cache().ToOID(table.OID.AsId()),
+				name:             table.Item.Name(),
+				hasIndexes:       hasIndexes,
+				hasTriggers:      hasTriggers,
+				rowSecurity:      security.Enabled,
+				forceRowSecurity: security.Forced,
+				kind:             kind,
+				schemaOid:        schema.OID.AsId(),
+				schemaOidNative:  id.Cache().ToOID(schema.OID.AsId()),
+				relType:          id.NewType(table.OID.SchemaName(), table.OID.SchemaName()).AsId(),
 			}
 			nameIdx.Add(class)
 			oidIdx.Add(class)
@@ -410,15 +432,18 @@ var pgClassSchema = sql.Schema{
 // pgClass represents a row in the pg_class table.
 // We store oids in their native format as well so that we can do range scans on them.
 type pgClass struct {
-	oid             id.Id
-	oidNative       uint32
-	name            string
-	schemaOid       id.Id
-	schemaOidNative uint32
-	hasIndexes      bool
-	hasTriggers     bool
-	kind            string // r = ordinary table, i = index, S = sequence, t = TOAST table, v = view, m = materialized view, c = composite type, f = foreign table, p = partitioned table, I = partitioned index
-	relType         id.Id
+	oid              id.Id
+	oidNative        uint32
+	name             string
+	schemaOid        id.Id
+	schemaOidNative  uint32
+	hasIndexes       bool
+	hasTriggers      bool
+	rowSecurity      bool
+	forceRowSecurity bool
+	kind             string // r = ordinary table, i = index, S = sequence, t = TOAST table, v = view, m = materialized view, c = composite type, f = foreign table, p = partitioned table, I = partitioned index
+	relType          id.Id
+	accessMethod     string // only set for indexes
 }
 
 // lessOid is a sort function for pgClass based on oid.
@@ -457,46 +482,46 @@ func pgClassToRow(class *pgClass) sql.Row {
 	// TODO: this is temporary definition of 'relam' field
 	var relam = id.Null
 	if class.kind == "i" {
-		relam = id.NewAccessMethod("btree").AsId()
-	} else if class.kind == "r" || class.kind == "t" {
+		relam = id.NewAccessMethod(class.accessMethod).AsId()
+	} else if class.kind == "r" || class.kind == "t" || class.kind == "m" {
 		relam = id.NewAccessMethod("heap").AsId()
 	}
 
 	// TODO: Fill in the rest of the pg_class columns
 	return sql.Row{
-		class.oid,         // oid
-		class.name,        // relname
-		class.schemaOid,   // relnamespace
-		class.relType,     // reltype
-		id.Null,           // reloftype
-		id.Null,           // relowner
-		relam,             // relam
-		id.Null,           // relfilenode
-		id.Null,           // reltablespace
-		int32(0),          // relpages
-		float32(0),        // reltuples
-		int32(0),          // relallvisible
-		id.Null,           // reltoastrelid
-		class.hasIndexes,  // relhasindex
-		false,             // relisshared
-		"p",               // relpersistence
-		class.kind,        // relkind
-		int16(0),          // relnatts
-		int16(0),          // relchecks
-		false,             // relhasrules
-		class.hasTriggers, // relhastriggers
-		false,             // relhassubclass
-		false,             // relrowsecurity
-		false,             // relforcerowsecurity
-		true,              // relispopulated
-		"d",               // relreplident
-		false,             // relispartition
-		id.Null,           // relrewrite
-		uint32(0),         // relfrozenxid
-		uint32(0),         // relminmxid
-		nil,               // relacl
-		nil,               // reloptions
-		nil,               // relpartbound
+		class.oid,              // oid
+		class.name,             // relname
+		class.schemaOid,        // relnamespace
+		class.relType,          // reltype
+		id.Null,                // reloftype
+		id.Null,                // relowner
+		relam,                  // relam
+		id.Null,                // relfilenode
+		id.Null,                // reltablespace
+		int32(0),               // relpages
+		float32(0),             // reltuples
+		int32(0),               // relallvisible
+		id.Null,                // reltoastrelid
+		class.hasIndexes,       // relhasindex
+		false,                  // relisshared
+		"p",                    // relpersistence
+		class.kind,             // relkind
+		int16(0),               // relnatts
+		int16(0),               // relchecks
+		false,                  // relhasrules
+		class.hasTriggers,      // relhastriggers
+		false,                  // relhassubclass
+		class.rowSecurity,      // relrowsecurity
+		class.forceRowSecurity, // relforcerowsecurity
+		true,                   // relispopulated
+		"d",                    // relreplident
+		false,                  // relispartition
+		id.Null,                // relrewrite
+		uint32(0),              // relfrozenxid
+		uint32(0),              // relminmxid
+		nil,                    // relacl
+		nil,                    // reloptions
+		nil,                    // relpartbound
 	}
 }
 
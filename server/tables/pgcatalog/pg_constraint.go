@@ -533,6 +533,7 @@ func cachePgConstraints(ctx *sql.Context, pgCatalogCache *pgCatalogCache) error
 					conType:         "c",
 					typeOid:         typ.Oid.AsId(),
 					typeOidNative:   id.Cache().ToOID(typ.Oid.AsId()),
+					convalidated:    !check.IsNotValid,
 				}
 				oidIdx.Add(constraint)
 				relidTypNameIdx.Add(constraint)
@@ -20,8 +20,7 @@ import (
 	"github.com/dolthub/go-mysql-server/sql"
 
 	"github.com/dolthub/doltgresql/core/id"
-	"github.com/dolthub/doltgresql/core/sequences"
-	"github.com/dolthub/doltgresql/server/functions"
+	"github.com/dolthub/doltgresql/server/dependencies"
 	"github.com/dolthub/doltgresql/server/tables"
 	pgtypes "github.com/dolthub/doltgresql/server/types"
 )
@@ -32,8 +31,13 @@ const PgDependName = "pg_depend"
 // Fixed OIDs of the system catalogs themselves, used in the classid/refclassid
 // columns to identify which catalog the (referenced) object belongs to.
 const (
-	pgClassCatalogOID   = 1259 // pg_class
-	pgAttrdefCatalogOID = 2604 // pg_attrdef
+	pgClassCatalogOID     = 1259 // pg_class
+	pgAttrdefCatalogOID   = 2604 // pg_attrdef
+	pgProcCatalogOID      = 1255 // pg_proc
+	pgTypeCatalogOID      = 1247 // pg_type
+	pgTriggerCatalogOID   = 2620 // pg_trigger
+	pgExtensionCatalogOID = 3079 // pg_extension
+	pgPolicyCatalogOID    = 3256 // pg_policy
 )
 
 // InitPgDepend handles registration of the pg_depend handler.
@@ -71,77 +75,56 @@ func (p PgDependHandler) RowIter(ctx *sql.Context, _ sql.Partition) (sql.RowIter
 
 // cachePgDepends caches the pg_depend data for the current database in the session.
 func cachePgDepends(ctx *sql.Context, pgCatalogCache *pgCatalogCache) error {
-	// pg_depend is a partial implementation containing only the dependencies that can currently be
-	// derived from the database's schema:
-	//   - sequence -> owning column ('a' auto dependencies, e.g. SERIAL columns and OWNED BY)
-	//   - column default (pg_attrdef entry) -> table column ('a' auto dependencies)
-	// TODO: emit the remaining dependency kinds: views -> tables/columns, foreign key and other
-	//  constraints, indexes -> columns, functions -> types, extension membership ('e'), internal
+	// pg_depend contains the same dependencies that are used to enforce RESTRICT and CASCADE. Views depend directly on
+	// their referenced relations rather than through a pg_rewrite rule, since we do not have rewrite rules.
+	// TODO: emit the remaining dependency kinds: foreign key and other constraints, indexes -> columns, internal
 	//  dependencies ('i'), and 'p' pinned rows for built-in objects.
-	pgClassOid := id.NewOID(pgClassCatalogOID).AsId()
-	pgAttrdefOid := id.NewOID(pgAttrdefCatalogOID).AsId()
-
-	var rows []sql.Row
-	var seqs []*sequences.Sequence
-	tableSchemas := make(map[id.Table]sql.Schema)
-	err := functions.IterateCurrentDatabase(ctx, functions.Callbacks{
-		Table: func(ctx *sql.Context, _ functions.ItemSchema, table functions.ItemTable) (cont bool, err error) {
-			tableSchemas[table.OID] = table.Item.Schema(ctx)
-			return true, nil
-		},
-		Sequence: func(ctx *sql.Context, _ functions.ItemSchema, sequence functions.ItemSequence) (cont bool, err error) {
-			seqs = append(seqs, sequence.Item)
-			return true, nil
-		},
-		ColumnDefault: func(ctx *sql.Context, _ functions.ItemSchema, table functions.ItemTable, colDefault functions.ItemColumnDefault) (cont bool, err error) {
-			// Each pg_attrdef entry has an automatic dependency on the column it applies to
-			rows = append(rows, sql.Row{
-				pgAttrdefOid,                           // classid
-				colDefault.OID.AsId(),                  // objid
-				int32(0),                               // objsubid
-				pgClassOid,                             // refclassid
-				table.OID.AsId(),                       // refobjid
-				int32(colDefault.Item.ColumnIndex + 1), // refobjsubid
-				"a",                                    // deptype
-			})
-			return true, nil
-		},
-	})
+	g, err := dependencies.Load(ctx)
 	if err != nil {
 		return err
 	}
-
-	// Sequences owned by a table column (SERIAL columns, OWNED BY clauses) have an automatic
-	// dependency on that column. The owning table's schema is needed to resolve the column's
-	// ordinal, so these rows are built after iteration completes.
-	for _, seq := range seqs {
-		if !seq.OwnerTable.IsValid() {
-			continue
-		}
-		sch, ok := tableSchemas[seq.OwnerTable]
-		if !ok {
-			continue
-		}
-		for colIdx, col := range sch {
-			if col.Name == seq.OwnerColumn {
-				rows = append(rows, sql.Row{
-					pgClassOid,            // classid
-					seq.Id.AsId(),         // objid
-					int32(0),              // objsubid
-					pgClassOid,            // refclassid
-					seq.OwnerTable.AsId(), // refobjid
-					int32(colIdx + 1),     // refobjsubid
-					"a",                   // deptype
-				})
-				break
-			}
-		}
+	rows := make([]sql.Row, 0, len(g.Dependencies))
+	for _, dep := range g.Dependencies {
+		classID, objID, objSubID := pgDependAddress(g, dep.Dependent)
+		refClassID, refObjID, refObjSubID := pgDependAddress(g, dep.Referenced)
+		rows = append(rows, sql.Row{
+			classID,          // classid
+			objID,            // objid
+			objSubID,         // objsubid
+			refClassID,       // refclassid
+			refObjID,         // refobjid
+			refObjSubID,      // refobjsubid
+			string(dep.Type), // deptype
+		})
 	}
-
 	pgCatalogCache.dependRows = rows
 	return nil
 }
 
+// pgDependAddress returns the catalog, OID, and sub-object ID that identify the given object within pg_depend.
+func pgDependAddress(g *dependencies.Graph, obj dependencies.Object) (classID id.Id, objID id.Id, objSubID int32) {
+	var catalogOID uint32
+	switch obj.Kind {
+	case dependencies.ObjectKind_Column:
+		return id.NewOID(pgClassCatalogOID).AsId(), obj.ID, int32(g.ColumnIndex(obj) + 1)
+	case dependencies.ObjectKind_ColumnDefault:
+		catalogOID = pgAttrdefCatalogOID
+	case dependencies.ObjectKind_Function, dependencies.ObjectKind_Procedure:
+		catalogOID = pgProcCatalogOID
+	case dependencies.ObjectKind_Type, dependencies.ObjectKind_Domain:
+		catalogOID = pgTypeCatalogOID
+	case dependencies.ObjectKind_Trigger:
+		catalogOID = pgTriggerCatalogOID
+	case dependencies.ObjectKind_Extension:
+		catalogOID = pgExtensionCatalogOID
+	case dependencies.ObjectKind_Policy:
+		catalogOID = pgPolicyCatalogOID
+	default:
+		catalogOID = pgClassCatalogOID
+	}
+	return id.NewOID(catalogOID).AsId(), obj.ID, 0
+}
+
 // PkSchema implements the interface tables.Handler.
 func (p PgDependHandler) PkSchema() sql.PrimaryKeySchema {
 	return sql.PrimaryKeySchema{
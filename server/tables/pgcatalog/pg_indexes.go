@@ -21,6 +21,7 @@ import (
 
 	"github.com/dolthub/go-mysql-server/sql"
 
+	"github.com/dolthub/doltgresql/core"
 	"github.com/dolthub/doltgresql/server/functions"
 	"github.com/dolthub/doltgresql/server/tables"
 	pgtypes "github.com/dolthub/doltgresql/server/types"
@@ -98,20 +99,28 @@ func (iter *pgIndexesRowIter) Next(ctx *sql.Context) (sql.Row, error) {
 	iter.idx++
 	index := iter.indexes.indexes[iter.idx-1]
 
+	indexDef, err := getIndexDef(ctx, index.index, index.schemaName, iter.indexes.tableSchemas[index.tableOid])
+	if err != nil {
+		return nil, err
+	}
+
 	// TODO: Fill in the rest of the pg_indexes columns
 	return sql.Row{
 		index.schemaName,                        // schemaname
 		iter.indexes.tableNames[index.tableOid], // tablename
 		formatIndexName(index.index),            // indexname
 		"",                                      // tablespace
-		getIndexDef(index.index, index.schemaName, iter.indexes.tableSchemas[index.tableOid]), // indexdef
+		indexDef,                                // indexdef
 	}, nil
 }
 
 // formatIndexName returns the definition of the index.
-func getIndexDef(index sql.Index, schema string, tableSchema sql.Schema) string {
+func getIndexDef(ctx *sql.Context, index sql.Index, schema string, tableSchema sql.Schema) (string, error) {
 	name := formatIndexName(index)
-	using := strings.ToLower(index.IndexType())
+	accessMethod, err := core.GetIndexAccessMethod(ctx, schema, index)
+	if err != nil {
+		return "", err
+	}
 	unique := ""
 	if index.IsUnique() {
 		unique = " UNIQUE"
@@ -130,13 +139,16 @@ func getIndexDef(index sql.Index, schema string, tableSchema sql.Schema) string
 		}
 		cols[i] = colName
 	}
+	if len(accessMethod.OpClass) > 0 && len(cols) == 1 {
+		cols[0] += " " + accessMethod.OpClass
+	}
 	colsStr := strings.Join(cols, ", ")
 
-	def := fmt.Sprintf("CREATE%s INDEX %s ON %s.%s USING %s (%s)", unique, name, schema, index.Table(), using, colsStr)
+	def := fmt.Sprintf("CREATE%s INDEX %s ON %s.%s USING %s (%s)", unique, name, schema, index.Table(), accessMethod.Name, colsStr)
 	if pi, ok := index.(sql.PartialIndex); ok && pi.Predicate() != "" {
 		def += " WHERE (" + pi.Predicate() + ")"
 	}
-	return def
+	return def, nil
 }
 
 // Close implements the interface sql.RowIter.
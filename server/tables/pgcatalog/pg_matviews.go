@@ -19,6 +19,9 @@ import (
 
 	"github.com/dolthub/go-mysql-server/sql"
 
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/id"
+	"github.com/dolthub/doltgresql/server/functions"
 	"github.com/dolthub/doltgresql/server/tables"
 	pgtypes "github.com/dolthub/doltgresql/server/types"
 )
@@ -43,10 +46,41 @@ func (p PgMatviewsHandler) Name() string {
 
 // RowIter implements the interface tables.Handler.
 func (p PgMatviewsHandler) RowIter(ctx *sql.Context, partition sql.Partition) (sql.RowIter, error) {
-	// pg_matviews is currently empty, since Doltgres does not yet support materialized views
-	// (CREATE MATERIALIZED VIEW returns an error, see server/ast/create_materialized_view.go).
-	// TODO: fill this in when materialized views are supported
-	return emptyRowIter()
+	collection, err := core.GetMaterializedViewsCollectionFromContext(ctx, ctx.GetCurrentDatabase())
+	if err != nil {
+		return nil, err
+	}
+	var rows []sql.Row
+	err = functions.IterateCurrentDatabase(ctx, functions.Callbacks{
+		Table: func(ctx *sql.Context, schema functions.ItemSchema, table functions.ItemTable) (cont bool, err error) {
+			mv, err := collection.GetMaterializedView(ctx, id.NewView(table.OID.SchemaName(), table.OID.TableName()))
+			if err != nil || !mv.ID.IsValid() {
+				return err == nil, err
+			}
+			var hasIndexes bool
+			if it, ok := table.Item.(sql.IndexAddressable); ok {
+				idxs, err := it.GetIndexes(ctx)
+				if err != nil {
+					return false, err
+				}
+				hasIndexes = len(idxs) > 0
+			}
+			rows = append(rows, sql.Row{
+				schema.Item.SchemaName(), // schemaname
+				mv.ID.ViewName(),         // matviewname
+				"postgres",               // matviewowner
+				nil,                      // tablespace
+				hasIndexes,               // hasindexes
+				mv.Populated,             // ispopulated
+				mv.Definition,            // definition
+			})
+			return true, nil
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pgMatviewsRowIter{rows: rows}, nil
 }
 
 // PkSchema implements the interface tables.Handler.
@@ -70,13 +104,19 @@ var pgMatviewsSchema = sql.Schema{
 
 // pgMatviewsRowIter is the sql.RowIter for the pg_matviews table.
 type pgMatviewsRowIter struct {
+	rows []sql.Row
+	idx  int
 }
 
 var _ sql.RowIter = (*pgMatviewsRowIter)(nil)
 
 // Next implements the interface sql.RowIter.
 func (iter *pgMatviewsRowIter) Next(ctx *sql.Context) (sql.Row, error) {
-	return nil, io.EOF
+	if iter.idx >= len(iter.rows) {
+		return nil, io.EOF
+	}
+	iter.idx++
+	return iter.rows[iter.idx-1], nil
 }
 
 // Close implements the interface sql.RowIter.
@@ -23,6 +23,7 @@ import (
 	"github.com/dolthub/doltgresql/core/id"
 	"github.com/dolthub/doltgresql/server/functions"
 	"github.com/dolthub/doltgresql/server/tables"
+	"github.com/dolthub/doltgresql/server/temporary"
 	pgtypes "github.com/dolthub/doltgresql/server/types"
 )
 
@@ -97,6 +98,22 @@ func cachePgNamespaces(ctx *sql.Context, pgCatalogCache *pgCatalogCache) error {
 	if err != nil {
 		return err
 	}
+	// The session's temporary schema exists once the session has created a temporary object
+	hasTemporarySchema, err := functions.HasTemporarySchema(ctx)
+	if err != nil {
+		return err
+	}
+	if hasTemporarySchema {
+		schemaName := temporary.SchemaName(ctx.Session.ID())
+		namespace := &pgNamespace{
+			oid:       id.NewNamespace(schemaName).AsId(),
+			oidNative: id.Cache().ToOID(id.NewNamespace(schemaName).AsId()),
+			name:      schemaName,
+		}
+		oidIdx.Add(namespace)
+		nameIdx.Add(namespace)
+		namespaces = append(namespaces, namespace)
+	}
 
 	pgCatalogCache.pgNamespaces = &pgNamespaceCache{
 		namespaces: namespaces,
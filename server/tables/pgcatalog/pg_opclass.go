@@ -77,6 +77,7 @@ type operatorClass struct {
 	name       string
 	familyName string // the operator family this class belongs to, within the same access method
 	inputType  *pgtypes.DoltgresType
+	keyType    *pgtypes.DoltgresType // the type that is stored within the index, when it differs from the input type
 	isDefault  bool
 }
 
@@ -88,8 +89,8 @@ func (c operatorClass) oid() id.Id {
 // defaultOperatorClasses is the list of built-in operator classes available in Postgres for the access methods and
 // types that Doltgres supports. Unlike operator families, Postgres assigns most operator class OIDs dynamically during
 // initdb, so Doltgres assigns its own fixed OIDs (registered in core/id/cache_operator_class_defaults.go).
-// TODO: Postgres defines more operator classes (gin, gist, brin, spgist, and additional types); add them as the
-// related types and access methods gain support.
+// TODO: Postgres defines more operator classes (gist, spgist, other brin strategies, and additional types); add them as
+// the related types and access methods gain support.
 var defaultOperatorClasses = []operatorClass{
 	{am: "btree", name: "array_ops", familyName: "array_ops", inputType: pgtypes.AnyArray, isDefault: true},
 	{am: "btree", name: "bit_ops", familyName: "bit_ops", inputType: pgtypes.Bit, isDefault: true},
@@ -137,6 +138,20 @@ var defaultOperatorClasses = []operatorClass{
 	{am: "hash", name: "uuid_ops", familyName: "uuid_ops", inputType: pgtypes.Uuid, isDefault: true},
 	// varchar_ops operates on text, matching Postgres (varchar has no operators of its own)
 	{am: "hash", name: "varchar_ops", familyName: "text_ops", inputType: pgtypes.Text, isDefault: false},
+	{am: "gin", name: "array_ops", familyName: "array_ops", inputType: pgtypes.AnyArray, keyType: pgtypes.AnyElement, isDefault: true},
+	{am: "gin", name: "jsonb_ops", familyName: "jsonb_ops", inputType: pgtypes.JsonB, keyType: pgtypes.Text, isDefault: true},
+	{am: "gin", name: "jsonb_path_ops", familyName: "jsonb_path_ops", inputType: pgtypes.JsonB, keyType: pgtypes.Int32, isDefault: false},
+	{am: "brin", name: "date_minmax_ops", familyName: "datetime_minmax_ops", inputType: pgtypes.Date, keyType: pgtypes.Date, isDefault: true},
+	{am: "brin", name: "float4_minmax_ops", familyName: "float_minmax_ops", inputType: pgtypes.Float32, keyType: pgtypes.Float32, isDefault: true},
+	{am: "brin", name: "float8_minmax_ops", familyName: "float_minmax_ops", inputType: pgtypes.Float64, keyType: pgtypes.Float64, isDefault: true},
+	{am: "brin", name: "int2_minmax_ops", familyName: "integer_minmax_ops", inputType: pgtypes.Int16, keyType: pgtypes.Int16, isDefault: true},
+	{am: "brin", name: "int4_minmax_ops", familyName: "integer_minmax_ops", inputType: pgtypes.Int32, keyType: pgtypes.Int32, isDefault: true},
+	{am: "brin", name: "int8_minmax_ops", familyName: "integer_minmax_ops", inputType: pgtypes.Int64, keyType: pgtypes.Int64, isDefault: true},
+	{am: "brin", name: "numeric_minmax_ops", familyName: "numeric_minmax_ops", inputType: pgtypes.Numeric, keyType: pgtypes.Numeric, isDefault: true},
+	{am: "brin", name: "text_minmax_ops", familyName: "text_minmax_ops", inputType: pgtypes.Text, keyType: pgtypes.Text, isDefault: true},
+	{am: "brin", name: "timestamp_minmax_ops", familyName: "datetime_minmax_ops", inputType: pgtypes.Timestamp, keyType: pgtypes.Timestamp, isDefault: true},
+	{am: "brin", name: "timestamptz_minmax_ops", familyName: "datetime_minmax_ops", inputType: pgtypes.TimestampTZ, keyType: pgtypes.TimestampTZ, isDefault: true},
+	{am: "brin", name: "uuid_minmax_ops", familyName: "uuid_minmax_ops", inputType: pgtypes.Uuid, keyType: pgtypes.Uuid, isDefault: true},
 }
 
 // pgOpclassRowIter is the sql.RowIter for the pg_opclass table.
@@ -154,6 +169,10 @@ func (iter *pgOpclassRowIter) Next(ctx *sql.Context) (sql.Row, error) {
 	}
 	iter.idx++
 	class := iter.classes[iter.idx-1]
+	keyType := id.Null
+	if class.keyType != nil {
+		keyType = class.keyType.ID.AsId()
+	}
 
 	return sql.Row{
 		class.oid(),                          // oid
@@ -164,7 +183,7 @@ func (iter *pgOpclassRowIter) Next(ctx *sql.Context) (sql.Row, error) {
 		operatorFamily{am: class.am, name: class.familyName}.oid(), // opcfamily
 		class.inputType.ID.AsId(),                                  // opcintype
 		class.isDefault,                                            // opcdefault
-		id.Null,                                                    // opckeytype
+		keyType,                                                    // opckeytype
 	}, nil
 }
 
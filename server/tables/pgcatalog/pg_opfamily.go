@@ -81,8 +81,8 @@ func (f operatorFamily) oid() id.Id {
 // defaultOperatorFamilies is the list of built-in operator families available in Postgres for the access methods and
 // types that Doltgres supports. Their OIDs are registered in core/id/cache_operator_class_defaults.go, and match the
 // fixed operator family OIDs assigned by Postgres.
-// TODO: Postgres defines more operator families (gin, gist, brin, spgist, and additional types); add them as the
-// related types and access methods gain support.
+// TODO: Postgres defines more operator families (gist, spgist, other brin strategies, and additional types); add them
+// as the related types and access methods gain support.
 var defaultOperatorFamilies = []operatorFamily{
 	{am: "btree", name: "array_ops"},
 	{am: "btree", name: "bit_ops"},
@@ -115,6 +115,15 @@ var defaultOperatorFamilies = []operatorFamily{
 	{am: "hash", name: "bool_ops"},
 	{am: "hash", name: "uuid_ops"},
 	{am: "hash", name: "jsonb_ops"},
+	{am: "gin", name: "array_ops"},
+	{am: "gin", name: "jsonb_ops"},
+	{am: "gin", name: "jsonb_path_ops"},
+	{am: "brin", name: "integer_minmax_ops"},
+	{am: "brin", name: "numeric_minmax_ops"},
+	{am: "brin", name: "text_minmax_ops"},
+	{am: "brin", name: "datetime_minmax_ops"},
+	{am: "brin", name: "float_minmax_ops"},
+	{am: "brin", name: "uuid_minmax_ops"},
 }
 
 // pgOpfamilyRowIter is the sql.RowIter for the pg_opfamily table.
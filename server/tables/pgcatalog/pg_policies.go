@@ -19,6 +19,7 @@ import (
 
 	"github.com/dolthub/go-mysql-server/sql"
 
+	"github.com/dolthub/doltgresql/core/policies"
 	"github.com/dolthub/doltgresql/server/tables"
 	pgtypes "github.com/dolthub/doltgresql/server/types"
 )
@@ -43,9 +44,23 @@ func (p PgPoliciesHandler) Name() string {
 
 // RowIter implements the interface tables.Handler.
 func (p PgPoliciesHandler) RowIter(ctx *sql.Context, partition sql.Partition) (sql.RowIter, error) {
-	// pg_policies is currently empty, since row-level security policies are not supported.
-	// TODO: fill this in when row-level security is supported
-	return emptyRowIter()
+	// Use cached data from this process if it exists
+	pgCatalogCache, err := getPgCatalogCache(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if pgCatalogCache.policies == nil {
+		err = cachePgPolicies(ctx, pgCatalogCache)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &pgPoliciesRowIter{
+		policies: pgCatalogCache.policies,
+		idx:      0,
+	}, nil
 }
 
 // PkSchema implements the interface tables.Handler.
@@ -70,13 +85,41 @@ var pgPoliciesSchema = sql.Schema{
 
 // pgPoliciesRowIter is the sql.RowIter for the pg_policies table.
 type pgPoliciesRowIter struct {
+	policies []policies.Policy
+	idx      int
 }
 
 var _ sql.RowIter = (*pgPoliciesRowIter)(nil)
 
 // Next implements the interface sql.RowIter.
 func (iter *pgPoliciesRowIter) Next(ctx *sql.Context) (sql.Row, error) {
-	return nil, io.EOF
+	if iter.idx >= len(iter.policies) {
+		return nil, io.EOF
+	}
+	iter.idx++
+	p := iter.policies[iter.idx-1]
+
+	permissive := "PERMISSIVE"
+	if p.Restrictive {
+		permissive = "RESTRICTIVE"
+	}
+	roles := []any{"public"}
+	if len(p.Roles) > 0 {
+		roles = make([]any, len(p.Roles))
+		for i, role := range p.Roles {
+			roles[i] = role
+		}
+	}
+	return sql.Row{
+		p.ID.SchemaName(),             // schemaname
+		p.ID.TableName(),              // tablename
+		p.ID.PolicyName(),             // policyname
+		permissive,                    // permissive
+		roles,                         // roles
+		p.Command.String(),            // cmd
+		policyExpression(p.Using),     // qual
+		policyExpression(p.WithCheck), // with_check
+	}, nil
 }
 
 // Close implements the interface sql.RowIter.
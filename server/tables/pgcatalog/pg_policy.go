@@ -16,9 +16,13 @@ package pgcatalog
 
 import (
 	"io"
+	"sort"
 
 	"github.com/dolthub/go-mysql-server/sql"
 
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/id"
+	"github.com/dolthub/doltgresql/core/policies"
 	"github.com/dolthub/doltgresql/server/tables"
 	pgtypes "github.com/dolthub/doltgresql/server/types"
 )
@@ -43,9 +47,51 @@ func (p PgPolicyHandler) Name() string {
 
 // RowIter implements the interface tables.Handler.
 func (p PgPolicyHandler) RowIter(ctx *sql.Context, partition sql.Partition) (sql.RowIter, error) {
-	// pg_policy is currently empty, since row-level security policies are not supported.
-	// TODO: fill this in when row-level security is supported
-	return emptyRowIter()
+	// Use cached data from this process if it exists
+	pgCatalogCache, err := getPgCatalogCache(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if pgCatalogCache.policies == nil {
+		err = cachePgPolicies(ctx, pgCatalogCache)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &pgPolicyRowIter{
+		policies: pgCatalogCache.policies,
+		idx:      0,
+	}, nil
+}
+
+// cachePgPolicies caches the pg_policy and pg_policies data for the current database in the session.
+func cachePgPolicies(ctx *sql.Context, pgCatalogCache *pgCatalogCache) error {
+	collection, err := core.GetPoliciesCollectionFromContext(ctx, ctx.GetCurrentDatabase())
+	if err != nil {
+		return err
+	}
+	pols := make([]policies.Policy, 0)
+	err = collection.IteratePolicies(ctx, func(p policies.Policy) (stop bool, err error) {
+		pols = append(pols, p)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+	// Sort for deterministic output: by schema, then table, then policy name
+	sort.Slice(pols, func(i, j int) bool {
+		if pols[i].ID.SchemaName() != pols[j].ID.SchemaName() {
+			return pols[i].ID.SchemaName() < pols[j].ID.SchemaName()
+		}
+		if pols[i].ID.TableName() != pols[j].ID.TableName() {
+			return pols[i].ID.TableName() < pols[j].ID.TableName()
+		}
+		return pols[i].ID.PolicyName() < pols[j].ID.PolicyName()
+	})
+	pgCatalogCache.policies = pols
+	return nil
 }
 
 // PkSchema implements the interface tables.Handler.
@@ -68,15 +114,64 @@ var pgPolicySchema = sql.Schema{
 	{Name: "polwithcheck", Type: pgtypes.Text, Default: nil, Nullable: true, Source: PgPolicyName}, // TODO: pg_node_tree type, collation C
 }
 
+// policyCommandChar returns the polcmd character for the given command.
+func policyCommandChar(command policies.PolicyCommand) string {
+	switch command {
+	case policies.PolicyCommand_Select:
+		return "r"
+	case policies.PolicyCommand_Insert:
+		return "a"
+	case policies.PolicyCommand_Update:
+		return "w"
+	case policies.PolicyCommand_Delete:
+		return "d"
+	default:
+		return "*"
+	}
+}
+
+// policyExpression returns the expression of a policy, which is NULL when the expression was not given.
+func policyExpression(expression string) any {
+	if len(expression) == 0 {
+		return nil
+	}
+	return expression
+}
+
 // pgPolicyRowIter is the sql.RowIter for the pg_policy table.
 type pgPolicyRowIter struct {
+	policies []policies.Policy
+	idx      int
 }
 
 var _ sql.RowIter = (*pgPolicyRowIter)(nil)
 
 // Next implements the interface sql.RowIter.
 func (iter *pgPolicyRowIter) Next(ctx *sql.Context) (sql.Row, error) {
-	return nil, io.EOF
+	if iter.idx >= len(iter.policies) {
+		return nil, io.EOF
+	}
+	iter.idx++
+	p := iter.policies[iter.idx-1]
+
+	// PUBLIC is represented by a zero OID
+	roles := []any{id.Null}
+	if len(p.Roles) > 0 {
+		roles = make([]any, len(p.Roles))
+		for i, role := range p.Roles {
+			roles[i] = roleOid(role)
+		}
+	}
+	return sql.Row{
+		p.ID.AsId(),                   // oid
+		p.ID.PolicyName(),             // polname
+		p.TableID().AsId(),            // polrelid
+		policyCommandChar(p.Command),  // polcmd
+		!p.Restrictive,                // polpermissive
+		roles,                         // polroles
+		policyExpression(p.Using),     // polqual (TODO: node tree rather than the expression's text)
+		policyExpression(p.WithCheck), // polwithcheck (TODO: node tree rather than the expression's text)
+	}, nil
 }
 
 // Close implements the interface sql.RowIter.
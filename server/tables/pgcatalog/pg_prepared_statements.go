@@ -15,10 +15,9 @@
 package pgcatalog
 
 import (
-	"io"
-
 	"github.com/dolthub/go-mysql-server/sql"
 
+	"github.com/dolthub/doltgresql/server/prepared"
 	"github.com/dolthub/doltgresql/server/tables"
 	pgtypes "github.com/dolthub/doltgresql/server/types"
 )
@@ -43,12 +42,32 @@ func (p PgPreparedStatementsHandler) Name() string {
 
 // RowIter implements the interface tables.Handler.
 func (p PgPreparedStatementsHandler) RowIter(ctx *sql.Context, partition sql.Partition) (sql.RowIter, error) {
-	// pg_prepared_statements is currently empty. Prepared statements are tracked per-connection in
-	// the wire protocol layer (ConnectionHandler.preparedStatements in server/connection_handler.go),
-	// which is not reachable from the SQL session that this table is queried from.
-	// TODO: fill this in once the connection handler's prepared statement registry is exposed to
-	// the SQL session
-	return emptyRowIter()
+	var rows []sql.Row
+	for _, statement := range prepared.Statements(ctx.Session.ID()) {
+		parameterTypes := make([]any, len(statement.ParameterTypes))
+		for i, typ := range statement.ParameterTypes {
+			parameterTypes[i] = typ.AsId()
+		}
+		var resultTypes any
+		if statement.ResultTypes != nil {
+			types := make([]any, len(statement.ResultTypes))
+			for i, typ := range statement.ResultTypes {
+				types[i] = typ.AsId()
+			}
+			resultTypes = types
+		}
+		rows = append(rows, sql.Row{
+			statement.Name,        // name
+			statement.Definition,  // statement
+			statement.PrepareTime, // prepare_time
+			parameterTypes,        // parameter_types
+			resultTypes,           // result_types
+			statement.FromSQL,     // from_sql
+			int64(0),              // generic_plans
+			int64(0),              // custom_plans
+		})
+	}
+	return sql.RowsToRowIter(rows...), nil
 }
 
 // PkSchema implements the interface tables.Handler.
@@ -70,19 +89,3 @@ var pgPreparedStatementsSchema = sql.Schema{
 	{Name: "generic_plans", Type: pgtypes.Int64, Default: nil, Nullable: true, Source: PgPreparedStatementsName},
 	{Name: "custom_plans", Type: pgtypes.Int64, Default: nil, Nullable: true, Source: PgPreparedStatementsName},
 }
-
-// pgPreparedStatementsRowIter is the sql.RowIter for the pg_prepared_statements table.
-type pgPreparedStatementsRowIter struct {
-}
-
-var _ sql.RowIter = (*pgPreparedStatementsRowIter)(nil)
-
-// Next implements the interface sql.RowIter.
-func (iter *pgPreparedStatementsRowIter) Next(ctx *sql.Context) (sql.Row, error) {
-	return nil, io.EOF
-}
-
-// Close implements the interface sql.RowIter.
-func (iter *pgPreparedStatementsRowIter) Close(ctx *sql.Context) error {
-	return nil
-}
@@ -24,6 +24,7 @@ import (
 	"github.com/dolthub/doltgresql/core/id"
 	"github.com/dolthub/doltgresql/core/procedures"
 	"github.com/dolthub/doltgresql/server/functions"
+	"github.com/dolthub/doltgresql/server/functions/framework"
 	"github.com/dolthub/doltgresql/server/tables"
 	pgtypes "github.com/dolthub/doltgresql/server/types"
 )
@@ -92,7 +93,6 @@ func cachePgProcs(ctx *sql.Context, pgCatalogCache *pgCatalogCache) error {
 	var pprocs []*pgProc
 
 	err := functions.IterateCurrentDatabase(ctx, functions.Callbacks{
-		// TODO: add built-in functions
 		Function: func(ctx *sql.Context, schema functions.ItemSchema, f functions.ItemFunction) (cont bool, err error) {
 			variadic := id.Null
 			if f.Item.Variadic {
@@ -263,10 +263,57 @@ func cachePgProcs(ctx *sql.Context, pgCatalogCache *pgCatalogCache) error {
 		return err
 	}
 
-	pgCatalogCache.procs = pprocs
+	pgCatalogCache.procs = append(pprocs, builtinPgProcs()...)
 	return nil
 }
 
+// builtinPgProcVolatility maps the built-in functions that are listed in pg_proc to their volatility.
+var builtinPgProcVolatility = []struct {
+	name     string
+	volatile string
+}{
+	{name: "convert", volatile: "s"},
+	{name: "convert_from", volatile: "s"},
+	{name: "convert_to", volatile: "s"},
+	{name: "decode", volatile: "i"},
+	{name: "sha224", volatile: "i"},
+	{name: "sha256", volatile: "i"},
+	{name: "sha384", volatile: "i"},
+	{name: "sha512", volatile: "i"},
+}
+
+// builtinPgProcs returns the pg_proc rows for the built-in functions in builtinPgProcVolatility, which all belong to
+// pg_catalog.
+func builtinPgProcs() []*pgProc {
+	schemaOid := id.NewNamespace(PgCatalogName).AsId()
+	var pprocs []*pgProc
+	for _, builtin := range builtinPgProcVolatility {
+		for _, f := range framework.Catalog[builtin.name] {
+			paramTypes := f.GetInputParameterTypes()
+			types := make([]any, len(paramTypes))
+			for i, paramType := range paramTypes {
+				types[i] = paramType.ID.AsId()
+			}
+			pprocs = append(pprocs, &pgProc{
+				oid:       f.InternalID(),
+				name:      builtin.name,
+				schemaOid: schemaOid,
+				variadic:  id.Null,
+				kind:      "f",
+				strict:    f.IsStrict(),
+				retSet:    f.IsSRF(),
+				volatile:  builtin.volatile,
+				nArgs:     int16(len(paramTypes)),
+				retTyp:    f.GetReturn().ID.AsId(),
+				argTypes:  types,
+				// Internal functions use the name of their implementation as the source
+				src: builtin.name,
+			})
+		}
+	}
+	return pprocs
+}
+
 // PkSchema implements the interface tables.Handler.
 func (p PgProcHandler) PkSchema() sql.PrimaryKeySchema {
 	return sql.PrimaryKeySchema{
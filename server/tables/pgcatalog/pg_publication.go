@@ -16,11 +16,14 @@ package pgcatalog
 
 import (
 	"io"
+	"strconv"
 
 	"github.com/dolthub/go-mysql-server/sql"
 
+	"github.com/dolthub/doltgresql/core/id"
 	"github.com/dolthub/doltgresql/server/tables"
 	pgtypes "github.com/dolthub/doltgresql/server/types"
+	"github.com/dolthub/doltgresql/server/walsender"
 )
 
 // PgPublicationName is a constant to the pg_publication name.
@@ -43,9 +46,10 @@ func (p PgPublicationHandler) Name() string {
 
 // RowIter implements the interface tables.Handler.
 func (p PgPublicationHandler) RowIter(ctx *sql.Context, partition sql.Partition) (sql.RowIter, error) {
-	// pg_publication is currently empty, since CREATE PUBLICATION (logical replication publishing) is not supported.
-	// TODO: fill this in when publications are supported
-	return emptyRowIter()
+	return &pgPublicationRowIter{
+		publications: walsender.Publications(ctx.GetCurrentDatabase()),
+		idx:          0,
+	}, nil
 }
 
 // PkSchema implements the interface tables.Handler.
@@ -56,6 +60,11 @@ func (p PgPublicationHandler) PkSchema() sql.PrimaryKeySchema {
 	}
 }
 
+// publicationOid returns the OID of the given publication, which does not change when the publication is renamed.
+func publicationOid(pub walsender.Publication) id.Id {
+	return id.NewId(id.Section_Publication, pub.Database, strconv.FormatUint(pub.ID, 10))
+}
+
 // pgPublicationSchema is the schema for pg_publication.
 var pgPublicationSchema = sql.Schema{
 	{Name: "oid", Type: pgtypes.Oid, Default: nil, Nullable: false, Source: PgPublicationName},
@@ -71,13 +80,30 @@ var pgPublicationSchema = sql.Schema{
 
 // pgPublicationRowIter is the sql.RowIter for the pg_publication table.
 type pgPublicationRowIter struct {
+	publications []walsender.Publication
+	idx          int
 }
 
 var _ sql.RowIter = (*pgPublicationRowIter)(nil)
 
 // Next implements the interface sql.RowIter.
 func (iter *pgPublicationRowIter) Next(ctx *sql.Context) (sql.Row, error) {
-	return nil, io.EOF
+	if iter.idx >= len(iter.publications) {
+		return nil, io.EOF
+	}
+	iter.idx++
+	pub := iter.publications[iter.idx-1]
+	return sql.Row{
+		publicationOid(pub), // oid
+		pub.Name,            // pubname
+		roleOid(pub.Owner),  // pubowner
+		pub.AllTables,       // puballtables
+		pub.Insert,          // pubinsert
+		pub.Update,          // pubupdate
+		pub.Delete,          // pubdelete
+		pub.Truncate,        // pubtruncate
+		false,               // pubviaroot
+	}, nil
 }
 
 // Close implements the interface sql.RowIter.
@@ -15,12 +15,14 @@
 package pgcatalog
 
 import (
-	"io"
+	"strconv"
 
 	"github.com/dolthub/go-mysql-server/sql"
 
+	"github.com/dolthub/doltgresql/core/id"
 	"github.com/dolthub/doltgresql/server/tables"
 	pgtypes "github.com/dolthub/doltgresql/server/types"
+	"github.com/dolthub/doltgresql/server/walsender"
 )
 
 // PgPublicationNamespaceName is a constant to the pg_publication_namespace name.
@@ -43,9 +45,19 @@ func (p PgPublicationNamespaceHandler) Name() string {
 
 // RowIter implements the interface tables.Handler.
 func (p PgPublicationNamespaceHandler) RowIter(ctx *sql.Context, partition sql.Partition) (sql.RowIter, error) {
-	// pg_publication_namespace is currently empty, since CREATE PUBLICATION (logical replication publishing) is not supported.
-	// TODO: fill this in when publications are supported
-	return emptyRowIter()
+	var rows []sql.Row
+	for _, pub := range walsender.Publications(ctx.GetCurrentDatabase()) {
+		pubOid := publicationOid(pub)
+		for _, schema := range pub.Schemas {
+			nspOid := id.NewId(id.Section_Publication, pub.Database, strconv.FormatUint(pub.ID, 10), schema)
+			rows = append(rows, sql.Row{
+				nspOid,                         // oid
+				pubOid,                         // pnpubid
+				id.NewNamespace(schema).AsId(), // pnnspid
+			})
+		}
+	}
+	return sql.RowsToRowIter(rows...), nil
 }
 
 // PkSchema implements the interface tables.Handler.
@@ -62,19 +74,3 @@ var pgPublicationNamespaceSchema = sql.Schema{
 	{Name: "pnpubid", Type: pgtypes.Oid, Default: nil, Nullable: false, Source: PgPublicationNamespaceName},
 	{Name: "pnnspid", Type: pgtypes.Oid, Default: nil, Nullable: false, Source: PgPublicationNamespaceName},
 }
-
-// pgPublicationNamespaceRowIter is the sql.RowIter for the pg_publication_namespace table.
-type pgPublicationNamespaceRowIter struct {
-}
-
-var _ sql.RowIter = (*pgPublicationNamespaceRowIter)(nil)
-
-// Next implements the interface sql.RowIter.
-func (iter *pgPublicationNamespaceRowIter) Next(ctx *sql.Context) (sql.Row, error) {
-	return nil, io.EOF
-}
-
-// Close implements the interface sql.RowIter.
-func (iter *pgPublicationNamespaceRowIter) Close(ctx *sql.Context) error {
-	return nil
-}
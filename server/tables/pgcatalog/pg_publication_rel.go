@@ -15,12 +15,14 @@
 package pgcatalog
 
 import (
-	"io"
+	"strconv"
 
 	"github.com/dolthub/go-mysql-server/sql"
 
+	"github.com/dolthub/doltgresql/core/id"
 	"github.com/dolthub/doltgresql/server/tables"
 	pgtypes "github.com/dolthub/doltgresql/server/types"
+	"github.com/dolthub/doltgresql/server/walsender"
 )
 
 // PgPublicationRelName is a constant to the pg_publication_rel name.
@@ -43,9 +45,21 @@ func (p PgPublicationRelHandler) Name() string {
 
 // RowIter implements the interface tables.Handler.
 func (p PgPublicationRelHandler) RowIter(ctx *sql.Context, partition sql.Partition) (sql.RowIter, error) {
-	// pg_publication_rel is currently empty, since CREATE PUBLICATION (logical replication publishing) is not supported.
-	// TODO: fill this in when publications are supported
-	return emptyRowIter()
+	var rows []sql.Row
+	for _, pub := range walsender.Publications(ctx.GetCurrentDatabase()) {
+		pubOid := publicationOid(pub)
+		for _, table := range pub.Tables {
+			relOid := id.NewId(id.Section_Publication, pub.Database, strconv.FormatUint(pub.ID, 10), table.Schema, table.Name)
+			rows = append(rows, sql.Row{
+				relOid, // oid
+				pubOid, // prpubid
+				id.NewTable(table.Schema, table.Name).AsId(), // prrelid
+				nil, // prqual
+				nil, // prattrs
+			})
+		}
+	}
+	return sql.RowsToRowIter(rows...), nil
 }
 
 // PkSchema implements the interface tables.Handler.
@@ -64,19 +78,3 @@ var pgPublicationRelSchema = sql.Schema{
 	{Name: "prqual", Type: pgtypes.Text, Default: nil, Nullable: true, Source: PgPublicationRelName}, // TODO: pg_node_tree type, collation C
 	{Name: "prattrs", Type: pgtypes.Int16vector, Default: nil, Nullable: true, Source: PgPublicationRelName},
 }
-
-// pgPublicationRelRowIter is the sql.RowIter for the pg_publication_rel table.
-type pgPublicationRelRowIter struct {
-}
-
-var _ sql.RowIter = (*pgPublicationRelRowIter)(nil)
-
-// Next implements the interface sql.RowIter.
-func (iter *pgPublicationRelRowIter) Next(ctx *sql.Context) (sql.Row, error) {
-	return nil, io.EOF
-}
-
-// Close implements the interface sql.RowIter.
-func (iter *pgPublicationRelRowIter) Close(ctx *sql.Context) error {
-	return nil
-}
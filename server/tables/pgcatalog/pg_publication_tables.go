@@ -15,12 +15,12 @@
 package pgcatalog
 
 import (
-	"io"
-
 	"github.com/dolthub/go-mysql-server/sql"
 
+	"github.com/dolthub/doltgresql/server/functions"
 	"github.com/dolthub/doltgresql/server/tables"
 	pgtypes "github.com/dolthub/doltgresql/server/types"
+	"github.com/dolthub/doltgresql/server/walsender"
 )
 
 // PgPublicationTablesName is a constant to the pg_publication_tables name.
@@ -43,9 +43,42 @@ func (p PgPublicationTablesHandler) Name() string {
 
 // RowIter implements the interface tables.Handler.
 func (p PgPublicationTablesHandler) RowIter(ctx *sql.Context, partition sql.Partition) (sql.RowIter, error) {
-	// pg_publication_tables is currently empty, since CREATE PUBLICATION (logical replication publishing) is not supported.
-	// TODO: fill this in when publications are supported
-	return emptyRowIter()
+	pubs := walsender.Publications(ctx.GetCurrentDatabase())
+	if len(pubs) == 0 {
+		return sql.RowsToRowIter(), nil
+	}
+	var rows []sql.Row
+	err := functions.IterateCurrentDatabase(ctx, functions.Callbacks{
+		Table: func(ctx *sql.Context, schema functions.ItemSchema, table functions.ItemTable) (cont bool, err error) {
+			if schema.IsSystemSchema() {
+				return true, nil
+			}
+			schemaName := schema.Item.SchemaName()
+			tableName := table.Item.Name()
+			for _, pub := range pubs {
+				if !pub.PublishesTable(schemaName, tableName) {
+					continue
+				}
+				tableSch := table.Item.Schema(ctx)
+				attnames := make([]any, len(tableSch))
+				for i, col := range tableSch {
+					attnames[i] = col.Name
+				}
+				rows = append(rows, sql.Row{
+					pub.Name,   // pubname
+					schemaName, // schemaname
+					tableName,  // tablename
+					attnames,   // attnames
+					nil,        // rowfilter
+				})
+			}
+			return true, nil
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(rows...), nil
 }
 
 // PkSchema implements the interface tables.Handler.
@@ -64,19 +97,3 @@ var pgPublicationTablesSchema = sql.Schema{
 	{Name: "attnames", Type: pgtypes.NameArray, Default: nil, Nullable: true, Source: PgPublicationTablesName},
 	{Name: "rowfilter", Type: pgtypes.Text, Default: nil, Nullable: true, Source: PgPublicationTablesName},
 }
-
-// pgPublicationTablesRowIter is the sql.RowIter for the pg_publication_tables table.
-type pgPublicationTablesRowIter struct {
-}
-
-var _ sql.RowIter = (*pgPublicationTablesRowIter)(nil)
-
-// Next implements the interface sql.RowIter.
-func (iter *pgPublicationTablesRowIter) Next(ctx *sql.Context) (sql.Row, error) {
-	return nil, io.EOF
-}
-
-// Close implements the interface sql.RowIter.
-func (iter *pgPublicationTablesRowIter) Close(ctx *sql.Context) error {
-	return nil
-}
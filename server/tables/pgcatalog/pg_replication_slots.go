@@ -15,12 +15,12 @@
 package pgcatalog
 
 import (
-	"io"
-
 	"github.com/dolthub/go-mysql-server/sql"
 
+	"github.com/dolthub/doltgresql/core/id"
 	"github.com/dolthub/doltgresql/server/tables"
 	pgtypes "github.com/dolthub/doltgresql/server/types"
+	"github.com/dolthub/doltgresql/server/walsender"
 )
 
 // PgReplicationSlotsName is a constant to the pg_replication_slots name.
@@ -43,10 +43,31 @@ func (p PgReplicationSlotsHandler) Name() string {
 
 // RowIter implements the interface tables.Handler.
 func (p PgReplicationSlotsHandler) RowIter(ctx *sql.Context, partition sql.Partition) (sql.RowIter, error) {
-	// pg_replication_slots is currently empty, since replication slots are not supported.
-	// Doltgres uses its own replication mechanisms, which are not exposed through this catalog.
-	// TODO: fill this in when replication slots are supported
-	return emptyRowIter()
+	var rows []sql.Row
+	for _, slot := range walsender.ReplicationSlots() {
+		var activePid any
+		if slot.ActivePID != 0 {
+			activePid = slot.ActivePID
+		}
+		rows = append(rows, sql.Row{
+			slot.Name,                            // slot_name
+			slot.Plugin,                          // plugin
+			"logical",                            // slot_type
+			id.NewDatabase(slot.Database).AsId(), // datoid
+			slot.Database,                        // database
+			slot.Temporary,                       // temporary
+			slot.ActivePID != 0,                  // active
+			activePid,                            // active_pid
+			nil,                                  // xmin
+			nil,                                  // catalog_xmin
+			slot.ConfirmedLSN.String(),           // restart_lsn
+			slot.ConfirmedLSN.String(),           // confirmed_flush_lsn
+			"reserved",                           // wal_status
+			nil,                                  // safe_wal_size
+			false,                                // two_phase
+		})
+	}
+	return sql.RowsToRowIter(rows...), nil
 }
 
 // PkSchema implements the interface tables.Handler.
@@ -75,19 +96,3 @@ var pgReplicationSlotsSchema = sql.Schema{
 	{Name: "safe_wal_size", Type: pgtypes.Int64, Default: nil, Nullable: true, Source: PgReplicationSlotsName},
 	{Name: "two_phase", Type: pgtypes.Bool, Default: nil, Nullable: true, Source: PgReplicationSlotsName},
 }
-
-// pgReplicationSlotsRowIter is the sql.RowIter for the pg_replication_slots table.
-type pgReplicationSlotsRowIter struct {
-}
-
-var _ sql.RowIter = (*pgReplicationSlotsRowIter)(nil)
-
-// Next implements the interface sql.RowIter.
-func (iter *pgReplicationSlotsRowIter) Next(ctx *sql.Context) (sql.Row, error) {
-	return nil, io.EOF
-}
-
-// Close implements the interface sql.RowIter.
-func (iter *pgReplicationSlotsRowIter) Close(ctx *sql.Context) error {
-	return nil
-}
@@ -15,10 +15,13 @@
 package pgcatalog
 
 import (
-	"io"
+	"os"
+	"time"
 
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/jackc/pglogrepl"
 
+	"github.com/dolthub/doltgresql/server/logrepl"
 	"github.com/dolthub/doltgresql/server/tables"
 	pgtypes "github.com/dolthub/doltgresql/server/types"
 )
@@ -43,9 +46,32 @@ func (p PgStatSubscriptionHandler) Name() string {
 
 // RowIter implements the interface tables.Handler.
 func (p PgStatSubscriptionHandler) RowIter(ctx *sql.Context, partition sql.Partition) (sql.RowIter, error) {
-	// pg_stat_subscription is currently empty, since CREATE SUBSCRIPTION is not supported.
-	// TODO: fill this in when subscriptions are supported
-	return emptyRowIter()
+	statuses, err := logrepl.SubscriptionStatuses()
+	if err != nil {
+		return nil, err
+	}
+	var rows []sql.Row
+	for _, status := range statuses {
+		// Workers run within the server's process, so a running worker reports the server's process ID
+		var pid any
+		if status.Running {
+			pid = int32(os.Getpid())
+		}
+		progress := status.Replication
+		rows = append(rows, sql.Row{
+			subscriptionOid(status.Name),           // subid
+			status.Name,                            // subname
+			pid,                                    // pid
+			nil,                                    // leader_pid
+			nil,                                    // relid
+			nullableLSN(progress.ReceivedLSN),      // received_lsn
+			nullableTime(progress.LastMsgSendTime), // last_msg_send_time
+			nullableTime(progress.LastMsgReceiptTime), // last_msg_receipt_time
+			nullableLSN(progress.LatestEndLSN),        // latest_end_lsn
+			nullableTime(progress.LatestEndTime),      // latest_end_time
+		})
+	}
+	return sql.RowsToRowIter(rows...), nil
 }
 
 // PkSchema implements the interface tables.Handler.
@@ -70,18 +96,18 @@ var pgStatSubscriptionSchema = sql.Schema{
 	{Name: "latest_end_time", Type: pgtypes.TimestampTZ, Default: nil, Nullable: true, Source: PgStatSubscriptionName},
 }
 
-// pgStatSubscriptionRowIter is the sql.RowIter for the pg_stat_subscription table.
-type pgStatSubscriptionRowIter struct {
-}
-
-var _ sql.RowIter = (*pgStatSubscriptionRowIter)(nil)
-
-// Next implements the interface sql.RowIter.
-func (iter *pgStatSubscriptionRowIter) Next(ctx *sql.Context) (sql.Row, error) {
-	return nil, io.EOF
+// nullableLSN returns the LSN as text, or nil when the LSN is zero.
+func nullableLSN(lsn pglogrepl.LSN) any {
+	if lsn == 0 {
+		return nil
+	}
+	return lsn.String()
 }
 
-// Close implements the interface sql.RowIter.
-func (iter *pgStatSubscriptionRowIter) Close(ctx *sql.Context) error {
-	return nil
+// nullableTime returns the time, or nil when the time is zero.
+func nullableTime(t time.Time) any {
+	if t.IsZero() {
+		return nil
+	}
+	return t
 }
@@ -19,6 +19,9 @@ import (
 
 	"github.com/dolthub/go-mysql-server/sql"
 
+	"github.com/dolthub/doltgresql/core/id"
+	"github.com/dolthub/doltgresql/server/auth"
+	"github.com/dolthub/doltgresql/server/logrepl"
 	"github.com/dolthub/doltgresql/server/tables"
 	pgtypes "github.com/dolthub/doltgresql/server/types"
 )
@@ -43,9 +46,16 @@ func (p PgSubscriptionHandler) Name() string {
 
 // RowIter implements the interface tables.Handler.
 func (p PgSubscriptionHandler) RowIter(ctx *sql.Context, partition sql.Partition) (sql.RowIter, error) {
-	// pg_subscription is currently empty, since CREATE SUBSCRIPTION is not supported.
-	// TODO: fill this in when subscriptions are supported
-	return emptyRowIter()
+	// The connection string may contain a password, so it is only shown to superusers
+	var showConnInfo bool
+	auth.LockRead(func() {
+		showConnInfo = auth.GetRole(ctx.Client().User).IsSuperUser
+	})
+	return &pgSubscriptionRowIter{
+		subscriptions: logrepl.Subscriptions(),
+		showConnInfo:  showConnInfo,
+		idx:           0,
+	}, nil
 }
 
 // PkSchema implements the interface tables.Handler.
@@ -56,6 +66,11 @@ func (p PgSubscriptionHandler) PkSchema() sql.PrimaryKeySchema {
 	}
 }
 
+// subscriptionOid returns the OID of the subscription with the given name.
+func subscriptionOid(name string) id.Id {
+	return id.NewId(id.Section_Subscription, name)
+}
+
 // pgSubscriptionSchema is the schema for pg_subscription.
 var pgSubscriptionSchema = sql.Schema{
 	{Name: "oid", Type: pgtypes.Oid, Default: nil, Nullable: false, Source: PgSubscriptionName},
@@ -68,7 +83,7 @@ var pgSubscriptionSchema = sql.Schema{
 	{Name: "substream", Type: pgtypes.Bool, Default: nil, Nullable: false, Source: PgSubscriptionName},
 	{Name: "subtwophasestate", Type: pgtypes.InternalChar, Default: nil, Nullable: false, Source: PgSubscriptionName},
 	{Name: "subdisableonerr", Type: pgtypes.Bool, Default: nil, Nullable: false, Source: PgSubscriptionName},
-	{Name: "subconninfo", Type: pgtypes.Text, Default: nil, Nullable: false, Source: PgSubscriptionName}, // TODO: collation C
+	{Name: "subconninfo", Type: pgtypes.Text, Default: nil, Nullable: true, Source: PgSubscriptionName}, // TODO: collation C
 	{Name: "subslotname", Type: pgtypes.Name, Default: nil, Nullable: true, Source: PgSubscriptionName},
 	{Name: "subsynccommit", Type: pgtypes.Text, Default: nil, Nullable: false, Source: PgSubscriptionName},        // TODO: collation C
 	{Name: "subpublications", Type: pgtypes.TextArray, Default: nil, Nullable: false, Source: PgSubscriptionName}, // TODO: collation C
@@ -76,13 +91,48 @@ var pgSubscriptionSchema = sql.Schema{
 
 // pgSubscriptionRowIter is the sql.RowIter for the pg_subscription table.
 type pgSubscriptionRowIter struct {
+	subscriptions []logrepl.Subscription
+	showConnInfo  bool
+	idx           int
 }
 
 var _ sql.RowIter = (*pgSubscriptionRowIter)(nil)
 
 // Next implements the interface sql.RowIter.
 func (iter *pgSubscriptionRowIter) Next(ctx *sql.Context) (sql.Row, error) {
-	return nil, io.EOF
+	if iter.idx >= len(iter.subscriptions) {
+		return nil, io.EOF
+	}
+	iter.idx++
+	sub := iter.subscriptions[iter.idx-1]
+	var slotName any
+	if sub.SlotName != "" {
+		slotName = sub.SlotName
+	}
+	var connInfo any
+	if iter.showConnInfo {
+		connInfo = sub.ConnInfo
+	}
+	publications := make([]any, len(sub.Publications))
+	for i, publication := range sub.Publications {
+		publications[i] = publication
+	}
+	return sql.Row{
+		subscriptionOid(sub.Name),           // oid
+		id.NewDatabase(sub.Database).AsId(), // subdbid
+		"0/0",                               // subskiplsn
+		sub.Name,                            // subname
+		roleOid(sub.Owner),                  // subowner
+		sub.Enabled,                         // subenabled
+		false,                               // subbinary
+		true,                                // substream (large transactions are always streamed)
+		"d",                                 // subtwophasestate
+		false,                               // subdisableonerr
+		connInfo,                            // subconninfo
+		slotName,                            // subslotname
+		"off",                               // subsynccommit
+		publications,                        // subpublications
+	}, nil
 }
 
 // Close implements the interface sql.RowIter.
@@ -15,10 +15,10 @@
 package pgcatalog
 
 import (
-	"io"
-
 	"github.com/dolthub/go-mysql-server/sql"
 
+	"github.com/dolthub/doltgresql/core/id"
+	"github.com/dolthub/doltgresql/server/logrepl"
 	"github.com/dolthub/doltgresql/server/tables"
 	pgtypes "github.com/dolthub/doltgresql/server/types"
 )
@@ -43,9 +43,26 @@ func (p PgSubscriptionRelHandler) Name() string {
 
 // RowIter implements the interface tables.Handler.
 func (p PgSubscriptionRelHandler) RowIter(ctx *sql.Context, partition sql.Partition) (sql.RowIter, error) {
-	// pg_subscription_rel is currently empty, since CREATE SUBSCRIPTION is not supported.
-	// TODO: fill this in when subscriptions are supported
-	return emptyRowIter()
+	statuses, err := logrepl.SubscriptionStatuses()
+	if err != nil {
+		return nil, err
+	}
+	var rows []sql.Row
+	for _, status := range statuses {
+		for _, table := range status.Tables {
+			var lsn any
+			if table.LSN != 0 {
+				lsn = table.LSN.String()
+			}
+			rows = append(rows, sql.Row{
+				subscriptionOid(status.Name),                 // srsubid
+				id.NewTable(table.Schema, table.Name).AsId(), // srrelid
+				table.State, // srsubstate
+				lsn,         // srsublsn
+			})
+		}
+	}
+	return sql.RowsToRowIter(rows...), nil
 }
 
 // PkSchema implements the interface tables.Handler.
@@ -63,19 +80,3 @@ var pgSubscriptionRelSchema = sql.Schema{
 	{Name: "srsubstate", Type: pgtypes.InternalChar, Default: nil, Nullable: false, Source: PgSubscriptionRelName},
 	{Name: "srsublsn", Type: pgtypes.Text, Default: nil, Nullable: true, Source: PgSubscriptionRelName}, // TODO: pg_lsn type
 }
-
-// pgSubscriptionRelRowIter is the sql.RowIter for the pg_subscription_rel table.
-type pgSubscriptionRelRowIter struct {
-}
-
-var _ sql.RowIter = (*pgSubscriptionRelRowIter)(nil)
-
-// Next implements the interface sql.RowIter.
-func (iter *pgSubscriptionRelRowIter) Next(ctx *sql.Context) (sql.Row, error) {
-	return nil, io.EOF
-}
-
-// Close implements the interface sql.RowIter.
-func (iter *pgSubscriptionRelRowIter) Close(ctx *sql.Context) error {
-	return nil
-}
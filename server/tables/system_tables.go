@@ -0,0 +1,49 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tables
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// systemTableHandlers is a map from the table name to the handler, for system tables that are resolved in every schema
+// of a database (such as dolt_diff_functions). User tables and Dolt's own system tables take precedence.
+var systemTableHandlers = map[string]Handler{}
+
+// DatabaseHandler is a Handler for a system table whose rows depend on the database that the table was resolved from.
+type DatabaseHandler interface {
+	Handler
+	// WithDatabase returns a copy of the handler that reads from the given database.
+	WithDatabase(database string) Handler
+}
+
+// AddSystemTableHandler adds the given handler to the system table handler set.
+func AddSystemTableHandler(handler Handler) {
+	systemTableHandlers[strings.ToLower(handler.Name())] = handler
+}
+
+// getSystemTable returns the system table with the given name, if one exists.
+func getSystemTable(tblName string, schema sql.DatabaseSchema) (sql.Table, bool) {
+	handler, ok := systemTableHandlers[strings.ToLower(tblName)]
+	if !ok {
+		return nil, false
+	}
+	if dbHandler, ok := handler.(DatabaseHandler); ok {
+		handler = dbHandler.WithDatabase(schema.Name())
+	}
+	return NewVirtualTable(handler, schema), true
+}
@@ -0,0 +1,145 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tables
+
+import (
+	"fmt"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/postgres/parser/pgerror"
+	"github.com/dolthub/doltgresql/server/temporary"
+)
+
+// TemporarySchema is the temporary schema of a session, which holds the session's temporary tables, views, and
+// sequences. None of these objects are written to the working set: temporary tables are held by Dolt's session, while
+// temporary views and sequences are held by the temporary package. Temporary tables are created through the schema
+// that is current for the session.
+type TemporarySchema struct {
+	*PgDatabase
+	connectionID uint32
+}
+
+var _ sql.DatabaseSchema = (*TemporarySchema)(nil)
+var _ sql.ViewDatabase = (*TemporarySchema)(nil)
+var _ sql.SchemaObjectNameValidator = (*TemporarySchema)(nil)
+
+// newTemporarySchema returns the temporary schema of the session, backed by the session's current schema.
+func newTemporarySchema(ctx *sql.Context, d *PgDatabase) (*TemporarySchema, bool, error) {
+	currentSchema, err := core.GetCurrentSchema(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	schema, ok, err := d.Database.GetSchema(ctx, currentSchema)
+	if !ok || err != nil {
+		return nil, false, err
+	}
+	sdb, ok := schema.(sqle.Database)
+	if !ok {
+		return nil, false, nil
+	}
+	return &TemporarySchema{PgDatabase: &PgDatabase{sdb}, connectionID: ctx.Session.ID()}, true, nil
+}
+
+// SchemaName implements the interface sql.DatabaseSchema.
+func (t *TemporarySchema) SchemaName() string {
+	return temporary.SchemaName(t.connectionID)
+}
+
+// GetTableInsensitive implements the interface sql.Database. Only temporary tables are found in this schema.
+func (t *TemporarySchema) GetTableInsensitive(ctx *sql.Context, tblName string) (sql.Table, bool, error) {
+	table, ok := dsess.DSessFromSess(ctx.Session).GetTemporaryTable(ctx, t.Name(), tblName)
+	return table, ok, nil
+}
+
+// GetTableNames implements the interface sql.Database. Only temporary tables are found in this schema.
+func (t *TemporarySchema) GetTableNames(ctx *sql.Context) ([]string, error) {
+	tables, err := t.Database.GetAllTemporaryTables(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tableNames := make([]string, len(tables))
+	for i, table := range tables {
+		tableNames[i] = table.Name()
+	}
+	return tableNames, nil
+}
+
+// AllViews implements the interface sql.ViewDatabase.
+func (t *TemporarySchema) AllViews(ctx *sql.Context) ([]sql.ViewDefinition, error) {
+	views := temporary.Views(t.connectionID, t.Name())
+	definitions := make([]sql.ViewDefinition, len(views))
+	for i, view := range views {
+		definitions[i] = temporaryViewDefinition(t.connectionID, view)
+	}
+	return definitions, nil
+}
+
+// CreateView implements the interface sql.ViewDatabase.
+func (t *TemporarySchema) CreateView(ctx *sql.Context, name string, selectStatement, createViewStmt string) error {
+	if !temporary.AddView(t.connectionID, t.Name(), temporary.View{
+		Name:                name,
+		TextDefinition:      selectStatement,
+		CreateViewStatement: createViewStmt,
+	}) {
+		return sql.ErrExistingView.New(t.Name(), name)
+	}
+	return nil
+}
+
+// DropView implements the interface sql.ViewDatabase.
+func (t *TemporarySchema) DropView(ctx *sql.Context, name string) error {
+	if !temporary.DropView(t.connectionID, t.Name(), name) {
+		return sql.ErrViewDoesNotExist.New(t.Name(), name)
+	}
+	return nil
+}
+
+// GetViewDefinition implements the interface sql.ViewDatabase.
+func (t *TemporarySchema) GetViewDefinition(ctx *sql.Context, viewName string) (sql.ViewDefinition, bool, error) {
+	view, ok := temporary.GetView(t.connectionID, t.Name(), viewName)
+	if !ok {
+		return sql.ViewDefinition{}, false, nil
+	}
+	return temporaryViewDefinition(t.connectionID, view), true, nil
+}
+
+// ValidateNewViewName implements the interface sql.SchemaObjectNameValidator.
+func (t *TemporarySchema) ValidateNewViewName(ctx *sql.Context, newViewName string, replaceAllowed bool) error {
+	if _, ok := temporary.GetView(t.connectionID, t.Name(), newViewName); ok {
+		if replaceAllowed {
+			return nil
+		}
+	} else if _, ok = temporary.GetSequence(t.connectionID, t.Name(), newViewName); !ok {
+		return nil
+	} else if replaceAllowed {
+		return fmt.Errorf(`"%s" is not a view`, newViewName)
+	}
+	return pgerror.WithCandidateCode(fmt.Errorf(`relation "%s" already exists`, newViewName), pgcode.DuplicateRelation)
+}
+
+// temporaryViewDefinition returns the definition of the given temporary view.
+func temporaryViewDefinition(connectionID uint32, view temporary.View) sql.ViewDefinition {
+	return sql.ViewDefinition{
+		Name:                view.Name,
+		TextDefinition:      view.TextDefinition,
+		CreateViewStatement: view.CreateViewStatement,
+		SchemaName:          temporary.SchemaName(connectionID),
+	}
+}
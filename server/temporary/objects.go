@@ -0,0 +1,329 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package temporary
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/dolthub/doltgresql/core/sequences"
+)
+
+// OnCommit is the action that is taken on a temporary table whenever a transaction ends.
+type OnCommit uint8
+
+const (
+	OnCommit_PreserveRows OnCommit = iota
+	OnCommit_DeleteRows
+	OnCommit_Drop
+)
+
+// View is a temporary view, which is only visible to the session that created it.
+type View struct {
+	Name                string
+	TextDefinition      string
+	CreateViewStatement string
+}
+
+// Table is a temporary table that has an ON COMMIT action other than PRESERVE ROWS.
+type Table struct {
+	Database string
+	Name     string
+	OnCommit OnCommit
+}
+
+// objectName is the name of a temporary object within a database.
+type objectName struct {
+	database string
+	name     string
+}
+
+// objects holds the temporary objects of a single session. Temporary tables are held by Dolt's session rather than
+// here, so only the ON COMMIT actions of those tables are tracked.
+type objects struct {
+	views     map[objectName]View
+	sequences map[objectName]*sequences.Sequence
+	onCommit  map[objectName]OnCommit
+	used      bool
+}
+
+// sessions holds the temporary objects of every connection, keyed by the connection ID. None of these objects are
+// ever written to the working set.
+var sessions = struct {
+	mu          sync.Mutex
+	connections map[uint32]*objects
+}{connections: make(map[uint32]*objects)}
+
+// SchemaName returns the name of the temporary schema for the given connection.
+func SchemaName(connectionID uint32) string {
+	return fmt.Sprintf("pg_temp_%d", connectionID)
+}
+
+// IsSchema returns whether the schema name refers to the temporary schema of the given connection. The "pg_temp"
+// alias always refers to the temporary schema of the current connection.
+func IsSchema(connectionID uint32, schemaName string) bool {
+	schemaName = strings.ToLower(schemaName)
+	return schemaName == "pg_temp" || schemaName == SchemaName(connectionID)
+}
+
+// Used returns whether the given connection has created any temporary objects that are tracked here.
+func Used(connectionID uint32) bool {
+	sessions.mu.Lock()
+	defer sessions.mu.Unlock()
+	session, ok := sessions.connections[connectionID]
+	return ok && session.used
+}
+
+// AddView adds the temporary view to the given connection. Returns false if a temporary view with the same name
+// already exists.
+func AddView(connectionID uint32, database string, view View) bool {
+	sessions.mu.Lock()
+	defer sessions.mu.Unlock()
+	session := getSession(connectionID)
+	key := objectName{database: database, name: view.Name}
+	if _, ok := session.views[key]; ok {
+		return false
+	}
+	session.views[key] = view
+	return true
+}
+
+// GetView returns the temporary view with the given name from the given connection, and whether it exists.
+func GetView(connectionID uint32, database string, name string) (View, bool) {
+	sessions.mu.Lock()
+	defer sessions.mu.Unlock()
+	session, ok := sessions.connections[connectionID]
+	if !ok {
+		return View{}, false
+	}
+	view, ok := session.views[objectName{database: database, name: name}]
+	return view, ok
+}
+
+// DropView removes the temporary view with the given name from the given connection. Returns false if the view did
+// not exist.
+func DropView(connectionID uint32, database string, name string) bool {
+	sessions.mu.Lock()
+	defer sessions.mu.Unlock()
+	session, ok := sessions.connections[connectionID]
+	if !ok {
+		return false
+	}
+	key := objectName{database: database, name: name}
+	if _, ok = session.views[key]; !ok {
+		return false
+	}
+	delete(session.views, key)
+	return true
+}
+
+// Views returns every temporary view of the given connection within the database, sorted by name.
+func Views(connectionID uint32, database string) []View {
+	sessions.mu.Lock()
+	defer sessions.mu.Unlock()
+	session, ok := sessions.connections[connectionID]
+	if !ok {
+		return nil
+	}
+	var views []View
+	for key, view := range session.views {
+		if key.database == database {
+			views = append(views, view)
+		}
+	}
+	sort.Slice(views, func(i, j int) bool {
+		return views[i].Name < views[j].Name
+	})
+	return views
+}
+
+// AddSequence adds a copy of the temporary sequence to the given connection. Returns false if a temporary sequence
+// with the same name already exists.
+func AddSequence(connectionID uint32, database string, sequence *sequences.Sequence) bool {
+	sessions.mu.Lock()
+	defer sessions.mu.Unlock()
+	session := getSession(connectionID)
+	key := objectName{database: database, name: sequence.Id.SequenceName()}
+	if _, ok := session.sequences[key]; ok {
+		return false
+	}
+	sequenceCopy := *sequence
+	session.sequences[key] = &sequenceCopy
+	return true
+}
+
+// GetSequence returns a copy of the temporary sequence with the given name from the given connection, and whether
+// it exists.
+func GetSequence(connectionID uint32, database string, name string) (*sequences.Sequence, bool) {
+	sessions.mu.Lock()
+	defer sessions.mu.Unlock()
+	session, ok := sessions.connections[connectionID]
+	if !ok {
+		return nil, false
+	}
+	sequence, ok := session.sequences[objectName{database: database, name: name}]
+	if !ok {
+		return nil, false
+	}
+	sequenceCopy := *sequence
+	return &sequenceCopy, true
+}
+
+// NextVal advances the temporary sequence with the given name, returning the value that it was advanced from.
+// Returns false if the sequence does not exist.
+func NextVal(connectionID uint32, database string, name string) (int64, bool, error) {
+	sessions.mu.Lock()
+	defer sessions.mu.Unlock()
+	session, ok := sessions.connections[connectionID]
+	if !ok {
+		return 0, false, nil
+	}
+	sequence, ok := session.sequences[objectName{database: database, name: name}]
+	if !ok {
+		return 0, false, nil
+	}
+	value, _, nextState, err := sequence.SequenceState.Next()
+	if err != nil {
+		return 0, true, err
+	}
+	sequence.SequenceState = nextState
+	return value, true, nil
+}
+
+// SetVal sets the current value of the temporary sequence with the given name. When |autoAdvance| is true, the next
+// call to NextVal returns the value after the one given. Returns false if the sequence does not exist.
+func SetVal(connectionID uint32, database string, name string, value int64, autoAdvance bool) (bool, error) {
+	sessions.mu.Lock()
+	defer sessions.mu.Unlock()
+	session, ok := sessions.connections[connectionID]
+	if !ok {
+		return false, nil
+	}
+	sequence, ok := session.sequences[objectName{database: database, name: name}]
+	if !ok {
+		return false, nil
+	}
+	if value < sequence.Minimum || value > sequence.Maximum {
+		return true, errors.Errorf(`setval: value %d is out of bounds for sequence "%s" (%d..%d)`,
+			value, name, sequence.Minimum, sequence.Maximum)
+	}
+	nextState := sequence.SequenceState.WithValue(value)
+	nextState.HasBeenCalled = autoAdvance
+	if autoAdvance {
+		var err error
+		if _, _, nextState, err = nextState.Next(); err != nil {
+			return true, err
+		}
+	}
+	sequence.SequenceState = nextState
+	return true, nil
+}
+
+// DropSequence removes the temporary sequence with the given name from the given connection. Returns false if the
+// sequence did not exist.
+func DropSequence(connectionID uint32, database string, name string) bool {
+	sessions.mu.Lock()
+	defer sessions.mu.Unlock()
+	session, ok := sessions.connections[connectionID]
+	if !ok {
+		return false
+	}
+	key := objectName{database: database, name: name}
+	if _, ok = session.sequences[key]; !ok {
+		return false
+	}
+	delete(session.sequences, key)
+	return true
+}
+
+// SetOnCommit sets the ON COMMIT action of the temporary table with the given name.
+func SetOnCommit(connectionID uint32, database string, table string, action OnCommit) {
+	sessions.mu.Lock()
+	defer sessions.mu.Unlock()
+	session := getSession(connectionID)
+	key := objectName{database: database, name: table}
+	if action == OnCommit_PreserveRows {
+		delete(session.onCommit, key)
+		return
+	}
+	session.onCommit[key] = action
+}
+
+// OnCommitTables returns every temporary table of the given connection that has an ON COMMIT action other than
+// PRESERVE ROWS, sorted by database and then by name.
+func OnCommitTables(connectionID uint32) []Table {
+	sessions.mu.Lock()
+	defer sessions.mu.Unlock()
+	session, ok := sessions.connections[connectionID]
+	if !ok {
+		return nil
+	}
+	tables := make([]Table, 0, len(session.onCommit))
+	for key, action := range session.onCommit {
+		tables = append(tables, Table{Database: key.database, Name: key.name, OnCommit: action})
+	}
+	sort.Slice(tables, func(i, j int) bool {
+		if tables[i].Database != tables[j].Database {
+			return tables[i].Database < tables[j].Database
+		}
+		return tables[i].Name < tables[j].Name
+	})
+	return tables
+}
+
+// RemoveTable removes the ON COMMIT action of the temporary table with the given name, along with any temporary
+// sequences that are owned by the table. This is called once the table has been dropped.
+func RemoveTable(connectionID uint32, database string, table string) {
+	sessions.mu.Lock()
+	defer sessions.mu.Unlock()
+	session, ok := sessions.connections[connectionID]
+	if !ok {
+		return
+	}
+	delete(session.onCommit, objectName{database: database, name: table})
+	for key, sequence := range session.sequences {
+		if key.database == database && sequence.OwnerTable.IsValid() && sequence.OwnerTable.TableName() == table {
+			delete(session.sequences, key)
+		}
+	}
+}
+
+// RemoveAll removes every temporary object from the given connection.
+func RemoveAll(connectionID uint32) {
+	sessions.mu.Lock()
+	defer sessions.mu.Unlock()
+	delete(sessions.connections, connectionID)
+}
+
+// getSession returns the temporary objects of the given connection, creating them if they do not yet exist. Any
+// caller is considered to be creating an object, so the session is marked as having used its temporary schema. This
+// assumes that the lock is held.
+func getSession(connectionID uint32) *objects {
+	session, ok := sessions.connections[connectionID]
+	if !ok {
+		session = &objects{
+			views:     make(map[objectName]View),
+			sequences: make(map[objectName]*sequences.Sequence),
+			onCommit:  make(map[objectName]OnCommit),
+		}
+		sessions.connections[connectionID] = session
+	}
+	session.used = true
+	return session
+}
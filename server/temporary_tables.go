@@ -0,0 +1,65 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/dolthub/doltgresql/server/temporary"
+)
+
+// applyOnCommitActions performs the ON COMMIT actions of the session's temporary tables, which take place whenever a
+// transaction ends. Temporary tables are never part of the working set, so a rollback does not undo any of their
+// changes, and the same actions are therefore performed when a transaction is rolled back. Tables that belong to a
+// database other than the current one keep their actions until that database is current again.
+func (h *ConnectionHandler) applyOnCommitActions() {
+	tables := temporary.OnCommitTables(h.mysqlConn.ConnectionID)
+	if len(tables) == 0 {
+		return
+	}
+	ctx, err := h.doltgresHandler.NewContext(context.Background(), h.mysqlConn, "")
+	if err != nil {
+		logrus.Warnf("error performing ON COMMIT actions: %s", err)
+		return
+	}
+	currentDatabase := ctx.GetCurrentDatabase()
+	for _, table := range tables {
+		if table.Database != currentDatabase {
+			continue
+		}
+		var statement string
+		switch table.OnCommit {
+		case temporary.OnCommit_DeleteRows:
+			statement = "DELETE FROM " + quoteTemporaryTableName(table.Name)
+		case temporary.OnCommit_Drop:
+			statement = "DROP TABLE " + quoteTemporaryTableName(table.Name)
+		default:
+			continue
+		}
+		if err = h.runEngineTransactionControl(statement); err != nil {
+			// The table can no longer be found, so there's nothing left for its action to apply to
+			logrus.Warnf("error performing ON COMMIT action on temporary table %s: %s", table.Name, err)
+			temporary.RemoveTable(h.mysqlConn.ConnectionID, table.Database, table.Name)
+		}
+	}
+}
+
+// quoteTemporaryTableName returns the name of the temporary table quoted for use within a statement.
+func quoteTemporaryTableName(name string) string {
+	return `pg_temp."` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
@@ -0,0 +1,340 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"strings"
+
+	"github.com/dolthub/dolt/go/store/hash"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgproto3"
+	"github.com/sirupsen/logrus"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/server/isolation"
+	"github.com/dolthub/doltgresql/server/node"
+)
+
+// transactionBlock holds the characteristics of an explicit transaction block. Dolt gives every transaction a snapshot
+// of the database as of its start, which is exactly REPEATABLE READ. The other isolation levels are built on top of
+// that: READ COMMITTED restarts the engine transaction before each statement so that the statement sees everything
+// that has been committed (until the transaction makes its first change, see prepareTransactionSnapshot), and
+// SERIALIZABLE additionally has its reads and writes tracked (see the isolation package)
+// so that conflicts may be detected at commit.
+type transactionBlock struct {
+	level    isolation.Level
+	readOnly bool
+	// started is set once the block has run a statement that reads or writes data, after which the isolation level
+	// may no longer be changed.
+	started bool
+	// savepoints are the names of the savepoints that are active, in the order that they were created.
+	savepoints []string
+	// snapshot is the hash of the root at the start of the engine transaction. If the current root still matches,
+	// then the transaction has not made any changes, and it's safe to restart the engine transaction.
+	snapshot hash.Hash
+}
+
+// beginTransactionBlock starts an explicit transaction block with the characteristics from the given BEGIN statement.
+func (h *ConnectionHandler) beginTransactionBlock(stmt *sqlparser.Begin, query ConvertedQuery) error {
+	level, readOnly := transactionCharacteristics(stmt.TransactionCharacteristic)
+	ctx, err := h.doltgresHandler.NewContext(context.Background(), h.mysqlConn, "")
+	if err != nil {
+		return err
+	}
+	if h.transactionState == implicitTransactionState {
+		// A BEGIN inside an implicit transaction block converts it into a regular (explicit) transaction block: the
+		// statements already executed in the implicit block are NOT committed, but instead are retroactively included
+		// in the new explicit block. The engine transaction backing the implicit block simply continues as the
+		// explicit block's transaction, so we don't involve the engine here.
+		if level == "" {
+			level = isolation.CurrentLevel(ctx)
+		}
+		h.transactionState = explicitTransactionState
+		h.transactionBlock = &transactionBlock{level: level, readOnly: readOnly, started: true}
+		isolation.SetLevel(h.mysqlConn.ConnectionID, level)
+		if err = isolation.SetCurrentLevel(ctx, level); err != nil {
+			return err
+		}
+		return h.send(makeCommandComplete(query.StatementTag, 0))
+	}
+	if level == "" {
+		level = isolation.DefaultLevel(ctx)
+	}
+	// Tracking must begin before the engine transaction, so that no commit is missed between the two
+	isolation.Begin(h.mysqlConn.ConnectionID, level, false)
+	if err = h.startEngineTransaction(readOnly); err != nil {
+		isolation.Rollback(h.mysqlConn.ConnectionID)
+		return err
+	}
+	h.transactionState = explicitTransactionState
+	h.transactionBlock = &transactionBlock{level: level, readOnly: readOnly}
+	if err = isolation.SetCurrentLevel(ctx, level); err != nil {
+		return err
+	}
+	if h.transactionBlock.snapshot, err = h.transactionRootHash(); err != nil {
+		return err
+	}
+	return h.send(makeCommandComplete(query.StatementTag, 0))
+}
+
+// setTransaction handles SET TRANSACTION, which changes the isolation level of the current transaction block.
+func (h *ConnectionHandler) setTransaction(stmt *node.SetTransaction) error {
+	if h.transactionState != explicitTransactionState || h.transactionBlock == nil {
+		// Postgres only warns when this is used outside of a transaction block, since it has no effect
+		if err := h.send(&pgproto3.NoticeResponse{
+			Severity: "WARNING",
+			Code:     pgcode.NoActiveSQLTransaction.String(),
+			Message:  "SET TRANSACTION can only be used in transaction blocks",
+		}); err != nil {
+			return err
+		}
+		return h.send(&pgproto3.CommandComplete{CommandTag: []byte("SET")})
+	}
+	level := isolation.Level(stmt.IsolationLevel)
+	if h.transactionBlock.started && level != h.transactionBlock.level {
+		return &pgconn.PgError{
+			Severity: string(ErrorResponseSeverity_Error),
+			Code:     pgcode.ActiveSQLTransaction.String(),
+			Message:  "SET TRANSACTION ISOLATION LEVEL must be called before any query",
+		}
+	}
+	ctx, err := h.doltgresHandler.NewContext(context.Background(), h.mysqlConn, "")
+	if err != nil {
+		return err
+	}
+	if err = isolation.SetCurrentLevel(ctx, level); err != nil {
+		return err
+	}
+	return h.send(&pgproto3.CommandComplete{CommandTag: []byte("SET")})
+}
+
+// prepareTransactionSnapshot is called before the given statement is executed within an explicit transaction block,
+// and gives the statement the snapshot that its isolation level requires. Postgres takes the snapshot of a transaction
+// on its first statement rather than at BEGIN, so the first statement always receives a fresh snapshot, and READ
+// COMMITTED transactions receive a fresh snapshot for every statement.
+func (h *ConnectionHandler) prepareTransactionSnapshot(query ConvertedQuery) error {
+	block := h.transactionBlock
+	if h.transactionState != explicitTransactionState || block == nil || !statementUsesSnapshot(query) {
+		return nil
+	}
+	if !block.started {
+		ctx, err := h.doltgresHandler.NewContext(context.Background(), h.mysqlConn, "")
+		if err != nil {
+			return err
+		}
+		// The level may have been changed by SET TRANSACTION, or by setting transaction_isolation directly
+		block.level = isolation.CurrentLevel(ctx)
+		block.started = true
+		isolation.Begin(h.mysqlConn.ConnectionID, block.level, false)
+	} else if !block.level.UsesStatementSnapshots() {
+		return nil
+	}
+	currentRoot, err := h.transactionRootHash()
+	if err != nil {
+		return err
+	}
+	if currentRoot != block.snapshot {
+		// The transaction has made changes, which would be lost by restarting the engine transaction. Giving this
+		// statement a fresh snapshot would require merging those changes onto the latest committed root, which is not
+		// yet supported, so the statement continues to see the snapshot that the changes were made against. This is
+		// a limitation of READ COMMITTED: once a transaction has written, it no longer sees concurrent commits, which
+		// are instead merged with its changes when it commits. A block converted from an implicit transaction block
+		// also lands here, as its snapshot is unknown.
+		return nil
+	}
+	return h.refreshTransactionSnapshot()
+}
+
+// refreshTransactionSnapshot restarts the engine transaction backing the explicit transaction block, so that the next
+// statement sees all data that has been committed. This must only be called when the transaction has not made any
+// changes. Savepoints are recreated in the new transaction, and since there were no changes, they refer to the same
+// data as before.
+func (h *ConnectionHandler) refreshTransactionSnapshot() (err error) {
+	block := h.transactionBlock
+	if err = h.runEngineStatement("ROLLBACK", &sqlparser.Rollback{}); err != nil {
+		return err
+	}
+	if err = h.startEngineTransaction(block.readOnly); err != nil {
+		return err
+	}
+	for _, savepoint := range block.savepoints {
+		if err = h.runEngineStatement("SAVEPOINT", &sqlparser.Savepoint{Identifier: savepoint}); err != nil {
+			return err
+		}
+	}
+	block.snapshot, err = h.transactionRootHash()
+	return err
+}
+
+// trackSavepoint updates the active savepoints of the explicit transaction block for the given statement.
+func (h *ConnectionHandler) trackSavepoint(stmt sqlparser.Statement) {
+	block := h.transactionBlock
+	if block == nil {
+		return
+	}
+	switch stmt := stmt.(type) {
+	case *sqlparser.Savepoint:
+		block.savepoints = append(block.savepoints, stmt.Identifier)
+	case *sqlparser.RollbackSavepoint:
+		// Rolling back to a savepoint keeps the savepoint, while destroying every savepoint created after it
+		if idx := lastSavepointIndex(block.savepoints, stmt.Identifier); idx >= 0 {
+			block.savepoints = block.savepoints[:idx+1]
+		}
+	case *sqlparser.ReleaseSavepoint:
+		if idx := lastSavepointIndex(block.savepoints, stmt.Identifier); idx >= 0 {
+			block.savepoints = block.savepoints[:idx]
+		}
+	}
+}
+
+// commitTransactionIsolation checks whether the current transaction may commit under its isolation level. If it may
+// not, then the engine transaction is rolled back, and the serialization failure is returned. This must be called
+// before the engine transaction is committed.
+func (h *ConnectionHandler) commitTransactionIsolation() error {
+	h.endTransactionBlock()
+	if err := isolation.Commit(h.mysqlConn.ConnectionID); err != nil {
+		if rollbackErr := h.runEngineStatement("ROLLBACK", &sqlparser.Rollback{}); rollbackErr != nil {
+			logrus.Warnf("error rolling back transaction after serialization failure: %s", rollbackErr)
+		}
+		return err
+	}
+	return nil
+}
+
+// rollbackTransactionIsolation stops tracking the current transaction, as it is being rolled back.
+func (h *ConnectionHandler) rollbackTransactionIsolation() {
+	h.endTransactionBlock()
+	isolation.Rollback(h.mysqlConn.ConnectionID)
+}
+
+// endTransactionBlock removes the explicit transaction block, restoring the reported isolation level to the default.
+func (h *ConnectionHandler) endTransactionBlock() {
+	if h.transactionBlock == nil {
+		return
+	}
+	h.transactionBlock = nil
+	ctx, err := h.doltgresHandler.NewContext(context.Background(), h.mysqlConn, "")
+	if err != nil {
+		return
+	}
+	if err = isolation.SetCurrentLevel(ctx, isolation.DefaultLevel(ctx)); err != nil {
+		logrus.Warnf("error restoring transaction_isolation: %s", err)
+	}
+}
+
+// startEngineTransaction starts a new engine transaction, which commits any engine transaction that is in progress.
+func (h *ConnectionHandler) startEngineTransaction(readOnly bool) error {
+	characteristic := sqlparser.TxReadWrite
+	if readOnly {
+		characteristic = sqlparser.TxReadOnly
+	}
+	return h.runEngineStatement("START TRANSACTION", &sqlparser.Begin{TransactionCharacteristic: characteristic})
+}
+
+// runEngineStatement runs the given statement through the engine without sending any response messages to the client.
+func (h *ConnectionHandler) runEngineStatement(query string, stmt sqlparser.Statement) error {
+	return h.doltgresHandler.ComQuery(context.Background(), h.mysqlConn, query, stmt,
+		func(*sql.Context, *Result) error {
+			return nil
+		})
+}
+
+// transactionRootHash returns the hash of the session's root for the current database. Returns an empty hash if there
+// is no current database, or if it is not backed by a Doltgres root.
+func (h *ConnectionHandler) transactionRootHash() (hash.Hash, error) {
+	ctx, err := h.doltgresHandler.NewContext(context.Background(), h.mysqlConn, "")
+	if err != nil {
+		return hash.Hash{}, err
+	}
+	if len(ctx.GetCurrentDatabase()) == 0 {
+		return hash.Hash{}, nil
+	}
+	_, root, err := core.GetRootFromContext(ctx)
+	if err != nil || root == nil {
+		return hash.Hash{}, err
+	}
+	return root.HashOf()
+}
+
+// transactionCharacteristics returns the isolation level and access mode from the characteristic of a BEGIN
+// statement. The isolation level is empty if it was not specified.
+func transactionCharacteristics(characteristic string) (level isolation.Level, readOnly bool) {
+	for _, part := range strings.Split(characteristic, ",") {
+		switch strings.TrimSpace(part) {
+		case sqlparser.IsolationLevelReadUncommitted:
+			level = isolation.ReadUncommitted
+		case sqlparser.IsolationLevelReadCommitted:
+			level = isolation.ReadCommitted
+		case sqlparser.IsolationLevelRepeatableRead:
+			level = isolation.RepeatableRead
+		case sqlparser.IsolationLevelSerializable:
+			level = isolation.Serializable
+		case sqlparser.TxReadOnly:
+			readOnly = true
+		}
+	}
+	return level, readOnly
+}
+
+// statementUsesSnapshot returns whether the given statement reads or writes data, and therefore needs a snapshot.
+func statementUsesSnapshot(query ConvertedQuery) bool {
+	if query.AST == nil {
+		return false
+	}
+	switch stmt := query.AST.(type) {
+	case *sqlparser.Begin, *sqlparser.Commit, *sqlparser.Rollback, *sqlparser.Savepoint, *sqlparser.RollbackSavepoint,
+		*sqlparser.ReleaseSavepoint, *sqlparser.Set, *sqlparser.Deallocate:
+		return false
+	case sqlparser.InjectedStatement:
+		switch stmt.Statement.(type) {
+		case *node.SetTransaction, *node.PrepareStatement, node.DiscardStatement:
+			return false
+		}
+	}
+	return query.StatementTag != "SHOW"
+}
+
+// lastSavepointIndex returns the index of the most recent savepoint with the given name, or -1 if it does not exist.
+func lastSavepointIndex(savepoints []string, name string) int {
+	for i := len(savepoints) - 1; i >= 0; i-- {
+		if strings.EqualFold(savepoints[i], name) {
+			return i
+		}
+	}
+	return -1
+}
+
+// autocommitQuery runs the given statement. Outside of a transaction block, the statement is committed by the engine
+// as soon as it completes, and when it is SERIALIZABLE, its reads and writes are recorded so that concurrent
+// SERIALIZABLE transactions are checked against them.
+func (h *ConnectionHandler) autocommitQuery(query ConvertedQuery) error {
+	if h.transactionState != idleTransactionState {
+		return h.query(query)
+	}
+	ctx, err := h.doltgresHandler.NewContext(context.Background(), h.mysqlConn, "")
+	if err != nil {
+		return err
+	}
+	isolation.Begin(h.mysqlConn.ConnectionID, isolation.DefaultLevel(ctx), true)
+	if err = h.query(query); err != nil {
+		isolation.Rollback(h.mysqlConn.ConnectionID)
+		return err
+	}
+	return isolation.Commit(h.mysqlConn.ConnectionID)
+}
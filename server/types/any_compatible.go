@@ -0,0 +1,56 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"github.com/dolthub/doltgresql/core/id"
+)
+
+// AnyCompatible is a pseudo-type that can represent any type. Unlike anyelement, arguments declared as anycompatible
+// do not need to share the same type, as they are implicitly cast to their common type.
+var AnyCompatible = &DoltgresType{
+	ID:            toInternal("anycompatible"),
+	TypLength:     int16(4),
+	PassedByVal:   true,
+	TypType:       TypeType_Pseudo,
+	TypCategory:   TypeCategory_PseudoTypes,
+	IsPreferred:   false,
+	IsDefined:     true,
+	Delimiter:     ",",
+	RelID:         id.Null,
+	SubscriptFunc: toFuncID("-"),
+	Elem:          internalNullType,
+	Array:         internalNullType,
+	InputFunc:     toFuncID("anycompatible_in", toInternal("cstring")),
+	OutputFunc:    toFuncID("anycompatible_out", toInternal("anycompatible")),
+	ReceiveFunc:   toFuncID("-"),
+	SendFunc:      toFuncID("-"),
+	ModInFunc:     toFuncID("-"),
+	ModOutFunc:    toFuncID("-"),
+	AnalyzeFunc:   toFuncID("-"),
+	Align:         TypeAlignment_Int,
+	Storage:       TypeStorage_Plain,
+	NotNull:       false,
+	BaseTypeType:  internalNullType,
+	TypMod:        -1,
+	NDims:         0,
+	TypCollation:  id.NullCollation,
+	DefaulBin:     "",
+	Default:       "",
+	Acl:           nil,
+	Checks:        nil,
+	attTypMod:     -1,
+	CompareFunc:   toFuncID("-"),
+}
@@ -15,6 +15,8 @@
 package types
 
 import (
+	"sort"
+
 	"github.com/cockroachdb/errors"
 	"github.com/dolthub/go-mysql-server/sql"
 	srcdErrors "gopkg.in/src-d/go-errors.v1"
@@ -84,6 +86,72 @@ func NewEnumLabel(ctx *sql.Context, labelID id.EnumLabel, so float32) EnumLabel
 	}
 }
 
+// SortedEnumLabels returns the given labels in their sort order.
+func SortedEnumLabels(labels map[string]EnumLabel) []EnumLabel {
+	sorted := make([]EnumLabel, 0, len(labels))
+	for _, label := range labels {
+		sorted = append(sorted, label)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].SortOrder < sorted[j].SortOrder
+	})
+	return sorted
+}
+
+// PlaceEnumLabel returns a copy of the labels that also contains the new label, which is positioned directly before or
+// after the neighbor. An empty neighbor places the label after every other label. Existing labels keep their sort
+// order unless there is no room left between the neighbors, in which case every label is renumbered.
+func PlaceEnumLabel(typeID id.Type, labels map[string]EnumLabel, label string, neighbor string, before bool) (map[string]EnumLabel, error) {
+	sorted := SortedEnumLabels(labels)
+	idx := len(sorted)
+	if len(neighbor) > 0 {
+		idx = -1
+		for i, existing := range sorted {
+			if existing.ID.Label() == neighbor {
+				idx = i
+				break
+			}
+		}
+		if idx == -1 {
+			return nil, errors.Errorf(`"%s" is not an existing enum label`, neighbor)
+		}
+		if !before {
+			idx++
+		}
+	}
+	var sortOrder float32
+	switch {
+	case len(sorted) == 0:
+		sortOrder = 1
+	case idx == 0:
+		sortOrder = sorted[0].SortOrder - 1
+	case idx == len(sorted):
+		sortOrder = sorted[len(sorted)-1].SortOrder + 1
+	default:
+		lower, upper := sorted[idx-1].SortOrder, sorted[idx].SortOrder
+		sortOrder = lower + (upper-lower)/2
+		if sortOrder <= lower || sortOrder >= upper {
+			// We've run out of precision between the two labels, so we renumber all of them
+			newLabels := make(map[string]EnumLabel, len(sorted)+1)
+			for i, existing := range sorted {
+				so := float32(i + 1)
+				if i >= idx {
+					so++
+				}
+				newLabels[existing.ID.Label()] = EnumLabel{ID: existing.ID, SortOrder: so}
+			}
+			newLabels[label] = EnumLabel{ID: id.NewEnumLabel(typeID, label), SortOrder: float32(idx + 1)}
+			return newLabels, nil
+		}
+	}
+	newLabels := make(map[string]EnumLabel, len(labels)+1)
+	for name, existing := range labels {
+		newLabels[name] = existing
+	}
+	newLabels[label] = EnumLabel{ID: id.NewEnumLabel(typeID, label), SortOrder: sortOrder}
+	return newLabels, nil
+}
+
 // serializeTypeEnum handles serialization from the standard representation to our serialized representation that is
 // written in Dolt.
 func serializeTypeEnum(ctx *sql.Context, t *DoltgresType, val any) ([]byte, error) {
@@ -202,6 +202,7 @@ func init() {
 		toInternal("aclitem"):          Unknown,
 		toInternal("any"):              Any,
 		toInternal("anyarray"):         AnyArray,
+		toInternal("anycompatible"):    AnyCompatible,
 		toInternal("anyelement"):       AnyElement,
 		toInternal("anyenum"):          AnyEnum,
 		toInternal("anynonarray"):      AnyNonArray,
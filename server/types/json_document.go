@@ -16,6 +16,7 @@ package types
 
 import (
 	"bytes"
+	encjson "encoding/json"
 	"regexp"
 	"sort"
 	"strings"
@@ -329,3 +330,34 @@ func ConvertToJsonDocument(val interface{}) (JsonValue, error) {
 		return nil, errors.Errorf("unexpected type while constructing JsonDocument: %T", val)
 	}
 }
+
+// JsonNumberToDecimal converts the numeric types that are returned from JSON deserialization into a decimal.
+func JsonNumberToDecimal(v any) (*apd.Decimal, bool) {
+	switch n := v.(type) {
+	case float64:
+		d, _ := apd.New(0, 0).SetFloat64(n)
+		return d, true
+	case float32:
+		d, _ := apd.New(0, 0).SetFloat64(float64(n))
+		return d, true
+	case encjson.Number:
+		d, _, err := apd.NewFromString(n.String())
+		if err != nil {
+			return nil, false
+		}
+		return d, true
+	case json.Number:
+		d, _, err := apd.NewFromString(n.String())
+		if err != nil {
+			return nil, false
+		}
+		return d, true
+	case int64:
+		return apd.New(n, 0), true
+	case int32:
+		return apd.New(int64(n), 0), true
+	case *apd.Decimal:
+		return n, true
+	}
+	return nil, false
+}
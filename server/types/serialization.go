@@ -60,7 +60,7 @@ func DeserializeTypeFromCollection(ctx *sql.Context, typeColl TypeCollection, se
 	typ := &DoltgresType{}
 	reader := utils.NewReader(serializedType)
 	version := reader.VariableUint()
-	if version != 0 {
+	if version > 1 {
 		return nil, errors.Errorf("version %d of types is not supported, please upgrade the server", version)
 	}
 
@@ -142,6 +142,12 @@ func DeserializeTypeFromCollection(ctx *sql.Context, typeColl TypeCollection, se
 		}
 	}
 	typ.InternalName = reader.String()
+	if version >= 1 {
+		notValid := reader.BoolSlice()
+		for k := 0; k < len(notValid) && k < len(typ.Checks); k++ {
+			typ.Checks[k].IsNotValid = notValid[k]
+		}
+	}
 	if !reader.IsEmpty() {
 		return nil, errors.Errorf("extra data found while deserializing type %s", typ.Name())
 	}
@@ -182,7 +188,7 @@ func DeserializeTypeFromCollection(ctx *sql.Context, typeColl TypeCollection, se
 // Serialize returns the DoltgresType as a byte slice.
 func (t *DoltgresType) Serialize() []byte {
 	writer := utils.NewWriter(256)
-	writer.VariableUint(0) // Version
+	writer.VariableUint(1) // Version
 	// Write the type to the writer
 	writer.Id(t.ID.AsId())
 	writer.Int16(t.TypLength)
@@ -244,6 +250,12 @@ func (t *DoltgresType) Serialize() []byte {
 		}
 	}
 	writer.String(t.InternalName)
+	// Version 1
+	notValid := make([]bool, len(t.Checks))
+	for i, check := range t.Checks {
+		notValid[i] = check.IsNotValid
+	}
+	writer.BoolSlice(notValid)
 	return writer.Data()
 }
 
@@ -39,6 +39,38 @@ func TestSerializationConsistency(t *testing.T) {
 	}
 }
 
+// TestSerializationVersion0 checks that types serialized before the not-valid state of checks was written are still
+// readable.
+func TestSerializationVersion0(t *testing.T) {
+	for _, typ := range GetAllBuitInTypes() {
+		t.Run(typ.Name(), func(t *testing.T) {
+			serializedType := typ.Serialize()
+			require.Equal(t, byte(1), serializedType[0])
+			// Version 0 only differs by the version, and by lacking the trailing (empty) slice of not-valid checks
+			require.Equal(t, byte(0), serializedType[len(serializedType)-1])
+			version0 := append([]byte{0}, serializedType[1:len(serializedType)-1]...)
+			dt, err := DeserializeType(sql.NewEmptyContext(), version0)
+			require.NoError(t, err)
+			require.Equal(t, typ.ID, dt.(*DoltgresType).ID)
+		})
+	}
+}
+
+// TestSerializationNotValidChecks checks that the not-valid state of a domain's checks is kept.
+func TestSerializationNotValidChecks(t *testing.T) {
+	typ := Int32.Copy()
+	typ.Checks = []*sql.CheckDefinition{
+		{Name: "c1", CheckExpression: "VALUE > 0", Enforced: true},
+		{Name: "c2", CheckExpression: "VALUE < 10", Enforced: true, IsNotValid: true},
+	}
+	dt, err := DeserializeType(sql.NewEmptyContext(), typ.Serialize())
+	require.NoError(t, err)
+	dgt := dt.(*DoltgresType)
+	require.Len(t, dgt.Checks, 2)
+	require.False(t, dgt.Checks[0].IsNotValid)
+	require.True(t, dgt.Checks[1].IsNotValid)
+}
+
 // TestJsonValueType operates as a line of defense to prevent accidental changes to JSON type values. If this test
 // fails, then a JsonValueType was changed that should not have been changed.
 func TestJsonValueType(t *testing.T) {
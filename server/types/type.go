@@ -810,7 +810,7 @@ func (t *DoltgresType) IsEmptyType() bool {
 // The exception is the "any" type, which is not a polymorphic type.
 func (t *DoltgresType) IsPolymorphicType() bool {
 	switch t.ID.TypeName() {
-	case "anyelement", "anyarray", "anynonarray", "anyenum", "anyrange":
+	case "anyelement", "anyarray", "anynonarray", "anyenum", "anyrange", "anycompatible":
 		// TODO: add other polymorphic types
 		// https://www.postgresql.org/docs/15/extend-type-system.html#EXTEND-TYPES-POLYMORPHIC-TABLE
 		return true
@@ -831,7 +831,7 @@ func (t *DoltgresType) IsValidForPolymorphicType(target *DoltgresType) bool {
 	case "any":
 		// "any" is not a polymorphic type like the others are, but it's useful to treat it as such for this check
 		return true
-	case "anyelement":
+	case "anyelement", "anycompatible":
 		return true
 	case "anyarray":
 		return target.TypCategory == TypeCategory_ArrayTypes
@@ -0,0 +1,297 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package walsender
+
+import (
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/jackc/pglogrepl"
+)
+
+// Command is a replication command, which may only be run on a replication connection. These are described in
+// https://www.postgresql.org/docs/15/protocol-replication.html
+type Command interface {
+	replicationCommand()
+}
+
+// IdentifySystemCommand is the IDENTIFY_SYSTEM command.
+type IdentifySystemCommand struct{}
+
+// CreateReplicationSlotCommand is the CREATE_REPLICATION_SLOT command.
+type CreateReplicationSlotCommand struct {
+	Name      string
+	Temporary bool
+	Physical  bool
+	Plugin    string
+}
+
+// DropReplicationSlotCommand is the DROP_REPLICATION_SLOT command.
+type DropReplicationSlotCommand struct {
+	Name string
+	Wait bool
+}
+
+// StartReplicationCommand is the START_REPLICATION command.
+type StartReplicationCommand struct {
+	Slot     string
+	Physical bool
+	LSN      pglogrepl.LSN
+	// Options are the options of the output plugin, keyed by their lower-case names.
+	Options map[string]string
+}
+
+func (IdentifySystemCommand) replicationCommand()        {}
+func (CreateReplicationSlotCommand) replicationCommand() {}
+func (DropReplicationSlotCommand) replicationCommand()   {}
+func (StartReplicationCommand) replicationCommand()      {}
+
+// ParseCommand parses the query as a replication command. Returns false if the query is not a replication command, in
+// which case it should be handled as a regular SQL statement.
+func ParseCommand(query string) (Command, bool, error) {
+	tokens, err := tokenizeCommand(query)
+	if err != nil || len(tokens) == 0 {
+		return nil, false, nil
+	}
+	p := &commandParser{tokens: tokens}
+	switch strings.ToUpper(p.next().text) {
+	case "IDENTIFY_SYSTEM":
+		cmd := IdentifySystemCommand{}
+		return cmd, true, p.end()
+	case "CREATE_REPLICATION_SLOT":
+		cmd, err := p.createReplicationSlot()
+		return cmd, true, err
+	case "DROP_REPLICATION_SLOT":
+		cmd := DropReplicationSlotCommand{Name: p.next().text}
+		if cmd.Name == "" {
+			return nil, true, errors.New("syntax error: expected a replication slot name")
+		}
+		if p.peekKeyword("WAIT") {
+			p.next()
+			cmd.Wait = true
+		}
+		return cmd, true, p.end()
+	case "START_REPLICATION":
+		cmd, err := p.startReplication()
+		return cmd, true, err
+	case "READ_REPLICATION_SLOT", "TIMELINE_HISTORY", "BASE_BACKUP":
+		return nil, true, errors.Errorf("%s is not yet supported", strings.ToUpper(tokens[0].text))
+	default:
+		return nil, false, nil
+	}
+}
+
+// createReplicationSlot parses the remainder of a CREATE_REPLICATION_SLOT command.
+func (p *commandParser) createReplicationSlot() (Command, error) {
+	cmd := CreateReplicationSlotCommand{Name: p.next().text}
+	if cmd.Name == "" {
+		return nil, errors.New("syntax error: expected a replication slot name")
+	}
+	if p.peekKeyword("TEMPORARY") {
+		p.next()
+		cmd.Temporary = true
+	}
+	switch kind := strings.ToUpper(p.next().text); kind {
+	case "PHYSICAL":
+		cmd.Physical = true
+	case "LOGICAL":
+		cmd.Plugin = p.next().text
+		if cmd.Plugin == "" {
+			return nil, errors.New("syntax error: expected an output plugin")
+		}
+	default:
+		return nil, errors.New("syntax error: expected LOGICAL or PHYSICAL")
+	}
+	// The remaining options control snapshots and two-phase decoding, which do not apply to this server
+	for !p.done() {
+		p.next()
+	}
+	return cmd, nil
+}
+
+// startReplication parses the remainder of a START_REPLICATION command.
+func (p *commandParser) startReplication() (Command, error) {
+	cmd := StartReplicationCommand{Options: make(map[string]string)}
+	if p.peekKeyword("SLOT") {
+		p.next()
+		cmd.Slot = p.next().text
+	}
+	switch strings.ToUpper(p.next().text) {
+	case "PHYSICAL":
+		cmd.Physical = true
+	case "LOGICAL":
+	default:
+		return nil, errors.New("syntax error: expected LOGICAL or PHYSICAL")
+	}
+	lsn, err := pglogrepl.ParseLSN(p.next().text)
+	if err != nil {
+		return nil, errors.Errorf("syntax error: invalid LSN: %v", err)
+	}
+	cmd.LSN = lsn
+	if p.peekKeyword("TIMELINE") {
+		p.next()
+		p.next()
+	}
+	if !p.done() {
+		if p.next().text != "(" {
+			return nil, errors.New("syntax error: expected an option list")
+		}
+		for {
+			name := p.next()
+			if name.text == "" {
+				return nil, errors.New("syntax error: unterminated option list")
+			}
+			value := ""
+			if tok := p.peek(); tok.text != "," && tok.text != ")" || tok.quoted {
+				value = p.next().text
+			}
+			cmd.Options[strings.ToLower(name.text)] = value
+			if sep := p.next(); sep.text == ")" && !sep.quoted {
+				break
+			} else if sep.text != "," || sep.quoted {
+				return nil, errors.New("syntax error: expected a comma in the option list")
+			}
+		}
+	}
+	return cmd, p.end()
+}
+
+// ParsePublicationNames parses the publication_names option of the pgoutput plugin, which is a comma-separated list
+// of publication names that may be double-quoted.
+func ParsePublicationNames(value string) ([]string, error) {
+	tokens, err := tokenizeCommand("names " + value)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	expectName := true
+	for _, tok := range tokens[1:] {
+		if tok.text == "," && !tok.quoted {
+			if expectName {
+				return nil, errors.New("invalid publication_names syntax")
+			}
+			expectName = true
+			continue
+		}
+		if !expectName || tok.text == "" {
+			return nil, errors.New("invalid publication_names syntax")
+		}
+		names = append(names, tok.text)
+		expectName = false
+	}
+	if expectName {
+		return nil, errors.New("invalid publication_names syntax")
+	}
+	return names, nil
+}
+
+// commandToken is a single token of a replication command.
+type commandToken struct {
+	text string
+	// quoted is whether the token was a quoted identifier or string, which are never keywords or punctuation.
+	quoted bool
+}
+
+// commandParser reads the tokens of a replication command.
+type commandParser struct {
+	tokens []commandToken
+	idx    int
+}
+
+// next returns the next token, or an empty token when there are no tokens left.
+func (p *commandParser) next() commandToken {
+	tok := p.peek()
+	if p.idx < len(p.tokens) {
+		p.idx++
+	}
+	return tok
+}
+
+// peek returns the next token without consuming it.
+func (p *commandParser) peek() commandToken {
+	if p.idx >= len(p.tokens) {
+		return commandToken{}
+	}
+	return p.tokens[p.idx]
+}
+
+// peekKeyword returns whether the next token is the given keyword.
+func (p *commandParser) peekKeyword(keyword string) bool {
+	tok := p.peek()
+	return !tok.quoted && strings.EqualFold(tok.text, keyword)
+}
+
+// done returns whether every token has been consumed.
+func (p *commandParser) done() bool {
+	return p.idx >= len(p.tokens)
+}
+
+// end returns an error if there are tokens that have not been consumed.
+func (p *commandParser) end() error {
+	if !p.done() {
+		return errors.Errorf(`syntax error at or near "%s"`, p.peek().text)
+	}
+	return nil
+}
+
+// tokenizeCommand splits a replication command into its tokens. Unquoted identifiers are folded to lower case, while
+// keywords are matched case-insensitively by the parser.
+func tokenizeCommand(query string) ([]commandToken, error) {
+	var tokens []commandToken
+	query = strings.TrimSpace(query)
+	query = strings.TrimSpace(strings.TrimSuffix(query, ";"))
+	for i := 0; i < len(query); {
+		c := query[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '(' || c == ')' || c == ',':
+			tokens = append(tokens, commandToken{text: string(c)})
+			i++
+		case c == '"' || c == '\'':
+			var sb strings.Builder
+			i++
+			for {
+				if i >= len(query) {
+					return nil, errors.New("unterminated quoted string")
+				}
+				if query[i] == c {
+					if i+1 < len(query) && query[i+1] == c {
+						sb.WriteByte(c)
+						i += 2
+						continue
+					}
+					i++
+					break
+				}
+				sb.WriteByte(query[i])
+				i++
+			}
+			tokens = append(tokens, commandToken{text: sb.String(), quoted: true})
+		default:
+			start := i
+			for i < len(query) && !strings.ContainsRune(" \t\n\r(),\"'", rune(query[i])) {
+				i++
+			}
+			word := query[start:i]
+			if len(tokens) > 0 {
+				// Only the command name keeps its case, so that it may be reported in errors
+				word = strings.ToLower(word)
+			}
+			tokens = append(tokens, commandToken{text: word})
+		}
+	}
+	return tokens, nil
+}
@@ -0,0 +1,211 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package walsender
+
+import (
+	"encoding/binary"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/jackc/pglogrepl"
+)
+
+// The messages in this file are described in https://www.postgresql.org/docs/15/protocol-logicalrep-message-formats.html
+// and https://www.postgresql.org/docs/15/protocol-replication.html
+
+// pgEpoch is the epoch of the timestamps in the replication protocol.
+var pgEpoch = time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// pgTime returns the given time as microseconds since pgEpoch.
+func pgTime(t time.Time) int64 {
+	return t.Sub(pgEpoch).Microseconds()
+}
+
+// RelationColumn is a column of a relation, as described by a pgoutput Relation message.
+type RelationColumn struct {
+	Name    string
+	TypeOID uint32
+	TypMod  int32
+	// Key is whether the column is part of the relation's replica identity.
+	Key bool
+}
+
+// Relation describes a published table to the consumer, which must be sent before the first change to the table, and
+// again whenever the table's columns change.
+type Relation struct {
+	ID        uint32
+	Namespace string
+	Name      string
+	// ReplicaIdentity is 'd' for tables that are identified by their primary key, and 'f' for keyless tables, whose
+	// rows are identified by every column.
+	ReplicaIdentity byte
+	Columns         []RelationColumn
+}
+
+// equals returns whether the relations describe the same table with the same columns.
+func (r *Relation) equals(other *Relation) bool {
+	if r.ID != other.ID || r.Namespace != other.Namespace || r.Name != other.Name ||
+		r.ReplicaIdentity != other.ReplicaIdentity || len(r.Columns) != len(other.Columns) {
+		return false
+	}
+	for i := range r.Columns {
+		if r.Columns[i] != other.Columns[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// encodeBegin returns a pgoutput Begin message.
+func encodeBegin(finalLSN pglogrepl.LSN, commitTime time.Time, xid uint32) []byte {
+	buf := []byte{byte(pglogrepl.MessageTypeBegin)}
+	buf = binary.BigEndian.AppendUint64(buf, uint64(finalLSN))
+	buf = binary.BigEndian.AppendUint64(buf, uint64(pgTime(commitTime)))
+	return binary.BigEndian.AppendUint32(buf, xid)
+}
+
+// encodeCommit returns a pgoutput Commit message.
+func encodeCommit(commitLSN pglogrepl.LSN, endLSN pglogrepl.LSN, commitTime time.Time) []byte {
+	buf := []byte{byte(pglogrepl.MessageTypeCommit), 0}
+	buf = binary.BigEndian.AppendUint64(buf, uint64(commitLSN))
+	buf = binary.BigEndian.AppendUint64(buf, uint64(endLSN))
+	return binary.BigEndian.AppendUint64(buf, uint64(pgTime(commitTime)))
+}
+
+// encodeRelation returns a pgoutput Relation message.
+func encodeRelation(rel *Relation) []byte {
+	buf := []byte{byte(pglogrepl.MessageTypeRelation)}
+	buf = binary.BigEndian.AppendUint32(buf, rel.ID)
+	buf = appendString(buf, rel.Namespace)
+	buf = appendString(buf, rel.Name)
+	buf = append(buf, rel.ReplicaIdentity)
+	buf = binary.BigEndian.AppendUint16(buf, uint16(len(rel.Columns)))
+	for _, col := range rel.Columns {
+		var flags byte
+		if col.Key {
+			flags = 1
+		}
+		buf = append(buf, flags)
+		buf = appendString(buf, col.Name)
+		buf = binary.BigEndian.AppendUint32(buf, col.TypeOID)
+		buf = binary.BigEndian.AppendUint32(buf, uint32(col.TypMod))
+	}
+	return buf
+}
+
+// encodeInsert returns a pgoutput Insert message.
+func encodeInsert(relID uint32, newTuple [][]byte) []byte {
+	buf := []byte{byte(pglogrepl.MessageTypeInsert)}
+	buf = binary.BigEndian.AppendUint32(buf, relID)
+	buf = append(buf, 'N')
+	return appendTuple(buf, newTuple)
+}
+
+// encodeUpdate returns a pgoutput Update message. The old tuple is omitted when it is nil, and is otherwise marked as
+// either the old key ('K') or the entire old row ('O').
+func encodeUpdate(relID uint32, oldKind byte, oldTuple [][]byte, newTuple [][]byte) []byte {
+	buf := []byte{byte(pglogrepl.MessageTypeUpdate)}
+	buf = binary.BigEndian.AppendUint32(buf, relID)
+	if oldTuple != nil {
+		buf = append(buf, oldKind)
+		buf = appendTuple(buf, oldTuple)
+	}
+	buf = append(buf, 'N')
+	return appendTuple(buf, newTuple)
+}
+
+// encodeDelete returns a pgoutput Delete message, whose old tuple is either the old key ('K') or the entire old row
+// ('O').
+func encodeDelete(relID uint32, oldKind byte, oldTuple [][]byte) []byte {
+	buf := []byte{byte(pglogrepl.MessageTypeDelete)}
+	buf = binary.BigEndian.AppendUint32(buf, relID)
+	buf = append(buf, oldKind)
+	return appendTuple(buf, oldTuple)
+}
+
+// appendTuple appends the pgoutput TupleData of the given text-formatted values, where nil values are NULL.
+func appendTuple(buf []byte, values [][]byte) []byte {
+	buf = binary.BigEndian.AppendUint16(buf, uint16(len(values)))
+	for _, value := range values {
+		if value == nil {
+			buf = append(buf, 'n')
+			continue
+		}
+		buf = append(buf, 't')
+		buf = binary.BigEndian.AppendUint32(buf, uint32(len(value)))
+		buf = append(buf, value...)
+	}
+	return buf
+}
+
+// appendString appends a null-terminated string.
+func appendString(buf []byte, s string) []byte {
+	buf = append(buf, s...)
+	return append(buf, 0)
+}
+
+// EncodeXLogData wraps a pgoutput message in an XLogData message, which is sent to the consumer in a CopyData message.
+func EncodeXLogData(walStart pglogrepl.LSN, walEnd pglogrepl.LSN, now time.Time, data []byte) []byte {
+	buf := make([]byte, 0, 25+len(data))
+	buf = append(buf, pglogrepl.XLogDataByteID)
+	buf = binary.BigEndian.AppendUint64(buf, uint64(walStart))
+	buf = binary.BigEndian.AppendUint64(buf, uint64(walEnd))
+	buf = binary.BigEndian.AppendUint64(buf, uint64(pgTime(now)))
+	return append(buf, data...)
+}
+
+// EncodeKeepalive returns a primary keepalive message, which is sent to the consumer in a CopyData message. When
+// replyRequested is true, the consumer should respond with a standby status update immediately.
+func EncodeKeepalive(walEnd pglogrepl.LSN, now time.Time, replyRequested bool) []byte {
+	buf := []byte{pglogrepl.PrimaryKeepaliveMessageByteID}
+	buf = binary.BigEndian.AppendUint64(buf, uint64(walEnd))
+	buf = binary.BigEndian.AppendUint64(buf, uint64(pgTime(now)))
+	if replyRequested {
+		return append(buf, 1)
+	}
+	return append(buf, 0)
+}
+
+// StandbyStatusUpdate is the progress reported by the consumer of a replication stream.
+type StandbyStatusUpdate struct {
+	WritePosition  pglogrepl.LSN
+	FlushPosition  pglogrepl.LSN
+	ApplyPosition  pglogrepl.LSN
+	ReplyRequested bool
+}
+
+// ParseStandbyMessage parses the contents of a CopyData message sent by the consumer. Standby status updates are
+// returned, while hot standby feedback messages (which only apply to physical replication) return false.
+func ParseStandbyMessage(data []byte) (StandbyStatusUpdate, bool, error) {
+	if len(data) == 0 {
+		return StandbyStatusUpdate{}, false, errors.New("unexpected empty message from the replication consumer")
+	}
+	switch data[0] {
+	case pglogrepl.StandbyStatusUpdateByteID:
+		if len(data) < 34 {
+			return StandbyStatusUpdate{}, false, errors.New("invalid standby status update message")
+		}
+		return StandbyStatusUpdate{
+			WritePosition:  pglogrepl.LSN(binary.BigEndian.Uint64(data[1:])),
+			FlushPosition:  pglogrepl.LSN(binary.BigEndian.Uint64(data[9:])),
+			ApplyPosition:  pglogrepl.LSN(binary.BigEndian.Uint64(data[17:])),
+			ReplyRequested: data[33] != 0,
+		}, true, nil
+	case 'h':
+		return StandbyStatusUpdate{}, false, nil
+	default:
+		return StandbyStatusUpdate{}, false, errors.Errorf("unexpected message type \"%c\" from the replication consumer", data[0])
+	}
+}
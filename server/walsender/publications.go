@@ -0,0 +1,433 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package walsender
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/jackc/pglogrepl"
+
+	"github.com/dolthub/doltgresql/utils"
+)
+
+// PublishedTable is a table that was explicitly added to a publication.
+type PublishedTable struct {
+	Schema string `json:"schema"`
+	Name   string `json:"name"`
+}
+
+// Publication is a set of tables, created by CREATE PUBLICATION, whose changes are streamed to the subscribers of
+// this server. Publications belong to a single database.
+type Publication struct {
+	// ID identifies the publication for its entire lifetime, so that renaming the publication does not change its OID.
+	ID       uint64 `json:"id"`
+	Name     string `json:"name"`
+	Owner    string `json:"owner"`
+	Database string `json:"database"`
+	// AllTables is whether every table in the database is published, including tables that are created later.
+	AllTables bool             `json:"all_tables"`
+	Tables    []PublishedTable `json:"tables"`
+	// Schemas are the schemas whose tables are all published, including tables that are created later.
+	Schemas  []string `json:"schemas"`
+	Insert   bool     `json:"insert"`
+	Update   bool     `json:"update"`
+	Delete   bool     `json:"delete"`
+	Truncate bool     `json:"truncate"`
+}
+
+// PublishesTable returns whether the publication includes the given table.
+func (pub Publication) PublishesTable(schema string, name string) bool {
+	if pub.AllTables {
+		return true
+	}
+	for _, pubSchema := range pub.Schemas {
+		if pubSchema == schema {
+			return true
+		}
+	}
+	for _, table := range pub.Tables {
+		if table.Schema == schema && table.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// ReplicationSlot is a logical replication slot created on this server, which records how far its consumer has
+// confirmed the stream of changes. As changes are derived from Dolt commits, the slot's position is the commit whose
+// changes (and those of its ancestors) have been confirmed, along with the LSN that the commit was streamed at.
+type ReplicationSlot struct {
+	Name     string `json:"name"`
+	Database string `json:"database"`
+	Plugin   string `json:"plugin"`
+	// Temporary slots are not persisted, and are dropped when the connection that created them ends.
+	Temporary bool `json:"-"`
+	// ConfirmedLSN is the LSN that the consumer has confirmed.
+	ConfirmedLSN pglogrepl.LSN `json:"confirmed_lsn"`
+	// ConfirmedCommit is the hash of the commit at ConfirmedLSN.
+	ConfirmedCommit string `json:"confirmed_commit"`
+	// ActivePID is the process ID of the connection that is streaming from the slot, which is zero when the slot is
+	// not in use.
+	ActivePID int32 `json:"-"`
+}
+
+// publicationsFile is the persisted form of every publication and permanent replication slot. Publications are keyed
+// by the name of their database, without any revision. They are server state rather than versioned data, so they are
+// not branch-aware: every branch of a database shares the same publications, and they are unaffected by checkouts,
+// merges, and resets.
+type publicationsFile struct {
+	NextID       uint64                    `json:"next_id"`
+	Publications map[string][]*Publication `json:"publications"`
+	Slots        []*ReplicationSlot        `json:"slots"`
+}
+
+// publicationManager owns every publication and replication slot.
+type publicationManager struct {
+	dir  string
+	file publicationsFile
+	mu   sync.Mutex
+}
+
+// publications is the manager initialized by InitPublications.
+var publications *publicationManager
+
+// InitPublications loads the publications and replication slots that were persisted in the given directory. This must
+// be called once the server has started, before any publication statements or replication connections are handled.
+func InitPublications(dir string) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	m := &publicationManager{
+		dir:  dir,
+		file: publicationsFile{NextID: 1, Publications: make(map[string][]*Publication)},
+	}
+	contents, err := os.ReadFile(m.filePath())
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	} else if err == nil {
+		if err = json.Unmarshal(contents, &m.file); err != nil {
+			return err
+		}
+		if m.file.Publications == nil {
+			m.file.Publications = make(map[string][]*Publication)
+		}
+	}
+	publications = m
+	return nil
+}
+
+// Publications returns every publication of the given database, sorted by name.
+func Publications(database string) []Publication {
+	m := publications
+	if m == nil {
+		return nil
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var pubs []Publication
+	for _, pub := range m.file.Publications[publicationDatabase(database)] {
+		pubs = append(pubs, *pub)
+	}
+	sort.Slice(pubs, func(i, j int) bool {
+		return pubs[i].Name < pubs[j].Name
+	})
+	return pubs
+}
+
+// GetPublication returns the publication with the given name in the given database, and whether it exists.
+func GetPublication(database string, name string) (Publication, bool) {
+	m := publications
+	if m == nil {
+		return Publication{}, false
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if pub := m.findPublication(database, name); pub != nil {
+		return *pub, true
+	}
+	return Publication{}, false
+}
+
+// CreatePublication creates the given publication, assigning its ID.
+func CreatePublication(pub Publication) error {
+	m, err := manager()
+	if err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	pub.Database = publicationDatabase(pub.Database)
+	if m.findPublication(pub.Database, pub.Name) != nil {
+		return errors.Errorf(`publication "%s" already exists`, pub.Name)
+	}
+	pub.ID = m.file.NextID
+	m.file.NextID++
+	m.file.Publications[pub.Database] = append(m.file.Publications[pub.Database], &pub)
+	return m.persist()
+}
+
+// AlterPublication runs the given function against the named publication, and persists the publications afterward.
+// The changes are discarded if the function returns an error.
+func AlterPublication(database string, name string, alter func(pub *Publication) error) error {
+	m, err := manager()
+	if err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	pub := m.findPublication(database, name)
+	if pub == nil {
+		return errors.Errorf(`publication "%s" does not exist`, name)
+	}
+	original := *pub
+	if err = alter(pub); err != nil {
+		*pub = original
+		return err
+	}
+	if pub.Name != original.Name {
+		for _, existing := range m.file.Publications[pub.Database] {
+			if existing != pub && existing.Name == pub.Name {
+				*pub = original
+				return errors.Errorf(`publication "%s" already exists`, existing.Name)
+			}
+		}
+	}
+	return m.persist()
+}
+
+// DropPublication removes the publication with the given name from the given database.
+func DropPublication(database string, name string) error {
+	m, err := manager()
+	if err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	database = publicationDatabase(database)
+	pubs := m.file.Publications[database]
+	for i, pub := range pubs {
+		if pub.Name == name {
+			if pubs = append(pubs[:i], pubs[i+1:]...); len(pubs) > 0 {
+				m.file.Publications[database] = pubs
+			} else {
+				delete(m.file.Publications, database)
+			}
+			return m.persist()
+		}
+	}
+	return errors.Errorf(`publication "%s" does not exist`, name)
+}
+
+// ReplicationSlots returns every replication slot, sorted by name.
+func ReplicationSlots() []ReplicationSlot {
+	m := publications
+	if m == nil {
+		return nil
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	slots := make([]ReplicationSlot, len(m.file.Slots))
+	for i, slot := range m.file.Slots {
+		slots[i] = *slot
+	}
+	sort.Slice(slots, func(i, j int) bool {
+		return slots[i].Name < slots[j].Name
+	})
+	return slots
+}
+
+// GetReplicationSlot returns the replication slot with the given name, and whether it exists.
+func GetReplicationSlot(name string) (ReplicationSlot, bool) {
+	m := publications
+	if m == nil {
+		return ReplicationSlot{}, false
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if slot := m.findSlot(name); slot != nil {
+		return *slot, true
+	}
+	return ReplicationSlot{}, false
+}
+
+// CreateReplicationSlot creates the given replication slot.
+func CreateReplicationSlot(slot ReplicationSlot) error {
+	m, err := manager()
+	if err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.findSlot(slot.Name) != nil {
+		return errors.Errorf(`replication slot "%s" already exists`, slot.Name)
+	}
+	m.file.Slots = append(m.file.Slots, &slot)
+	return m.persist()
+}
+
+// DropReplicationSlot removes the replication slot with the given name, which may not be in use.
+func DropReplicationSlot(name string) error {
+	m, err := manager()
+	if err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i, slot := range m.file.Slots {
+		if slot.Name == name {
+			if slot.ActivePID != 0 {
+				return errors.Errorf(`replication slot "%s" is active for PID %d`, name, slot.ActivePID)
+			}
+			m.file.Slots = append(m.file.Slots[:i], m.file.Slots[i+1:]...)
+			return m.persist()
+		}
+	}
+	return errors.Errorf(`replication slot "%s" does not exist`, name)
+}
+
+// AcquireReplicationSlot marks the replication slot as in use by the given process, returning an error if it is
+// already in use. The slot must be released with ReleaseReplicationSlot once streaming ends.
+func AcquireReplicationSlot(name string, pid int32) (ReplicationSlot, error) {
+	m, err := manager()
+	if err != nil {
+		return ReplicationSlot{}, err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	slot := m.findSlot(name)
+	if slot == nil {
+		return ReplicationSlot{}, errors.Errorf(`replication slot "%s" does not exist`, name)
+	}
+	if slot.ActivePID != 0 {
+		return ReplicationSlot{}, errors.Errorf(`replication slot "%s" is active for PID %d`, name, slot.ActivePID)
+	}
+	slot.ActivePID = pid
+	return *slot, nil
+}
+
+// ReleaseReplicationSlot marks the replication slot as no longer in use.
+func ReleaseReplicationSlot(name string) {
+	m := publications
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if slot := m.findSlot(name); slot != nil {
+		slot.ActivePID = 0
+	}
+}
+
+// AdvanceReplicationSlot records that the consumer of the replication slot has confirmed the given commit, which was
+// streamed at the given LSN.
+func AdvanceReplicationSlot(name string, lsn pglogrepl.LSN, commit string) error {
+	m, err := manager()
+	if err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	slot := m.findSlot(name)
+	if slot == nil {
+		return errors.Errorf(`replication slot "%s" does not exist`, name)
+	}
+	if lsn < slot.ConfirmedLSN {
+		return nil
+	}
+	slot.ConfirmedLSN = lsn
+	slot.ConfirmedCommit = commit
+	if slot.Temporary {
+		return nil
+	}
+	return m.persist()
+}
+
+// manager returns the publication manager, or an error if publications have not been initialized.
+func manager() (*publicationManager, error) {
+	if publications == nil {
+		return nil, errors.New("publications are not available on this server")
+	}
+	return publications, nil
+}
+
+// findPublication returns the publication with the given name in the given database, or nil if it does not exist.
+func (m *publicationManager) findPublication(database string, name string) *Publication {
+	for _, pub := range m.file.Publications[publicationDatabase(database)] {
+		if pub.Name == name {
+			return pub
+		}
+	}
+	return nil
+}
+
+// findSlot returns the replication slot with the given name, or nil if it does not exist.
+func (m *publicationManager) findSlot(name string) *ReplicationSlot {
+	for _, slot := range m.file.Slots {
+		if slot.Name == name {
+			return slot
+		}
+	}
+	return nil
+}
+
+// filePath returns the path of the file that the publications are persisted to.
+func (m *publicationManager) filePath() string {
+	return filepath.Join(m.dir, "publications.json")
+}
+
+// persist writes every publication and permanent replication slot to disk.
+func (m *publicationManager) persist() error {
+	file := publicationsFile{
+		NextID:       m.file.NextID,
+		Publications: m.file.Publications,
+	}
+	for _, slot := range m.file.Slots {
+		if !slot.Temporary {
+			file.Slots = append(file.Slots, slot)
+		}
+	}
+	contents, err := json.Marshal(&file)
+	if err != nil {
+		return err
+	}
+	return utils.WriteFileAtomic(m.filePath(), contents, 0644)
+}
+
+// publicationDatabase returns the name that publications of the given database are keyed by, which removes any
+// revision from the name.
+func publicationDatabase(database string) string {
+	baseName, _ := doltdb.SplitRevisionDbName(database)
+	return baseName
+}
+
+// ValidateSlotName returns an error if the name cannot be used for a replication slot, which only allows lower case
+// letters, numbers, and the underscore character.
+func ValidateSlotName(name string) error {
+	if len(name) > 63 {
+		return errors.Errorf(`replication slot name "%s" is too long`, name)
+	}
+	for _, r := range name {
+		if !(r >= 'a' && r <= 'z') && !(r >= '0' && r <= '9') && r != '_' {
+			return errors.Errorf(`replication slot name "%s" contains invalid character`, name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,425 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package walsender
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/jackc/pglogrepl"
+	"github.com/jackc/pgx/v5/pgproto3"
+
+	"github.com/dolthub/doltgresql/core/id"
+)
+
+// InitialSlotLSN is the position of a newly created replication slot. Each slot numbers the commits that it streams
+// starting from this position.
+const InitialSlotLSN pglogrepl.LSN = 0x1000000
+
+// QueryResult is the result of a query run by a QueryRunner, with every value in its text format. NULL values are nil.
+type QueryResult struct {
+	Fields []pgproto3.FieldDescription
+	Rows   [][][]byte
+}
+
+// QueryRunner runs a query against the database that is being streamed, using a new transaction for each query so
+// that new commits are visible.
+type QueryRunner func(query string) (QueryResult, error)
+
+// streamedCommit is a commit that has been streamed, but not yet confirmed by the consumer.
+type streamedCommit struct {
+	endLSN pglogrepl.LSN
+	commit string
+}
+
+// Stream produces the pgoutput messages for the changes made to the published tables by the commits on the current
+// branch, starting after the commit that the replication slot has confirmed. Each commit becomes a single transaction,
+// whose changes are the diff between the commit and its first parent. Commits are numbered deterministically starting
+// from the slot's confirmed position, so that a commit is always streamed at the same LSN for a given slot.
+type Stream struct {
+	slot         ReplicationSlot
+	publications []string
+	run          QueryRunner
+	send         func(walStart pglogrepl.LSN, walEnd pglogrepl.LSN, data []byte) error
+	// startLSN is the position requested by the consumer, which has already received every commit ending at or before
+	// this position.
+	startLSN pglogrepl.LSN
+	// position is the end of the last commit that was streamed.
+	position pglogrepl.LSN
+	// commit is the last commit that was streamed.
+	commit    string
+	relations map[uint32]*Relation
+	pending   []streamedCommit
+}
+
+// NewStream returns a stream for the given slot, which must have been acquired by the caller. The publications must
+// belong to the slot's database. Messages are sent using the given function.
+func NewStream(slot ReplicationSlot, startLSN pglogrepl.LSN, publications []string, run QueryRunner,
+	send func(walStart pglogrepl.LSN, walEnd pglogrepl.LSN, data []byte) error) (*Stream, error) {
+	for _, name := range publications {
+		if _, ok := GetPublication(slot.Database, name); !ok {
+			return nil, errors.Errorf(`publication "%s" does not exist`, name)
+		}
+	}
+	return &Stream{
+		slot:         slot,
+		publications: publications,
+		run:          run,
+		send:         send,
+		startLSN:     startLSN,
+		position:     slot.ConfirmedLSN,
+		commit:       slot.ConfirmedCommit,
+		relations:    make(map[uint32]*Relation),
+	}, nil
+}
+
+// Position returns the end of the last commit that was streamed.
+func (s *Stream) Position() pglogrepl.LSN {
+	return s.position
+}
+
+// Poll streams every commit that has been made on the branch since the last commit that was streamed.
+func (s *Stream) Poll() error {
+	result, err := s.run("SELECT dolt_hashof('HEAD');")
+	if err != nil {
+		return err
+	}
+	head := firstValue(result)
+	if head == "" || head == s.commit {
+		return nil
+	}
+	commits, err := s.commitsSince(head)
+	if err != nil {
+		return err
+	}
+	for _, commit := range commits {
+		if err = s.streamCommit(s.commit, commit); err != nil {
+			return err
+		}
+	}
+	if s.startLSN > s.slot.ConfirmedLSN {
+		return s.Confirm(s.startLSN)
+	}
+	return nil
+}
+
+// Confirm records that the consumer has flushed every commit ending at or before the given position, advancing the
+// replication slot.
+func (s *Stream) Confirm(flushLSN pglogrepl.LSN) error {
+	confirmed := -1
+	for i, streamed := range s.pending {
+		if streamed.endLSN > flushLSN {
+			break
+		}
+		confirmed = i
+	}
+	if confirmed < 0 {
+		return nil
+	}
+	streamed := s.pending[confirmed]
+	s.pending = s.pending[confirmed+1:]
+	if err := AdvanceReplicationSlot(s.slot.Name, streamed.endLSN, streamed.commit); err != nil {
+		return err
+	}
+	s.slot.ConfirmedLSN, s.slot.ConfirmedCommit = streamed.endLSN, streamed.commit
+	return nil
+}
+
+// commitsSince returns the commits between the last streamed commit (exclusive) and the given commit (inclusive),
+// following the first parent of each commit, ordered from oldest to newest.
+func (s *Stream) commitsSince(head string) ([]string, error) {
+	var commits []string
+	for commit := head; commit != s.commit; {
+		commits = append(commits, commit)
+		result, err := s.run(fmt.Sprintf("SELECT parent_hash FROM dolt_commit_ancestors WHERE commit_hash = '%s' AND parent_index = 0;", commit))
+		if err != nil {
+			return nil, err
+		}
+		commit = firstValue(result)
+		if commit == "" {
+			return nil, errors.Errorf(`replication slot "%s" is at commit %s, which is not an ancestor of HEAD`,
+				s.slot.Name, s.slot.ConfirmedCommit)
+		}
+	}
+	for i, j := 0, len(commits)-1; i < j; i, j = i+1, j-1 {
+		commits[i], commits[j] = commits[j], commits[i]
+	}
+	return commits, nil
+}
+
+// streamCommit sends the changes between the two commits as a single transaction. Commits that do not change any
+// published tables are not sent, and do not advance the stream's position.
+func (s *Stream) streamCommit(from string, to string) error {
+	commitTime, err := s.commitTime(to)
+	if err != nil {
+		return err
+	}
+	summary, err := s.run(fmt.Sprintf("SELECT to_table_name, data_change FROM dolt_diff_summary('%s', '%s');", from, to))
+	if err != nil {
+		return err
+	}
+	// Each change is preceded by the relations that have yet to be described to the consumer
+	type change struct {
+		relations [][]byte
+		data      []byte
+	}
+	var changes []change
+	var described []*Relation
+	for _, row := range summary.Rows {
+		if len(row[0]) == 0 || string(row[1]) != "t" {
+			continue
+		}
+		schemaName, tableName := splitTableName(string(row[0]))
+		publishInsert, publishUpdate, publishDelete := s.publishedActions(schemaName, tableName)
+		if !publishInsert && !publishUpdate && !publishDelete {
+			continue
+		}
+		rel, tableChanges, err := s.tableChanges(from, to, schemaName, tableName, publishInsert, publishUpdate, publishDelete)
+		if err != nil {
+			return err
+		}
+		if len(tableChanges) == 0 {
+			continue
+		}
+		var relations [][]byte
+		if existing, ok := s.relations[rel.ID]; !ok || !existing.equals(rel) {
+			relations = append(relations, encodeRelation(rel))
+			described = append(described, rel)
+		}
+		for i, data := range tableChanges {
+			if i == 0 {
+				changes = append(changes, change{relations: relations, data: data})
+			} else {
+				changes = append(changes, change{data: data})
+			}
+		}
+	}
+	if len(changes) == 0 {
+		s.pending = append(s.pending, streamedCommit{endLSN: s.position, commit: to})
+		s.commit = to
+		return nil
+	}
+
+	// Relation messages share the position of the change that follows them, so that the positions of a commit do not
+	// depend on which relations the consumer has already been sent
+	beginLSN := s.position + 1
+	commitLSN := beginLSN + pglogrepl.LSN(len(changes))
+	endLSN := commitLSN + 1
+	if endLSN > s.startLSN {
+		if err = s.send(beginLSN, endLSN, encodeBegin(commitLSN, commitTime, commitXid(to))); err != nil {
+			return err
+		}
+		for i, c := range changes {
+			changeLSN := beginLSN + pglogrepl.LSN(i)
+			for _, relation := range c.relations {
+				if err = s.send(changeLSN, endLSN, relation); err != nil {
+					return err
+				}
+			}
+			if err = s.send(changeLSN, endLSN, c.data); err != nil {
+				return err
+			}
+		}
+		if err = s.send(commitLSN, endLSN, encodeCommit(commitLSN, endLSN, commitTime)); err != nil {
+			return err
+		}
+		for _, rel := range described {
+			s.relations[rel.ID] = rel
+		}
+	}
+	s.pending = append(s.pending, streamedCommit{endLSN: endLSN, commit: to})
+	s.position = endLSN
+	s.commit = to
+	return nil
+}
+
+// publishedActions returns which changes to the table are published by the stream's publications.
+func (s *Stream) publishedActions(schemaName string, tableName string) (insert bool, update bool, del bool) {
+	for _, name := range s.publications {
+		pub, ok := GetPublication(s.slot.Database, name)
+		if !ok || !pub.PublishesTable(schemaName, tableName) {
+			continue
+		}
+		insert = insert || pub.Insert
+		update = update || pub.Update
+		del = del || pub.Delete
+	}
+	return insert, update, del
+}
+
+// tableChanges returns the relation of the table as of the newer commit, along with the Insert, Update, and Delete
+// messages for the rows that changed between the two commits. Deletes are ordered before updates, which are ordered
+// before inserts, so that a row whose key changed does not conflict with itself.
+func (s *Stream) tableChanges(from string, to string, schemaName string, tableName string,
+	publishInsert bool, publishUpdate bool, publishDelete bool) (*Relation, [][]byte, error) {
+	qualifiedName := quoteLiteral(schemaName + "." + tableName)
+	diff, err := s.run(fmt.Sprintf("SELECT * FROM dolt_diff('%s', '%s', '%s');", from, to, qualifiedName))
+	if err != nil {
+		return nil, nil, err
+	}
+	keyColumns, err := s.primaryKeyColumns(schemaName, tableName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The diff contains the columns of the newer commit, the to_commit and to_commit_date columns, the columns of the
+	// older commit, the from_commit and from_commit_date columns, and finally the diff_type column
+	toCount := commitColumnIndex(diff.Fields, 0, "to_")
+	fromStart := toCount + 2
+	fromCount := commitColumnIndex(diff.Fields, fromStart, "from_") - fromStart
+	diffTypeIdx := len(diff.Fields) - 1
+	if toCount < 0 || fromCount < 0 || string(diff.Fields[diffTypeIdx].Name) != "diff_type" {
+		return nil, nil, errors.Errorf(`unexpected diff columns for table "%s"`, tableName)
+	}
+
+	rel := &Relation{
+		ID:              id.Cache().ToOID(id.NewTable(schemaName, tableName).AsId()),
+		Namespace:       schemaName,
+		Name:            tableName,
+		ReplicaIdentity: 'd',
+		Columns:         make([]RelationColumn, toCount),
+	}
+	if len(keyColumns) == 0 {
+		rel.ReplicaIdentity = 'f'
+	}
+	for i := 0; i < toCount; i++ {
+		_, isKey := keyColumns[i+1]
+		rel.Columns[i] = RelationColumn{
+			Name:    strings.TrimPrefix(string(diff.Fields[i].Name), "to_"),
+			TypeOID: diff.Fields[i].DataTypeOID,
+			TypMod:  diff.Fields[i].TypeModifier,
+			Key:     isKey || len(keyColumns) == 0,
+		}
+	}
+	// The older row's values are matched to the relation's columns by name, since the columns may have changed
+	fromColumns := make(map[string]int, fromCount)
+	for i := 0; i < fromCount; i++ {
+		fromColumns[strings.TrimPrefix(string(diff.Fields[fromStart+i].Name), "from_")] = fromStart + i
+	}
+	oldTuple := func(row [][]byte) [][]byte {
+		tuple := make([][]byte, len(rel.Columns))
+		for i, col := range rel.Columns {
+			if idx, ok := fromColumns[col.Name]; ok && col.Key {
+				tuple[i] = row[idx]
+			}
+		}
+		return tuple
+	}
+	oldKind := byte('K')
+	if rel.ReplicaIdentity == 'f' {
+		oldKind = 'O'
+	}
+
+	var deletes, updates, inserts [][]byte
+	for _, row := range diff.Rows {
+		switch string(row[diffTypeIdx]) {
+		case "added":
+			if publishInsert {
+				inserts = append(inserts, encodeInsert(rel.ID, row[:toCount]))
+			}
+		case "modified":
+			if publishUpdate {
+				var old [][]byte
+				if rel.ReplicaIdentity == 'f' {
+					old = oldTuple(row)
+				}
+				updates = append(updates, encodeUpdate(rel.ID, oldKind, old, row[:toCount]))
+			}
+		case "removed":
+			if publishDelete {
+				deletes = append(deletes, encodeDelete(rel.ID, oldKind, oldTuple(row)))
+			}
+		}
+	}
+	return rel, append(append(deletes, updates...), inserts...), nil
+}
+
+// primaryKeyColumns returns the attribute numbers of the table's primary key columns.
+func (s *Stream) primaryKeyColumns(schemaName string, tableName string) (map[int]struct{}, error) {
+	result, err := s.run(fmt.Sprintf(`SELECT unnest(c.conkey) FROM pg_catalog.pg_constraint c
+JOIN pg_catalog.pg_class t ON c.conrelid = t.oid
+JOIN pg_catalog.pg_namespace n ON t.relnamespace = n.oid
+WHERE c.contype = 'p' AND n.nspname = '%s' AND t.relname = '%s';`, quoteLiteral(schemaName), quoteLiteral(tableName)))
+	if err != nil {
+		return nil, err
+	}
+	keyColumns := make(map[int]struct{}, len(result.Rows))
+	for _, row := range result.Rows {
+		attnum, err := strconv.Atoi(string(row[0]))
+		if err != nil {
+			return nil, err
+		}
+		keyColumns[attnum] = struct{}{}
+	}
+	return keyColumns, nil
+}
+
+// commitTime returns the time that the commit was made, or the current time if it cannot be determined.
+func (s *Stream) commitTime(commit string) (time.Time, error) {
+	result, err := s.run(fmt.Sprintf("SELECT date FROM dolt_log WHERE commit_hash = '%s';", commit))
+	if err != nil {
+		return time.Time{}, err
+	}
+	value := firstValue(result)
+	for _, layout := range []string{"2006-01-02 15:04:05.999999999-07", "2006-01-02 15:04:05.999999999"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	return time.Now(), nil
+}
+
+// commitColumnIndex returns the index of the first commit column with the given prefix, starting the search at the
+// given index. Returns -1 if the column could not be found.
+func commitColumnIndex(fields []pgproto3.FieldDescription, start int, prefix string) int {
+	for i := start; i+1 < len(fields); i++ {
+		if string(fields[i].Name) == prefix+"commit" && string(fields[i+1].Name) == prefix+"commit_date" {
+			return i
+		}
+	}
+	return -1
+}
+
+// commitXid returns the transaction ID of the commit's transaction.
+func commitXid(commit string) uint32 {
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(commit))
+	return hash.Sum32()
+}
+
+// splitTableName splits a schema-qualified table name into its schema and table.
+func splitTableName(name string) (schemaName string, tableName string) {
+	if idx := strings.IndexByte(name, '.'); idx >= 0 {
+		return name[:idx], name[idx+1:]
+	}
+	return "public", name
+}
+
+// firstValue returns the first value of the first row of the result, or an empty string if there isn't one.
+func firstValue(result QueryResult) string {
+	if len(result.Rows) == 0 || len(result.Rows[0]) == 0 {
+		return ""
+	}
+	return string(result.Rows[0][0])
+}
+
+// quoteLiteral escapes the value so that it may be placed within a string literal.
+func quoteLiteral(value string) string {
+	return strings.ReplaceAll(value, "'", "''")
+}
@@ -0,0 +1,198 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package walsender
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackc/pglogrepl"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPgoutputMessages verifies that the encoded messages are parsed by a pgoutput consumer.
+func TestPgoutputMessages(t *testing.T) {
+	commitTime := time.Date(2026, time.March, 4, 5, 6, 7, 0, time.UTC)
+
+	msg, err := pglogrepl.Parse(encodeBegin(0x1000003, commitTime, 42))
+	require.NoError(t, err)
+	begin := msg.(*pglogrepl.BeginMessage)
+	assert.Equal(t, pglogrepl.LSN(0x1000003), begin.FinalLSN)
+	assert.True(t, commitTime.Equal(begin.CommitTime))
+	assert.Equal(t, uint32(42), begin.Xid)
+
+	msg, err = pglogrepl.Parse(encodeRelation(&Relation{
+		ID:              1234,
+		Namespace:       "public",
+		Name:            "test",
+		ReplicaIdentity: 'd',
+		Columns: []RelationColumn{
+			{Name: "pk", TypeOID: 23, TypMod: -1, Key: true},
+			{Name: "v1", TypeOID: 1043, TypMod: 14},
+		},
+	}))
+	require.NoError(t, err)
+	rel := msg.(*pglogrepl.RelationMessage)
+	assert.Equal(t, uint32(1234), rel.RelationID)
+	assert.Equal(t, "public", rel.Namespace)
+	assert.Equal(t, "test", rel.RelationName)
+	assert.Equal(t, uint8('d'), rel.ReplicaIdentity)
+	require.Len(t, rel.Columns, 2)
+	assert.Equal(t, uint8(1), rel.Columns[0].Flags)
+	assert.Equal(t, "pk", rel.Columns[0].Name)
+	assert.Equal(t, uint32(23), rel.Columns[0].DataType)
+	assert.Equal(t, uint8(0), rel.Columns[1].Flags)
+	assert.Equal(t, int32(14), rel.Columns[1].TypeModifier)
+
+	msg, err = pglogrepl.Parse(encodeInsert(1234, [][]byte{[]byte("1"), nil}))
+	require.NoError(t, err)
+	insert := msg.(*pglogrepl.InsertMessage)
+	assert.Equal(t, uint32(1234), insert.RelationID)
+	require.Len(t, insert.Tuple.Columns, 2)
+	assert.Equal(t, uint8('t'), insert.Tuple.Columns[0].DataType)
+	assert.Equal(t, []byte("1"), insert.Tuple.Columns[0].Data)
+	assert.Equal(t, uint8('n'), insert.Tuple.Columns[1].DataType)
+
+	msg, err = pglogrepl.Parse(encodeUpdate(1234, 'O', [][]byte{[]byte("1"), []byte("a")}, [][]byte{[]byte("1"), []byte("b")}))
+	require.NoError(t, err)
+	update := msg.(*pglogrepl.UpdateMessage)
+	assert.Equal(t, uint8('O'), update.OldTupleType)
+	assert.Equal(t, []byte("a"), update.OldTuple.Columns[1].Data)
+	assert.Equal(t, []byte("b"), update.NewTuple.Columns[1].Data)
+
+	msg, err = pglogrepl.Parse(encodeUpdate(1234, 'K', nil, [][]byte{[]byte("1"), []byte("c")}))
+	require.NoError(t, err)
+	update = msg.(*pglogrepl.UpdateMessage)
+	assert.Nil(t, update.OldTuple)
+	assert.Equal(t, []byte("c"), update.NewTuple.Columns[1].Data)
+
+	msg, err = pglogrepl.Parse(encodeDelete(1234, 'K', [][]byte{[]byte("1"), nil}))
+	require.NoError(t, err)
+	del := msg.(*pglogrepl.DeleteMessage)
+	assert.Equal(t, uint8('K'), del.OldTupleType)
+	assert.Equal(t, []byte("1"), del.OldTuple.Columns[0].Data)
+
+	msg, err = pglogrepl.Parse(encodeCommit(0x1000003, 0x1000004, commitTime))
+	require.NoError(t, err)
+	commit := msg.(*pglogrepl.CommitMessage)
+	assert.Equal(t, pglogrepl.LSN(0x1000003), commit.CommitLSN)
+	assert.Equal(t, pglogrepl.LSN(0x1000004), commit.TransactionEndLSN)
+	assert.True(t, commitTime.Equal(commit.CommitTime))
+}
+
+// TestReplicationFrames verifies the framing of the messages that are exchanged in the CopyBoth mode.
+func TestReplicationFrames(t *testing.T) {
+	now := time.Date(2026, time.March, 4, 5, 6, 7, 0, time.UTC)
+
+	data := EncodeXLogData(0x1000001, 0x1000004, now, []byte{'x'})
+	require.Equal(t, byte(pglogrepl.XLogDataByteID), data[0])
+	xld, err := pglogrepl.ParseXLogData(data[1:])
+	require.NoError(t, err)
+	assert.Equal(t, pglogrepl.LSN(0x1000001), xld.WALStart)
+	assert.Equal(t, pglogrepl.LSN(0x1000004), xld.ServerWALEnd)
+	assert.True(t, now.Equal(xld.ServerTime))
+	assert.Equal(t, []byte{'x'}, xld.WALData)
+
+	data = EncodeKeepalive(0x1000004, now, true)
+	require.Equal(t, byte(pglogrepl.PrimaryKeepaliveMessageByteID), data[0])
+	pkm, err := pglogrepl.ParsePrimaryKeepaliveMessage(data[1:])
+	require.NoError(t, err)
+	assert.Equal(t, pglogrepl.LSN(0x1000004), pkm.ServerWALEnd)
+	assert.True(t, pkm.ReplyRequested)
+
+	var update []byte
+	update = append(update, pglogrepl.StandbyStatusUpdateByteID)
+	for _, lsn := range []uint64{0x1000004, 0x1000003, 0x1000002, 0} {
+		update = append(update, byte(lsn>>56), byte(lsn>>48), byte(lsn>>40), byte(lsn>>32), byte(lsn>>24), byte(lsn>>16), byte(lsn>>8), byte(lsn))
+	}
+	update = append(update, 1)
+	status, ok, err := ParseStandbyMessage(update)
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, pglogrepl.LSN(0x1000004), status.WritePosition)
+	assert.Equal(t, pglogrepl.LSN(0x1000003), status.FlushPosition)
+	assert.Equal(t, pglogrepl.LSN(0x1000002), status.ApplyPosition)
+	assert.True(t, status.ReplyRequested)
+}
+
+// TestParseCommand verifies the parsing of replication commands.
+func TestParseCommand(t *testing.T) {
+	tests := []struct {
+		query    string
+		expected Command
+		notCmd   bool
+		err      bool
+	}{
+		{query: "IDENTIFY_SYSTEM", expected: IdentifySystemCommand{}},
+		{query: "identify_system;", expected: IdentifySystemCommand{}},
+		{query: "IDENTIFY_SYSTEM extra", err: true},
+		{
+			query:    `CREATE_REPLICATION_SLOT my_slot LOGICAL pgoutput`,
+			expected: CreateReplicationSlotCommand{Name: "my_slot", Plugin: "pgoutput"},
+		},
+		{
+			query:    `CREATE_REPLICATION_SLOT "My_Slot" TEMPORARY LOGICAL pgoutput (SNAPSHOT 'nothing')`,
+			expected: CreateReplicationSlotCommand{Name: "My_Slot", Temporary: true, Plugin: "pgoutput"},
+		},
+		{
+			query:    `CREATE_REPLICATION_SLOT my_slot LOGICAL pgoutput USE_SNAPSHOT`,
+			expected: CreateReplicationSlotCommand{Name: "my_slot", Plugin: "pgoutput"},
+		},
+		{
+			query:    `CREATE_REPLICATION_SLOT my_slot PHYSICAL`,
+			expected: CreateReplicationSlotCommand{Name: "my_slot", Physical: true},
+		},
+		{query: `CREATE_REPLICATION_SLOT my_slot`, err: true},
+		{query: `DROP_REPLICATION_SLOT my_slot WAIT`, expected: DropReplicationSlotCommand{Name: "my_slot", Wait: true}},
+		{
+			query: `START_REPLICATION SLOT my_slot LOGICAL 0/1A2B3C (proto_version '1', publication_names '"pub1",pub2')`,
+			expected: StartReplicationCommand{
+				Slot: "my_slot",
+				LSN:  0x1A2B3C,
+				Options: map[string]string{
+					"proto_version":     "1",
+					"publication_names": `"pub1",pub2`,
+				},
+			},
+		},
+		{query: `START_REPLICATION SLOT my_slot LOGICAL invalid`, err: true},
+		{query: `START_REPLICATION SLOT my_slot LOGICAL 0/0 (proto_version '1'`, err: true},
+		{query: `SELECT 1`, notCmd: true},
+		{query: `SHOW wal_level`, notCmd: true},
+	}
+	for _, test := range tests {
+		t.Run(test.query, func(t *testing.T) {
+			cmd, ok, err := ParseCommand(test.query)
+			if test.notCmd {
+				assert.False(t, ok)
+				return
+			}
+			require.True(t, ok)
+			if test.err {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, cmd)
+		})
+	}
+
+	names, err := ParsePublicationNames(`"pub1", pub2,"Pub,3"`)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"pub1", "pub2", "Pub,3"}, names)
+	_, err = ParsePublicationNames(`pub1,,pub2`)
+	assert.Error(t, err)
+}
@@ -85,8 +85,22 @@ type PostgresReplicationConfig struct {
 	PostgresDatabase      *string `yaml:"postgres_database,omitempty" minver:"0.7.4"`
 	PostgresPort          *int    `yaml:"postgres_port,omitempty" minver:"0.7.4"`
 	SlotName              *string `yaml:"slot_name,omitempty" minver:"0.7.4"`
+	// CommitMode determines how replicated transactions are recorded. See PostgresReplicationCommitModeWorkingSet and
+	// PostgresReplicationCommitModeDoltCommit.
+	CommitMode *string `yaml:"commit_mode,omitempty" minver:"1.2.0"`
 }
 
+const (
+	// PostgresReplicationCommitModeWorkingSet applies each replicated transaction to the working set. This is the
+	// default.
+	PostgresReplicationCommitModeWorkingSet = "working_set"
+	// PostgresReplicationCommitModeDoltCommit creates a Dolt commit of the tables changed by each replicated
+	// transaction, whose message records the transaction's LSN, commit timestamp, and origin on the primary as
+	// "upstream_lsn", "upstream_commit_time", and "upstream_origin" lines. Local changes must not be staged in the
+	// replicated database while replicating in this mode.
+	PostgresReplicationCommitModeDoltCommit = "dolt_commit"
+)
+
 // DoltgresBehaviorConfig contains server configuration regarding how the server should behave
 type DoltgresBehaviorConfig struct {
 	ReadOnly *bool `yaml:"read_only,omitempty" minver:"0.7.4"`
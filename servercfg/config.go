@@ -64,6 +64,7 @@ func (*DoltgresConfig) Overrides() sql.EngineOverrides {
 				PreSQLExecution: hook.BeforeTableModifyColumn,
 			},
 			TableDropColumn: sql.TableDropColumn{
+				PreSQLExecution:  hook.BeforeTableDropColumn,
 				PostSQLExecution: hook.AfterTableDropColumn,
 			},
 		},
@@ -18,26 +18,26 @@ import "testing"
 
 func TestAlterDomain(t *testing.T) {
 	tests := []QueryParses{
-		Parses("ALTER DOMAIN name SET DEFAULT expression"),
-		Parses("ALTER DOMAIN name DROP DEFAULT"),
-		Parses("ALTER DOMAIN name SET NOT NULL"),
-		Parses("ALTER DOMAIN name DROP NOT NULL"),
+		Converts("ALTER DOMAIN name SET DEFAULT expression"),
+		Converts("ALTER DOMAIN name DROP DEFAULT"),
+		Converts("ALTER DOMAIN name SET NOT NULL"),
+		Converts("ALTER DOMAIN name DROP NOT NULL"),
 		Parses("ALTER DOMAIN name ADD CONSTRAINT name CHECK ( condition )"),
 		Parses("ALTER DOMAIN name ADD CONSTRAINT name CHECK ( condition ) NOT VALID"),
-		Parses("ALTER DOMAIN name DROP CONSTRAINT constraint_name"),
-		Parses("ALTER DOMAIN name DROP CONSTRAINT IF EXISTS constraint_name"),
-		Parses("ALTER DOMAIN name DROP CONSTRAINT constraint_name RESTRICT"),
-		Parses("ALTER DOMAIN name DROP CONSTRAINT IF EXISTS constraint_name RESTRICT"),
-		Parses("ALTER DOMAIN name DROP CONSTRAINT constraint_name CASCADE"),
-		Parses("ALTER DOMAIN name DROP CONSTRAINT IF EXISTS constraint_name CASCADE"),
-		Parses("ALTER DOMAIN name RENAME CONSTRAINT constraint_name TO new_constraint_name"),
-		Parses("ALTER DOMAIN name VALIDATE CONSTRAINT constraint_name"),
+		Converts("ALTER DOMAIN name DROP CONSTRAINT constraint_name"),
+		Converts("ALTER DOMAIN name DROP CONSTRAINT IF EXISTS constraint_name"),
+		Converts("ALTER DOMAIN name DROP CONSTRAINT constraint_name RESTRICT"),
+		Converts("ALTER DOMAIN name DROP CONSTRAINT IF EXISTS constraint_name RESTRICT"),
+		Converts("ALTER DOMAIN name DROP CONSTRAINT constraint_name CASCADE"),
+		Converts("ALTER DOMAIN name DROP CONSTRAINT IF EXISTS constraint_name CASCADE"),
+		Converts("ALTER DOMAIN name RENAME CONSTRAINT constraint_name TO new_constraint_name"),
+		Converts("ALTER DOMAIN name VALIDATE CONSTRAINT constraint_name"),
 		Converts("ALTER DOMAIN name OWNER TO new_owner"),
 		Converts("ALTER DOMAIN name OWNER TO CURRENT_ROLE"),
 		Converts("ALTER DOMAIN name OWNER TO CURRENT_USER"),
 		Converts("ALTER DOMAIN name OWNER TO SESSION_USER"),
-		Parses("ALTER DOMAIN name RENAME TO new_name"),
-		Parses("ALTER DOMAIN name SET SCHEMA new_schema"),
+		Converts("ALTER DOMAIN name RENAME TO new_name"),
+		Converts("ALTER DOMAIN name SET SCHEMA new_schema"),
 	}
 	RunTests(t, tests)
 }
@@ -3320,7 +3320,7 @@ func TestAlterPublication(t *testing.T) {
 		Unimplemented("ALTER PUBLICATION name ADD TABLE table_name * ( column_name ) , TABLE table_name * WHERE ( expression ) , TABLES IN SCHEMA schema_name , schema_name"),
 		Unimplemented("ALTER PUBLICATION name ADD TABLE ONLY table_name , TABLE table_name ( column_name ) WHERE ( expression ) , TABLES IN SCHEMA schema_name , schema_name"),
 		Unimplemented("ALTER PUBLICATION name ADD TABLE table_name * ( column_name , column_name ) WHERE ( expression ) , TABLE table_name * ( column_name , column_name ) WHERE ( expression ) , TABLES IN SCHEMA schema_name , schema_name"),
-		Unimplemented("ALTER PUBLICATION name ADD TABLES IN SCHEMA schema_name , schema_name , TABLES IN SCHEMA schema_name , schema_name"),
+		Converts("ALTER PUBLICATION name ADD TABLES IN SCHEMA schema_name , schema_name , TABLES IN SCHEMA schema_name , schema_name"),
 		Unimplemented("ALTER PUBLICATION name ADD TABLE table_name * ( column_name , column_name ) , TABLES IN SCHEMA CURRENT_SCHEMA , schema_name"),
 		Unimplemented("ALTER PUBLICATION name ADD TABLE ONLY table_name ( column_name ) , TABLE table_name , TABLES IN SCHEMA CURRENT_SCHEMA , schema_name"),
 		Unimplemented("ALTER PUBLICATION name ADD TABLE table_name ( column_name ) , TABLE table_name , TABLES IN SCHEMA CURRENT_SCHEMA , schema_name"),
@@ -9958,7 +9958,7 @@ func TestAlterPublication(t *testing.T) {
 		Unimplemented("ALTER PUBLICATION name DROP TABLE table_name , TABLE ONLY table_name ( column_name , column_name ) WHERE ( expression ) , TABLES IN SCHEMA schema_name"),
 		Unimplemented("ALTER PUBLICATION name DROP TABLE table_name ( column_name ) WHERE ( expression ) , TABLE ONLY table_name ( column_name , column_name ) WHERE ( expression ) , TABLES IN SCHEMA schema_name"),
 		Unimplemented("ALTER PUBLICATION name DROP TABLE table_name , TABLE table_name * ( column_name , column_name ) WHERE ( expression ) , TABLES IN SCHEMA schema_name"),
-		Unimplemented("ALTER PUBLICATION name DROP TABLES IN SCHEMA schema_name , TABLES IN SCHEMA schema_name"),
+		Converts("ALTER PUBLICATION name DROP TABLES IN SCHEMA schema_name , TABLES IN SCHEMA schema_name"),
 		Unimplemented("ALTER PUBLICATION name DROP TABLE table_name * ( column_name ) , TABLES IN SCHEMA CURRENT_SCHEMA"),
 		Unimplemented("ALTER PUBLICATION name DROP TABLE table_name * ( column_name ) , TABLE ONLY table_name , TABLES IN SCHEMA CURRENT_SCHEMA"),
 		Unimplemented("ALTER PUBLICATION name DROP TABLE table_name * ( column_name ) , TABLE table_name , TABLES IN SCHEMA CURRENT_SCHEMA"),
@@ -18,70 +18,70 @@ import "testing"
 
 func TestAlterSubscription(t *testing.T) {
 	tests := []QueryParses{
-		Unimplemented("ALTER SUBSCRIPTION name CONNECTION ' conninfo '"),
-		Unimplemented("ALTER SUBSCRIPTION name SET PUBLICATION publication_name"),
-		Unimplemented("ALTER SUBSCRIPTION name SET PUBLICATION publication_name , publication_name"),
-		Unimplemented("ALTER SUBSCRIPTION name SET PUBLICATION publication_name WITH ( publication_option )"),
-		Unimplemented("ALTER SUBSCRIPTION name SET PUBLICATION publication_name , publication_name WITH ( publication_option )"),
-		Unimplemented("ALTER SUBSCRIPTION name SET PUBLICATION publication_name WITH ( publication_option = value )"),
-		Unimplemented("ALTER SUBSCRIPTION name SET PUBLICATION publication_name , publication_name WITH ( publication_option = value )"),
-		Unimplemented("ALTER SUBSCRIPTION name SET PUBLICATION publication_name WITH ( publication_option , publication_option )"),
-		Unimplemented("ALTER SUBSCRIPTION name SET PUBLICATION publication_name , publication_name WITH ( publication_option , publication_option )"),
-		Unimplemented("ALTER SUBSCRIPTION name SET PUBLICATION publication_name WITH ( publication_option = value , publication_option )"),
-		Unimplemented("ALTER SUBSCRIPTION name SET PUBLICATION publication_name , publication_name WITH ( publication_option = value , publication_option )"),
-		Unimplemented("ALTER SUBSCRIPTION name SET PUBLICATION publication_name WITH ( publication_option , publication_option = value )"),
-		Unimplemented("ALTER SUBSCRIPTION name SET PUBLICATION publication_name , publication_name WITH ( publication_option , publication_option = value )"),
-		Unimplemented("ALTER SUBSCRIPTION name SET PUBLICATION publication_name WITH ( publication_option = value , publication_option = value )"),
-		Unimplemented("ALTER SUBSCRIPTION name SET PUBLICATION publication_name , publication_name WITH ( publication_option = value , publication_option = value )"),
-		Unimplemented("ALTER SUBSCRIPTION name ADD PUBLICATION publication_name"),
-		Unimplemented("ALTER SUBSCRIPTION name ADD PUBLICATION publication_name , publication_name"),
-		Unimplemented("ALTER SUBSCRIPTION name ADD PUBLICATION publication_name WITH ( publication_option )"),
-		Unimplemented("ALTER SUBSCRIPTION name ADD PUBLICATION publication_name , publication_name WITH ( publication_option )"),
-		Unimplemented("ALTER SUBSCRIPTION name ADD PUBLICATION publication_name WITH ( publication_option = value )"),
-		Unimplemented("ALTER SUBSCRIPTION name ADD PUBLICATION publication_name , publication_name WITH ( publication_option = value )"),
-		Unimplemented("ALTER SUBSCRIPTION name ADD PUBLICATION publication_name WITH ( publication_option , publication_option )"),
-		Unimplemented("ALTER SUBSCRIPTION name ADD PUBLICATION publication_name , publication_name WITH ( publication_option , publication_option )"),
-		Unimplemented("ALTER SUBSCRIPTION name ADD PUBLICATION publication_name WITH ( publication_option = value , publication_option )"),
-		Unimplemented("ALTER SUBSCRIPTION name ADD PUBLICATION publication_name , publication_name WITH ( publication_option = value , publication_option )"),
-		Unimplemented("ALTER SUBSCRIPTION name ADD PUBLICATION publication_name WITH ( publication_option , publication_option = value )"),
-		Unimplemented("ALTER SUBSCRIPTION name ADD PUBLICATION publication_name , publication_name WITH ( publication_option , publication_option = value )"),
-		Unimplemented("ALTER SUBSCRIPTION name ADD PUBLICATION publication_name WITH ( publication_option = value , publication_option = value )"),
-		Unimplemented("ALTER SUBSCRIPTION name ADD PUBLICATION publication_name , publication_name WITH ( publication_option = value , publication_option = value )"),
-		Unimplemented("ALTER SUBSCRIPTION name DROP PUBLICATION publication_name"),
-		Unimplemented("ALTER SUBSCRIPTION name DROP PUBLICATION publication_name , publication_name"),
-		Unimplemented("ALTER SUBSCRIPTION name DROP PUBLICATION publication_name WITH ( publication_option )"),
-		Unimplemented("ALTER SUBSCRIPTION name DROP PUBLICATION publication_name , publication_name WITH ( publication_option )"),
-		Unimplemented("ALTER SUBSCRIPTION name DROP PUBLICATION publication_name WITH ( publication_option = value )"),
-		Unimplemented("ALTER SUBSCRIPTION name DROP PUBLICATION publication_name , publication_name WITH ( publication_option = value )"),
-		Unimplemented("ALTER SUBSCRIPTION name DROP PUBLICATION publication_name WITH ( publication_option , publication_option )"),
-		Unimplemented("ALTER SUBSCRIPTION name DROP PUBLICATION publication_name , publication_name WITH ( publication_option , publication_option )"),
-		Unimplemented("ALTER SUBSCRIPTION name DROP PUBLICATION publication_name WITH ( publication_option = value , publication_option )"),
-		Unimplemented("ALTER SUBSCRIPTION name DROP PUBLICATION publication_name , publication_name WITH ( publication_option = value , publication_option )"),
-		Unimplemented("ALTER SUBSCRIPTION name DROP PUBLICATION publication_name WITH ( publication_option , publication_option = value )"),
-		Unimplemented("ALTER SUBSCRIPTION name DROP PUBLICATION publication_name , publication_name WITH ( publication_option , publication_option = value )"),
-		Unimplemented("ALTER SUBSCRIPTION name DROP PUBLICATION publication_name WITH ( publication_option = value , publication_option = value )"),
-		Unimplemented("ALTER SUBSCRIPTION name DROP PUBLICATION publication_name , publication_name WITH ( publication_option = value , publication_option = value )"),
-		Unimplemented("ALTER SUBSCRIPTION name REFRESH PUBLICATION"),
-		Unimplemented("ALTER SUBSCRIPTION name REFRESH PUBLICATION WITH ( refresh_option )"),
-		Unimplemented("ALTER SUBSCRIPTION name REFRESH PUBLICATION WITH ( refresh_option = value )"),
-		Unimplemented("ALTER SUBSCRIPTION name REFRESH PUBLICATION WITH ( refresh_option , refresh_option )"),
-		Unimplemented("ALTER SUBSCRIPTION name REFRESH PUBLICATION WITH ( refresh_option = value , refresh_option )"),
-		Unimplemented("ALTER SUBSCRIPTION name REFRESH PUBLICATION WITH ( refresh_option , refresh_option = value )"),
-		Unimplemented("ALTER SUBSCRIPTION name REFRESH PUBLICATION WITH ( refresh_option = value , refresh_option = value )"),
-		Unimplemented("ALTER SUBSCRIPTION name ENABLE"),
-		Unimplemented("ALTER SUBSCRIPTION name DISABLE"),
-		Unimplemented("ALTER SUBSCRIPTION name SET ( subscription_parameter )"),
-		Unimplemented("ALTER SUBSCRIPTION name SET ( subscription_parameter = value )"),
-		Unimplemented("ALTER SUBSCRIPTION name SET ( subscription_parameter , subscription_parameter )"),
-		Unimplemented("ALTER SUBSCRIPTION name SET ( subscription_parameter = value , subscription_parameter )"),
-		Unimplemented("ALTER SUBSCRIPTION name SET ( subscription_parameter , subscription_parameter = value )"),
-		Unimplemented("ALTER SUBSCRIPTION name SET ( subscription_parameter = value , subscription_parameter = value )"),
+		Converts("ALTER SUBSCRIPTION name CONNECTION ' conninfo '"),
+		Converts("ALTER SUBSCRIPTION name SET PUBLICATION publication_name"),
+		Converts("ALTER SUBSCRIPTION name SET PUBLICATION publication_name , publication_name"),
+		Parses("ALTER SUBSCRIPTION name SET PUBLICATION publication_name WITH ( publication_option )"),
+		Parses("ALTER SUBSCRIPTION name SET PUBLICATION publication_name , publication_name WITH ( publication_option )"),
+		Parses("ALTER SUBSCRIPTION name SET PUBLICATION publication_name WITH ( publication_option = value )"),
+		Parses("ALTER SUBSCRIPTION name SET PUBLICATION publication_name , publication_name WITH ( publication_option = value )"),
+		Parses("ALTER SUBSCRIPTION name SET PUBLICATION publication_name WITH ( publication_option , publication_option )"),
+		Parses("ALTER SUBSCRIPTION name SET PUBLICATION publication_name , publication_name WITH ( publication_option , publication_option )"),
+		Parses("ALTER SUBSCRIPTION name SET PUBLICATION publication_name WITH ( publication_option = value , publication_option )"),
+		Parses("ALTER SUBSCRIPTION name SET PUBLICATION publication_name , publication_name WITH ( publication_option = value , publication_option )"),
+		Parses("ALTER SUBSCRIPTION name SET PUBLICATION publication_name WITH ( publication_option , publication_option = value )"),
+		Parses("ALTER SUBSCRIPTION name SET PUBLICATION publication_name , publication_name WITH ( publication_option , publication_option = value )"),
+		Parses("ALTER SUBSCRIPTION name SET PUBLICATION publication_name WITH ( publication_option = value , publication_option = value )"),
+		Parses("ALTER SUBSCRIPTION name SET PUBLICATION publication_name , publication_name WITH ( publication_option = value , publication_option = value )"),
+		Converts("ALTER SUBSCRIPTION name ADD PUBLICATION publication_name"),
+		Converts("ALTER SUBSCRIPTION name ADD PUBLICATION publication_name , publication_name"),
+		Parses("ALTER SUBSCRIPTION name ADD PUBLICATION publication_name WITH ( publication_option )"),
+		Parses("ALTER SUBSCRIPTION name ADD PUBLICATION publication_name , publication_name WITH ( publication_option )"),
+		Parses("ALTER SUBSCRIPTION name ADD PUBLICATION publication_name WITH ( publication_option = value )"),
+		Parses("ALTER SUBSCRIPTION name ADD PUBLICATION publication_name , publication_name WITH ( publication_option = value )"),
+		Parses("ALTER SUBSCRIPTION name ADD PUBLICATION publication_name WITH ( publication_option , publication_option )"),
+		Parses("ALTER SUBSCRIPTION name ADD PUBLICATION publication_name , publication_name WITH ( publication_option , publication_option )"),
+		Parses("ALTER SUBSCRIPTION name ADD PUBLICATION publication_name WITH ( publication_option = value , publication_option )"),
+		Parses("ALTER SUBSCRIPTION name ADD PUBLICATION publication_name , publication_name WITH ( publication_option = value , publication_option )"),
+		Parses("ALTER SUBSCRIPTION name ADD PUBLICATION publication_name WITH ( publication_option , publication_option = value )"),
+		Parses("ALTER SUBSCRIPTION name ADD PUBLICATION publication_name , publication_name WITH ( publication_option , publication_option = value )"),
+		Parses("ALTER SUBSCRIPTION name ADD PUBLICATION publication_name WITH ( publication_option = value , publication_option = value )"),
+		Parses("ALTER SUBSCRIPTION name ADD PUBLICATION publication_name , publication_name WITH ( publication_option = value , publication_option = value )"),
+		Converts("ALTER SUBSCRIPTION name DROP PUBLICATION publication_name"),
+		Converts("ALTER SUBSCRIPTION name DROP PUBLICATION publication_name , publication_name"),
+		Parses("ALTER SUBSCRIPTION name DROP PUBLICATION publication_name WITH ( publication_option )"),
+		Parses("ALTER SUBSCRIPTION name DROP PUBLICATION publication_name , publication_name WITH ( publication_option )"),
+		Parses("ALTER SUBSCRIPTION name DROP PUBLICATION publication_name WITH ( publication_option = value )"),
+		Parses("ALTER SUBSCRIPTION name DROP PUBLICATION publication_name , publication_name WITH ( publication_option = value )"),
+		Parses("ALTER SUBSCRIPTION name DROP PUBLICATION publication_name WITH ( publication_option , publication_option )"),
+		Parses("ALTER SUBSCRIPTION name DROP PUBLICATION publication_name , publication_name WITH ( publication_option , publication_option )"),
+		Parses("ALTER SUBSCRIPTION name DROP PUBLICATION publication_name WITH ( publication_option = value , publication_option )"),
+		Parses("ALTER SUBSCRIPTION name DROP PUBLICATION publication_name , publication_name WITH ( publication_option = value , publication_option )"),
+		Parses("ALTER SUBSCRIPTION name DROP PUBLICATION publication_name WITH ( publication_option , publication_option = value )"),
+		Parses("ALTER SUBSCRIPTION name DROP PUBLICATION publication_name , publication_name WITH ( publication_option , publication_option = value )"),
+		Parses("ALTER SUBSCRIPTION name DROP PUBLICATION publication_name WITH ( publication_option = value , publication_option = value )"),
+		Parses("ALTER SUBSCRIPTION name DROP PUBLICATION publication_name , publication_name WITH ( publication_option = value , publication_option = value )"),
+		Converts("ALTER SUBSCRIPTION name REFRESH PUBLICATION"),
+		Parses("ALTER SUBSCRIPTION name REFRESH PUBLICATION WITH ( refresh_option )"),
+		Parses("ALTER SUBSCRIPTION name REFRESH PUBLICATION WITH ( refresh_option = value )"),
+		Parses("ALTER SUBSCRIPTION name REFRESH PUBLICATION WITH ( refresh_option , refresh_option )"),
+		Parses("ALTER SUBSCRIPTION name REFRESH PUBLICATION WITH ( refresh_option = value , refresh_option )"),
+		Parses("ALTER SUBSCRIPTION name REFRESH PUBLICATION WITH ( refresh_option , refresh_option = value )"),
+		Parses("ALTER SUBSCRIPTION name REFRESH PUBLICATION WITH ( refresh_option = value , refresh_option = value )"),
+		Converts("ALTER SUBSCRIPTION name ENABLE"),
+		Converts("ALTER SUBSCRIPTION name DISABLE"),
+		Parses("ALTER SUBSCRIPTION name SET ( subscription_parameter )"),
+		Parses("ALTER SUBSCRIPTION name SET ( subscription_parameter = value )"),
+		Parses("ALTER SUBSCRIPTION name SET ( subscription_parameter , subscription_parameter )"),
+		Parses("ALTER SUBSCRIPTION name SET ( subscription_parameter = value , subscription_parameter )"),
+		Parses("ALTER SUBSCRIPTION name SET ( subscription_parameter , subscription_parameter = value )"),
+		Parses("ALTER SUBSCRIPTION name SET ( subscription_parameter = value , subscription_parameter = value )"),
 		Unimplemented("ALTER SUBSCRIPTION name SKIP ( skip_option = value )"),
-		Unimplemented("ALTER SUBSCRIPTION name OWNER TO new_owner"),
-		Unimplemented("ALTER SUBSCRIPTION name OWNER TO CURRENT_ROLE"),
-		Unimplemented("ALTER SUBSCRIPTION name OWNER TO CURRENT_USER"),
-		Unimplemented("ALTER SUBSCRIPTION name OWNER TO SESSION_USER"),
-		Unimplemented("ALTER SUBSCRIPTION name RENAME TO new_name"),
+		Converts("ALTER SUBSCRIPTION name OWNER TO new_owner"),
+		Converts("ALTER SUBSCRIPTION name OWNER TO CURRENT_ROLE"),
+		Converts("ALTER SUBSCRIPTION name OWNER TO CURRENT_USER"),
+		Converts("ALTER SUBSCRIPTION name OWNER TO SESSION_USER"),
+		Converts("ALTER SUBSCRIPTION name RENAME TO new_name"),
 	}
 	RunTests(t, tests)
 }
@@ -22,618 +22,618 @@ func TestAlterType(t *testing.T) {
 		Converts("ALTER TYPE name OWNER TO CURRENT_ROLE"),
 		Converts("ALTER TYPE name OWNER TO CURRENT_USER"),
 		Converts("ALTER TYPE name OWNER TO SESSION_USER"),
-		Parses("ALTER TYPE name RENAME TO new_name"),
-		Parses("ALTER TYPE name SET SCHEMA new_schema"),
-		Parses("ALTER TYPE name RENAME ATTRIBUTE attribute_name TO new_attribute_name"),
-		Parses("ALTER TYPE name RENAME ATTRIBUTE attribute_name TO new_attribute_name CASCADE"),
-		Parses("ALTER TYPE name RENAME ATTRIBUTE attribute_name TO new_attribute_name RESTRICT"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type CASCADE"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type RESTRICT"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name CASCADE"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name CASCADE"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name RESTRICT"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type , ADD ATTRIBUTE attribute_name data_type"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US , ADD ATTRIBUTE attribute_name data_type"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type CASCADE , ADD ATTRIBUTE attribute_name data_type"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE , ADD ATTRIBUTE attribute_name data_type"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type RESTRICT , ADD ATTRIBUTE attribute_name data_type"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT , ADD ATTRIBUTE attribute_name data_type"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name , ADD ATTRIBUTE attribute_name data_type"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name , ADD ATTRIBUTE attribute_name data_type"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name CASCADE , ADD ATTRIBUTE attribute_name data_type"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name CASCADE , ADD ATTRIBUTE attribute_name data_type"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name RESTRICT , ADD ATTRIBUTE attribute_name data_type"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT , ADD ATTRIBUTE attribute_name data_type"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type , ADD ATTRIBUTE attribute_name data_type"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type , ADD ATTRIBUTE attribute_name data_type"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US , ADD ATTRIBUTE attribute_name data_type"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US , ADD ATTRIBUTE attribute_name data_type"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE , ADD ATTRIBUTE attribute_name data_type"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE , ADD ATTRIBUTE attribute_name data_type"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE , ADD ATTRIBUTE attribute_name data_type"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE , ADD ATTRIBUTE attribute_name data_type"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT , ADD ATTRIBUTE attribute_name data_type"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT , ADD ATTRIBUTE attribute_name data_type"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT , ADD ATTRIBUTE attribute_name data_type"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT , ADD ATTRIBUTE attribute_name data_type"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type , ADD ATTRIBUTE attribute_name data_type COLLATE en_US"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US , ADD ATTRIBUTE attribute_name data_type COLLATE en_US"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type CASCADE , ADD ATTRIBUTE attribute_name data_type COLLATE en_US"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE , ADD ATTRIBUTE attribute_name data_type COLLATE en_US"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type RESTRICT , ADD ATTRIBUTE attribute_name data_type COLLATE en_US"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT , ADD ATTRIBUTE attribute_name data_type COLLATE en_US"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name , ADD ATTRIBUTE attribute_name data_type COLLATE en_US"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name , ADD ATTRIBUTE attribute_name data_type COLLATE en_US"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name CASCADE , ADD ATTRIBUTE attribute_name data_type COLLATE en_US"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name CASCADE , ADD ATTRIBUTE attribute_name data_type COLLATE en_US"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name RESTRICT , ADD ATTRIBUTE attribute_name data_type COLLATE en_US"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT , ADD ATTRIBUTE attribute_name data_type COLLATE en_US"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type , ADD ATTRIBUTE attribute_name data_type COLLATE en_US"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type , ADD ATTRIBUTE attribute_name data_type COLLATE en_US"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US , ADD ATTRIBUTE attribute_name data_type COLLATE en_US"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US , ADD ATTRIBUTE attribute_name data_type COLLATE en_US"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE , ADD ATTRIBUTE attribute_name data_type COLLATE en_US"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE , ADD ATTRIBUTE attribute_name data_type COLLATE en_US"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE , ADD ATTRIBUTE attribute_name data_type COLLATE en_US"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE , ADD ATTRIBUTE attribute_name data_type COLLATE en_US"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT , ADD ATTRIBUTE attribute_name data_type COLLATE en_US"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT , ADD ATTRIBUTE attribute_name data_type COLLATE en_US"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT , ADD ATTRIBUTE attribute_name data_type COLLATE en_US"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT , ADD ATTRIBUTE attribute_name data_type COLLATE en_US"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type , ADD ATTRIBUTE attribute_name data_type CASCADE"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US , ADD ATTRIBUTE attribute_name data_type CASCADE"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type CASCADE , ADD ATTRIBUTE attribute_name data_type CASCADE"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE , ADD ATTRIBUTE attribute_name data_type CASCADE"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type RESTRICT , ADD ATTRIBUTE attribute_name data_type CASCADE"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT , ADD ATTRIBUTE attribute_name data_type CASCADE"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name , ADD ATTRIBUTE attribute_name data_type CASCADE"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name , ADD ATTRIBUTE attribute_name data_type CASCADE"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name CASCADE , ADD ATTRIBUTE attribute_name data_type CASCADE"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name CASCADE , ADD ATTRIBUTE attribute_name data_type CASCADE"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name RESTRICT , ADD ATTRIBUTE attribute_name data_type CASCADE"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT , ADD ATTRIBUTE attribute_name data_type CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type , ADD ATTRIBUTE attribute_name data_type CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type , ADD ATTRIBUTE attribute_name data_type CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US , ADD ATTRIBUTE attribute_name data_type CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US , ADD ATTRIBUTE attribute_name data_type CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE , ADD ATTRIBUTE attribute_name data_type CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE , ADD ATTRIBUTE attribute_name data_type CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE , ADD ATTRIBUTE attribute_name data_type CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE , ADD ATTRIBUTE attribute_name data_type CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT , ADD ATTRIBUTE attribute_name data_type CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT , ADD ATTRIBUTE attribute_name data_type CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT , ADD ATTRIBUTE attribute_name data_type CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT , ADD ATTRIBUTE attribute_name data_type CASCADE"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type , ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US , ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type CASCADE , ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE , ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type RESTRICT , ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT , ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name , ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name , ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name CASCADE , ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name CASCADE , ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name RESTRICT , ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT , ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type , ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type , ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US , ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US , ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE , ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE , ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE , ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE , ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT , ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT , ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT , ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT , ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type , ADD ATTRIBUTE attribute_name data_type RESTRICT"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US , ADD ATTRIBUTE attribute_name data_type RESTRICT"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type CASCADE , ADD ATTRIBUTE attribute_name data_type RESTRICT"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE , ADD ATTRIBUTE attribute_name data_type RESTRICT"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type RESTRICT , ADD ATTRIBUTE attribute_name data_type RESTRICT"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT , ADD ATTRIBUTE attribute_name data_type RESTRICT"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name , ADD ATTRIBUTE attribute_name data_type RESTRICT"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name , ADD ATTRIBUTE attribute_name data_type RESTRICT"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name CASCADE , ADD ATTRIBUTE attribute_name data_type RESTRICT"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name CASCADE , ADD ATTRIBUTE attribute_name data_type RESTRICT"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name RESTRICT , ADD ATTRIBUTE attribute_name data_type RESTRICT"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT , ADD ATTRIBUTE attribute_name data_type RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type , ADD ATTRIBUTE attribute_name data_type RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type , ADD ATTRIBUTE attribute_name data_type RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US , ADD ATTRIBUTE attribute_name data_type RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US , ADD ATTRIBUTE attribute_name data_type RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE , ADD ATTRIBUTE attribute_name data_type RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE , ADD ATTRIBUTE attribute_name data_type RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE , ADD ATTRIBUTE attribute_name data_type RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE , ADD ATTRIBUTE attribute_name data_type RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT , ADD ATTRIBUTE attribute_name data_type RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT , ADD ATTRIBUTE attribute_name data_type RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT , ADD ATTRIBUTE attribute_name data_type RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT , ADD ATTRIBUTE attribute_name data_type RESTRICT"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type , ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US , ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type CASCADE , ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE , ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type RESTRICT , ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT , ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name , ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name , ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name CASCADE , ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name CASCADE , ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name RESTRICT , ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT , ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type , ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type , ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US , ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US , ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE , ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE , ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE , ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE , ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT , ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT , ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT , ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT , ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type , DROP ATTRIBUTE attribute_name"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US , DROP ATTRIBUTE attribute_name"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type CASCADE , DROP ATTRIBUTE attribute_name"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE , DROP ATTRIBUTE attribute_name"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type RESTRICT , DROP ATTRIBUTE attribute_name"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT , DROP ATTRIBUTE attribute_name"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name , DROP ATTRIBUTE attribute_name"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name , DROP ATTRIBUTE attribute_name"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name CASCADE , DROP ATTRIBUTE attribute_name"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name CASCADE , DROP ATTRIBUTE attribute_name"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name RESTRICT , DROP ATTRIBUTE attribute_name"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT , DROP ATTRIBUTE attribute_name"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type , DROP ATTRIBUTE attribute_name"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type , DROP ATTRIBUTE attribute_name"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US , DROP ATTRIBUTE attribute_name"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US , DROP ATTRIBUTE attribute_name"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE , DROP ATTRIBUTE attribute_name"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE , DROP ATTRIBUTE attribute_name"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE , DROP ATTRIBUTE attribute_name"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE , DROP ATTRIBUTE attribute_name"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT , DROP ATTRIBUTE attribute_name"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT , DROP ATTRIBUTE attribute_name"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT , DROP ATTRIBUTE attribute_name"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT , DROP ATTRIBUTE attribute_name"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type , DROP ATTRIBUTE IF EXISTS attribute_name"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US , DROP ATTRIBUTE IF EXISTS attribute_name"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type CASCADE , DROP ATTRIBUTE IF EXISTS attribute_name"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE , DROP ATTRIBUTE IF EXISTS attribute_name"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type RESTRICT , DROP ATTRIBUTE IF EXISTS attribute_name"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT , DROP ATTRIBUTE IF EXISTS attribute_name"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name , DROP ATTRIBUTE IF EXISTS attribute_name"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name , DROP ATTRIBUTE IF EXISTS attribute_name"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name CASCADE , DROP ATTRIBUTE IF EXISTS attribute_name"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name CASCADE , DROP ATTRIBUTE IF EXISTS attribute_name"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name RESTRICT , DROP ATTRIBUTE IF EXISTS attribute_name"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT , DROP ATTRIBUTE IF EXISTS attribute_name"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type , DROP ATTRIBUTE IF EXISTS attribute_name"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type , DROP ATTRIBUTE IF EXISTS attribute_name"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US , DROP ATTRIBUTE IF EXISTS attribute_name"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US , DROP ATTRIBUTE IF EXISTS attribute_name"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE , DROP ATTRIBUTE IF EXISTS attribute_name"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE , DROP ATTRIBUTE IF EXISTS attribute_name"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE , DROP ATTRIBUTE IF EXISTS attribute_name"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE , DROP ATTRIBUTE IF EXISTS attribute_name"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT , DROP ATTRIBUTE IF EXISTS attribute_name"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT , DROP ATTRIBUTE IF EXISTS attribute_name"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT , DROP ATTRIBUTE IF EXISTS attribute_name"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT , DROP ATTRIBUTE IF EXISTS attribute_name"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type , DROP ATTRIBUTE attribute_name CASCADE"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US , DROP ATTRIBUTE attribute_name CASCADE"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type CASCADE , DROP ATTRIBUTE attribute_name CASCADE"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE , DROP ATTRIBUTE attribute_name CASCADE"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type RESTRICT , DROP ATTRIBUTE attribute_name CASCADE"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT , DROP ATTRIBUTE attribute_name CASCADE"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name , DROP ATTRIBUTE attribute_name CASCADE"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name , DROP ATTRIBUTE attribute_name CASCADE"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name CASCADE , DROP ATTRIBUTE attribute_name CASCADE"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name CASCADE , DROP ATTRIBUTE attribute_name CASCADE"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name RESTRICT , DROP ATTRIBUTE attribute_name CASCADE"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT , DROP ATTRIBUTE attribute_name CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type , DROP ATTRIBUTE attribute_name CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type , DROP ATTRIBUTE attribute_name CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US , DROP ATTRIBUTE attribute_name CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US , DROP ATTRIBUTE attribute_name CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE , DROP ATTRIBUTE attribute_name CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE , DROP ATTRIBUTE attribute_name CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE , DROP ATTRIBUTE attribute_name CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE , DROP ATTRIBUTE attribute_name CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT , DROP ATTRIBUTE attribute_name CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT , DROP ATTRIBUTE attribute_name CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT , DROP ATTRIBUTE attribute_name CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT , DROP ATTRIBUTE attribute_name CASCADE"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type , DROP ATTRIBUTE IF EXISTS attribute_name CASCADE"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US , DROP ATTRIBUTE IF EXISTS attribute_name CASCADE"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type CASCADE , DROP ATTRIBUTE IF EXISTS attribute_name CASCADE"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE , DROP ATTRIBUTE IF EXISTS attribute_name CASCADE"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type RESTRICT , DROP ATTRIBUTE IF EXISTS attribute_name CASCADE"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT , DROP ATTRIBUTE IF EXISTS attribute_name CASCADE"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name , DROP ATTRIBUTE IF EXISTS attribute_name CASCADE"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name , DROP ATTRIBUTE IF EXISTS attribute_name CASCADE"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name CASCADE , DROP ATTRIBUTE IF EXISTS attribute_name CASCADE"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name CASCADE , DROP ATTRIBUTE IF EXISTS attribute_name CASCADE"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name RESTRICT , DROP ATTRIBUTE IF EXISTS attribute_name CASCADE"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT , DROP ATTRIBUTE IF EXISTS attribute_name CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type , DROP ATTRIBUTE IF EXISTS attribute_name CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type , DROP ATTRIBUTE IF EXISTS attribute_name CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US , DROP ATTRIBUTE IF EXISTS attribute_name CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US , DROP ATTRIBUTE IF EXISTS attribute_name CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE , DROP ATTRIBUTE IF EXISTS attribute_name CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE , DROP ATTRIBUTE IF EXISTS attribute_name CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE , DROP ATTRIBUTE IF EXISTS attribute_name CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE , DROP ATTRIBUTE IF EXISTS attribute_name CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT , DROP ATTRIBUTE IF EXISTS attribute_name CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT , DROP ATTRIBUTE IF EXISTS attribute_name CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT , DROP ATTRIBUTE IF EXISTS attribute_name CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT , DROP ATTRIBUTE IF EXISTS attribute_name CASCADE"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type , DROP ATTRIBUTE attribute_name RESTRICT"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US , DROP ATTRIBUTE attribute_name RESTRICT"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type CASCADE , DROP ATTRIBUTE attribute_name RESTRICT"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE , DROP ATTRIBUTE attribute_name RESTRICT"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type RESTRICT , DROP ATTRIBUTE attribute_name RESTRICT"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT , DROP ATTRIBUTE attribute_name RESTRICT"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name , DROP ATTRIBUTE attribute_name RESTRICT"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name , DROP ATTRIBUTE attribute_name RESTRICT"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name CASCADE , DROP ATTRIBUTE attribute_name RESTRICT"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name CASCADE , DROP ATTRIBUTE attribute_name RESTRICT"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name RESTRICT , DROP ATTRIBUTE attribute_name RESTRICT"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT , DROP ATTRIBUTE attribute_name RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type , DROP ATTRIBUTE attribute_name RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type , DROP ATTRIBUTE attribute_name RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US , DROP ATTRIBUTE attribute_name RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US , DROP ATTRIBUTE attribute_name RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE , DROP ATTRIBUTE attribute_name RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE , DROP ATTRIBUTE attribute_name RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE , DROP ATTRIBUTE attribute_name RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE , DROP ATTRIBUTE attribute_name RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT , DROP ATTRIBUTE attribute_name RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT , DROP ATTRIBUTE attribute_name RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT , DROP ATTRIBUTE attribute_name RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT , DROP ATTRIBUTE attribute_name RESTRICT"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type , DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US , DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type CASCADE , DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE , DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type RESTRICT , DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT , DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name , DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name , DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name CASCADE , DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name CASCADE , DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name RESTRICT , DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT , DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type , DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type , DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US , DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US , DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE , DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE , DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE , DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE , DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT , DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT , DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT , DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT , DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type , ALTER ATTRIBUTE attribute_name TYPE data_type"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name TYPE data_type"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name , ALTER ATTRIBUTE attribute_name TYPE data_type"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name , ALTER ATTRIBUTE attribute_name TYPE data_type"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type , ALTER ATTRIBUTE attribute_name TYPE data_type"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type , ALTER ATTRIBUTE attribute_name TYPE data_type"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name TYPE data_type"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name TYPE data_type"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type , ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name , ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name , ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type , ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type , ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type , ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name , ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name , ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type , ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type , ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE attribute_name RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name ADD VALUE '1'"),
-		Parses("ALTER TYPE name ADD VALUE IF NOT EXISTS '1'"),
-		Parses("ALTER TYPE name ADD VALUE '1' BEFORE '1'"),
-		Parses("ALTER TYPE name ADD VALUE IF NOT EXISTS '1' BEFORE '1'"),
-		Parses("ALTER TYPE name ADD VALUE '1' AFTER '1'"),
-		Parses("ALTER TYPE name ADD VALUE IF NOT EXISTS '1' AFTER '1'"),
-		Parses("ALTER TYPE name RENAME VALUE '1' TO '1'"),
+		Converts("ALTER TYPE name RENAME TO new_name"),
+		Converts("ALTER TYPE name SET SCHEMA new_schema"),
+		Converts("ALTER TYPE name RENAME ATTRIBUTE attribute_name TO new_attribute_name"),
+		Converts("ALTER TYPE name RENAME ATTRIBUTE attribute_name TO new_attribute_name CASCADE"),
+		Converts("ALTER TYPE name RENAME ATTRIBUTE attribute_name TO new_attribute_name RESTRICT"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type CASCADE"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type RESTRICT"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name CASCADE"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name CASCADE"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name RESTRICT"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type , ADD ATTRIBUTE attribute_name data_type"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US , ADD ATTRIBUTE attribute_name data_type"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type CASCADE , ADD ATTRIBUTE attribute_name data_type"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE , ADD ATTRIBUTE attribute_name data_type"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type RESTRICT , ADD ATTRIBUTE attribute_name data_type"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT , ADD ATTRIBUTE attribute_name data_type"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name , ADD ATTRIBUTE attribute_name data_type"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name , ADD ATTRIBUTE attribute_name data_type"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name CASCADE , ADD ATTRIBUTE attribute_name data_type"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name CASCADE , ADD ATTRIBUTE attribute_name data_type"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name RESTRICT , ADD ATTRIBUTE attribute_name data_type"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT , ADD ATTRIBUTE attribute_name data_type"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type , ADD ATTRIBUTE attribute_name data_type"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type , ADD ATTRIBUTE attribute_name data_type"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US , ADD ATTRIBUTE attribute_name data_type"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US , ADD ATTRIBUTE attribute_name data_type"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE , ADD ATTRIBUTE attribute_name data_type"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE , ADD ATTRIBUTE attribute_name data_type"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE , ADD ATTRIBUTE attribute_name data_type"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE , ADD ATTRIBUTE attribute_name data_type"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT , ADD ATTRIBUTE attribute_name data_type"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT , ADD ATTRIBUTE attribute_name data_type"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT , ADD ATTRIBUTE attribute_name data_type"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT , ADD ATTRIBUTE attribute_name data_type"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type , ADD ATTRIBUTE attribute_name data_type COLLATE en_US"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US , ADD ATTRIBUTE attribute_name data_type COLLATE en_US"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type CASCADE , ADD ATTRIBUTE attribute_name data_type COLLATE en_US"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE , ADD ATTRIBUTE attribute_name data_type COLLATE en_US"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type RESTRICT , ADD ATTRIBUTE attribute_name data_type COLLATE en_US"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT , ADD ATTRIBUTE attribute_name data_type COLLATE en_US"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name , ADD ATTRIBUTE attribute_name data_type COLLATE en_US"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name , ADD ATTRIBUTE attribute_name data_type COLLATE en_US"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name CASCADE , ADD ATTRIBUTE attribute_name data_type COLLATE en_US"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name CASCADE , ADD ATTRIBUTE attribute_name data_type COLLATE en_US"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name RESTRICT , ADD ATTRIBUTE attribute_name data_type COLLATE en_US"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT , ADD ATTRIBUTE attribute_name data_type COLLATE en_US"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type , ADD ATTRIBUTE attribute_name data_type COLLATE en_US"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type , ADD ATTRIBUTE attribute_name data_type COLLATE en_US"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US , ADD ATTRIBUTE attribute_name data_type COLLATE en_US"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US , ADD ATTRIBUTE attribute_name data_type COLLATE en_US"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE , ADD ATTRIBUTE attribute_name data_type COLLATE en_US"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE , ADD ATTRIBUTE attribute_name data_type COLLATE en_US"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE , ADD ATTRIBUTE attribute_name data_type COLLATE en_US"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE , ADD ATTRIBUTE attribute_name data_type COLLATE en_US"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT , ADD ATTRIBUTE attribute_name data_type COLLATE en_US"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT , ADD ATTRIBUTE attribute_name data_type COLLATE en_US"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT , ADD ATTRIBUTE attribute_name data_type COLLATE en_US"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT , ADD ATTRIBUTE attribute_name data_type COLLATE en_US"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type , ADD ATTRIBUTE attribute_name data_type CASCADE"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US , ADD ATTRIBUTE attribute_name data_type CASCADE"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type CASCADE , ADD ATTRIBUTE attribute_name data_type CASCADE"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE , ADD ATTRIBUTE attribute_name data_type CASCADE"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type RESTRICT , ADD ATTRIBUTE attribute_name data_type CASCADE"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT , ADD ATTRIBUTE attribute_name data_type CASCADE"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name , ADD ATTRIBUTE attribute_name data_type CASCADE"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name , ADD ATTRIBUTE attribute_name data_type CASCADE"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name CASCADE , ADD ATTRIBUTE attribute_name data_type CASCADE"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name CASCADE , ADD ATTRIBUTE attribute_name data_type CASCADE"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name RESTRICT , ADD ATTRIBUTE attribute_name data_type CASCADE"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT , ADD ATTRIBUTE attribute_name data_type CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type , ADD ATTRIBUTE attribute_name data_type CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type , ADD ATTRIBUTE attribute_name data_type CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US , ADD ATTRIBUTE attribute_name data_type CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US , ADD ATTRIBUTE attribute_name data_type CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE , ADD ATTRIBUTE attribute_name data_type CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE , ADD ATTRIBUTE attribute_name data_type CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE , ADD ATTRIBUTE attribute_name data_type CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE , ADD ATTRIBUTE attribute_name data_type CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT , ADD ATTRIBUTE attribute_name data_type CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT , ADD ATTRIBUTE attribute_name data_type CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT , ADD ATTRIBUTE attribute_name data_type CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT , ADD ATTRIBUTE attribute_name data_type CASCADE"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type , ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US , ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type CASCADE , ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE , ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type RESTRICT , ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT , ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name , ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name , ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name CASCADE , ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name CASCADE , ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name RESTRICT , ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT , ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type , ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type , ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US , ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US , ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE , ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE , ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE , ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE , ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT , ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT , ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT , ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT , ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type , ADD ATTRIBUTE attribute_name data_type RESTRICT"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US , ADD ATTRIBUTE attribute_name data_type RESTRICT"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type CASCADE , ADD ATTRIBUTE attribute_name data_type RESTRICT"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE , ADD ATTRIBUTE attribute_name data_type RESTRICT"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type RESTRICT , ADD ATTRIBUTE attribute_name data_type RESTRICT"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT , ADD ATTRIBUTE attribute_name data_type RESTRICT"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name , ADD ATTRIBUTE attribute_name data_type RESTRICT"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name , ADD ATTRIBUTE attribute_name data_type RESTRICT"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name CASCADE , ADD ATTRIBUTE attribute_name data_type RESTRICT"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name CASCADE , ADD ATTRIBUTE attribute_name data_type RESTRICT"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name RESTRICT , ADD ATTRIBUTE attribute_name data_type RESTRICT"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT , ADD ATTRIBUTE attribute_name data_type RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type , ADD ATTRIBUTE attribute_name data_type RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type , ADD ATTRIBUTE attribute_name data_type RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US , ADD ATTRIBUTE attribute_name data_type RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US , ADD ATTRIBUTE attribute_name data_type RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE , ADD ATTRIBUTE attribute_name data_type RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE , ADD ATTRIBUTE attribute_name data_type RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE , ADD ATTRIBUTE attribute_name data_type RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE , ADD ATTRIBUTE attribute_name data_type RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT , ADD ATTRIBUTE attribute_name data_type RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT , ADD ATTRIBUTE attribute_name data_type RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT , ADD ATTRIBUTE attribute_name data_type RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT , ADD ATTRIBUTE attribute_name data_type RESTRICT"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type , ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US , ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type CASCADE , ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE , ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type RESTRICT , ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT , ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name , ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name , ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name CASCADE , ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name CASCADE , ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name RESTRICT , ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT , ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type , ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type , ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US , ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US , ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE , ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE , ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE , ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE , ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT , ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT , ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT , ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT , ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type , DROP ATTRIBUTE attribute_name"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US , DROP ATTRIBUTE attribute_name"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type CASCADE , DROP ATTRIBUTE attribute_name"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE , DROP ATTRIBUTE attribute_name"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type RESTRICT , DROP ATTRIBUTE attribute_name"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT , DROP ATTRIBUTE attribute_name"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name , DROP ATTRIBUTE attribute_name"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name , DROP ATTRIBUTE attribute_name"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name CASCADE , DROP ATTRIBUTE attribute_name"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name CASCADE , DROP ATTRIBUTE attribute_name"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name RESTRICT , DROP ATTRIBUTE attribute_name"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT , DROP ATTRIBUTE attribute_name"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type , DROP ATTRIBUTE attribute_name"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type , DROP ATTRIBUTE attribute_name"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US , DROP ATTRIBUTE attribute_name"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US , DROP ATTRIBUTE attribute_name"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE , DROP ATTRIBUTE attribute_name"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE , DROP ATTRIBUTE attribute_name"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE , DROP ATTRIBUTE attribute_name"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE , DROP ATTRIBUTE attribute_name"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT , DROP ATTRIBUTE attribute_name"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT , DROP ATTRIBUTE attribute_name"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT , DROP ATTRIBUTE attribute_name"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT , DROP ATTRIBUTE attribute_name"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type , DROP ATTRIBUTE IF EXISTS attribute_name"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US , DROP ATTRIBUTE IF EXISTS attribute_name"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type CASCADE , DROP ATTRIBUTE IF EXISTS attribute_name"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE , DROP ATTRIBUTE IF EXISTS attribute_name"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type RESTRICT , DROP ATTRIBUTE IF EXISTS attribute_name"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT , DROP ATTRIBUTE IF EXISTS attribute_name"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name , DROP ATTRIBUTE IF EXISTS attribute_name"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name , DROP ATTRIBUTE IF EXISTS attribute_name"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name CASCADE , DROP ATTRIBUTE IF EXISTS attribute_name"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name CASCADE , DROP ATTRIBUTE IF EXISTS attribute_name"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name RESTRICT , DROP ATTRIBUTE IF EXISTS attribute_name"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT , DROP ATTRIBUTE IF EXISTS attribute_name"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type , DROP ATTRIBUTE IF EXISTS attribute_name"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type , DROP ATTRIBUTE IF EXISTS attribute_name"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US , DROP ATTRIBUTE IF EXISTS attribute_name"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US , DROP ATTRIBUTE IF EXISTS attribute_name"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE , DROP ATTRIBUTE IF EXISTS attribute_name"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE , DROP ATTRIBUTE IF EXISTS attribute_name"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE , DROP ATTRIBUTE IF EXISTS attribute_name"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE , DROP ATTRIBUTE IF EXISTS attribute_name"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT , DROP ATTRIBUTE IF EXISTS attribute_name"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT , DROP ATTRIBUTE IF EXISTS attribute_name"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT , DROP ATTRIBUTE IF EXISTS attribute_name"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT , DROP ATTRIBUTE IF EXISTS attribute_name"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type , DROP ATTRIBUTE attribute_name CASCADE"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US , DROP ATTRIBUTE attribute_name CASCADE"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type CASCADE , DROP ATTRIBUTE attribute_name CASCADE"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE , DROP ATTRIBUTE attribute_name CASCADE"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type RESTRICT , DROP ATTRIBUTE attribute_name CASCADE"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT , DROP ATTRIBUTE attribute_name CASCADE"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name , DROP ATTRIBUTE attribute_name CASCADE"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name , DROP ATTRIBUTE attribute_name CASCADE"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name CASCADE , DROP ATTRIBUTE attribute_name CASCADE"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name CASCADE , DROP ATTRIBUTE attribute_name CASCADE"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name RESTRICT , DROP ATTRIBUTE attribute_name CASCADE"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT , DROP ATTRIBUTE attribute_name CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type , DROP ATTRIBUTE attribute_name CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type , DROP ATTRIBUTE attribute_name CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US , DROP ATTRIBUTE attribute_name CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US , DROP ATTRIBUTE attribute_name CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE , DROP ATTRIBUTE attribute_name CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE , DROP ATTRIBUTE attribute_name CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE , DROP ATTRIBUTE attribute_name CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE , DROP ATTRIBUTE attribute_name CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT , DROP ATTRIBUTE attribute_name CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT , DROP ATTRIBUTE attribute_name CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT , DROP ATTRIBUTE attribute_name CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT , DROP ATTRIBUTE attribute_name CASCADE"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type , DROP ATTRIBUTE IF EXISTS attribute_name CASCADE"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US , DROP ATTRIBUTE IF EXISTS attribute_name CASCADE"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type CASCADE , DROP ATTRIBUTE IF EXISTS attribute_name CASCADE"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE , DROP ATTRIBUTE IF EXISTS attribute_name CASCADE"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type RESTRICT , DROP ATTRIBUTE IF EXISTS attribute_name CASCADE"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT , DROP ATTRIBUTE IF EXISTS attribute_name CASCADE"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name , DROP ATTRIBUTE IF EXISTS attribute_name CASCADE"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name , DROP ATTRIBUTE IF EXISTS attribute_name CASCADE"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name CASCADE , DROP ATTRIBUTE IF EXISTS attribute_name CASCADE"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name CASCADE , DROP ATTRIBUTE IF EXISTS attribute_name CASCADE"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name RESTRICT , DROP ATTRIBUTE IF EXISTS attribute_name CASCADE"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT , DROP ATTRIBUTE IF EXISTS attribute_name CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type , DROP ATTRIBUTE IF EXISTS attribute_name CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type , DROP ATTRIBUTE IF EXISTS attribute_name CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US , DROP ATTRIBUTE IF EXISTS attribute_name CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US , DROP ATTRIBUTE IF EXISTS attribute_name CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE , DROP ATTRIBUTE IF EXISTS attribute_name CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE , DROP ATTRIBUTE IF EXISTS attribute_name CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE , DROP ATTRIBUTE IF EXISTS attribute_name CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE , DROP ATTRIBUTE IF EXISTS attribute_name CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT , DROP ATTRIBUTE IF EXISTS attribute_name CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT , DROP ATTRIBUTE IF EXISTS attribute_name CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT , DROP ATTRIBUTE IF EXISTS attribute_name CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT , DROP ATTRIBUTE IF EXISTS attribute_name CASCADE"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type , DROP ATTRIBUTE attribute_name RESTRICT"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US , DROP ATTRIBUTE attribute_name RESTRICT"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type CASCADE , DROP ATTRIBUTE attribute_name RESTRICT"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE , DROP ATTRIBUTE attribute_name RESTRICT"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type RESTRICT , DROP ATTRIBUTE attribute_name RESTRICT"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT , DROP ATTRIBUTE attribute_name RESTRICT"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name , DROP ATTRIBUTE attribute_name RESTRICT"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name , DROP ATTRIBUTE attribute_name RESTRICT"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name CASCADE , DROP ATTRIBUTE attribute_name RESTRICT"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name CASCADE , DROP ATTRIBUTE attribute_name RESTRICT"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name RESTRICT , DROP ATTRIBUTE attribute_name RESTRICT"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT , DROP ATTRIBUTE attribute_name RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type , DROP ATTRIBUTE attribute_name RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type , DROP ATTRIBUTE attribute_name RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US , DROP ATTRIBUTE attribute_name RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US , DROP ATTRIBUTE attribute_name RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE , DROP ATTRIBUTE attribute_name RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE , DROP ATTRIBUTE attribute_name RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE , DROP ATTRIBUTE attribute_name RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE , DROP ATTRIBUTE attribute_name RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT , DROP ATTRIBUTE attribute_name RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT , DROP ATTRIBUTE attribute_name RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT , DROP ATTRIBUTE attribute_name RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT , DROP ATTRIBUTE attribute_name RESTRICT"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type , DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US , DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type CASCADE , DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE , DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type RESTRICT , DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT , DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name , DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name , DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name CASCADE , DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name CASCADE , DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name RESTRICT , DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT , DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type , DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type , DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US , DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US , DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE , DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE , DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE , DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE , DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT , DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT , DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT , DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT , DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type , ALTER ATTRIBUTE attribute_name TYPE data_type"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name TYPE data_type"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name , ALTER ATTRIBUTE attribute_name TYPE data_type"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name , ALTER ATTRIBUTE attribute_name TYPE data_type"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type , ALTER ATTRIBUTE attribute_name TYPE data_type"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type , ALTER ATTRIBUTE attribute_name TYPE data_type"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name TYPE data_type"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name TYPE data_type"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type , ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name , ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name , ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type , ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type , ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type , ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name , ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name , ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type , ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type , ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name ADD ATTRIBUTE attribute_name data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE attribute_name RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name DROP ATTRIBUTE IF EXISTS attribute_name RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US CASCADE , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT"),
+		Converts("ALTER TYPE name ADD VALUE '1'"),
+		Converts("ALTER TYPE name ADD VALUE IF NOT EXISTS '1'"),
+		Converts("ALTER TYPE name ADD VALUE '1' BEFORE '1'"),
+		Converts("ALTER TYPE name ADD VALUE IF NOT EXISTS '1' BEFORE '1'"),
+		Converts("ALTER TYPE name ADD VALUE '1' AFTER '1'"),
+		Converts("ALTER TYPE name ADD VALUE IF NOT EXISTS '1' AFTER '1'"),
+		Converts("ALTER TYPE name RENAME VALUE '1' TO '1'"),
 		Parses("ALTER TYPE name SET ( RECEIVE = receive_function )"),
 		Parses("ALTER TYPE name SET ( SEND = send_function )"),
 		Parses("ALTER TYPE name SET ( TYPMOD_IN = type_modifier_input_function )"),
@@ -0,0 +1,258 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestAlterDomain(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "SET and DROP DEFAULT",
+			SetUpScript: []string{
+				`CREATE DOMAIN year AS integer;`,
+				`CREATE TABLE events (pk int primary key, y year);`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query: `ALTER DOMAIN year SET DEFAULT 2000;`,
+				},
+				{
+					Query: `INSERT INTO events (pk) VALUES (1);`,
+				},
+				{
+					Query: `ALTER DOMAIN year DROP DEFAULT;`,
+				},
+				{
+					Query: `INSERT INTO events (pk) VALUES (2);`,
+				},
+				{
+					Query:    `SELECT * FROM events ORDER BY pk;`,
+					Expected: []sql.Row{{1, 2000}, {2, nil}},
+				},
+			},
+		},
+		{
+			Name: "SET and DROP NOT NULL",
+			SetUpScript: []string{
+				`CREATE DOMAIN year AS integer;`,
+				`CREATE TABLE events (pk int primary key, y year);`,
+				`INSERT INTO events VALUES (1, 1999), (2, NULL);`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       `ALTER DOMAIN year SET NOT NULL;`,
+					ExpectedErr: `column "y" of table "events" contains null values`,
+				},
+				{
+					Query: `DELETE FROM events WHERE pk = 2;`,
+				},
+				{
+					Query: `ALTER DOMAIN year SET NOT NULL;`,
+				},
+				{
+					Query:       `INSERT INTO events VALUES (2, NULL);`,
+					ExpectedErr: `non-nullable`,
+				},
+				{
+					Query: `ALTER DOMAIN year DROP NOT NULL;`,
+				},
+				{
+					Query: `INSERT INTO events VALUES (2, NULL);`,
+				},
+				{
+					Query:    `SELECT * FROM events ORDER BY pk;`,
+					Expected: []sql.Row{{1, 1999}, {2, nil}},
+				},
+			},
+		},
+		{
+			Name: "ADD CONSTRAINT validates existing rows",
+			SetUpScript: []string{
+				`CREATE DOMAIN year AS integer;`,
+				`CREATE TABLE events (pk int primary key, y year);`,
+				`CREATE TABLE archive (pk int primary key, y year);`,
+				`INSERT INTO events VALUES (1, 1999);`,
+				`INSERT INTO archive VALUES (1, 1850);`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       `ALTER DOMAIN year ADD CONSTRAINT year_check_min CHECK (VALUE >= 1901);`,
+					ExpectedErr: `column "y" of table "archive" contains values that violate the new constraint`,
+				},
+				{
+					Query: `DELETE FROM archive;`,
+				},
+				{
+					Query: `ALTER DOMAIN year ADD CONSTRAINT year_check_min CHECK (VALUE >= 1901);`,
+				},
+				{
+					Query:       `ALTER DOMAIN year ADD CONSTRAINT year_check_min CHECK (VALUE <= 2155);`,
+					ExpectedErr: `constraint "year_check_min" for domain "year" already exists`,
+				},
+				{
+					Query: `ALTER DOMAIN year ADD CHECK (VALUE <= 2155);`,
+				},
+				{
+					Query:    `SELECT conname, convalidated FROM pg_constraint WHERE conname LIKE 'year%' ORDER BY conname;`,
+					Expected: []sql.Row{{"year_check", "t"}, {"year_check_min", "t"}},
+				},
+				{
+					Query:       `INSERT INTO archive VALUES (2, 1850);`,
+					ExpectedErr: `value for domain year violates check constraint "year_check_min"`,
+				},
+				{
+					Query:       `INSERT INTO events VALUES (2, 2200);`,
+					ExpectedErr: `value for domain year violates check constraint "year_check"`,
+				},
+				{
+					Query: `INSERT INTO events VALUES (2, 2024);`,
+				},
+				{
+					Query:       `ALTER DOMAIN year ADD CONSTRAINT year_null NULL;`,
+					ExpectedErr: `only CHECK and NOT NULL constraints may be added to a domain`,
+				},
+			},
+		},
+		{
+			Name: "NOT VALID and VALIDATE CONSTRAINT",
+			SetUpScript: []string{
+				`CREATE DOMAIN year AS integer;`,
+				`CREATE TABLE events (pk int primary key, y year);`,
+				`INSERT INTO events VALUES (1, 1850), (2, 1999);`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query: `ALTER DOMAIN year ADD CONSTRAINT year_check_min CHECK (VALUE >= 1901) NOT VALID;`,
+				},
+				{
+					Query:    `SELECT conname, convalidated FROM pg_constraint WHERE conname LIKE 'year%';`,
+					Expected: []sql.Row{{"year_check_min", "f"}},
+				},
+				{
+					Query:       `INSERT INTO events VALUES (3, 1850);`,
+					ExpectedErr: `value for domain year violates check constraint "year_check_min"`,
+				},
+				{
+					Query:       `ALTER DOMAIN year VALIDATE CONSTRAINT year_check_min;`,
+					ExpectedErr: `column "y" of table "events" contains values that violate the new constraint`,
+				},
+				{
+					Query: `UPDATE events SET y = 1950 WHERE pk = 1;`,
+				},
+				{
+					Query: `ALTER DOMAIN year VALIDATE CONSTRAINT year_check_min;`,
+				},
+				{
+					Query:    `SELECT conname, convalidated FROM pg_constraint WHERE conname LIKE 'year%';`,
+					Expected: []sql.Row{{"year_check_min", "t"}},
+				},
+				{
+					Query:       `ALTER DOMAIN year VALIDATE CONSTRAINT missing;`,
+					ExpectedErr: `constraint "missing" of domain "year" does not exist`,
+				},
+			},
+		},
+		{
+			Name: "DROP and RENAME CONSTRAINT",
+			SetUpScript: []string{
+				`CREATE DOMAIN year AS integer CONSTRAINT year_check_min CHECK (VALUE >= 1901) CONSTRAINT year_check_max CHECK (VALUE <= 2155);`,
+				`CREATE TABLE events (pk int primary key, y year);`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query: `ALTER DOMAIN year DROP CONSTRAINT year_check_min;`,
+				},
+				{
+					Query: `INSERT INTO events VALUES (1, 1850);`,
+				},
+				{
+					Query:       `ALTER DOMAIN year DROP CONSTRAINT year_check_min;`,
+					ExpectedErr: `constraint "year_check_min" of domain "year" does not exist`,
+				},
+				{
+					Query: `ALTER DOMAIN year DROP CONSTRAINT IF EXISTS year_check_min;`,
+					ExpectedNotices: []ExpectedNotice{
+						{
+							Severity: "NOTICE",
+							Message:  `constraint "year_check_min" of domain "year" does not exist, skipping`,
+						},
+					},
+				},
+				{
+					Query: `ALTER DOMAIN year RENAME CONSTRAINT year_check_max TO year_upper_bound;`,
+				},
+				{
+					Query:       `INSERT INTO events VALUES (2, 2200);`,
+					ExpectedErr: `value for domain year violates check constraint "year_upper_bound"`,
+				},
+				{
+					Query:    `SELECT conname FROM pg_constraint WHERE conname LIKE 'year%';`,
+					Expected: []sql.Row{{"year_upper_bound"}},
+				},
+			},
+		},
+		{
+			Name: "RENAME TO and SET SCHEMA",
+			SetUpScript: []string{
+				`CREATE SCHEMA archive;`,
+				`CREATE DOMAIN year AS integer CHECK (VALUE >= 1901);`,
+				`CREATE TABLE events (pk int primary key, y year);`,
+				`INSERT INTO events VALUES (1, 1999);`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query: `ALTER DOMAIN year RENAME TO event_year;`,
+				},
+				{
+					Query:       `ALTER DOMAIN year SET NOT NULL;`,
+					ExpectedErr: `does not exist`,
+				},
+				{
+					Query: `ALTER DOMAIN event_year SET SCHEMA archive;`,
+				},
+				{
+					Query: `ALTER DOMAIN archive.event_year SET DEFAULT 2000;`,
+				},
+				{
+					Query: `INSERT INTO events (pk) VALUES (2);`,
+				},
+				{
+					Query:       `INSERT INTO events VALUES (3, 1850);`,
+					ExpectedErr: `violates check constraint`,
+				},
+				{
+					Query:    `SELECT * FROM events ORDER BY pk;`,
+					Expected: []sql.Row{{1, 1999}, {2, 2000}},
+				},
+			},
+		},
+		{
+			Name: "not a domain",
+			SetUpScript: []string{
+				`CREATE TYPE mood AS ENUM ('happy', 'sad');`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       `ALTER DOMAIN mood SET NOT NULL;`,
+					ExpectedErr: `mood is not a domain`,
+				},
+			},
+		},
+	})
+}
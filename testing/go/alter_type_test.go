@@ -0,0 +1,399 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestAlterType(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "ADD VALUE",
+			SetUpScript: []string{
+				`CREATE TYPE status AS ENUM ('active', 'deleted');`,
+				`CREATE TABLE items (pk INT4 PRIMARY KEY, s status);`,
+				`INSERT INTO items VALUES (1, 'active'), (2, 'deleted');`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       `INSERT INTO items VALUES (3, 'archived');`,
+					ExpectedErr: `invalid input value for enum status: "archived"`,
+				},
+				{
+					Query:    `ALTER TYPE status ADD VALUE 'archived' BEFORE 'deleted';`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `ALTER TYPE status ADD VALUE 'pending' BEFORE 'active';`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `ALTER TYPE status ADD VALUE 'purged';`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `ALTER TYPE status ADD VALUE 'suspended' AFTER 'active';`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query: `SELECT e.enumlabel FROM pg_catalog.pg_enum e JOIN pg_catalog.pg_type t ON e.enumtypid = t.oid WHERE t.typname = 'status' ORDER BY e.enumsortorder;`,
+					Expected: []sql.Row{
+						{"pending"},
+						{"active"},
+						{"suspended"},
+						{"archived"},
+						{"deleted"},
+						{"purged"},
+					},
+				},
+				{
+					Query:    `INSERT INTO items VALUES (3, 'archived'), (4, 'pending'), (5, 'suspended');`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SELECT * FROM items ORDER BY s;`,
+					Expected: []sql.Row{{4, "pending"}, {1, "active"}, {5, "suspended"}, {3, "archived"}, {2, "deleted"}},
+				},
+				{
+					Query:       `ALTER TYPE status ADD VALUE 'active';`,
+					ExpectedErr: `enum label "active" already exists`,
+				},
+				{
+					Query: `ALTER TYPE status ADD VALUE IF NOT EXISTS 'active';`,
+					ExpectedNotices: []ExpectedNotice{
+						{
+							Severity: "NOTICE",
+							Message:  `enum label "active" already exists, skipping`,
+						},
+					},
+				},
+				{
+					Query:       `ALTER TYPE status ADD VALUE 'restored' AFTER 'missing';`,
+					ExpectedErr: `"missing" is not an existing enum label`,
+				},
+				{
+					Query:       `ALTER TYPE does_not_exist ADD VALUE 'a';`,
+					ExpectedErr: `type "does_not_exist" does not exist`,
+				},
+				{
+					Query:       `ALTER TYPE _status ADD VALUE 'a';`,
+					ExpectedErr: `cannot alter array type status[]`,
+				},
+			},
+		},
+		{
+			Name: "RENAME VALUE",
+			SetUpScript: []string{
+				`CREATE TYPE mood AS ENUM ('sad', 'ok', 'happy');`,
+				`CREATE TABLE person (name TEXT PRIMARY KEY, m mood);`,
+				`INSERT INTO person VALUES ('Moe', 'happy'), ('Larry', 'sad');`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `ALTER TYPE mood RENAME VALUE 'happy' TO 'joyful';`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SELECT * FROM person ORDER BY m;`,
+					Expected: []sql.Row{{"Larry", "sad"}, {"Moe", "joyful"}},
+				},
+				{
+					Query:       `INSERT INTO person VALUES ('Curly', 'happy');`,
+					ExpectedErr: `invalid input value for enum mood: "happy"`,
+				},
+				{
+					Query:    `INSERT INTO person VALUES ('Curly', 'joyful');`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       `ALTER TYPE mood RENAME VALUE 'happy' TO 'glad';`,
+					ExpectedErr: `"happy" is not an existing enum label`,
+				},
+				{
+					Query:       `ALTER TYPE mood RENAME VALUE 'sad' TO 'ok';`,
+					ExpectedErr: `enum label "ok" already exists`,
+				},
+			},
+		},
+		{
+			Name: "RENAME TO and SET SCHEMA",
+			SetUpScript: []string{
+				`CREATE SCHEMA other;`,
+				`CREATE TYPE mood AS ENUM ('sad', 'happy');`,
+				`CREATE TYPE pair AS (a INT4, m mood);`,
+				`CREATE TABLE t1 (pk INT4 PRIMARY KEY, m mood, ms mood[], p pair);`,
+				`INSERT INTO t1 VALUES (1, 'happy', ARRAY['sad', 'happy']::mood[], ROW(1, 'sad')::pair);`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `ALTER TYPE mood RENAME TO feeling;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SELECT * FROM t1;`,
+					Expected: []sql.Row{{1, "happy", "{sad,happy}", "(1,sad)"}},
+				},
+				{
+					Query:    `SELECT 'sad'::feeling;`,
+					Expected: []sql.Row{{"sad"}},
+				},
+				{
+					Query:       `SELECT 'sad'::mood;`,
+					ExpectedErr: `type "mood" does not exist`,
+				},
+				{
+					Query:    `SELECT udt_name FROM information_schema.columns WHERE table_name = 't1' AND column_name = 'm';`,
+					Expected: []sql.Row{{"feeling"}},
+				},
+				{
+					Query:    `INSERT INTO t1 VALUES (2, 'sad', ARRAY['happy']::feeling[], ROW(2, 'happy')::pair);`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `ALTER TYPE feeling SET SCHEMA other;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SELECT * FROM t1 ORDER BY pk;`,
+					Expected: []sql.Row{{1, "happy", "{sad,happy}", "(1,sad)"}, {2, "sad", "{happy}", "(2,happy)"}},
+				},
+				{
+					Query:    `SELECT n.nspname FROM pg_catalog.pg_type t JOIN pg_catalog.pg_namespace n ON t.typnamespace = n.oid WHERE t.typname = 'feeling';`,
+					Expected: []sql.Row{{"other"}},
+				},
+				{
+					Query:       `ALTER TYPE other.feeling SET SCHEMA other;`,
+					ExpectedErr: `type feeling is already in schema "other"`,
+				},
+				{
+					Query:       `ALTER TYPE other.feeling SET SCHEMA missing;`,
+					ExpectedErr: `schema "missing" does not exist`,
+				},
+				{
+					Query:       `ALTER TYPE pair RENAME TO pair;`,
+					ExpectedErr: `type "pair" already exists`,
+				},
+			},
+		},
+		{
+			Name: "RENAME TO with a dependent function",
+			SetUpScript: []string{
+				`CREATE TYPE mood AS ENUM ('sad', 'happy');`,
+				`CREATE FUNCTION mood_text(m mood) RETURNS TEXT AS $$ BEGIN RETURN m::text; END; $$ LANGUAGE plpgsql;`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       `ALTER TYPE mood RENAME TO feeling;`,
+					ExpectedErr: `cannot alter type mood because function mood_text(mood) depends on it`,
+				},
+			},
+		},
+		{
+			Name: "composite attributes",
+			SetUpScript: []string{
+				`CREATE TYPE address AS (street TEXT, city TEXT);`,
+				`CREATE TYPE unused AS (a INT4);`,
+				`CREATE TABLE customers (pk INT4 PRIMARY KEY, addr address);`,
+				`INSERT INTO customers VALUES (1, ROW('Main St', 'Springfield')::address);`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `ALTER TYPE unused ADD ATTRIBUTE b TEXT, DROP ATTRIBUTE a;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SELECT ROW('x')::unused;`,
+					Expected: []sql.Row{{"(x)"}},
+				},
+				{
+					Query:       `ALTER TYPE address ADD ATTRIBUTE zip TEXT;`,
+					ExpectedErr: `cannot alter type "address" because column "customers.addr" uses it`,
+				},
+				{
+					Query:    `ALTER TYPE address ADD ATTRIBUTE zip TEXT CASCADE;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SELECT * FROM customers;`,
+					Expected: []sql.Row{{1, "(\"Main St\",Springfield,)"}},
+				},
+				{
+					Query:    `INSERT INTO customers VALUES (2, ROW('Elm St', 'Shelbyville', '12345')::address);`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `ALTER TYPE address RENAME ATTRIBUTE street TO line1;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SELECT (addr).line1 FROM customers ORDER BY pk;`,
+					Expected: []sql.Row{{"Main St"}, {"Elm St"}},
+				},
+				{
+					Query:    `ALTER TYPE address DROP ATTRIBUTE city CASCADE;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SELECT * FROM customers ORDER BY pk;`,
+					Expected: []sql.Row{{1, "(\"Main St\",)"}, {2, "(\"Elm St\",12345)"}},
+				},
+				{
+					Query:    `ALTER TYPE address ALTER ATTRIBUTE zip TYPE INT4 CASCADE;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SELECT (addr).zip + 1 FROM customers WHERE pk = 2;`,
+					Expected: []sql.Row{{12346}},
+				},
+				{
+					Query: `ALTER TYPE address DROP ATTRIBUTE IF EXISTS city CASCADE;`,
+					ExpectedNotices: []ExpectedNotice{
+						{
+							Severity: "NOTICE",
+							Message:  `column "city" of relation "address" does not exist, skipping`,
+						},
+					},
+				},
+				{
+					Query:       `ALTER TYPE address DROP ATTRIBUTE city;`,
+					ExpectedErr: `column "city" of relation "address" does not exist`,
+				},
+				{
+					Query:       `ALTER TYPE address ADD ATTRIBUTE zip TEXT;`,
+					ExpectedErr: `column "zip" of relation "address" already exists`,
+				},
+				{
+					Query:       `ALTER TYPE address ADD VALUE 'a';`,
+					ExpectedErr: `address is not an enum`,
+				},
+				{
+					Query:       `ALTER TYPE customers ADD ATTRIBUTE a INT4;`,
+					ExpectedErr: `customers is a table's row type`,
+				},
+			},
+		},
+		{
+			Name: "merging enum labels added on both branches",
+			SetUpScript: []string{
+				`CREATE TYPE status AS ENUM ('active', 'deleted');`,
+				`SELECT dolt_add('.');`,
+				`SELECT dolt_commit('-m', 'initial');`,
+				`SELECT dolt_checkout('-b', 'other');`,
+				`ALTER TYPE status ADD VALUE 'archived' BEFORE 'deleted';`,
+				`ALTER TYPE status RENAME VALUE 'active' TO 'live';`,
+				`SELECT dolt_add('.');`,
+				`SELECT dolt_commit('-m', 'other');`,
+				`SELECT dolt_checkout('main');`,
+				`ALTER TYPE status ADD VALUE 'pending' BEFORE 'active';`,
+				`ALTER TYPE status ADD VALUE 'purged';`,
+				`SELECT dolt_add('.');`,
+				`SELECT dolt_commit('-m', 'main');`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "SELECT length(dolt_merge('other')::text) = 57;",
+					Expected: []sql.Row{{"t"}},
+				},
+				{
+					Query: `SELECT e.enumlabel FROM pg_catalog.pg_enum e JOIN pg_catalog.pg_type t ON e.enumtypid = t.oid WHERE t.typname = 'status' ORDER BY e.enumsortorder;`,
+					Expected: []sql.Row{
+						{"pending"},
+						{"live"},
+						{"archived"},
+						{"deleted"},
+						{"purged"},
+					},
+				},
+			},
+		},
+		{
+			Name: "merging a renamed enum label with unchanged rows",
+			SetUpScript: []string{
+				`CREATE TYPE mood AS ENUM ('sad', 'ok', 'happy');`,
+				`CREATE TABLE feelings (pk INT4 PRIMARY KEY, m mood);`,
+				`INSERT INTO feelings VALUES (1, 'sad'), (2, 'happy');`,
+				`SELECT dolt_add('.');`,
+				`SELECT dolt_commit('-m', 'initial');`,
+				`SELECT dolt_checkout('-b', 'other');`,
+				`ALTER TYPE mood RENAME VALUE 'sad' TO 'unhappy';`,
+				`SELECT dolt_add('.');`,
+				`SELECT dolt_commit('-m', 'other');`,
+				`SELECT dolt_checkout('main');`,
+				`CREATE TABLE unrelated (pk INT4 PRIMARY KEY);`,
+				`SELECT dolt_add('.');`,
+				`SELECT dolt_commit('-m', 'main');`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "SELECT strpos(dolt_merge('other')::text, 'merge successful') > 0;",
+					Expected: []sql.Row{{"t"}},
+				},
+				{
+					Query: `SELECT * FROM feelings ORDER BY pk;`,
+					Expected: []sql.Row{
+						{1, "unhappy"},
+						{2, "happy"},
+					},
+				},
+				{
+					Query:    `SELECT * FROM feelings WHERE m = 'unhappy';`,
+					Expected: []sql.Row{{1, "unhappy"}},
+				},
+			},
+		},
+		{
+			Name: "merging a renamed enum label with rows using the old label",
+			SetUpScript: []string{
+				`CREATE TYPE mood AS ENUM ('sad', 'ok', 'happy');`,
+				`CREATE TABLE feelings (pk INT4 PRIMARY KEY, m mood);`,
+				`INSERT INTO feelings VALUES (1, 'sad');`,
+				`SELECT dolt_add('.');`,
+				`SELECT dolt_commit('-m', 'initial');`,
+				`SELECT dolt_checkout('-b', 'other');`,
+				`ALTER TYPE mood RENAME VALUE 'sad' TO 'unhappy';`,
+				`SELECT dolt_add('.');`,
+				`SELECT dolt_commit('-m', 'other');`,
+				`SELECT dolt_checkout('main');`,
+				`INSERT INTO feelings VALUES (2, 'sad');`,
+				`SELECT dolt_add('.');`,
+				`SELECT dolt_commit('-m', 'main');`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       "SELECT dolt_merge('other');",
+					ExpectedErr: `label "sad" was renamed to "unhappy" while table "feelings" was changed on the other side`,
+				},
+				{
+					Query: `SELECT * FROM feelings ORDER BY pk;`,
+					Expected: []sql.Row{
+						{1, "sad"},
+						{2, "sad"},
+					},
+				},
+				{
+					Query:    "SELECT dolt_checkout('other');",
+					Expected: []sql.Row{{[]any{int64(0), "Switched to branch 'other'"}}},
+				},
+				{
+					Query:    `SELECT * FROM feelings ORDER BY pk;`,
+					Expected: []sql.Row{{1, "unhappy"}},
+				},
+			},
+		},
+	})
+}
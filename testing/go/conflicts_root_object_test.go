@@ -1188,3 +1188,276 @@ func TestConflictsRootObject(t *testing.T) {
 		},
 	})
 }
+
+func TestRootObjectConflictsResolve(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "View function conflict definitions and resolve with theirs",
+			SetUpScript: []string{
+				`CREATE FUNCTION interpreted_example(input TEXT) RETURNS TEXT AS $$ BEGIN RETURN '1' || input; END; $$ LANGUAGE plpgsql;`,
+				`SELECT dolt_commit('-Am', 'initial');`,
+				`SELECT dolt_checkout('-b', 'other');`,
+				`CREATE OR REPLACE FUNCTION interpreted_example(input TEXT) RETURNS TEXT AS $$ BEGIN RETURN '3' || input; END; $$ LANGUAGE plpgsql;`,
+				`SELECT dolt_commit('-Am', 'other');`,
+				`SELECT dolt_checkout('main');`,
+				`CREATE OR REPLACE FUNCTION interpreted_example(input TEXT) RETURNS TEXT AS $$ BEGIN RETURN '2' || input; END; $$ LANGUAGE plpgsql;`,
+				`SELECT dolt_commit('-Am', 'next');`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query: `SELECT dolt_merge('other');`,
+					Expected: []sql.Row{
+						{[]any{"", int64(0), int64(1), "conflicts found"}},
+					},
+				},
+				{
+					Query: `SELECT schema_name, name, object_type FROM dolt_root_object_conflicts;`,
+					Expected: []sql.Row{
+						{"public", "interpreted_example(text)", "function"},
+					},
+				},
+				{
+					Query: `SELECT base_definition LIKE 'CREATE OR REPLACE FUNCTION interpreted_example%''1'' || input%',
+       our_definition LIKE 'CREATE OR REPLACE FUNCTION interpreted_example%''2'' || input%',
+       their_definition LIKE 'CREATE OR REPLACE FUNCTION interpreted_example%''3'' || input%'
+FROM dolt_root_object_conflicts;`,
+					Expected: []sql.Row{{"t", "t", "t"}},
+				},
+				{
+					Query:    `SELECT dolt_root_object_conflicts_resolve('interpreted_example(text)', '--theirs');`,
+					Expected: []sql.Row{{int64(0)}},
+				},
+				{
+					Query:    `SELECT * FROM dolt_root_object_conflicts;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SELECT * FROM dolt_conflicts;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT interpreted_example('12');",
+					Expected: []sql.Row{{"312"}},
+				},
+			},
+		},
+		{
+			Name: "Resolve all root object conflicts with ours",
+			SetUpScript: []string{
+				`CREATE FUNCTION interpreted_example(input TEXT) RETURNS TEXT AS $$ BEGIN RETURN '1' || input; END; $$ LANGUAGE plpgsql;`,
+				`SELECT dolt_commit('-Am', 'initial');`,
+				`SELECT dolt_checkout('-b', 'other');`,
+				`CREATE OR REPLACE FUNCTION interpreted_example(input TEXT) RETURNS TEXT AS $$ BEGIN RETURN '3' || input; END; $$ LANGUAGE plpgsql;`,
+				`SELECT dolt_commit('-Am', 'other');`,
+				`SELECT dolt_checkout('main');`,
+				`CREATE OR REPLACE FUNCTION interpreted_example(input TEXT) RETURNS TEXT AS $$ BEGIN RETURN '2' || input; END; $$ LANGUAGE plpgsql;`,
+				`SELECT dolt_commit('-Am', 'next');`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query: `SELECT dolt_merge('other');`,
+					Expected: []sql.Row{
+						{[]any{"", int64(0), int64(1), "conflicts found"}},
+					},
+				},
+				{
+					Query:    `SELECT dolt_root_object_conflicts_resolve('.', '--ours');`,
+					Expected: []sql.Row{{int64(0)}},
+				},
+				{
+					Query:    `SELECT * FROM dolt_conflicts;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT interpreted_example('12');",
+					Expected: []sql.Row{{"212"}},
+				},
+			},
+		},
+		{
+			Name: "Resolve function conflict with a replacement statement",
+			SetUpScript: []string{
+				`CREATE FUNCTION interpreted_example(input TEXT) RETURNS TEXT AS $$ BEGIN RETURN '1' || input; END; $$ LANGUAGE plpgsql;`,
+				`SELECT dolt_commit('-Am', 'initial');`,
+				`SELECT dolt_checkout('-b', 'other');`,
+				`CREATE OR REPLACE FUNCTION interpreted_example(input TEXT) RETURNS TEXT AS $$ BEGIN RETURN '3' || input; END; $$ LANGUAGE plpgsql;`,
+				`SELECT dolt_commit('-Am', 'other');`,
+				`SELECT dolt_checkout('main');`,
+				`CREATE OR REPLACE FUNCTION interpreted_example(input TEXT) RETURNS TEXT AS $$ BEGIN RETURN '2' || input; END; $$ LANGUAGE plpgsql;`,
+				`SELECT dolt_commit('-Am', 'next');`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query: `SELECT dolt_merge('other');`,
+					Expected: []sql.Row{
+						{[]any{"", int64(0), int64(1), "conflicts found"}},
+					},
+				},
+				{
+					Query:       `SELECT dolt_root_object_conflicts_resolve('interpreted_example(text)', 'DROP FUNCTION interpreted_example(text)');`,
+					ExpectedErr: `invalid resolution`,
+				},
+				{
+					Query:       `SELECT dolt_root_object_conflicts_resolve('missing_example(text)', '--ours');`,
+					ExpectedErr: `does not have a conflict`,
+				},
+				{
+					Query:    `SELECT dolt_root_object_conflicts_resolve('public.interpreted_example(text)', 'CREATE FUNCTION interpreted_example(input TEXT) RETURNS TEXT AS $$ BEGIN RETURN ''4'' || input; END; $$ LANGUAGE plpgsql;');`,
+					Expected: []sql.Row{{int64(0)}},
+				},
+				{
+					Query:    `SELECT * FROM dolt_root_object_conflicts;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT interpreted_example('12');",
+					Expected: []sql.Row{{"412"}},
+				},
+				{
+					Query:    "SELECT length(dolt_commit('-Am', 'resolved')::text) = 32;",
+					Expected: []sql.Row{{"t"}},
+				},
+				{
+					Query:    `SELECT * FROM dolt_status;`,
+					Expected: []sql.Row{},
+				},
+			},
+		},
+	})
+}
+
+func TestMergeRootObjectBodies(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "Function changes to different statements are merged",
+			SetUpScript: []string{
+				`CREATE FUNCTION classify(input INT4) RETURNS TEXT AS $$
+BEGIN
+	IF input > 0 THEN
+		RETURN 'positive';
+	END IF;
+	IF input < 0 THEN
+		RETURN 'negative';
+	END IF;
+	RETURN 'zero';
+END;
+$$ LANGUAGE plpgsql;`,
+				`SELECT dolt_commit('-Am', 'initial');`,
+				`SELECT dolt_checkout('-b', 'other');`,
+				`CREATE OR REPLACE FUNCTION classify(input INT4) RETURNS TEXT AS $$
+BEGIN
+	IF input > 0 THEN
+		RETURN 'pos';
+	END IF;
+	IF input < 0 THEN
+		RETURN 'negative';
+	END IF;
+	RETURN 'zero';
+END;
+$$ LANGUAGE plpgsql;`,
+				`SELECT dolt_commit('-Am', 'other');`,
+				`SELECT dolt_checkout('main');`,
+				`CREATE OR REPLACE FUNCTION classify(input INT4) RETURNS TEXT AS $$
+BEGIN
+	IF input > 0 THEN
+		RETURN 'positive';
+	END IF;
+	IF input < 0 THEN
+		RETURN 'neg';
+	END IF;
+	RETURN 'zero';
+END;
+$$ LANGUAGE plpgsql;`,
+				`SELECT dolt_commit('-Am', 'main');`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "SELECT strpos(dolt_merge('other')::text, '0,0,\"merge successful\"') > 1;",
+					Expected: []sql.Row{{"t"}},
+				},
+				{
+					Query:    `SELECT * FROM dolt_conflicts;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT classify(1), classify(-1), classify(0);",
+					Expected: []sql.Row{{"pos", "neg", "zero"}},
+				},
+			},
+		},
+		{
+			Name: "Procedure changes to different statements on the same line are merged",
+			SetUpScript: []string{
+				`CREATE TABLE log (v INT4);`,
+				`CREATE PROCEDURE write_log() AS $$ BEGIN INSERT INTO log VALUES (1); INSERT INTO log VALUES (10); END; $$ LANGUAGE plpgsql;`,
+				`SELECT dolt_commit('-Am', 'initial');`,
+				`SELECT dolt_checkout('-b', 'other');`,
+				`CREATE OR REPLACE PROCEDURE write_log() AS $$ BEGIN INSERT INTO log VALUES (2); INSERT INTO log VALUES (10); END; $$ LANGUAGE plpgsql;`,
+				`SELECT dolt_commit('-Am', 'other');`,
+				`SELECT dolt_checkout('main');`,
+				`CREATE OR REPLACE PROCEDURE write_log() AS $$ BEGIN INSERT INTO log VALUES (1); INSERT INTO log VALUES (20); END; $$ LANGUAGE plpgsql;`,
+				`SELECT dolt_commit('-Am', 'main');`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "SELECT strpos(dolt_merge('other')::text, '0,0,\"merge successful\"') > 1;",
+					Expected: []sql.Row{{"t"}},
+				},
+				{
+					Query:    "CALL write_log();",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT v FROM log ORDER BY v;",
+					Expected: []sql.Row{{2}, {20}},
+				},
+			},
+		},
+		{
+			Name: "Function changes to the same statement conflict",
+			SetUpScript: []string{
+				`CREATE FUNCTION classify(input INT4) RETURNS TEXT AS $$
+BEGIN
+	IF input > 0 THEN
+		RETURN 'positive';
+	END IF;
+	RETURN 'other';
+END;
+$$ LANGUAGE plpgsql;`,
+				`SELECT dolt_commit('-Am', 'initial');`,
+				`SELECT dolt_checkout('-b', 'other');`,
+				`CREATE OR REPLACE FUNCTION classify(input INT4) RETURNS TEXT AS $$
+BEGIN
+	IF input > 0 THEN
+		RETURN 'pos';
+	END IF;
+	RETURN 'other';
+END;
+$$ LANGUAGE plpgsql;`,
+				`SELECT dolt_commit('-Am', 'other');`,
+				`SELECT dolt_checkout('main');`,
+				`CREATE OR REPLACE FUNCTION classify(input INT4) RETURNS TEXT AS $$
+BEGIN
+	IF input > 0 THEN
+		RETURN 'plus';
+	END IF;
+	RETURN 'other';
+END;
+$$ LANGUAGE plpgsql;`,
+				`SELECT dolt_commit('-Am', 'main');`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query: `SELECT dolt_merge('other');`,
+					Expected: []sql.Row{
+						{[]any{"", int64(0), int64(1), "conflicts found"}},
+					},
+				},
+				{
+					Query:    `SELECT dolt_conflict_id FROM "dolt_conflicts_classify(int4)";`,
+					Expected: []sql.Row{{"definition"}},
+				},
+			},
+		},
+	})
+}
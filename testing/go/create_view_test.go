@@ -225,10 +225,6 @@ var createViewStmts = []ScriptTest{
 	{
 		Name: "not yet supported create view queries",
 		Assertions: []ScriptTestAssertion{
-			{
-				Query: "CREATE TEMPORARY VIEW v AS SELECT 1;",
-				Skip:  true,
-			},
 			{
 				Query: "CREATE RECURSIVE VIEW v AS SELECT 1;",
 				Skip:  true,
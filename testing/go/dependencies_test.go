@@ -0,0 +1,312 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestDependencies(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "views depend on tables, columns, and other views",
+			SetUpScript: []string{
+				`CREATE TABLE t1 (pk INT4 PRIMARY KEY, c1 TEXT, c2 TEXT);`,
+				`INSERT INTO t1 VALUES (1, 'a', 'b');`,
+				`CREATE VIEW view_a AS SELECT pk, c1 FROM t1;`,
+				`CREATE VIEW view_b AS SELECT * FROM view_a;`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       `DROP TABLE t1;`,
+					ExpectedErr: "cannot drop table t1 because other objects depend on it\nview view_a depends on table t1\nview view_b depends on view view_a",
+				},
+				{
+					Query:       `DROP VIEW view_a;`,
+					ExpectedErr: "cannot drop view view_a because other objects depend on it\nview view_b depends on view view_a",
+				},
+				{
+					Query:       `DROP VIEW view_a RESTRICT;`,
+					ExpectedErr: "cannot drop view view_a because other objects depend on it",
+				},
+				{
+					Query:       `ALTER TABLE t1 DROP COLUMN c1;`,
+					ExpectedErr: "cannot drop column c1 of table t1 because other objects depend on it\nview view_a depends on column c1 of table t1\nview view_b depends on view view_a",
+				},
+				{
+					Query:    `ALTER TABLE t1 DROP COLUMN c2;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query: `ALTER TABLE t1 DROP COLUMN c1 CASCADE;`,
+					ExpectedNotices: []ExpectedNotice{
+						{
+							Severity: "NOTICE",
+							Message:  "drop cascades to 2 other objects",
+						},
+					},
+				},
+				{
+					Query:    `SELECT * FROM t1;`,
+					Expected: []sql.Row{{1}},
+				},
+				{
+					Query:       `SELECT * FROM view_a;`,
+					ExpectedErr: "not found",
+				},
+				{
+					Query:       `SELECT * FROM view_b;`,
+					ExpectedErr: "not found",
+				},
+			},
+		},
+		{
+			Name: "DROP TABLE and DROP VIEW with CASCADE",
+			SetUpScript: []string{
+				`CREATE TABLE t1 (pk INT4 PRIMARY KEY, v1 TEXT);`,
+				`CREATE TABLE t2 (pk INT4 PRIMARY KEY, t t1);`,
+				`INSERT INTO t2 VALUES (1, ROW(1, 'abc')::t1);`,
+				`CREATE VIEW v1 AS SELECT v1 FROM t1;`,
+				`CREATE VIEW v2 AS SELECT pk FROM t2;`,
+				`CREATE VIEW v3 AS SELECT * FROM v2;`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query: `DROP TABLE t1 CASCADE;`,
+					ExpectedNotices: []ExpectedNotice{
+						{
+							Severity: "NOTICE",
+							Message:  "drop cascades to 2 other objects",
+						},
+					},
+				},
+				{
+					Query:       `SELECT * FROM t1;`,
+					ExpectedErr: "not found",
+				},
+				{
+					Query:    `SELECT * FROM t2;`,
+					Expected: []sql.Row{{1}},
+				},
+				{
+					Query: `DROP VIEW v2 CASCADE;`,
+					ExpectedNotices: []ExpectedNotice{
+						{
+							Severity: "NOTICE",
+							Message:  "drop cascades to view v3",
+						},
+					},
+				},
+				{
+					Query:       `SELECT * FROM v3;`,
+					ExpectedErr: "not found",
+				},
+				{
+					Query:    `DROP TABLE IF EXISTS t1 CASCADE;`,
+					Expected: []sql.Row{},
+				},
+			},
+		},
+		{
+			Name: "functions used by triggers",
+			SetUpScript: []string{
+				`CREATE TABLE t1 (pk INT4 PRIMARY KEY, v1 TEXT);`,
+				`CREATE FUNCTION trig_func() RETURNS TRIGGER AS $$ BEGIN NEW.v1 := NEW.v1 || 'x'; RETURN NEW; END; $$ LANGUAGE plpgsql;`,
+				`CREATE TRIGGER trig1 BEFORE INSERT ON t1 FOR EACH ROW EXECUTE FUNCTION trig_func();`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       `DROP FUNCTION trig_func();`,
+					ExpectedErr: "cannot drop function trig_func() because other objects depend on it\ntrigger trig1 on table t1 depends on function trig_func()",
+				},
+				{
+					Query:    `INSERT INTO t1 VALUES (1, 'a');`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query: `DROP FUNCTION trig_func() CASCADE;`,
+					ExpectedNotices: []ExpectedNotice{
+						{
+							Severity: "NOTICE",
+							Message:  "drop cascades to trigger trig1 on table t1",
+						},
+					},
+				},
+				{
+					Query:    `INSERT INTO t1 VALUES (2, 'b');`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SELECT * FROM t1 ORDER BY pk;`,
+					Expected: []sql.Row{{1, "ax"}, {2, "b"}},
+				},
+			},
+		},
+		{
+			Name: "types and domains used by columns and functions",
+			SetUpScript: []string{
+				`CREATE TYPE mood AS ENUM ('happy', 'sad');`,
+				`CREATE DOMAIN positive AS INT4 CHECK (VALUE > 0);`,
+				`CREATE TABLE t1 (pk INT4 PRIMARY KEY, m mood, p positive);`,
+				`CREATE FUNCTION mood_func(m mood) RETURNS TEXT AS $$ BEGIN RETURN m::text; END; $$ LANGUAGE plpgsql;`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       `DROP TYPE mood;`,
+					ExpectedErr: "cannot drop type mood because other objects depend on it\ncolumn m of table t1 depends on type mood\nfunction mood_func(mood) depends on type mood",
+				},
+				{
+					Query: `DROP TYPE mood CASCADE;`,
+					ExpectedNotices: []ExpectedNotice{
+						{
+							Severity: "NOTICE",
+							Message:  "drop cascades to 2 other objects",
+						},
+					},
+				},
+				{
+					Query:       `SELECT mood_func('happy');`,
+					ExpectedErr: "does not exist",
+				},
+				{
+					Query:       `DROP DOMAIN positive;`,
+					ExpectedErr: "cannot drop type positive because other objects depend on it\ncolumn p of table t1 depends on type positive",
+				},
+				{
+					Query: `DROP DOMAIN positive CASCADE;`,
+					ExpectedNotices: []ExpectedNotice{
+						{
+							Severity: "NOTICE",
+							Message:  "drop cascades to column p of table t1",
+						},
+					},
+				},
+				{
+					Query:    `SELECT column_name FROM information_schema.columns WHERE table_name = 't1' ORDER BY ordinal_position;`,
+					Expected: []sql.Row{{"pk"}},
+				},
+			},
+		},
+		{
+			Name: "sequences used by column defaults",
+			SetUpScript: []string{
+				`CREATE SEQUENCE seq1;`,
+				`CREATE TABLE t1 (pk INT4 PRIMARY KEY DEFAULT nextval('seq1'), v1 TEXT);`,
+				`CREATE TABLE t2 (pk SERIAL PRIMARY KEY, v1 TEXT);`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       `DROP SEQUENCE seq1;`,
+					ExpectedErr: "cannot drop sequence seq1 because other objects depend on it\ndefault value for column pk of table t1 depends on sequence seq1",
+				},
+				{
+					Query:       `DROP SEQUENCE t2_pk_seq;`,
+					ExpectedErr: "cannot drop sequence t2_pk_seq because other objects depend on it\ndefault value for column pk of table t2 depends on sequence t2_pk_seq",
+				},
+				{
+					Query: `DROP SEQUENCE seq1 CASCADE;`,
+					ExpectedNotices: []ExpectedNotice{
+						{
+							Severity: "NOTICE",
+							Message:  "drop cascades to default value for column pk of table t1",
+						},
+					},
+				},
+				{
+					Query:       `INSERT INTO t1 (v1) VALUES ('a');`,
+					ExpectedErr: "null",
+				},
+				{
+					Query:    `INSERT INTO t1 VALUES (5, 'a');`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SELECT * FROM t1;`,
+					Expected: []sql.Row{{5, "a"}},
+				},
+				{
+					Query:    `DROP TABLE t2;`,
+					Expected: []sql.Row{},
+				},
+			},
+		},
+		{
+			Name: "DROP SCHEMA with CASCADE",
+			SetUpScript: []string{
+				`CREATE SCHEMA s1;`,
+				`CREATE TABLE s1.t1 (pk INT4 PRIMARY KEY);`,
+				`CREATE SEQUENCE s1.seq1;`,
+				`CREATE VIEW public.v1 AS SELECT pk FROM s1.t1;`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       `DROP SCHEMA s1;`,
+					ExpectedErr: "cannot drop schema s1 because other objects depend on it",
+				},
+				{
+					Query: `DROP SCHEMA s1 CASCADE;`,
+					ExpectedNotices: []ExpectedNotice{
+						{
+							Severity: "NOTICE",
+							Message:  "drop cascades to 3 other objects",
+						},
+					},
+				},
+				{
+					Query:    `SELECT schema_name FROM information_schema.schemata WHERE schema_name = 's1';`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       `SELECT * FROM v1;`,
+					ExpectedErr: "not found",
+				},
+			},
+		},
+		{
+			Name: "pg_depend",
+			SetUpScript: []string{
+				`CREATE TYPE mood AS ENUM ('happy', 'sad');`,
+				`CREATE TABLE t1 (pk INT4 PRIMARY KEY, v1 TEXT, m mood);`,
+				`CREATE VIEW v1 AS SELECT v1 FROM t1;`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{ // Views depend on the relations and columns that they reference
+					Query: `SELECT c.relname, d.refobjsubid, d.deptype
+							FROM pg_catalog.pg_depend d
+							JOIN pg_catalog.pg_class c ON d.refobjid = c.oid
+							JOIN pg_catalog.pg_class v ON d.objid = v.oid
+							WHERE v.relname = 'v1'
+							ORDER BY d.refobjsubid;`,
+					Expected: []sql.Row{
+						{"t1", 0, "n"},
+						{"t1", 2, "n"},
+					},
+				},
+				{ // Columns depend on their types
+					Query: `SELECT c.relname, d.objsubid, t.typname, d.deptype
+							FROM pg_catalog.pg_depend d
+							JOIN pg_catalog.pg_class c ON d.objid = c.oid
+							JOIN pg_catalog.pg_type t ON d.refobjid = t.oid
+							WHERE d.refclassid = 1247;`,
+					Expected: []sql.Row{
+						{"t1", 3, "mood", "n"},
+					},
+				},
+			},
+		},
+	})
+}
@@ -2972,14 +2972,14 @@ func TestDoltFunctionSmokeTests(t *testing.T) {
 					Query: "SELECT statement_order, table_name, diff_type, statement FROM dolt_patch('HEAD', 'WORKING')",
 					Expected: []sql.Row{
 						{Numeric("1"), "public.t1", "schema", "CREATE TABLE \"t1\" (\n  \"pk\" integer NOT NULL,\n  PRIMARY KEY (\"pk\")\n);"},
-						{Numeric("2"), "public.t1", "data", "INSERT INTO \"t1\" (\"pk\") VALUES (1);"},
+						{Numeric("2"), "public.t1", "data", "INSERT INTO \"public\".\"t1\" (\"pk\") VALUES (1);"},
 					},
 				},
 				{
 					Query: "SELECT statement_order, table_name, diff_type, statement FROM dolt_patch('HEAD', 'WORKING', 't1')",
 					Expected: []sql.Row{
 						{Numeric("1"), "public.t1", "schema", "CREATE TABLE \"t1\" (\n  \"pk\" integer NOT NULL,\n  PRIMARY KEY (\"pk\")\n);"},
-						{Numeric("2"), "public.t1", "data", "INSERT INTO \"t1\" (\"pk\") VALUES (1);"},
+						{Numeric("2"), "public.t1", "data", "INSERT INTO \"public\".\"t1\" (\"pk\") VALUES (1);"},
 					},
 				},
 				{
@@ -3089,23 +3089,23 @@ func TestDoltFunctionSmokeTests(t *testing.T) {
 					Query: "SELECT statement_order, table_name, diff_type, statement FROM dolt_patch('HEAD', 'WORKING')",
 					Expected: []sql.Row{
 						{Numeric("1"), "public.t1", "schema", "CREATE TABLE \"t1\" (\n  \"pk\" integer NOT NULL,\n  PRIMARY KEY (\"pk\")\n);"},
-						{Numeric("2"), "public.t1", "data", "INSERT INTO \"t1\" (\"pk\") VALUES (1);"},
+						{Numeric("2"), "public.t1", "data", "INSERT INTO \"public\".\"t1\" (\"pk\") VALUES (1);"},
 						{Numeric("3"), "testschema.t2", "schema", "CREATE TABLE \"t2\" (\n  \"pk\" integer NOT NULL,\n  PRIMARY KEY (\"pk\")\n);"},
-						{Numeric("4"), "testschema.t2", "data", "INSERT INTO \"t2\" (\"pk\") VALUES (1);"},
+						{Numeric("4"), "testschema.t2", "data", "INSERT INTO \"testschema\".\"t2\" (\"pk\") VALUES (1);"},
 					},
 				},
 				{
 					Query: "SELECT statement_order, table_name, diff_type, statement FROM dolt_patch('HEAD', 'WORKING', 't1')",
 					Expected: []sql.Row{
 						{Numeric("1"), "public.t1", "schema", "CREATE TABLE \"t1\" (\n  \"pk\" integer NOT NULL,\n  PRIMARY KEY (\"pk\")\n);"},
-						{Numeric("2"), "public.t1", "data", "INSERT INTO \"t1\" (\"pk\") VALUES (1);"},
+						{Numeric("2"), "public.t1", "data", "INSERT INTO \"public\".\"t1\" (\"pk\") VALUES (1);"},
 					},
 				},
 				{
 					Query: "SELECT statement_order, table_name, diff_type, statement FROM dolt_patch('HEAD', 'WORKING', 't2')",
 					Expected: []sql.Row{
 						{Numeric("1"), "testschema.t2", "schema", "CREATE TABLE \"t2\" (\n  \"pk\" integer NOT NULL,\n  PRIMARY KEY (\"pk\")\n);"},
-						{Numeric("2"), "testschema.t2", "data", "INSERT INTO \"t2\" (\"pk\") VALUES (1);"},
+						{Numeric("2"), "testschema.t2", "data", "INSERT INTO \"testschema\".\"t2\" (\"pk\") VALUES (1);"},
 					},
 				},
 				{
@@ -3154,7 +3154,7 @@ func TestDoltFunctionSmokeTests(t *testing.T) {
 					Query: "SELECT statement_order, table_name, diff_type, statement FROM dolt_patch('HEAD', 'WORKING', 'repro')",
 					Expected: []sql.Row{
 						{Numeric("1"), "public.repro", "schema", "CREATE TABLE \"repro\" (\n  \"pk\" integer NOT NULL,\n  \"data\" jsonb,\n  PRIMARY KEY (\"pk\")\n);"},
-						{Numeric("2"), "public.repro", "data", "INSERT INTO \"repro\" (\"pk\",\"data\") VALUES (1,'{\\\"text\\\": \\\"hello\\\"}');"},
+						{Numeric("2"), "public.repro", "data", "INSERT INTO \"public\".\"repro\" (\"pk\",\"data\") VALUES (1,'{\"text\": \"hello\"}');"},
 					},
 				},
 				{
@@ -3165,6 +3165,80 @@ func TestDoltFunctionSmokeTests(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "dolt_patch emits Postgres data statements and root objects",
+			SetUpScript: []string{
+				"CREATE TABLE t (pk int primary key, v text, b bool);",
+				"INSERT INTO t VALUES (1, 'a', true), (2, 'b', false);",
+				"SELECT dolt_commit('-Am', 'initial');",
+				"UPDATE t SET v = 'c' WHERE pk = 1;",
+				"DELETE FROM t WHERE pk = 2;",
+				"INSERT INTO t VALUES (3, 'it''s', NULL);",
+				"CREATE TYPE mood AS ENUM ('sad', 'happy');",
+				"CREATE SEQUENCE seq1;",
+				"CREATE FUNCTION add_one(x INT4) RETURNS INT4 AS $$ BEGIN RETURN x + 1; END; $$ LANGUAGE plpgsql;",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query: "SELECT statement FROM dolt_patch('HEAD', 'WORKING') WHERE diff_type = 'data' ORDER BY statement;",
+					Expected: []sql.Row{
+						{"DELETE FROM \"public\".\"t\" WHERE (\"pk\"=2);"},
+						{"INSERT INTO \"public\".\"t\" (\"pk\",\"v\",\"b\") VALUES (3,'it''s',NULL);"},
+						{"UPDATE \"public\".\"t\" SET \"v\"='c' WHERE (\"pk\"=1);"},
+					},
+				},
+				{
+					Query: "SELECT statement_order, table_name, statement FROM dolt_patch('HEAD', 'WORKING') WHERE table_name IN ('public.mood', 'public.seq1');",
+					Expected: []sql.Row{
+						{Numeric("1"), "public.mood", "CREATE TYPE \"public\".\"mood\" AS ENUM ('sad', 'happy');"},
+						{Numeric("2"), "public.seq1", "CREATE SEQUENCE \"public\".\"seq1\" AS int8 INCREMENT BY 1 MINVALUE 1 MAXVALUE 9223372036854775807 START WITH 1 CACHE 1 NO CYCLE;"},
+					},
+				},
+				{
+					Query:    "SELECT statement_order, statement LIKE 'CREATE OR REPLACE FUNCTION add_one%' FROM dolt_patch('HEAD', 'WORKING') WHERE table_name = 'public.add_one(int4)';",
+					Expected: []sql.Row{{Numeric("3"), "t"}},
+				},
+				{
+					Query:    "SELECT count(*) FROM dolt_patch('HEAD', 'WORKING', 't');",
+					Expected: []sql.Row{{3}},
+				},
+				{
+					Query:            "SELECT dolt_commit('-Am', 'add objects');",
+					SkipResultsCheck: true,
+				},
+				{
+					Query:    "DROP FUNCTION add_one(INT4);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query: "SELECT statement_order, table_name, diff_type, statement FROM dolt_patch('HEAD', 'WORKING');",
+					Expected: []sql.Row{
+						{Numeric("1"), "public.add_one(int4)", "schema", "DROP FUNCTION IF EXISTS \"public\".\"add_one\"(int4);"},
+					},
+				},
+			},
+		},
+		{
+			Name: "dolt_patch with keyless tables and three-dot ranges",
+			SetUpScript: []string{
+				"CREATE TABLE k (v int, w text);",
+				"INSERT INTO k VALUES (1, 'a'), (1, 'a'), (2, NULL);",
+				"SELECT dolt_commit('-Am', 'initial');",
+				"DELETE FROM k WHERE v = 2;",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query: "SELECT statement FROM dolt_patch('HEAD', 'WORKING') WHERE diff_type = 'data';",
+					Expected: []sql.Row{
+						{"DELETE FROM \"public\".\"k\" WHERE ctid = (SELECT ctid FROM \"public\".\"k\" WHERE (\"v\"=2 AND \"w\" IS NULL) LIMIT 1);"},
+					},
+				},
+				{
+					Query:       "SELECT statement FROM dolt_patch('HEAD~1...HEAD');",
+					ExpectedErr: "dolt_patch does not support three-dot ranges",
+				},
+			},
+		},
 		{
 			Name: "DOLT_PREVIEW_MERGE_CONFLICTS basic functionality",
 			SetUpScript: []string{
@@ -528,6 +528,62 @@ func TestAggregateFunctions(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "corr, covar_pop, covar_samp, and regr_*",
+			SetUpScript: []string{
+				"CREATE TABLE regr (id INT PRIMARY KEY, y INT, x INT);",
+				"INSERT INTO regr VALUES (1, 1, 1), (2, 3, 2), (3, 2, 3), (4, 5, 4), (5, NULL, 5), (6, 7, NULL);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query: `SELECT corr(y, x), covar_pop(y, x), covar_samp(y, x) FROM regr;`,
+					Expected: []sql.Row{
+						{0.8315218406202999, 1.375, 1.8333333333333333},
+					},
+				},
+				{
+					Query: `SELECT regr_count(y, x), regr_avgx(y, x), regr_avgy(y, x), regr_sxx(y, x), regr_syy(y, x), regr_sxy(y, x) FROM regr;`,
+					Expected: []sql.Row{
+						{int64(4), 2.5, 2.75, 5.0, 8.75, 5.5},
+					},
+				},
+				{
+					Query: `SELECT regr_slope(y, x), regr_intercept(y, x), regr_r2(y, x) FROM regr;`,
+					Expected: []sql.Row{
+						{1.1, 0.0, 0.6914285714285714},
+					},
+				},
+				{
+					Query: `SELECT regr_count(y, x), corr(y, x), covar_pop(y, x), covar_samp(y, x), regr_slope(y, x) FROM regr WHERE id = 1;`,
+					Expected: []sql.Row{
+						{int64(1), nil, 0.0, nil, nil},
+					},
+				},
+				{
+					Query: `SELECT regr_count(y, x), corr(y, x), regr_avgx(y, x) FROM regr WHERE id > 10;`,
+					Expected: []sql.Row{
+						{int64(0), nil, nil},
+					},
+				},
+				{
+					Query: `SELECT regr_r2(y, x), regr_slope(y, x) FROM (VALUES (2.0::float8, 1.0::float8), (2.0, 3.0)) v(y, x);`,
+					Expected: []sql.Row{
+						{1.0, 0.0},
+					},
+				},
+				{
+					Query: `SELECT id, regr_count(y, x) OVER (ORDER BY id), covar_samp(y, x) OVER (ORDER BY id ROWS BETWEEN 1 PRECEDING AND CURRENT ROW) FROM regr ORDER BY id;`,
+					Expected: []sql.Row{
+						{1, int64(1), nil},
+						{2, int64(2), 1.0},
+						{3, int64(3), -0.5},
+						{4, int64(4), 1.5},
+						{5, int64(4), nil},
+						{6, int64(4), nil},
+					},
+				},
+			},
+		},
 	})
 }
 
@@ -2582,10 +2638,15 @@ func TestSystemCatalogInformationFunctions(t *testing.T) {
 					},
 				},
 				{
-					// TODO: only UTF8 is supported for now
 					Query: `SELECT pg_char_to_encoding('LATIN1');`,
 					Expected: []sql.Row{
-						{-1},
+						{8},
+					},
+				},
+				{
+					Query: `SELECT pg_char_to_encoding('bogus'), pg_encoding_to_char(24);`,
+					Expected: []sql.Row{
+						{-1, "WIN1252"},
 					},
 				},
 			},
@@ -4085,6 +4146,10 @@ func TestDateAndTimeFunction(t *testing.T) {
 					Query:       `select make_timestamp(2000, 7, 15, 25, 30, 61);`,
 					ExpectedErr: `time field value out of range`,
 				},
+				{
+					Query:       `select make_timestamp(2013, 2, 30, 12, 30, 15);`,
+					ExpectedErr: `date field value out of range`,
+				},
 			},
 		},
 		{
@@ -4123,6 +4188,218 @@ func TestDateAndTimeFunction(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "make_date and make_time",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT make_date(2013, 7, 15);`,
+					Expected: []sql.Row{{"2013-07-15"}},
+				},
+				{
+					Query:    `SELECT make_date(-44, 3, 15);`,
+					Expected: []sql.Row{{"0044-03-15 BC"}},
+				},
+				{
+					Query:    `SELECT make_date(2024, 2, 29);`,
+					Expected: []sql.Row{{"2024-02-29"}},
+				},
+				{
+					Query:       `SELECT make_date(2013, 2, 30);`,
+					ExpectedErr: `date field value out of range: 2013-02-30`,
+				},
+				{
+					Query:       `SELECT make_date(0, 7, 15);`,
+					ExpectedErr: `date field value out of range: 0-07-15`,
+				},
+				{
+					Query:    `SELECT make_time(8, 15, 23.5);`,
+					Expected: []sql.Row{{"08:15:23.5"}},
+				},
+				{
+					Query:    `SELECT make_time(24, 0, 0);`,
+					Expected: []sql.Row{{"24:00:00"}},
+				},
+				{
+					Query:       `SELECT make_time(25, 30, 0);`,
+					ExpectedErr: `time field value out of range: 25:30:00`,
+				},
+				{
+					Query:       `SELECT make_time(10, 60, 0);`,
+					ExpectedErr: `time field value out of range: 10:60:00`,
+				},
+			},
+		},
+		{
+			Name: "make_interval",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT make_interval();`,
+					Expected: []sql.Row{{"00:00:00"}},
+				},
+				{
+					Query:    `SELECT make_interval(1);`,
+					Expected: []sql.Row{{"1 year"}},
+				},
+				{
+					Query:    `SELECT make_interval(0, 0, 2, 3);`,
+					Expected: []sql.Row{{"17 days"}},
+				},
+				{
+					Query:    `SELECT make_interval(1, 2, 3, 4, 5, 6, 7.5);`,
+					Expected: []sql.Row{{"1 year 2 mons 25 days 05:06:07.5"}},
+				},
+				{
+					Query:    `SELECT make_interval(0, 0, 0, 0, 0, -90);`,
+					Expected: []sql.Row{{"-01:30:00"}},
+				},
+				{
+					Query:       `SELECT make_interval(2147483647);`,
+					ExpectedErr: `interval out of range`,
+				},
+				{
+					Query:       `SELECT make_interval(0, 0, 0, 0, 0, 0, 'infinity');`,
+					ExpectedErr: `interval out of range`,
+				},
+				{
+					Query:    `SELECT make_interval(days => 10);`,
+					Expected: []sql.Row{{"10 days"}},
+				},
+				{
+					Query:    `SELECT make_interval(weeks => 1, years => 2);`,
+					Expected: []sql.Row{{"2 years 7 days"}},
+				},
+				{
+					Query:    `SELECT make_interval(1, secs => 1.5);`,
+					Expected: []sql.Row{{"1 year 00:00:01.5"}},
+				},
+				{
+					Query:       `SELECT make_interval(dayz => 10);`,
+					ExpectedErr: `function make_interval(dayz => integer) does not exist`,
+				},
+				{
+					Query:       `SELECT make_interval(1, years => 2);`,
+					ExpectedErr: `function make_interval(integer, years => integer) does not exist`,
+				},
+				{
+					Query:       `SELECT make_interval(days => 1, days => 2);`,
+					ExpectedErr: `argument name "days" used more than once`,
+				},
+				{
+					Query:       `SELECT make_interval(days => 1, 2);`,
+					ExpectedErr: `positional argument cannot follow named argument`,
+				},
+			},
+		},
+		{
+			Name: "justify_days, justify_hours, and justify_interval",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT justify_days(interval '35 days');`,
+					Expected: []sql.Row{{"1 mon 5 days"}},
+				},
+				{
+					Query:    `SELECT justify_days(interval '1 mon -35 days');`,
+					Expected: []sql.Row{{"-5 days"}},
+				},
+				{
+					Query:    `SELECT justify_days(interval '2 mons -10 days');`,
+					Expected: []sql.Row{{"1 mon 20 days"}},
+				},
+				{
+					Query:    `SELECT justify_hours(interval '27 hours');`,
+					Expected: []sql.Row{{"1 day 03:00:00"}},
+				},
+				{
+					Query:    `SELECT justify_hours(interval '-27 hours');`,
+					Expected: []sql.Row{{"-1 days -03:00:00"}},
+				},
+				{
+					Query:    `SELECT justify_hours(interval '1 day -1 hour');`,
+					Expected: []sql.Row{{"23:00:00"}},
+				},
+				{
+					Query:    `SELECT justify_interval(interval '1 mon -1 hour');`,
+					Expected: []sql.Row{{"29 days 23:00:00"}},
+				},
+				{
+					Query:    `SELECT justify_interval(interval '65 days 30 hours');`,
+					Expected: []sql.Row{{"2 mons 6 days 06:00:00"}},
+				},
+			},
+		},
+		{
+			Name: "date_add and date_subtract",
+			SetUpScript: []string{
+				`SET timezone = 'UTC'`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT date_add('2021-10-31 00:00:00+02'::timestamptz, '1 day'::interval, 'Europe/Warsaw');`,
+					Expected: []sql.Row{{"2021-10-31 23:00:00+00"}},
+				},
+				{
+					Query:    `SELECT date_add('2021-10-31 00:00:00+02'::timestamptz, '1 day'::interval);`,
+					Expected: []sql.Row{{"2021-10-31 22:00:00+00"}},
+				},
+				{
+					Query:    `SELECT date_subtract('2021-11-01 00:00:00+01'::timestamptz, '1 day'::interval, 'Europe/Warsaw');`,
+					Expected: []sql.Row{{"2021-10-30 22:00:00+00"}},
+				},
+				{
+					Query:    `SELECT date_add('2024-01-31 12:00:00+00'::timestamptz, '1 month'::interval, 'UTC');`,
+					Expected: []sql.Row{{"2024-02-29 12:00:00+00"}},
+				},
+				{
+					Query:    `SELECT date_add('2024-03-31 00:30:00+00'::timestamptz, '1 month'::interval, 'America/New_York');`,
+					Expected: []sql.Row{{"2024-05-01 00:30:00+00"}},
+				},
+				{
+					Query:    `SELECT date_add('2024-03-31 00:30:00+00'::timestamptz, '1 month'::interval);`,
+					Expected: []sql.Row{{"2024-04-30 00:30:00+00"}},
+				},
+				{
+					Query:    `SELECT date_add('infinity'::timestamptz, '1 month'::interval), date_subtract('-infinity'::timestamptz, '1 day'::interval);`,
+					Expected: []sql.Row{{"infinity", "-infinity"}},
+				},
+				{
+					Query:       `SELECT date_add('2024-01-01 00:00:00+00'::timestamptz, '1 day'::interval, 'Nehwon/Lankhmar');`,
+					ExpectedErr: `time zone "Nehwon/Lankhmar" not recognized`,
+				},
+				{
+					Query:       `SELECT date_add('2024-01-01 00:00:00+00'::timestamptz, '1000000000 years'::interval);`,
+					ExpectedErr: `timestamp out of range`,
+				},
+			},
+		},
+		{
+			Name: "isfinite",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT isfinite(date '2001-02-16');`,
+					Expected: []sql.Row{{"t"}},
+				},
+				{
+					Query:    `SELECT isfinite(timestamp '2001-02-16 21:28:30'), isfinite('infinity'::timestamp), isfinite('-infinity'::timestamp);`,
+					Expected: []sql.Row{{"t", "f", "f"}},
+				},
+				{
+					Query:    `SELECT isfinite(timestamptz '2001-02-16 21:28:30+00'), isfinite('infinity'::timestamptz);`,
+					Expected: []sql.Row{{"t", "f"}},
+				},
+				{
+					Query:    `SELECT isfinite(interval '4 hours');`,
+					Expected: []sql.Row{{"t"}},
+				},
+				{
+					Query:    `SELECT 'infinity'::timestamp, '-infinity'::timestamptz;`,
+					Expected: []sql.Row{{"infinity", "-infinity"}},
+				},
+				{
+					Query:       `SELECT age('infinity'::timestamp, timestamp '2001-02-16');`,
+					ExpectedErr: `timestamp out of range`,
+				},
+			},
+		},
 		{
 			Name: "date_bin",
 			Assertions: []ScriptTestAssertion{
@@ -4293,6 +4570,74 @@ func TestStringFunction(t *testing.T) {
 					Query:    `select quote_ident('hi"b"ye');`,
 					Expected: []sql.Row{{`"hi""b""ye"`}},
 				},
+				{
+					Query:    `select quote_ident('abc_1'), quote_ident('Abc'), quote_ident('1abc'), quote_ident('select'), quote_ident('name'), quote_ident('');`,
+					Expected: []sql.Row{{`abc_1`, `"Abc"`, `"1abc"`, `"select"`, `name`, `""`}},
+				},
+			},
+		},
+		{
+			Name:        "quote_literal and quote_nullable",
+			SetUpScript: []string{},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT quote_literal('O''Reilly'), quote_literal(E'C:\\temp'), quote_literal(42), quote_literal(NULL::text);`,
+					Expected: []sql.Row{{`'O''Reilly'`, `E'C:\\temp'`, `'42'`, nil}},
+				},
+				{
+					Query:    `SELECT quote_nullable('abc'), quote_nullable(NULL::text), quote_nullable(NULL::int4), quote_nullable(1.5::numeric);`,
+					Expected: []sql.Row{{`'abc'`, `NULL`, `NULL`, `'1.5'`}},
+				},
+			},
+		},
+		{
+			Name:        "format",
+			SetUpScript: []string{},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT format('Hello %s, %s', 'World', 42), format('no args'), format(NULL::text, 1);`,
+					Expected: []sql.Row{{"Hello World, 42", "no args", nil}},
+				},
+				{
+					Query:    `SELECT format('SELECT * FROM %I WHERE id = %L AND name = %L', 'my table', 'O''Reilly', NULL::text);`,
+					Expected: []sql.Row{{`SELECT * FROM "my table" WHERE id = 'O''Reilly' AND name = NULL`}},
+				},
+				{
+					Query:    `SELECT format('%I.%I', 'public', 'select'), format('%I', 'Users'), format('%s|%s|', NULL::text, true);`,
+					Expected: []sql.Row{{`public."select"`, `"Users"`, "|t|"}},
+				},
+				{
+					Query:    `SELECT format('%2$s %1$s %s', 'a', 'b'), format('100%%');`,
+					Expected: []sql.Row{{"b a b", "100%"}},
+				},
+				{
+					Query:    `SELECT format('|%10s|%-10s|', 'foo', 'bar'), format('|%*s|', 6, 'x'), format('|%*s|', -6, 'x'), format('|%-*2$s|', 'x', 4);`,
+					Expected: []sql.Row{{"|       foo|bar       |", "|     x|", "|x     |", "|x   |"}},
+				},
+				{
+					Query:    `SELECT format('%s and %s', VARIADIC ARRAY['one', 'two']), format('%2$s, %1$s', VARIADIC ARRAY[1, 2]), format('Hello', VARIADIC NULL::int4[]);`,
+					Expected: []sql.Row{{"one and two", "2, 1", "Hello"}},
+				},
+				{
+					Query:       `SELECT format('%s %s', 'a');`,
+					ExpectedErr: "too few arguments for format()",
+				},
+				{
+					Query:       `SELECT format('%0$s', 'a');`,
+					ExpectedErr: "format specifies argument 0, but arguments are numbered from 1",
+				},
+				{
+					Query:       `SELECT format('%d', 1);`,
+					ExpectedErr: `unrecognized format() type specifier "d"`,
+				},
+				{
+					Query:       `SELECT format('%I', NULL::text);`,
+					ExpectedErr: "null values cannot be formatted as an SQL identifier",
+				},
+				{
+					Query:       `SELECT format('abc %');`,
+					ExpectedErr: "unterminated format() type specifier",
+				},
 			},
 		},
 		{
@@ -4522,6 +4867,129 @@ func TestStringFunction(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "sha224, sha256, sha384, and sha512",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT encode(sha224('abc'), 'hex');`,
+					Expected: []sql.Row{{"23097d223405d8228642a477bda255b32aadbce4bda0b3f7e36c9da7"}},
+				},
+				{
+					Query:    `SELECT encode(sha256('abc'), 'hex');`,
+					Expected: []sql.Row{{"ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad"}},
+				},
+				{
+					Query:    `SELECT encode(sha384('abc'), 'hex');`,
+					Expected: []sql.Row{{"cb00753f45a35e8bb5a03d699ac65007272c32ab0eded1631a8b605a43ff5bed8086072ba1e7cc2358baeca134c825a7"}},
+				},
+				{
+					Query:    `SELECT encode(sha512('abc'), 'hex');`,
+					Expected: []sql.Row{{"ddaf35a193617abacc417349ae20413112e6fa4e89a97ea20a9eeee64b55d39a2192992a274fc1a836ba3c23a3feebbd454d4423643ce80e2a9ac94fa54ca49f"}},
+				},
+				{
+					Query:    `SELECT encode(sha256(''::bytea), 'hex'), encode(sha256('user@example.com'::text::bytea), 'hex');`,
+					Expected: []sql.Row{{"e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", "b4c9a289323b21a01c3e940f150eb9b8c542587f1abfd8f0e1cc1ffc5e475514"}},
+				},
+				{
+					Query:    `SELECT sha256(NULL);`,
+					Expected: []sql.Row{{nil}},
+				},
+				{
+					Query:    `SELECT 'sha256'::regproc::oid;`,
+					Expected: []sql.Row{{3420}},
+				},
+			},
+		},
+		{
+			Name: "decode",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT convert_from(decode('SGVsbG8sIFdvcmxkIQ==', 'base64'), 'UTF8');`,
+					Expected: []sql.Row{{"Hello, World!"}},
+				},
+				{
+					Query:    `SELECT convert_from(decode(E'SGVsbG8s\nIFdvcmxkIQ==', 'base64'), 'UTF8');`,
+					Expected: []sql.Row{{"Hello, World!"}},
+				},
+				{
+					Query:    `SELECT decode(encode('\x1234567890abcdef00'::bytea, 'base64'), 'base64') = '\x1234567890abcdef00'::bytea;`,
+					Expected: []sql.Row{{"t"}},
+				},
+				{
+					Query:    `SELECT encode(decode('12 34 AB', 'hex'), 'hex');`,
+					Expected: []sql.Row{{"1234ab"}},
+				},
+				{
+					Query:    `SELECT encode(decode('a\\b\001', 'escape'), 'hex');`,
+					Expected: []sql.Row{{"615c6201"}},
+				},
+				{
+					Query:       `SELECT decode('123', 'hex');`,
+					ExpectedErr: `invalid hexadecimal data: odd number of digits`,
+				},
+				{
+					Query:       `SELECT decode('12zz', 'hex');`,
+					ExpectedErr: `invalid hexadecimal digit: "z"`,
+				},
+				{
+					Query:       `SELECT decode('SGVsbG8', 'base64');`,
+					ExpectedErr: `invalid base64 end sequence`,
+				},
+				{
+					Query:       `SELECT decode('SG!s', 'base64');`,
+					ExpectedErr: `invalid symbol "!" found while decoding base64 sequence`,
+				},
+				{
+					Query:       `SELECT decode('a\b', 'escape');`,
+					ExpectedErr: `invalid input syntax for type bytea`,
+				},
+				{
+					Query:       `SELECT decode('abc', 'bogus');`,
+					ExpectedErr: `unrecognized encoding: "bogus"`,
+				},
+			},
+		},
+		{
+			Name: "convert, convert_from, and convert_to",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT encode(convert_to('café', 'LATIN1'), 'hex'), encode(convert_to('café €', 'WIN1252'), 'hex'), encode(convert_to('é€', 'UTF8'), 'hex');`,
+					Expected: []sql.Row{{"636166e9", "636166e92080", "c3a9e282ac"}},
+				},
+				{
+					Query:    `SELECT convert_from('\x636166e9'::bytea, 'LATIN1'), convert_from('\x80'::bytea, 'WIN1252'), convert_from('\xc3a9'::bytea, 'utf-8');`,
+					Expected: []sql.Row{{"café", "€", "é"}},
+				},
+				{
+					Query:    `SELECT encode(convert('\x636166e9'::bytea, 'LATIN1', 'UTF8'), 'hex'), encode(convert('\x80'::bytea, 'WIN1252', 'UTF8'), 'hex');`,
+					Expected: []sql.Row{{"636166c3a9", "e282ac"}},
+				},
+				{
+					Query:       `SELECT convert_to('€', 'LATIN1');`,
+					ExpectedErr: `character with byte sequence 0xe2 0x82 0xac in encoding "UTF8" has no equivalent in encoding "LATIN1"`,
+				},
+				{
+					Query:       `SELECT convert_from('\x81'::bytea, 'WIN1252');`,
+					ExpectedErr: `character with byte sequence 0x81 in encoding "WIN1252" has no equivalent in encoding "UTF8"`,
+				},
+				{
+					Query:       `SELECT convert_from('\x61ff'::bytea, 'UTF8');`,
+					ExpectedErr: `invalid byte sequence for encoding "UTF8": 0xff`,
+				},
+				{
+					Query:       `SELECT convert('\xe282ac'::bytea, 'UTF8', 'LATIN1');`,
+					ExpectedErr: `has no equivalent in encoding "LATIN1"`,
+				},
+				{
+					Query:       `SELECT convert_to('abc', 'BOGUS');`,
+					ExpectedErr: `invalid destination encoding name "BOGUS"`,
+				},
+				{
+					Query:       `SELECT convert_from('\x616263'::bytea, 'BOGUS');`,
+					ExpectedErr: `invalid source encoding name "BOGUS"`,
+				},
+			},
+		},
 	})
 }
 
@@ -0,0 +1,481 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestIndexAccessMethods(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "Hash and BRIN indexes",
+			SetUpScript: []string{
+				`CREATE TABLE logs (id INT8 PRIMARY KEY, created_at TIMESTAMP, level TEXT);`,
+				`INSERT INTO logs VALUES (1, '2026-01-01 00:00:00', 'info'), (2, '2026-01-02 00:00:00', 'warn'), (3, '2026-01-03 00:00:00', 'info');`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query: `CREATE INDEX logs_level_idx ON logs USING hash (level);`,
+				},
+				{
+					Query: `CREATE INDEX logs_created_at_idx ON logs USING brin (created_at);`,
+				},
+				{
+					Query:    `SELECT id FROM logs WHERE level = 'info' ORDER BY id;`,
+					Expected: []sql.Row{{1}, {3}},
+				},
+				{
+					Query:    `SELECT id FROM logs WHERE created_at >= '2026-01-02' ORDER BY id;`,
+					Expected: []sql.Row{{2}, {3}},
+				},
+				{
+					Query: `SELECT indexname, indexdef FROM pg_indexes WHERE tablename = 'logs' ORDER BY indexname;`,
+					Expected: []sql.Row{
+						{"logs_created_at_idx", "CREATE INDEX logs_created_at_idx ON public.logs USING brin (created_at)"},
+						{"logs_level_idx", "CREATE INDEX logs_level_idx ON public.logs USING hash (level)"},
+						{"logs_pkey", "CREATE UNIQUE INDEX logs_pkey ON public.logs USING btree (id)"},
+					},
+				},
+				{
+					Query: `SELECT c.relname, am.amname FROM pg_class c JOIN pg_am am ON c.relam = am.oid
+							WHERE c.relname IN ('logs_created_at_idx', 'logs_level_idx', 'logs_pkey') ORDER BY c.relname;`,
+					Expected: []sql.Row{
+						{"logs_created_at_idx", "brin"},
+						{"logs_level_idx", "hash"},
+						{"logs_pkey", "btree"},
+					},
+				},
+				{
+					Query:    `SELECT pg_get_indexdef('logs_level_idx'::regclass);`,
+					Expected: []sql.Row{{"CREATE INDEX logs_level_idx ON public.logs USING hash (level)"}},
+				},
+				{
+					Query:       `CREATE UNIQUE INDEX logs_level_uniq ON logs USING hash (level);`,
+					ExpectedErr: `access method "hash" does not support unique indexes`,
+				},
+				{
+					Query:       `CREATE INDEX logs_multi_idx ON logs USING hash (level, created_at);`,
+					ExpectedErr: `access method "hash" does not support multicolumn indexes`,
+				},
+				{
+					Query:       `CREATE INDEX logs_bad_idx ON logs USING nonsense (level);`,
+					ExpectedErr: `access method "nonsense" does not exist`,
+				},
+				{
+					Query:       `CREATE INDEX logs_gist_idx ON logs USING gist (level);`,
+					ExpectedErr: `not yet supported`,
+				},
+				{
+					Query:       `CREATE INDEX logs_opclass_idx ON logs USING hash (level text_ops);`,
+					ExpectedErr: `operator classes are not yet supported for access method "hash"`,
+				},
+				{
+					Query:    `SELECT count(*) FROM pg_indexes WHERE indexdef LIKE '%doltgres%';`,
+					Expected: []sql.Row{{0}},
+				},
+				{
+					Query: `DROP INDEX logs_level_idx;`,
+				},
+				{
+					Query: `CREATE INDEX logs_level_idx ON logs (level);`,
+				},
+				{
+					Query:    `SELECT pg_get_indexdef('logs_level_idx'::regclass);`,
+					Expected: []sql.Row{{"CREATE INDEX logs_level_idx ON public.logs USING btree (level)"}},
+				},
+				{
+					Query: `ALTER TABLE logs RENAME TO events_log;`,
+				},
+				{
+					Query:    `SELECT indexname, indexdef FROM pg_indexes WHERE tablename = 'events_log' AND indexname = 'logs_created_at_idx';`,
+					Expected: []sql.Row{{"logs_created_at_idx", "CREATE INDEX logs_created_at_idx ON public.events_log USING brin (created_at)"}},
+				},
+				{
+					Query: `DROP TABLE events_log;`,
+				},
+				{
+					Query:    `SELECT count(*) FROM dolt_diff_index_access_methods WHERE to_commit = 'WORKING';`,
+					Expected: []sql.Row{{0}},
+				},
+			},
+		},
+		{
+			Name: "GIN index on jsonb",
+			SetUpScript: []string{
+				`CREATE TABLE docs (id INT4 PRIMARY KEY, body JSONB);`,
+				`INSERT INTO docs VALUES (1, '{"a": 1, "tags": ["x", "y"]}'), (2, '{"a": 2, "b": {"c": true}}'), (3, '{"b": {"c": false}}'), (4, NULL);`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query: `CREATE INDEX docs_body_idx ON docs USING gin (body);`,
+				},
+				{
+					Query: `CREATE INDEX IF NOT EXISTS docs_body_idx ON docs USING gin (body);`,
+					ExpectedNotices: []ExpectedNotice{
+						{
+							Severity: "NOTICE",
+							Message:  `relation "docs_body_idx" already exists, skipping`,
+						},
+					},
+				},
+				{
+					Query:       `CREATE INDEX docs_body_idx ON docs USING gin (body);`,
+					ExpectedErr: `already exists`,
+				},
+				{
+					Query:    `SELECT id FROM docs WHERE body @> '{"a": 1}' ORDER BY id;`,
+					Expected: []sql.Row{{1}},
+				},
+				{
+					Query:    `SELECT id FROM docs WHERE body @> '{"tags": ["y"]}' ORDER BY id;`,
+					Expected: []sql.Row{{1}},
+				},
+				{
+					Query:    `SELECT id FROM docs WHERE body @> '{"b": {"c": true}}' ORDER BY id;`,
+					Expected: []sql.Row{{2}},
+				},
+				{
+					Query:    `SELECT id FROM docs WHERE body @> '{}' ORDER BY id;`,
+					Expected: []sql.Row{{1}, {2}, {3}},
+				},
+				{
+					Query:    `SELECT id FROM docs WHERE '{"a": 2}' <@ body ORDER BY id;`,
+					Expected: []sql.Row{{2}},
+				},
+				{
+					Query:    `SELECT id FROM docs WHERE body ? 'b' ORDER BY id;`,
+					Expected: []sql.Row{{2}, {3}},
+				},
+				{
+					Query:    `SELECT id FROM docs WHERE body ?| ARRAY['tags', 'b'] ORDER BY id;`,
+					Expected: []sql.Row{{1}, {2}, {3}},
+				},
+				{
+					Query:    `SELECT id FROM docs WHERE body ?& ARRAY['a', 'b'] ORDER BY id;`,
+					Expected: []sql.Row{{2}},
+				},
+				{
+					Query: `INSERT INTO docs VALUES (5, '{"a": 1, "z": "new"}');`,
+				},
+				{
+					Query: `UPDATE docs SET body = '{"a": 3}' WHERE id = 1;`,
+				},
+				{
+					Query: `DELETE FROM docs WHERE id = 2;`,
+				},
+				{
+					Query:    `SELECT id FROM docs WHERE body @> '{"a": 1}' ORDER BY id;`,
+					Expected: []sql.Row{{5}},
+				},
+				{
+					Query:    `SELECT id FROM docs WHERE body @> '{"a": 3}' ORDER BY id;`,
+					Expected: []sql.Row{{1}},
+				},
+				{
+					Query:    `SELECT id FROM docs WHERE body ? 'b' ORDER BY id;`,
+					Expected: []sql.Row{{3}},
+				},
+				{
+					Query: `UPDATE docs SET body = body WHERE body @> '{"a": 1}';`,
+				},
+				{
+					Query:    `SELECT d.id FROM docs d WHERE d.body @> '{"z": "new"}' ORDER BY d.id;`,
+					Expected: []sql.Row{{5}},
+				},
+				{
+					Query: `SELECT indexname, indexdef FROM pg_indexes WHERE tablename = 'docs' ORDER BY indexname;`,
+					Expected: []sql.Row{
+						{"docs_body_idx", "CREATE INDEX docs_body_idx ON public.docs USING gin (body)"},
+						{"docs_pkey", "CREATE UNIQUE INDEX docs_pkey ON public.docs USING btree (id)"},
+					},
+				},
+				{
+					Query:    `SELECT count(*) FROM pg_class WHERE relname LIKE 'dg_gin_%';`,
+					Expected: []sql.Row{{0}},
+				},
+				{
+					Query: `TRUNCATE docs;`,
+				},
+				{
+					Query: `INSERT INTO docs VALUES (6, '{"a": 1}');`,
+				},
+				{
+					Query:    `SELECT id FROM docs WHERE body @> '{"a": 1}' ORDER BY id;`,
+					Expected: []sql.Row{{6}},
+				},
+				{
+					Query: `DROP INDEX docs_body_idx;`,
+				},
+				{
+					Query:    `SELECT id FROM docs WHERE body @> '{"a": 1}' ORDER BY id;`,
+					Expected: []sql.Row{{6}},
+				},
+			},
+		},
+		{
+			Name: "GIN index with jsonb_path_ops",
+			SetUpScript: []string{
+				`CREATE TABLE events (id INT4 PRIMARY KEY, payload JSONB);`,
+				`INSERT INTO events VALUES (1, '{"user": {"name": "a"}, "n": 1.0}'), (2, '{"user": {"name": "b"}, "n": 2}');`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query: `CREATE INDEX ON events USING gin (payload jsonb_path_ops);`,
+				},
+				{
+					Query:    `SELECT id FROM events WHERE payload @> '{"user": {"name": "b"}}';`,
+					Expected: []sql.Row{{2}},
+				},
+				{
+					Query:    `SELECT id FROM events WHERE payload @> '{"n": 1}';`,
+					Expected: []sql.Row{{1}},
+				},
+				{
+					Query:    `SELECT id FROM events WHERE payload ? 'user' ORDER BY id;`,
+					Expected: []sql.Row{{1}, {2}},
+				},
+				{
+					Query: `SELECT indexname, indexdef FROM pg_indexes WHERE tablename = 'events' ORDER BY indexname;`,
+					Expected: []sql.Row{
+						{"events_payload_idx", "CREATE INDEX events_payload_idx ON public.events USING gin (payload jsonb_path_ops)"},
+						{"events_pkey", "CREATE UNIQUE INDEX events_pkey ON public.events USING btree (id)"},
+					},
+				},
+				{
+					Query:       `CREATE INDEX events_bad_idx ON events USING gin (payload array_ops);`,
+					ExpectedErr: `operator class "array_ops" does not accept data type jsonb`,
+				},
+				{
+					Query:       `CREATE INDEX events_bad_idx ON events USING gin (payload nonsense_ops);`,
+					ExpectedErr: `operator class "nonsense_ops" does not exist for access method "gin"`,
+				},
+				{
+					Query:       `CREATE INDEX events_bad_idx ON events USING gin (id);`,
+					ExpectedErr: `data type integer has no default operator class for access method "gin"`,
+				},
+				{
+					Query: `DROP TABLE events;`,
+				},
+				{
+					Query:    `SELECT count(*) FROM dolt_status WHERE table_name LIKE '%dg_gin_%';`,
+					Expected: []sql.Row{{0}},
+				},
+			},
+		},
+		{
+			Name: "GIN index on arrays",
+			SetUpScript: []string{
+				`CREATE TABLE items (id INT4 PRIMARY KEY, tags TEXT[]);`,
+				`INSERT INTO items VALUES (1, ARRAY['red', 'blue']), (2, ARRAY['green']), (3, ARRAY['blue', 'green', NULL]), (4, '{}');`,
+				`CREATE INDEX items_tags_idx ON items USING gin (tags);`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT id FROM items WHERE tags && ARRAY['blue'] ORDER BY id;`,
+					Expected: []sql.Row{{1}, {3}},
+				},
+				{
+					Query:    `SELECT id FROM items WHERE tags @> ARRAY['blue', 'green'] ORDER BY id;`,
+					Expected: []sql.Row{{3}},
+				},
+				{
+					Query:    `SELECT id FROM items WHERE tags @> '{}'::text[] ORDER BY id;`,
+					Expected: []sql.Row{{1}, {2}, {3}, {4}},
+				},
+				{
+					Query:    `SELECT id FROM items WHERE ARRAY['green'] && tags ORDER BY id;`,
+					Expected: []sql.Row{{2}, {3}},
+				},
+				{
+					Query:    `SELECT id FROM items WHERE tags <@ ARRAY['red', 'blue', 'yellow'] ORDER BY id;`,
+					Expected: []sql.Row{{1}, {4}},
+				},
+				{
+					Query: `UPDATE items SET tags = ARRAY['yellow'] WHERE id = 2;`,
+				},
+				{
+					Query:    `SELECT id FROM items WHERE tags && ARRAY['green', 'yellow'] ORDER BY id;`,
+					Expected: []sql.Row{{2}, {3}},
+				},
+				{
+					Query: `SELECT c.relname, am.amname FROM pg_class c JOIN pg_am am ON c.relam = am.oid WHERE c.relname = 'items_tags_idx';`,
+					Expected: []sql.Row{
+						{"items_tags_idx", "gin"},
+					},
+				},
+			},
+		},
+		{
+			Name: "GIN index on a materialized view",
+			SetUpScript: []string{
+				`CREATE TABLE src (id INT4 PRIMARY KEY, label TEXT, body JSONB);`,
+				`INSERT INTO src VALUES (1, 'a', '{"k": 1}'), (2, NULL, '{"k": 2}');`,
+				`CREATE MATERIALIZED VIEW mv AS SELECT label, body FROM src;`,
+				`CREATE INDEX mv_body_idx ON mv USING gin (body);`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT label FROM mv WHERE body @> '{"k": 1}' ORDER BY label;`,
+					Expected: []sql.Row{{"a"}},
+				},
+				{
+					// The rows of a materialized view are found by every column, including those that are NULL
+					Query:    `SELECT count(*) FROM mv WHERE body @> '{"k": 2}';`,
+					Expected: []sql.Row{{1}},
+				},
+				{
+					Query: `INSERT INTO src VALUES (3, 'c', '{"k": 1}'), (4, 'c', '{"k": 1}');`,
+				},
+				{
+					Query: `UPDATE src SET body = '{"k": 3}' WHERE id = 1;`,
+				},
+				{
+					Query:    `SELECT label FROM mv WHERE body @> '{"k": 1}' ORDER BY label;`,
+					Expected: []sql.Row{{"a"}},
+				},
+				{
+					Query: `REFRESH MATERIALIZED VIEW mv;`,
+				},
+				{
+					Query:    `SELECT label FROM mv WHERE body @> '{"k": 1}' ORDER BY label;`,
+					Expected: []sql.Row{{"c"}, {"c"}},
+				},
+				{
+					Query:    `SELECT label FROM mv WHERE body @> '{"k": 3}' ORDER BY label;`,
+					Expected: []sql.Row{{"a"}},
+				},
+				{
+					Query:    `SELECT count(*) FROM mv WHERE body ? 'k';`,
+					Expected: []sql.Row{{4}},
+				},
+				{
+					Query: `DELETE FROM src WHERE id = 3;`,
+				},
+				{
+					Query: `REFRESH MATERIALIZED VIEW mv;`,
+				},
+				{
+					Query:    `SELECT label FROM mv WHERE body @> '{"k": 1}' ORDER BY label;`,
+					Expected: []sql.Row{{"c"}},
+				},
+				{
+					Query:    `SELECT count(*) FROM pg_class WHERE relname LIKE 'dg_gin_%';`,
+					Expected: []sql.Row{{0}},
+				},
+			},
+		},
+		{
+			Name: "GIN index requires a primary key on tables",
+			SetUpScript: []string{
+				`CREATE TABLE keyless (body JSONB);`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       `CREATE INDEX ON keyless USING gin (body);`,
+					ExpectedErr: `GIN indexes are not yet supported on tables without a primary key`,
+				},
+			},
+		},
+		{
+			Name: "GIN posting tables are hidden from Dolt and versioned with their tables",
+			SetUpScript: []string{
+				`CREATE TABLE docs (id INT4 PRIMARY KEY, body JSONB);`,
+				`INSERT INTO docs VALUES (1, '{"a": 1}'), (2, '{"a": 2}');`,
+				`CREATE INDEX docs_body_idx ON docs USING gin (body);`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT table_name FROM dolt_status ORDER BY table_name;`,
+					Expected: []sql.Row{{"public.docs"}},
+				},
+				{
+					Query:    `SELECT dolt_add('docs');`,
+					Expected: []sql.Row{{int64(0)}},
+				},
+				{
+					Query:            `SELECT dolt_commit('-m', 'add docs');`,
+					SkipResultsCheck: true,
+				},
+				{
+					Query: `UPDATE docs SET body = '{"a": 3}' WHERE id = 1;`,
+				},
+				{
+					Query:    `SELECT table_name FROM dolt_diff WHERE commit_hash = 'WORKING' ORDER BY table_name;`,
+					Expected: []sql.Row{{"public.docs"}},
+				},
+				{
+					Query:    `SELECT count(*) FROM dolt_diff_summary('HEAD', 'WORKING') WHERE to_table_name LIKE '%dg_gin_%';`,
+					Expected: []sql.Row{{0}},
+				},
+				{
+					Query:    `SELECT count(*) FROM dolt_patch('HEAD', 'WORKING') WHERE table_name LIKE '%dg_gin_%';`,
+					Expected: []sql.Row{{0}},
+				},
+				{
+					Query:            `SELECT dolt_checkout('docs');`,
+					SkipResultsCheck: true,
+				},
+				{
+					Query:    `SELECT id FROM docs WHERE body @> '{"a": 1}' ORDER BY id;`,
+					Expected: []sql.Row{{1}},
+				},
+				{
+					Query:    `SELECT id FROM docs WHERE body @> '{"a": 3}' ORDER BY id;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SELECT count(*) FROM dolt_status;`,
+					Expected: []sql.Row{{0}},
+				},
+			},
+		},
+		{
+			Name: "GIN operator classes in the catalogs",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query: `SELECT opc.opcname, opf.opfname, t.typname, opc.opcdefault
+							FROM pg_catalog.pg_opclass opc
+							JOIN pg_catalog.pg_am am ON opc.opcmethod = am.oid
+							JOIN pg_catalog.pg_opfamily opf ON opc.opcfamily = opf.oid
+							JOIN pg_catalog.pg_type t ON opc.opcintype = t.oid
+							WHERE am.amname = 'gin'
+							ORDER BY opc.opcname;`,
+					Expected: []sql.Row{
+						{"array_ops", "array_ops", "anyarray", "t"},
+						{"jsonb_ops", "jsonb_ops", "jsonb", "t"},
+						{"jsonb_path_ops", "jsonb_path_ops", "jsonb", "f"},
+					},
+				},
+				{
+					Query: `SELECT opf.oid, opf.opfname FROM pg_catalog.pg_opfamily opf
+							JOIN pg_catalog.pg_am am ON opf.opfmethod = am.oid
+							WHERE am.amname = 'gin' ORDER BY opf.oid;`,
+					Expected: []sql.Row{
+						{2745, "array_ops"},
+						{4036, "jsonb_ops"},
+						{4037, "jsonb_path_ops"},
+					},
+				},
+				{
+					Query:    `SELECT count(*) FROM pg_catalog.pg_opclass opc JOIN pg_catalog.pg_am am ON opc.opcmethod = am.oid WHERE am.amname = 'brin';`,
+					Expected: []sql.Row{{11}},
+				},
+			},
+		},
+	})
+}
@@ -1084,7 +1084,7 @@ func TestBasicIndexing(t *testing.T) {
 					Query: "CREATE INDEX v1_idx ON test(v1 varchar_pattern_ops) WITH (storage_opt1 = foo) TABLESPACE tablespace_name;",
 				},
 				{
-					Query:       "CREATE INDEX v1_idx2 ON test using hash (v1);",
+					Query:       "CREATE INDEX v1_idx2 ON test using gist (v1);",
 					ExpectedErr: "not yet supported",
 				},
 				{
@@ -0,0 +1,285 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestMaterializedViews(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "Create and refresh a materialized view",
+			SetUpScript: []string{
+				`CREATE TABLE t1 (pk INT4 PRIMARY KEY, v1 INT4);`,
+				`INSERT INTO t1 VALUES (1, 10), (2, 20);`,
+				`CREATE MATERIALIZED VIEW mv1 AS SELECT pk, v1 * 2 AS doubled FROM t1;`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT * FROM mv1 ORDER BY pk;`,
+					Expected: []sql.Row{{1, 20}, {2, 40}},
+				},
+				{
+					Query:    `INSERT INTO t1 VALUES (3, 30);`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SELECT * FROM mv1 ORDER BY pk;`,
+					Expected: []sql.Row{{1, 20}, {2, 40}},
+				},
+				{
+					Query:    `REFRESH MATERIALIZED VIEW mv1;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SELECT * FROM mv1 ORDER BY pk;`,
+					Expected: []sql.Row{{1, 20}, {2, 40}, {3, 60}},
+				},
+				{
+					Query:       `CREATE MATERIALIZED VIEW mv1 AS SELECT 1;`,
+					ExpectedErr: `relation "mv1" already exists`,
+				},
+				{
+					Query:    `CREATE MATERIALIZED VIEW IF NOT EXISTS mv1 AS SELECT 1;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       `REFRESH MATERIALIZED VIEW t1;`,
+					ExpectedErr: `"t1" is not a materialized view`,
+				},
+			},
+		},
+		{
+			Name: "Column names",
+			SetUpScript: []string{
+				`CREATE TABLE t1 (pk INT4 PRIMARY KEY, v1 TEXT);`,
+				`INSERT INTO t1 VALUES (1, 'a');`,
+				`CREATE MATERIALIZED VIEW mv1 (id, val) AS SELECT pk, v1 FROM t1;`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT id, val FROM mv1;`,
+					Expected: []sql.Row{{1, "a"}},
+				},
+			},
+		},
+		{
+			Name: "WITH NO DATA",
+			SetUpScript: []string{
+				`CREATE TABLE t1 (pk INT4 PRIMARY KEY);`,
+				`INSERT INTO t1 VALUES (1), (2);`,
+				`CREATE MATERIALIZED VIEW mv1 AS SELECT pk FROM t1 WITH NO DATA;`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       `SELECT * FROM mv1;`,
+					ExpectedErr: `materialized view "mv1" has not been populated`,
+				},
+				{
+					Query:    `SELECT matviewname, ispopulated FROM pg_catalog.pg_matviews;`,
+					Expected: []sql.Row{{"mv1", "f"}},
+				},
+				{
+					Query:       `REFRESH MATERIALIZED VIEW CONCURRENTLY mv1;`,
+					ExpectedErr: `CONCURRENTLY cannot be used when the materialized view is not populated`,
+				},
+				{
+					Query:    `REFRESH MATERIALIZED VIEW mv1;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SELECT * FROM mv1 ORDER BY pk;`,
+					Expected: []sql.Row{{1}, {2}},
+				},
+				{
+					Query:    `REFRESH MATERIALIZED VIEW mv1 WITH NO DATA;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       `SELECT * FROM mv1;`,
+					ExpectedErr: `materialized view "mv1" has not been populated`,
+				},
+				{
+					Query:       `REFRESH MATERIALIZED VIEW CONCURRENTLY mv1 WITH NO DATA;`,
+					ExpectedErr: `REFRESH options CONCURRENTLY and WITH NO DATA cannot be used together`,
+				},
+			},
+		},
+		{
+			Name: "Concurrent refresh",
+			SetUpScript: []string{
+				`CREATE TABLE t1 (pk INT4 PRIMARY KEY, v1 TEXT);`,
+				`INSERT INTO t1 VALUES (1, 'a'), (2, 'b'), (3, 'c');`,
+				`CREATE MATERIALIZED VIEW mv1 AS SELECT pk, v1 FROM t1;`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       `REFRESH MATERIALIZED VIEW CONCURRENTLY mv1;`,
+					ExpectedErr: `cannot refresh materialized view "public.mv1" concurrently`,
+				},
+				{
+					Query:    `CREATE UNIQUE INDEX mv1_pk ON mv1 (pk);`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SELECT hasindexes FROM pg_catalog.pg_matviews WHERE matviewname = 'mv1';`,
+					Expected: []sql.Row{{"t"}},
+				},
+				{
+					Query:    `SELECT length(dolt_commit('-Am', 'initial')::text) = 32;`,
+					Expected: []sql.Row{{"t"}},
+				},
+				{
+					Query:    `UPDATE t1 SET v1 = 'bb' WHERE pk = 2;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `DELETE FROM t1 WHERE pk = 3;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `INSERT INTO t1 VALUES (4, 'd');`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `REFRESH MATERIALIZED VIEW CONCURRENTLY mv1;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SELECT * FROM mv1 ORDER BY pk;`,
+					Expected: []sql.Row{{1, "a"}, {2, "bb"}, {4, "d"}},
+				},
+				{
+					// Only the rows that changed are recorded, so the unchanged row is absent
+					Query:    `SELECT from_pk, from_v1, to_pk, to_v1, diff_type FROM dolt_diff('HEAD', 'WORKING', 'mv1') ORDER BY coalesce(to_pk, from_pk), diff_type;`,
+					Expected: []sql.Row{{nil, nil, 2, "bb", "added"}, {2, "b", nil, nil, "removed"}, {3, "c", nil, nil, "removed"}, {nil, nil, 4, "d", "added"}},
+				},
+			},
+		},
+		{
+			Name: "Materialized views cannot be modified directly",
+			SetUpScript: []string{
+				`CREATE TABLE t1 (pk INT4 PRIMARY KEY);`,
+				`INSERT INTO t1 VALUES (1);`,
+				`CREATE MATERIALIZED VIEW mv1 AS SELECT pk FROM t1;`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       `INSERT INTO mv1 VALUES (2);`,
+					ExpectedErr: `cannot change materialized view "mv1"`,
+				},
+				{
+					Query:       `UPDATE mv1 SET pk = 2;`,
+					ExpectedErr: `cannot change materialized view "mv1"`,
+				},
+				{
+					Query:       `DELETE FROM mv1;`,
+					ExpectedErr: `cannot change materialized view "mv1"`,
+				},
+				{
+					Query:       `TRUNCATE mv1;`,
+					ExpectedErr: `cannot change materialized view "mv1"`,
+				},
+				{
+					Query:    `SELECT * FROM mv1;`,
+					Expected: []sql.Row{{1}},
+				},
+			},
+		},
+		{
+			Name: "Materialized views in pg_class",
+			SetUpScript: []string{
+				`CREATE TABLE t1 (pk INT4 PRIMARY KEY);`,
+				`CREATE MATERIALIZED VIEW mv1 AS SELECT pk FROM t1;`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT relname, relkind FROM pg_catalog.pg_class WHERE relname IN ('t1', 'mv1') ORDER BY relname;`,
+					Expected: []sql.Row{{"mv1", "m"}, {"t1", "r"}},
+				},
+				{
+					Query:    `SELECT schemaname, matviewname, ispopulated, hasindexes FROM pg_catalog.pg_matviews;`,
+					Expected: []sql.Row{{"public", "mv1", "t", "f"}},
+				},
+			},
+		},
+		{
+			Name: "Rename and drop a materialized view",
+			SetUpScript: []string{
+				`CREATE TABLE t1 (pk INT4 PRIMARY KEY);`,
+				`INSERT INTO t1 VALUES (1);`,
+				`CREATE MATERIALIZED VIEW mv1 AS SELECT pk FROM t1;`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `ALTER MATERIALIZED VIEW mv1 RENAME TO mv2;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `INSERT INTO t1 VALUES (2);`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `REFRESH MATERIALIZED VIEW mv2;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SELECT * FROM mv2 ORDER BY pk;`,
+					Expected: []sql.Row{{1}, {2}},
+				},
+				{
+					Query:    `SELECT matviewname FROM pg_catalog.pg_matviews;`,
+					Expected: []sql.Row{{"mv2"}},
+				},
+				{
+					Query:       `DROP MATERIALIZED VIEW t1;`,
+					ExpectedErr: `"t1" is not a materialized view`,
+				},
+				{
+					Query:    `DROP MATERIALIZED VIEW mv2;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       `SELECT * FROM mv2;`,
+					ExpectedErr: `not found`,
+				},
+				{
+					Query:    `SELECT matviewname FROM pg_catalog.pg_matviews;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `DROP MATERIALIZED VIEW IF EXISTS mv2;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       `DROP MATERIALIZED VIEW mv2;`,
+					ExpectedErr: `materialized view "mv2" does not exist`,
+				},
+				{
+					// The name is free to be reused once the materialized view is dropped
+					Query:    `CREATE TABLE mv2 (pk INT4);`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SELECT relkind FROM pg_catalog.pg_class WHERE relname = 'mv2';`,
+					Expected: []sql.Row{{"r"}},
+				},
+			},
+		},
+	})
+}
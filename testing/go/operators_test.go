@@ -3810,12 +3810,10 @@ func TestOperators(t *testing.T) {
 				},
 				{
 					Query:    `SELECT '{"a":1, "b":2}'::jsonb @> '{"b":2}'::jsonb;`,
-					Skip:     true,
 					Expected: []sql.Row{{"t"}},
 				},
 				{
 					Query:    `SELECT '{"b":2}'::jsonb <@ '{"a":1, "b":2}'::jsonb;`,
-					Skip:     true,
 					Expected: []sql.Row{{"t"}},
 				},
 				{
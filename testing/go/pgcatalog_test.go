@@ -1362,7 +1362,8 @@ func TestPgDepend(t *testing.T) {
 						{"dep_test_id_seq", "dep_test", 1, "a"},
 					},
 				},
-				{ // Each column default (pg_attrdef entry) has an automatic dependency on its column
+				{ // Each column default (pg_attrdef entry) has an automatic dependency on its column, and the SERIAL
+					// column's default has a normal dependency on the sequence that it calls nextval on
 					Query: `SELECT c.relname, d.objsubid, d.refobjsubid, d.deptype
 							FROM pg_catalog.pg_depend d
 							JOIN pg_catalog.pg_attrdef ad ON d.objid = ad.oid
@@ -1370,6 +1371,7 @@ func TestPgDepend(t *testing.T) {
 							WHERE d.classid = 2604
 							ORDER BY d.refobjsubid;`,
 					Expected: []sql.Row{
+						{"dep_test_id_seq", 0, 0, "n"},
 						{"dep_test", 0, 1, "a"},
 						{"dep_test", 0, 2, "a"},
 					},
@@ -1388,6 +1390,7 @@ func TestPgDepend(t *testing.T) {
 						{1259},
 						{2604},
 						{2604},
+						{2604},
 					},
 				},
 			},
@@ -2749,7 +2752,16 @@ func TestPgProc(t *testing.T) {
 					Query: `SELECT * FROM "pg_catalog"."pg_proc";`,
 					Expected: []sql.Row{
 						{2891346960, "alt_func1", 2200, 0, 0, 1.0, 0.0, 0, nil, "f", "f", "f", "f", "f", "v", "u", 1, 0, 23, "23", nil, nil, nil, nil, nil, "SELECT $1 + 1", nil, nil, nil, nil},
-						{1886569565, "ptest5", 2200, 0, 0, 1.0, 0.0, 0, nil, "p", "f", "f", "f", "f", "v", "u", 3, 1, 2278, "23 25 23", nil, nil, "{a,b,c}", nil, nil, "INSERT INTO cp_test VALUES (a, b);INSERT INTO cp_test VALUES (c, b)", nil, nil, nil, nil}},
+						{1886569565, "ptest5", 2200, 0, 0, 1.0, 0.0, 0, nil, "p", "f", "f", "f", "f", "v", "u", 3, 1, 2278, "23 25 23", nil, nil, "{a,b,c}", nil, nil, "INSERT INTO cp_test VALUES (a, b);INSERT INTO cp_test VALUES (c, b)", nil, nil, nil, nil},
+						{1813, "convert", 11, 0, 0, 1.0, 0.0, 0, nil, "f", "f", "f", "t", "f", "s", "u", 3, 0, 17, "17 19 19", nil, nil, nil, nil, nil, "convert", nil, nil, nil, nil},
+						{1714, "convert_from", 11, 0, 0, 1.0, 0.0, 0, nil, "f", "f", "f", "t", "f", "s", "u", 2, 0, 25, "17 19", nil, nil, nil, nil, nil, "convert_from", nil, nil, nil, nil},
+						{364617814, "convert_to", 11, 0, 0, 1.0, 0.0, 0, nil, "f", "f", "f", "t", "f", "s", "u", 2, 0, 17, "25 19", nil, nil, nil, nil, nil, "convert_to", nil, nil, nil, nil},
+						{1947, "decode", 11, 0, 0, 1.0, 0.0, 0, nil, "f", "f", "f", "t", "f", "i", "u", 2, 0, 17, "25 25", nil, nil, nil, nil, nil, "decode", nil, nil, nil, nil},
+						{3419, "sha224", 11, 0, 0, 1.0, 0.0, 0, nil, "f", "f", "f", "t", "f", "i", "u", 1, 0, 17, "17", nil, nil, nil, nil, nil, "sha224", nil, nil, nil, nil},
+						{3420, "sha256", 11, 0, 0, 1.0, 0.0, 0, nil, "f", "f", "f", "t", "f", "i", "u", 1, 0, 17, "17", nil, nil, nil, nil, nil, "sha256", nil, nil, nil, nil},
+						{3421, "sha384", 11, 0, 0, 1.0, 0.0, 0, nil, "f", "f", "f", "t", "f", "i", "u", 1, 0, 17, "17", nil, nil, nil, nil, nil, "sha384", nil, nil, nil, nil},
+						{3422, "sha512", 11, 0, 0, 1.0, 0.0, 0, nil, "f", "f", "f", "t", "f", "i", "u", 1, 0, 17, "17", nil, nil, nil, nil, nil, "sha512", nil, nil, nil, nil},
+					},
 				},
 				{ // Different cases and quoted, so it fails
 					Query:       `SELECT * FROM "PG_catalog"."pg_proc";`,
@@ -2761,12 +2773,29 @@ func TestPgProc(t *testing.T) {
 				},
 				{ // Different cases but non-quoted, so it works
 					Query:    "SELECT proname FROM PG_catalog.pg_PROC ORDER BY proname;",
-					Expected: []sql.Row{{"alt_func1"}, {"ptest5"}},
+					Expected: []sql.Row{{"alt_func1"}, {"convert"}, {"convert_from"}, {"convert_to"}, {"decode"}, {"ptest5"}, {"sha224"}, {"sha256"}, {"sha384"}, {"sha512"}},
 				},
 				{
 					Query:    `SELECT t1.oid, t1.typname, p1.oid, p1.proname FROM pg_type AS t1, pg_proc AS p1 WHERE t1.typinput = p1.oid;`,
 					Expected: []sql.Row{},
 				},
+				{
+					Query: `SELECT p.proname, p.proargtypes, p.prorettype, p.proisstrict, p.provolatile FROM pg_proc p JOIN pg_namespace n ON n.oid = p.pronamespace WHERE n.nspname = 'pg_catalog' AND p.proname IN ('sha224', 'sha256', 'sha384', 'sha512', 'decode', 'convert', 'convert_from', 'convert_to') ORDER BY p.proname;`,
+					Expected: []sql.Row{
+						{"convert", "17 19 19", 17, "t", "s"},
+						{"convert_from", "17 19", 25, "t", "s"},
+						{"convert_to", "25 19", 17, "t", "s"},
+						{"decode", "25 25", 17, "t", "i"},
+						{"sha224", "17", 17, "t", "i"},
+						{"sha256", "17", 17, "t", "i"},
+						{"sha384", "17", 17, "t", "i"},
+						{"sha512", "17", 17, "t", "i"},
+					},
+				},
+				{
+					Query:    `SELECT 'sha256'::regproc::oid = (SELECT oid FROM pg_proc WHERE proname = 'sha256');`,
+					Expected: []sql.Row{{"t"}},
+				},
 			},
 		},
 	})
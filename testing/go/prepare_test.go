@@ -0,0 +1,227 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrepare(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "PREPARE, EXECUTE and DEALLOCATE",
+			SetUpScript: []string{
+				`CREATE TABLE items (id int primary key, name text);`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query: `PREPARE ins(int, text) AS INSERT INTO items VALUES ($1, $2);`,
+				},
+				{
+					Query:       `EXECUTE ins(1, 'one');`,
+					ExpectedTag: `INSERT 0 1`,
+				},
+				{
+					Query:       `EXECUTE ins(2, 'two');`,
+					ExpectedTag: `INSERT 0 1`,
+				},
+				{
+					Query: `PREPARE sel(int) AS SELECT name FROM items WHERE id = $1;`,
+				},
+				{
+					Query:    `EXECUTE sel(2);`,
+					Expected: []sql.Row{{"two"}},
+				},
+				{
+					Query:    `EXECUTE sel('1');`,
+					Expected: []sql.Row{{"one"}},
+				},
+				{
+					Query:    `EXECUTE sel(1 + 1);`,
+					Expected: []sql.Row{{"two"}},
+				},
+				{
+					Query:       `PREPARE sel AS SELECT 1;`,
+					ExpectedErr: `prepared statement "sel" already exists`,
+				},
+				{
+					Query:       `EXECUTE sel(1, 2);`,
+					ExpectedErr: `wrong number of parameters for prepared statement "sel"`,
+				},
+				{
+					Query:       `EXECUTE missing;`,
+					ExpectedErr: `prepared statement "missing" does not exist`,
+				},
+				{
+					Query: `DEALLOCATE sel;`,
+				},
+				{
+					Query:       `EXECUTE sel(1);`,
+					ExpectedErr: `prepared statement "sel" does not exist`,
+				},
+				{
+					Query:       `DEALLOCATE sel;`,
+					ExpectedErr: `prepared statement "sel" does not exist`,
+				},
+				{
+					Query: `DEALLOCATE ALL;`,
+				},
+				{
+					Query:       `EXECUTE ins(3, 'three');`,
+					ExpectedErr: `prepared statement "ins" does not exist`,
+				},
+				{
+					Query:    `SELECT * FROM items ORDER BY id;`,
+					Expected: []sql.Row{{1, "one"}, {2, "two"}},
+				},
+			},
+		},
+		{
+			Name: "parameter type inference",
+			SetUpScript: []string{
+				`CREATE TABLE items (id int primary key, name text, price numeric);`,
+				`INSERT INTO items VALUES (1, 'one', 1.50), (2, 'two', 2.25);`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query: `PREPARE upd AS UPDATE items SET name = $2 WHERE id = $1;`,
+				},
+				{
+					Query:       `EXECUTE upd(1, 'uno');`,
+					ExpectedTag: `UPDATE 1`,
+				},
+				{
+					Query: `PREPARE cheaper AS SELECT id FROM items WHERE price < $1 ORDER BY id;`,
+				},
+				{
+					Query:    `EXECUTE cheaper(2);`,
+					Expected: []sql.Row{{1}},
+				},
+				{
+					Query: `PREPARE partial(int) AS SELECT name FROM items WHERE id = $1 AND price > $2;`,
+				},
+				{
+					Query:    `EXECUTE partial(2, '2.00');`,
+					Expected: []sql.Row{{"two"}},
+				},
+				{
+					Query: `PREPARE ret(int) AS DELETE FROM items WHERE id = $1 RETURNING name;`,
+				},
+				{
+					Query:    `EXECUTE ret(1);`,
+					Expected: []sql.Row{{"uno"}},
+				},
+				{
+					Query:    `SELECT name, array_length(parameter_types, 1), parameter_types[1]::text FROM pg_prepared_statements WHERE name IN ('partial', 'ret') ORDER BY name;`,
+					Expected: []sql.Row{{"partial", 2, "integer"}, {"ret", 1, "integer"}},
+				},
+			},
+		},
+		{
+			Name: "pg_prepared_statements",
+			SetUpScript: []string{
+				`CREATE TABLE items (id int primary key, name text);`,
+				`INSERT INTO items VALUES (1, 'one');`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT * FROM pg_prepared_statements;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query: `PREPARE q(int) AS SELECT name FROM items WHERE id = $1;`,
+				},
+				{
+					Query: `PREPARE q2(text, int) AS INSERT INTO items VALUES ($2, $1);`,
+				},
+				{
+					Query: `SELECT name, statement, prepare_time IS NOT NULL, parameter_types[1]::text, parameter_types[2]::text, from_sql, generic_plans, custom_plans FROM pg_prepared_statements ORDER BY name;`,
+					Expected: []sql.Row{
+						{"q", "PREPARE q(int) AS SELECT name FROM items WHERE id = $1", "t", "integer", nil, "t", 0, 0},
+						{"q2", "PREPARE q2(text, int) AS INSERT INTO items VALUES ($2, $1)", "t", "text", "integer", "t", 0, 0},
+					},
+				},
+				{
+					Query:    `SELECT name, result_types[1]::text, result_types IS NULL FROM pg_prepared_statements ORDER BY name;`,
+					Expected: []sql.Row{{"q", "text", "f"}, {"q2", nil, "t"}},
+				},
+				{
+					Query:       `EXECUTE q2('two', 2);`,
+					ExpectedTag: `INSERT 0 1`,
+				},
+				{
+					Query:    `EXECUTE q(2);`,
+					Expected: []sql.Row{{"two"}},
+				},
+				{
+					Query: `DEALLOCATE q;`,
+				},
+				{
+					Query:    `SELECT name FROM pg_prepared_statements;`,
+					Expected: []sql.Row{{"q2"}},
+				},
+				{
+					Query: `DISCARD ALL;`,
+				},
+				{
+					Query:    `SELECT name FROM pg_prepared_statements;`,
+					Expected: []sql.Row{},
+				},
+			},
+		},
+	})
+}
+
+// TestPrepareWithProtocolStatements checks that statements that are prepared through the extended query protocol are
+// shared with the SQL-level prepared statements.
+func TestPrepareWithProtocolStatements(t *testing.T) {
+	ctx, conn, controller := CreateServer(t, "postgres")
+	defer func() {
+		conn.Close(ctx)
+		controller.Stop()
+		err := controller.WaitForStop()
+		require.NoError(t, err)
+	}()
+
+	_, err := conn.Exec(ctx, "CREATE TABLE items (id int primary key, name text);")
+	require.NoError(t, err)
+	_, err = conn.Exec(ctx, "INSERT INTO items VALUES (1, 'one');")
+	require.NoError(t, err)
+	_, err = conn.Current.Prepare(ctx, "find_item", "SELECT name FROM items WHERE id = $1::int4;")
+	require.NoError(t, err)
+
+	rows, err := conn.Query(ctx, "SELECT name, from_sql, parameter_types[1]::text, result_types[1]::text FROM pg_prepared_statements;")
+	require.NoError(t, err)
+	readRows, _, err := ReadRows(rows, true)
+	require.NoError(t, err)
+	require.Equal(t, []sql.Row{{"find_item", "f", "integer", "text"}}, readRows)
+
+	rows, err = conn.Query(ctx, "EXECUTE find_item(1);")
+	require.NoError(t, err)
+	readRows, _, err = ReadRows(rows, true)
+	require.NoError(t, err)
+	require.Equal(t, []sql.Row{{"one"}}, readRows)
+
+	_, err = conn.Exec(ctx, "DEALLOCATE find_item;")
+	require.NoError(t, err)
+	rows, err = conn.Query(ctx, "SELECT name FROM pg_prepared_statements;")
+	require.NoError(t, err)
+	readRows, _, err = ReadRows(rows, true)
+	require.NoError(t, err)
+	require.Empty(t, readRows)
+}
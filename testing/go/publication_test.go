@@ -0,0 +1,350 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/jackc/pglogrepl"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgproto3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublications(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "CREATE, ALTER, and DROP PUBLICATION",
+			SetUpScript: []string{
+				`CREATE TABLE t1 (pk INT PRIMARY KEY, v1 TEXT);`,
+				`CREATE TABLE t2 (pk INT PRIMARY KEY);`,
+				`CREATE SCHEMA other;`,
+				`CREATE TABLE other.t3 (pk INT PRIMARY KEY);`,
+				`CREATE PUBLICATION pub1 FOR TABLE t1, t2 WITH (publish = 'insert, update');`,
+				`CREATE PUBLICATION pub2 FOR ALL TABLES;`,
+				`CREATE PUBLICATION pub3;`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query: `SELECT pubname, puballtables, pubinsert, pubupdate, pubdelete, pubtruncate, pubviaroot FROM pg_publication ORDER BY pubname;`,
+					Expected: []sql.Row{
+						{"pub1", "f", "t", "t", "f", "f", "f"},
+						{"pub2", "t", "t", "t", "t", "t", "f"},
+						{"pub3", "f", "t", "t", "t", "t", "f"},
+					},
+				},
+				{
+					Query:    `SELECT r.rolname FROM pg_publication p JOIN pg_roles r ON r.oid = p.pubowner WHERE p.pubname = 'pub1';`,
+					Expected: []sql.Row{{"postgres"}},
+				},
+				{
+					Query:    `SELECT p.pubname, c.relname FROM pg_publication_rel pr JOIN pg_publication p ON p.oid = pr.prpubid JOIN pg_class c ON c.oid = pr.prrelid ORDER BY 1, 2;`,
+					Expected: []sql.Row{{"pub1", "t1"}, {"pub1", "t2"}},
+				},
+				{
+					Query: `SELECT pubname, schemaname, tablename FROM pg_publication_tables ORDER BY 1, 2, 3;`,
+					Expected: []sql.Row{
+						{"pub1", "public", "t1"},
+						{"pub1", "public", "t2"},
+						{"pub2", "other", "t3"},
+						{"pub2", "public", "t1"},
+						{"pub2", "public", "t2"},
+					},
+				},
+				{
+					Query:    `SELECT array_to_string(attnames, ',') FROM pg_publication_tables WHERE pubname = 'pub1' AND tablename = 't1';`,
+					Expected: []sql.Row{{"pk,v1"}},
+				},
+				{
+					Query:       `CREATE PUBLICATION pub1 FOR TABLE t1;`,
+					ExpectedErr: `publication "pub1" already exists`,
+				},
+				{
+					Query:       `CREATE PUBLICATION pub4 FOR TABLE missing;`,
+					ExpectedErr: `relation "missing" does not exist`,
+				},
+				{
+					Query:       `CREATE PUBLICATION pub4 FOR TABLES IN SCHEMA missing;`,
+					ExpectedErr: `schema "missing" does not exist`,
+				},
+				{
+					Query:       `CREATE PUBLICATION pub4 WITH (publish = 'insert, merge');`,
+					ExpectedErr: `unrecognized value for publication option "publish": "merge"`,
+				},
+				{
+					Query:       `CREATE PUBLICATION pub4 WITH (unknown = true);`,
+					ExpectedErr: `unrecognized publication parameter: "unknown"`,
+				},
+				{
+					Query:       `CREATE PUBLICATION pub4 FOR t1;`,
+					ExpectedErr: `invalid publication object list`,
+				},
+				{
+					Query:       `ALTER PUBLICATION pub1 ADD TABLE t1;`,
+					ExpectedErr: `relation "t1" is already member of publication "pub1"`,
+				},
+				{
+					Query:    `ALTER PUBLICATION pub1 DROP TABLE t2;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       `ALTER PUBLICATION pub1 DROP TABLE t2;`,
+					ExpectedErr: `relation "t2" is not part of the publication`,
+				},
+				{
+					Query:    `ALTER PUBLICATION pub1 ADD TABLES IN SCHEMA other;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SELECT n.nspname FROM pg_publication_namespace pn JOIN pg_namespace n ON n.oid = pn.pnnspid;`,
+					Expected: []sql.Row{{"other"}},
+				},
+				{
+					Query:    `SELECT schemaname, tablename FROM pg_publication_tables WHERE pubname = 'pub1' ORDER BY 1, 2;`,
+					Expected: []sql.Row{{"other", "t3"}, {"public", "t1"}},
+				},
+				{
+					Query:    `ALTER PUBLICATION pub1 SET TABLE other.t3, t2;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SELECT schemaname, tablename FROM pg_publication_tables WHERE pubname = 'pub1' ORDER BY 1, 2;`,
+					Expected: []sql.Row{{"other", "t3"}, {"public", "t2"}},
+				},
+				{
+					Query:       `ALTER PUBLICATION pub2 ADD TABLE t1;`,
+					ExpectedErr: `publication "pub2" is defined as FOR ALL TABLES`,
+				},
+				{
+					Query:    `ALTER PUBLICATION pub1 SET (publish = 'delete');`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SELECT pubinsert, pubupdate, pubdelete, pubtruncate FROM pg_publication WHERE pubname = 'pub1';`,
+					Expected: []sql.Row{{"f", "f", "t", "f"}},
+				},
+				{
+					Query:    `ALTER PUBLICATION pub3 RENAME TO pub4;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SELECT pubname FROM pg_publication ORDER BY pubname;`,
+					Expected: []sql.Row{{"pub1"}, {"pub2"}, {"pub4"}},
+				},
+				{
+					Query:       `ALTER PUBLICATION pub4 RENAME TO pub1;`,
+					ExpectedErr: `publication "pub1" already exists`,
+				},
+				{
+					Query:       `ALTER PUBLICATION pub4 OWNER TO missing;`,
+					ExpectedErr: `role "missing" does not exist`,
+				},
+				{
+					Query:    `DROP PUBLICATION pub1, pub4;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `DROP PUBLICATION IF EXISTS pub1;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       `DROP PUBLICATION pub1;`,
+					ExpectedErr: `publication "pub1" does not exist`,
+				},
+				{
+					Query:    `SELECT pubname FROM pg_publication;`,
+					Expected: []sql.Row{{"pub2"}},
+				},
+			},
+		},
+		{
+			Name: "publications are shared by every branch",
+			SetUpScript: []string{
+				`CREATE TABLE t1 (pk INT PRIMARY KEY);`,
+				`CREATE PUBLICATION pub1 FOR TABLE t1;`,
+				`SELECT dolt_commit('-Am', 'create t1');`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT dolt_checkout('-b', 'b1');`,
+					Expected: []sql.Row{{[]any{int64(0), "Switched to branch 'b1'"}}},
+				},
+				{
+					Query:    `SELECT pubname FROM pg_publication;`,
+					Expected: []sql.Row{{"pub1"}},
+				},
+				{
+					Query:    `DROP PUBLICATION pub1;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SELECT dolt_checkout('main');`,
+					Expected: []sql.Row{{[]any{int64(0), "Switched to branch 'main'"}}},
+				},
+				{
+					Query:    `SELECT pubname FROM pg_publication;`,
+					Expected: []sql.Row{},
+				},
+			},
+		},
+		{
+			Name: "publications require privileges",
+			SetUpScript: []string{
+				`CREATE TABLE t1 (pk INT PRIMARY KEY);`,
+				`CREATE USER pub_user PASSWORD 'password';`,
+				`GRANT ALL ON t1 TO pub_user;`,
+				`CREATE PUBLICATION pub1 FOR TABLE t1;`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       `CREATE PUBLICATION pub2 FOR ALL TABLES;`,
+					Username:    `pub_user`,
+					Password:    `password`,
+					ExpectedErr: `must be superuser to create FOR ALL TABLES publication`,
+				},
+				{
+					Query:       `ALTER PUBLICATION pub1 DROP TABLE t1;`,
+					Username:    `pub_user`,
+					Password:    `password`,
+					ExpectedErr: `must be owner of publication pub1`,
+				},
+				{
+					Query:    `CREATE PUBLICATION pub3 FOR TABLE t1;`,
+					Username: `pub_user`,
+					Password: `password`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `DROP PUBLICATION pub3;`,
+					Username: `pub_user`,
+					Password: `password`,
+					Expected: []sql.Row{},
+				},
+			},
+		},
+	})
+}
+
+// TestPublicationStreaming verifies that a pgoutput consumer receives the changes of each Dolt commit through a
+// replication connection.
+func TestPublicationStreaming(t *testing.T) {
+	port, err := sql.GetEmptyPort()
+	require.NoError(t, err)
+	ctx, conn, controller := CreateServerWithPort(t, "postgres", port)
+	defer func() {
+		conn.Close(ctx)
+		controller.Stop()
+		require.NoError(t, controller.WaitForStop())
+	}()
+
+	for _, query := range []string{
+		`CREATE TABLE test (pk INT PRIMARY KEY, v1 TEXT);`,
+		`CREATE TABLE unpublished (pk INT PRIMARY KEY);`,
+		`CREATE PUBLICATION pub FOR TABLE test;`,
+		`SELECT dolt_commit('-Am', 'create tables');`,
+	} {
+		_, err = conn.Exec(ctx, query)
+		require.NoError(t, err)
+	}
+
+	replConn, err := pgconn.Connect(ctx, fmt.Sprintf("postgres://postgres:password@%s:%d/postgres?replication=database", serverHost, port))
+	require.NoError(t, err)
+	defer replConn.Close(context.Background())
+
+	sysident, err := pglogrepl.IdentifySystem(ctx, replConn)
+	require.NoError(t, err)
+	assert.Equal(t, "postgres", sysident.DBName)
+
+	slot, err := pglogrepl.CreateReplicationSlot(ctx, replConn, "test_slot", "pgoutput", pglogrepl.CreateReplicationSlotOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "test_slot", slot.SlotName)
+
+	err = pglogrepl.StartReplication(ctx, replConn, "test_slot", 0, pglogrepl.StartReplicationOptions{
+		PluginArgs: []string{"proto_version '1'", "publication_names 'pub'"},
+	})
+	require.NoError(t, err)
+
+	for _, query := range []string{
+		`INSERT INTO test VALUES (1, 'one'), (2, 'two');`,
+		`INSERT INTO unpublished VALUES (1);`,
+		`SELECT dolt_commit('-Am', 'insert rows');`,
+		`UPDATE test SET v1 = 'uno' WHERE pk = 1;`,
+		`DELETE FROM test WHERE pk = 2;`,
+		`SELECT dolt_commit('-Am', 'update and delete rows');`,
+	} {
+		_, err = conn.Exec(ctx, query)
+		require.NoError(t, err)
+	}
+
+	var messages []pglogrepl.Message
+	var commitEnd pglogrepl.LSN
+	commits := 0
+	deadline := time.Now().Add(30 * time.Second)
+	for commits < 2 {
+		require.True(t, time.Now().Before(deadline), "timed out waiting for replicated changes")
+		receiveCtx, cancel := context.WithDeadline(ctx, deadline)
+		rawMsg, err := replConn.ReceiveMessage(receiveCtx)
+		cancel()
+		require.NoError(t, err)
+		copyData, ok := rawMsg.(*pgproto3.CopyData)
+		require.True(t, ok, "unexpected message %T", rawMsg)
+		if copyData.Data[0] != pglogrepl.XLogDataByteID {
+			continue
+		}
+		xld, err := pglogrepl.ParseXLogData(copyData.Data[1:])
+		require.NoError(t, err)
+		msg, err := pglogrepl.Parse(xld.WALData)
+		require.NoError(t, err)
+		messages = append(messages, msg)
+		if commit, ok := msg.(*pglogrepl.CommitMessage); ok {
+			commits++
+			commitEnd = commit.TransactionEndLSN
+		}
+	}
+
+	var msgTypes []string
+	for _, msg := range messages {
+		msgTypes = append(msgTypes, msg.Type().String())
+	}
+	assert.Equal(t, []string{"Begin", "Relation", "Insert", "Insert", "Commit", "Begin", "Delete", "Update", "Commit"}, msgTypes)
+
+	relation := messages[1].(*pglogrepl.RelationMessage)
+	assert.Equal(t, "public", relation.Namespace)
+	assert.Equal(t, "test", relation.RelationName)
+	require.Len(t, relation.Columns, 2)
+	assert.Equal(t, "pk", relation.Columns[0].Name)
+	assert.Equal(t, uint8(1), relation.Columns[0].Flags)
+	insert := messages[2].(*pglogrepl.InsertMessage)
+	assert.Equal(t, relation.RelationID, insert.RelationID)
+	assert.Equal(t, []byte("1"), insert.Tuple.Columns[0].Data)
+	assert.Equal(t, []byte("one"), insert.Tuple.Columns[1].Data)
+	del := messages[6].(*pglogrepl.DeleteMessage)
+	assert.Equal(t, []byte("2"), del.OldTuple.Columns[0].Data)
+	update := messages[7].(*pglogrepl.UpdateMessage)
+	assert.Equal(t, []byte("uno"), update.NewTuple.Columns[1].Data)
+
+	err = pglogrepl.SendStandbyStatusUpdate(ctx, replConn, pglogrepl.StandbyStatusUpdate{WALWritePosition: commitEnd})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		var confirmed string
+		err := conn.Current.QueryRow(ctx, `SELECT confirmed_flush_lsn FROM pg_replication_slots WHERE slot_name = 'test_slot';`).Scan(&confirmed)
+		return err == nil && confirmed == commitEnd.String()
+	}, 10*time.Second, 100*time.Millisecond)
+}
@@ -0,0 +1,143 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestRootObjectSystemTables(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "dolt_diff_functions and dolt_history_functions",
+			SetUpScript: []string{
+				"CREATE FUNCTION add_one(x INT4) RETURNS INT4 AS $$ BEGIN RETURN x + 1; END; $$ LANGUAGE plpgsql;",
+				"SELECT dolt_commit('-Am', 'add function');",
+				"CREATE OR REPLACE FUNCTION add_one(x INT4) RETURNS INT4 AS $$ BEGIN RETURN x + 2; END; $$ LANGUAGE plpgsql;",
+				"SELECT dolt_commit('-Am', 'change function');",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query: "SELECT schema_name, name, diff_type, field_name FROM dolt_diff_functions WHERE field_name IS NULL OR field_name = 'definition' ORDER BY diff_type;",
+					Expected: []sql.Row{
+						{"public", "add_one(int4)", "added", nil},
+						{"public", "add_one(int4)", "modified", "definition"},
+					},
+				},
+				{
+					Query:    "SELECT from_value LIKE '%x + 1%', to_value LIKE '%x + 2%' FROM dolt_diff_functions WHERE field_name = 'definition';",
+					Expected: []sql.Row{{"t", "t"}},
+				},
+				{
+					Query: "SELECT name, changed, message FROM dolt_history_functions ORDER BY message;",
+					Expected: []sql.Row{
+						{"add_one(int4)", "t", "add function"},
+						{"add_one(int4)", "t", "change function"},
+					},
+				},
+				{
+					Query:    "DROP FUNCTION add_one(INT4);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT to_commit, diff_type, field_name FROM dolt_diff_functions WHERE to_commit = 'WORKING';",
+					Expected: []sql.Row{{"WORKING", "removed", nil}},
+				},
+				{
+					Query:    "SELECT count(*) FROM dolt_history_functions;",
+					Expected: []sql.Row{{2}},
+				},
+			},
+		},
+		{
+			Name: "dolt_diff_sequences and dolt_history_sequences",
+			SetUpScript: []string{
+				"CREATE SEQUENCE seq1;",
+				"CREATE SEQUENCE seq2;",
+				"SELECT dolt_commit('-Am', 'add sequences');",
+				"ALTER SEQUENCE seq1 INCREMENT BY 5;",
+				"SELECT dolt_commit('-Am', 'alter seq1');",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query: "SELECT name, diff_type, field_name, from_value, to_value FROM dolt_diff_sequences WHERE diff_type = 'modified';",
+					Expected: []sql.Row{
+						{"seq1", "modified", "increment", "1", "5"},
+					},
+				},
+				{
+					Query: "SELECT name, changed FROM dolt_history_sequences WHERE message = 'alter seq1' ORDER BY name;",
+					Expected: []sql.Row{
+						{"seq1", "t"},
+						{"seq2", "f"},
+					},
+				},
+				{
+					Query: "SELECT DISTINCT d.name, h.committer FROM dolt_diff_sequences d JOIN dolt_history_sequences h ON d.to_commit = h.commit_hash AND d.name = h.name WHERE d.diff_type = 'modified';",
+					Expected: []sql.Row{
+						{"seq1", "postgres"},
+					},
+				},
+			},
+		},
+		{
+			Name: "history of the database the table is resolved from",
+			SetUpScript: []string{
+				"CREATE SEQUENCE seq1;",
+				"SELECT dolt_commit('-Am', 'add seq1');",
+				"SELECT dolt_branch('b1');",
+				"SELECT dolt_checkout('b1');",
+				"CREATE SEQUENCE seq2;",
+				"SELECT dolt_commit('-Am', 'add seq2');",
+				"SELECT dolt_checkout('main');",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "SELECT name FROM dolt_history_sequences WHERE message = 'add seq2';",
+					Expected: []sql.Row{},
+				},
+				{
+					Query: `SELECT name, changed FROM "postgres/b1".public.dolt_history_sequences WHERE message = 'add seq2' ORDER BY name;`,
+					Expected: []sql.Row{
+						{"seq1", "f"},
+						{"seq2", "t"},
+					},
+				},
+				{
+					Query: `SELECT name, diff_type FROM "postgres/b1".public.dolt_diff_sequences WHERE to_commit <> 'WORKING' ORDER BY name;`,
+					Expected: []sql.Row{
+						{"seq1", "added"},
+						{"seq2", "added"},
+					},
+				},
+			},
+		},
+		{
+			Name: "user tables take precedence",
+			SetUpScript: []string{
+				"CREATE TABLE functions (pk INT4 PRIMARY KEY);",
+				"INSERT INTO functions VALUES (1);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "SELECT to_pk, diff_type FROM dolt_diff_functions;",
+					Expected: []sql.Row{{1, "added"}},
+				},
+			},
+		},
+	})
+}
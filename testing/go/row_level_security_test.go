@@ -0,0 +1,342 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestRowLevelSecurity(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "Tenant isolation using a session setting",
+			SetUpScript: []string{
+				`CREATE TABLE docs (id INT4 PRIMARY KEY, tenant_id INT4, body TEXT);`,
+				`INSERT INTO docs VALUES (1, 1, 'first'), (2, 1, 'second'), (3, 2, 'third');`,
+				`CREATE USER tester PASSWORD 'password';`,
+				`GRANT ALL PRIVILEGES ON SCHEMA public TO tester;`,
+				`GRANT ALL PRIVILEGES ON docs TO tester;`,
+				`CREATE POLICY tenant_isolation ON docs USING (tenant_id = current_setting('app.tenant')::int);`,
+				`ALTER TABLE docs ENABLE ROW LEVEL SECURITY;`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SET app.tenant = '1';`,
+					Username: "tester",
+					Password: "password",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SELECT * FROM docs ORDER BY id;`,
+					Username: "tester",
+					Password: "password",
+					Expected: []sql.Row{{1, 1, "first"}, {2, 1, "second"}},
+				},
+				{
+					Query:    `SELECT count(*) FROM docs WHERE id > 1;`,
+					Username: "tester",
+					Password: "password",
+					Expected: []sql.Row{{1}},
+				},
+				{
+					Query:    `UPDATE docs SET body = 'updated';`,
+					Username: "tester",
+					Password: "password",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `DELETE FROM docs WHERE id = 3;`,
+					Username: "tester",
+					Password: "password",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `INSERT INTO docs VALUES (4, 1, 'fourth');`,
+					Username: "tester",
+					Password: "password",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       `INSERT INTO docs VALUES (5, 2, 'fifth');`,
+					Username:    "tester",
+					Password:    "password",
+					ExpectedErr: `new row violates row-level security policy for table "docs"`,
+				},
+				{
+					Query:       `UPDATE docs SET tenant_id = 2 WHERE id = 1;`,
+					Username:    "tester",
+					Password:    "password",
+					ExpectedErr: `new row violates row-level security policy for table "docs"`,
+				},
+				{
+					Query:    `SET app.tenant = '2';`,
+					Username: "tester",
+					Password: "password",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SELECT * FROM docs ORDER BY id;`,
+					Username: "tester",
+					Password: "password",
+					Expected: []sql.Row{{3, 2, "third"}},
+				},
+				{ // Superusers are not subject to row-level security
+					Query:    `SELECT * FROM docs ORDER BY id;`,
+					Expected: []sql.Row{{1, 1, "updated"}, {2, 1, "updated"}, {3, 2, "third"}, {4, 1, "fourth"}},
+				},
+				{
+					Query:    `ALTER USER tester BYPASSRLS;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SELECT count(*) FROM docs;`,
+					Username: "tester",
+					Password: "password",
+					Expected: []sql.Row{{4}},
+				},
+				{
+					Query:    `ALTER USER tester NOBYPASSRLS;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `ALTER TABLE docs DISABLE ROW LEVEL SECURITY;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SELECT count(*) FROM docs;`,
+					Username: "tester",
+					Password: "password",
+					Expected: []sql.Row{{4}},
+				},
+			},
+		},
+		{
+			Name: "Tables without permissive policies deny every row",
+			SetUpScript: []string{
+				`CREATE TABLE t1 (pk INT4 PRIMARY KEY, v1 INT4);`,
+				`INSERT INTO t1 VALUES (1, 10), (2, 20);`,
+				`CREATE USER tester PASSWORD 'password';`,
+				`GRANT ALL PRIVILEGES ON SCHEMA public TO tester;`,
+				`GRANT ALL PRIVILEGES ON t1 TO tester;`,
+				`ALTER TABLE t1 ENABLE ROW LEVEL SECURITY;`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT * FROM t1;`,
+					Username: "tester",
+					Password: "password",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       `INSERT INTO t1 VALUES (3, 30);`,
+					Username:    "tester",
+					Password:    "password",
+					ExpectedErr: `new row violates row-level security policy for table "t1"`,
+				},
+				{
+					Query:    `CREATE POLICY only_large ON t1 AS RESTRICTIVE USING (v1 > 15);`,
+					Expected: []sql.Row{},
+				},
+				{ // Restrictive policies alone do not grant access
+					Query:    `SELECT * FROM t1;`,
+					Username: "tester",
+					Password: "password",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `CREATE POLICY everything ON t1 USING (true);`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SELECT * FROM t1 ORDER BY pk;`,
+					Username: "tester",
+					Password: "password",
+					Expected: []sql.Row{{2, 20}},
+				},
+				{
+					Query:    `DROP POLICY only_large ON t1;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SELECT * FROM t1 ORDER BY pk;`,
+					Username: "tester",
+					Password: "password",
+					Expected: []sql.Row{{1, 10}, {2, 20}},
+				},
+			},
+		},
+		{
+			Name: "Policies for specific commands and roles",
+			SetUpScript: []string{
+				`CREATE TABLE t1 (pk INT4 PRIMARY KEY, v1 INT4);`,
+				`INSERT INTO t1 VALUES (1, 10), (2, 20), (3, 30);`,
+				`CREATE ROLE readers;`,
+				`CREATE USER tester PASSWORD 'password';`,
+				`CREATE USER other PASSWORD 'password';`,
+				`GRANT readers TO tester;`,
+				`GRANT ALL PRIVILEGES ON SCHEMA public TO tester, other;`,
+				`GRANT ALL PRIVILEGES ON t1 TO tester, other;`,
+				`CREATE POLICY read_some ON t1 FOR SELECT TO readers USING (v1 < 30);`,
+				`CREATE POLICY delete_one ON t1 FOR DELETE USING (pk = 1);`,
+				`CREATE POLICY insert_small ON t1 FOR INSERT WITH CHECK (v1 < 100);`,
+				`ALTER TABLE t1 ENABLE ROW LEVEL SECURITY;`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT * FROM t1 ORDER BY pk;`,
+					Username: "tester",
+					Password: "password",
+					Expected: []sql.Row{{1, 10}, {2, 20}},
+				},
+				{
+					Query:    `SELECT * FROM t1 ORDER BY pk;`,
+					Username: "other",
+					Password: "password",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `INSERT INTO t1 VALUES (4, 40);`,
+					Username: "other",
+					Password: "password",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       `INSERT INTO t1 VALUES (5, 500);`,
+					Username:    "other",
+					Password:    "password",
+					ExpectedErr: `new row violates row-level security policy for table "t1"`,
+				},
+				{
+					Query:    `DELETE FROM t1;`,
+					Username: "other",
+					Password: "password",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SELECT * FROM t1 ORDER BY pk;`,
+					Expected: []sql.Row{{2, 20}, {3, 30}, {4, 40}},
+				},
+				{
+					Query:    `ALTER POLICY read_some ON t1 TO PUBLIC USING (v1 > 20);`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SELECT * FROM t1 ORDER BY pk;`,
+					Username: "other",
+					Password: "password",
+					Expected: []sql.Row{{3, 30}, {4, 40}},
+				},
+			},
+		},
+		{
+			Name: "Policy statements and catalogs",
+			SetUpScript: []string{
+				`CREATE TABLE t1 (pk INT4 PRIMARY KEY, v1 INT4);`,
+				`CREATE ROLE readers;`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `CREATE POLICY p1 ON t1 USING (v1 > 0);`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `CREATE POLICY p2 ON t1 AS RESTRICTIVE FOR UPDATE TO readers USING (v1 > 1) WITH CHECK (v1 < 100);`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       `CREATE POLICY p1 ON t1 USING (true);`,
+					ExpectedErr: `policy "p1" for table "t1" already exists`,
+				},
+				{
+					Query:       `CREATE POLICY p3 ON t2 USING (true);`,
+					ExpectedErr: `relation "t2" does not exist`,
+				},
+				{
+					Query:       `CREATE POLICY p3 ON t1 TO missing USING (true);`,
+					ExpectedErr: `role "missing" does not exist`,
+				},
+				{
+					Query:       `CREATE POLICY p3 ON t1 FOR INSERT USING (true);`,
+					ExpectedErr: `only WITH CHECK expression allowed for INSERT`,
+				},
+				{
+					Query:       `CREATE POLICY p3 ON t1 FOR SELECT WITH CHECK (true);`,
+					ExpectedErr: `WITH CHECK cannot be applied to SELECT or DELETE`,
+				},
+				{
+					Query:       `CREATE POLICY p3 ON t1 AS SOMETIMES USING (true);`,
+					ExpectedErr: `unrecognized row security option "sometimes"`,
+				},
+				{
+					Query:       `CREATE POLICY p3 ON t1 USING (v2 > 0);`,
+					ExpectedErr: `column "v2" could not be found`,
+				},
+				{
+					Query: `SELECT schemaname, tablename, policyname, permissive, roles, cmd, qual, with_check FROM pg_policies ORDER BY policyname;`,
+					Expected: []sql.Row{
+						{"public", "t1", "p1", "PERMISSIVE", "{public}", "ALL", "v1 > 0", nil},
+						{"public", "t1", "p2", "RESTRICTIVE", "{readers}", "UPDATE", "v1 > 1", "v1 < 100"},
+					},
+				},
+				{
+					Query:    `SELECT polname, polcmd, polpermissive FROM pg_policy ORDER BY polname;`,
+					Expected: []sql.Row{{"p1", "*", "t"}, {"p2", "w", "f"}},
+				},
+				{
+					Query:    `ALTER TABLE t1 ENABLE ROW LEVEL SECURITY, FORCE ROW LEVEL SECURITY;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SELECT relrowsecurity, relforcerowsecurity FROM pg_class WHERE relname = 't1';`,
+					Expected: []sql.Row{{"t", "t"}},
+				},
+				{
+					Query:       `ALTER TABLE t1 ENABLE ROW LEVEL SECURITY, ADD COLUMN v2 INT4;`,
+					ExpectedErr: `This command does not currently support multiple actions in one statement`,
+				},
+				{
+					Query:    `ALTER POLICY p1 ON t1 RENAME TO p3;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       `ALTER POLICY p1 ON t1 USING (true);`,
+					ExpectedErr: `policy "p1" for table "t1" does not exist`,
+				},
+				{
+					Query:    `DROP POLICY p3 ON t1;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       `DROP POLICY p3 ON t1;`,
+					ExpectedErr: `policy "p3" for table "t1" does not exist`,
+				},
+				{
+					Query:    `DROP POLICY IF EXISTS p3 ON t1;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `DROP TABLE t1;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SELECT count(*) FROM pg_policies;`,
+					Expected: []sql.Row{{0}},
+				},
+			},
+		},
+	})
+}
@@ -915,9 +915,17 @@ var SchemaTests = []ScriptTest{
 				ExpectedErr: "cannot drop schema hastables because other objects depend on it",
 			},
 			{
-				Skip:  true, // not implemented yet
 				Query: "drop schema hasTables cascade;",
 			},
+			{
+				Query: "Show schemas",
+				Expected: []sql.Row{
+					{"dolt"},
+					{"pg_catalog"},
+					{"public"},
+					{"information_schema"},
+				},
+			},
 			{
 				Query: "create schema hastype;",
 			},
@@ -924,7 +924,6 @@ func TestSequences(t *testing.T) {
 		},
 		{
 			Name: "identity generated by default with sequence options",
-			Skip: true, // not supported yet, need to add sequence info into DML node given to GMS
 			SetUpScript: []string{
 				`CREATE TABLE "django_migrations" (
     "id" bigint NOT NULL PRIMARY KEY GENERATED BY DEFAULT AS IDENTITY (START WITH 100 INCREMENT BY 2),
@@ -947,9 +946,215 @@ func TestSequences(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "sequence cache",
+			SetUpScript: []string{
+				"CREATE SEQUENCE test CACHE 10;",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "SELECT nextval('test');",
+					Expected: []sql.Row{{1}},
+				},
+				{
+					Username: "postgres",
+					Password: "password",
+					Query:    "SELECT nextval('test');",
+					Expected: []sql.Row{{11}},
+				},
+				{
+					Query:    "SELECT nextval('test');",
+					Expected: []sql.Row{{2}},
+				},
+				{
+					Query:    "SELECT setval('test', 100);",
+					Expected: []sql.Row{{100}},
+				},
+				{
+					Query:    "SELECT nextval('test');",
+					Expected: []sql.Row{{101}},
+				},
+				{
+					Query:       "CREATE SEQUENCE test2 CACHE 0;",
+					ExpectedErr: "CACHE (0) must be greater than zero",
+				},
+				{
+					Query:       "ALTER SEQUENCE test CACHE -1;",
+					ExpectedErr: "CACHE (-1) must be greater than zero",
+				},
+			},
+		},
+		{
+			Name: "sequence cache is kept per branch",
+			SetUpScript: []string{
+				"CREATE SEQUENCE test CACHE 10;",
+				"SELECT dolt_commit('-Am', 'add sequence');",
+				"SELECT dolt_branch('b1');",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "SELECT nextval('test');",
+					Expected: []sql.Row{{1}},
+				},
+				{
+					Query:    "SELECT dolt_checkout('b1');",
+					Expected: []sql.Row{{[]any{int64(0), "Switched to branch 'b1'"}}},
+				},
+				{
+					Query:    "SELECT setval('test', 50);",
+					Expected: []sql.Row{{50}},
+				},
+				{
+					Query:    "SELECT nextval('test');",
+					Expected: []sql.Row{{51}},
+				},
+				{
+					Query:    "SELECT dolt_checkout('main');",
+					Expected: []sql.Row{{[]any{int64(0), "Switched to branch 'main'"}}},
+				},
+				{
+					Query:    "SELECT nextval('test');",
+					Expected: []sql.Row{{2}},
+				},
+			},
+		},
+		{
+			Name: "alter sequence options",
+			SetUpScript: []string{
+				"CREATE SEQUENCE test;",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "SELECT nextval('test');",
+					Expected: []sql.Row{{1}},
+				},
+				{
+					Query:    "ALTER SEQUENCE test INCREMENT BY 5;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT nextval('test');",
+					Expected: []sql.Row{{6}},
+				},
+				{
+					Query:    "ALTER SEQUENCE test RESTART WITH 100;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT nextval('test');",
+					Expected: []sql.Row{{100}},
+				},
+				{
+					Query:       "ALTER SEQUENCE test MAXVALUE 50;",
+					ExpectedErr: "RESTART value (105) cannot be greater than MAXVALUE (50)",
+				},
+				{
+					Query:       "ALTER SEQUENCE test INCREMENT BY 0;",
+					ExpectedErr: "INCREMENT must not be zero",
+				},
+				{
+					Query:    "SELECT nextval('test');",
+					Expected: []sql.Row{{105}},
+				},
+			},
+		},
+		{
+			Name: "alter identity columns",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY, v1 INT);",
+				"INSERT INTO test (v1) VALUES (1), (2);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "ALTER TABLE test ALTER COLUMN pk RESTART WITH 100;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "INSERT INTO test (v1) VALUES (3);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "ALTER TABLE test ALTER COLUMN pk SET INCREMENT BY 10;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "INSERT INTO test (v1) VALUES (4);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "ALTER TABLE test ALTER COLUMN pk SET GENERATED ALWAYS;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "INSERT INTO test (v1) VALUES (5);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query: "SELECT * FROM test ORDER BY v1;",
+					Expected: []sql.Row{
+						{1, 1},
+						{2, 2},
+						{100, 3},
+						{110, 4},
+						{120, 5},
+					},
+				},
+				{
+					Query:       "ALTER TABLE test ALTER COLUMN v1 RESTART WITH 5;",
+					ExpectedErr: `column "v1" of relation "test" is not an identity column`,
+				},
+				{
+					Query:       "ALTER TABLE test ALTER COLUMN v2 RESTART WITH 5;",
+					ExpectedErr: `column "v2" of relation "test" does not exist`,
+				},
+				{
+					Query:    "ALTER TABLE test ALTER COLUMN pk DROP IDENTITY;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "SELECT nextval('test_pk_seq');",
+					ExpectedErr: "does not exist",
+				},
+				{
+					Query:    "INSERT INTO test VALUES (200, 6);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "ALTER TABLE test ALTER COLUMN pk DROP IDENTITY;",
+					ExpectedErr: `column "pk" of relation "test" is not an identity column`,
+				},
+				{
+					Query:    "ALTER TABLE test ALTER COLUMN pk DROP IDENTITY IF EXISTS;",
+					Expected: []sql.Row{},
+				},
+			},
+		},
+		{
+			Name: "identity generated always with sequence options",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk BIGINT GENERATED ALWAYS AS IDENTITY (START WITH 10 INCREMENT BY -1 MINVALUE 8) PRIMARY KEY, v1 INT);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "INSERT INTO test (v1) VALUES (1), (2), (3);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query: "SELECT * FROM test ORDER BY v1;",
+					Expected: []sql.Row{
+						{10, 1},
+						{9, 2},
+						{8, 3},
+					},
+				},
+				{
+					Query:       "INSERT INTO test (v1) VALUES (4);",
+					ExpectedErr: "reached minimum value",
+				},
+			},
+		},
 		{
 			Name: "insert on a different branch",
-			Skip: true, // currently dies on creating the sequence on a non-current DB table
 			SetUpScript: []string{
 				"create table test (pk serial primary key, v1 int);",
 				"insert into test (v1) values (2), (3), (5), (7), (11);",
@@ -70,11 +70,12 @@ func TestDiscard(t *testing.T) {
 	})
 }
 
-// TestBeginIsolationLevel asserts that BEGIN statements accept any transaction isolation level clause.
+// TestBeginIsolationLevel asserts that BEGIN statements accept any transaction isolation level clause. The behavior of
+// each isolation level is tested in TestTransactionIsolation.
 func TestBeginIsolationLevel(t *testing.T) {
 	RunScripts(t, []ScriptTest{
 		{
-			Name: "BEGIN with any isolation level clause is accepted as a no-op",
+			Name: "BEGIN with any isolation level clause is accepted",
 			SetUpScript: []string{
 				`CREATE TABLE test (a INT PRIMARY KEY)`,
 			},
@@ -0,0 +1,265 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestSubscriptions(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "CREATE, ALTER, and DROP SUBSCRIPTION without connecting",
+			SetUpScript: []string{
+				`CREATE SUBSCRIPTION sub1 CONNECTION 'host=127.0.0.1 port=1 dbname=postgres' PUBLICATION pub1, pub2 WITH (connect = false);`,
+				`CREATE USER sub_reader PASSWORD 'hello';`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT subname, subenabled, subslotname, subconninfo, array_to_string(subpublications, ',') FROM pg_subscription;`,
+					Expected: []sql.Row{{"sub1", "f", "sub1", "host=127.0.0.1 port=1 dbname=postgres", "pub1,pub2"}},
+				},
+				{
+					Query:    `SELECT subname, subconninfo FROM pg_subscription;`,
+					Username: `sub_reader`,
+					Password: `hello`,
+					Expected: []sql.Row{{"sub1", nil}},
+				},
+				{
+					Query:    `SELECT r.rolname FROM pg_subscription s JOIN pg_roles r ON r.oid = s.subowner;`,
+					Expected: []sql.Row{{"postgres"}},
+				},
+				{
+					Query:    `SELECT subname, pid, received_lsn, latest_end_lsn FROM pg_stat_subscription;`,
+					Expected: []sql.Row{{"sub1", nil, nil, nil}},
+				},
+				{
+					Query:    `SELECT count(*) FROM pg_subscription_rel;`,
+					Expected: []sql.Row{{0}},
+				},
+				{
+					Query:    `SELECT s.oid = st.subid FROM pg_subscription s JOIN pg_stat_subscription st ON s.subname = st.subname;`,
+					Expected: []sql.Row{{"t"}},
+				},
+				{
+					Query:       `CREATE SUBSCRIPTION sub1 CONNECTION 'host=127.0.0.1 port=1 dbname=postgres' PUBLICATION pub1 WITH (connect = false);`,
+					ExpectedErr: `subscription "sub1" already exists`,
+				},
+				{
+					Query:       `ALTER SUBSCRIPTION sub1 ADD PUBLICATION pub1 WITH (refresh = false);`,
+					ExpectedErr: `publication "pub1" is already in subscription "sub1"`,
+				},
+				{
+					Query:    `ALTER SUBSCRIPTION sub1 ADD PUBLICATION pub3 WITH (refresh = false);`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SELECT array_to_string(subpublications, ',') FROM pg_subscription;`,
+					Expected: []sql.Row{{"pub1,pub2,pub3"}},
+				},
+				{
+					Query:    `ALTER SUBSCRIPTION sub1 DROP PUBLICATION pub1, pub2 WITH (refresh = false);`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SELECT array_to_string(subpublications, ',') FROM pg_subscription;`,
+					Expected: []sql.Row{{"pub3"}},
+				},
+				{
+					Query:       `ALTER SUBSCRIPTION sub1 DROP PUBLICATION pub1 WITH (refresh = false);`,
+					ExpectedErr: `publication "pub1" is not in subscription "sub1"`,
+				},
+				{
+					Query:       `ALTER SUBSCRIPTION sub1 DROP PUBLICATION pub3 WITH (refresh = false);`,
+					ExpectedErr: `cannot drop all the publications from a subscription`,
+				},
+				{
+					Query:       `ALTER SUBSCRIPTION sub1 SET PUBLICATION pub4;`,
+					ExpectedErr: `ALTER SUBSCRIPTION with refresh is not allowed for disabled subscriptions`,
+				},
+				{
+					Query:       `ALTER SUBSCRIPTION sub1 SET PUBLICATION pub4 WITH (refresh = false, copy_data = true);`,
+					ExpectedErr: `refresh = false and copy_data = true are mutually exclusive options`,
+				},
+				{
+					Query:    `ALTER SUBSCRIPTION sub1 SET PUBLICATION pub4 WITH (refresh = false);`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       `ALTER SUBSCRIPTION sub1 REFRESH PUBLICATION;`,
+					ExpectedErr: `ALTER SUBSCRIPTION ... REFRESH is not allowed for disabled subscriptions`,
+				},
+				{
+					Query:    `ALTER SUBSCRIPTION sub1 CONNECTION 'host=127.0.0.1 port=2 dbname=postgres';`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `ALTER SUBSCRIPTION sub1 RENAME TO sub2;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SELECT subname, subconninfo, array_to_string(subpublications, ',') FROM pg_subscription;`,
+					Expected: []sql.Row{{"sub2", "host=127.0.0.1 port=2 dbname=postgres", "pub4"}},
+				},
+				{
+					Query:       `ALTER SUBSCRIPTION sub1 DISABLE;`,
+					ExpectedErr: `subscription "sub1" does not exist`,
+				},
+				{
+					Query:    `ALTER SUBSCRIPTION sub2 SET (slot_name = NONE);`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SELECT subslotname FROM pg_subscription;`,
+					Expected: []sql.Row{{nil}},
+				},
+				{
+					Query:       `ALTER SUBSCRIPTION sub2 ENABLE;`,
+					ExpectedErr: `cannot enable subscription that does not have a slot name`,
+				},
+				{
+					Query:       `ALTER SUBSCRIPTION sub2 SET (foo = 1);`,
+					ExpectedErr: `unrecognized subscription parameter: "foo"`,
+				},
+				{
+					Query:       `ALTER SUBSCRIPTION sub2 SET (binary = true);`,
+					ExpectedErr: `not yet supported`,
+				},
+				{
+					Query:    `DROP SUBSCRIPTION sub2;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SELECT count(*) FROM pg_subscription;`,
+					Expected: []sql.Row{{0}},
+				},
+				{
+					Query:       `DROP SUBSCRIPTION sub2;`,
+					ExpectedErr: `subscription "sub2" does not exist`,
+				},
+				{
+					Query:    `DROP SUBSCRIPTION IF EXISTS sub2;`,
+					Expected: []sql.Row{},
+					ExpectedNotices: []ExpectedNotice{
+						{
+							Severity: "NOTICE",
+							Message:  `subscription "sub2" does not exist, skipping`,
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "CREATE SUBSCRIPTION option validation",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       `CREATE SUBSCRIPTION sub1 CONNECTION 'host=127.0.0.1 port=1' PUBLICATION pub1 WITH (connect = false, enabled = true);`,
+					ExpectedErr: `connect = false and enabled = true are mutually exclusive options`,
+				},
+				{
+					Query:       `CREATE SUBSCRIPTION sub1 CONNECTION 'host=127.0.0.1 port=1' PUBLICATION pub1 WITH (connect = false, copy_data);`,
+					ExpectedErr: `connect = false and copy_data = true are mutually exclusive options`,
+				},
+				{
+					Query:       `CREATE SUBSCRIPTION sub1 CONNECTION 'host=127.0.0.1 port=1' PUBLICATION pub1 WITH (slot_name = NONE);`,
+					ExpectedErr: `subscription with slot_name = NONE must also set enabled = false`,
+				},
+				{
+					Query:       `CREATE SUBSCRIPTION sub1 CONNECTION 'host=127.0.0.1 port=1' PUBLICATION pub1 WITH (slot_name = NONE, enabled = false);`,
+					ExpectedErr: `subscription with slot_name = NONE must also set create_slot = false`,
+				},
+				{
+					Query:       `CREATE SUBSCRIPTION sub1 CONNECTION 'host=127.0.0.1 port=1' PUBLICATION pub1 WITH (slot_name = NONE, enabled = true);`,
+					ExpectedErr: `slot_name = NONE and enabled = true are mutually exclusive options`,
+				},
+				{
+					Query:       `CREATE SUBSCRIPTION sub1 CONNECTION 'host=127.0.0.1 port=1' PUBLICATION pub1 WITH (enabled = 'maybe');`,
+					ExpectedErr: `enabled requires a Boolean value`,
+				},
+				{
+					Query:       `CREATE SUBSCRIPTION sub1 CONNECTION 'host=127.0.0.1 port=1' PUBLICATION pub1 WITH (copy_data, copy_data = false);`,
+					ExpectedErr: `conflicting or redundant options`,
+				},
+				{
+					Query:       `CREATE SUBSCRIPTION sub1 CONNECTION 'host=127.0.0.1 port=1' PUBLICATION pub1 WITH (refresh = false);`,
+					ExpectedErr: `unrecognized subscription parameter: "refresh"`,
+				},
+				{
+					Query:       `CREATE SUBSCRIPTION sub1 CONNECTION 'host=127.0.0.1 port=1' PUBLICATION pub1, pub1 WITH (connect = false);`,
+					ExpectedErr: `publication name "pub1" used more than once`,
+				},
+				{
+					Query:       `CREATE SUBSCRIPTION sub1 CONNECTION 'host=127.0.0.1 port=1' PUBLICATION pub1 WITH (connect = false, slot_name = 'Bad-Name');`,
+					ExpectedErr: `replication slot name "Bad-Name" contains invalid character`,
+				},
+				{
+					Query:    `CREATE SUBSCRIPTION sub1 CONNECTION 'host=127.0.0.1 port=1' PUBLICATION pub1 WITH (connect = off, slot_name = 'other_slot');`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SELECT subname, subenabled, subslotname FROM pg_subscription;`,
+					Expected: []sql.Row{{"sub1", "f", "other_slot"}},
+				},
+			},
+		},
+		{
+			Name: "subscription privileges",
+			SetUpScript: []string{
+				`CREATE USER user1 PASSWORD 'password';`,
+				`CREATE SUBSCRIPTION sub1 CONNECTION 'host=127.0.0.1 port=1' PUBLICATION pub1 WITH (connect = false, slot_name = NONE);`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       `CREATE SUBSCRIPTION sub2 CONNECTION 'host=127.0.0.1 port=1' PUBLICATION pub1 WITH (connect = false);`,
+					Username:    `user1`,
+					Password:    `password`,
+					ExpectedErr: `permission denied to create subscription`,
+				},
+				{
+					Query:       `ALTER SUBSCRIPTION sub1 RENAME TO sub2;`,
+					Username:    `user1`,
+					Password:    `password`,
+					ExpectedErr: `must be owner of subscription sub1`,
+				},
+				{
+					Query:       `ALTER SUBSCRIPTION sub1 OWNER TO user2;`,
+					ExpectedErr: `role "user2" does not exist`,
+				},
+				{
+					Query:    `ALTER SUBSCRIPTION sub1 OWNER TO user1;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `ALTER SUBSCRIPTION sub1 RENAME TO sub2;`,
+					Username: `user1`,
+					Password: `password`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SELECT s.subname, r.rolname FROM pg_subscription s JOIN pg_roles r ON r.oid = s.subowner;`,
+					Expected: []sql.Row{{"sub2", "user1"}},
+				},
+				{
+					Query:    `DROP SUBSCRIPTION sub2;`,
+					Username: `user1`,
+					Password: `password`,
+					Expected: []sql.Row{},
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,305 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestTemporaryObjects(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "temporary views",
+			SetUpScript: []string{
+				`CREATE TABLE test (a INT PRIMARY KEY, b TEXT)`,
+				`INSERT INTO test VALUES (1, 'one'), (2, 'two')`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "CREATE TEMPORARY VIEW temp_view AS SELECT b FROM test WHERE a > 1",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM temp_view",
+					Expected: []sql.Row{{"two"}},
+				},
+				{
+					Query:    "SELECT * FROM pg_temp.temp_view",
+					Expected: []sql.Row{{"two"}},
+				},
+				{
+					Query:       "CREATE TEMP VIEW temp_view AS SELECT 1",
+					ExpectedErr: "already exists",
+				},
+				{
+					Query:    "CREATE OR REPLACE TEMP VIEW temp_view AS SELECT a FROM test",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM temp_view ORDER BY a",
+					Expected: []sql.Row{{1}, {2}},
+				},
+				{
+					Query:    "SELECT count(*) FROM dolt_status",
+					Expected: []sql.Row{{1}},
+				},
+				{
+					Username:    "postgres",
+					Password:    "password",
+					Query:       "SELECT * FROM temp_view",
+					ExpectedErr: "not found",
+				},
+				{
+					Query:    "DROP VIEW temp_view",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "SELECT * FROM temp_view",
+					ExpectedErr: "not found",
+				},
+			},
+		},
+		{
+			Name: "temporary views take precedence over permanent views",
+			SetUpScript: []string{
+				`CREATE VIEW shadowed AS SELECT 'permanent' AS source`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "CREATE TEMP VIEW shadowed AS SELECT 'temporary' AS source",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM shadowed",
+					Expected: []sql.Row{{"temporary"}},
+				},
+				{
+					Query:    "DROP VIEW shadowed",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM shadowed",
+					Expected: []sql.Row{{"permanent"}},
+				},
+			},
+		},
+		{
+			Name: "temporary relations may not be created in other schemas",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       "CREATE TEMP TABLE public.temp_table (a INT)",
+					ExpectedErr: "cannot create temporary relation in non-temporary schema",
+				},
+				{
+					Query:       "CREATE TEMP VIEW public.temp_view AS SELECT 1",
+					ExpectedErr: "cannot create temporary relation in non-temporary schema",
+				},
+				{
+					Query:       "CREATE TABLE permanent (a INT) ON COMMIT DROP",
+					ExpectedErr: "ON COMMIT can only be used on temporary tables",
+				},
+			},
+		},
+		{
+			Name: "tables created in pg_temp are temporary",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "CREATE TABLE pg_temp.staging (a INT PRIMARY KEY)",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "INSERT INTO staging VALUES (1), (2)",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM pg_temp.staging ORDER BY a",
+					Expected: []sql.Row{{1}, {2}},
+				},
+				{
+					Query:    "SELECT count(*) FROM dolt_status",
+					Expected: []sql.Row{{0}},
+				},
+				{
+					Username:    "postgres",
+					Password:    "password",
+					Query:       "SELECT * FROM staging",
+					ExpectedErr: "not found",
+				},
+			},
+		},
+		{
+			Name: "ON COMMIT DELETE ROWS",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "CREATE TEMP TABLE staging (a INT PRIMARY KEY) ON COMMIT DELETE ROWS",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "BEGIN",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "INSERT INTO staging VALUES (1), (2)",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM staging ORDER BY a",
+					Expected: []sql.Row{{1}, {2}},
+				},
+				{
+					Query:    "COMMIT",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM staging",
+					Expected: []sql.Row{},
+				},
+				{
+					// Outside of a transaction block, each statement is its own transaction
+					Query:    "INSERT INTO staging VALUES (3)",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM staging",
+					Expected: []sql.Row{},
+				},
+			},
+		},
+		{
+			Name: "ON COMMIT DROP",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "BEGIN",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "CREATE TEMP TABLE staging (a INT PRIMARY KEY) ON COMMIT DROP",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "INSERT INTO staging VALUES (1)",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM staging",
+					Expected: []sql.Row{{1}},
+				},
+				{
+					Query:    "COMMIT",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "SELECT * FROM staging",
+					ExpectedErr: "not found",
+				},
+				{
+					Query:    "CREATE TEMP TABLE staging (a INT PRIMARY KEY) ON COMMIT PRESERVE ROWS",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "INSERT INTO staging VALUES (2)",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM staging",
+					Expected: []sql.Row{{2}},
+				},
+			},
+		},
+		{
+			Name: "temporary sequences",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "CREATE TEMPORARY SEQUENCE temp_seq START 10",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT nextval('temp_seq'), nextval('pg_temp.temp_seq')",
+					Expected: []sql.Row{{10, 11}},
+				},
+				{
+					Query:    "SELECT setval('temp_seq', 20)",
+					Expected: []sql.Row{{20}},
+				},
+				{
+					Query:    "SELECT nextval('temp_seq')",
+					Expected: []sql.Row{{21}},
+				},
+				{
+					Query:    "SELECT count(*) FROM dolt_status",
+					Expected: []sql.Row{{0}},
+				},
+				{
+					Username:    "postgres",
+					Password:    "password",
+					Query:       "SELECT nextval('temp_seq')",
+					ExpectedErr: "does not exist",
+				},
+				{
+					Query:    "DROP SEQUENCE temp_seq",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "SELECT nextval('temp_seq')",
+					ExpectedErr: "does not exist",
+				},
+			},
+		},
+		{
+			Name: "temporary tables with serial columns use temporary sequences",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "CREATE TEMP TABLE staging (id SERIAL PRIMARY KEY, v TEXT)",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "INSERT INTO staging (v) VALUES ('a'), ('b')",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM staging ORDER BY id",
+					Expected: []sql.Row{{1, "a"}, {2, "b"}},
+				},
+				{
+					Query:    "SELECT count(*) FROM dolt_status",
+					Expected: []sql.Row{{0}},
+				},
+			},
+		},
+		{
+			Name: "pg_my_temp_schema",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "SELECT pg_my_temp_schema() = 0",
+					Expected: []sql.Row{{"t"}},
+				},
+				{
+					Query:    "CREATE TEMP VIEW temp_view AS SELECT 1",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT pg_my_temp_schema() = 0",
+					Expected: []sql.Row{{"f"}},
+				},
+				{
+					Query:    "SELECT nspname LIKE 'pg_temp_%' FROM pg_namespace WHERE oid = pg_my_temp_schema()",
+					Expected: []sql.Row{{"t"}},
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,370 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestTransactionIsolation(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "READ COMMITTED sees data committed by other transactions on each statement",
+			SetUpScript: []string{
+				`CREATE TABLE test (a INT PRIMARY KEY)`,
+				`INSERT INTO test VALUES (1)`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "BEGIN",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SHOW transaction_isolation",
+					Expected: []sql.Row{{"read committed"}},
+				},
+				{
+					Query:    "SELECT * FROM test ORDER BY a",
+					Expected: []sql.Row{{1}},
+				},
+				{
+					Username: "postgres",
+					Password: "password",
+					Query:    "INSERT INTO test VALUES (2)",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM test ORDER BY a",
+					Expected: []sql.Row{{1}, {2}},
+				},
+				{
+					Query:    "COMMIT",
+					Expected: []sql.Row{},
+				},
+			},
+		},
+		{
+			Name: "READ COMMITTED keeps its snapshot once it has made changes",
+			SetUpScript: []string{
+				`CREATE TABLE test (a INT PRIMARY KEY)`,
+				`INSERT INTO test VALUES (1)`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "BEGIN",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "INSERT INTO test VALUES (3)",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM test ORDER BY a",
+					Expected: []sql.Row{{1}, {3}},
+				},
+				{
+					Username: "postgres",
+					Password: "password",
+					Query:    "INSERT INTO test VALUES (2)",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM test ORDER BY a",
+					Expected: []sql.Row{{1}, {3}},
+				},
+				{
+					Query:    "COMMIT",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM test ORDER BY a",
+					Expected: []sql.Row{{1}, {2}, {3}},
+				},
+			},
+		},
+		{
+			Name: "REPEATABLE READ keeps the snapshot from its first statement",
+			SetUpScript: []string{
+				`CREATE TABLE test (a INT PRIMARY KEY)`,
+				`INSERT INTO test VALUES (1)`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "BEGIN ISOLATION LEVEL REPEATABLE READ",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SHOW transaction_isolation",
+					Expected: []sql.Row{{"repeatable read"}},
+				},
+				{
+					Query:    "SELECT * FROM test ORDER BY a",
+					Expected: []sql.Row{{1}},
+				},
+				{
+					Username: "postgres",
+					Password: "password",
+					Query:    "INSERT INTO test VALUES (2)",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM test ORDER BY a",
+					Expected: []sql.Row{{1}},
+				},
+				{
+					Query:    "COMMIT",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SHOW transaction_isolation",
+					Expected: []sql.Row{{"read committed"}},
+				},
+				{
+					Query:    "SELECT * FROM test ORDER BY a",
+					Expected: []sql.Row{{1}, {2}},
+				},
+			},
+		},
+		{
+			Name: "SERIALIZABLE detects write skew",
+			SetUpScript: []string{
+				`CREATE TABLE doctors (name TEXT PRIMARY KEY, on_call BOOL)`,
+				`INSERT INTO doctors VALUES ('alice', true), ('bob', true)`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "BEGIN ISOLATION LEVEL SERIALIZABLE",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT count(*) FROM doctors WHERE on_call",
+					Expected: []sql.Row{{2}},
+				},
+				{
+					Username: "postgres",
+					Password: "password",
+					Query:    "BEGIN ISOLATION LEVEL SERIALIZABLE",
+					Expected: []sql.Row{},
+				},
+				{
+					Username: "postgres",
+					Password: "password",
+					Query:    "SELECT count(*) FROM doctors WHERE on_call",
+					Expected: []sql.Row{{2}},
+				},
+				{
+					Username: "postgres",
+					Password: "password",
+					Query:    "UPDATE doctors SET on_call = false WHERE name = 'bob'",
+					Expected: []sql.Row{},
+				},
+				{
+					Username: "postgres",
+					Password: "password",
+					Query:    "COMMIT",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "UPDATE doctors SET on_call = false WHERE name = 'alice'",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "COMMIT",
+					ExpectedErr: "could not serialize access due to read/write dependencies among transactions",
+				},
+				{
+					Query:    "SELECT * FROM doctors ORDER BY name",
+					Expected: []sql.Row{{"alice", "t"}, {"bob", "f"}},
+				},
+				{
+					// Retrying the transaction succeeds, since nothing else is running concurrently
+					Query:    "BEGIN ISOLATION LEVEL SERIALIZABLE",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT count(*) FROM doctors WHERE on_call",
+					Expected: []sql.Row{{1}},
+				},
+				{
+					Query:    "COMMIT",
+					Expected: []sql.Row{},
+				},
+			},
+		},
+		{
+			Name: "SERIALIZABLE transactions with only an outgoing dependency do not fail",
+			SetUpScript: []string{
+				`CREATE TABLE a (v INT PRIMARY KEY)`,
+				`CREATE TABLE b (v INT PRIMARY KEY)`,
+				`INSERT INTO a VALUES (1)`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "BEGIN ISOLATION LEVEL SERIALIZABLE",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM a ORDER BY v",
+					Expected: []sql.Row{{1}},
+				},
+				{
+					Username: "postgres",
+					Password: "password",
+					Query:    "BEGIN ISOLATION LEVEL SERIALIZABLE",
+					Expected: []sql.Row{},
+				},
+				{
+					Username: "postgres",
+					Password: "password",
+					Query:    "INSERT INTO a VALUES (2)",
+					Expected: []sql.Row{},
+				},
+				{
+					Username: "postgres",
+					Password: "password",
+					Query:    "COMMIT",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "INSERT INTO b VALUES (1)",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "COMMIT",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM a ORDER BY v",
+					Expected: []sql.Row{{1}, {2}},
+				},
+			},
+		},
+		{
+			Name: "SERIALIZABLE transactions that only read do not fail",
+			SetUpScript: []string{
+				`CREATE TABLE test (a INT PRIMARY KEY)`,
+				`INSERT INTO test VALUES (1)`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "BEGIN ISOLATION LEVEL SERIALIZABLE",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM test ORDER BY a",
+					Expected: []sql.Row{{1}},
+				},
+				{
+					Username: "postgres",
+					Password: "password",
+					Query:    "INSERT INTO test VALUES (2)",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM test ORDER BY a",
+					Expected: []sql.Row{{1}},
+				},
+				{
+					Query:    "COMMIT",
+					Expected: []sql.Row{},
+				},
+			},
+		},
+		{
+			Name: "SET TRANSACTION",
+			SetUpScript: []string{
+				`CREATE TABLE test (a INT PRIMARY KEY)`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					// Outside of a transaction block, this only emits a warning
+					Query:    "SET TRANSACTION ISOLATION LEVEL SERIALIZABLE",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SHOW transaction_isolation",
+					Expected: []sql.Row{{"read committed"}},
+				},
+				{
+					Query:    "BEGIN",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SET TRANSACTION ISOLATION LEVEL SERIALIZABLE",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SHOW transaction_isolation",
+					Expected: []sql.Row{{"serializable"}},
+				},
+				{
+					Query:    "SELECT * FROM test",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "SET TRANSACTION ISOLATION LEVEL READ COMMITTED",
+					ExpectedErr: "SET TRANSACTION ISOLATION LEVEL must be called before any query",
+				},
+				{
+					Query:    "ROLLBACK",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SHOW transaction_isolation",
+					Expected: []sql.Row{{"read committed"}},
+				},
+				{
+					Query:       "SET TRANSACTION READ ONLY",
+					ExpectedErr: "not yet supported",
+				},
+			},
+		},
+		{
+			Name: "SET SESSION CHARACTERISTICS",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "SET SESSION CHARACTERISTICS AS TRANSACTION ISOLATION LEVEL SERIALIZABLE",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SHOW default_transaction_isolation",
+					Expected: []sql.Row{{"serializable"}},
+				},
+				{
+					Query:    "BEGIN",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SHOW transaction_isolation",
+					Expected: []sql.Row{{"serializable"}},
+				},
+				{
+					Query:    "COMMIT",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SET SESSION CHARACTERISTICS AS TRANSACTION ISOLATION LEVEL READ COMMITTED",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SHOW default_transaction_isolation",
+					Expected: []sql.Row{{"read committed"}},
+				},
+			},
+		},
+	})
+}
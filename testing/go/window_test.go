@@ -397,6 +397,233 @@ func TestWindowFunctions(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "lag and lead with offsets and defaults",
+			SetUpScript: []string{
+				"CREATE TABLE ll (id INT PRIMARY KEY, grp INT, val INT, amt NUMERIC);",
+				"INSERT INTO ll VALUES (1,1,10,1.5),(2,1,20,2.5),(3,1,30,3.5),(4,2,40,4.5);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query: "SELECT id, lag(val) OVER (PARTITION BY grp ORDER BY id), lead(val) OVER (PARTITION BY grp ORDER BY id) FROM ll ORDER BY id",
+					Expected: []sql.Row{
+						{1, nil, 20},
+						{2, 10, 30},
+						{3, 20, nil},
+						{4, nil, nil},
+					},
+				},
+				{
+					Query: "SELECT id, lead(val, 2, 0) OVER (PARTITION BY grp ORDER BY id), lead(val, -1) OVER (PARTITION BY grp ORDER BY id) FROM ll ORDER BY id",
+					Expected: []sql.Row{
+						{1, 30, nil},
+						{2, 0, 10},
+						{3, 0, 20},
+						{4, 0, nil},
+					},
+				},
+				{
+					Query: "SELECT id, lag(amt, 1, 0) OVER (ORDER BY id) FROM ll ORDER BY id",
+					Expected: []sql.Row{
+						{1, Numeric("0")},
+						{2, Numeric("1.5")},
+						{3, Numeric("2.5")},
+						{4, Numeric("3.5")},
+					},
+				},
+				{
+					Query: "SELECT id, lag(val, NULL) OVER (ORDER BY id) FROM ll ORDER BY id",
+					Expected: []sql.Row{
+						{1, nil},
+						{2, nil},
+						{3, nil},
+						{4, nil},
+					},
+				},
+				{
+					Query:       "SELECT lag(val, 1, 'abc'::text) OVER (ORDER BY id) FROM ll",
+					ExpectedErr: "does not exist",
+				},
+			},
+		},
+		{
+			Name: "first_value and last_value with ROWS, RANGE, and GROUPS frames and EXCLUDE",
+			SetUpScript: []string{
+				"CREATE TABLE fv (id INT PRIMARY KEY, val INT);",
+				"INSERT INTO fv VALUES (1,10),(2,20),(3,30),(4,40);",
+				"CREATE TABLE fg (id INT PRIMARY KEY, g INT, val INT);",
+				"INSERT INTO fg VALUES (1,1,10),(2,1,10),(3,2,20),(4,2,20),(5,3,30);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query: "SELECT id, first_value(val) OVER (ORDER BY id), last_value(val) OVER (ORDER BY id) FROM fv ORDER BY id",
+					Expected: []sql.Row{
+						{1, 10, 10},
+						{2, 10, 20},
+						{3, 10, 30},
+						{4, 10, 40},
+					},
+				},
+				{
+					Query: "SELECT id, last_value(val) OVER (ORDER BY g) FROM fg ORDER BY id",
+					Expected: []sql.Row{
+						{1, 10},
+						{2, 10},
+						{3, 20},
+						{4, 20},
+						{5, 30},
+					},
+				},
+				{
+					Query: "SELECT id, last_value(val) OVER (ORDER BY id ROWS BETWEEN CURRENT ROW AND 1 FOLLOWING) FROM fv ORDER BY id",
+					Expected: []sql.Row{
+						{1, 20},
+						{2, 30},
+						{3, 40},
+						{4, 40},
+					},
+				},
+				{
+					Query: "SELECT id, first_value(val) OVER (ORDER BY g GROUPS BETWEEN 1 PRECEDING AND CURRENT ROW), last_value(val) OVER (ORDER BY g GROUPS BETWEEN CURRENT ROW AND 1 FOLLOWING) FROM fg ORDER BY id",
+					Expected: []sql.Row{
+						{1, 10, 20},
+						{2, 10, 20},
+						{3, 10, 30},
+						{4, 10, 30},
+						{5, 20, 30},
+					},
+				},
+				{
+					Query: "SELECT id, first_value(val) OVER (ORDER BY g GROUPS BETWEEN 1 FOLLOWING AND UNBOUNDED FOLLOWING) FROM fg ORDER BY id",
+					Expected: []sql.Row{
+						{1, 20},
+						{2, 20},
+						{3, 30},
+						{4, 30},
+						{5, nil},
+					},
+				},
+				{
+					Query: "SELECT id, first_value(val) OVER (ORDER BY id ROWS BETWEEN 1 PRECEDING AND 1 FOLLOWING EXCLUDE CURRENT ROW), last_value(val) OVER (ORDER BY id ROWS BETWEEN UNBOUNDED PRECEDING AND UNBOUNDED FOLLOWING EXCLUDE CURRENT ROW) FROM fv ORDER BY id",
+					Expected: []sql.Row{
+						{1, 20, 40},
+						{2, 10, 40},
+						{3, 20, 40},
+						{4, 30, 30},
+					},
+				},
+				{
+					Query: "SELECT id, nth_value(val, 2) OVER (ORDER BY id ROWS BETWEEN UNBOUNDED PRECEDING AND UNBOUNDED FOLLOWING EXCLUDE CURRENT ROW) FROM fv ORDER BY id",
+					Expected: []sql.Row{
+						{1, 30},
+						{2, 30},
+						{3, 20},
+						{4, 20},
+					},
+				},
+				{
+					Query: "SELECT id, last_value(val) OVER (ORDER BY g ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW EXCLUDE GROUP) FROM fg ORDER BY id",
+					Expected: []sql.Row{
+						{1, nil},
+						{2, nil},
+						{3, 10},
+						{4, 10},
+						{5, 20},
+					},
+				},
+				{
+					Query: "SELECT id, nth_value(val, 2) OVER (ORDER BY g ROWS BETWEEN UNBOUNDED PRECEDING AND UNBOUNDED FOLLOWING EXCLUDE TIES) FROM fg ORDER BY id",
+					Expected: []sql.Row{
+						{1, 20},
+						{2, 20},
+						{3, 10},
+						{4, 10},
+						{5, 10},
+					},
+				},
+				{
+					Query:       "SELECT sum(val) OVER (ORDER BY g GROUPS BETWEEN 1 PRECEDING AND CURRENT ROW) FROM fg",
+					ExpectedErr: "GROUPS is not yet supported",
+				},
+				{
+					Query: "SELECT id, first_value(val) OVER (ORDER BY g RANGE BETWEEN 1 PRECEDING AND 1 FOLLOWING EXCLUDE GROUP), last_value(val) OVER (ORDER BY g RANGE BETWEEN 1 PRECEDING AND 1 FOLLOWING EXCLUDE GROUP) FROM fg ORDER BY id",
+					Expected: []sql.Row{
+						{1, 20, 20},
+						{2, 20, 20},
+						{3, 10, 30},
+						{4, 10, 30},
+						{5, 20, 20},
+					},
+				},
+				{
+					Query: "SELECT id, last_value(val) OVER (ORDER BY g DESC RANGE BETWEEN CURRENT ROW AND 1 FOLLOWING EXCLUDE CURRENT ROW) FROM fg ORDER BY id",
+					Expected: []sql.Row{
+						{1, 10},
+						{2, 10},
+						{3, 10},
+						{4, 10},
+						{5, 20},
+					},
+				},
+				{
+					Query:       "SELECT first_value(val) OVER (ORDER BY g, id RANGE BETWEEN 1 PRECEDING AND CURRENT ROW EXCLUDE CURRENT ROW) FROM fg",
+					ExpectedErr: "RANGE with offset PRECEDING/FOLLOWING requires exactly one ORDER BY column",
+				},
+				{
+					Query:       "SELECT first_value(val) OVER (ORDER BY g RANGE BETWEEN -1 PRECEDING AND CURRENT ROW EXCLUDE CURRENT ROW) FROM fg",
+					ExpectedErr: "invalid preceding or following size in window function",
+				},
+				{
+					Query: "SELECT id, first_value(val) OVER w, last_value(val) OVER w FROM fg WINDOW w AS (ORDER BY g GROUPS BETWEEN 1 PRECEDING AND CURRENT ROW EXCLUDE TIES) ORDER BY id",
+					Expected: []sql.Row{
+						{1, 10, 10},
+						{2, 10, 10},
+						{3, 10, 20},
+						{4, 10, 20},
+						{5, 20, 30},
+					},
+				},
+				{
+					Query: "SELECT id, last_value(val) OVER (w ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW EXCLUDE GROUP) FROM fg WINDOW w AS (ORDER BY g) ORDER BY id",
+					Expected: []sql.Row{
+						{1, nil},
+						{2, nil},
+						{3, 10},
+						{4, 10},
+						{5, 20},
+					},
+				},
+				{
+					Query:       "SELECT sum(val) OVER w FROM fg WINDOW w AS (ORDER BY g GROUPS BETWEEN 1 PRECEDING AND CURRENT ROW)",
+					ExpectedErr: "GROUPS is not yet supported",
+				},
+			},
+		},
+		{
+			Name: "RANGE frames with interval offsets and EXCLUDE",
+			SetUpScript: []string{
+				"CREATE TABLE fts (ts TIMESTAMP PRIMARY KEY, val INT);",
+				"INSERT INTO fts VALUES ('2024-01-01 00:00:00', 1), ('2024-01-02 00:00:00', 2), ('2024-01-05 00:00:00', 5);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query: "SELECT val, first_value(val) OVER (ORDER BY ts RANGE BETWEEN INTERVAL '2 days' PRECEDING AND CURRENT ROW EXCLUDE CURRENT ROW) FROM fts ORDER BY val",
+					Expected: []sql.Row{
+						{1, nil},
+						{2, 1},
+						{5, nil},
+					},
+				},
+				{
+					Query: "SELECT val, last_value(val) OVER (ORDER BY ts RANGE BETWEEN CURRENT ROW AND INTERVAL '3 days' FOLLOWING EXCLUDE CURRENT ROW) FROM fts ORDER BY val",
+					Expected: []sql.Row{
+						{1, 2},
+						{2, 5},
+						{5, nil},
+					},
+				},
+			},
+		},
 		{
 			Name: "variance/stddev window functions over an int column",
 			SetUpScript: []string{
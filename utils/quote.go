@@ -0,0 +1,57 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"strings"
+
+	"github.com/dolthub/doltgresql/postgres/parser/lex"
+)
+
+// QuoteIdentifier returns the identifier as a quoted PostgreSQL identifier, so that it keeps its case and may be a
+// keyword.
+func QuoteIdentifier(identifier string) string {
+	return `"` + strings.ReplaceAll(identifier, `"`, `""`) + `"`
+}
+
+// QuoteQualifiedIdentifier returns the schema-qualified name as a pair of quoted PostgreSQL identifiers. Only the name
+// is returned when the schema is empty.
+func QuoteQualifiedIdentifier(schemaName string, name string) string {
+	if schemaName == "" {
+		return QuoteIdentifier(name)
+	}
+	return QuoteIdentifier(schemaName) + "." + QuoteIdentifier(name)
+}
+
+// QuoteIdentifierIfNeeded returns the identifier as a PostgreSQL identifier, which is only quoted when necessary,
+// matching quote_ident in Postgres. Identifiers are left unquoted when they consist solely of lowercase letters,
+// digits, and underscores (without a leading digit), so that case folding does not change them, and are not a keyword
+// other than an unreserved keyword.
+func QuoteIdentifierIfNeeded(identifier string) string {
+	safe := len(identifier) > 0 && ((identifier[0] >= 'a' && identifier[0] <= 'z') || identifier[0] == '_')
+	for i := 0; safe && i < len(identifier); i++ {
+		ch := identifier[i]
+		safe = (ch >= 'a' && ch <= 'z') || (ch >= '0' && ch <= '9') || ch == '_'
+	}
+	if safe {
+		if category, ok := lex.KeywordsCategories[identifier]; ok && category != "U" {
+			safe = false
+		}
+	}
+	if safe {
+		return identifier
+	}
+	return QuoteIdentifier(identifier)
+}